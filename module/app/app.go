@@ -834,14 +834,19 @@ func (app *Gravity) firstBeginBlocker(ctx sdk.Context) {
 	app.assertBech32PrefixMatches(ctx)
 
 	// The following call should panic if any invalid ERC20 addresses exist in types/const.go
-	monitoredErc20s := app.gravityKeeper.MonitoredERC20Tokens(ctx)
-	if len(monitoredErc20s) > 0 {
-		ctx.Logger().Info(
-			"Loaded Monitored ERC20 Tokens, your orchestrator is required to monitor the Gravity.sol balance of the following tokens: %v",
-			monitoredErc20s,
-		)
-	} else {
-		ctx.Logger().Info("Monitored ERC20 Tokens not yet set, your orchestrator is currently not required to monitor any Gravity.sol balances")
+	for _, evmChain := range app.gravityKeeper.GetEvmChains(ctx) {
+		monitoredErc20s := app.gravityKeeper.MonitoredERC20Tokens(ctx, evmChain.EvmChainPrefix)
+		if len(monitoredErc20s) > 0 {
+			ctx.Logger().Info(
+				"Loaded Monitored ERC20 Tokens, your orchestrator is required to monitor the Gravity.sol balance of the following tokens",
+				"evm_chain_prefix", evmChain.EvmChainPrefix, "tokens", monitoredErc20s,
+			)
+		} else {
+			ctx.Logger().Info(
+				"Monitored ERC20 Tokens not yet set, your orchestrator is currently not required to monitor any Gravity.sol balances",
+				"evm_chain_prefix", evmChain.EvmChainPrefix,
+			)
+		}
 	}
 }
 
@@ -0,0 +1,33 @@
+package apollo
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	crisiskeeper "github.com/cosmos/cosmos-sdk/x/crisis/keeper"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+func GetApolloUpgradeHandler(
+	mm *module.Manager, configurator *module.Configurator, crisisKeeper *crisiskeeper.Keeper,
+) func(
+	ctx sdk.Context, plan upgradetypes.Plan, vmap module.VersionMap,
+) (module.VersionMap, error) {
+	if mm == nil {
+		panic("Nil argument to GetApolloUpgradeHandler")
+	}
+	return func(ctx sdk.Context, plan upgradetypes.Plan, vmap module.VersionMap) (module.VersionMap, error) {
+		ctx.Logger().Info("Apollo upgrade: Starting upgrade")
+
+		// The gravity module moves from consensus version 4 to 5 here, see the keeper's Migrate4to5
+		ctx.Logger().Info("Apollo Upgrade: Running any configured module migrations")
+		out, outErr := mm.RunMigrations(ctx, *configurator, vmap)
+		if outErr != nil {
+			return out, outErr
+		}
+		ctx.Logger().Info("Asserting invariants after upgrade")
+		crisisKeeper.AssertInvariants(ctx)
+
+		ctx.Logger().Info("Apollo Upgrade Successful")
+		return out, nil
+	}
+}
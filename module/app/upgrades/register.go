@@ -14,6 +14,7 @@ import (
 	ibctransferkeeper "github.com/cosmos/ibc-go/v4/modules/apps/transfer/keeper"
 
 	"github.com/Gravity-Bridge/Gravity-Bridge/module/app/upgrades/antares"
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/app/upgrades/apollo"
 	"github.com/Gravity-Bridge/Gravity-Bridge/module/app/upgrades/orion"
 	"github.com/Gravity-Bridge/Gravity-Bridge/module/app/upgrades/singlestep"
 )
@@ -85,4 +86,10 @@ func RegisterUpgradeHandlers(
 		antares.OrionToAntaresPlanName,
 		antares.GetAntaresUpgradeHandler(mm, configurator, crisisKeeper),
 	)
+
+	// Apollo upgrade handler
+	upgradeKeeper.SetUpgradeHandler(
+		apollo.AntaresToApolloPlanName,
+		apollo.GetApolloUpgradeHandler(mm, configurator, crisisKeeper),
+	)
 }
@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/debug"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/spf13/cobra"
+
+	gravitytypes "github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// DebugCmd extends the sdk's debug command with the gravity tooling which needs no node
+func DebugCmd() *cobra.Command {
+	cmd := debug.Cmd()
+	cmd.AddCommand(VerifyConfirmCmd())
+	return cmd
+}
+
+// VerifyConfirmCmd recovers the signer of a confirm signature over a valset, batch or logic call offline
+func VerifyConfirmCmd() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "verify-confirm [valset|batch|logic-call] [subject json file] [gravity-id] [signature] [ethereum-address]",
+		Short: "Verify a confirm signature over a valset, batch or logic call without a node",
+		Long: `Compute the abi encoded checkpoint of a valset, batch or logic call under the gravity id of its evm chain,
+recover the signer of the hex encoded signature over it and compare it to the expected delegate ethereum address.
+The subject json file holds the valset, batch or logic call as printed by the gravity queries, for example
+the "batch" field of 'query gravity batch-request-by-nonce'. Fails when the signature is not valid, which
+makes the signature a candidate for MsgSubmitBadSignatureEvidence if its checkpoint was never requested.`,
+		Args: cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			subject, err := gravitytypes.NewCheckpointSubject(args[0])
+			if err != nil {
+				return err
+			}
+			bz, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+			if err := clientCtx.Codec.UnmarshalJSON(bz, subject.(codec.ProtoMarshaler)); err != nil {
+				return fmt.Errorf("unable to parse %s as a %s: %w", args[1], args[0], err)
+			}
+			if err := gravitytypes.ValidateCheckpointSubject(subject); err != nil {
+				return fmt.Errorf("invalid %s in %s: %w", args[0], args[1], err)
+			}
+			ethAddress, err := gravitytypes.NewEthAddress(args[4])
+			if err != nil {
+				return err
+			}
+
+			res := gravitytypes.NewQueryCheckpointResponse(args[2], subject)
+			confirm := gravitytypes.NewCheckpointConfirm(subject.GetCheckpoint(args[2]), "", "", args[3], ethAddress)
+			res.Confirms = append(res.Confirms, confirm)
+			if err := clientCtx.PrintProto(&res); err != nil {
+				return err
+			}
+			if !confirm.Valid {
+				return fmt.Errorf("invalid confirm: %s", confirm.Error)
+			}
+			return nil
+		},
+	}
+	return cmd
+}
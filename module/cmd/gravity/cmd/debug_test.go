@@ -0,0 +1,79 @@
+package cmd_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/app"
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/cmd/gravity/cmd"
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// TestVerifyConfirmCmd tests the offline verification of a batch confirm
+// nolint: exhaustruct
+func TestVerifyConfirmCmd(t *testing.T) {
+	encCfg := app.MakeEncodingConfig()
+
+	batch := types.OutgoingTxBatch{
+		BatchNonce:    3,
+		BatchTimeout:  420,
+		Transactions:  []types.OutgoingTransferTx{},
+		TokenContract: "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7",
+	}
+	subject := filepath.Join(t.TempDir(), "batch.json")
+	bz, err := encCfg.Marshaler.MarshalJSON(&batch)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(subject, bz, 0600))
+
+	privKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	signer := crypto.PubkeyToAddress(privKey.PublicKey).Hex()
+	signature, err := types.NewEthereumSignature(batch.GetCheckpoint("gravity-test"), privKey)
+	require.NoError(t, err)
+
+	run := func(args ...string) (string, error) {
+		out := new(bytes.Buffer)
+		clientCtx := client.Context{}.WithCodec(encCfg.Marshaler).WithOutput(out)
+		ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+		verify := cmd.VerifyConfirmCmd()
+		verify.SetErr(new(bytes.Buffer))
+		verify.SetArgs(args)
+		err := verify.ExecuteContext(ctx)
+		return out.String(), err
+	}
+
+	out, err := run(types.CheckpointKindBatch, subject, "gravity-test", hex.EncodeToString(signature), signer)
+	require.NoError(t, err)
+	require.Contains(t, out, hex.EncodeToString(batch.GetCheckpoint("gravity-test")))
+
+	// another gravity id, another signer or another kind of subject do not match
+	_, err = run(types.CheckpointKindBatch, subject, "gravity-other", hex.EncodeToString(signature), signer)
+	require.Error(t, err)
+	_, err = run(types.CheckpointKindBatch, subject, "gravity-test", hex.EncodeToString(signature), types.ZeroAddressString)
+	require.Error(t, err)
+	_, err = run(types.CheckpointKindLogicCall, subject, "gravity-test", hex.EncodeToString(signature), signer)
+	require.Error(t, err)
+
+	// a subject whose checkpoint cannot be computed is rejected
+	batch.TokenContract = "not an address"
+	bz, err = encCfg.Marshaler.MarshalJSON(&batch)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(subject, bz, 0600))
+	require.NotPanics(t, func() {
+		_, err = run(types.CheckpointKindBatch, subject, "gravity-test", hex.EncodeToString(signature), signer)
+	})
+	require.Error(t, err)
+	require.NotPanics(t, func() {
+		_, err = run(types.CheckpointKindValset, subject, "gravity-test", hex.EncodeToString(signature), signer)
+	})
+	require.Error(t, err)
+}
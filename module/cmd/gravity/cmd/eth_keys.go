@@ -1,22 +1,35 @@
 package cmd
 
 import (
+	"bufio"
 	"crypto/ecdsa"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"os"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/input"
 	"github.com/cosmos/cosmos-sdk/client/keys"
+	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/accounts/keystore"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/spf13/cobra"
 	"github.com/tendermint/tendermint/libs/cli"
+
+	gravitycli "github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/client/cli"
+	gravitytypes "github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
 )
 
-const flagPassphrase = "passphrase"
+const (
+	flagPassphrase       = "passphrase"
+	flagImportPassphrase = "import-passphrase"
+	flagKeystore         = "keystore"
+	flagYes              = "yes"
+)
 
 // Commands registers a sub-tree of commands to interact with
 // local private key storage.
@@ -35,6 +48,12 @@ The keyring supports the following backends:
 
 	cmd.AddCommand(
 		AddKeyCommand(),
+		ListKeysCommand(),
+		ShowKeyCommand(),
+		ImportKeyCommand(),
+		ExportKeyCommand(),
+		DeleteKeyCommand(),
+		SignCommand(),
 	)
 
 	cmd.PersistentFlags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
@@ -67,10 +86,21 @@ func AddKeyCommand() *cobra.Command {
 
 type EthereumKeyOutput struct {
 	PublicKey  string `json:"public_key"`
-	PrivateKey string `json:"private_key"`
+	PrivateKey string `json:"private_key,omitempty"`
 	Address    string `json:"address"`
 }
 
+func newEthereumKeyOutput(privateKey *ecdsa.PrivateKey, withPrivateKey bool) EthereumKeyOutput {
+	keyOutput := EthereumKeyOutput{
+		PublicKey: hexutil.Encode(crypto.FromECDSAPub(&privateKey.PublicKey)),
+		Address:   crypto.PubkeyToAddress(privateKey.PublicKey).Hex(),
+	}
+	if withPrivateKey {
+		keyOutput.PrivateKey = hexutil.Encode(crypto.FromECDSA(privateKey))
+	}
+	return keyOutput
+}
+
 func runAddCmd(cmd *cobra.Command, args []string) error {
 	privateKey, err := crypto.GenerateKey()
 	if err != nil {
@@ -121,7 +151,10 @@ func printCreate(cmd *cobra.Command, keyOutput EthereumKeyOutput) error {
 	switch output {
 	case keys.OutputFormatText:
 		cmd.PrintErrln()
-		cmd.Printf("private: %s \npublic: %s \naddress: %s\n", keyOutput.PrivateKey, keyOutput.PublicKey, keyOutput.Address)
+		if keyOutput.PrivateKey != "" {
+			cmd.Printf("private: %s \n", keyOutput.PrivateKey)
+		}
+		cmd.Printf("public: %s \naddress: %s\n", keyOutput.PublicKey, keyOutput.Address)
 
 	case keys.OutputFormatJSON:
 		outputBytes, err := json.Marshal(keyOutput)
@@ -136,3 +169,273 @@ func printCreate(cmd *cobra.Command, keyOutput EthereumKeyOutput) error {
 
 	return nil
 }
+
+// ListKeysCommand defines a keys command to list the keys of the keystore
+func ListKeysCommand() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List the ethereum keys of the keystore",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			ks := gravitycli.EthKeyStore(clientCtx.KeyringDir)
+
+			output, err := cmd.Flags().GetString(cli.OutputFlag)
+			if err != nil {
+				return err
+			}
+			switch output {
+			case keys.OutputFormatText:
+				for _, account := range ks.Accounts() {
+					cmd.Printf("address: %s \nfile: %s\n", account.Address.Hex(), account.URL.Path)
+				}
+
+			case keys.OutputFormatJSON:
+				keysOutput := []EthereumKeyFileOutput{}
+				for _, account := range ks.Accounts() {
+					keysOutput = append(keysOutput, EthereumKeyFileOutput{Address: account.Address.Hex(), File: account.URL.Path})
+				}
+				outputBytes, err := json.Marshal(keysOutput)
+				if err != nil {
+					return err
+				}
+				cmd.Println(string(outputBytes))
+
+			default:
+				return fmt.Errorf("invalid output format %s", output)
+			}
+			return nil
+		},
+	}
+	return cmd
+}
+
+type EthereumKeyFileOutput struct {
+	Address string `json:"address"`
+	File    string `json:"file"`
+}
+
+// ShowKeyCommand defines a keys command to show the public key and address of a key of the keystore
+func ShowKeyCommand() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "show [address]",
+		Short: "Show the public key of an ethereum key, the address may be omitted when the keystore holds a single key",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			privateKey, err := unlockEthKey(cmd, args)
+			if err != nil {
+				return err
+			}
+			return printCreate(cmd, newEthereumKeyOutput(privateKey, false))
+		},
+	}
+	cmd.Flags().String(flagPassphrase, "default", "Password of the ethereum key")
+	return cmd
+}
+
+// ImportKeyCommand defines a keys command to import a private key or a keystore file into the keystore
+func ImportKeyCommand() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "import [keystore json file]",
+		Short: "Import an ethereum private key, given as a keystore json file or in hex on stdin, and encrypt it to disk",
+		Long: `Import an ethereum private key and encrypt it to disk. The key is read from the given keystore json file, or
+without a file it is prompted for in hex, or read from stdin, so that it never appears in the shell history.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			ks := gravitycli.EthKeyStore(clientCtx.KeyringDir)
+			passphrase, err := cmd.Flags().GetString(flagPassphrase)
+			if err != nil {
+				return err
+			}
+
+			var privateKey *ecdsa.PrivateKey
+			if len(args) == 1 {
+				keyJSON, err := os.ReadFile(args[0])
+				if err != nil {
+					return fmt.Errorf("unable to read keystore file %s: %w", args[0], err)
+				}
+				importPassphrase, err := cmd.Flags().GetString(flagImportPassphrase)
+				if err != nil {
+					return err
+				}
+				key, err := keystore.DecryptKey(keyJSON, importPassphrase)
+				if err != nil {
+					return fmt.Errorf("unable to decrypt keystore file %s: %w", args[0], err)
+				}
+				privateKey = key.PrivateKey
+			} else {
+				buf := bufio.NewReader(cmd.InOrStdin())
+				keyHex, err := input.GetPassword("Enter the hex private key:", buf)
+				if err != nil {
+					return err
+				}
+				privateKey, err = crypto.HexToECDSA(strings.TrimPrefix(strings.TrimSpace(keyHex), "0x"))
+				if err != nil {
+					return fmt.Errorf("invalid hex private key: %w", err)
+				}
+			}
+
+			if _, err := ks.ImportECDSA(privateKey, passphrase); err != nil {
+				return err
+			}
+			return printCreate(cmd, newEthereumKeyOutput(privateKey, false))
+		},
+	}
+	cmd.Flags().String(flagPassphrase, "default", "Password used to encrypt the imported key")
+	cmd.Flags().String(flagImportPassphrase, "", "Password of the keystore json file being imported")
+	return cmd
+}
+
+// ExportKeyCommand defines a keys command to export a key of the keystore
+func ExportKeyCommand() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "export [address]",
+		Short: "Export an ethereum private key in hex, or as a keystore json file with --keystore",
+		Long: `Export an ethereum private key in hex, or as a keystore json file with --keystore, the address may be omitted
+when the keystore holds a single key. The exported keystore file is encrypted with the passphrase of the key.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			asKeystore, err := cmd.Flags().GetBool(flagKeystore)
+			if err != nil {
+				return err
+			}
+			if !asKeystore {
+				privateKey, err := unlockEthKey(cmd, args)
+				if err != nil {
+					return err
+				}
+				return printCreate(cmd, newEthereumKeyOutput(privateKey, true))
+			}
+
+			ks, account, err := findEthKey(cmd, args)
+			if err != nil {
+				return err
+			}
+			passphrase, err := cmd.Flags().GetString(flagPassphrase)
+			if err != nil {
+				return err
+			}
+			keyJSON, err := ks.Export(account, passphrase, passphrase)
+			if err != nil {
+				return err
+			}
+			cmd.Println(string(keyJSON))
+			return nil
+		},
+	}
+	cmd.Flags().String(flagPassphrase, "default", "Password of the ethereum key")
+	cmd.Flags().Bool(flagKeystore, false, "Export the key as a keystore json file instead of a hex private key")
+	return cmd
+}
+
+// DeleteKeyCommand defines a keys command to delete a key from the keystore
+func DeleteKeyCommand() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "delete [address]",
+		Short: "Delete an ethereum key from the keystore, the address may be omitted when the keystore holds a single key",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ks, account, err := findEthKey(cmd, args)
+			if err != nil {
+				return err
+			}
+			passphrase, err := cmd.Flags().GetString(flagPassphrase)
+			if err != nil {
+				return err
+			}
+
+			if skip, _ := cmd.Flags().GetBool(flagYes); !skip {
+				buf := bufio.NewReader(cmd.InOrStdin())
+				yes, err := input.GetConfirmation(fmt.Sprintf("Key %s will be deleted. Continue?", account.Address.Hex()), buf, cmd.ErrOrStderr())
+				if err != nil {
+					return err
+				}
+				if !yes {
+					return nil
+				}
+			}
+
+			if err := ks.Delete(account, passphrase); err != nil {
+				return err
+			}
+			cmd.PrintErrln("Key deleted forever (uh oh!)")
+			return nil
+		},
+	}
+	cmd.Flags().String(flagPassphrase, "default", "Password of the ethereum key")
+	cmd.Flags().BoolP(flagYes, "y", false, "Skip confirmation prompt when deleting the key")
+	return cmd
+}
+
+// SignCommand defines a keys command to sign a checkpoint with a key of the keystore
+func SignCommand() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "sign [checkpoint] [address]",
+		Short: "Sign a valset, batch or logic call checkpoint with an ethereum key",
+		Long: `Sign a valset, batch or logic call checkpoint, given as 32 hex encoded bytes, the way the orchestrator confirms
+it. The address may be omitted when the keystore holds a single key. Prints the hex encoded signature.`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			checkpoint, err := hexutil.Decode(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid checkpoint %s: %w", args[0], err)
+			}
+			if len(checkpoint) != 32 {
+				return fmt.Errorf("invalid checkpoint length %d, expected 32 bytes", len(checkpoint))
+			}
+			privateKey, err := unlockEthKey(cmd, args[1:])
+			if err != nil {
+				return err
+			}
+			signature, err := gravitytypes.NewEthereumSignature(checkpoint, privateKey)
+			if err != nil {
+				return err
+			}
+			cmd.Println(hexutil.Encode(signature))
+			return nil
+		},
+	}
+	cmd.Flags().String(flagPassphrase, "default", "Password of the ethereum key")
+	return cmd
+}
+
+// findEthKey opens the keystore of the command and looks up the key of the optional address argument
+func findEthKey(cmd *cobra.Command, args []string) (*keystore.KeyStore, accounts.Account, error) {
+	clientCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return nil, accounts.Account{}, err
+	}
+	ks := gravitycli.EthKeyStore(clientCtx.KeyringDir)
+	address := ""
+	if len(args) > 0 {
+		address = args[0]
+	}
+	account, err := gravitycli.FindEthKey(ks, address)
+	return ks, account, err
+}
+
+// unlockEthKey decrypts the key of the optional address argument with the passphrase of the command
+func unlockEthKey(cmd *cobra.Command, args []string) (*ecdsa.PrivateKey, error) {
+	_, account, err := findEthKey(cmd, args)
+	if err != nil {
+		return nil, err
+	}
+	passphrase, err := cmd.Flags().GetString(flagPassphrase)
+	if err != nil {
+		return nil, err
+	}
+	return gravitycli.UnlockEthKey(account, passphrase)
+}
@@ -0,0 +1,100 @@
+package cmd_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/cli"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/cmd/gravity/cmd"
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// TestEthKeysCmd tests the life cycle of a key in the ethereum keystore
+// nolint: exhaustruct
+func TestEthKeysCmd(t *testing.T) {
+	home := t.TempDir()
+	clientCtx := client.Context{}.WithHomeDir(home)
+	ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+
+	runWithInput := func(stdin string, args ...string) (string, error) {
+		out := new(bytes.Buffer)
+		ethKeys := cmd.Commands(home)
+		ethKeys.SetIn(strings.NewReader(stdin))
+		ethKeys.SetOut(out)
+		ethKeys.SetErr(new(bytes.Buffer))
+		ethKeys.SetArgs(append(args, fmt.Sprintf("--%s=%s", flags.FlagHome, home)))
+		err := ethKeys.ExecuteContext(ctx)
+		return strings.TrimSpace(out.String()), err
+	}
+	run := func(args ...string) (string, error) {
+		return runWithInput("", args...)
+	}
+
+	privateKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	address := crypto.PubkeyToAddress(privateKey.PublicKey).Hex()
+
+	// signing needs a key
+	checkpoint := hexutil.Encode(crypto.Keccak256([]byte("checkpoint")))
+	_, err = run("sign", checkpoint)
+	require.Error(t, err)
+
+	// the hex key is read from stdin, an argument must be a keystore file
+	_, err = run("import", hexutil.Encode(crypto.FromECDSA(privateKey)), "--passphrase=secret")
+	require.Error(t, err)
+	_, err = runWithInput("not a key\n", "import")
+	require.Error(t, err)
+	_, err = runWithInput(hexutil.Encode(crypto.FromECDSA(privateKey))+"\n", "import", "--passphrase=secret")
+	require.NoError(t, err)
+
+	out, err := run("list", fmt.Sprintf("--%s=json", cli.OutputFlag))
+	require.NoError(t, err)
+	var listed []cmd.EthereumKeyFileOutput
+	require.NoError(t, json.Unmarshal([]byte(out), &listed))
+	require.Len(t, listed, 1)
+	require.Equal(t, address, listed[0].Address)
+
+	// the only key is used when the address is omitted
+	out, err = run("sign", checkpoint, "--passphrase=secret")
+	require.NoError(t, err)
+	signature, err := hexutil.Decode(out)
+	require.NoError(t, err)
+	ethAddress, err := types.NewEthAddress(address)
+	require.NoError(t, err)
+	require.NoError(t, types.ValidateEthereumSignature(hexutil.MustDecode(checkpoint), signature, *ethAddress))
+
+	_, err = run("sign", checkpoint, "--passphrase=wrong")
+	require.Error(t, err)
+	_, err = run("sign", "0x01", address, "--passphrase=secret")
+	require.Error(t, err)
+
+	out, err = run("export", address, "--passphrase=secret", fmt.Sprintf("--%s=json", cli.OutputFlag))
+	require.NoError(t, err)
+	var exported cmd.EthereumKeyOutput
+	require.NoError(t, json.Unmarshal([]byte(out), &exported))
+	require.Equal(t, hexutil.Encode(crypto.FromECDSA(privateKey)), exported.PrivateKey)
+
+	out, err = run("show", address, "--passphrase=secret", fmt.Sprintf("--%s=json", cli.OutputFlag))
+	require.NoError(t, err)
+	var shown cmd.EthereumKeyOutput
+	require.NoError(t, json.Unmarshal([]byte(out), &shown))
+	require.Equal(t, address, shown.Address)
+	require.Empty(t, shown.PrivateKey)
+
+	_, err = run("delete", address, "--passphrase=secret", "--yes")
+	require.NoError(t, err)
+	out, err = run("list", fmt.Sprintf("--%s=json", cli.OutputFlag))
+	require.NoError(t, err)
+	require.Equal(t, "[]", out)
+}
@@ -20,6 +20,7 @@ import (
 	tmos "github.com/tendermint/tendermint/libs/os"
 	tmtypes "github.com/tendermint/tendermint/types"
 
+	gravitycli "github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/client/cli"
 	gravitytypes "github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -51,11 +52,13 @@ func GenTxCmd(mbm module.BasicManager, txEncCfg client.TxEncodingConfig, genBalI
 	cmd := &cobra.Command{
 		Use:   "gentx [key_name] [amount] [eth-address] [orchestrator-address]",
 		Short: "Generate a genesis tx carrying a self delegation, oracle key delegation and orchestrator key delegation",
-		Args:  cobra.ExactArgs(4),
+		Args:  cobra.RangeArgs(3, 4),
 		Long: fmt.Sprintf(`Generate a genesis transaction that creates a validator with a self-delegation, oracle key 
 delegation and orchestrator key delegation that is signed by the key in the Keyring referenced by a given name. A node 
 ID and Bech32 consensus pubkey may optionally be provided. If they are omitted, they will be retrieved from the 
-priv_validator.json file. The following default parameters are included:
+priv_validator.json file. The ethereum key is read from the keystore managed by 'eth_keys', which signs the key
+delegation, its address may be omitted when the keystore holds a single key. An address which is not in the keystore
+is delegated to without a signature. The following default parameters are included:
     %s
 
 Example:
@@ -67,7 +70,9 @@ $ %s gentx my-key-name 1000000stake 0x033030FEeBd93E3178487c35A9c8cA80874353C9 c
     --details="..." \
     --security-contact="..." \
     --website="..."
-`, defaultsDesc, version.AppName,
+
+$ %s gentx my-key-name 1000000stake cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn --eth-passphrase="..." --chain-id=test-chain-1
+`, defaultsDesc, version.AppName, version.AppName,
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			serverCtx := server.GetServerContextFromCmd(cmd)
@@ -135,15 +140,23 @@ $ %s gentx my-key-name 1000000stake 0x033030FEeBd93E3178487c35A9c8cA80874353C9 c
 				return errors.Wrapf(err, "failed to fetch '%s' from the keyring", name)
 			}
 
-			ethAddress := args[2]
+			ethAddress, orchArg := "", args[2]
+			if len(args) > 3 {
+				ethAddress, orchArg = args[2], args[3]
+				if err := gravitytypes.ValidateEthAddress(ethAddress); err != nil {
+					return errors.Wrapf(err, "invalid ethereum address")
+				}
+			}
 
-			if err := gravitytypes.ValidateEthAddress(ethAddress); err != nil {
-				return errors.Wrapf(err, "invalid ethereum address")
+			orchAddress, err := sdk.AccAddressFromBech32(orchArg)
+			if err != nil {
+				return errors.Wrapf(err, "failed to parse orchAddress(%s)", orchArg)
 			}
 
-			orchAddress, err := sdk.AccAddressFromBech32(args[3])
+			valAddress := sdk.ValAddress(key.GetAddress()).String()
+			ethAddress, ethSignature, err := gravitycli.DelegateKeysFromKeyStore(cmd, clientCtx.KeyringDir, ethAddress, valAddress, orchAddress.String())
 			if err != nil {
-				return errors.Wrapf(err, "failed to parse orchAddress(%s)", args[3])
+				return errors.Wrap(err, "failed to sign the key delegation")
 			}
 
 			moniker := config.Moniker
@@ -198,9 +211,10 @@ $ %s gentx my-key-name 1000000stake 0x033030FEeBd93E3178487c35A9c8cA80874353C9 c
 			}
 
 			delegateKeySetMsg := &gravitytypes.MsgSetOrchestratorAddress{
-				Validator:    sdk.ValAddress(key.GetAddress()).String(),
+				Validator:    valAddress,
 				Orchestrator: orchAddress.String(),
 				EthAddress:   ethAddress,
+				EthSignature: ethSignature,
 			}
 
 			msgs := []sdk.Msg{msg, delegateKeySetMsg}
@@ -258,6 +272,7 @@ $ %s gentx my-key-name 1000000stake 0x033030FEeBd93E3178487c35A9c8cA80874353C9 c
 	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
 	cmd.Flags().String(flags.FlagOutputDocument, "", "Write the genesis transaction JSON document to the given file instead of the default location")
 	cmd.Flags().String(flags.FlagChainID, "", "The network chain ID")
+	cmd.Flags().String(gravitycli.FlagEthPassphrase, "default", "Passphrase of the ethereum key in the keystore")
 	cmd.Flags().AddFlagSet(fsCreateValidator)
 	flags.AddTxFlagsToCmd(cmd)
 
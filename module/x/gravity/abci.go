@@ -21,12 +21,17 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 	evmChains := k.GetEvmChains(ctx)
 
 	for _, evmChain := range evmChains {
-		// slashing(ctx, k, params, evmChain.EvmChainPrefix)
+		slashing(ctx, k, params, evmChain.EvmChainPrefix)
 		attestationTally(ctx, k, evmChain.EvmChainPrefix)
+		releaseHeldSendToCosmos(ctx, k, evmChain.EvmChainPrefix)
+		processIbcAutoForwards(ctx, k, params, evmChain.EvmChainPrefix)
 		cleanupTimedOutBatches(ctx, k, evmChain.EvmChainPrefix)
 		cleanupTimedOutLogicCalls(ctx, k, evmChain.EvmChainPrefix)
-		createValsets(ctx, k, evmChain.EvmChainPrefix)
+		createAutoBatches(ctx, k, evmChain.EvmChainPrefix)
+		createValsets(ctx, k, params, evmChain.EvmChainPrefix)
 		pruning(ctx, k, params, evmChain.EvmChainPrefix)
+		// must come last, the chain may be removed
+		decommissionEvmChain(ctx, k, evmChain.EvmChainPrefix)
 		// pruneValsets(ctx, k, params, evmChain.EvmChainPrefix)
 		// pruneAttestations(ctx, k, evmChain.EvmChainPrefix)
 	}
@@ -52,48 +57,109 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 // 	}
 // }
 
-func createValsets(ctx sdk.Context, k keeper.Keeper, evmChainPrefix string) {
+// releaseHeldSendToCosmos delivers the deposits held back by inbound rate limits once their window has capacity again,
+// up to MaxHeldSendToCosmosReleasesPerBlock of them
+func releaseHeldSendToCosmos(ctx sdk.Context, k keeper.Keeper, evmChainPrefix string) {
+	k.ReleaseHeldSendToCosmosWithinLimits(ctx, evmChainPrefix, types.MaxHeldSendToCosmosReleasesPerBlock)
+}
+
+// createAutoBatches builds the batches of the tokens whose auto batch threshold is met, after the timed out batches
+// returned their txs to the pool
+func createAutoBatches(ctx sdk.Context, k keeper.Keeper, evmChainPrefix string) {
+	k.CreateAutoBatches(ctx, evmChainPrefix)
+}
+
+// processIbcAutoForwards drains up to IbcAutoForwardsPerBlock pending IBC Auto-Forwards of chains which opted in,
+// the outcome of each is recorded in the IbcAutoForwardLog since events emitted here are not observable
+func processIbcAutoForwards(ctx sdk.Context, k keeper.Keeper, params types.Params, evmChainPrefix string) {
+	evmChainParam := params.GetEvmChain(evmChainPrefix)
+	if evmChainParam == nil || evmChainParam.IbcAutoForwardsPerBlock == 0 {
+		return
+	}
+	// process in a cache context so that a failure leaves the queue untouched for the next attempt
+	xCtx, commit := ctx.CacheContext()
+	if err := k.ProcessPendingIbcAutoForwards(xCtx, evmChainPrefix, evmChainParam.IbcAutoForwardsPerBlock); err != nil {
+		ctx.Logger().Error("Unable to process Pending IBC Auto-Forwards", "evmChainPrefix", evmChainPrefix, "cause", err.Error())
+		return
+	}
+	commit()
+	ctx.EventManager().EmitEvents(xCtx.EventManager().Events())
+}
+
+// decommissionEvmChain drains an evm chain being removed by governance, removing it once its deadline has passed and
+// the gravity module escrows nothing more for it
+func decommissionEvmChain(ctx sdk.Context, k keeper.Keeper, evmChainPrefix string) {
+	k.ProcessEvmChainDecommission(ctx, evmChainPrefix)
+}
+
+func createValsets(ctx sdk.Context, k keeper.Keeper, params types.Params, evmChainPrefix string) {
 	// Auto ValsetRequest Creation.
 	// WARNING: do not use k.GetLastObservedValset in this function, it *will* result in losing control of the bridge
 	// 1. If there are no valset requests, create a new one.
 	// 2. If there is at least one validator who started unbonding in current block. (we persist last unbonded block height in hooks.go)
 	// This will make sure the unbonding validator has to provide an attestation to a new Valset
 	// that excludes him before he completely Unbonds.  Otherwise he will be slashed
-	// 3. If power change between validators of CurrentValset and latest valset request is > 5%
+	// 3. If the chain refreshes its valset on delegate key changes and a validator set its keys in the current block
+	// 4. If the latest valset request is older than the chain's maximum valset interval
+	// 5. If power change between validators of CurrentValset and latest valset request is greater than the chain's
+	// power diff threshold (5% by default), unless the latest valset request is younger than the minimum valset interval
+	// nolint: exhaustruct
+	evmChainParam := types.EvmChainParam{}
+	if p := params.GetEvmChain(evmChainPrefix); p != nil {
+		evmChainParam = *p
+	}
+	height := uint64(ctx.BlockHeight())
 
 	// get the last valsets to compare against
 	latestValset := k.GetLatestValset(ctx, evmChainPrefix)
-	lastUnbondingHeight := k.GetLastUnBondingBlockHeight(ctx)
+	if latestValset == nil {
+		k.SetValsetRequest(ctx, evmChainPrefix, types.VALSET_REASON_FIRST)
+		return
+	}
+	if k.GetLastUnBondingBlockHeight(ctx) == height {
+		k.SetValsetRequest(ctx, evmChainPrefix, types.VALSET_REASON_UNBONDING)
+		return
+	}
+	if evmChainParam.ValsetRefreshOnDelegateKeyChange && k.GetLastDelegateKeyChangeBlockHeight(ctx) == height {
+		k.SetValsetRequest(ctx, evmChainPrefix, types.VALSET_REASON_DELEGATE_KEY_CHANGE)
+		return
+	}
+	var sinceLatest uint64
+	if height > latestValset.Height {
+		sinceLatest = height - latestValset.Height
+	}
+	if evmChainParam.MaxValsetInterval != 0 && sinceLatest >= evmChainParam.MaxValsetInterval {
+		k.SetValsetRequest(ctx, evmChainPrefix, types.VALSET_REASON_MAX_INTERVAL)
+		return
+	}
+	if sinceLatest < evmChainParam.MinValsetInterval {
+		return
+	}
 
-	significantPowerDiff := false
-	if latestValset != nil {
-		vs, err := k.GetCurrentValset(ctx, evmChainPrefix)
-		if err != nil {
-			// this condition should only occur in the simulator
-			// ref : https://github.com/Gravity-Bridge/Gravity-Bridge/issues/35
-			if err == types.ErrNoValidators {
-				ctx.Logger().Error("no bonded validators",
-					"cause", err.Error(),
-				)
-				return
-			}
-			panic(err)
-		}
-		intCurrMembers, err := types.BridgeValidators(vs.Members).ToInternal()
-		if err != nil {
-			panic(sdkerrors.Wrap(err, "invalid current valset members"))
+	vs, err := k.GetCurrentValset(ctx, evmChainPrefix)
+	if err != nil {
+		// this condition should only occur in the simulator
+		// ref : https://github.com/Gravity-Bridge/Gravity-Bridge/issues/35
+		if err == types.ErrNoValidators {
+			ctx.Logger().Error("no bonded validators",
+				"cause", err.Error(),
+			)
+			return
 		}
-		intLatestMembers, err := types.BridgeValidators(latestValset.Members).ToInternal()
-		if err != nil {
-			panic(sdkerrors.Wrap(err, "invalid latest valset members"))
-		}
-
-		significantPowerDiff = intCurrMembers.PowerDiff(*intLatestMembers).GT(sdk.NewDecWithPrec(5, 2))
+		panic(err)
+	}
+	intCurrMembers, err := types.BridgeValidators(vs.Members).ToInternal()
+	if err != nil {
+		panic(sdkerrors.Wrap(err, "invalid current valset members"))
+	}
+	intLatestMembers, err := types.BridgeValidators(latestValset.Members).ToInternal()
+	if err != nil {
+		panic(sdkerrors.Wrap(err, "invalid latest valset members"))
 	}
 
-	if (latestValset == nil) || (lastUnbondingHeight == uint64(ctx.BlockHeight())) || significantPowerDiff {
-		// if the conditions are true, put in a new validator set request to be signed and submitted to evm chain
-		k.SetValsetRequest(ctx, evmChainPrefix)
+	if intCurrMembers.PowerDiff(*intLatestMembers).GT(evmChainParam.EffectiveValsetPowerDiffThreshold()) {
+		// put in a new validator set request to be signed and submitted to evm chain
+		k.SetValsetRequest(ctx, evmChainPrefix, types.VALSET_REASON_POWER_DIFF)
 	}
 }
 
@@ -105,11 +171,16 @@ func pruneValsets(ctx sdk.Context, k keeper.Keeper, params types.Params, evmChai
 	// last observed nonce, they can't be submitted any longer
 	// Only prune valsets after the signed valsets window has passed
 	// so that slashing can occur the block before we remove them
+	evmChainParam := params.GetEvmChain(evmChainPrefix)
+	if evmChainParam == nil {
+		return
+	}
+	signedValsetsWindow := params.EffectiveSlashingParams(*evmChainParam).SignedValsetsWindow
 	lastObserved := k.GetLastObservedValset(ctx, evmChainPrefix)
 	currentBlock := uint64(ctx.BlockHeight())
-	tooEarly := currentBlock < params.SignedValsetsWindow
+	tooEarly := currentBlock < signedValsetsWindow
 	if lastObserved != nil && !tooEarly {
-		earliestToPrune := currentBlock - params.GetSignedValsetsWindow()
+		earliestToPrune := currentBlock - signedValsetsWindow
 		sets := k.GetValsets(ctx, evmChainPrefix)
 		for _, set := range sets {
 			if set.Nonce < lastObserved.Nonce && set.Height < earliestToPrune {
@@ -120,11 +191,21 @@ func pruneValsets(ctx sdk.Context, k keeper.Keeper, params types.Params, evmChai
 	}
 }
 
+// slashing punishes validators who have not confirmed valsets, batches or logic calls for the given evm chain, using
+// the slashing windows and fractions of that chain. Validators are only slashed and jailed while the chain's bridge
+// is active and its slashing is not disabled, otherwise the unsigned objects are just marked as handled so that a
+// paused or flaky chain can never put the validators of every other chain at risk
 func slashing(ctx sdk.Context, k keeper.Keeper, params types.Params, evmChainPrefix string) {
+	evmChainParam := params.GetEvmChain(evmChainPrefix)
+	if evmChainParam == nil {
+		return
+	}
+	chainParams := params.EffectiveSlashingParams(*evmChainParam)
+
 	// Slash validator for not confirming valset requests, batch requests, logic call requests
-	valsetSlashing(ctx, k, params, evmChainPrefix)
-	batchSlashing(ctx, k, params, evmChainPrefix)
-	logicCallSlashing(ctx, k, params, evmChainPrefix)
+	valsetSlashing(ctx, k, chainParams)
+	batchSlashing(ctx, k, chainParams)
+	logicCallSlashing(ctx, k, chainParams)
 }
 
 // Iterate over all attestations currently being voted on in order of nonce and
@@ -145,6 +226,8 @@ func attestationTally(ctx sdk.Context, k keeper.Keeper, evmChainPrefix string) {
 	}
 
 	attmap, keys := k.GetAttestationMapping(ctx, evmChainPrefix)
+	// computed at most once per block, on the first attestation tallied
+	var requiredPower *sdk.Int
 
 	// This iterates over all keys (event nonces) in the attestation mapping. Each value contains
 	// a slice with one or more attestations at that event nonce. There can be multiple attestations
@@ -171,7 +254,11 @@ func attestationTally(ctx sdk.Context, k keeper.Keeper, evmChainPrefix string) {
 			// If no attestation becomes observed, when we get to the next nonce, every attestation in
 			// it will be skipped. The same will happen for every nonce after that.
 			if nonce == uint64(k.GetLastObservedEventNonce(ctx, evmChainPrefix))+1 {
-				k.TryAttestation(ctx, &att)
+				if requiredPower == nil {
+					power := k.AttestationRequiredPower(ctx, evmChainPrefix)
+					requiredPower = &power
+				}
+				k.TryAttestation(ctx, &att, *requiredPower)
 			}
 		}
 	}
@@ -195,6 +282,8 @@ func cleanupTimedOutBatches(ctx sdk.Context, k keeper.Keeper, evmChainPrefix str
 			if err != nil {
 				panic("Failed to cancel outgoing txbatch!")
 			}
+			// transfers which arrived over IBC are returned to their counterparty sender
+			k.RefundIbcOriginatedTxs(ctx, evmChainPrefix, batch.Transactions)
 		}
 	}
 }
@@ -245,12 +334,22 @@ func prepValsetConfirms(ctx sdk.Context, k keeper.Keeper, evmChainPrefix string,
 }
 
 // valsetSlashing slashes validators who have not signed validator sets during the signing window
-func valsetSlashing(ctx sdk.Context, k keeper.Keeper, params types.Params, evmChainPrefix string) {
+func valsetSlashing(ctx sdk.Context, k keeper.Keeper, chainParams types.EvmChainParam) {
+	evmChainPrefix := chainParams.EvmChainPrefix
 	// don't slash in the beginning before there aren't even SignedValsetsWindow blocks yet
-	if uint64(ctx.BlockHeight()) <= params.SignedValsetsWindow {
+	if uint64(ctx.BlockHeight()) <= chainParams.SignedValsetsWindow {
+		return
+	}
+	unslashedValsets := k.GetUnSlashedValsets(ctx, evmChainPrefix, chainParams.SignedValsetsWindow)
+	if !chainParams.SlashingActive() {
+		// nobody is expected to sign for a paused bridge, nor slashed for a chain which opted out, mark these valsets as handled without slashing
+		for _, vs := range unslashedValsets {
+			if vs.Nonce > k.GetLastSlashedValsetNonce(ctx, evmChainPrefix) {
+				k.SetLastSlashedValsetNonce(ctx, evmChainPrefix, vs.Nonce)
+			}
+		}
 		return
 	}
-	unslashedValsets := k.GetUnSlashedValsets(ctx, evmChainPrefix, params.SignedValsetsWindow)
 
 	currentBondedSet := k.StakingKeeper.GetBondedValidatorsByPower(ctx)
 	unbondingValidators := getUnbondingValidators(ctx, k)
@@ -278,7 +377,7 @@ func valsetSlashing(ctx sdk.Context, k keeper.Keeper, params types.Params, evmCh
 					// refresh validator before slashing/jailing
 					val = updateValidator(ctx, k, val.GetOperator())
 					if !val.IsJailed() {
-						k.StakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), val.ConsensusPower(sdk.DefaultPowerReduction), params.SlashFractionValset, 0)
+						k.StakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), val.ConsensusPower(sdk.DefaultPowerReduction), chainParams.SlashFractionValset, 0)
 						if err := ctx.EventManager().EmitTypedEvent(
 							&types.EventSignatureSlashing{
 								Type:    types.AttributeKeyValsetSignatureSlashing,
@@ -314,7 +413,7 @@ func valsetSlashing(ctx sdk.Context, k keeper.Keeper, params types.Params, evmCh
 
 			// Only slash validators who joined after valset is created and they are unbonding and UNBOND_SLASHING_WINDOW hasn't passed
 			startedBeforeValsetCreated := valSigningInfo.StartHeight < int64(vs.Height)
-			unbondingPeriodEndsAfterSlashingPeriod := vs.Height < uint64(validator.UnbondingHeight)+params.UnbondSlashingValsetsWindow
+			unbondingPeriodEndsAfterSlashingPeriod := vs.Height < uint64(validator.UnbondingHeight)+chainParams.UnbondSlashingValsetsWindow
 
 			if exist && startedBeforeValsetCreated && validator.IsUnbonding() && unbondingPeriodEndsAfterSlashingPeriod {
 				// Check if validator has confirmed valset or not
@@ -325,7 +424,7 @@ func valsetSlashing(ctx sdk.Context, k keeper.Keeper, params types.Params, evmCh
 					// refresh validator before slashing/jailing
 					validator = updateValidator(ctx, k, validator.GetOperator())
 					if !validator.IsJailed() {
-						k.StakingKeeper.Slash(ctx, valConsAddr, ctx.BlockHeight(), validator.ConsensusPower(sdk.DefaultPowerReduction), params.SlashFractionValset, 0)
+						k.StakingKeeper.Slash(ctx, valConsAddr, ctx.BlockHeight(), validator.ConsensusPower(sdk.DefaultPowerReduction), chainParams.SlashFractionValset, 0)
 						if err := ctx.EventManager().EmitTypedEvent(
 							&types.EventSignatureSlashing{
 								Type:    types.AttributeKeyValsetSignatureSlashing,
@@ -399,21 +498,32 @@ func prepBatchConfirms(ctx sdk.Context, k keeper.Keeper, evmChainPrefix string,
 // batchSlashing slashes currently bonded validators who have not submitted batch
 // signatures. This is distinct from validator sets, which includes unbonding validators
 // because validator set updates must succeed as validators leave the set, batches will just be re-created
-func batchSlashing(ctx sdk.Context, k keeper.Keeper, params types.Params, evmChainPrefix string) {
+func batchSlashing(ctx sdk.Context, k keeper.Keeper, chainParams types.EvmChainParam) {
+	evmChainPrefix := chainParams.EvmChainPrefix
 	// We look through the full bonded set (the active set)
 	// and we slash users who haven't signed a batch confirmation that is >15hrs in blocks old
 	var maxHeight uint64
 
 	// don't slash in the beginning before there aren't even SignedBatchesWindow blocks yet
-	if uint64(ctx.BlockHeight()) > params.SignedBatchesWindow {
-		maxHeight = uint64(ctx.BlockHeight()) - params.SignedBatchesWindow
+	if uint64(ctx.BlockHeight()) > chainParams.SignedBatchesWindow {
+		maxHeight = uint64(ctx.BlockHeight()) - chainParams.SignedBatchesWindow
 	} else {
 		// we can't slash anyone if this window has not yet passed
 		return
 	}
 
-	currentBondedSet := k.StakingKeeper.GetBondedValidatorsByPower(ctx)
 	unslashedBatches := k.GetUnSlashedBatches(ctx, evmChainPrefix, maxHeight)
+	if !chainParams.SlashingActive() {
+		// nobody is expected to sign for a paused bridge, nor slashed for a chain which opted out, mark these batches as handled without slashing
+		for _, batch := range unslashedBatches {
+			if batch.CosmosBlockCreated > k.GetLastSlashedBatchBlock(ctx, evmChainPrefix) {
+				k.SetLastSlashedBatchBlock(ctx, evmChainPrefix, batch.CosmosBlockCreated)
+			}
+		}
+		return
+	}
+
+	currentBondedSet := k.StakingKeeper.GetBondedValidatorsByPower(ctx)
 	for _, batch := range unslashedBatches {
 		// SLASH BONDED VALIDTORS who didn't attest batch requests
 		confirms := prepBatchConfirms(ctx, k, evmChainPrefix, batch)
@@ -434,7 +544,7 @@ func batchSlashing(ctx sdk.Context, k keeper.Keeper, params types.Params, evmCha
 					// refresh validator before slashing/jailing
 					val = updateValidator(ctx, k, val.GetOperator())
 					if !val.IsJailed() {
-						k.StakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), val.ConsensusPower(sdk.DefaultPowerReduction), params.SlashFractionBatch, 0)
+						k.StakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), val.ConsensusPower(sdk.DefaultPowerReduction), chainParams.SlashFractionBatch, 0)
 						if err := ctx.EventManager().EmitTypedEvent(
 							&types.EventSignatureSlashing{
 								Type:    types.AttributeKeyBatchSignatureSlashing,
@@ -479,21 +589,32 @@ func prepLogicCallConfirms(ctx sdk.Context, k keeper.Keeper, evmChainPrefix stri
 // logicCallSlashing slashes currently bonded validators who have not submitted logicCall
 // signatures. This is distinct from validator sets, which includes unbonding validators
 // because validator set updates must succeed as validators leave the set, logicCalls will just be re-created
-func logicCallSlashing(ctx sdk.Context, k keeper.Keeper, params types.Params, evmChainPrefix string) {
+func logicCallSlashing(ctx sdk.Context, k keeper.Keeper, chainParams types.EvmChainParam) {
+	evmChainPrefix := chainParams.EvmChainPrefix
 	// We look through the full bonded set (the active set)
 	// and we slash users who haven't signed a batch confirmation that is >15hrs in blocks old
 	var maxHeight uint64
 
 	// don't slash in the beginning before there aren't even SignedBatchesWindow blocks yet
-	if uint64(ctx.BlockHeight()) > params.SignedLogicCallsWindow {
-		maxHeight = uint64(ctx.BlockHeight()) - params.SignedLogicCallsWindow
+	if uint64(ctx.BlockHeight()) > chainParams.SignedLogicCallsWindow {
+		maxHeight = uint64(ctx.BlockHeight()) - chainParams.SignedLogicCallsWindow
 	} else {
 		// we can't slash anyone if this window has not yet passed
 		return
 	}
 
-	currentBondedSet := k.StakingKeeper.GetBondedValidatorsByPower(ctx)
 	unslashedLogicCalls := k.GetUnSlashedLogicCalls(ctx, evmChainPrefix, maxHeight)
+	if !chainParams.SlashingActive() {
+		// nobody is expected to sign for a paused bridge, nor slashed for a chain which opted out, mark these logic calls as handled without slashing
+		for _, call := range unslashedLogicCalls {
+			if call.CosmosBlockCreated > k.GetLastSlashedLogicCallBlock(ctx, evmChainPrefix) {
+				k.SetLastSlashedLogicCallBlock(ctx, evmChainPrefix, call.CosmosBlockCreated)
+			}
+		}
+		return
+	}
+
+	currentBondedSet := k.StakingKeeper.GetBondedValidatorsByPower(ctx)
 	for _, call := range unslashedLogicCalls {
 
 		// SLASH BONDED VALIDTORS who didn't attest batch requests
@@ -513,7 +634,7 @@ func logicCallSlashing(ctx sdk.Context, k keeper.Keeper, params types.Params, ev
 					// refresh validator before slashing/jailing
 					val = updateValidator(ctx, k, val.GetOperator())
 					if !val.IsJailed() {
-						k.StakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), val.ConsensusPower(sdk.DefaultPowerReduction), params.SlashFractionLogicCall, 0)
+						k.StakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), val.ConsensusPower(sdk.DefaultPowerReduction), chainParams.SlashFractionLogicCall, 0)
 						if err := ctx.EventManager().EmitTypedEvent(
 							&types.EventSignatureSlashing{
 								Type:    types.AttributeKeyLogicCallSignatureSlashing,
@@ -537,6 +658,31 @@ func pruning(ctx sdk.Context, k keeper.Keeper, params types.Params, evmChainPref
 	pruneValsets(ctx, k, params, evmChainPrefix)
 	pruneAttestations(ctx, k, evmChainPrefix, EventsToKeep)
 	pruneBridgeBalanceSnapshots(ctx, k, evmChainPrefix, EventsToKeep)
+	pruneIbcAutoForwardLogs(ctx, k, evmChainPrefix, EventsToKeep)
+	pruneIbcAutoForwardTransfers(ctx, k, evmChainPrefix, EventsToKeep)
+	k.PruneExpiredBlacklistEntries(ctx, evmChainPrefix)
+}
+
+// pruneIbcAutoForwardLogs removes the logs of IBC Auto-Forwards whose event nonce is more than logsToKeep behind
+// the last observed event nonce
+func pruneIbcAutoForwardLogs(ctx sdk.Context, k keeper.Keeper, evmChainPrefix string, logsToKeep uint64) {
+	lastNonce := k.GetLastObservedEventNonce(ctx, evmChainPrefix)
+	if lastNonce <= logsToKeep {
+		return
+	}
+	cutoff := lastNonce - logsToKeep
+
+	var toDelete []uint64
+	k.IterateIbcAutoForwardLogs(ctx, evmChainPrefix, false, func(log types.IbcAutoForwardLog) (stop bool) {
+		if log.EventNonce > cutoff {
+			return true
+		}
+		toDelete = append(toDelete, log.EventNonce)
+		return false
+	})
+	for _, nonce := range toDelete {
+		k.DeleteIbcAutoForwardLog(ctx, evmChainPrefix, nonce)
+	}
 }
 
 // Iterate over all attestations currently being voted on in order of nonce
@@ -575,8 +721,8 @@ func pruneAttestations(ctx sdk.Context, k keeper.Keeper, evmChainPrefix string,
 	}
 }
 
-// pruneBridgeBalanceSnapshots will iterate over all BridgeBalanceSnapshots currently in the
-// store and prune those that are older than the current nonce, retaining a minimum of `eventsToKeep`
+// pruneBridgeBalanceSnapshots will iterate over the given evm chain's BridgeBalanceSnapshots currently in the
+// store and prune those that are older than the chain's current nonce, retaining a minimum of `eventsToKeep`
 func pruneBridgeBalanceSnapshots(ctx sdk.Context, k keeper.Keeper, evmChainPrefix string, snapshotsToKeep uint64) {
 	lastNonce := uint64(k.GetLastObservedEventNonce(ctx, evmChainPrefix))
 	var cutoff uint64
@@ -588,10 +734,11 @@ func pruneBridgeBalanceSnapshots(ctx sdk.Context, k keeper.Keeper, evmChainPrefi
 
 	k.IterateBridgeBalanceSnapshots(
 		ctx,
+		evmChainPrefix,
 		false,
 		func(key []byte, snapshot types.BridgeBalanceSnapshot) (stop bool) {
 			if snapshot.EventNonce <= cutoff {
-				if err := k.DeleteBridgeBalanceSnapshot(ctx, snapshot.EventNonce, snapshot.EvmChainPrefix); err != nil {
+				if err := k.DeleteBridgeBalanceSnapshot(ctx, snapshot.EventNonce, evmChainPrefix); err != nil {
 					errMsg := fmt.Sprintf("Discovered nonexistent snapshot with nonce %v while iterating: %v", snapshot.EventNonce, snapshot)
 					ctx.Logger().Error(errMsg)
 					panic(errMsg)
@@ -604,3 +751,27 @@ func pruneBridgeBalanceSnapshots(ctx sdk.Context, k keeper.Keeper, evmChainPrefi
 		},
 	)
 }
+
+// pruneIbcAutoForwardTransfers removes the completed ibc transfers of IBC Auto-Forwards whose event nonce is more than
+// transfersToKeep behind the last observed event nonce, transfers still in flight are kept until they complete
+func pruneIbcAutoForwardTransfers(ctx sdk.Context, k keeper.Keeper, evmChainPrefix string, transfersToKeep uint64) {
+	lastNonce := k.GetLastObservedEventNonce(ctx, evmChainPrefix)
+	if lastNonce <= transfersToKeep {
+		return
+	}
+	cutoff := lastNonce - transfersToKeep
+
+	var toDelete []types.IbcAutoForwardTransfer
+	k.IterateIbcAutoForwardTransfers(ctx, evmChainPrefix, func(transfer types.IbcAutoForwardTransfer) (stop bool) {
+		if transfer.EventNonce > cutoff {
+			return true
+		}
+		if transfer.IsCompleted() {
+			toDelete = append(toDelete, transfer)
+		}
+		return false
+	})
+	for _, transfer := range toDelete {
+		k.DeleteIbcAutoForwardTransfer(ctx, transfer)
+	}
+}
@@ -48,7 +48,7 @@ func TestValsetCreationUponUnbonding(t *testing.T) {
 	evmChain := pk.GetEvmChainData(ctx, keeper.EthChainPrefix)
 
 	currentValsetNonce := pk.GetLatestValsetNonce(ctx, evmChain.EvmChainPrefix)
-	pk.SetValsetRequest(ctx, evmChain.EvmChainPrefix)
+	pk.SetValsetRequest(ctx, evmChain.EvmChainPrefix, types.VALSET_REASON_UNSPECIFIED)
 
 	input.Context = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
 	// begin unbonding
@@ -65,6 +65,52 @@ func TestValsetCreationUponUnbonding(t *testing.T) {
 	assert.NotEqual(t, currentValsetNonce, pk.GetLatestValsetNonce(ctx, evmChain.EvmChainPrefix))
 }
 
+func TestValsetCreationTriggers(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+	pk := input.GravityKeeper
+
+	params := pk.GetParams(ctx)
+	evmChainParam := params.GetEvmChain(keeper.EthChainPrefix)
+	evmChainParam.MinValsetInterval = 5
+	evmChainParam.MaxValsetInterval = 10
+	evmChainParam.ValsetRefreshOnDelegateKeyChange = true
+	pk.SetParams(ctx, params)
+	params = pk.GetParams(ctx)
+
+	createValsets(ctx, pk, params, keeper.EthChainPrefix)
+	createValsets(ctx, pk, params, keeper.BscChainPrefix)
+	require.Equal(t, types.VALSET_REASON_FIRST, pk.GetLatestValset(ctx, keeper.EthChainPrefix).Reason)
+	firstNonce := pk.GetLatestValsetNonce(ctx, keeper.EthChainPrefix)
+
+	// nothing changed
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	createValsets(ctx, pk, params, keeper.EthChainPrefix)
+	require.Equal(t, firstNonce, pk.GetLatestValsetNonce(ctx, keeper.EthChainPrefix))
+
+	// a delegate key change refreshes the valset of the chains which opted in only
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	pk.SetLastDelegateKeyChangeBlockHeight(ctx, uint64(ctx.BlockHeight()))
+	bscNonce := pk.GetLatestValsetNonce(ctx, keeper.BscChainPrefix)
+	createValsets(ctx, pk, params, keeper.EthChainPrefix)
+	createValsets(ctx, pk, params, keeper.BscChainPrefix)
+	latest := pk.GetLatestValset(ctx, keeper.EthChainPrefix)
+	require.Equal(t, types.VALSET_REASON_DELEGATE_KEY_CHANGE, latest.Reason)
+	require.Equal(t, bscNonce, pk.GetLatestValsetNonce(ctx, keeper.BscChainPrefix))
+
+	// the valset is refreshed once the max interval has passed
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 9)
+	createValsets(ctx, pk, params, keeper.EthChainPrefix)
+	require.Equal(t, latest.Nonce, pk.GetLatestValsetNonce(ctx, keeper.EthChainPrefix))
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	createValsets(ctx, pk, params, keeper.EthChainPrefix)
+	require.Equal(t, types.VALSET_REASON_MAX_INTERVAL, pk.GetLatestValset(ctx, keeper.EthChainPrefix).Reason)
+
+	evmChainParam = params.GetEvmChain(keeper.EthChainPrefix)
+	evmChainParam.MinValsetInterval = 11
+	require.Error(t, evmChainParam.ValidateBasic())
+}
+
 func TestValsetSlashing_ValsetCreated_Before_ValidatorBonded(t *testing.T) {
 	// Don't slash validators if valset is created before he is bonded.
 
@@ -125,9 +171,8 @@ func TestValsetSlashing_ValsetCreated_After_ValidatorBonded(t *testing.T) {
 	EndBlocker(ctx, pk)
 
 	// ensure that the  validator who is bonded before valset is created is slashed
-	// now validator will not be slashed, unless all evm chains are not updated
 	val := input.StakingKeeper.Validator(ctx, keeper.ValAddrs[0])
-	require.False(t, val.IsJailed())
+	require.True(t, val.IsJailed())
 
 	// ensure that the  validator who attested the valset is not slashed.
 	val = input.StakingKeeper.Validator(ctx, keeper.ValAddrs[1])
@@ -250,7 +295,7 @@ func TestValsetSlashing_UnbondingValidator_UnbondWindow_NotExpired(t *testing.T)
 
 	// Create Valset request
 	ctx = ctx.WithBlockHeight(valsetRequestHeight)
-	vs := pk.SetValsetRequest(ctx, evmChain.EvmChainPrefix)
+	vs := pk.SetValsetRequest(ctx, evmChain.EvmChainPrefix, types.VALSET_REASON_UNSPECIFIED)
 
 	// Start Unbonding validators
 	// Validator-1  Unbond slash window is not expired. if not attested, slash
@@ -454,7 +499,7 @@ func TestBatchSlashing(t *testing.T) {
 
 	// ensure that the  validator is jailed and slashed
 	val := input.StakingKeeper.Validator(ctx, keeper.ValAddrs[0])
-	require.False(t, val.IsJailed())
+	require.True(t, val.IsJailed())
 
 	// ensure that the 2nd  validator is not jailed and slashed
 	val2 := input.StakingKeeper.Validator(ctx, keeper.ValAddrs[1])
@@ -462,9 +507,90 @@ func TestBatchSlashing(t *testing.T) {
 
 	// Ensure that the last slashed valset nonce is set properly
 	lastSlashedBatchBlock := input.GravityKeeper.GetLastSlashedBatchBlock(ctx, evmChain.EvmChainPrefix)
-	assert.NotEqual(t, lastSlashedBatchBlock, batch.CosmosBlockCreated)
-	assert.True(t, len(pk.GetUnSlashedBatches(ctx, evmChain.EvmChainPrefix, uint64(ctx.BlockHeight()))) != 0)
+	assert.Equal(t, lastSlashedBatchBlock, batch.CosmosBlockCreated)
+	assert.True(t, len(pk.GetUnSlashedBatches(ctx, evmChain.EvmChainPrefix, uint64(ctx.BlockHeight()))) == 0)
+
+}
+
+func TestBatchSlashing_PausedBridge(t *testing.T) {
+	// Validators must not be slashed for a chain whose bridge is not active, or which opted out of slashing
+	for name, optOut := range map[string]func(*types.EvmChainParam){
+		"paused bridge":     func(p *types.EvmChainParam) { p.BridgeActive = false },
+		"slashing disabled": func(p *types.EvmChainParam) { p.SlashingDisabled = true },
+	} {
+		t.Run(name, func(t *testing.T) {
+			input, ctx := keeper.SetupFiveValChain(t)
+			defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+
+			pk := input.GravityKeeper
+			evmChain := pk.GetEvmChains(ctx)[0]
+			params := pk.GetParams(ctx)
+			optOut(params.GetEvmChain(evmChain.EvmChainPrefix))
+			pk.SetParams(ctx, params)
+
+			ctx = ctx.WithBlockHeight(ctx.BlockHeight() + int64(params.SignedValsetsWindow) + 2)
+			batch, err := types.NewInternalOutgingTxBatchFromExternalBatch(types.OutgoingTxBatch{
+				BatchNonce:         1,
+				BatchTimeout:       0,
+				Transactions:       []types.OutgoingTransferTx{},
+				TokenContract:      keeper.TokenContractAddrs[0],
+				CosmosBlockCreated: uint64(ctx.BlockHeight() - int64(params.SignedBatchesWindow+1)),
+			})
+			require.NoError(t, err)
+			pk.StoreBatch(ctx, evmChain.EvmChainPrefix, *batch)
+
+			EndBlocker(ctx, pk)
 
+			// nobody signed the batch, but nobody is jailed either
+			for _, valAddr := range keeper.ValAddrs {
+				require.False(t, input.StakingKeeper.Validator(ctx, valAddr).IsJailed())
+			}
+			// the batch is still considered handled, so re-enabling slashing will not slash for it
+			assert.Equal(t, batch.CosmosBlockCreated, pk.GetLastSlashedBatchBlock(ctx, evmChain.EvmChainPrefix))
+		})
+	}
+}
+
+func TestValsetSlashing_PerChainWindow(t *testing.T) {
+	// A chain specific signed valsets window overrides the module wide window
+	input, ctx := keeper.SetupFiveValChain(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+
+	pk := input.GravityKeeper
+	evmChain := pk.GetEvmChains(ctx)[0]
+	params := pk.GetParams(ctx)
+	chainWindow := params.SignedValsetsWindow * 2
+	params.GetEvmChain(evmChain.EvmChainPrefix).SignedValsetsWindow = chainWindow
+	pk.SetParams(ctx, params)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + int64(chainWindow) + 2)
+	vs, err := pk.GetCurrentValset(ctx, evmChain.EvmChainPrefix)
+	require.NoError(t, err)
+	// old enough to be slashed under the module wide window, but not under the chain's window
+	vs.Height = uint64(ctx.BlockHeight()) - (params.SignedValsetsWindow + 1)
+	vs.Nonce = pk.GetLatestValsetNonce(ctx, evmChain.EvmChainPrefix) + 1
+	pk.StoreValset(ctx, evmChain.EvmChainPrefix, vs)
+	pk.SetLatestValsetNonce(ctx, evmChain.EvmChainPrefix, vs.Nonce)
+
+	for i, orch := range keeper.OrchAddrs {
+		if i == 0 {
+			// don't sign with first validator
+			continue
+		}
+		ethAddr, err := types.NewEthAddress(keeper.EthAddrs[i].String())
+		require.NoError(t, err)
+		pk.SetValsetConfirm(ctx, *types.NewMsgValsetConfirm(evmChain.EvmChainPrefix, vs.Nonce, *ethAddr, orch, "dummysig"))
+	}
+
+	EndBlocker(ctx, pk)
+	require.False(t, input.StakingKeeper.Validator(ctx, keeper.ValAddrs[0]).IsJailed())
+
+	// once the chain's window has passed the validators who did not sign are jailed
+	ctx = ctx.WithBlockHeight(int64(vs.Height + chainWindow))
+	EndBlocker(ctx, pk)
+	require.True(t, input.StakingKeeper.Validator(ctx, keeper.ValAddrs[0]).IsJailed())
+	require.False(t, input.StakingKeeper.Validator(ctx, keeper.ValAddrs[1]).IsJailed())
+	assert.Equal(t, vs.Nonce, pk.GetLastSlashedValsetNonce(ctx, evmChain.EvmChainPrefix))
 }
 
 func TestValsetEmission(t *testing.T) {
@@ -499,7 +625,7 @@ func TestValsetSetting(t *testing.T) {
 
 	pk := input.GravityKeeper
 	evmChain := pk.GetEvmChains(ctx)[0]
-	pk.SetValsetRequest(ctx, evmChain.EvmChainPrefix)
+	pk.SetValsetRequest(ctx, evmChain.EvmChainPrefix, types.VALSET_REASON_UNSPECIFIED)
 	valsets := pk.GetValsets(ctx, evmChain.EvmChainPrefix)
 	require.True(t, len(valsets) == 1)
 }
@@ -633,6 +759,144 @@ func TestBatchTimeout(t *testing.T) {
 	require.Equal(t, 0, len(secondBatchConfirms))
 }
 
+// tests that the EndBlocker creates the batches of tokens once their auto batch fee or age threshold is met
+func TestAutoBatchCreation(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+
+	pk := input.GravityKeeper
+	evmChainPrefix := keeper.EthChainPrefix
+	var (
+		mySender, e1        = sdk.AccAddressFromBech32("gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm")
+		receiver, e2        = types.NewEthAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		tokenContract, e3   = types.NewEthAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5") // Pickle
+		token, e4           = types.NewInternalERC20Token(sdk.NewInt(99999), tokenContract.GetAddress().Hex())
+		allVouchers         = sdk.NewCoins(token.GravityCoin(evmChainPrefix))
+		autoBatchedEventMsg = fmt.Sprintf("%q", keeper.AutoBatchMessage)
+	)
+	require.NoError(t, e1)
+	require.NoError(t, e2)
+	require.NoError(t, e3)
+	require.NoError(t, e4)
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, allVouchers))
+
+	addTxs := func(fees ...int64) {
+		for _, fee := range fees {
+			amount := sdk.NewCoin(allVouchers[0].Denom, sdk.NewInt(100))
+			_, err := pk.AddToOutgoingPool(ctx, evmChainPrefix, mySender, *receiver, amount, sdk.NewCoin(amount.Denom, sdk.NewInt(fee)))
+			require.NoError(t, err)
+		}
+	}
+	// runs the EndBlocker at the given height, returning true if it emitted an auto batch EventBatchCreated
+	endBlock := func(height int64) bool {
+		ctx = ctx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+		EndBlocker(ctx, pk)
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type != "gravity.v1.EventBatchCreated" {
+				continue
+			}
+			for _, attr := range event.Attributes {
+				if string(attr.Key) == "message" && string(attr.Value) == autoBatchedEventMsg {
+					return true
+				}
+			}
+		}
+		return false
+	}
+
+	height := ctx.BlockHeight()
+	addTxs(2, 3)
+	// tokens without an auto batch threshold wait for a MsgRequestBatch
+	require.False(t, endBlock(height+1))
+
+	require.NoError(t, pk.SetBatchStrategy(ctx, types.TokenBatchStrategy{
+		EvmChainPrefix:   evmChainPrefix,
+		TokenContract:    tokenContract.GetAddress().Hex(),
+		AutoBatchMinFees: sdk.NewInt(10),
+	}))
+	require.False(t, endBlock(height+2))
+	require.Empty(t, pk.GetOutgoingTxBatches(ctx, evmChainPrefix))
+
+	// the fee threshold is met
+	addTxs(6)
+	require.True(t, endBlock(height+3))
+	batches := pk.GetOutgoingTxBatches(ctx, evmChainPrefix)
+	require.Len(t, batches, 1)
+	require.Len(t, batches[0].Transactions, 3)
+
+	// a cheap tx cannot beat the pending batch, but it is batched anyway once it aged
+	require.NoError(t, pk.SetBatchStrategy(ctx, types.TokenBatchStrategy{
+		EvmChainPrefix:  evmChainPrefix,
+		TokenContract:   tokenContract.GetAddress().Hex(),
+		AutoBatchMaxAge: 5,
+	}))
+	_, err := pk.BuildOutgoingTxBatch(ctx, evmChainPrefix, *tokenContract, keeper.OutgoingTxBatchSize)
+	require.Error(t, err)
+	ctx = ctx.WithBlockHeight(height + 4)
+	addTxs(1)
+	require.False(t, endBlock(height+5))
+	require.False(t, endBlock(height+8))
+	require.True(t, endBlock(height+9))
+	require.Len(t, pk.GetOutgoingTxBatches(ctx, evmChainPrefix), 2)
+	require.Empty(t, pk.GetUnbatchedTransactionsByContract(ctx, evmChainPrefix, *tokenContract))
+}
+
+// TestAgedAutoBatchHoldsAgedTxs checks that an age triggered batch holds the aged txs even though younger txs pay
+// more and the batch has room for a single tx
+func TestAgedAutoBatchHoldsAgedTxs(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+
+	pk := input.GravityKeeper
+	evmChainPrefix := keeper.EthChainPrefix
+	var (
+		mySender, e1      = sdk.AccAddressFromBech32("gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm")
+		receiver, e2      = types.NewEthAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		tokenContract, e3 = types.NewEthAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5") // Pickle
+		token, e4         = types.NewInternalERC20Token(sdk.NewInt(99999), tokenContract.GetAddress().Hex())
+		allVouchers       = sdk.NewCoins(token.GravityCoin(evmChainPrefix))
+	)
+	require.NoError(t, e1)
+	require.NoError(t, e2)
+	require.NoError(t, e3)
+	require.NoError(t, e4)
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, allVouchers))
+
+	addTx := func(fee int64) {
+		amount := sdk.NewCoin(allVouchers[0].Denom, sdk.NewInt(100))
+		_, err := pk.AddToOutgoingPool(ctx, evmChainPrefix, mySender, *receiver, amount, sdk.NewCoin(amount.Denom, sdk.NewInt(fee)))
+		require.NoError(t, err)
+	}
+	require.NoError(t, pk.SetBatchStrategy(ctx, types.TokenBatchStrategy{
+		EvmChainPrefix:  evmChainPrefix,
+		TokenContract:   tokenContract.GetAddress().Hex(),
+		AutoBatchMaxAge: 3,
+		MaxBatchSize:    1,
+	}))
+
+	height := ctx.BlockHeight()
+	addTx(1)
+	ctx = ctx.WithBlockHeight(height + 1)
+	addTx(50)
+	ctx = ctx.WithBlockHeight(height + 2)
+	EndBlocker(ctx, pk)
+	require.Empty(t, pk.GetOutgoingTxBatches(ctx, evmChainPrefix))
+
+	ctx = ctx.WithBlockHeight(height + 3)
+	EndBlocker(ctx, pk)
+	batches := pk.GetOutgoingTxBatches(ctx, evmChainPrefix)
+	require.Len(t, batches, 1)
+	require.Len(t, batches[0].Transactions, 1)
+	require.Equal(t, sdk.NewInt(1), batches[0].Transactions[0].Erc20Fee.Amount)
+	unbatched := pk.GetUnbatchedTransactionsByContract(ctx, evmChainPrefix, *tokenContract)
+	require.Len(t, unbatched, 1)
+	require.Equal(t, sdk.NewInt(50), unbatched[0].Erc20Fee.Amount)
+}
+
 func TestValsetPruning(t *testing.T) {
 	input, ctx := keeper.SetupFiveValChain(t)
 	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
@@ -642,7 +906,7 @@ func TestValsetPruning(t *testing.T) {
 	params := pk.GetParams(ctx)
 
 	// Create new validator set with nonce 1
-	pk.SetValsetRequest(ctx, evmChain.EvmChainPrefix)
+	pk.SetValsetRequest(ctx, evmChain.EvmChainPrefix, types.VALSET_REASON_UNSPECIFIED)
 	firstValsetNonce := pk.GetLatestValsetNonce(ctx, evmChain.EvmChainPrefix)
 	require.NotNil(t, pk.GetValset(ctx, evmChain.EvmChainPrefix, firstValsetNonce))
 	require.True(t, len(pk.GetValsets(ctx, evmChain.EvmChainPrefix)) == 1)
@@ -659,7 +923,7 @@ func TestValsetPruning(t *testing.T) {
 	require.True(t, len(pk.GetValsetConfirms(ctx, evmChain.EvmChainPrefix, firstValsetNonce)) == len(keeper.OrchAddrs))
 
 	// Create new validator set with nonce 2
-	pk.SetValsetRequest(ctx, evmChain.EvmChainPrefix)
+	pk.SetValsetRequest(ctx, evmChain.EvmChainPrefix, types.VALSET_REASON_UNSPECIFIED)
 	require.True(t, len(pk.GetValsets(ctx, evmChain.EvmChainPrefix)) == 2)
 	valset := pk.GetValset(ctx, evmChain.EvmChainPrefix, pk.GetLatestValsetNonce(ctx, evmChain.EvmChainPrefix))
 	require.NotNil(t, valset)
@@ -683,7 +947,7 @@ func TestSnapshotPruning(t *testing.T) {
 	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
 
 	pk := input.GravityKeeper
-	tokens := pk.MonitoredERC20Tokens(ctx)
+	tokens := pk.MonitoredERC20Tokens(ctx, keeper.EthChainPrefix)
 
 	var balances []*types.ERC20Token
 	for _, t := range tokens {
@@ -699,16 +963,18 @@ func TestSnapshotPruning(t *testing.T) {
 
 	// Create test snapshots
 	store := ctx.KVStore(input.GravityStoreKey)
+	lastObservedNonceKey := types.AppendChainPrefix(types.LastObservedEventNonceKey, keeper.EthChainPrefix)
 	for i := 0; i < 3; i++ {
 		key := types.GetBridgeBalanceSnapshotKey(uint64(i+1), keeper.EthChainPrefix)
 		snap := types.BridgeBalanceSnapshot{
 			CosmosBlockHeight:   uint64(ctx.BlockHeight()),
 			EthereumBlockHeight: uint64(1234567 + i),
+			EvmChainPrefix:      keeper.EthChainPrefix,
 			Balances:            balances,
 			EventNonce:          uint64(i + 1),
 		}
 		store.Set(key, input.Marshaler.MustMarshal(&snap))
-		store.Set(types.LastObservedEventNonceKey, types.UInt64Bytes(uint64(i+1)))
+		store.Set(lastObservedNonceKey, types.UInt64Bytes(uint64(i+1)))
 		input.Context.WithBlockHeight(ctx.BlockHeight() + 1)
 	}
 	// Create enough snapshots to test pruning
@@ -717,13 +983,27 @@ func TestSnapshotPruning(t *testing.T) {
 		snap := types.BridgeBalanceSnapshot{
 			CosmosBlockHeight:   uint64(ctx.BlockHeight()),
 			EthereumBlockHeight: uint64(1234567 + i),
+			EvmChainPrefix:      keeper.EthChainPrefix,
 			Balances:            balances,
 			EventNonce:          uint64(i + 1),
 		}
 		store.Set(key, input.Marshaler.MustMarshal(&snap))
-		store.Set(types.LastObservedEventNonceKey, types.UInt64Bytes(uint64(i+1)))
+		store.Set(lastObservedNonceKey, types.UInt64Bytes(uint64(i+1)))
 		input.Context.WithBlockHeight(ctx.BlockHeight() + 1)
 	}
+	// Another chain with few observed events, whose snapshots must be left alone
+	for i := 0; i < 3; i++ {
+		key := types.GetBridgeBalanceSnapshotKey(uint64(i+1), keeper.BscChainPrefix)
+		snap := types.BridgeBalanceSnapshot{
+			CosmosBlockHeight:   uint64(ctx.BlockHeight()),
+			EthereumBlockHeight: uint64(7654321 + i),
+			EvmChainPrefix:      keeper.BscChainPrefix,
+			Balances:            balances,
+			EventNonce:          uint64(i + 1),
+		}
+		store.Set(key, input.Marshaler.MustMarshal(&snap))
+		store.Set(types.AppendChainPrefix(types.LastObservedEventNonceKey, keeper.BscChainPrefix), types.UInt64Bytes(uint64(i+1)))
+	}
 
 	// Assert that the snapshots are in the store
 	for i := uint64(0); i < EventsToKeep+3; i++ {
@@ -734,14 +1014,13 @@ func TestSnapshotPruning(t *testing.T) {
 		require.Equal(t, snapshot.Balances, balances)
 	}
 
-	// EndBlocker should cleanup snapshot with nonce 1
+	// EndBlocker should cleanup the ethereum snapshots with nonces 1 to 3
 	EndBlocker(ctx, pk)
 
 	// Assert that the snapshots before the cutoff have been removed
 	for i := 0; i < 3; i++ {
 		key := types.GetBridgeBalanceSnapshotKey(uint64(i+1), keeper.EthChainPrefix)
-		fmt.Println("Checking for snapshot with nonce ", i, "and key", key)
-		require.True(t, store.Has(key))
+		require.False(t, store.Has(key))
 	}
 	// and that the rest remain
 	for i := uint64(3); i < EventsToKeep+3; i++ {
@@ -751,4 +1030,6 @@ func TestSnapshotPruning(t *testing.T) {
 		input.Marshaler.MustUnmarshal(snap, &snapshot)
 		require.Equal(t, snapshot.Balances, balances)
 	}
+	// while the other chain's snapshots are untouched
+	require.Len(t, pk.CollectBridgeBalanceSnapshots(ctx, keeper.BscChainPrefix, false, 0), 3)
 }
@@ -0,0 +1,94 @@
+package cli
+
+import (
+	"crypto/ecdsa"
+	"fmt"
+	"os"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// FlagEthPassphrase is the passphrase of the ethereum key in the keystore managed by `gravity eth_keys`
+const FlagEthPassphrase = "eth-passphrase"
+
+// The ethereum keys created and imported by `gravity eth_keys` are go-ethereum keystore files kept in the keyring
+// directory of the client, next to the cosmos keys. The helpers below are shared between `eth_keys` and the commands
+// which register delegate keys, so that a validator can prove control of the ethereum key without handling it in raw form
+
+// EthKeyStore opens the ethereum keystore in the given directory
+func EthKeyStore(dir string) *keystore.KeyStore {
+	return keystore.NewKeyStore(dir, keystore.StandardScryptN, keystore.StandardScryptP)
+}
+
+// FindEthKey returns the keystore account with the given address, or the only account of the keystore when the
+// address is empty
+func FindEthKey(ks *keystore.KeyStore, address string) (accounts.Account, error) {
+	if address == "" {
+		keys := ks.Accounts()
+		switch len(keys) {
+		case 0:
+			return accounts.Account{}, fmt.Errorf("no ethereum key in the keystore, add one with `eth_keys add` or `eth_keys import`")
+		case 1:
+			return keys[0], nil
+		default:
+			return accounts.Account{}, fmt.Errorf("%d ethereum keys in the keystore, specify the address of the one to use", len(keys))
+		}
+	}
+	if err := types.ValidateEthAddress(address); err != nil {
+		return accounts.Account{}, err
+	}
+	// nolint: exhaustruct
+	return ks.Find(accounts.Account{Address: gethcommon.HexToAddress(address)})
+}
+
+// UnlockEthKey decrypts the private key of a keystore account with its passphrase
+func UnlockEthKey(account accounts.Account, passphrase string) (*ecdsa.PrivateKey, error) {
+	keyJSON, err := os.ReadFile(account.URL.Path)
+	if err != nil {
+		return nil, err
+	}
+	key, err := keystore.DecryptKey(keyJSON, passphrase)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decrypt ethereum key %s: %w", account.Address.Hex(), err)
+	}
+	return key.PrivateKey, nil
+}
+
+// SignDelegateKeys signs the delegation of a validator's voting responsibilities to the orchestrator and the
+// ethereum key, the resulting signature is the EthSignature of MsgSetOrchestratorAddress
+func SignDelegateKeys(privateKey *ecdsa.PrivateKey, validator string, orchestrator string) ([]byte, error) {
+	return types.NewEthereumSignature(types.DelegateKeysSignHash(validator, orchestrator), privateKey)
+}
+
+// DelegateKeysFromKeyStore looks up the ethereum key of `address` (the only key when empty) in the keystore of
+// `keyringDir` and returns its address along with its signature of the delegation. When an explicit address is not in
+// the keystore the address is returned without a signature, as before keys were kept in the keystore
+func DelegateKeysFromKeyStore(cmd *cobra.Command, keyringDir string, address string, validator string, orchestrator string) (string, []byte, error) {
+	ks := EthKeyStore(keyringDir)
+	account, err := FindEthKey(ks, address)
+	if err != nil {
+		if address != "" && err == accounts.ErrUnknownAccount {
+			cmd.PrintErrf("Ethereum key %s is not in the keystore, the delegation will not be signed\n", address)
+			return address, nil, nil
+		}
+		return "", nil, err
+	}
+	passphrase, err := cmd.Flags().GetString(FlagEthPassphrase)
+	if err != nil {
+		return "", nil, err
+	}
+	privateKey, err := UnlockEthKey(account, passphrase)
+	if err != nil {
+		return "", nil, err
+	}
+	signature, err := SignDelegateKeys(privateKey, validator, orchestrator)
+	if err != nil {
+		return "", nil, err
+	}
+	return account.Address.Hex(), signature, nil
+}
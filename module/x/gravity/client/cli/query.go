@@ -18,6 +18,9 @@ const (
 	FlagNonce     = "nonce"
 	FlagEthHeight = "eth-height"
 	FlagUseV1Key  = "use-v1-key"
+
+	FlagTokenContract  = "token-contract"
+	FlagInvalidationID = "invalidation-id"
 )
 
 // GetQueryCmd bundles all the query subcmds together so they appear under `gravity query` or `gravity q`
@@ -44,6 +47,18 @@ func GetQueryCmd() *cobra.Command {
 		CmdGetLastObservedEthNonce(),
 		GetCmdQueryParams(),
 		GetCmdQueryMonitoredERC20s(),
+		GetCmdQueryRateLimits(),
+		GetCmdQueryHeldSendToCosmos(),
+		GetCmdQueryIbcBridgeFees(),
+		GetCmdQueryIbcAutoForwardLogs(),
+		GetCmdQueryIbcAutoForwardTransfer(),
+		GetCmdQueryFailedAttestations(),
+		GetCmdQueryEvmChainDecommission(),
+		GetCmdQueryBlacklist(),
+		GetCmdQueryBatchStrategies(),
+		GetCmdQueryBridgedSupply(),
+		GetCmdQueryCheckpoint(),
+		GetCmdQueryEvidenceRecords(),
 	}...)
 
 	return gravityQueryCmd
@@ -511,20 +526,109 @@ func GetCmdQueryParams() *cobra.Command {
 	return cmd
 }
 
-// GetCmdQueryMonitoredERC20s fetches the current monitored ERC20s
+// GetCmdQueryMonitoredERC20s fetches the current monitored ERC20s of an evm chain
 func GetCmdQueryMonitoredERC20s() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "monitored-erc20s",
-		Args:  cobra.NoArgs,
-		Short: "Query gravity monitored ERC20s",
-		RunE: func(cmd *cobra.Command, _ []string) error {
+		Use:   "monitored-erc20s [evm chain prefix]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query gravity monitored ERC20s of an evm chain",
+		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
 				return err
 			}
 			queryClient := types.NewQueryClient(clientCtx)
 
-			res, err := queryClient.GetMonitoredERC20Addresses(cmd.Context(), &types.QueryMonitoredERC20Addresses{})
+			res, err := queryClient.GetMonitoredERC20Addresses(cmd.Context(), &types.QueryMonitoredERC20Addresses{EvmChainPrefix: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryRateLimits fetches the rate limits of an evm chain and the usage of their current window
+func GetCmdQueryRateLimits() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "rate-limits [evm chain prefix] [optional denom]",
+		Args:  cobra.RangeArgs(1, 2),
+		Short: "Query the rate limits of an evm chain, optionally only the rate limit of a single denom",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryRateLimitsRequest{EvmChainPrefix: args[0]}
+			if len(args) == 2 {
+				req.Denom = args[1]
+			}
+			res, err := queryClient.GetRateLimits(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryHeldSendToCosmos fetches the deposits of an evm chain held back by an inbound rate limit
+func GetCmdQueryHeldSendToCosmos() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "held-send-to-cosmos [evm chain prefix]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the deposits of an evm chain held back by an inbound rate limit",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.GetHeldSendToCosmos(cmd.Context(), &types.QueryHeldSendToCosmosRequest{EvmChainPrefix: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryIbcBridgeFees fetches the default bridge fees of IBC transfers forwarded to an evm chain
+func GetCmdQueryIbcBridgeFees() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "ibc-bridge-fees [evm chain prefix] [optional denom]",
+		Args:  cobra.RangeArgs(1, 2),
+		Short: "Query the default bridge fees of IBC transfers forwarded to an evm chain, optionally only the fee of a single denom",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryIbcBridgeFeesRequest{EvmChainPrefix: args[0]}
+			if len(args) == 2 {
+				req.Denom = args[1]
+			}
+			res, err := queryClient.GetIbcBridgeFees(cmd.Context(), req)
 			if err != nil {
 				return err
 			}
@@ -536,3 +640,294 @@ func GetCmdQueryMonitoredERC20s() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryIbcAutoForwardLogs fetches the recorded outcomes of processed IBC auto forwards of an evm chain
+func GetCmdQueryIbcAutoForwardLogs() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "ibc-auto-forward-logs [evm chain prefix] [optional event nonce]",
+		Args:  cobra.RangeArgs(1, 2),
+		Short: "Query the outcomes of processed IBC auto forwards newest first, optionally only the forward of a single event nonce",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryIbcAutoForwardLogsRequest{EvmChainPrefix: args[0]}
+			if len(args) == 2 {
+				req.EventNonce, err = strconv.ParseUint(args[1], 10, 64)
+				if err != nil {
+					return sdkerrors.Wrapf(err, "Unable to parse event nonce from %v", args[1])
+				}
+			}
+			res, err := queryClient.GetIbcAutoForwardLogs(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryIbcAutoForwardTransfer fetches the status of the ibc transfer sent for an auto forwarded deposit
+func GetCmdQueryIbcAutoForwardTransfer() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "ibc-auto-forward-transfer [evm chain prefix] [event nonce]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query the status of the ibc transfer sent for the auto forwarded deposit with the given event nonce",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			nonce, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return sdkerrors.Wrapf(err, "Unable to parse event nonce from %v", args[1])
+			}
+			req := &types.QueryIbcAutoForwardTransferRequest{EvmChainPrefix: args[0], EventNonce: nonce}
+			res, err := queryClient.GetIbcAutoForwardTransfer(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryFailedAttestations fetches the attestations whose handler failed and which await resolution by governance
+func GetCmdQueryFailedAttestations() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "failed-attestations [evm chain prefix]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the observed attestations whose handler failed and which await a ResolveFailedAttestation proposal",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryFailedAttestationsRequest{EvmChainPrefix: args[0]}
+			res, err := queryClient.GetFailedAttestations(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryEvmChainDecommission fetches the report of the decommissioning of an evm chain
+func GetCmdQueryEvmChainDecommission() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "evm-chain-decommission [evm chain prefix]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the progress of the removal of an evm chain, available after the chain has been removed too",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryEvmChainDecommissionRequest{EvmChainPrefix: args[0]}
+			res, err := queryClient.GetEvmChainDecommission(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryBlacklist fetches the blacklist of an evm chain, or the blacklist entry of a single address
+func GetCmdQueryBlacklist() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "blacklist [evm chain prefix] [optional address]",
+		Args:  cobra.RangeArgs(1, 2),
+		Short: "Query the evm and bech32 addresses blacklisted on an evm chain, or whether the given address is",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryBlacklistRequest{EvmChainPrefix: args[0]}
+			if len(args) == 2 {
+				req.Address = args[1]
+			}
+			res, err := queryClient.GetBlacklist(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryBatchStrategies fetches the batch strategies of the tokens of an evm chain and its max batch size
+func GetCmdQueryBatchStrategies() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "batch-strategies [evm chain prefix]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the strategies used to build the batches of the tokens of an evm chain, other tokens are batched by fee",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryBatchStrategiesRequest{EvmChainPrefix: args[0]}
+			res, err := queryClient.GetBatchStrategies(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryBridgedSupply fetches the bridged supply ledger of an evm chain
+func GetCmdQueryBridgedSupply() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "bridged-supply [evm chain prefix]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the outstanding vouchers of evm originated tokens and the locked coins of cosmos originated tokens of an evm chain",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryBridgedSupplyRequest{EvmChainPrefix: args[0]}
+			res, err := queryClient.GetBridgedSupply(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryCheckpoint fetches the checkpoint of a valset, batch or logic call and the signers of its confirms
+func GetCmdQueryCheckpoint() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "checkpoint [valset|batch|logic-call] [nonce] [evm chain prefix]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Query the abi encoded checkpoint of a valset, batch or logic call and the signer recovered from each of its confirms",
+		Long: `Query the abi encoded checkpoint of a valset, batch or logic call and the signer recovered from each of its
+confirms. Confirms which were not signed by the delegate ethereum key of the orchestrator's validator are not valid.
+A batch is found by nonce unless --token-contract is given, a logic call by invalidation nonce unless
+--invalidation-id is given.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			nonce, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			tokenContract, err := cmd.Flags().GetString(FlagTokenContract)
+			if err != nil {
+				return err
+			}
+			invalidationID, err := cmd.Flags().GetString(FlagInvalidationID)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryCheckpointRequest{
+				EvmChainPrefix: args[2],
+				Kind:           args[0],
+				Nonce:          nonce,
+				TokenContract:  tokenContract,
+				InvalidationId: invalidationID,
+			}
+			res, err := queryClient.GetCheckpoint(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	cmd.Flags().String(FlagTokenContract, "", "The token contract of the batch")
+	cmd.Flags().String(FlagInvalidationID, "", "The hex encoded invalidation id of the logic call")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryEvidenceRecords fetches the evidence of bad and double signing accepted on an evm chain
+func GetCmdQueryEvidenceRecords() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "evidence-records [evm chain prefix] [optional offender validator address]",
+		Args:  cobra.RangeArgs(1, 2),
+		Short: "Query the evidence of bad and double signing accepted on an evm chain, along with the slashed amounts and reporter rewards",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryEvidenceRecordsRequest{EvmChainPrefix: args[0]}
+			if len(args) > 1 {
+				req.Offender = args[1]
+			}
+			res, err := queryClient.GetEvidenceRecords(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"os"
@@ -22,6 +23,13 @@ import (
 	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
 )
 
+const (
+	FlagAttestationThreshold          = "attestation-threshold"
+	FlagAttestationDelegatedPowerOnly = "attestation-delegated-power-only"
+
+	FlagExtraFee = "extra-fee"
+)
+
 // GetTxCmd bundles all the subcmds together so they appear under `gravity tx`
 func GetTxCmd(storeKey string) *cobra.Command {
 	// needed for governance proposal txs in cli case
@@ -40,7 +48,9 @@ func GetTxCmd(storeKey string) *cobra.Command {
 	gravityTxCmd.AddCommand([]*cobra.Command{
 		CmdSendToEth(),
 		CmdCancelSendToEth(),
+		CmdIncreaseBridgeFee(),
 		CmdRequestBatch(),
+		CmdRequestBatchCancellation(),
 		CmdSetOrchestratorAddress(),
 		CmdGovIbcMetadataProposal(),
 		CmdGovAirdropProposal(),
@@ -48,6 +58,14 @@ func GetTxCmd(storeKey string) *cobra.Command {
 		CmdExecutePendingIbcAutoForwards(),
 		CmdAddEvmChainProposal(),
 		CmdRemoveEvmChainProposal(),
+		CmdGovOutgoingLogicCallProposal(),
+		CmdGovSetRateLimitProposal(),
+		CmdGovReleaseHeldSendToCosmosProposal(),
+		CmdGovSetIbcBridgeFeeProposal(),
+		CmdGovResolveFailedAttestationProposal(),
+		CmdGovAddBlacklistProposal(),
+		CmdGovRemoveBlacklistProposal(),
+		CmdGovSetBatchStrategyProposal(),
 	}...)
 
 	return gravityTxCmd
@@ -334,8 +352,20 @@ func CmdAddEvmChainProposal() *cobra.Command {
 			}
 			gravityId := args[3]
 			bridgeEthAddress := args[4]
+			threshold, err := cmd.Flags().GetUint64(FlagAttestationThreshold)
+			if err != nil {
+				return err
+			}
+			delegatedOnly, err := cmd.Flags().GetBool(FlagAttestationDelegatedPowerOnly)
+			if err != nil {
+				return err
+			}
 
-			proposal := &types.AddEvmChainProposal{EvmChainName: evmChainName, EvmChainPrefix: evmChainPrefix, EvmChainNetVersion: evmChainNetVersion, GravityId: gravityId, BridgeEthereumAddress: bridgeEthAddress, Title: args[5], Description: args[7]}
+			proposal := &types.AddEvmChainProposal{EvmChainName: evmChainName, EvmChainPrefix: evmChainPrefix, EvmChainNetVersion: evmChainNetVersion, GravityId: gravityId, BridgeEthereumAddress: bridgeEthAddress, Title: args[5], Description: args[7],
+				AttestationVotesPowerThreshold: threshold, AttestationPowerDelegatedOnly: delegatedOnly}
+			if err := proposal.ValidateBasic(); err != nil {
+				return err
+			}
 			proposalAny, err := codectypes.NewAnyWithValue(proposal)
 			if err != nil {
 				return sdkerrors.Wrap(err, "invalid metadata or proposal details!")
@@ -354,16 +384,19 @@ func CmdAddEvmChainProposal() *cobra.Command {
 			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), &msg)
 		},
 	}
+	cmd.Flags().Uint64(FlagAttestationThreshold, 0, "the percentage of the power which must attest to an event of the chain, 0 for the module wide threshold")
+	cmd.Flags().Bool(FlagAttestationDelegatedPowerOnly, false, "compute the attestation threshold over the power of the validators which have set their delegate keys only")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
 
-// CmdRemoveEvmChainProposal enables users to create a proposal to add new EVM chains
+// CmdRemoveEvmChainProposal enables users to create a proposal to remove an EVM chain, which is first drained for
+// the optional number of blocks
 func CmdRemoveEvmChainProposal() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "remove-evm-chain [evm-chain-prefix] [initial-deposit] [title] [description]",
-		Short: "Creates a governance proposal to remove an EVM chain on the network",
-		Args:  cobra.ExactArgs(4),
+		Use:   "remove-evm-chain [evm-chain-prefix] [initial-deposit] [title] [description] [optional drain-blocks]",
+		Short: "Creates a governance proposal to drain and then remove an EVM chain on the network",
+		Args:  cobra.RangeArgs(4, 5),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -382,7 +415,18 @@ func CmdRemoveEvmChainProposal() *cobra.Command {
 
 			evmChainPrefix := args[0]
 
-			proposal := &types.RemoveEvmChainProposal{EvmChainPrefix: evmChainPrefix, Title: args[2], Description: args[3]}
+			var drainBlocks uint64
+			if len(args) == 5 {
+				drainBlocks, err = strconv.ParseUint(args[4], 10, 64)
+				if err != nil {
+					return sdkerrors.Wrapf(err, "Unable to parse drain blocks from %v", args[4])
+				}
+			}
+
+			proposal := &types.RemoveEvmChainProposal{EvmChainPrefix: evmChainPrefix, Title: args[2], Description: args[3], DrainBlocks: drainBlocks}
+			if err := proposal.ValidateBasic(); err != nil {
+				return err
+			}
 			proposalAny, err := codectypes.NewAnyWithValue(proposal)
 			if err != nil {
 				return sdkerrors.Wrap(err, "invalid metadata or proposal details!")
@@ -405,6 +449,105 @@ func CmdRemoveEvmChainProposal() *cobra.Command {
 	return cmd
 }
 
+// OutgoingLogicCallProposalPlain is a struct with hex encoded payload and invalidation id so that the proposal.json
+// can be readable, rather than containing the base64 encoding json uses for byte arrays
+type OutgoingLogicCallProposalPlain struct {
+	Title                string
+	Description          string
+	EvmChainPrefix       string
+	Transfers            []types.ERC20Token
+	Fees                 []types.ERC20Token
+	LogicContractAddress string
+	Payload              string
+	Timeout              uint64
+	InvalidationId       string
+	InvalidationNonce    uint64
+}
+
+// CmdGovOutgoingLogicCallProposal enables users to easily submit json file proposals for arbitrary logic calls
+// executed by the Gravity contract on an evm chain, funded by the community pool
+func CmdGovOutgoingLogicCallProposal() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "gov-outgoing-logic-call [path-to-proposal-json] [initial-deposit]",
+		Short: "Creates a governance proposal for a logic call executed through the bridge on an evm chain",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cosmosAddr := cliCtx.GetFromAddress()
+
+			initialDeposit, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "bad initial deposit amount")
+			}
+
+			if len(initialDeposit) != 1 {
+				return fmt.Errorf("unexpected coin amounts, expecting just 1 coin amount for initialDeposit")
+			}
+
+			proposalFile := args[0]
+
+			contents, err := os.ReadFile(proposalFile)
+			if err != nil {
+				return sdkerrors.Wrap(err, "failed to read proposal json file")
+			}
+
+			proposal := &OutgoingLogicCallProposalPlain{}
+			err = json.Unmarshal(contents, proposal)
+			if err != nil {
+				return sdkerrors.Wrap(err, "proposal json file is not valid json")
+			}
+
+			payload, err := hex.DecodeString(strings.TrimPrefix(proposal.Payload, "0x"))
+			if err != nil {
+				return sdkerrors.Wrap(err, "payload is not valid hex")
+			}
+			invalidationId, err := hex.DecodeString(strings.TrimPrefix(proposal.InvalidationId, "0x"))
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalidation id is not valid hex")
+			}
+
+			finalProposal := &types.OutgoingLogicCallProposal{
+				Title:                proposal.Title,
+				Description:          proposal.Description,
+				EvmChainPrefix:       proposal.EvmChainPrefix,
+				Transfers:            proposal.Transfers,
+				Fees:                 proposal.Fees,
+				LogicContractAddress: proposal.LogicContractAddress,
+				Payload:              payload,
+				Timeout:              proposal.Timeout,
+				InvalidationId:       invalidationId,
+				InvalidationNonce:    proposal.InvalidationNonce,
+			}
+			if err := finalProposal.ValidateBasic(); err != nil {
+				return err
+			}
+
+			proposalAny, err := codectypes.NewAnyWithValue(finalProposal)
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid logic call or proposal details!")
+			}
+
+			// Make the message
+			msg := govtypes.MsgSubmitProposal{
+				Proposer:       cosmosAddr.String(),
+				InitialDeposit: initialDeposit,
+				Content:        proposalAny,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			// Send it
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // CmdSendToEth sends tokens to Ethereum. Locks Cosmos-side tokens into the Transaction pool for batching.
 func CmdSendToEth() *cobra.Command {
 	// nolint: exhaustruct
@@ -440,6 +583,18 @@ func CmdSendToEth() *cobra.Command {
 			if len(amount) != 1 || len(bridgeFee) != 1 || len(chainFee) != 1 {
 				return fmt.Errorf("unexpected coin amounts, expecting just 1 coin amount for both amount and bridgeFee")
 			}
+			var extraFee *sdk.Coin
+			extraFeeStr, err := cmd.Flags().GetString(FlagExtraFee)
+			if err != nil {
+				return err
+			}
+			if extraFeeStr != "" {
+				coin, err := sdk.ParseCoinNormalized(extraFeeStr)
+				if err != nil {
+					return sdkerrors.Wrap(err, "extra fee")
+				}
+				extraFee = &coin
+			}
 
 			// Make the message
 			msg := types.MsgSendToEth{
@@ -449,6 +604,7 @@ func CmdSendToEth() *cobra.Command {
 				BridgeFee:      bridgeFee[0],
 				ChainFee:       chainFee[0],
 				EvmChainPrefix: args[4],
+				ExtraFee:       extraFee,
 			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -457,6 +613,7 @@ func CmdSendToEth() *cobra.Command {
 			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), &msg)
 		},
 	}
+	cmd.Flags().String(FlagExtraFee, "", "an optional bridge fee in one of the extra fee denoms of the evm chain, paid on cosmos to the orchestrator of the relayer")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -498,14 +655,52 @@ func CmdCancelSendToEth() *cobra.Command {
 	return cmd
 }
 
+// CmdIncreaseBridgeFee adds to the bridge fee of a transaction still waiting in the pool
+func CmdIncreaseBridgeFee() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "increase-bridge-fee [transaction id] [evm chain prefix] [added fee]",
+		Short: "Adds to the bridge fee of your transaction in the pool, making it more attractive to batch. Fails once the transaction is in a batch.",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cosmosAddr := cliCtx.GetFromAddress()
+
+			txId, err := strconv.ParseUint(args[0], 0, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "failed to parse transaction id")
+			}
+			addedFee, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return sdkerrors.Wrap(err, "added fee")
+			}
+
+			// Make the message
+			msg := types.NewMsgIncreaseBridgeFee(args[1], cosmosAddr, txId, addedFee)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			// Send it
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // CmdRequestBatch requests that the validators create and confirm a batch to be sent to Ethereum. This
 // is a manual command which duplicates the efforts of the Ethereum Relayer, likely not to be used often
 func CmdRequestBatch() *cobra.Command {
 	// nolint: exhaustruct
 	cmd := &cobra.Command{
-		Use:   "request-batch [token_contract_address]",
+		Use:   "request-batch [evm_chain_prefix] [token_contract_address or denom]",
 		Short: "Request a new batch on the cosmos side for pooled withdrawal transactions",
-		Args:  cobra.ExactArgs(1),
+		Long: "Request a new batch on the cosmos side for pooled withdrawal transactions of the evm chain. " +
+			"An erc20 address names the voucher of that token on the evm chain, anything else is taken as a cosmos denom",
+		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -513,10 +708,15 @@ func CmdRequestBatch() *cobra.Command {
 			}
 			cosmosAddr := cliCtx.GetFromAddress()
 
-			// TODO: better denom searching
+			evmChainPrefix := args[0]
+			denom := args[1]
+			if tokenContract, err := types.NewEthAddress(denom); err == nil {
+				denom = types.GravityDenom(evmChainPrefix, *tokenContract)
+			}
 			msg := types.MsgRequestBatch{
-				Sender: cosmosAddr.String(),
-				Denom:  fmt.Sprintf("gravity%s", args[0]),
+				Sender:         cosmosAddr.String(),
+				Denom:          denom,
+				EvmChainPrefix: evmChainPrefix,
 			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -529,23 +729,75 @@ func CmdRequestBatch() *cobra.Command {
 	return cmd
 }
 
+// CmdRequestBatchCancellation cancels a stuck batch containing one of your transactions, returning its transactions
+// to the pool
+func CmdRequestBatchCancellation() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "request-batch-cancellation [evm_chain_prefix] [token_contract_address] [batch_nonce]",
+		Short: "Cancel a stuck batch containing one of your transactions, returning its transactions to the pool",
+		Long: "Cancel a batch containing one of your transactions once it is older than the batch cancellation age " +
+			"of the evm chain, has not been relayed and lacks the confirms to be relayed. Its transactions return to the " +
+			"pool and may be batched again",
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cosmosAddr := cliCtx.GetFromAddress()
+
+			tokenContract, err := types.NewEthAddress(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid token contract")
+			}
+			nonce, err := strconv.ParseUint(args[2], 0, 64)
+			if err != nil {
+				return sdkerrors.Wrap(err, "failed to parse batch nonce")
+			}
+
+			msg := types.NewMsgRequestBatchCancellation(args[0], cosmosAddr, *tokenContract, nonce)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			// Send it
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // CmdSetOrchestratorAddress registers delegate keys for a validator so that their Orchestrator has authority to perform
-// its responsibility
+// its responsibility. The ethereum key is read from the keystore of `gravity eth_keys`, which signs the delegation
 func CmdSetOrchestratorAddress() *cobra.Command {
 	// nolint: exhaustruct
 	cmd := &cobra.Command{
 		Use:   "set-orchestrator-address [validator-address] [orchestrator-address] [ethereum-address]",
 		Short: "Allows validators to delegate their voting responsibilities to a given key.",
-		Args:  cobra.ExactArgs(3),
+		Long: `Allows validators to delegate their voting responsibilities to a given key.
+The ethereum key is taken from the keystore managed by 'eth_keys', which signs the delegation with it. The
+ethereum address may be omitted when the keystore holds a single key. An address which is not in the keystore
+is delegated to without a signature.`,
+		Args: cobra.RangeArgs(2, 3),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
+			ethAddress := ""
+			if len(args) > 2 {
+				ethAddress = args[2]
+			}
+			ethAddress, ethSignature, err := DelegateKeysFromKeyStore(cmd, cliCtx.KeyringDir, ethAddress, args[0], args[1])
+			if err != nil {
+				return err
+			}
 			msg := types.MsgSetOrchestratorAddress{
 				Validator:    args[0],
 				Orchestrator: args[1],
-				EthAddress:   args[2],
+				EthAddress:   ethAddress,
+				EthSignature: ethSignature,
 			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -554,6 +806,7 @@ func CmdSetOrchestratorAddress() *cobra.Command {
 			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), &msg)
 		},
 	}
+	cmd.Flags().String(FlagEthPassphrase, "default", "Passphrase of the ethereum key in the keystore")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -597,3 +850,436 @@ func CmdExecutePendingIbcAutoForwards() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// CmdGovSetRateLimitProposal enables users to easily submit json file proposals setting or, with both
+// maximums left empty, removing the rate limit of a denom on an evm chain
+func CmdGovSetRateLimitProposal() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "gov-set-rate-limit [path-to-proposal-json] [initial-deposit]",
+		Short: "Creates a governance proposal to cap the amount of a denom bridged to or from an evm chain per window of blocks",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cosmosAddr := cliCtx.GetFromAddress()
+
+			initialDeposit, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "bad initial deposit amount")
+			}
+
+			if len(initialDeposit) != 1 {
+				return fmt.Errorf("unexpected coin amounts, expecting just 1 coin amount for initialDeposit")
+			}
+
+			proposalFile := args[0]
+
+			contents, err := os.ReadFile(proposalFile)
+			if err != nil {
+				return sdkerrors.Wrap(err, "failed to read proposal json file")
+			}
+
+			proposal := &types.SetRateLimitProposal{}
+			err = json.Unmarshal(contents, proposal)
+			if err != nil {
+				return sdkerrors.Wrap(err, "proposal json file is not valid json")
+			}
+			if err := proposal.ValidateBasic(); err != nil {
+				return err
+			}
+
+			proposalAny, err := codectypes.NewAnyWithValue(proposal)
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid rate limit or proposal details!")
+			}
+
+			// Make the message
+			msg := govtypes.MsgSubmitProposal{
+				Proposer:       cosmosAddr.String(),
+				InitialDeposit: initialDeposit,
+				Content:        proposalAny,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			// Send it
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdGovReleaseHeldSendToCosmosProposal enables users to easily submit json file proposals delivering deposits held back by
+// an inbound rate limit, an empty list of event nonces releases every held deposit of the evm chain
+func CmdGovReleaseHeldSendToCosmosProposal() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "gov-release-held-send-to-cosmos [path-to-proposal-json] [initial-deposit]",
+		Short: "Creates a governance proposal to release deposits held back by an inbound rate limit",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cosmosAddr := cliCtx.GetFromAddress()
+
+			initialDeposit, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "bad initial deposit amount")
+			}
+
+			if len(initialDeposit) != 1 {
+				return fmt.Errorf("unexpected coin amounts, expecting just 1 coin amount for initialDeposit")
+			}
+
+			proposalFile := args[0]
+
+			contents, err := os.ReadFile(proposalFile)
+			if err != nil {
+				return sdkerrors.Wrap(err, "failed to read proposal json file")
+			}
+
+			proposal := &types.ReleaseHeldSendToCosmosProposal{}
+			err = json.Unmarshal(contents, proposal)
+			if err != nil {
+				return sdkerrors.Wrap(err, "proposal json file is not valid json")
+			}
+			if err := proposal.ValidateBasic(); err != nil {
+				return err
+			}
+
+			proposalAny, err := codectypes.NewAnyWithValue(proposal)
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid release or proposal details!")
+			}
+
+			// Make the message
+			msg := govtypes.MsgSubmitProposal{
+				Proposer:       cosmosAddr.String(),
+				InitialDeposit: initialDeposit,
+				Content:        proposalAny,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			// Send it
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdGovSetIbcBridgeFeeProposal enables users to easily submit json file proposals setting or, with a zero
+// fee, removing the default bridge fee of IBC transfers of a denom forwarded to an evm chain
+func CmdGovSetIbcBridgeFeeProposal() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "gov-set-ibc-bridge-fee [path-to-proposal-json] [initial-deposit]",
+		Short: "Creates a governance proposal to set the bridge fee paid by IBC transfers forwarded to an evm chain without a fee in their memo",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cosmosAddr := cliCtx.GetFromAddress()
+
+			initialDeposit, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "bad initial deposit amount")
+			}
+
+			if len(initialDeposit) != 1 {
+				return fmt.Errorf("unexpected coin amounts, expecting just 1 coin amount for initialDeposit")
+			}
+
+			proposalFile := args[0]
+
+			contents, err := os.ReadFile(proposalFile)
+			if err != nil {
+				return sdkerrors.Wrap(err, "failed to read proposal json file")
+			}
+
+			proposal := &types.SetIbcBridgeFeeProposal{}
+			err = json.Unmarshal(contents, proposal)
+			if err != nil {
+				return sdkerrors.Wrap(err, "proposal json file is not valid json")
+			}
+			if err := proposal.ValidateBasic(); err != nil {
+				return err
+			}
+
+			proposalAny, err := codectypes.NewAnyWithValue(proposal)
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid bridge fee or proposal details!")
+			}
+
+			// Make the message
+			msg := govtypes.MsgSubmitProposal{
+				Proposer:       cosmosAddr.String(),
+				InitialDeposit: initialDeposit,
+				Content:        proposalAny,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			// Send it
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdGovResolveFailedAttestationProposal enables users to easily submit json file proposals retrying a quarantined
+// attestation or, with a refund receiver, delivering a failed deposit to a new receiver
+func CmdGovResolveFailedAttestationProposal() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "gov-resolve-failed-attestation [path-to-proposal-json] [initial-deposit]",
+		Short: "Creates a governance proposal to retry an attestation whose handler failed, or to refund its deposit to a new receiver",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cosmosAddr := cliCtx.GetFromAddress()
+
+			initialDeposit, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "bad initial deposit amount")
+			}
+
+			if len(initialDeposit) != 1 {
+				return fmt.Errorf("unexpected coin amounts, expecting just 1 coin amount for initialDeposit")
+			}
+
+			proposalFile := args[0]
+
+			contents, err := os.ReadFile(proposalFile)
+			if err != nil {
+				return sdkerrors.Wrap(err, "failed to read proposal json file")
+			}
+
+			proposal := &types.ResolveFailedAttestationProposal{}
+			err = json.Unmarshal(contents, proposal)
+			if err != nil {
+				return sdkerrors.Wrap(err, "proposal json file is not valid json")
+			}
+			if err := proposal.ValidateBasic(); err != nil {
+				return err
+			}
+
+			proposalAny, err := codectypes.NewAnyWithValue(proposal)
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid failed attestation or proposal details!")
+			}
+
+			// Make the message
+			msg := govtypes.MsgSubmitProposal{
+				Proposer:       cosmosAddr.String(),
+				InitialDeposit: initialDeposit,
+				Content:        proposalAny,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			// Send it
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdGovAddBlacklistProposal enables users to easily submit json file proposals blacklisting evm and bech32 addresses
+func CmdGovAddBlacklistProposal() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "gov-add-blacklist [path-to-proposal-json] [initial-deposit]",
+		Short: "Creates a governance proposal to blacklist evm and bech32 addresses on an evm chain, optionally until an expiry block height",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cosmosAddr := cliCtx.GetFromAddress()
+
+			initialDeposit, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "bad initial deposit amount")
+			}
+
+			if len(initialDeposit) != 1 {
+				return fmt.Errorf("unexpected coin amounts, expecting just 1 coin amount for initialDeposit")
+			}
+
+			proposalFile := args[0]
+
+			contents, err := os.ReadFile(proposalFile)
+			if err != nil {
+				return sdkerrors.Wrap(err, "failed to read proposal json file")
+			}
+
+			proposal := &types.AddBlacklistProposal{}
+			err = json.Unmarshal(contents, proposal)
+			if err != nil {
+				return sdkerrors.Wrap(err, "proposal json file is not valid json")
+			}
+			if err := proposal.ValidateBasic(); err != nil {
+				return err
+			}
+
+			proposalAny, err := codectypes.NewAnyWithValue(proposal)
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid blacklist or proposal details!")
+			}
+
+			// Make the message
+			msg := govtypes.MsgSubmitProposal{
+				Proposer:       cosmosAddr.String(),
+				InitialDeposit: initialDeposit,
+				Content:        proposalAny,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			// Send it
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdGovRemoveBlacklistProposal enables users to easily submit json file proposals lifting the blacklisting of
+// evm and bech32 addresses
+func CmdGovRemoveBlacklistProposal() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "gov-remove-blacklist [path-to-proposal-json] [initial-deposit]",
+		Short: "Creates a governance proposal to remove evm and bech32 addresses from the blacklist of an evm chain",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cosmosAddr := cliCtx.GetFromAddress()
+
+			initialDeposit, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "bad initial deposit amount")
+			}
+
+			if len(initialDeposit) != 1 {
+				return fmt.Errorf("unexpected coin amounts, expecting just 1 coin amount for initialDeposit")
+			}
+
+			proposalFile := args[0]
+
+			contents, err := os.ReadFile(proposalFile)
+			if err != nil {
+				return sdkerrors.Wrap(err, "failed to read proposal json file")
+			}
+
+			proposal := &types.RemoveBlacklistProposal{}
+			err = json.Unmarshal(contents, proposal)
+			if err != nil {
+				return sdkerrors.Wrap(err, "proposal json file is not valid json")
+			}
+			if err := proposal.ValidateBasic(); err != nil {
+				return err
+			}
+
+			proposalAny, err := codectypes.NewAnyWithValue(proposal)
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid blacklist or proposal details!")
+			}
+
+			// Make the message
+			msg := govtypes.MsgSubmitProposal{
+				Proposer:       cosmosAddr.String(),
+				InitialDeposit: initialDeposit,
+				Content:        proposalAny,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			// Send it
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// CmdGovSetBatchStrategyProposal enables users to easily submit json file proposals choosing how the batches of a
+// token are built
+func CmdGovSetBatchStrategyProposal() *cobra.Command {
+	// nolint: exhaustruct
+	cmd := &cobra.Command{
+		Use:   "gov-set-batch-strategy [path-to-proposal-json] [initial-deposit]",
+		Short: "Creates a governance proposal to set the strategy and max size of the batches of a token on an evm chain",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cosmosAddr := cliCtx.GetFromAddress()
+
+			initialDeposit, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return sdkerrors.Wrap(err, "bad initial deposit amount")
+			}
+
+			if len(initialDeposit) != 1 {
+				return fmt.Errorf("unexpected coin amounts, expecting just 1 coin amount for initialDeposit")
+			}
+
+			proposalFile := args[0]
+
+			contents, err := os.ReadFile(proposalFile)
+			if err != nil {
+				return sdkerrors.Wrap(err, "failed to read proposal json file")
+			}
+
+			proposal := &types.SetBatchStrategyProposal{}
+			err = json.Unmarshal(contents, proposal)
+			if err != nil {
+				return sdkerrors.Wrap(err, "proposal json file is not valid json")
+			}
+			if err := proposal.ValidateBasic(); err != nil {
+				return err
+			}
+
+			proposalAny, err := codectypes.NewAnyWithValue(proposal)
+			if err != nil {
+				return sdkerrors.Wrap(err, "invalid batch strategy or proposal details!")
+			}
+
+			// Make the message
+			msg := govtypes.MsgSubmitProposal{
+				Proposer:       cosmosAddr.String(),
+				InitialDeposit: initialDeposit,
+				Content:        proposalAny,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			// Send it
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
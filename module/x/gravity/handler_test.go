@@ -261,6 +261,175 @@ func TestMsgSendToCosmosClaim(t *testing.T) {
 
 }
 
+// nolint: exhaustruct
+func TestMsgSendToCosmosClaimRateLimited(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+
+	var (
+		myCosmosAddr, _ = sdk.AccAddressFromBech32("gravity16ahjkfqxpp6lvfy9fpfnfjg39xr96qet0l08hu")
+		anyETHAddr      = "0xf9613b532673Cc223aBa451dFA8539B87e1F666D"
+		tokenETHAddr    = "0x0bc529c00c6401aef6d220be8c6ea1667f6ad93e"
+		erc20Denom      = keeper.EthChainPrefix + "0x0bc529c00C6401aEF6D220BE8C6Ea1667F6Ad93e"
+	)
+
+	h := NewHandler(input.GravityKeeper)
+	input.GravityKeeper.SetRateLimit(ctx, types.RateLimit{
+		EvmChainPrefix: keeper.EthChainPrefix,
+		Denom:          erc20Denom,
+		WindowBlocks:   10,
+		MaxOutbound:    sdk.ZeroInt(),
+		MaxInbound:     sdk.NewInt(60),
+	})
+
+	deposit := func(nonce uint64, amount int64) {
+		for _, v := range keeper.OrchAddrs {
+			ethClaim := types.MsgSendToCosmosClaim{
+				EventNonce:     nonce,
+				EthBlockHeight: 1234567,
+				TokenContract:  tokenETHAddr,
+				Amount:         sdk.NewInt(amount),
+				EthereumSender: anyETHAddr,
+				CosmosReceiver: myCosmosAddr.String(),
+				Orchestrator:   v.String(),
+				EvmChainPrefix: keeper.EthChainPrefix,
+			}
+			_, err := h(ctx, &ethClaim)
+			require.NoError(t, err)
+			EndBlocker(ctx, input.GravityKeeper)
+		}
+	}
+
+	// the first deposit fits, the second exceeds the cap and the third is queued behind the second
+	deposit(1, 50)
+	deposit(2, 50)
+	deposit(3, 5)
+	assert.Equal(t, sdk.NewInt(50), input.BankKeeper.GetBalance(ctx, myCosmosAddr, erc20Denom).Amount)
+	held := input.GravityKeeper.AllHeldSendToCosmos(ctx, keeper.EthChainPrefix)
+	require.Len(t, held, 2)
+	assert.Equal(t, uint64(2), held[0].EventNonce)
+	assert.Equal(t, sdk.NewInt64Coin(erc20Denom, 50), held[0].Token)
+	assert.Equal(t, uint64(3), held[1].EventNonce)
+	// the held deposits are accounted for by the module balance invariant
+	input.AssertInvariants()
+
+	// nothing is released while the window is still exhausted
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 5)
+	EndBlocker(ctx, input.GravityKeeper)
+	require.Len(t, input.GravityKeeper.AllHeldSendToCosmos(ctx, keeper.EthChainPrefix), 2)
+
+	// both deposits fit into the next window and are released in order
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 5)
+	EndBlocker(ctx, input.GravityKeeper)
+	require.Empty(t, input.GravityKeeper.AllHeldSendToCosmos(ctx, keeper.EthChainPrefix))
+	assert.Equal(t, sdk.NewInt(105), input.BankKeeper.GetBalance(ctx, myCosmosAddr, erc20Denom).Amount)
+
+	// a deposit larger than the whole cap does not block its denom, once held for a full window it fills a window
+	// of its own
+	deposit(4, 100)
+	deposit(5, 5)
+	deposit(6, 5)
+	require.Len(t, input.GravityKeeper.AllHeldSendToCosmos(ctx, keeper.EthChainPrefix), 3)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 10)
+	EndBlocker(ctx, input.GravityKeeper)
+	require.Len(t, input.GravityKeeper.AllHeldSendToCosmos(ctx, keeper.EthChainPrefix), 2)
+	assert.Equal(t, sdk.NewInt(205), input.BankKeeper.GetBalance(ctx, myCosmosAddr, erc20Denom).Amount)
+
+	// the number of deposits released per block is bounded
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 10)
+	input.GravityKeeper.ReleaseHeldSendToCosmosWithinLimits(ctx, keeper.EthChainPrefix, 1)
+	held = input.GravityKeeper.AllHeldSendToCosmos(ctx, keeper.EthChainPrefix)
+	require.Len(t, held, 1)
+	assert.Equal(t, uint64(6), held[0].EventNonce)
+	EndBlocker(ctx, input.GravityKeeper)
+	require.Empty(t, input.GravityKeeper.AllHeldSendToCosmos(ctx, keeper.EthChainPrefix))
+	assert.Equal(t, sdk.NewInt(215), input.BankKeeper.GetBalance(ctx, myCosmosAddr, erc20Denom).Amount)
+}
+
+func TestIbcAutoForwardsInEndBlocker(t *testing.T) {
+	input, ctx := keeper.SetupFiveValChain(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+
+	var (
+		foreignReceiver = "channel-0/oraib14n3tx8s5ftzhlxvq0w5962v60vd82h305kec0j"
+		anyETHAddr      = "0xf9613b532673Cc223aBa451dFA8539B87e1F666D"
+		tokenETHAddr    = "0x0bc529c00c6401aef6d220be8c6ea1667f6ad93e"
+		erc20Denom      = keeper.EthChainPrefix + "0x0bc529c00C6401aEF6D220BE8C6Ea1667F6Ad93e"
+	)
+	input.IbcTransferKeeper.SetParams(ctx, ibctransfertypes.DefaultParams())
+	setForwardsPerBlock := func(forwards uint64) {
+		params := input.GravityKeeper.GetParams(ctx)
+		for i := range params.EvmChainParams {
+			if params.EvmChainParams[i].EvmChainPrefix == keeper.EthChainPrefix {
+				params.EvmChainParams[i].IbcAutoForwardsPerBlock = forwards
+			}
+		}
+		input.GravityKeeper.SetParams(ctx, params)
+	}
+
+	h := NewHandler(input.GravityKeeper)
+	deposit := func(nonce uint64) {
+		for _, v := range keeper.OrchAddrs {
+			ethClaim := types.MsgSendToCosmosClaim{
+				EventNonce:     nonce,
+				EthBlockHeight: 1234567,
+				TokenContract:  tokenETHAddr,
+				Amount:         sdk.NewInt(100),
+				EthereumSender: anyETHAddr,
+				CosmosReceiver: foreignReceiver,
+				Orchestrator:   v.String(),
+				EvmChainPrefix: keeper.EthChainPrefix,
+			}
+			_, err := h(ctx, &ethClaim)
+			require.NoError(t, err)
+			EndBlocker(ctx, input.GravityKeeper)
+		}
+	}
+
+	// without opting in the forwards wait for MsgExecuteIbcAutoForwards
+	deposit(1)
+	deposit(2)
+	require.Len(t, input.GravityKeeper.PendingIbcAutoForwards(ctx, keeper.EthChainPrefix, 0), 2)
+	require.Empty(t, input.GravityKeeper.IbcAutoForwardLogs(ctx, keeper.EthChainPrefix, 0))
+
+	// once opted in at most one forward is processed per block, without an open channel the funds stay local
+	setForwardsPerBlock(1)
+	EndBlocker(ctx, input.GravityKeeper)
+	pending := input.GravityKeeper.PendingIbcAutoForwards(ctx, keeper.EthChainPrefix, 0)
+	require.Len(t, pending, 1)
+	assert.Equal(t, uint64(2), pending[0].EventNonce)
+	EndBlocker(ctx, input.GravityKeeper)
+	require.Empty(t, input.GravityKeeper.PendingIbcAutoForwards(ctx, keeper.EthChainPrefix, 0))
+
+	// the outcomes are recorded in the log, newest first
+	res, err := input.GravityKeeper.GetIbcAutoForwardLogs(sdk.WrapSDKContext(ctx), &types.QueryIbcAutoForwardLogsRequest{
+		EvmChainPrefix: keeper.EthChainPrefix,
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Logs, 2)
+	assert.Equal(t, uint64(2), res.Logs[0].EventNonce)
+	assert.Equal(t, uint64(1), res.Logs[1].EventNonce)
+	for _, log := range res.Logs {
+		assert.False(t, log.Forwarded)
+		assert.NotEmpty(t, log.Error)
+		assert.Equal(t, foreignReceiver, log.ForeignReceiver)
+		assert.Equal(t, sdk.NewInt64Coin(erc20Denom, 100), log.Token)
+		assert.Equal(t, "channel-0", log.IbcChannel)
+	}
+	res, err = input.GravityKeeper.GetIbcAutoForwardLogs(sdk.WrapSDKContext(ctx), &types.QueryIbcAutoForwardLogsRequest{
+		EvmChainPrefix: keeper.EthChainPrefix,
+		EventNonce:     1,
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Logs, 1)
+	assert.Equal(t, uint64(1), res.Logs[0].EventNonce)
+	_, err = input.GravityKeeper.GetIbcAutoForwardLogs(sdk.WrapSDKContext(ctx), &types.QueryIbcAutoForwardLogsRequest{
+		EvmChainPrefix: keeper.EthChainPrefix,
+		EventNonce:     3,
+	})
+	require.Error(t, err)
+}
+
 // nolint: exhaustruct
 func TestEthereumBlacklist(t *testing.T) {
 
@@ -287,17 +456,13 @@ func TestEthereumBlacklist(t *testing.T) {
 
 	k := input.GravityKeeper
 	blockedAddress := anyETHSender
-	newParams := k.GetParams(ctx)
-
-	evmChainParam := newParams.GetEvmChain(evmChain.EvmChainPrefix)
-	evmChainParam.EthereumBlacklist = []string{blockedAddress}
-
-	k.SetParams(ctx, newParams)
-
-	params := k.GetParams(ctx)
-	evmParams := params.GetEvmChain(evmChain.EvmChainPrefix)
-
-	assert.Equal(t, evmParams.EthereumBlacklist, []string{blockedAddress})
+	require.NoError(t, k.HandleAddBlacklistProposal(ctx, &types.AddBlacklistProposal{
+		Title:          "test title",
+		Description:    "test description",
+		EvmChainPrefix: evmChain.EvmChainPrefix,
+		Addresses:      []string{blockedAddress},
+	}))
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
 
 	// send attestations from all five validators
 	for _, v := range keeper.OrchAddrs {
@@ -343,6 +508,14 @@ func TestEthereumBlacklist(t *testing.T) {
 	community_pool_balance := input.DistKeeper.GetFeePool(ctx).CommunityPool
 	assert.Equal(t, sdk.NewDecFromInt(amountA), community_pool_balance.AmountOf(denom))
 
+	// and the deposit is reported as blacklisted
+	blacklisted := 0
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == "gravity.v1.EventBlacklistedSendToCosmos" {
+			blacklisted++
+		}
+	}
+	assert.Equal(t, 1, blacklisted)
 }
 
 const biggestInt = "115792089237316195423570985008687907853269984665640564039457584007913129639935" // 2^256 - 1
@@ -42,7 +42,8 @@ func (im IBCMiddleware) OnRecvPacket(
 	relayer sdk.AccAddress,
 ) exported.Acknowledgement {
 	// check if packet has memo then create MsgSendToEth, otherwise just call underlying
-	// this middleware is for IBC transfer
+	// this middleware is for IBC transfer, the packet is recorded as the origin of the
+	// created pool tx so that a cancel or timeout refunds the counterparty sender
 
 	ack := im.app.OnRecvPacket(ctx, packet, relayer)
 
@@ -61,8 +62,13 @@ func (im IBCMiddleware) OnTimeoutPacket(
 	packet channeltypes.Packet,
 	relayer sdk.AccAddress,
 ) error {
-	// call underlying callback
-	return im.app.OnTimeoutPacket(ctx, packet, relayer)
+	// call underlying callback, which refunds the sender
+	if err := im.app.OnTimeoutPacket(ctx, packet, relayer); err != nil {
+		return err
+	}
+
+	// record the timeout of any IBC Auto-Forward sent as this packet
+	return im.keeper.OnTimeoutPacket(ctx, packet)
 }
 
 // OnChanOpenAck implements the IBCMiddleware interface
@@ -142,7 +148,12 @@ func (im IBCMiddleware) OnAcknowledgementPacket(
 	acknowledgement []byte,
 	relayer sdk.AccAddress,
 ) error {
-	return im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
+	if err := im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer); err != nil {
+		return err
+	}
+
+	// record the final status of any IBC Auto-Forward sent as this packet
+	return im.keeper.OnAcknowledgementPacket(ctx, packet, acknowledgement)
 }
 
 // SendPacket implements the ICS4 Wrapper interface
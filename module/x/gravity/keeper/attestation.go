@@ -9,6 +9,7 @@ import (
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
@@ -75,10 +76,44 @@ func (k Keeper) Attest(
 	}
 }
 
+// AttestationRequiredPower returns the power which must attest to an event of the evm chain for it to be observed:
+// the chain's threshold percentage of either the total bonded power or, if the chain counts delegated power only,
+// the power of the bonded validators which have set their delegate keys, but no less than
+// MinAttestationDelegatedPowerShare percent of the total bonded power
+func (k Keeper) AttestationRequiredPower(ctx sdk.Context, evmChainPrefix string) sdk.Int {
+	threshold := types.AttestationVotesPowerThreshold
+	totalPower := k.StakingKeeper.GetLastTotalPower(ctx)
+
+	params := k.GetParams(ctx)
+	if evmChainParam := params.GetEvmChain(evmChainPrefix); evmChainParam != nil {
+		threshold = evmChainParam.EffectiveAttestationVotesPowerThreshold()
+		if evmChainParam.AttestationPowerDelegatedOnly {
+			floor := totalPower.MulRaw(types.MinAttestationDelegatedPowerShare).QuoRaw(100)
+			totalPower = sdk.MaxInt(k.delegatedPower(ctx), floor)
+		}
+	}
+	return threshold.Mul(totalPower).Quo(sdk.NewInt(100))
+}
+
+// delegatedPower returns the last power of the bonded validators which have set their delegate keys
+func (k Keeper) delegatedPower(ctx sdk.Context) sdk.Int {
+	power := sdk.ZeroInt()
+	k.StakingKeeper.IterateLastValidators(ctx, func(_ int64, validator stakingtypes.ValidatorI) (stop bool) {
+		val := validator.GetOperator()
+		if _, found := k.GetEvmAddressByValidator(ctx, val); found {
+			power = power.Add(sdk.NewInt(k.StakingKeeper.GetLastValidatorPower(ctx, val)))
+		}
+		return false
+	})
+	return power
+}
+
 // TryAttestation checks if an attestation has enough votes to be applied to the consensus state
 // and has not already been marked Observed, then calls processAttestation to actually apply it to the state,
 // and then marks it Observed and emits an event.
-func (k Keeper) TryAttestation(ctx sdk.Context, att *types.Attestation) {
+// requiredPower is the AttestationRequiredPower of the attestation's evm chain, which the caller computes once per
+// block since neither the bonded power nor the delegate keys change while attestations are tallied
+func (k Keeper) TryAttestation(ctx sdk.Context, att *types.Attestation, requiredPower sdk.Int) {
 
 	claim, err := k.UnpackAttestationClaim(att)
 	if err != nil {
@@ -93,8 +128,6 @@ func (k Keeper) TryAttestation(ctx sdk.Context, att *types.Attestation) {
 	if !att.Observed {
 		// Sum the current powers of all validators who have voted and see if it passes the current threshold
 		// TODO: The different integer types and math here needs a careful review
-		totalPower := k.StakingKeeper.GetLastTotalPower(ctx)
-		requiredPower := types.AttestationVotesPowerThreshold.Mul(totalPower).Quo(sdk.NewInt(100))
 		attestationPower := sdk.NewInt(0)
 		for _, validator := range att.Votes {
 			val, err := sdk.ValAddressFromBech32(validator)
@@ -150,7 +183,8 @@ func (k Keeper) processAttestation(ctx sdk.Context, att *types.Attestation, clai
 	// then execute in a new Tx so that we can store state on failure
 	xCtx, commit := ctx.CacheContext()
 	if err := k.AttestationHandler.Handle(xCtx, *att, claim); err != nil { // execute with a transient storage
-		// If the attestation fails, something has gone wrong and we can't recover it. Log and move on
+		// If the attestation fails, something has gone wrong and we can't recover it here. Log it and quarantine it
+		// so that governance may retry it once the problem is fixed, then move on
 		// The attestation will still be marked "Observed", allowing the oracle to progress properly
 		k.logger(ctx).Error("attestation failed",
 			"cause", err.Error(),
@@ -158,8 +192,11 @@ func (k Keeper) processAttestation(ctx sdk.Context, att *types.Attestation, clai
 			"id", types.GetAttestationKey(claim.GetEvmChainPrefix(), claim.GetEventNonce(), hash),
 			"nonce", fmt.Sprint(claim.GetEventNonce()),
 		)
+		k.quarantineAttestation(ctx, *att, claim, err)
 	} else {
 		commit() // persist transient storage
+		// the cache context has its own event manager, the events of the handler are kept only on success
+		ctx.EventManager().EmitEvents(xCtx.EventManager().Events())
 	}
 }
 
@@ -537,15 +574,17 @@ func (k Keeper) ExpectedSupplyChange(ctx sdk.Context, ethClaim types.EthereumCla
 		var claim *types.MsgLogicCallExecutedClaim = (ethClaim).(*types.MsgLogicCallExecutedClaim)
 		logicCall := k.GetOutgoingLogicCall(ctx, claim.EvmChainPrefix, claim.InvalidationId, claim.InvalidationNonce)
 		if logicCall == nil {
-			return nil, fmt.Errorf("could not find logic call for claim (%v)", ethClaim)
+			// the attestation handler will refuse to apply this claim, so the supply can not change
+			change = sdk.Coins{}
+			break
 		}
 
-		intLC, err := logicCall.ToInternal()
+		// Only the evm originated vouchers are burned, cosmos originated tokens stay locked in the module
+		ethOriginated, err := k.LogicCallEthOriginatedCoins(ctx, claim.EvmChainPrefix, *logicCall)
 		if err != nil {
 			return nil, fmt.Errorf("invalid logic call (%v): %v", logicCall, err)
 		}
-
-		change = intLC.TotalValue(claim.EvmChainPrefix)
+		change = ethOriginated
 	}
 
 	return &change, nil
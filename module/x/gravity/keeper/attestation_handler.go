@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"encoding/hex"
 	"fmt"
 	"math/big"
 	"strconv"
@@ -47,6 +48,9 @@ func (a AttestationHandler) Handle(ctx sdk.Context, att types.Attestation, claim
 	case *types.MsgValsetUpdatedClaim:
 		return a.handleValsetUpdated(ctx, *claim)
 
+	case *types.MsgLogicCallExecutedClaim:
+		return a.handleLogicCallExecuted(ctx, *claim)
+
 	default:
 		panic(fmt.Sprintf("Invalid event type for attestations %s", claim.GetType()))
 	}
@@ -57,26 +61,6 @@ func (a AttestationHandler) Handle(ctx sdk.Context, att types.Attestation, claim
 // In the event of a native receiver, bank module handles the transfer, otherwise an IBC transfer is initiated
 // Note: Previously SendToCosmos was referred to as a bridge "Deposit", as tokens are deposited into the gravity contract
 func (a AttestationHandler) handleSendToCosmos(ctx sdk.Context, claim types.MsgSendToCosmosClaim) error {
-
-	invalidAddress := false
-	// Validate the receiver as a valid bech32 address
-	sourceChannel, _, _, accountPrefix, receiverAddress, err := types.ParseReceiver(claim.CosmosReceiver)
-
-	if err != nil {
-		invalidAddress = true
-		hash, er := claim.ClaimHash()
-		if er != nil {
-			return sdkerrors.Wrapf(er, "Could not compute ClaimHash for claim %v: %v", claim, er)
-		}
-
-		a.keeper.logger(ctx).Error("Invalid SendToCosmos receiver",
-			"address", sdk.AccAddress(receiverAddress).String(),
-			"cause", err.Error(),
-			"claim type", claim.GetType(),
-			"id", types.GetAttestationKey(claim.EvmChainPrefix, claim.GetEventNonce(), hash),
-			"nonce", fmt.Sprint(claim.GetEventNonce()),
-		)
-	}
 	tokenAddress, errTokenAddress := types.NewEthAddress(claim.TokenContract)
 	evmChainSender, errEvmChainSender := types.NewEthAddress(claim.EthereumSender)
 	// nil address is not possible unless the validators get together and submit
@@ -110,9 +94,94 @@ func (a AttestationHandler) handleSendToCosmos(ctx sdk.Context, claim types.MsgS
 		return sdkerrors.Wrap(errTokenAddress, "invalid evn chain sender on claim")
 	}
 
+	// Check if coin is Cosmos-originated asset and get denom
+	isCosmosOriginated, denom := a.keeper.ERC20ToDenomLookup(ctx, claim.EvmChainPrefix, *tokenAddress)
+	coin := sdk.NewCoin(denom, claim.Amount)
+
+	moduleAddr := a.keeper.accountKeeper.GetModuleAddress(types.ModuleName)
+	if !isCosmosOriginated { // We need to mint evm-originated coins (aka vouchers)
+		if err := a.mintEthereumOriginatedVouchers(ctx, moduleAddr, claim, coin); err != nil {
+			// TODO: Evaluate closely, if we can't mint an evm voucher, what should we do?
+			return err
+		}
+	} else { // The coins come back from the evm chain, they no longer back its erc20 representation
+		if err := a.keeper.decreaseBridgedSupply(ctx, claim.EvmChainPrefix, *tokenAddress, claim.Amount); err != nil {
+			return err
+		}
+	}
+
+	// Deposits beyond the inbound rate limit wait in the gravity module until the window resets, later deposits of
+	// the same denom are queued behind them so that the held deposits are delivered in order. The deposits of a
+	// draining chain are delivered at once like the deposits its drain releases, so that its escrow only goes down
+	// and nothing is left held for a chain about to be removed
+	if a.keeper.IsEvmChainDraining(ctx, claim.EvmChainPrefix) {
+		return a.deliverSendToCosmos(ctx, claim, *tokenAddress, *evmChainSender, coin)
+	}
+	if a.keeper.hasHeldSendToCosmos(ctx, claim.EvmChainPrefix, denom) || !a.keeper.consumeInboundRateLimit(ctx, claim.EvmChainPrefix, coin) {
+		return a.keeper.holdSendToCosmos(ctx, claim, coin)
+	}
+
+	return a.deliverSendToCosmos(ctx, claim, *tokenAddress, *evmChainSender, coin)
+}
+
+// emitBlacklistedSendToCosmos reports a deposit sent to the community pool because `blacklistedAddress`, its sender
+// or its receiver, is blacklisted
+func (a AttestationHandler) emitBlacklistedSendToCosmos(
+	ctx sdk.Context, claim types.MsgSendToCosmosClaim, tokenAddress types.EthAddress, blacklistedAddress string,
+) error {
+	return ctx.EventManager().EmitTypedEvent(
+		&types.EventBlacklistedSendToCosmos{
+			Amount:             claim.Amount.String(),
+			Nonce:              strconv.Itoa(int(claim.GetEventNonce())),
+			Token:              tokenAddress.GetAddress().Hex(),
+			Sender:             claim.EthereumSender,
+			Receiver:           claim.CosmosReceiver,
+			EvmChainPrefix:     claim.EvmChainPrefix,
+			BlacklistedAddress: blacklistedAddress,
+		},
+	)
+}
+
+// deliverSendToCosmos sends `coin`, already minted or unlocked into the gravity module, to the receiver of the claim,
+// falling back to the community pool when the receiver is invalid or blacklisted. Receivers naming an evm chain of the
+// bridge have the deposit forwarded to that chain
+func (a AttestationHandler) deliverSendToCosmos(
+	ctx sdk.Context, claim types.MsgSendToCosmosClaim, tokenAddress types.EthAddress, evmChainSender types.EthAddress, coin sdk.Coin,
+) error {
+	// deposits of blacklisted senders are never forwarded, they go to the community pool below
+	if memo := a.keeper.parseEvmRoute(ctx, claim.CosmosReceiver); memo != nil && !a.keeper.IsOnBlacklist(ctx, claim.EvmChainPrefix, evmChainSender) {
+		if owner, ok := evmRouteOwner(*memo); ok {
+			return a.routeSendToEvmChain(ctx, claim, tokenAddress, evmChainSender, coin, *memo, owner)
+		}
+		// without a refund address the route is not followed, the receiver is delivered to as any other below
+		a.keeper.logger(ctx).Info("SendToCosmos names an evm chain without a refund address, not forwarding",
+			"evm chain prefix", memo.EvmChainPrefix, "receiver", claim.CosmosReceiver, "nonce", claim.EventNonce,
+		)
+	}
+
+	invalidAddress := false
+	// Validate the receiver as a valid bech32 address
+	sourceChannel, _, _, accountPrefix, receiverAddress, err := types.ParseReceiver(claim.CosmosReceiver)
+
+	if err != nil {
+		invalidAddress = true
+		hash, er := claim.ClaimHash()
+		if er != nil {
+			return sdkerrors.Wrapf(er, "Could not compute ClaimHash for claim %v: %v", claim, er)
+		}
+
+		a.keeper.logger(ctx).Error("Invalid SendToCosmos receiver",
+			"address", sdk.AccAddress(receiverAddress).String(),
+			"cause", err.Error(),
+			"claim type", claim.GetType(),
+			"id", types.GetAttestationKey(claim.EvmChainPrefix, claim.GetEventNonce(), hash),
+			"nonce", fmt.Sprint(claim.GetEventNonce()),
+		)
+	}
+
 	// Block blacklisted asset transfers
 	// (these funds are unrecoverable for the blacklisted sender, they will instead be sent to community pool)
-	if a.keeper.IsOnBlacklist(ctx, claim.EvmChainPrefix, *evmChainSender) {
+	if a.keeper.IsOnBlacklist(ctx, claim.EvmChainPrefix, evmChainSender) {
 		hash, er := claim.ClaimHash()
 		if er != nil {
 			return sdkerrors.Wrapf(er, "Unable to log blacklisted error, could not compute ClaimHash for claim %v: %v", claim, er)
@@ -124,21 +193,31 @@ func (a AttestationHandler) handleSendToCosmos(ctx sdk.Context, claim types.MsgS
 			"nonce", fmt.Sprint(claim.GetEventNonce()),
 		)
 		invalidAddress = true
+		if err := a.emitBlacklistedSendToCosmos(ctx, claim, tokenAddress, claim.EthereumSender); err != nil {
+			return err
+		}
 	}
-
-	// Check if coin is Cosmos-originated asset and get denom
-	isCosmosOriginated, denom := a.keeper.ERC20ToDenomLookup(ctx, claim.EvmChainPrefix, *tokenAddress)
-	coin := sdk.NewCoin(denom, claim.Amount)
-	coins := sdk.Coins{coin}
-
-	moduleAddr := a.keeper.accountKeeper.GetModuleAddress(types.ModuleName)
-	if !isCosmosOriginated { // We need to mint evm-originated coins (aka vouchers)
-		if err := a.mintEthereumOriginatedVouchers(ctx, moduleAddr, claim, coin); err != nil {
-			// TODO: Evaluate closely, if we can't mint an evm voucher, what should we do?
+	if !invalidAddress && a.keeper.IsOnCosmosBlacklist(ctx, claim.EvmChainPrefix, receiverAddress) {
+		hash, er := claim.ClaimHash()
+		if er != nil {
+			return sdkerrors.Wrapf(er, "Unable to log blacklisted error, could not compute ClaimHash for claim %v: %v", claim, er)
+		}
+		a.keeper.logger(ctx).Error("Invalid SendToCosmos: cosmos receiver is blacklisted",
+			"address", sdk.AccAddress(receiverAddress).String(),
+			"claim type", claim.GetType(),
+			"id", types.GetAttestationKey(claim.EvmChainPrefix, claim.GetEventNonce(), hash),
+			"nonce", fmt.Sprint(claim.GetEventNonce()),
+		)
+		invalidAddress = true
+		if err := a.emitBlacklistedSendToCosmos(ctx, claim, tokenAddress, claim.CosmosReceiver); err != nil {
 			return err
 		}
 	}
 
+	denom := coin.Denom
+	coins := sdk.Coins{coin}
+	moduleAddr := a.keeper.accountKeeper.GetModuleAddress(types.ModuleName)
+
 	if !invalidAddress { // address appears valid, attempt to send minted/locked coins to receiver
 		preSendBalance := a.keeper.bankKeeper.GetBalance(ctx, moduleAddr, denom)
 		// Failure to send will result in funds transfer to community pool
@@ -209,6 +288,10 @@ func (a AttestationHandler) handleBatchSendToEth(ctx sdk.Context, claim types.Ms
 	if err != nil {
 		return sdkerrors.Wrap(err, "invalid token contract on batch")
 	}
+	// a batch cancelled by MsgRequestBatchCancellation may still execute, quarantine its claim instead of halting
+	if a.keeper.GetOutgoingTxBatch(ctx, claim.EvmChainPrefix, *contract, claim.BatchNonce) == nil {
+		return sdkerrors.Wrapf(types.ErrUnknown, "batch for token %s nonce %d", contract.GetAddress().Hex(), claim.BatchNonce)
+	}
 	a.keeper.OutgoingTxBatchExecuted(ctx, *contract, claim)
 
 	err = ctx.EventManager().EmitTypedEvent(
@@ -220,6 +303,22 @@ func (a AttestationHandler) handleBatchSendToEth(ctx sdk.Context, claim types.Ms
 	return err
 }
 
+// Upon acceptance of sufficient LogicCallExecuted claims: burn evm originated vouchers, invalidate pending
+// logic calls with the same invalidation id and a lower invalidation nonce, and clean up state
+func (a AttestationHandler) handleLogicCallExecuted(ctx sdk.Context, claim types.MsgLogicCallExecutedClaim) error {
+	if a.keeper.GetOutgoingLogicCall(ctx, claim.EvmChainPrefix, claim.InvalidationId, claim.InvalidationNonce) == nil {
+		return sdkerrors.Wrapf(types.ErrUnknown, "logic call for invalidation id %x nonce %d", claim.InvalidationId, claim.InvalidationNonce)
+	}
+	a.keeper.OutgoingLogicCallExecuted(ctx, claim)
+
+	return ctx.EventManager().EmitTypedEvent(
+		&types.EventLogicCallExecutedClaim{
+			LogicCallInvalidationId:    hex.EncodeToString(claim.InvalidationId),
+			LogicCallInvalidationNonce: fmt.Sprint(claim.InvalidationNonce),
+		},
+	)
+}
+
 // Upon acceptance of sufficient ERC20 Deployed claims, register claim.TokenContract as the canonical evm
 // representation of the metadata governance previously voted for
 func (a AttestationHandler) handleErc20Deployed(ctx sdk.Context, claim types.MsgERC20DeployedClaim) error {
@@ -362,6 +461,7 @@ func (a AttestationHandler) handleValsetUpdated(ctx sdk.Context, claim types.Msg
 				}
 				return sdkerrors.Wrapf(err, "unable to mint cosmos originated coins %v", coins)
 			}
+			a.keeper.increaseBridgedSupply(ctx, claim.EvmChainPrefix, *rewardAddress, claim.RewardAmount)
 		} else {
 			// If it is not cosmos originated, burn the coins (aka Vouchers)
 			// so that we don't think we have more in the bridge than we actually do
@@ -449,6 +549,7 @@ func (a AttestationHandler) mintEthereumOriginatedVouchers(
 			preMintBalance.String(), postMintBalance.String(), claim.Amount.String()),
 		)
 	}
+	a.keeper.increaseBridgedSupplyOfCoins(ctx, claim.EvmChainPrefix, coins)
 	return nil
 }
 
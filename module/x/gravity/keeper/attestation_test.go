@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"bytes"
+	"encoding/hex"
 	"fmt"
 	"testing"
 
@@ -12,6 +13,8 @@ import (
 	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
 	bech32ibctypes "github.com/althea-net/bech32-ibc/x/bech32ibc/types"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 func TestGetAndDeleteAttestation(t *testing.T) {
@@ -478,6 +481,7 @@ func TestExpectedSupplyChange(t *testing.T) {
 		InvalidationId:       []byte{0x0},
 		InvalidationNonce:    0,
 		CosmosBlockCreated:   0,
+		Funded:               true,
 	}
 	pk.SetOutgoingLogicCall(ctx, EthChainPrefix, logicCall.ToExternal())
 
@@ -585,3 +589,198 @@ func TestExpectedSupplyChange(t *testing.T) {
 		}
 	}
 }
+
+// nolint: exhaustruct
+func TestLogicCallExecutedClaim(t *testing.T) {
+	input := CreateTestEnv(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+	ctx := input.Context
+	k := input.GravityKeeper
+
+	tokenContract := TokenContracts[0]
+	denom := types.GravityDenom(EthChainPrefix, tokenContract)
+
+	// fund the community pool, which pays for governance logic calls
+	poolBalance := sdk.NewInt64Coin(denom, 10000)
+	feePool := k.DistKeeper.GetFeePool(ctx)
+	feePool.CommunityPool = feePool.CommunityPool.Add(sdk.NewDecCoinFromCoin(poolBalance))
+	k.DistKeeper.SetFeePool(ctx, feePool)
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(poolBalance)))
+	k.increaseBridgedSupply(ctx, EthChainPrefix, tokenContract, poolBalance.Amount)
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, disttypes.ModuleName, sdk.NewCoins(poolBalance)))
+	k.SetLastObservedEvmChainBlockHeight(ctx, EthChainPrefix, 1000)
+
+	invalidationId := []byte("invalidation id")
+	proposal := types.OutgoingLogicCallProposal{
+		Title:                "test title",
+		Description:          "test description",
+		EvmChainPrefix:       EthChainPrefix,
+		Transfers:            []types.ERC20Token{{Contract: tokenContract.GetAddress().Hex(), Amount: sdk.NewInt(5000)}},
+		Fees:                 []types.ERC20Token{{Contract: tokenContract.GetAddress().Hex(), Amount: sdk.NewInt(100)}},
+		LogicContractAddress: EthAddrs[1].String(),
+		Payload:              []byte("payload"),
+		Timeout:              2000,
+		InvalidationId:       invalidationId,
+		InvalidationNonce:    1,
+	}
+	require.NoError(t, k.HandleOutgoingLogicCallProposal(ctx, &proposal))
+	proposal.Transfers = []types.ERC20Token{{Contract: tokenContract.GetAddress().Hex(), Amount: sdk.NewInt(1000)}}
+	proposal.InvalidationNonce = 2
+	require.NoError(t, k.HandleOutgoingLogicCallProposal(ctx, &proposal))
+	require.Len(t, k.GetOutgoingLogicCalls(ctx, EthChainPrefix), 2)
+
+	k.SetLogicCallConfirm(ctx, &types.MsgConfirmLogicCall{
+		InvalidationId:    hex.EncodeToString(invalidationId),
+		InvalidationNonce: 2,
+		EthSigner:         EthAddrs[0].String(),
+		Orchestrator:      OrchAddrs[0].String(),
+		Signature:         "signature",
+		EvmChainPrefix:    EthChainPrefix,
+	})
+	require.Len(t, k.GetLogicConfirmsByInvalidationIDAndNonce(ctx, EthChainPrefix, invalidationId, 2), 1)
+
+	claim := types.MsgLogicCallExecutedClaim{
+		EventNonce:        1,
+		EthBlockHeight:    1500,
+		InvalidationId:    invalidationId,
+		InvalidationNonce: 2,
+		Orchestrator:      OrchAddrs[0].String(),
+		EvmChainPrefix:    EthChainPrefix,
+	}
+	expected, err := k.ExpectedSupplyChange(ctx, &claim)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(denom, 1100)), *expected)
+
+	// unknown logic calls are rejected without panicking
+	unknown := claim
+	unknown.InvalidationNonce = 3
+	require.Error(t, k.AttestationHandler.Handle(ctx, types.Attestation{}, &unknown))
+
+	require.NoError(t, k.AttestationHandler.Handle(ctx, types.Attestation{}, &claim))
+
+	// the executed call and the lower nonce call it invalidated are gone along with their confirms
+	require.Empty(t, k.GetOutgoingLogicCalls(ctx, EthChainPrefix))
+	require.Empty(t, k.GetLogicConfirmsByInvalidationIDAndNonce(ctx, EthChainPrefix, invalidationId, 2))
+
+	// the executed call's vouchers were burned, the invalidated call was refunded to the community pool
+	require.Equal(t, sdk.NewInt(8900), input.BankKeeper.GetSupply(ctx, denom).Amount)
+	feePool = k.DistKeeper.GetFeePool(ctx)
+	require.Equal(t, sdk.NewDec(8900), feePool.CommunityPool.AmountOf(denom))
+
+	found := false
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == "gravity.v1.EventLogicCallExecutedClaim" {
+			found = true
+		}
+	}
+	require.True(t, found)
+}
+
+func TestAttestationRequiredPower(t *testing.T) {
+	input, ctx := SetupTestChain(t, []uint64{10_000_000, 10_000_000, 10_000_000, 10_000_000, 20_000_000}, false)
+	pk := input.GravityKeeper
+
+	// total bonded power is 60, by default two thirds of it must attest
+	require.Equal(t, int64(60), pk.StakingKeeper.GetLastTotalPower(ctx).Int64())
+	require.Equal(t, sdk.NewInt(39), pk.AttestationRequiredPower(ctx, EthChainPrefix))
+
+	params := pk.GetParams(ctx)
+	evmChainParam := params.GetEvmChain(EthChainPrefix)
+	evmChainParam.AttestationVotesPowerThreshold = 90
+	evmChainParam.AttestationPowerDelegatedOnly = true
+	pk.SetParams(ctx, params)
+
+	// only the largest validator runs an orchestrator for the chain so far, its 20 power is below the floor of half the
+	// bonded power so 90 percent of 30 must attest, other chains keep the default
+	validators := pk.StakingKeeper.GetBondedValidatorsByPower(ctx)
+	setDelegateKeys := func(validators []stakingtypes.Validator) {
+		for i, validator := range validators {
+			ethAddr, err := types.NewEthAddress(EthAddrs[i].String())
+			require.NoError(t, err)
+			pk.SetEvmAddressForValidator(ctx, validator.GetOperator(), *ethAddr)
+		}
+	}
+	setDelegateKeys(validators[:1])
+	require.Equal(t, sdk.NewInt(27), pk.AttestationRequiredPower(ctx, EthChainPrefix))
+	require.Equal(t, sdk.NewInt(39), pk.AttestationRequiredPower(ctx, BscChainPrefix))
+
+	// 90 percent of the 40 power of the three delegated validators
+	setDelegateKeys(validators[:3])
+	require.Equal(t, sdk.NewInt(36), pk.AttestationRequiredPower(ctx, EthChainPrefix))
+	require.Equal(t, sdk.NewInt(39), pk.AttestationRequiredPower(ctx, BscChainPrefix))
+
+	evmChainParam.AttestationVotesPowerThreshold = 50
+	require.Error(t, evmChainParam.ValidateBasic())
+	evmChainParam.AttestationVotesPowerThreshold = 101
+	require.Error(t, evmChainParam.ValidateBasic())
+}
+
+// Deposits whose receiver names another evm chain of the bridge are forwarded to its outgoing pool, or delivered to
+// the sender's local account when that chain has no erc20 for the deposited denom
+func TestSendToCosmosRoutedToEvmChain(t *testing.T) {
+	input := CreateTestEnv(t)
+	k := input.GravityKeeper
+	ctx := input.Context
+
+	var (
+		tokenContract  = "0xdafea492d9c6733ae3d56b7ed1adb60692c98bc5"
+		voucherDenom   = "ethereum0xDAFEA492D9c6733ae3d56b7Ed1ADB60692c98Bc5"
+		evmSender, _   = types.NewEthAddress("0x993d06FC97F45f16e4805883b98a6c20BAb54964")
+		destination    = "0xf9613b532673Cc223aBa451dFA8539B87e1F666D"
+		bscToken, _    = types.NewEthAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		owner          = AccAddrs[0]
+		depositAmount  = sdk.NewInt(1000)
+		attestationHdl = AttestationHandler{keeper: &k}
+	)
+	route := func(bridgeFee int64) string {
+		return fmt.Sprintf(`{"gravity":{"evm_chain_prefix":"%s","dest":"%s","bridge_fee":"%d","refund":"%s"}}`,
+			BscChainPrefix, destination, bridgeFee, owner.String())
+	}
+
+	claim := types.MsgSendToCosmosClaim{
+		EventNonce:     1,
+		EthBlockHeight: 1,
+		TokenContract:  tokenContract,
+		Amount:         depositAmount,
+		EthereumSender: evmSender.GetAddress().Hex(),
+		CosmosReceiver: route(0),
+		Orchestrator:   "gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm",
+		EvmChainPrefix: EthChainPrefix,
+	}
+
+	// bsc has no erc20 for the ethereum voucher, the deposit lands on the refund address
+	require.NoError(t, attestationHdl.handleSendToCosmos(ctx, claim))
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(voucherDenom, depositAmount)), k.bankKeeper.GetAllBalances(ctx, owner))
+	require.Empty(t, k.GetUnbatchedTransactions(ctx, BscChainPrefix))
+
+	// once bsc represents the voucher the deposit is sent on, paying the bridge fee out of the deposit
+	k.setCosmosOriginatedDenomToERC20(ctx, BscChainPrefix, voucherDenom, *bscToken)
+	claim.EventNonce = 2
+	claim.CosmosReceiver = route(3)
+	require.NoError(t, attestationHdl.handleSendToCosmos(ctx, claim))
+
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(voucherDenom, depositAmount)), k.bankKeeper.GetAllBalances(ctx, owner))
+	unbatched := k.GetUnbatchedTransactions(ctx, BscChainPrefix)
+	require.Len(t, unbatched, 1)
+	require.Equal(t, owner.String(), unbatched[0].Sender.String())
+	require.Equal(t, destination, unbatched[0].DestAddress.GetAddress().Hex())
+	require.Equal(t, *bscToken, unbatched[0].Erc20Token.Contract)
+	require.Equal(t, depositAmount.SubRaw(3), unbatched[0].Erc20Token.Amount)
+	require.Equal(t, sdk.NewInt(3), unbatched[0].Erc20Fee.Amount)
+
+	// routes without a refund address are not forwarded, nobody could sign for an account of the evm sender, so the
+	// receiver goes to the community pool as an invalid receiver
+	communityPool := k.DistKeeper.GetFeePool(ctx).CommunityPool.AmountOf(voucherDenom)
+	claim.EventNonce = 3
+	claim.CosmosReceiver = BscChainPrefix + destination + ":3:0"
+	require.NoError(t, attestationHdl.handleSendToCosmos(ctx, claim))
+	require.Len(t, k.GetUnbatchedTransactions(ctx, BscChainPrefix), 1)
+	require.True(t, k.bankKeeper.GetAllBalances(ctx, sdk.AccAddress(evmSender.GetAddress().Bytes())).IsZero())
+	require.Equal(t, communityPool.Add(sdk.NewDecFromInt(depositAmount)), k.DistKeeper.GetFeePool(ctx).CommunityPool.AmountOf(voucherDenom))
+
+	// receivers naming an unknown chain are not routed and go to the community pool as invalid receivers
+	claim.EventNonce = 4
+	claim.CosmosReceiver = "polygon" + destination
+	require.NoError(t, attestationHdl.handleSendToCosmos(ctx, claim))
+	require.Len(t, k.GetUnbatchedTransactions(ctx, BscChainPrefix), 1)
+}
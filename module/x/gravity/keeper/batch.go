@@ -7,6 +7,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 
 	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
 )
@@ -15,9 +16,10 @@ const OutgoingTxBatchSize = 100
 
 // BuildOutgoingTxBatch starts the following process chain:
 // - find bridged denominator for given voucher type
+// - select available transactions from the outgoing transaction pool using the BatchStrategy of the token, capped
+// by the max batch size of the token and evm chain
 // - determine if an unexecuted batch is already waiting for this token type, if so confirm the new batch would
-// have a higher total fees. If not exit without creating a batch
-// - select available transactions from the outgoing transaction pool sorted by fee desc
+// have a higher total fees or that the strategy allows a less profitable batch. If not exit without creating a batch
 // - persist an outgoing batch object with an incrementing ID = nonce
 // - emit an event
 func (k Keeper) BuildOutgoingTxBatch(
@@ -25,6 +27,17 @@ func (k Keeper) BuildOutgoingTxBatch(
 	evmChainPrefix string,
 	contract types.EthAddress,
 	maxElements uint) (*types.InternalOutgoingTxBatch, error) {
+	return k.buildOutgoingTxBatch(ctx, evmChainPrefix, contract, maxElements, 0)
+}
+
+// buildOutgoingTxBatch is BuildOutgoingTxBatch, if `maxAge` is not zero the txs which waited at least `maxAge` blocks
+// are selected first and the profitability check is skipped
+func (k Keeper) buildOutgoingTxBatch(
+	ctx sdk.Context,
+	evmChainPrefix string,
+	contract types.EthAddress,
+	maxElements uint,
+	maxAge uint64) (*types.InternalOutgoingTxBatch, error) {
 	if maxElements == 0 {
 		return nil, sdkerrors.Wrap(types.ErrInvalid, "max elements value")
 	}
@@ -38,31 +51,36 @@ func (k Keeper) BuildOutgoingTxBatch(
 	if !evmChainParam.BridgeActive {
 		return nil, sdkerrors.Wrap(types.ErrInvalid, "bridge paused")
 	}
+	if k.IsEvmChainDraining(ctx, evmChainPrefix) {
+		return nil, sdkerrors.Wrapf(types.ErrEvmChainDraining, "no new batches for %s", evmChainPrefix)
+	}
+
+	strategy, maxSize := k.batchStrategyFor(ctx, evmChainPrefix, contract, maxElements)
+	if maxAge != 0 {
+		strategy = agedFirstBatchStrategy{inner: strategy, height: uint64(ctx.BlockHeight()), maxAge: maxAge}
+	}
+	selectedTxs := strategy.SelectTxs(k.batchableTxs(ctx, evmChainPrefix, contract), maxSize)
+	if len(selectedTxs) == 0 {
+		return nil, sdkerrors.Wrap(types.ErrInvalid, "no transactions of this type to batch")
+	}
 
 	lastBatch := k.GetLastOutgoingBatchByTokenType(ctx, evmChainPrefix, contract)
 
 	// lastBatch may be nil if there are no existing batches, we only need
 	// to perform this check if a previous batch exists
 	if lastBatch != nil {
-		// this traverses the current tx pool for this token type and determines what
-		// fees a hypothetical batch would have if created
-		currentFees := k.GetBatchFeeByTokenType(ctx, evmChainPrefix, contract, maxElements)
-		if currentFees == nil {
-			return nil, sdkerrors.Wrap(types.ErrInvalid, "error getting fees from tx pool")
+		currentFees := sdk.ZeroInt()
+		for _, tx := range selectedTxs {
+			currentFees = currentFees.Add(tx.Erc20Fee.Amount)
 		}
 
 		lastFees := lastBatch.ToExternal().GetFees()
-		if lastFees.GT(currentFees.TotalFees) {
+		if lastFees.GT(currentFees) && !strategy.AllowsLessProfitableBatch(ctx, selectedTxs) {
 			return nil, sdkerrors.Wrap(types.ErrInvalid, "new batch would not be more profitable")
 		}
 	}
 
-	selectedTxs, err := k.pickUnbatchedTxs(ctx, evmChainPrefix, contract, maxElements)
-	if err != nil {
-		return nil, err
-	} else if len(selectedTxs) == 0 {
-		return nil, sdkerrors.Wrap(types.ErrInvalid, "no transactions of this type to batch")
-	}
+	k.removeSelectedTxs(ctx, evmChainPrefix, selectedTxs)
 
 	nextID := k.autoIncrementID(ctx, types.AppendChainPrefix(types.KeyLastOutgoingBatchID, evmChainPrefix))
 	batch, err := types.NewInternalOutgingTxBatch(nextID, k.getBatchTimeoutHeight(ctx, evmChainPrefix), selectedTxs, contract, 0)
@@ -141,8 +159,13 @@ func (k Keeper) OutgoingTxBatchExecuted(ctx sdk.Context, tokenContract types.Eth
 		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, burnVouchers); err != nil {
 			panic(err)
 		}
+		if err := k.decreaseBridgedSupply(ctx, claim.EvmChainPrefix, contract, totalToBurn); err != nil {
+			panic(err)
+		}
 	}
 
+	k.payBatchExtraFees(ctx, claim.EvmChainPrefix, *b, claim.Relayer)
+
 	// Iterate through remaining batches
 	k.IterateOutgoingTxBatches(ctx, claim.EvmChainPrefix, func(key []byte, batch types.InternalOutgoingTxBatch) bool {
 		// If the iterated batches nonce is lower than the one that was just executed, cancel it
@@ -157,12 +180,75 @@ func (k Keeper) OutgoingTxBatchExecuted(ctx sdk.Context, tokenContract types.Eth
 		return false
 	})
 
+	// The transactions have reached the evm chain, they can no longer be refunded over IBC
+	for _, tx := range b.Transactions {
+		k.DeleteIbcTransferOrigin(ctx, claim.EvmChainPrefix, tx.Id)
+	}
+
 	// Delete batch since it is finished
 	k.DeleteBatch(ctx, claim.EvmChainPrefix, *b)
 	// Delete it's confirmations as well
 	k.DeleteBatchConfirms(ctx, claim.EvmChainPrefix, *b)
 }
 
+// payBatchExtraFees pays the extra fees of the txs in an executed batch to the orchestrator of the validator whose
+// evm key relayed the batch, the fees go to the community pool instead if the relayer is unknown or not a validator
+func (k Keeper) payBatchExtraFees(ctx sdk.Context, evmChainPrefix string, batch types.InternalOutgoingTxBatch, relayer string) {
+	fees := sdk.NewCoins()
+	for _, tx := range batch.Transactions {
+		if tx.ExtraFee != nil {
+			fees = fees.Add(*tx.ExtraFee)
+		}
+	}
+	if fees.IsZero() {
+		return
+	}
+
+	recipient := k.relayerOrchestrator(ctx, relayer)
+	if recipient == nil || k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, fees) != nil {
+		recipient = nil
+		if err := k.SendToCommunityPool(ctx, fees); err != nil {
+			panic(sdkerrors.Wrapf(err, "unable to pay the extra fees of batch %d to the community pool", batch.BatchNonce))
+		}
+	}
+
+	recipientStr := distrtypes.ModuleName
+	if recipient != nil {
+		recipientStr = recipient.String()
+	}
+	err := ctx.EventManager().EmitTypedEvent(
+		&types.EventBatchExtraFeesPaid{
+			BridgeContract: k.GetBridgeContractAddress(ctx, evmChainPrefix).GetAddress().Hex(),
+			BridgeChainId:  strconv.Itoa(int(k.GetBridgeChainID(ctx, evmChainPrefix))),
+			TokenContract:  batch.TokenContract.GetAddress().Hex(),
+			Nonce:          fmt.Sprint(batch.BatchNonce),
+			Relayer:        relayer,
+			Recipient:      recipientStr,
+			Fees:           fees.String(),
+		},
+	)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// relayerOrchestrator returns the orchestrator of the validator owning the relayer evm address, nil if there is none
+func (k Keeper) relayerOrchestrator(ctx sdk.Context, relayer string) sdk.AccAddress {
+	relayerAddr, err := types.NewEthAddress(relayer)
+	if err != nil {
+		return nil
+	}
+	validator, found := k.GetValidatorByEvmAddress(ctx, *relayerAddr)
+	if !found {
+		return nil
+	}
+	orch, found := k.GetOrchestratorByValidator(ctx, validator.GetOperator())
+	if !found {
+		return nil
+	}
+	return orch
+}
+
 // StoreBatch stores a transaction batch, it will refuse to overwrite an existing
 // batch and panic instead, once a batch is stored in state signature collection begins
 // so no mutation of a batch in state can ever be valid
@@ -188,46 +274,19 @@ func (k Keeper) DeleteBatch(ctx sdk.Context, evmChainPrefix string, batch types.
 	store.Delete(types.GetOutgoingTxBatchKey(evmChainPrefix, batch.TokenContract, batch.BatchNonce))
 }
 
-// pickUnbatchedTxs moves unbatched Txs from the pool into a collection ready for batching
-func (k Keeper) pickUnbatchedTxs(
-	ctx sdk.Context,
-	evmChainPrefix string,
-	contractAddress types.EthAddress,
-	maxElements uint) ([]*types.InternalOutgoingTransferTx, error) {
-	var selectedTxs []*types.InternalOutgoingTransferTx
-	var err error
-	k.IterateUnbatchedTransactionsByContract(ctx, evmChainPrefix, contractAddress, func(_ []byte, tx *types.InternalOutgoingTransferTx) bool {
-		if tx != nil && tx.Erc20Fee != nil {
-			// check the blacklist before picking this tx, this was already
-			// checked on MsgSendToEth, but we want to double check. For example
-			// a major erc20 throws on send to address X a MsgSendToEth is made with that destination
-			// batches with that tx will forever panic, blocking that erc20. With this check governance
-			// can add that address to the blacklist and quickly eliminate the issue. Note this is
-			// very inefficient, IsOnBlacklist is O(blacklist-length) and should be made faster
-			if !k.IsOnBlacklist(ctx, evmChainPrefix, *tx.DestAddress) {
-				selectedTxs = append(selectedTxs, tx)
-				err = k.removeUnbatchedTX(ctx, evmChainPrefix, *tx.Erc20Fee, tx.Id)
-				if err != nil {
-					panic("Failed to remote tx from unbatched queue")
-				}
-
-				// double check that no duplicates exist in the index
-				oldTx, oldTxErr := k.GetUnbatchedTxByFeeAndId(ctx, evmChainPrefix, *tx.Erc20Fee, tx.Id)
-				if oldTx != nil || oldTxErr == nil {
-					panic("picked a duplicate transaction from the pool, duplicates should never exist!")
-				}
-
-				return uint(len(selectedTxs)) == maxElements
-			} else {
-				// if the tx was on the blacklist we return false
-				// to continue to the next loop iteration
-				return false
-			}
-		} else {
-			panic("tx and fee should never be nil!")
+// removeSelectedTxs removes the txs picked for a new batch from the unbatched pool
+func (k Keeper) removeSelectedTxs(ctx sdk.Context, evmChainPrefix string, selectedTxs []*types.InternalOutgoingTransferTx) {
+	for _, tx := range selectedTxs {
+		if err := k.removeUnbatchedTX(ctx, evmChainPrefix, *tx.Erc20Fee, tx.Id); err != nil {
+			panic("Failed to remote tx from unbatched queue")
 		}
-	})
-	return selectedTxs, err
+
+		// double check that no duplicates exist in the index
+		oldTx, oldTxErr := k.GetUnbatchedTxByFeeAndId(ctx, evmChainPrefix, *tx.Erc20Fee, tx.Id)
+		if oldTx != nil || oldTxErr == nil {
+			panic("picked a duplicate transaction from the pool, duplicates should never exist!")
+		}
+	}
 }
 
 // GetOutgoingTxBatch loads a batch object. Returns nil when not exists.
@@ -279,6 +338,149 @@ func (k Keeper) CancelOutgoingTxBatch(ctx sdk.Context, evmChainPrefix string, to
 	)
 }
 
+// RequestBatchCancellation cancels a batch on behalf of the sender of one of its txs, returning the txs to the pool
+// - checks that user requested cancellations are enabled for the evm chain and that the batch is old enough
+// - checks that no BatchSendToEthClaim has been submitted for the batch, it may be executing
+// - checks that the confirms of the batch fall short of the power the Gravity contract requires, a batch which can be
+// relayed may still execute until its timeout and is left to cleanupTimedOutBatches. Once cancelled no further
+// confirms can be submitted for the batch, so it can never execute
+// - checks that the sender sent one of the txs in the batch
+func (k Keeper) RequestBatchCancellation(ctx sdk.Context, evmChainPrefix string, tokenContract types.EthAddress, nonce uint64, sender sdk.AccAddress) error {
+	if ctx.IsZero() || nonce < 1 || sdk.VerifyAddressFormat(sender) != nil {
+		return sdkerrors.Wrap(types.ErrInvalid, "arguments")
+	}
+	params := k.GetParams(ctx)
+	evmChainParam := params.GetEvmChain(evmChainPrefix)
+	if evmChainParam == nil {
+		return sdkerrors.Wrapf(types.ErrEvmChainNotFound, "evm chain prefix %s", evmChainPrefix)
+	}
+	if evmChainParam.BatchCancellationAge == 0 {
+		return sdkerrors.Wrapf(types.ErrInvalid, "batch cancellation is disabled for %s", evmChainPrefix)
+	}
+	batch := k.GetOutgoingTxBatch(ctx, evmChainPrefix, tokenContract, nonce)
+	if batch == nil {
+		return sdkerrors.Wrapf(types.ErrUnknown, "batch %s %d", tokenContract.GetAddress().Hex(), nonce)
+	}
+	if uint64(ctx.BlockHeight()) < batch.CosmosBlockCreated+evmChainParam.BatchCancellationAge {
+		return sdkerrors.Wrapf(types.ErrInvalid, "batch created at height %d can not be cancelled before height %d",
+			batch.CosmosBlockCreated, batch.CosmosBlockCreated+evmChainParam.BatchCancellationAge)
+	}
+	if k.hasBatchSendToEthClaim(ctx, evmChainPrefix, tokenContract, nonce) {
+		return sdkerrors.Wrapf(types.ErrInvalid, "batch %d has been relayed, it can not be cancelled", nonce)
+	}
+	if k.batchMayExecute(ctx, evmChainPrefix, *batch) {
+		return sdkerrors.Wrapf(types.ErrInvalid, "batch %d has enough confirms to be relayed, it times out at %s height %d",
+			nonce, evmChainPrefix, batch.BatchTimeout)
+	}
+	isSender := false
+	for _, tx := range batch.Transactions {
+		if tx.Sender.Equals(sender) {
+			isSender = true
+			break
+		}
+	}
+	if !isSender {
+		return sdkerrors.Wrapf(types.ErrInvalid, "Sender %s did not send any tx in batch %d", sender, nonce)
+	}
+
+	if err := k.CancelOutgoingTxBatch(ctx, evmChainPrefix, tokenContract, nonce); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(
+		&types.EventBatchCancellationRequested{
+			Sender:         sender.String(),
+			BridgeContract: k.GetBridgeContractAddress(ctx, evmChainPrefix).GetAddress().Hex(),
+			BridgeChainId:  strconv.Itoa(int(k.GetBridgeChainID(ctx, evmChainPrefix))),
+			TokenContract:  tokenContract.GetAddress().Hex(),
+			Nonce:          fmt.Sprint(nonce),
+		},
+	)
+}
+
+// batchMayExecute returns true if the confirms of the batch carry enough power for the Gravity contract to execute it,
+// under the valset last observed on the evm chain or under any newer valset which may yet be submitted to the contract
+func (k Keeper) batchMayExecute(ctx sdk.Context, evmChainPrefix string, batch types.InternalOutgoingTxBatch) bool {
+	signers := make(map[string]bool)
+	k.IterateBatchConfirmByNonceAndTokenContract(ctx, evmChainPrefix, batch.BatchNonce, batch.TokenContract,
+		func(_ []byte, confirm types.MsgConfirmBatch) bool {
+			if signer, err := types.NewEthAddress(confirm.EthSigner); err == nil {
+				signers[signer.GetAddress().Hex()] = true
+			}
+			return false
+		},
+	)
+	if len(signers) == 0 {
+		return false
+	}
+
+	var valsets []types.Valset
+	lastObservedNonce := uint64(0)
+	if observed := k.GetLastObservedValset(ctx, evmChainPrefix); observed != nil {
+		valsets = append(valsets, *observed)
+		lastObservedNonce = observed.Nonce
+	}
+	k.IterateValsets(ctx, evmChainPrefix, func(_ []byte, valset *types.Valset) bool {
+		if valset.Nonce <= lastObservedNonce {
+			return true // valsets are iterated newest first
+		}
+		valsets = append(valsets, *valset)
+		return false
+	})
+	for _, valset := range valsets {
+		if valset.SignedPower(signers) > types.GravityPowerToPass {
+			return true
+		}
+	}
+	return false
+}
+
+// hasBatchSendToEthClaim returns true if any orchestrator has claimed the execution of the given batch
+// An observed claim removes the batch, so only the attestations after the last observed event nonce are looked up.
+// Orchestrators claim events in nonce order, the pending event nonces therefore follow each other without gaps.
+func (k Keeper) hasBatchSendToEthClaim(ctx sdk.Context, evmChainPrefix string, tokenContract types.EthAddress, nonce uint64) bool {
+	store := ctx.KVStore(k.storeKey)
+	for eventNonce := k.GetLastObservedEventNonce(ctx, evmChainPrefix) + 1; ; eventNonce++ {
+		pending, found := k.hasBatchSendToEthClaimAtEventNonce(store, evmChainPrefix, eventNonce, tokenContract, nonce)
+		if found {
+			return true
+		}
+		if !pending {
+			return false
+		}
+	}
+}
+
+// hasBatchSendToEthClaimAtEventNonce reports whether there are attestations for eventNonce, and whether one of them
+// claims the execution of the given batch
+func (k Keeper) hasBatchSendToEthClaimAtEventNonce(
+	store sdk.KVStore, evmChainPrefix string, eventNonce uint64, tokenContract types.EthAddress, nonce uint64,
+) (pending bool, found bool) {
+	iter := sdk.KVStorePrefixIterator(store, types.GetAttestationKey(evmChainPrefix, eventNonce, nil))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var att types.Attestation
+		k.cdc.MustUnmarshal(iter.Value(), &att)
+		claim, err := k.UnpackAttestationClaim(&att)
+		if err != nil {
+			panic(sdkerrors.Wrap(err, "couldn't cast to claim"))
+		}
+		if claim.GetEvmChainPrefix() != evmChainPrefix || claim.GetEventNonce() != eventNonce {
+			continue
+		}
+		pending = true
+		batchClaim, ok := claim.(*types.MsgBatchSendToEthClaim)
+		if !ok || batchClaim.BatchNonce != nonce {
+			continue
+		}
+		claimContract, err := types.NewEthAddress(batchClaim.TokenContract)
+		if err == nil && *claimContract == tokenContract {
+			return true, true
+		}
+	}
+	return pending, false
+}
+
 // IterateOutgoingTxBatches iterates through all outgoing batches in DESC order.
 func (k Keeper) IterateOutgoingTxBatches(ctx sdk.Context, evmChainPrefix string, cb func(key []byte, batch types.InternalOutgoingTxBatch) bool) {
 	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.AppendChainPrefix(types.OutgoingTxBatchKey, evmChainPrefix))
@@ -0,0 +1,333 @@
+// Contains the logic for the governance selected strategies which pick the transactions of each new batch
+/*
+Flow: Governance sets a TokenBatchStrategy per (evm chain, token contract) through a SetBatchStrategyProposal, tokens
+  without one use BATCH_STRATEGY_FEE_DESC. BuildOutgoingTxBatch asks the token's BatchStrategy to select at most
+  maxBatchSize of the unbatched txs, then refuses the batch if it pays less fees than the last batch of the token,
+  unless the strategy allows it (FIFO once the oldest selected tx has waited long enough). GetBatchFeeByTokenType
+  reports the fees of the same selection, so relayers see the batch they would actually get. Tokens whose strategy sets
+  an auto batch threshold do not wait for a MsgRequestBatch, the EndBlocker calls CreateAutoBatches which builds their
+  batch once its fees or the age of their oldest unbatched tx cross the threshold, an age triggered batch holding the
+  aged txs first and skipping the profitability check. Only the erc20 fees of the txs count, their extra fees are a
+  tip for the relayer which is reported by GetBatchFeeByTokenType but never selects or triggers a batch.
+*/
+
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// UnbatchedTxIterators visit the unbatched txs of a token which may be batched, each stops once the callback returns
+// true
+type UnbatchedTxIterators struct {
+	// ByFee visits the txs highest fee first
+	ByFee func(cb func(tx *types.InternalOutgoingTransferTx) (stop bool))
+	// ByAge visits the txs in the order they entered the pool
+	ByAge func(cb func(tx *types.InternalOutgoingTransferTx) (stop bool))
+}
+
+// BatchStrategy selects the transactions of a new batch from the unbatched txs of a token
+type BatchStrategy interface {
+	// SelectTxs returns at most maxElements txs for the next batch out of the txs visited by `pool`
+	SelectTxs(pool UnbatchedTxIterators, maxElements uint) []*types.InternalOutgoingTransferTx
+	// AllowsLessProfitableBatch returns true if the selected txs may be batched even though they pay less fees
+	// than the last batch of the token
+	AllowsLessProfitableBatch(ctx sdk.Context, selected []*types.InternalOutgoingTransferTx) bool
+}
+
+// NewBatchStrategy returns the BatchStrategy implementing the given config
+func NewBatchStrategy(config types.TokenBatchStrategy) BatchStrategy {
+	switch config.Strategy {
+	case types.BATCH_STRATEGY_FIFO:
+		return fifoBatchStrategy{maxWaitBlocks: config.MaxWaitBlocks}
+	case types.BATCH_STRATEGY_MIN_FEE:
+		return minFeeBatchStrategy{minFeePerTx: config.EffectiveMinFeePerTx()}
+	default:
+		return feeDescBatchStrategy{}
+	}
+}
+
+// feeDescBatchStrategy picks the txs paying the highest fees
+type feeDescBatchStrategy struct{}
+
+func (s feeDescBatchStrategy) SelectTxs(pool UnbatchedTxIterators, maxElements uint) (selected []*types.InternalOutgoingTransferTx) {
+	pool.ByFee(func(tx *types.InternalOutgoingTransferTx) bool {
+		selected = append(selected, tx)
+		return uint(len(selected)) == maxElements
+	})
+	return selected
+}
+
+func (s feeDescBatchStrategy) AllowsLessProfitableBatch(sdk.Context, []*types.InternalOutgoingTransferTx) bool {
+	return false
+}
+
+// fifoBatchStrategy picks the txs which entered the pool first, so that low fee txs are not starved by a
+// steady stream of higher fee txs
+type fifoBatchStrategy struct {
+	maxWaitBlocks uint64
+}
+
+func (s fifoBatchStrategy) SelectTxs(pool UnbatchedTxIterators, maxElements uint) (selected []*types.InternalOutgoingTransferTx) {
+	pool.ByAge(func(tx *types.InternalOutgoingTransferTx) bool {
+		selected = append(selected, tx)
+		return uint(len(selected)) == maxElements
+	})
+	return selected
+}
+
+func (s fifoBatchStrategy) AllowsLessProfitableBatch(ctx sdk.Context, selected []*types.InternalOutgoingTransferTx) bool {
+	if len(selected) == 0 {
+		return false
+	}
+	// the selection is ordered by age, txs which predate the recorded height count as created at genesis
+	oldest := selected[0].CosmosBlockCreated
+	return uint64(ctx.BlockHeight()) >= oldest+s.maxWaitBlocks
+}
+
+// minFeeBatchStrategy picks the txs paying the highest fees among those paying at least minFeePerTx
+type minFeeBatchStrategy struct {
+	minFeePerTx sdk.Int
+}
+
+func (s minFeeBatchStrategy) SelectTxs(pool UnbatchedTxIterators, maxElements uint) (selected []*types.InternalOutgoingTransferTx) {
+	pool.ByFee(func(tx *types.InternalOutgoingTransferTx) bool {
+		// txs are visited highest fee first, every remaining tx pays less than the floor
+		if tx.Erc20Fee.Amount.LT(s.minFeePerTx) {
+			return true
+		}
+		selected = append(selected, tx)
+		return uint(len(selected)) == maxElements
+	})
+	return selected
+}
+
+func (s minFeeBatchStrategy) AllowsLessProfitableBatch(sdk.Context, []*types.InternalOutgoingTransferTx) bool {
+	return false
+}
+
+// agedFirstBatchStrategy builds the batch of an age triggered auto batch, it picks the txs which waited at least
+// maxAge blocks oldest first and fills the rest of the batch with the selection of the token's own strategy
+type agedFirstBatchStrategy struct {
+	inner  BatchStrategy
+	height uint64
+	maxAge uint64
+}
+
+func (s agedFirstBatchStrategy) SelectTxs(pool UnbatchedTxIterators, maxElements uint) (selected []*types.InternalOutgoingTransferTx) {
+	picked := make(map[uint64]bool)
+	pool.ByAge(func(tx *types.InternalOutgoingTransferTx) bool {
+		// txs are visited oldest first, every remaining tx is younger than maxAge
+		if tx.CosmosBlockCreated+s.maxAge > s.height || uint(len(selected)) == maxElements {
+			return true
+		}
+		selected = append(selected, tx)
+		picked[tx.Id] = true
+		return false
+	})
+	// the inner selection holds at most len(selected) aged txs, so it always fills the batch if the pool can
+	for _, tx := range s.inner.SelectTxs(pool, maxElements) {
+		if uint(len(selected)) == maxElements {
+			break
+		}
+		if !picked[tx.Id] {
+			selected = append(selected, tx)
+		}
+	}
+	return selected
+}
+
+// AllowsLessProfitableBatch always allows the batch, otherwise a pending batch paying more would block the aged txs
+// forever
+func (s agedFirstBatchStrategy) AllowsLessProfitableBatch(sdk.Context, []*types.InternalOutgoingTransferTx) bool {
+	return true
+}
+
+// GetBatchStrategy returns the batch strategy of `tokenContract` on the given evm chain, or nil if the token has none
+func (k Keeper) GetBatchStrategy(ctx sdk.Context, evmChainPrefix string, tokenContract types.EthAddress) *types.TokenBatchStrategy {
+	return getChainRecord[types.TokenBatchStrategy](ctx, k, types.GetBatchStrategyKey(evmChainPrefix, tokenContract))
+}
+
+// SetBatchStrategy stores the given batch strategy, replacing the previous strategy of the token
+func (k Keeper) SetBatchStrategy(ctx sdk.Context, strategy types.TokenBatchStrategy) error {
+	if err := strategy.ValidateBasic(); err != nil {
+		return err
+	}
+	tokenContract, err := types.NewEthAddress(strategy.TokenContract)
+	if err != nil {
+		return err
+	}
+	// store the checksummed contract so that queries and exports are stable
+	strategy.TokenContract = tokenContract.GetAddress().Hex()
+	k.setChainRecord(ctx, types.GetBatchStrategyKey(strategy.EvmChainPrefix, *tokenContract), &strategy)
+	return nil
+}
+
+// DeleteBatchStrategy removes the batch strategy of `tokenContract` on the given evm chain
+func (k Keeper) DeleteBatchStrategy(ctx sdk.Context, evmChainPrefix string, tokenContract types.EthAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetBatchStrategyKey(evmChainPrefix, tokenContract))
+}
+
+// IterateBatchStrategies executes the given callback on each TokenBatchStrategy of the evm chain, ordered by token
+// cb should return true to stop iteration, false to continue
+func (k Keeper) IterateBatchStrategies(ctx sdk.Context, evmChainPrefix string, cb func(strategy types.TokenBatchStrategy) (stop bool)) {
+	iterateChainRecords(ctx, k, types.BatchStrategyKey, evmChainPrefix, false, cb)
+}
+
+// BatchStrategies returns every TokenBatchStrategy of the evm chain, ordered by token
+func (k Keeper) BatchStrategies(ctx sdk.Context, evmChainPrefix string) []types.TokenBatchStrategy {
+	return chainRecords[types.TokenBatchStrategy](ctx, k, types.BatchStrategyKey, evmChainPrefix, false, 0)
+}
+
+// MaxBatchSize returns the maximum number of txs in a batch of the evm chain, OutgoingTxBatchSize unless the chain
+// sets its own
+func (k Keeper) MaxBatchSize(ctx sdk.Context, evmChainPrefix string) uint {
+	params := k.GetParams(ctx)
+	evmChainParam := params.GetEvmChain(evmChainPrefix)
+	if evmChainParam == nil || evmChainParam.MaxBatchSize == 0 {
+		return OutgoingTxBatchSize
+	}
+	return uint(evmChainParam.MaxBatchSize)
+}
+
+// batchStrategyFor returns the BatchStrategy of the token along with the maximum number of txs of its next batch,
+// which is capped by `maxElements`, the max batch size of the token and that of the chain
+func (k Keeper) batchStrategyFor(
+	ctx sdk.Context,
+	evmChainPrefix string,
+	tokenContract types.EthAddress,
+	maxElements uint,
+) (BatchStrategy, uint) {
+	maxSize := k.MaxBatchSize(ctx, evmChainPrefix)
+	strategy := BatchStrategy(feeDescBatchStrategy{})
+	if config := k.GetBatchStrategy(ctx, evmChainPrefix, tokenContract); config != nil {
+		strategy = NewBatchStrategy(*config)
+		if config.MaxBatchSize != 0 && uint(config.MaxBatchSize) < maxSize {
+			maxSize = uint(config.MaxBatchSize)
+		}
+	}
+	if maxElements < maxSize {
+		maxSize = maxElements
+	}
+	return strategy, maxSize
+}
+
+// selectBatchTxs asks the token's BatchStrategy for the txs of its next batch without removing them from the pool,
+// blacklisted destinations are never offered to the strategy
+func (k Keeper) selectBatchTxs(
+	ctx sdk.Context,
+	evmChainPrefix string,
+	tokenContract types.EthAddress,
+	maxElements uint,
+) ([]*types.InternalOutgoingTransferTx, BatchStrategy) {
+	strategy, maxSize := k.batchStrategyFor(ctx, evmChainPrefix, tokenContract, maxElements)
+	return strategy.SelectTxs(k.batchableTxs(ctx, evmChainPrefix, tokenContract), maxSize), strategy
+}
+
+// batchableTxs returns the iterators over the unbatched txs of the token which may be batched, blacklisted
+// destinations are skipped
+func (k Keeper) batchableTxs(ctx sdk.Context, evmChainPrefix string, tokenContract types.EthAddress) UnbatchedTxIterators {
+	filter := func(cb func(tx *types.InternalOutgoingTransferTx) (stop bool)) func([]byte, *types.InternalOutgoingTransferTx) bool {
+		return func(_ []byte, tx *types.InternalOutgoingTransferTx) bool {
+			if tx == nil || tx.Erc20Fee == nil {
+				panic("tx and fee should never be nil!")
+			}
+			// check the blacklist before picking this tx, this was already
+			// checked on MsgSendToEth, but we want to double check. For example
+			// a major erc20 throws on send to address X a MsgSendToEth is made with that destination
+			// batches with that tx will forever panic, blocking that erc20. With this check governance
+			// can add that address to the blacklist and quickly eliminate the issue with an AddBlacklistProposal
+			if k.IsOnBlacklist(ctx, evmChainPrefix, *tx.DestAddress) {
+				return false
+			}
+			return cb(tx)
+		}
+	}
+	return UnbatchedTxIterators{
+		ByFee: func(cb func(tx *types.InternalOutgoingTransferTx) (stop bool)) {
+			k.IterateUnbatchedTransactionsByContract(ctx, evmChainPrefix, tokenContract, filter(cb))
+		},
+		ByAge: func(cb func(tx *types.InternalOutgoingTransferTx) (stop bool)) {
+			k.IterateUnbatchedTransactionsByAge(ctx, evmChainPrefix, tokenContract, filter(cb))
+		},
+	}
+}
+
+// AutoBatchMessage is the message of the EventBatchCreated emitted for batches created by the EndBlocker
+const AutoBatchMessage = "auto_batch"
+
+// CreateAutoBatches builds a batch for every token of the evm chain whose TokenBatchStrategy sets an auto batch
+// threshold which is met, either by the fees of the batch its strategy would build or by the age of its oldest
+// unbatched tx. A batch built on fees must still be more profitable than the last batch of the token, while a batch
+// built on age is not, otherwise a pending batch paying more would block the aged txs forever
+func (k Keeper) CreateAutoBatches(ctx sdk.Context, evmChainPrefix string) {
+	params := k.GetParams(ctx)
+	evmChainParam := params.GetEvmChain(evmChainPrefix)
+	if evmChainParam == nil || !evmChainParam.BridgeActive || k.IsEvmChainDraining(ctx, evmChainPrefix) {
+		return
+	}
+	height := uint64(ctx.BlockHeight())
+
+	// only tokens with a strategy may be auto batched, BatchStrategies is sorted by token, keeping the batch nonces
+	// deterministic
+	for _, config := range k.BatchStrategies(ctx, evmChainPrefix) {
+		if !config.IsAutoBatched() {
+			continue
+		}
+		tokenContract, err := types.NewEthAddress(config.TokenContract)
+		if err != nil {
+			panic(fmt.Sprintf("invalid token %s in the batch strategies of %s", config.TokenContract, evmChainPrefix))
+		}
+
+		maxBatchSize := k.MaxBatchSize(ctx, evmChainPrefix)
+		minFees := config.EffectiveAutoBatchMinFees()
+		feeTriggered := minFees.IsPositive() &&
+			k.GetBatchFeeByTokenType(ctx, evmChainPrefix, *tokenContract, maxBatchSize).TotalFees.GTE(minFees)
+		// an age triggered batch selects the aged txs first, which a fee ordered selection may leave out
+		var maxAge uint64
+		if !feeTriggered && config.AutoBatchMaxAge != 0 {
+			oldest, found := k.oldestUnbatchedTxHeight(ctx, evmChainPrefix, *tokenContract)
+			if found && height >= oldest+config.AutoBatchMaxAge {
+				maxAge = config.AutoBatchMaxAge
+			}
+		}
+		if !feeTriggered && maxAge == 0 {
+			continue
+		}
+
+		// build in a cache context so that a failure leaves no trace
+		xCtx, commit := ctx.CacheContext()
+		batch, err := k.buildOutgoingTxBatch(xCtx, evmChainPrefix, *tokenContract, maxBatchSize, maxAge)
+		if err != nil {
+			ctx.Logger().Debug("Unable to create auto batch", "evmChainPrefix", evmChainPrefix,
+				"token", config.TokenContract, "cause", err.Error())
+			continue
+		}
+		commit()
+		ctx.EventManager().EmitEvents(xCtx.EventManager().Events())
+
+		if err := ctx.EventManager().EmitTypedEvent(
+			&types.EventBatchCreated{
+				Message:    AutoBatchMessage,
+				BatchNonce: fmt.Sprint(batch.BatchNonce),
+			},
+		); err != nil {
+			panic(err)
+		}
+	}
+}
+
+// oldestUnbatchedTxHeight returns the height at which the oldest unbatched tx of the token which may be batched
+// entered the pool, false if there is no such tx
+func (k Keeper) oldestUnbatchedTxHeight(ctx sdk.Context, evmChainPrefix string, tokenContract types.EthAddress) (oldest uint64, found bool) {
+	k.batchableTxs(ctx, evmChainPrefix, tokenContract).ByAge(func(tx *types.InternalOutgoingTransferTx) bool {
+		oldest, found = tx.CosmosBlockCreated, true
+		return true
+	})
+	return oldest, found
+}
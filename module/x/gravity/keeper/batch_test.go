@@ -2,11 +2,14 @@ package keeper
 
 import (
 	"fmt"
+	"math"
 	"math/rand"
 	"testing"
 	"time"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -35,6 +38,7 @@ func TestBatches(t *testing.T) {
 		allVouchers := sdk.NewCoins(token.GravityCoin(evmChain.EvmChainPrefix))
 		// mint some voucher first
 		require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+		input.GravityKeeper.increaseBridgedSupplyOfCoins(ctx, evmChain.EvmChainPrefix, allVouchers)
 		// set senders balance
 		input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
 		require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, allVouchers))
@@ -91,18 +95,20 @@ func TestBatches(t *testing.T) {
 			BatchNonce: 1,
 			Transactions: []types.OutgoingTransferTx{
 				{
-					Id:          2,
-					Erc20Fee:    types.NewERC20Token(3, myTokenContractAddr.GetAddress().Hex()),
-					Sender:      mySender.String(),
-					DestAddress: myReceiver.GetAddress().Hex(),
-					Erc20Token:  types.NewERC20Token(101, myTokenContractAddr.GetAddress().Hex()),
+					Id:                 2,
+					Erc20Fee:           types.NewERC20Token(3, myTokenContractAddr.GetAddress().Hex()),
+					Sender:             mySender.String(),
+					DestAddress:        myReceiver.GetAddress().Hex(),
+					Erc20Token:         types.NewERC20Token(101, myTokenContractAddr.GetAddress().Hex()),
+					CosmosBlockCreated: 1234567,
 				},
 				{
-					Id:          3,
-					Erc20Fee:    types.NewERC20Token(2, myTokenContractAddr.GetAddress().Hex()),
-					Sender:      mySender.String(),
-					DestAddress: myReceiver.GetAddress().Hex(),
-					Erc20Token:  types.NewERC20Token(102, myTokenContractAddr.GetAddress().Hex()),
+					Id:                 3,
+					Erc20Fee:           types.NewERC20Token(2, myTokenContractAddr.GetAddress().Hex()),
+					Sender:             mySender.String(),
+					DestAddress:        myReceiver.GetAddress().Hex(),
+					Erc20Token:         types.NewERC20Token(102, myTokenContractAddr.GetAddress().Hex()),
+					CosmosBlockCreated: 1234567,
 				},
 			},
 			TokenContract:      myTokenContractAddr.GetAddress().Hex(),
@@ -148,18 +154,20 @@ func TestBatches(t *testing.T) {
 		oneHundredThreeTok, _ := types.NewInternalERC20Token(sdk.NewInt(103), myTokenContractAddr.GetAddress().Hex())
 		expUnbatchedTx := []*types.InternalOutgoingTransferTx{
 			{
-				Id:          1,
-				Erc20Fee:    twoFee,
-				Sender:      mySender,
-				DestAddress: myReceiver,
-				Erc20Token:  oneHundredTok,
+				Id:                 1,
+				Erc20Fee:           twoFee,
+				Sender:             mySender,
+				DestAddress:        myReceiver,
+				Erc20Token:         oneHundredTok,
+				CosmosBlockCreated: 1234567,
 			},
 			{
-				Id:          4,
-				Erc20Fee:    oneFee,
-				Sender:      mySender,
-				DestAddress: myReceiver,
-				Erc20Token:  oneHundredThreeTok,
+				Id:                 4,
+				Erc20Fee:           oneFee,
+				Sender:             mySender,
+				DestAddress:        myReceiver,
+				Erc20Token:         oneHundredThreeTok,
+				CosmosBlockCreated: 1234567,
 			},
 		}
 		assert.Equal(t, expUnbatchedTx, gotUnbatchedTx)
@@ -201,18 +209,20 @@ func TestBatches(t *testing.T) {
 			BatchNonce: 2,
 			Transactions: []types.OutgoingTransferTx{
 				{
-					Id:          6,
-					Erc20Fee:    types.NewERC20Token(5, myTokenContractAddr.GetAddress().Hex()),
-					Sender:      mySender.String(),
-					DestAddress: myReceiver.GetAddress().Hex(),
-					Erc20Token:  types.NewERC20Token(101, myTokenContractAddr.GetAddress().Hex()),
+					Id:                 6,
+					Erc20Fee:           types.NewERC20Token(5, myTokenContractAddr.GetAddress().Hex()),
+					Sender:             mySender.String(),
+					DestAddress:        myReceiver.GetAddress().Hex(),
+					Erc20Token:         types.NewERC20Token(101, myTokenContractAddr.GetAddress().Hex()),
+					CosmosBlockCreated: 1234567,
 				},
 				{
-					Id:          5,
-					Erc20Fee:    types.NewERC20Token(4, myTokenContractAddr.GetAddress().Hex()),
-					Sender:      mySender.String(),
-					DestAddress: myReceiver.GetAddress().Hex(),
-					Erc20Token:  types.NewERC20Token(100, myTokenContractAddr.GetAddress().Hex()),
+					Id:                 5,
+					Erc20Fee:           types.NewERC20Token(4, myTokenContractAddr.GetAddress().Hex()),
+					Sender:             mySender.String(),
+					DestAddress:        myReceiver.GetAddress().Hex(),
+					Erc20Token:         types.NewERC20Token(100, myTokenContractAddr.GetAddress().Hex()),
+					CosmosBlockCreated: 1234567,
 				},
 			},
 			TokenContract:      myTokenContractAddr.GetAddress().Hex(),
@@ -277,32 +287,36 @@ func TestBatches(t *testing.T) {
 		oneHundredTwoTok, _ := types.NewInternalERC20Token(sdk.NewInt(102), myTokenContractAddr.GetAddress().Hex())
 		expUnbatchedTx = []*types.InternalOutgoingTransferTx{
 			{
-				Id:          2,
-				Erc20Fee:    threeFee,
-				Sender:      mySender,
-				DestAddress: myReceiver,
-				Erc20Token:  oneHundredOneTok,
+				Id:                 2,
+				Erc20Fee:           threeFee,
+				Sender:             mySender,
+				DestAddress:        myReceiver,
+				Erc20Token:         oneHundredOneTok,
+				CosmosBlockCreated: 1234567,
 			},
 			{
-				Id:          3,
-				Erc20Fee:    twoFee,
-				Sender:      mySender,
-				DestAddress: myReceiver,
-				Erc20Token:  oneHundredTwoTok,
+				Id:                 3,
+				Erc20Fee:           twoFee,
+				Sender:             mySender,
+				DestAddress:        myReceiver,
+				Erc20Token:         oneHundredTwoTok,
+				CosmosBlockCreated: 1234567,
 			},
 			{
-				Id:          1,
-				Erc20Fee:    twoFee,
-				Sender:      mySender,
-				DestAddress: myReceiver,
-				Erc20Token:  oneHundredTok,
+				Id:                 1,
+				Erc20Fee:           twoFee,
+				Sender:             mySender,
+				DestAddress:        myReceiver,
+				Erc20Token:         oneHundredTok,
+				CosmosBlockCreated: 1234567,
 			},
 			{
-				Id:          4,
-				Erc20Fee:    oneFee,
-				Sender:      mySender,
-				DestAddress: myReceiver,
-				Erc20Token:  oneHundredThreeTok,
+				Id:                 4,
+				Erc20Fee:           oneFee,
+				Sender:             mySender,
+				DestAddress:        myReceiver,
+				Erc20Token:         oneHundredThreeTok,
+				CosmosBlockCreated: 1234567,
 			},
 		}
 		assert.Equal(t, expUnbatchedTx, gotUnbatchedTx)
@@ -344,6 +358,7 @@ func TestBatchesFullCoins(t *testing.T) {
 
 	// mint some voucher first
 	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.GravityKeeper.increaseBridgedSupplyOfCoins(ctx, EthChainPrefix, allVouchers)
 	// set senders balance
 	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
 	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, allVouchers))
@@ -380,18 +395,20 @@ func TestBatchesFullCoins(t *testing.T) {
 		BatchNonce: 1,
 		Transactions: []types.OutgoingTransferTx{
 			{
-				Id:          2,
-				Erc20Fee:    types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(300)), myTokenContractAddr),
-				Sender:      mySender.String(),
-				DestAddress: myReceiver,
-				Erc20Token:  types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(300)), myTokenContractAddr),
+				Id:                 2,
+				Erc20Fee:           types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(300)), myTokenContractAddr),
+				Sender:             mySender.String(),
+				DestAddress:        myReceiver,
+				Erc20Token:         types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(300)), myTokenContractAddr),
+				CosmosBlockCreated: 1234567,
 			},
 			{
-				Id:          3,
-				Erc20Fee:    types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(25)), myTokenContractAddr),
-				Sender:      mySender.String(),
-				DestAddress: myReceiver,
-				Erc20Token:  types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(25)), myTokenContractAddr),
+				Id:                 3,
+				Erc20Fee:           types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(25)), myTokenContractAddr),
+				Sender:             mySender.String(),
+				DestAddress:        myReceiver,
+				Erc20Token:         types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(25)), myTokenContractAddr),
+				CosmosBlockCreated: 1234567,
 			},
 		},
 		TokenContract:      myTokenContractAddr,
@@ -414,18 +431,20 @@ func TestBatchesFullCoins(t *testing.T) {
 	require.NoError(t, err)
 	expUnbatchedTx := []*types.InternalOutgoingTransferTx{
 		{
-			Id:          1,
-			Erc20Fee:    twentyTok,
-			Sender:      mySender,
-			DestAddress: receiverAddr,
-			Erc20Token:  twentyTok,
+			Id:                 1,
+			Erc20Fee:           twentyTok,
+			Sender:             mySender,
+			DestAddress:        receiverAddr,
+			Erc20Token:         twentyTok,
+			CosmosBlockCreated: 1234567,
 		},
 		{
-			Id:          4,
-			Erc20Fee:    tenTok,
-			Sender:      mySender,
-			DestAddress: receiverAddr,
-			Erc20Token:  tenTok,
+			Id:                 4,
+			Erc20Fee:           tenTok,
+			Sender:             mySender,
+			DestAddress:        receiverAddr,
+			Erc20Token:         tenTok,
+			CosmosBlockCreated: 1234567,
 		},
 	}
 	assert.Equal(t, expUnbatchedTx, gotUnbatchedTx)
@@ -459,18 +478,20 @@ func TestBatchesFullCoins(t *testing.T) {
 		BatchNonce: 2,
 		Transactions: []types.OutgoingTransferTx{
 			{
-				Id:          5,
-				Erc20Fee:    types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(200)), myTokenContractAddr),
-				Sender:      mySender.String(),
-				DestAddress: myReceiver,
-				Erc20Token:  types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(200)), myTokenContractAddr),
+				Id:                 5,
+				Erc20Fee:           types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(200)), myTokenContractAddr),
+				Sender:             mySender.String(),
+				DestAddress:        myReceiver,
+				Erc20Token:         types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(200)), myTokenContractAddr),
+				CosmosBlockCreated: 1234567,
 			},
 			{
-				Id:          6,
-				Erc20Fee:    types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(150)), myTokenContractAddr),
-				Sender:      mySender.String(),
-				DestAddress: myReceiver,
-				Erc20Token:  types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(150)), myTokenContractAddr),
+				Id:                 6,
+				Erc20Fee:           types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(150)), myTokenContractAddr),
+				Sender:             mySender.String(),
+				DestAddress:        myReceiver,
+				Erc20Token:         types.NewSDKIntERC20Token(oneEth.Mul(sdk.NewIntFromUint64(150)), myTokenContractAddr),
+				CosmosBlockCreated: 1234567,
 			},
 		},
 		TokenContract:      myTokenContractAddr,
@@ -507,32 +528,36 @@ func TestBatchesFullCoins(t *testing.T) {
 	require.NoError(t, err)
 	expUnbatchedTx = []*types.InternalOutgoingTransferTx{
 		{
-			Id:          2,
-			Erc20Fee:    threeHundredTok,
-			Sender:      mySender,
-			DestAddress: receiverAddr,
-			Erc20Token:  threeHundredTok,
+			Id:                 2,
+			Erc20Fee:           threeHundredTok,
+			Sender:             mySender,
+			DestAddress:        receiverAddr,
+			Erc20Token:         threeHundredTok,
+			CosmosBlockCreated: 1234567,
 		},
 		{
-			Id:          3,
-			Erc20Fee:    twentyFiveTok,
-			Sender:      mySender,
-			DestAddress: receiverAddr,
-			Erc20Token:  twentyFiveTok,
+			Id:                 3,
+			Erc20Fee:           twentyFiveTok,
+			Sender:             mySender,
+			DestAddress:        receiverAddr,
+			Erc20Token:         twentyFiveTok,
+			CosmosBlockCreated: 1234567,
 		},
 		{
-			Id:          1,
-			Erc20Fee:    twentyTok,
-			Sender:      mySender,
-			DestAddress: receiverAddr,
-			Erc20Token:  twentyTok,
+			Id:                 1,
+			Erc20Fee:           twentyTok,
+			Sender:             mySender,
+			DestAddress:        receiverAddr,
+			Erc20Token:         twentyTok,
+			CosmosBlockCreated: 1234567,
 		},
 		{
-			Id:          4,
-			Erc20Fee:    tenTok,
-			Sender:      mySender,
-			DestAddress: receiverAddr,
-			Erc20Token:  tenTok,
+			Id:                 4,
+			Erc20Fee:           tenTok,
+			Sender:             mySender,
+			DestAddress:        receiverAddr,
+			Erc20Token:         tenTok,
+			CosmosBlockCreated: 1234567,
 		},
 	}
 	assert.Equal(t, expUnbatchedTx, gotUnbatchedTx)
@@ -578,6 +603,7 @@ func TestManyBatches(t *testing.T) {
 
 	// mint vouchers first
 	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.GravityKeeper.increaseBridgedSupplyOfCoins(ctx, EthChainPrefix, allVouchers)
 	// set senders balance
 	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
 	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, allVouchers))
@@ -673,6 +699,7 @@ func TestPoolTxRefund(t *testing.T) {
 
 	// mint some voucher first
 	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.GravityKeeper.increaseBridgedSupplyOfCoins(ctx, EthChainPrefix, allVouchers)
 	// set senders balance
 	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
 	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, allVouchers))
@@ -753,6 +780,7 @@ func TestBatchesNotCreatedWhenBridgePaused(t *testing.T) {
 
 	// mint some voucher first
 	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.GravityKeeper.increaseBridgedSupplyOfCoins(ctx, EthChainPrefix, allVouchers)
 	// set senders balance
 	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
 	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, allVouchers))
@@ -830,14 +858,11 @@ func TestEthereumBlacklistBatches(t *testing.T) {
 	require.NoError(t, e5)
 
 	// add the blacklisted address to the blacklist
-	params := input.GravityKeeper.GetParams(ctx)
-	evmChainParam := params.GetEvmChain(evmChain.EvmChainPrefix)
-	evmChainParam.EthereumBlacklist = append(evmChainParam.EthereumBlacklist, blacklistedReceiver.GetAddress().Hex())
-
-	input.GravityKeeper.SetParams(ctx, params)
+	require.NoError(t, input.GravityKeeper.addToBlacklist(ctx, evmChain.EvmChainPrefix, []string{blacklistedReceiver.GetAddress().Hex()}, 0))
 
 	// mint some voucher first
 	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.GravityKeeper.increaseBridgedSupplyOfCoins(ctx, EthChainPrefix, allVouchers)
 	// set senders balance
 	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
 	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, allVouchers))
@@ -904,6 +929,357 @@ func TestEthereumBlacklistBatches(t *testing.T) {
 
 // tests total batch fee collected from all of the txs in the batch
 // nolint: exhaustruct
+// tests that the batch strategy of a token picks the txs of its batches and that the max batch sizes cap them
+// nolint: exhaustruct
+func TestBatchStrategies(t *testing.T) {
+	input := CreateTestEnv(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+
+	ctx := input.Context
+	evmChainPrefix := EthChainPrefix
+	var (
+		mySender, e1            = sdk.AccAddressFromBech32("gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm")
+		myReceiver, e2          = types.NewEthAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr, e3 = types.NewEthAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5") // Pickle
+		token, e4               = types.NewInternalERC20Token(sdk.NewInt(99999), myTokenContractAddr.GetAddress().Hex())
+	)
+	require.NoError(t, e1)
+	require.NoError(t, e2)
+	require.NoError(t, e3)
+	require.NoError(t, e4)
+	allVouchers := sdk.NewCoins(token.GravityCoin(evmChainPrefix))
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.GravityKeeper.increaseBridgedSupplyOfCoins(ctx, evmChainPrefix, allVouchers)
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, allVouchers))
+	input.GravityKeeper.SetLastObservedEvmChainBlockHeight(ctx, evmChainPrefix, 1234567)
+
+	addTxs := func(ctx sdk.Context, fees ...int64) {
+		for _, fee := range fees {
+			amount := sdk.NewCoin(token.GravityCoin(evmChainPrefix).Denom, sdk.NewInt(100))
+			feeCoin := sdk.NewCoin(amount.Denom, sdk.NewInt(fee))
+			_, err := input.GravityKeeper.AddToOutgoingPool(ctx, evmChainPrefix, mySender, *myReceiver, amount, feeCoin)
+			require.NoError(t, err)
+		}
+	}
+	setStrategy := func(ctx sdk.Context, strategy types.BatchStrategy, maxBatchSize uint64, maxWaitBlocks uint64, minFee int64) {
+		require.NoError(t, input.GravityKeeper.HandleSetBatchStrategyProposal(ctx, &types.SetBatchStrategyProposal{
+			Title:          "batch strategy",
+			Description:    "batch strategy",
+			EvmChainPrefix: evmChainPrefix,
+			TokenContract:  myTokenContractAddr.GetAddress().Hex(),
+			Strategy:       strategy,
+			MaxBatchSize:   maxBatchSize,
+			MaxWaitBlocks:  maxWaitBlocks,
+			MinFeePerTx:    sdk.NewInt(minFee),
+		}))
+	}
+	txIds := func(batch *types.InternalOutgoingTxBatch) (ids []uint64) {
+		for _, tx := range batch.Transactions {
+			ids = append(ids, tx.Id)
+		}
+		return ids
+	}
+
+	// ids 1, 2 and 3 enter the pool at the starting height, id 4 ten blocks later
+	startHeight := ctx.BlockHeight()
+	addTxs(ctx, 5, 1, 4)
+	ctx = ctx.WithBlockHeight(startHeight + 10)
+	addTxs(ctx, 10)
+
+	// fee desc capped by the max batch size of the token
+	setStrategy(ctx, types.BATCH_STRATEGY_UNSPECIFIED, 2, 0, 0)
+	fees := input.GravityKeeper.GetBatchFeeByTokenType(ctx, evmChainPrefix, *myTokenContractAddr, OutgoingTxBatchSize)
+	require.Equal(t, uint64(2), fees.TxCount)
+	require.Equal(t, sdk.NewInt(15), fees.TotalFees)
+	batch, err := input.GravityKeeper.BuildOutgoingTxBatch(ctx, evmChainPrefix, *myTokenContractAddr, OutgoingTxBatchSize)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{4, 1}, txIds(batch))
+
+	// the min fee floor leaves id 2 out, the remaining id 3 does not beat the last batch
+	setStrategy(ctx, types.BATCH_STRATEGY_MIN_FEE, 0, 0, 2)
+	fees = input.GravityKeeper.GetBatchFeeByTokenType(ctx, evmChainPrefix, *myTokenContractAddr, OutgoingTxBatchSize)
+	require.Equal(t, uint64(1), fees.TxCount)
+	require.Equal(t, sdk.NewInt(4), fees.TotalFees)
+	_, err = input.GravityKeeper.BuildOutgoingTxBatch(ctx, evmChainPrefix, *myTokenContractAddr, OutgoingTxBatchSize)
+	require.Error(t, err)
+
+	// fifo lets the less profitable batch through once its oldest tx waited long enough
+	setStrategy(ctx, types.BATCH_STRATEGY_FIFO, 0, 20, 0)
+	_, err = input.GravityKeeper.BuildOutgoingTxBatch(ctx, evmChainPrefix, *myTokenContractAddr, OutgoingTxBatchSize)
+	require.Error(t, err)
+	ctx = ctx.WithBlockHeight(startHeight + 20)
+	batch, err = input.GravityKeeper.BuildOutgoingTxBatch(ctx, evmChainPrefix, *myTokenContractAddr, OutgoingTxBatchSize)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{2, 3}, txIds(batch))
+	require.Empty(t, input.GravityKeeper.GetUnbatchedTransactionsByContract(ctx, evmChainPrefix, *myTokenContractAddr))
+
+	// removing the strategy falls back to fee desc, capped by the max batch size of the chain
+	setStrategy(ctx, types.BATCH_STRATEGY_UNSPECIFIED, 0, 0, 0)
+	require.Nil(t, input.GravityKeeper.GetBatchStrategy(ctx, evmChainPrefix, *myTokenContractAddr))
+	params := input.GravityKeeper.GetParams(ctx)
+	params.GetEvmChain(evmChainPrefix).MaxBatchSize = 1
+	input.GravityKeeper.SetParams(ctx, params)
+	addTxs(ctx, 3, 7)
+	fees = input.GravityKeeper.GetBatchFeeByTokenType(ctx, evmChainPrefix, *myTokenContractAddr, OutgoingTxBatchSize)
+	require.Equal(t, uint64(1), fees.TxCount)
+	require.Equal(t, sdk.NewInt(7), fees.TotalFees)
+	res, err := input.GravityKeeper.GetBatchStrategies(sdk.WrapSDKContext(ctx), &types.QueryBatchStrategiesRequest{EvmChainPrefix: evmChainPrefix})
+	require.NoError(t, err)
+	require.Empty(t, res.Strategies)
+	require.Equal(t, uint64(1), res.MaxBatchSize)
+}
+
+// Tests that a sender may cancel an old batch which can not be relayed, returning its txs to the pool
+func TestRequestBatchCancellation(t *testing.T) {
+	input := CreateTestEnv(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+
+	ctx := input.Context
+	evmChainPrefix := EthChainPrefix
+	var (
+		mySender, e1            = sdk.AccAddressFromBech32("gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm")
+		notMySender, e2         = sdk.AccAddressFromBech32("gravity1add7f8wyertuus9r20284ej0asrs085c8ajr0y")
+		myReceiver, e3          = types.NewEthAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr, e4 = types.NewEthAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5") // Pickle
+		token, e5               = types.NewInternalERC20Token(sdk.NewInt(99999), myTokenContractAddr.GetAddress().Hex())
+	)
+	require.NoError(t, e1)
+	require.NoError(t, e2)
+	require.NoError(t, e3)
+	require.NoError(t, e4)
+	require.NoError(t, e5)
+	allVouchers := sdk.NewCoins(token.GravityCoin(evmChainPrefix))
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.GravityKeeper.increaseBridgedSupplyOfCoins(ctx, evmChainPrefix, allVouchers)
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, allVouchers))
+	input.GravityKeeper.SetLastObservedEvmChainBlockHeight(ctx, evmChainPrefix, 1234567)
+
+	for _, fee := range []int64{2, 3} {
+		amount := sdk.NewCoin(token.GravityCoin(evmChainPrefix).Denom, sdk.NewInt(100))
+		_, err := input.GravityKeeper.AddToOutgoingPool(ctx, evmChainPrefix, mySender, *myReceiver, amount, sdk.NewCoin(amount.Denom, sdk.NewInt(fee)))
+		require.NoError(t, err)
+	}
+	batch, err := input.GravityKeeper.BuildOutgoingTxBatch(ctx, evmChainPrefix, *myTokenContractAddr, OutgoingTxBatchSize)
+	require.NoError(t, err)
+	checkpoint := batch.GetCheckpoint(input.GravityKeeper.GetGravityID(ctx, evmChainPrefix))
+
+	// cancellation is disabled until governance sets an age
+	err = input.GravityKeeper.RequestBatchCancellation(ctx, evmChainPrefix, *myTokenContractAddr, batch.BatchNonce, mySender)
+	require.Error(t, err)
+	params := input.GravityKeeper.GetParams(ctx)
+	params.GetEvmChain(evmChainPrefix).BatchCancellationAge = 100
+	input.GravityKeeper.SetParams(ctx, params)
+
+	// the batch is too young
+	err = input.GravityKeeper.RequestBatchCancellation(ctx, evmChainPrefix, *myTokenContractAddr, batch.BatchNonce, mySender)
+	require.Error(t, err)
+
+	// the batch may still be relayed while its confirms carry the power the contract requires, under the valset on
+	// the contract or under a newer one
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 100)
+	signerA := gethcommon.HexToAddress("0x993d06FC97F45f16e4805883b98a6c20BAb54964")
+	signerB := gethcommon.HexToAddress("0x7580bFE88Dd3d07947908FAE12d95872a260F2D8")
+	valset := func(nonce uint64, powerA uint64) types.Valset {
+		return types.Valset{Nonce: nonce, Height: 1, RewardAmount: sdk.ZeroInt(), Members: []types.BridgeValidator{
+			{Power: powerA, EthereumAddress: signerA.Hex()},
+			{Power: math.MaxUint32 - powerA, EthereumAddress: signerB.Hex()},
+		}}
+	}
+	input.GravityKeeper.SetLastObservedValset(ctx, evmChainPrefix, valset(1000, math.MaxUint32/10))
+	confirm := func(orchestrator sdk.AccAddress, signer gethcommon.Address) {
+		input.GravityKeeper.SetBatchConfirm(ctx, &types.MsgConfirmBatch{
+			Nonce:          batch.BatchNonce,
+			TokenContract:  myTokenContractAddr.GetAddress().Hex(),
+			EthSigner:      signer.Hex(),
+			Orchestrator:   orchestrator.String(),
+			EvmChainPrefix: evmChainPrefix,
+		})
+	}
+	confirm(OrchAddrs[1], signerB)
+	err = input.GravityKeeper.RequestBatchCancellation(ctx, evmChainPrefix, *myTokenContractAddr, batch.BatchNonce, mySender)
+	require.Error(t, err)
+	input.GravityKeeper.DeleteBatchConfirms(ctx, evmChainPrefix, *batch)
+	confirm(OrchAddrs[0], signerA)
+	input.GravityKeeper.StoreValset(ctx, evmChainPrefix, valset(1001, math.MaxUint32/10*9))
+	err = input.GravityKeeper.RequestBatchCancellation(ctx, evmChainPrefix, *myTokenContractAddr, batch.BatchNonce, mySender)
+	require.Error(t, err)
+	require.NotNil(t, input.GravityKeeper.GetOutgoingTxBatch(ctx, evmChainPrefix, *myTokenContractAddr, batch.BatchNonce))
+	require.Empty(t, input.GravityKeeper.GetUnbatchedTransactionsByContract(ctx, evmChainPrefix, *myTokenContractAddr))
+	input.GravityKeeper.DeleteValset(ctx, evmChainPrefix, 1001)
+
+	// only the senders of its txs may cancel it
+	err = input.GravityKeeper.RequestBatchCancellation(ctx, evmChainPrefix, *myTokenContractAddr, batch.BatchNonce, notMySender)
+	require.Error(t, err)
+
+	// a claim of its execution prevents the cancellation
+	claim := types.MsgBatchSendToEthClaim{
+		EventNonce:     1,
+		EthBlockHeight: 1234568,
+		BatchNonce:     batch.BatchNonce,
+		TokenContract:  myTokenContractAddr.GetAddress().Hex(),
+		Orchestrator:   notMySender.String(),
+		EvmChainPrefix: evmChainPrefix,
+	}
+	claimAny, err := codectypes.NewAnyWithValue(&claim)
+	require.NoError(t, err)
+	hash, err := claim.ClaimHash()
+	require.NoError(t, err)
+	att := &types.Attestation{Observed: false, Votes: []string{}, Height: uint64(ctx.BlockHeight()), Claim: claimAny}
+	input.GravityKeeper.SetAttestation(ctx, evmChainPrefix, claim.EventNonce, hash, att)
+	err = input.GravityKeeper.RequestBatchCancellation(ctx, evmChainPrefix, *myTokenContractAddr, batch.BatchNonce, mySender)
+	require.Error(t, err)
+	input.GravityKeeper.DeleteAttestation(ctx, *att)
+
+	require.NoError(t, input.GravityKeeper.RequestBatchCancellation(ctx, evmChainPrefix, *myTokenContractAddr, batch.BatchNonce, mySender))
+	require.Nil(t, input.GravityKeeper.GetOutgoingTxBatch(ctx, evmChainPrefix, *myTokenContractAddr, batch.BatchNonce))
+	require.Len(t, input.GravityKeeper.GetUnbatchedTransactionsByContract(ctx, evmChainPrefix, *myTokenContractAddr), 2)
+	require.Empty(t, input.GravityKeeper.GetBatchConfirmByNonceAndTokenContract(ctx, evmChainPrefix, batch.BatchNonce, *myTokenContractAddr))
+	// the checkpoint is kept, signatures over it are not evidence of misbehaviour
+	require.True(t, input.GravityKeeper.GetPastEthSignatureCheckpoint(ctx, evmChainPrefix, checkpoint))
+}
+
+// Tests that extra fees are escrowed with their txs and paid to the orchestrator of the relayer of their batch
+func TestBatchExtraFees(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+
+	evmChainPrefix := EthChainPrefix
+	var (
+		mySender, e1            = sdk.AccAddressFromBech32("gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm")
+		myReceiver, e2          = types.NewEthAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		myTokenContractAddr, e3 = types.NewEthAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5") // Pickle
+		token, e4               = types.NewInternalERC20Token(sdk.NewInt(99999), myTokenContractAddr.GetAddress().Hex())
+		extraFeeDenom           = "stake"
+	)
+	require.NoError(t, e1)
+	require.NoError(t, e2)
+	require.NoError(t, e3)
+	require.NoError(t, e4)
+	allVouchers := sdk.NewCoins(token.GravityCoin(evmChainPrefix), sdk.NewInt64Coin(extraFeeDenom, 1000))
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.GravityKeeper.increaseBridgedSupplyOfCoins(ctx, evmChainPrefix, allVouchers)
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, allVouchers))
+	input.GravityKeeper.SetLastObservedEvmChainBlockHeight(ctx, evmChainPrefix, 1234567)
+
+	amount := sdk.NewCoin(token.GravityCoin(evmChainPrefix).Denom, sdk.NewInt(100))
+	fee := sdk.NewCoin(amount.Denom, sdk.NewInt(2))
+	extraFee := func(amount int64) *sdk.Coin {
+		coin := sdk.NewInt64Coin(extraFeeDenom, amount)
+		return &coin
+	}
+
+	// extra fees must be paid in a denom accepted by the evm chain
+	_, err := input.GravityKeeper.AddToOutgoingPoolWithExtraFee(ctx, evmChainPrefix, mySender, *myReceiver, amount, fee, extraFee(10))
+	require.Error(t, err)
+	params := input.GravityKeeper.GetParams(ctx)
+	params.GetEvmChain(evmChainPrefix).ExtraFeeDenoms = []string{extraFeeDenom}
+	input.GravityKeeper.SetParams(ctx, params)
+
+	_, err = input.GravityKeeper.AddToOutgoingPoolWithExtraFee(ctx, evmChainPrefix, mySender, *myReceiver, amount, fee, extraFee(10))
+	require.NoError(t, err)
+	_, err = input.GravityKeeper.AddToOutgoingPoolWithExtraFee(ctx, evmChainPrefix, mySender, *myReceiver, amount, fee, extraFee(5))
+	require.NoError(t, err)
+	_, err = input.GravityKeeper.AddToOutgoingPool(ctx, evmChainPrefix, mySender, *myReceiver, amount, fee)
+	require.NoError(t, err)
+	cancelledId, err := input.GravityKeeper.AddToOutgoingPoolWithExtraFee(ctx, evmChainPrefix, mySender, *myReceiver, amount, fee, extraFee(7))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(1000-22), input.BankKeeper.GetBalance(ctx, mySender, extraFeeDenom).Amount)
+
+	// cancelling a tx refunds its extra fee
+	require.NoError(t, input.GravityKeeper.RemoveFromOutgoingPoolAndRefund(ctx, evmChainPrefix, cancelledId, mySender))
+	require.Equal(t, sdk.NewInt(1000-15), input.BankKeeper.GetBalance(ctx, mySender, extraFeeDenom).Amount)
+
+	// both fee components are reported
+	fees := input.GravityKeeper.GetBatchFeeByTokenType(ctx, evmChainPrefix, *myTokenContractAddr, OutgoingTxBatchSize)
+	require.Equal(t, sdk.NewInt(6), fees.TotalFees)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(extraFeeDenom, 15)), fees.ExtraFees)
+
+	// the extra fees are a tip, they do not count toward the auto batch fee threshold
+	require.NoError(t, input.GravityKeeper.SetBatchStrategy(ctx, types.TokenBatchStrategy{
+		EvmChainPrefix:   evmChainPrefix,
+		TokenContract:    myTokenContractAddr.GetAddress().Hex(),
+		AutoBatchMinFees: sdk.NewInt(7),
+	}))
+	input.GravityKeeper.CreateAutoBatches(ctx, evmChainPrefix)
+	require.Empty(t, input.GravityKeeper.GetOutgoingTxBatches(ctx, evmChainPrefix))
+	input.GravityKeeper.DeleteBatchStrategy(ctx, evmChainPrefix, *myTokenContractAddr)
+
+	// the extra fees go to the orchestrator of the validator whose evm key relayed the batch
+	batch, err := input.GravityKeeper.BuildOutgoingTxBatch(ctx, evmChainPrefix, *myTokenContractAddr, OutgoingTxBatchSize)
+	require.NoError(t, err)
+	orchBalance := input.BankKeeper.GetBalance(ctx, OrchAddrs[0], extraFeeDenom).Amount
+	claim := types.MsgBatchSendToEthClaim{
+		EthBlockHeight: batch.CosmosBlockCreated,
+		BatchNonce:     batch.BatchNonce,
+		EvmChainPrefix: evmChainPrefix,
+		Relayer:        EthAddrs[0].String(),
+	}
+	input.GravityKeeper.OutgoingTxBatchExecuted(ctx, batch.TokenContract, claim)
+	require.Equal(t, orchBalance.AddRaw(15), input.BankKeeper.GetBalance(ctx, OrchAddrs[0], extraFeeDenom).Amount)
+
+	// the extra fees of a batch relayed by anyone else go to the community pool
+	_, err = input.GravityKeeper.AddToOutgoingPoolWithExtraFee(ctx, evmChainPrefix, mySender, *myReceiver, amount, fee, extraFee(3))
+	require.NoError(t, err)
+	batch, err = input.GravityKeeper.BuildOutgoingTxBatch(ctx, evmChainPrefix, *myTokenContractAddr, OutgoingTxBatchSize)
+	require.NoError(t, err)
+	communityPool := input.GravityKeeper.DistKeeper.GetFeePool(ctx).CommunityPool.AmountOf(extraFeeDenom)
+	claim.BatchNonce = batch.BatchNonce
+	claim.Relayer = myReceiver.GetAddress().Hex()
+	input.GravityKeeper.OutgoingTxBatchExecuted(ctx, batch.TokenContract, claim)
+	require.Equal(t, communityPool.Add(sdk.NewDec(3)), input.GravityKeeper.DistKeeper.GetFeePool(ctx).CommunityPool.AmountOf(extraFeeDenom))
+	require.True(t, input.BankKeeper.GetBalance(ctx, input.AccountKeeper.GetModuleAddress(types.ModuleName), extraFeeDenom).IsZero())
+}
+
+// Tests that the relayer only takes part in batch claims once the evm chain's RelayerInBatchClaims param is set
+func TestBatchClaimRelayerParam(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+
+	pk := input.GravityKeeper
+	msgServer := NewMsgServerImpl(pk)
+	claim := func(eventNonce uint64, orch sdk.AccAddress, relayer string) types.MsgBatchSendToEthClaim {
+		return types.MsgBatchSendToEthClaim{
+			EventNonce:     eventNonce,
+			EthBlockHeight: 100,
+			BatchNonce:     1,
+			TokenContract:  TokenContractAddrs[0],
+			Orchestrator:   orch.String(),
+			EvmChainPrefix: EthChainPrefix,
+			Relayer:        relayer,
+		}
+	}
+	attestations := func(eventNonce uint64) []types.Attestation {
+		attmap, _ := pk.GetAttestationMapping(ctx, EthChainPrefix)
+		return attmap[eventNonce]
+	}
+
+	// claims which do and do not report the relayer attest to the same batch, without the relayer
+	for i, relayer := range []string{EthAddrs[0].String(), ""} {
+		msg := claim(1, OrchAddrs[i], relayer)
+		_, err := msgServer.BatchSendToEthClaim(sdk.WrapSDKContext(ctx), &msg)
+		require.NoError(t, err)
+	}
+	require.Len(t, attestations(1), 1)
+	require.Len(t, attestations(1)[0].Votes, 2)
+	stored, err := pk.UnpackAttestationClaim(&attestations(1)[0])
+	require.NoError(t, err)
+	require.Empty(t, stored.(*types.MsgBatchSendToEthClaim).Relayer)
+
+	// once enabled the reported relayer is part of the claim
+	params := pk.GetParams(ctx)
+	params.GetEvmChain(EthChainPrefix).RelayerInBatchClaims = true
+	pk.SetParams(ctx, params)
+	for i, relayer := range []string{EthAddrs[0].String(), ""} {
+		msg := claim(2, OrchAddrs[i], relayer)
+		_, err := msgServer.BatchSendToEthClaim(sdk.WrapSDKContext(ctx), &msg)
+		require.NoError(t, err)
+	}
+	require.Len(t, attestations(2), 2)
+}
+
 func TestGetFees(t *testing.T) {
 
 	txs := []types.OutgoingTransferTx{
@@ -958,6 +1334,7 @@ func TestBatchConfirms(t *testing.T) {
 
 	// mint some voucher first
 	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.GravityKeeper.increaseBridgedSupplyOfCoins(ctx, EthChainPrefix, allVouchers)
 	// set senders balance
 	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
 	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, allVouchers))
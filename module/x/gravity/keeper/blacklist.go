@@ -0,0 +1,156 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+/*	Blacklist
+	Every evm chain has a blacklist of evm and bech32 addresses managed through AddBlacklistProposal and
+	RemoveBlacklistProposal, indexed by address so that a lookup does not depend on the length of the list. Evm
+	addresses are indexed by their 20 bytes and bech32 addresses by their account bytes, so that the same account is
+	blocked on every chain whatever the prefix it is given with. Entries may expire, expired entries no longer apply
+	and are pruned in the EndBlocker. The EthereumBlacklist of the EvmChainParam is deprecated, the v5 upgrade moved
+	its addresses to the blacklist.
+*/
+
+// GetBlacklistEntry returns the blacklist entry of `address` on the evm chain, expired or not, or nil if the address
+// is not on the blacklist or is not a valid address
+func (k Keeper) GetBlacklistEntry(ctx sdk.Context, evmChainPrefix string, address string) *types.BlacklistEntry {
+	addressBytes, err := types.BlacklistAddressBytes(address)
+	if err != nil {
+		return nil
+	}
+	return k.getBlacklistEntry(ctx, evmChainPrefix, addressBytes)
+}
+
+func (k Keeper) getBlacklistEntry(ctx sdk.Context, evmChainPrefix string, addressBytes []byte) *types.BlacklistEntry {
+	return getChainRecord[types.BlacklistEntry](ctx, k, types.GetBlacklistKey(evmChainPrefix, addressBytes))
+}
+
+// setBlacklistEntry stores a blacklist entry under its evm chain and address
+func (k Keeper) setBlacklistEntry(ctx sdk.Context, entry types.BlacklistEntry) error {
+	addressBytes, err := types.BlacklistAddressBytes(entry.Address)
+	if err != nil {
+		return sdkerrors.Wrap(types.ErrInvalid, err.Error())
+	}
+	k.setChainRecord(ctx, types.GetBlacklistKey(entry.EvmChainPrefix, addressBytes), &entry)
+	return nil
+}
+
+// deleteBlacklistEntry removes the blacklist entry of `address` on the evm chain, if any
+func (k Keeper) deleteBlacklistEntry(ctx sdk.Context, evmChainPrefix string, addressBytes []byte) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetBlacklistKey(evmChainPrefix, addressBytes))
+}
+
+// IterateBlacklist executes the given callback on every blacklist entry of the evm chain, expired or not
+// cb should return true to stop iteration, false to continue
+func (k Keeper) IterateBlacklist(ctx sdk.Context, evmChainPrefix string, cb func(entry types.BlacklistEntry) (stop bool)) {
+	iterateChainRecords(ctx, k, types.BlacklistKey, evmChainPrefix, false, cb)
+}
+
+// Blacklist returns every blacklist entry of the evm chain, expired or not
+func (k Keeper) Blacklist(ctx sdk.Context, evmChainPrefix string) []types.BlacklistEntry {
+	return chainRecords[types.BlacklistEntry](ctx, k, types.BlacklistKey, evmChainPrefix, false, 0)
+}
+
+// isBlacklisted returns true if the address indexed by `addressBytes` has an entry on the blacklist of the evm chain
+// which has not expired
+func (k Keeper) isBlacklisted(ctx sdk.Context, evmChainPrefix string, addressBytes []byte) bool {
+	entry := k.getBlacklistEntry(ctx, evmChainPrefix, addressBytes)
+	return entry != nil && !entry.IsExpired(uint64(ctx.BlockHeight()))
+}
+
+// IsOnCosmosBlacklist returns true if the account is blacklisted on the evm chain, under any bech32 prefix
+func (k Keeper) IsOnCosmosBlacklist(ctx sdk.Context, evmChainPrefix string, addr sdk.AccAddress) bool {
+	return k.isBlacklisted(ctx, evmChainPrefix, types.BlacklistCosmosAddressBytes(addr))
+}
+
+// addToBlacklist blacklists `addresses` on the evm chain until `expiryBlockHeight`, or forever if it is zero,
+// replacing the expiry of the addresses already on the blacklist
+func (k Keeper) addToBlacklist(ctx sdk.Context, evmChainPrefix string, addresses []string, expiryBlockHeight uint64) error {
+	if expiryBlockHeight != 0 && expiryBlockHeight <= uint64(ctx.BlockHeight()) {
+		return sdkerrors.Wrapf(types.ErrInvalid, "expiry block height %d has already passed", expiryBlockHeight)
+	}
+	for _, address := range addresses {
+		entry := types.BlacklistEntry{
+			EvmChainPrefix:    evmChainPrefix,
+			Address:           canonicalBlacklistAddress(address),
+			ExpiryBlockHeight: expiryBlockHeight,
+		}
+		if err := k.setBlacklistEntry(ctx, entry); err != nil {
+			return sdkerrors.Wrapf(err, "unable to blacklist %s", address)
+		}
+	}
+	return nil
+}
+
+// removeFromBlacklist lifts the blacklisting of `addresses` on the evm chain. Every address must be blacklisted,
+// otherwise nothing is removed
+func (k Keeper) removeFromBlacklist(ctx sdk.Context, evmChainPrefix string, addresses []string) error {
+	if k.GetEvmChainData(ctx, evmChainPrefix) == nil {
+		return sdkerrors.Wrapf(types.ErrEvmChainNotFound, "evm chain prefix %s", evmChainPrefix)
+	}
+
+	indexed := make([][]byte, 0, len(addresses))
+	for _, address := range addresses {
+		addressBytes, err := types.BlacklistAddressBytes(address)
+		if err != nil {
+			return sdkerrors.Wrap(types.ErrInvalid, err.Error())
+		}
+		if k.getBlacklistEntry(ctx, evmChainPrefix, addressBytes) == nil {
+			return sdkerrors.Wrapf(types.ErrInvalid, "%s is not blacklisted on %s", address, evmChainPrefix)
+		}
+		indexed = append(indexed, addressBytes)
+	}
+
+	for _, addressBytes := range indexed {
+		k.deleteBlacklistEntry(ctx, evmChainPrefix, addressBytes)
+	}
+	return nil
+}
+
+// PruneExpiredBlacklistEntries removes the blacklist entries of the evm chain which have expired
+func (k Keeper) PruneExpiredBlacklistEntries(ctx sdk.Context, evmChainPrefix string) {
+	height := uint64(ctx.BlockHeight())
+	var expired []types.BlacklistEntry
+	k.IterateBlacklist(ctx, evmChainPrefix, func(entry types.BlacklistEntry) (stop bool) {
+		if entry.IsExpired(height) {
+			expired = append(expired, entry)
+		}
+		return false
+	})
+	for _, entry := range expired {
+		addressBytes, err := types.BlacklistAddressBytes(entry.Address)
+		if err != nil {
+			panic(sdkerrors.Wrapf(err, "invalid blacklist entry %v", entry))
+		}
+		k.deleteBlacklistEntry(ctx, evmChainPrefix, addressBytes)
+	}
+}
+
+// migrateParamBlacklist moves the addresses of the deprecated EthereumBlacklist param of every evm chain to its
+// blacklist, where they never expire, and empties the param
+func (k Keeper) migrateParamBlacklist(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	for _, evmChainParam := range params.EvmChainParams {
+		for _, baddr := range evmChainParam.EthereumBlacklist {
+			if err := k.addToBlacklist(ctx, evmChainParam.EvmChainPrefix, []string{baddr}, 0); err != nil {
+				panic(sdkerrors.Wrapf(err, "invalid ethereum blacklist param of %s", evmChainParam.EvmChainPrefix))
+			}
+		}
+		evmChainParam.EthereumBlacklist = []string{}
+	}
+	k.SetParams(ctx, params)
+}
+
+// canonicalBlacklistAddress returns evm addresses in their checksummed form and any other address unchanged
+func canonicalBlacklistAddress(address string) string {
+	if ethAddr, err := types.NewEthAddress(address); err == nil {
+		return ethAddr.GetAddress().Hex()
+	}
+	return address
+}
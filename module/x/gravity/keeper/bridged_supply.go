@@ -0,0 +1,170 @@
+package keeper
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// The bridged supply ledger records, for each evm chain and token contract, how much of the token gravity accounts for:
+// the outstanding vouchers of evm originated tokens, which are minted by deposits and burned by executed batches and
+// logic calls, and the coins locked in the gravity module on behalf of the chain for cosmos originated tokens, which
+// are locked by sends to the chain and rewards and unlocked by refunds and deposits.
+// The ledger is kept up to date as the supply changes, so that reading it never needs a scan of the bank supply
+
+// GetTokenBridgedSupply returns the bridged supply of `tokenContract` on the given evm chain, zero if it has none
+func (k Keeper) GetTokenBridgedSupply(ctx sdk.Context, evmChainPrefix string, tokenContract types.EthAddress) sdk.Int {
+	supply := getChainRecord[types.ERC20Token](ctx, k, types.GetBridgedSupplyKey(evmChainPrefix, tokenContract))
+	if supply == nil {
+		return sdk.ZeroInt()
+	}
+	return supply.Amount
+}
+
+// setBridgedSupply stores the bridged supply of `tokenContract` on the given evm chain, entries are kept at zero so
+// that every token which was ever bridged remains in the snapshots of the chain
+func (k Keeper) setBridgedSupply(ctx sdk.Context, evmChainPrefix string, tokenContract types.EthAddress, amount sdk.Int) {
+	if amount.IsNegative() {
+		panic(sdkerrors.Wrapf(types.ErrInvalid, "negative bridged supply %v of %s on %s", amount, tokenContract.GetAddress().Hex(), evmChainPrefix))
+	}
+	supply := types.ERC20Token{Contract: tokenContract.GetAddress().Hex(), Amount: amount}
+	k.setChainRecord(ctx, types.GetBridgedSupplyKey(evmChainPrefix, tokenContract), &supply)
+}
+
+// increaseBridgedSupply adds `amount` to the bridged supply of `tokenContract` on the given evm chain
+func (k Keeper) increaseBridgedSupply(ctx sdk.Context, evmChainPrefix string, tokenContract types.EthAddress, amount sdk.Int) {
+	k.setBridgedSupply(ctx, evmChainPrefix, tokenContract, k.GetTokenBridgedSupply(ctx, evmChainPrefix, tokenContract).Add(amount))
+}
+
+// decreaseBridgedSupply removes `amount` from the bridged supply of `tokenContract` on the given evm chain. More
+// supply leaving the evm chain than the ledger accounts for is an error, which leaves the ledger untouched so that the
+// attestation or message causing it fails instead of halting the chain
+func (k Keeper) decreaseBridgedSupply(ctx sdk.Context, evmChainPrefix string, tokenContract types.EthAddress, amount sdk.Int) error {
+	current := k.GetTokenBridgedSupply(ctx, evmChainPrefix, tokenContract)
+	if current.LT(amount) {
+		return sdkerrors.Wrapf(types.ErrInvalid, "bridged supply %v of %s on %s is short of the %v leaving it",
+			current, tokenContract.GetAddress().Hex(), evmChainPrefix, amount)
+	}
+	k.setBridgedSupply(ctx, evmChainPrefix, tokenContract, current.Sub(amount))
+	return nil
+}
+
+// increaseBridgedSupplyOfCoins adds `coins` to the bridged supply of their token contracts on the given evm chain,
+// coins without an erc20 representation on the chain are not bridged and are ignored
+func (k Keeper) increaseBridgedSupplyOfCoins(ctx sdk.Context, evmChainPrefix string, coins sdk.Coins) {
+	for _, coin := range coins {
+		if _, tokenContract, err := k.DenomToERC20Lookup(ctx, evmChainPrefix, coin.Denom); err == nil {
+			k.increaseBridgedSupply(ctx, evmChainPrefix, *tokenContract, coin.Amount)
+		}
+	}
+}
+
+// decreaseBridgedSupplyOfCoins removes `coins` from the bridged supply of their token contracts on the given evm
+// chain, coins without an erc20 representation on the chain are not bridged and are ignored
+func (k Keeper) decreaseBridgedSupplyOfCoins(ctx sdk.Context, evmChainPrefix string, coins sdk.Coins) error {
+	for _, coin := range coins {
+		if _, tokenContract, err := k.DenomToERC20Lookup(ctx, evmChainPrefix, coin.Denom); err == nil {
+			if err := k.decreaseBridgedSupply(ctx, evmChainPrefix, *tokenContract, coin.Amount); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// IterateBridgedSupply executes the given callback on the bridged supply of each token of the evm chain, ordered by
+// token contract. cb should return true to stop iteration, false to continue
+func (k Keeper) IterateBridgedSupply(ctx sdk.Context, evmChainPrefix string, cb func(tokenContract types.EthAddress, amount sdk.Int) (stop bool)) {
+	iterateChainRecords(ctx, k, types.BridgedSupplyKey, evmChainPrefix, false, func(supply types.ERC20Token) (stop bool) {
+		tokenContract, err := types.NewEthAddress(supply.Contract)
+		if err != nil {
+			panic(sdkerrors.Wrapf(err, "invalid bridged supply contract %s on %s", supply.Contract, evmChainPrefix))
+		}
+		return cb(*tokenContract, supply.Amount)
+	})
+}
+
+// BridgedSupply returns the bridged supply ledger of the evm chain, ordered by token contract
+func (k Keeper) BridgedSupply(ctx sdk.Context, evmChainPrefix string) []types.ERC20Token {
+	return chainRecords[types.ERC20Token](ctx, k, types.BridgedSupplyKey, evmChainPrefix, false, 0)
+}
+
+// seedBridgedSupply fills the bridged supply ledger of every evm chain from the bank and the store, for chains which
+// bridged tokens before the ledger existed:
+// - the bridged supply of an evm originated token is the bank supply of its voucher
+// - the bridged supply of a cosmos originated token starts with the coins the chain itself holds in escrow: its unbatched
+// txs, batches and funded logic calls
+// - the rest of the module balance of a cosmos originated denom, less the coins which the module holds without them
+// backing an erc20 (deposits held by rate limits, pending IBC auto-forwards and escrowed extra fees), is what circulates
+// as erc20s. The store does not record which evm chain a denom represented on several chains was sent to, so this rest
+// goes to the first of those chains by prefix. Deposits beyond its ledger on the other chains fail and are quarantined
+func (k Keeper) seedBridgedSupply(ctx sdk.Context) {
+	evmChains := k.GetEvmChains(ctx)
+	sort.Slice(evmChains, func(i, j int) bool { return evmChains[i].EvmChainPrefix < evmChains[j].EvmChainPrefix })
+
+	unlocked := make(map[string]*sdk.Int)
+	escrows := make(map[string]map[string]*sdk.Int)
+	for _, evmChain := range evmChains {
+		prefix := evmChain.EvmChainPrefix
+		unlocked = sumHeldSendToCosmos(ctx, prefix, k, unlocked)
+		unlocked = sumPendingIbcAutoForwards(ctx, prefix, k, unlocked)
+		unlocked = sumExtraFeeModuleBalances(ctx, prefix, k, unlocked)
+
+		escrow := make(map[string]*sdk.Int)
+		escrow = sumUnbatchedTxModuleBalances(ctx, prefix, k, escrow)
+		escrow = sumUnconfirmedBatchModuleBalances(ctx, prefix, k, escrow)
+		escrow = sumOutgoingLogicCallModuleBalances(ctx, prefix, k, escrow)
+		escrows[prefix] = escrow
+		for denom, amount := range escrow {
+			if _, ok := unlocked[denom]; !ok {
+				zero := sdk.ZeroInt()
+				unlocked[denom] = &zero
+			}
+			*unlocked[denom] = unlocked[denom].Add(*amount)
+		}
+	}
+	modAcc := k.accountKeeper.GetModuleAddress(types.ModuleName)
+	circulating := make(map[string]bool)
+
+	for _, evmChain := range evmChains {
+		prefix := evmChain.EvmChainPrefix
+		k.bankKeeper.IterateTotalSupply(ctx, func(supply sdk.Coin) bool {
+			tokenContract, err := types.GravityDenomToERC20(prefix, supply.Denom)
+			if err != nil {
+				return false // not a voucher of this chain
+			}
+			if isCosmosOriginated, _ := k.ERC20ToDenomLookup(ctx, prefix, *tokenContract); !isCosmosOriginated {
+				k.setBridgedSupply(ctx, prefix, *tokenContract, supply.Amount)
+			}
+			return false
+		})
+
+		k.IterateERC20ToDenom(ctx, prefix, func(_ []byte, erc20ToDenom *types.ERC20ToDenom) bool {
+			tokenContract, err := types.NewEthAddress(erc20ToDenom.Erc20)
+			if err != nil {
+				panic(sdkerrors.Wrapf(err, "invalid cosmos originated erc20 %s on %s", erc20ToDenom.Erc20, prefix))
+			}
+			denom := erc20ToDenom.Denom
+			locked := sdk.ZeroInt()
+			if escrowed, ok := escrows[prefix][denom]; ok {
+				locked = *escrowed
+			}
+			if circulating[denom] {
+				k.logger(ctx).Info("Circulating supply of cosmos originated denom already attributed to another evm chain",
+					"denom", denom, "evm chain prefix", prefix)
+			} else {
+				circulating[denom] = true
+				balance := k.bankKeeper.GetBalance(ctx, modAcc, denom).Amount
+				if held, ok := unlocked[denom]; ok {
+					balance = balance.Sub(sdk.MinInt(balance, *held))
+				}
+				locked = locked.Add(balance)
+			}
+			k.setBridgedSupply(ctx, prefix, *tokenContract, locked)
+			return false
+		})
+	}
+}
@@ -0,0 +1,76 @@
+// Contains the store access shared by the per chain records keyed with types.AppendDelimitedChainPrefix
+/*
+The per chain stores of rate limits, bridge fees, blacklists and the like all hold one generated proto type under
+[store prefix][length][evm chain prefix][record id]. The delimited chain prefix makes iterating over the records of
+one chain exact, so the helpers below neither filter by the chain of each record nor need to know which record type
+they handle beyond unmarshalling it.
+*/
+
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// protoRecord is the pointer to a generated proto type T
+type protoRecord[T any] interface {
+	*T
+	codec.ProtoMarshaler
+}
+
+// getChainRecord returns the record stored under key, or nil if there is none
+func getChainRecord[T any, P protoRecord[T]](ctx sdk.Context, k Keeper, key []byte) *T {
+	bz := ctx.KVStore(k.storeKey).Get(key)
+	if len(bz) == 0 {
+		return nil
+	}
+	record := new(T)
+	k.cdc.MustUnmarshal(bz, P(record))
+	return record
+}
+
+// setChainRecord stores the record under key
+func (k Keeper) setChainRecord(ctx sdk.Context, key []byte, record codec.ProtoMarshaler) {
+	ctx.KVStore(k.storeKey).Set(key, k.cdc.MustMarshal(record))
+}
+
+// iterateChainRecords executes the given callback on each record stored under keyPrefix for the evm chain, in key
+// order or in reverse key order
+// cb should return true to stop iteration, false to continue
+func iterateChainRecords[T any, P protoRecord[T]](
+	ctx sdk.Context, k Keeper, keyPrefix []byte, evmChainPrefix string, reverse bool, cb func(record T) (stop bool),
+) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.AppendDelimitedChainPrefix(keyPrefix, evmChainPrefix))
+	var iter storetypes.Iterator
+	if reverse {
+		iter = prefixStore.ReverseIterator(nil, nil)
+	} else {
+		iter = prefixStore.Iterator(nil, nil)
+	}
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var record T
+		k.cdc.MustUnmarshal(iter.Value(), P(&record))
+		if cb(record) {
+			break
+		}
+	}
+}
+
+// chainRecords returns the records stored under keyPrefix for the evm chain in key order or in reverse key order,
+// at most `limit` of them unless limit is 0
+func chainRecords[T any, P protoRecord[T]](
+	ctx sdk.Context, k Keeper, keyPrefix []byte, evmChainPrefix string, reverse bool, limit uint64,
+) []T {
+	records := []T{}
+	iterateChainRecords[T, P](ctx, k, keyPrefix, evmChainPrefix, reverse, func(record T) (stop bool) {
+		records = append(records, record)
+		return limit != 0 && uint64(len(records)) >= limit
+	})
+	return records
+}
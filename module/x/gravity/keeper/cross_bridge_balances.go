@@ -1,5 +1,5 @@
 // This file deals with the MonitoredERC20Tokens list and their associated BridgeBalanceSnapshots,
-// which are store entries containing the Cosmos Height, claim Ethereum Height, and monitored erc20 bank supply for
+// which are store entries containing the Cosmos Height, claim Ethereum Height, and bridged supply of each erc20 for
 // each applied Attestation the moment after its changes take effect
 // These BridgeBalanceSnapshots are used both by the gravity module to
 package keeper
@@ -19,10 +19,10 @@ import (
 	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
 )
 
-// MonitoredERC20Tokens fetches the current list of ERC20 tokens which the Orchestrators should monitor
-func (k Keeper) MonitoredERC20Tokens(ctx sdk.Context) []types.EthAddress {
+// MonitoredERC20Tokens fetches the current list of ERC20 tokens which the Orchestrators of the given evm chain should monitor
+func (k Keeper) MonitoredERC20Tokens(ctx sdk.Context, evmChainPrefix string) []types.EthAddress {
 	store := ctx.KVStore(k.storeKey)
-	key := types.MonitoredERC20TokensKey
+	key := types.GetMonitoredERC20TokensKey(evmChainPrefix)
 	var addresses []types.EthAddress
 	if !store.Has(key) {
 		return addresses
@@ -35,11 +35,11 @@ func (k Keeper) MonitoredERC20Tokens(ctx sdk.Context) []types.EthAddress {
 	return types.FromMonitoredERC20Addresses(monitoredErc20s)
 }
 
-// setMonitoredERC20Tokens will update the list of ERC20 tokens which the Orchestrators should monitor,
+// setMonitoredERC20Tokens will update the list of ERC20 tokens which the Orchestrators of the given evm chain should monitor,
 // Note that this list should ONLY be updated via governance or as part of an upgrade which includes consensus on the token list!
-func (k Keeper) setMonitoredERC20Tokens(ctx sdk.Context, erc20s types.EthAddresses) {
+func (k Keeper) setMonitoredERC20Tokens(ctx sdk.Context, evmChainPrefix string, erc20s types.EthAddresses) {
 	store := ctx.KVStore(k.storeKey)
-	key := types.MonitoredERC20TokensKey
+	key := types.GetMonitoredERC20TokensKey(evmChainPrefix)
 
 	storeVal := erc20s.ToMonitoredERC20Addresses()
 	bytes := k.cdc.MustMarshal(&storeVal)
@@ -50,7 +50,7 @@ func (k Keeper) setMonitoredERC20Tokens(ctx sdk.Context, erc20s types.EthAddress
 // MonitoredERC20TokenDenoms fetches the MonitoredERC20Tokens, gets their denom equivalent from the store, and separates
 // the values into two slices, the cosmosOriginated denoms and the ethOriginated denoms (returned in that order)
 func (k Keeper) MonitoredERC20TokenDenoms(ctx sdk.Context, evmChainPrefix string) (cosmosOriginated []string, ethOriginated []string) {
-	monitoredTokens := k.MonitoredERC20Tokens(ctx)
+	monitoredTokens := k.MonitoredERC20Tokens(ctx, evmChainPrefix)
 	for _, token := range monitoredTokens {
 		isCosmosOriginated, denom := k.ERC20ToDenomLookup(ctx, evmChainPrefix, token)
 		if isCosmosOriginated {
@@ -63,17 +63,18 @@ func (k Keeper) MonitoredERC20TokenDenoms(ctx sdk.Context, evmChainPrefix string
 	return
 }
 
-// IterateBridgeBalanceSnapshots will call `cb` on every discovered BridgeBalanceSnapshot in the store,
+// IterateBridgeBalanceSnapshots will call `cb` on every discovered BridgeBalanceSnapshot of the given evm chain in the store,
 // returning early if `cb` returns true, additionally exposing the event nonce encoded in `key`
 // The snapshots are iterated in order of ascending event nonce (oldest first) if `reverse` is false,
 // ascending (newest first) otherwise
 func (k Keeper) IterateBridgeBalanceSnapshots(
 	ctx sdk.Context,
+	evmChainPrefix string,
 	reverse bool,
 	cb func(key []byte, snapshot types.BridgeBalanceSnapshot) (stop bool),
 ) {
 	store := ctx.KVStore(k.storeKey)
-	pref := types.BridgeBalanceSnapshotsKey
+	pref := types.AppendDelimitedChainPrefix(types.BridgeBalanceSnapshotsKey, evmChainPrefix)
 	prefixStore := prefix.NewStore(store, pref)
 	var iter db.Iterator
 	var lastNonce uint64
@@ -109,13 +110,13 @@ func (k Keeper) IterateBridgeBalanceSnapshots(
 	}
 }
 
-// CollectBridgeBalanceSnapshots will iterate through the snapshots in the store, collecting them into a slice
+// CollectBridgeBalanceSnapshots will iterate through the snapshots of the given evm chain in the store, collecting them into a slice
 // If `limit` is positive, only `limit` results will be returned
 // The snapshots are returned in order of ascending event nonce (oldest first) if `reverse` is false,
 // ascending (newest first) if true
-func (k Keeper) CollectBridgeBalanceSnapshots(ctx sdk.Context, reverse bool, limit uint64) []*types.BridgeBalanceSnapshot {
+func (k Keeper) CollectBridgeBalanceSnapshots(ctx sdk.Context, evmChainPrefix string, reverse bool, limit uint64) []*types.BridgeBalanceSnapshot {
 	var snapshots []*types.BridgeBalanceSnapshot
-	k.IterateBridgeBalanceSnapshots(ctx, reverse,
+	k.IterateBridgeBalanceSnapshots(ctx, evmChainPrefix, reverse,
 		func(key []byte, snapshot types.BridgeBalanceSnapshot) (stop bool) {
 			snapshots = append(snapshots, &snapshot)
 			return limit == uint64(len(snapshots)) // Halt now if the limit has been collected
@@ -139,34 +140,21 @@ func (k Keeper) updateBridgeBalanceSnapshots(ctx sdk.Context, claim types.Ethere
 }
 
 // FetchBridgeBalanceSnapshot creates a BridgeBalanceSnapshot for the given claim under
-// the BridgeBalanceSnapshotsKey + `claim`'s event nonce, populated with the supply from x/bank
+// the BridgeBalanceSnapshotsKey + `claim`'s evm chain prefix + `claim`'s event nonce, populated with the bridged supply ledger
 func (k Keeper) FetchBridgeBalanceSnapshot(ctx sdk.Context, claim types.EthereumClaim) types.BridgeBalanceSnapshot {
 	snapshotBalances := k.FetchBridgedTokenBalances(ctx, claim.GetEvmChainPrefix())
-	return types.NewBridgeBalanceSnapshot(uint64(ctx.BlockHeight()), claim.GetEthBlockHeight(), snapshotBalances, claim.GetEventNonce())
+	return types.NewBridgeBalanceSnapshot(
+		uint64(ctx.BlockHeight()), claim.GetEthBlockHeight(), claim.GetEvmChainPrefix(), snapshotBalances, claim.GetEventNonce(),
+	)
 }
 
-// FetchMonitoredERC20Supply collects the supply of each Monitored ERC20 Token's associated denom
-// For Ethereum originated assets, total supply is collected. For Cosmos originated assets,
-// just the gravity module's balances are collected. The balances are returned with the ERC20 address,
-// not the cosmos denom (e.g. gravity0x... or ugraviton)
+// FetchBridgedTokenBalances collects the bridged supply ledger of the given evm chain. For Ethereum originated assets
+// the ledger holds the outstanding vouchers, for Cosmos originated assets the coins locked in the gravity module for
+// the chain. The balances are returned with the ERC20 address, not the cosmos denom (e.g. gravity0x... or ugraviton)
 func (k Keeper) FetchBridgedTokenBalances(ctx sdk.Context, evmChainPrefix string) []*types.ERC20Token {
 	var balances []*types.ERC20Token
-	gravityModuleAcc := k.accountKeeper.GetModuleAccount(ctx, types.ModuleName).GetAddress()
-	k.bankKeeper.IterateTotalSupply(ctx, func(c sdk.Coin) bool {
-		isCosmosOriginated, erc20, err := k.DenomToERC20Lookup(ctx, evmChainPrefix, c.Denom)
-		if err != nil {
-			// Cosmos originated denom has not been deployed on ethereum, do nothing
-			return false
-		}
-
-		if isCosmosOriginated { // Cosmos originated asset, collect gravity module balance only
-			gravityBalance := k.bankKeeper.GetBalance(ctx, gravityModuleAcc, c.Denom)
-			token := types.ERC20Token{Contract: erc20.GetAddress().String(), Amount: gravityBalance.Amount}
-			balances = append(balances, &token)
-		} else { // Ethereum originated asset, collect the whole bank supply
-			token := types.ERC20Token{Contract: erc20.GetAddress().String(), Amount: c.Amount}
-			balances = append(balances, &token)
-		}
+	k.IterateBridgedSupply(ctx, evmChainPrefix, func(tokenContract types.EthAddress, amount sdk.Int) (stop bool) {
+		balances = append(balances, &types.ERC20Token{Contract: tokenContract.GetAddress().String(), Amount: amount})
 		return false
 	})
 
@@ -189,7 +177,7 @@ func (k Keeper) storeBridgeBalanceSnapshot(ctx sdk.Context, snapshot types.Bridg
 	store.Set(key, k.cdc.MustMarshal(&snapshot))
 }
 
-// deleteBridgeBalanceSnapshot deletes the snapshot with the given eventNonce, returning an error if no such entry exists
+// DeleteBridgeBalanceSnapshot deletes the snapshot with the given eventNonce and evm chain, returning an error if no such entry exists
 func (k Keeper) DeleteBridgeBalanceSnapshot(ctx sdk.Context, eventNonce uint64, evmChainPrefix string) error {
 	store := ctx.KVStore(k.storeKey)
 	key := types.GetBridgeBalanceSnapshotKey(eventNonce, evmChainPrefix)
@@ -208,14 +196,39 @@ func (k Keeper) DeleteBridgeBalanceSnapshot(ctx sdk.Context, eventNonce uint64,
 // AssertBridgeBalanceSanity compares the current (ultimate) and previous (penultimate) BridgeBalanceSnapshots against the
 // given Attestation to make sure that the actual token balances reflect what should have happened
 func (k Keeper) AssertBridgeBalanceSanity(ctx sdk.Context, claim types.EthereumClaim, expectedSupplyChange sdk.Coins) error {
-	snaps := k.CollectBridgeBalanceSnapshots(ctx, true, uint64(2))
+	snaps := k.CollectBridgeBalanceSnapshots(ctx, claim.GetEvmChainPrefix(), true, uint64(2))
 	if len(snaps) != 2 {
 		k.logger(ctx).Info("Too few snapshots stored to make assertions - skipping for now! There should only be at most 2 of these warnings.")
 		return nil
 	}
 	// The most recent (including att's state changes) and previous (not including att's state changes) snapshots
-	ultimate, penultimate := snaps[0], snaps[1]
+	return assertSnapshotsDiff(claim, *snaps[0], *snaps[1], expectedSupplyChange)
+}
+
+// refreshBridgeBalanceSnapshot replaces the latest snapshot of the evm chain with the current bridged supply, once
+// `claim`, observed earlier but applied only now, has changed it. Orchestrators compare the latest snapshot with the
+// balances of the bridge, which included the changes of `claim` all along. The replaced snapshot must differ from the
+// new one by `expectedSupplyChange`
+func (k Keeper) refreshBridgeBalanceSnapshot(ctx sdk.Context, claim types.EthereumClaim, expectedSupplyChange sdk.Coins) error {
+	snaps := k.CollectBridgeBalanceSnapshots(ctx, claim.GetEvmChainPrefix(), true, uint64(1))
+	if len(snaps) == 0 {
+		// no snapshot to replace, `claim` takes its place as the first one
+		return k.updateBridgeBalanceSnapshots(ctx, claim, expectedSupplyChange)
+	}
+	previous := *snaps[0]
+	snapshot := types.NewBridgeBalanceSnapshot(
+		uint64(ctx.BlockHeight()), previous.EthereumBlockHeight, previous.EvmChainPrefix,
+		k.FetchBridgedTokenBalances(ctx, previous.EvmChainPrefix), previous.EventNonce,
+	)
+	k.storeBridgeBalanceSnapshot(ctx, snapshot)
+	return assertSnapshotsDiff(claim, snapshot, previous, expectedSupplyChange)
+}
 
+// assertSnapshotsDiff checks that the bridged supply of `ultimate` differs from that of `penultimate` by exactly
+// `expectedSupplyChange`, the change `claim` should have made
+func assertSnapshotsDiff(
+	claim types.EthereumClaim, ultimate types.BridgeBalanceSnapshot, penultimate types.BridgeBalanceSnapshot, expectedSupplyChange sdk.Coins,
+) error {
 	ultBals, err := types.ERC20Tokens(ultimate.Balances).ToInternal()
 	if err != nil {
 		return fmt.Errorf("unable to convert latest bridge balances (%v) to internal type: %v", ultimate.Balances, err)
@@ -19,7 +19,7 @@ func TestGetBridgeBalanceSnapshots(t *testing.T) {
 	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
 
 	pk := input.GravityKeeper
-	tokens := pk.MonitoredERC20Tokens(ctx)
+	tokens := pk.MonitoredERC20Tokens(ctx, EthChainPrefix)
 
 	var balances []*types.ERC20Token
 	for _, t := range tokens {
@@ -41,18 +41,29 @@ func TestGetBridgeBalanceSnapshots(t *testing.T) {
 		snap := types.BridgeBalanceSnapshot{
 			CosmosBlockHeight:   uint64(ctx.BlockHeight()),
 			EthereumBlockHeight: uint64(1234567 + i),
+			EvmChainPrefix:      EthChainPrefix,
 			Balances:            balances,
 			EventNonce:          uint64(i + 1),
 		}
 		pk.storeBridgeBalanceSnapshot(ctx, snap)
 		snapshots[i] = snap
 	}
+	// Another chain's snapshots must not show up while iterating over EthChainPrefix, even with overlapping nonces
+	for i := 0; i < 10; i++ {
+		pk.storeBridgeBalanceSnapshot(ctx, types.BridgeBalanceSnapshot{
+			CosmosBlockHeight:   uint64(ctx.BlockHeight()),
+			EthereumBlockHeight: uint64(7654321 + i),
+			EvmChainPrefix:      BscChainPrefix,
+			Balances:            balances,
+			EventNonce:          uint64(i + 1),
+		})
+	}
 
 	// Iterate in ascending order
-	pk.IterateBridgeBalanceSnapshots(ctx, false,
+	pk.IterateBridgeBalanceSnapshots(ctx, EthChainPrefix, false,
 		func(key []byte, snapshot types.BridgeBalanceSnapshot) (stop bool) {
-			n, _, err := types.ExtractNonceFromBridgeBalanceSnapshotKey(key)
-			if err != nil || n != snapshot.EventNonce {
+			n, prefix, err := types.ExtractNonceFromBridgeBalanceSnapshotKey(key)
+			if err != nil || n != snapshot.EventNonce || prefix != EthChainPrefix {
 				panic(fmt.Sprintf("bad key (%v) snap (%v) nonce (%v): err %v", key, snapshot, snapshot.EventNonce, err))
 			}
 			expectedSnap := snapshots[snapshot.EventNonce-1]
@@ -61,7 +72,7 @@ func TestGetBridgeBalanceSnapshots(t *testing.T) {
 		},
 	)
 
-	collectedSnaps := pk.CollectBridgeBalanceSnapshots(ctx, false, uint64(numSnaps))
+	collectedSnaps := pk.CollectBridgeBalanceSnapshots(ctx, EthChainPrefix, false, uint64(numSnaps))
 	require.Equalf(t, len(collectedSnaps), len(snapshots),
 		"bad number of snaps returned (%v) compared to those stored (%v)",
 		len(collectedSnaps), len(snapshots),
@@ -75,6 +86,10 @@ func TestGetBridgeBalanceSnapshots(t *testing.T) {
 			require.Equal(t, expectedBalances[j], collectedBalances[j])
 		}
 	}
+
+	bscSnaps := pk.CollectBridgeBalanceSnapshots(ctx, BscChainPrefix, true, 0)
+	require.Len(t, bscSnaps, 10)
+	require.Equal(t, uint64(10), bscSnaps[0].EventNonce)
 }
 
 func Test_fetchAndStoreBridgeBalanceSnapshot(t *testing.T) {
@@ -82,7 +97,7 @@ func Test_fetchAndStoreBridgeBalanceSnapshot(t *testing.T) {
 	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
 
 	pk := input.GravityKeeper
-	tokens := pk.MonitoredERC20Tokens(ctx)
+	tokens := pk.MonitoredERC20Tokens(ctx, EthChainPrefix)
 	require.Greater(t, len(tokens), 1, "Need at least 2 monitored ERC20 tokens for this test")
 	var desiredSupplies sdk.Coins
 	for i, t := range tokens {
@@ -98,6 +113,10 @@ func Test_fetchAndStoreBridgeBalanceSnapshot(t *testing.T) {
 	err := bk.MintCoins(ctx, "gravity", desiredSupplies)
 	require.NoError(t, err)
 	bk.SendCoinsFromModuleToModule(ctx, "gravity", "bank", desiredSupplies)
+	// snapshots read the bridged supply ledger, which the minting of deposits keeps up to date
+	for _, coin := range desiredSupplies {
+		pk.increaseBridgedSupplyOfCoins(ctx, EthChainPrefix, sdk.NewCoins(coin))
+	}
 
 	claim := types.MsgBatchSendToEthClaim{
 		EventNonce:     1,
@@ -114,9 +133,12 @@ func Test_fetchAndStoreBridgeBalanceSnapshot(t *testing.T) {
 	require.NoError(t, err)
 
 	// Confirm that the newly stored snapshot is as expected
-	snaps := pk.CollectBridgeBalanceSnapshots(ctx, false, 1)
+	snaps := pk.CollectBridgeBalanceSnapshots(ctx, EthChainPrefix, false, 1)
 	require.Equal(t, len(snaps), 1)
+	require.Empty(t, pk.CollectBridgeBalanceSnapshots(ctx, BscChainPrefix, false, 0))
 	snap := snaps[0]
+	require.Len(t, snap.Balances, len(tokens))
+	require.Equal(t, snap.EvmChainPrefix, EthChainPrefix)
 	require.Equal(t, snap.CosmosBlockHeight, uint64(ctx.BlockHeight()))
 	require.Equal(t, snap.EthereumBlockHeight, uint64(12345))
 	for _, token := range snap.Balances {
@@ -128,3 +150,15 @@ func Test_fetchAndStoreBridgeBalanceSnapshot(t *testing.T) {
 		require.Equal(t, token.Amount, supply.Amount)
 	}
 }
+
+func TestMonitoredERC20TokensPerChain(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+
+	pk := input.GravityKeeper
+	pk.setMonitoredERC20Tokens(ctx, BscChainPrefix, TokenContracts[2:3])
+
+	require.Equal(t, []types.EthAddress{TokenContracts[0], TokenContracts[1]}, pk.MonitoredERC20Tokens(ctx, EthChainPrefix))
+	require.Equal(t, []types.EthAddress{TokenContracts[2]}, pk.MonitoredERC20Tokens(ctx, BscChainPrefix))
+	require.Empty(t, pk.MonitoredERC20Tokens(ctx, PolygonChainPrefix))
+}
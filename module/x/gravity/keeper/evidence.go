@@ -4,9 +4,12 @@ import (
 	"bytes"
 	"encoding/hex"
 	"fmt"
+	"strings"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
@@ -15,54 +18,183 @@ import (
 func (k Keeper) CheckBadSignatureEvidence(
 	ctx sdk.Context,
 	msg *types.MsgSubmitBadSignatureEvidence) error {
-	var subject types.EthereumSigned
+	subject, err := k.unpackEvidenceSubject(msg.Subject)
+	if err != nil {
+		return err
+	}
+	return k.checkBadSignatureEvidenceInternal(ctx, msg.EvmChainPrefix, subject, msg.Signature, msg.Sender)
+}
+
+func (k Keeper) checkBadSignatureEvidenceInternal(ctx sdk.Context, evmChainPrefix string, subject types.EthereumSigned, signature string, reporter string) error {
+	sigBytes, err := decodeEvidenceSignature(signature)
+	if err != nil {
+		return err
+	}
+
+	// Get the checkpoint of the supposed bad signature (fake valset, batch, or logic call submitted to evm) under the
+	// gravity id it was signed for, along with the offending validator. Checkpoints found in the archives are skipped,
+	// we don't slash for them because they are not bad signatures
+	signed, err := k.findSignedCheckpoint(ctx, evmChainPrefix, subject, sigBytes, true)
+	if err != nil {
+		return err
+	}
 
-	err := k.cdc.UnpackAny(msg.Subject, &subject)
+	return k.punishEvidence(ctx, types.EvidenceKindBadSignature, signed.evmChainPrefix, reporter, signed.validator, signed.signer, signed.checkpoint)
+}
+
+// CheckDoubleSignEvidence slashes the validator whose delegate ethereum key signed both subjects of the evidence, two
+// different valsets, batches or logic calls at the same nonce
+func (k Keeper) CheckDoubleSignEvidence(ctx sdk.Context, msg *types.MsgSubmitDoubleSignEvidence) error {
+	subjectA, err := k.unpackEvidenceSubject(msg.SubjectA)
+	if err != nil {
+		return err
+	}
+	subjectB, err := k.unpackEvidenceSubject(msg.SubjectB)
+	if err != nil {
+		return err
+	}
+	if err := types.SameSigningSlot(subjectA, subjectB); err != nil {
+		return err
+	}
+	sigA, err := decodeEvidenceSignature(msg.SignatureA)
+	if err != nil {
+		return err
+	}
+	sigB, err := decodeEvidenceSignature(msg.SignatureB)
+	if err != nil {
+		return err
+	}
 
+	// the first signature tells the gravity id both subjects were signed for and the offending validator
+	signed, err := k.findSignedCheckpoint(ctx, msg.EvmChainPrefix, subjectA, sigA, false)
 	if err != nil {
-		return sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf("Invalid Any encoded evidence %s", err))
+		return err
+	}
+	checkpointB := subjectB.GetCheckpoint(signed.gravityID)
+	if bytes.Equal(signed.checkpoint, checkpointB) {
+		return sdkerrors.Wrap(types.ErrInvalid, "Both subjects have the same checkpoint, cannot slash")
+	}
+	signerB, err := types.EthAddressFromSignature(checkpointB, sigB)
+	if err != nil || signerB.GetAddress() != signed.signer.GetAddress() {
+		return sdkerrors.Wrapf(types.ErrInvalid, "Second signature %s with checkpoint %s was not signed by %s", msg.SignatureB, hex.EncodeToString(checkpointB), signed.signer.GetAddress().Hex())
+	}
+	if k.checkpointRequested(ctx, signed.gravityID, signed.checkpoint) && k.checkpointRequested(ctx, signed.gravityID, checkpointB) {
+		return sdkerrors.Wrap(types.ErrInvalid, "Both checkpoints exist, cannot slash")
+	}
+
+	return k.punishEvidence(ctx, types.EvidenceKindDoubleSign, signed.evmChainPrefix, msg.Sender, signed.validator, signed.signer, signed.checkpoint, checkpointB)
+}
+
+func (k Keeper) unpackEvidenceSubject(any *codectypes.Any) (types.EthereumSigned, error) {
+	var subject types.EthereumSigned
+	if err := k.cdc.UnpackAny(any, &subject); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf("Invalid Any encoded evidence %s", err))
 	}
 
-	switch subject := subject.(type) {
+	switch subject.(type) {
 	case *types.OutgoingTxBatch, *types.Valset, *types.OutgoingLogicCall:
-		return k.checkBadSignatureEvidenceInternal(ctx, msg.EvmChainPrefix, subject, msg.Signature)
+		return subject, nil
 	default:
-		return sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf("Bad signature must be over a batch, valset, or logic call got %s", subject))
+		return nil, sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf("Bad signature must be over a batch, valset, or logic call got %s", subject))
 	}
 }
 
-func (k Keeper) checkBadSignatureEvidenceInternal(ctx sdk.Context, evmChainPrefix string, subject types.EthereumSigned, signature string) error {
-	// Get checkpoint of the supposed bad signature (fake valset, batch, or logic call submitted to evm)
-	gravityID := k.GetGravityID(ctx, evmChainPrefix)
-	checkpoint := subject.GetCheckpoint(gravityID)
+// decodeEvidenceSignature decodes a hex encoded eth signature, with or without its 0x prefix
+func decodeEvidenceSignature(signature string) ([]byte, error) {
+	sigBytes, err := hex.DecodeString(strings.TrimPrefix(signature, "0x"))
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf("signature decoding %s", signature))
+	}
+	return sigBytes, nil
+}
+
+// signedCheckpoint is the checkpoint of an evidence subject under the gravity id of an evm chain, along with the
+// validator whose delegate ethereum key signed it
+type signedCheckpoint struct {
+	evmChainPrefix string
+	gravityID      string
+	checkpoint     []byte
+	signer         types.EthAddress
+	validator      stakingtypes.Validator
+}
 
-	// Try to find the checkpoint in the archives. If it exists, we don't slash because
-	// this is not a bad signature
-	if k.GetPastEthSignatureCheckpoint(ctx, evmChainPrefix, checkpoint) {
-		return sdkerrors.Wrap(types.ErrInvalid, "Checkpoint exists, cannot slash")
+// findSignedCheckpoint computes the checkpoint of the subject under the gravity id of every evm chain, starting with
+// `evmChainPrefix` if it is set, until the signature recovers to the delegate ethereum key of a validator. This
+// catches a checkpoint of one chain replayed under the gravity id of another. With `skipRequested` the checkpoints
+// which were requested by a chain are skipped, since signing them is no misbehaviour
+func (k Keeper) findSignedCheckpoint(ctx sdk.Context, evmChainPrefix string, subject types.EthereumSigned, sigBytes []byte, skipRequested bool) (*signedCheckpoint, error) {
+	var prefixes []string
+	if evmChainPrefix != "" {
+		prefixes = append(prefixes, evmChainPrefix)
+	}
+	for _, evmChain := range k.GetEvmChains(ctx) {
+		if evmChain.EvmChainPrefix != evmChainPrefix {
+			prefixes = append(prefixes, evmChain.EvmChainPrefix)
+		}
 	}
 
-	// Decode Eth signature to bytes
+	tried := make(map[string]bool)
+	requested := false
+	for _, prefix := range prefixes {
+		gravityID := k.GetGravityID(ctx, prefix)
+		if gravityID == "" || tried[gravityID] {
+			continue
+		}
+		tried[gravityID] = true
 
-	// strip 0x prefix if needed
-	if signature[:2] == "0x" {
-		signature = signature[2:]
+		checkpoint := subject.GetCheckpoint(gravityID)
+		if skipRequested && k.checkpointRequested(ctx, gravityID, checkpoint) {
+			requested = true
+			continue
+		}
+		// recovery rewrites the recovery id of the signature, which must be reused for every gravity id
+		sig := make([]byte, len(sigBytes))
+		copy(sig, sigBytes)
+		// Get eth address of the offending validator using the checkpoint and the signature
+		evmAddress, err := types.EthAddressFromSignature(checkpoint, sig)
+		if err != nil {
+			return nil, sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf("signature to eth address failed with checkpoint %s and signature %s", hex.EncodeToString(checkpoint), hex.EncodeToString(sigBytes)))
+		}
+		// Find the offending validator by eth address
+		if val, found := k.GetValidatorByEvmAddress(ctx, *evmAddress); found {
+			return &signedCheckpoint{
+				evmChainPrefix: prefix,
+				gravityID:      gravityID,
+				checkpoint:     checkpoint,
+				signer:         *evmAddress,
+				validator:      val,
+			}, nil
+		}
 	}
-	sigBytes, err := hex.DecodeString(signature)
-	if err != nil {
-		return sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf("signature decoding %s", signature))
+	if requested {
+		return nil, sdkerrors.Wrap(types.ErrInvalid, "Checkpoint exists, cannot slash")
 	}
+	return nil, sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf("Did not find a validator for signature %s under the GravityID of any evm chain", hex.EncodeToString(sigBytes)))
+}
 
-	// Get eth address of the offending validator using the checkpoint and the signature
-	evmAddress, err := types.EthAddressFromSignature(checkpoint, sigBytes)
-	if err != nil {
-		return sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf("signature to eth address failed with checkpoint %s and signature %s", hex.EncodeToString(checkpoint), signature))
+// checkpointRequested tells whether the checkpoint was ever requested by an evm chain with the given gravity id
+func (k Keeper) checkpointRequested(ctx sdk.Context, gravityID string, checkpoint []byte) bool {
+	for _, evmChain := range k.GetEvmChains(ctx) {
+		if k.GetGravityID(ctx, evmChain.EvmChainPrefix) == gravityID && k.GetPastEthSignatureCheckpoint(ctx, evmChain.EvmChainPrefix, checkpoint) {
+			return true
+		}
 	}
+	return false
+}
 
-	// Find the offending validator by eth address
-	val, found := k.GetValidatorByEvmAddress(ctx, *evmAddress)
-	if !found {
-		return sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf("Did not find validator for eth address %s from signature %s with checkpoint %s and GravityID %s", evmAddress.GetAddress().Hex(), signature, hex.EncodeToString(checkpoint), gravityID))
+// punishEvidence jails and slashes the offending validator, pays the reporter its reward and records the evidence.
+// The same misbehaviour may only be recorded once, and never by the offender itself. Evidence against a validator
+// which is already jailed is refused without being recorded, so that it can still be punished once it is unjailed
+func (k Keeper) punishEvidence(ctx sdk.Context, kind string, evmChainPrefix string, reporter string, val stakingtypes.Validator, signer types.EthAddress, checkpoints ...[]byte) error {
+	id := types.EvidenceID(kind, signer, checkpoints...)
+	if k.GetEvidenceRecord(ctx, evmChainPrefix, id) != nil {
+		return sdkerrors.Wrapf(types.ErrDuplicate, "evidence %s already recorded", hex.EncodeToString(id))
+	}
+	if k.isOffenderAccount(ctx, reporter, val.GetOperator()) {
+		return sdkerrors.Wrapf(types.ErrInvalid, "reporter %s belongs to the offending validator %s", reporter, val.GetOperator())
+	}
+	if val.IsJailed() {
+		return sdkerrors.Wrapf(types.ErrInvalid, "validator %s is jailed, submit the evidence once it is unjailed", val.GetOperator())
 	}
 
 	// Slash the offending validator
@@ -71,13 +203,116 @@ func (k Keeper) checkBadSignatureEvidenceInternal(ctx sdk.Context, evmChainPrefi
 		return sdkerrors.Wrap(err, "Could not get consensus key address for validator")
 	}
 
+	bondDenom := k.StakingKeeper.GetParams(ctx).BondDenom
+	slashAmount := sdk.NewCoin(bondDenom, sdk.ZeroInt())
+	selfSlashed := sdk.NewCoin(bondDenom, sdk.ZeroInt())
 	params := k.GetParams(ctx)
-	if !val.IsJailed() {
-		k.StakingKeeper.Jail(ctx, cons)
-		k.StakingKeeper.Slash(ctx, cons, ctx.BlockHeight(), val.ConsensusPower(sdk.DefaultPowerReduction), params.SlashFractionBadEthSignature, 0)
+	selfDelegation, hasSelfDelegation := k.StakingKeeper.GetDelegation(ctx, sdk.AccAddress(val.GetOperator()), val.GetOperator())
+	k.StakingKeeper.Jail(ctx, cons)
+	k.StakingKeeper.Slash(ctx, cons, ctx.BlockHeight(), val.ConsensusPower(sdk.DefaultPowerReduction), params.SlashFractionBadEthSignature, 0)
+	if slashed, found := k.StakingKeeper.GetValidator(ctx, val.GetOperator()); found && slashed.GetTokens().LT(val.GetTokens()) {
+		slashAmount.Amount = val.GetTokens().Sub(slashed.GetTokens())
+		if hasSelfDelegation {
+			selfSlashed.Amount = val.TokensFromShares(selfDelegation.Shares).Sub(slashed.TokensFromShares(selfDelegation.Shares)).TruncateInt()
+		}
+	}
+	reward, err := k.payEvidenceReward(ctx, evmChainPrefix, reporter, selfSlashed)
+	if err != nil {
+		return err
+	}
+
+	record := types.EvidenceRecord{
+		Id:                 hex.EncodeToString(id),
+		Kind:               kind,
+		EvmChainPrefix:     evmChainPrefix,
+		Reporter:           reporter,
+		Offender:           val.GetOperator().String(),
+		OffenderEthAddress: signer.GetAddress().Hex(),
+		Checkpoints:        make([]string, len(checkpoints)),
+		SlashAmount:        slashAmount,
+		ReporterReward:     reward,
+		BlockHeight:        uint64(ctx.BlockHeight()),
+	}
+	for i, checkpoint := range checkpoints {
+		record.Checkpoints[i] = hex.EncodeToString(checkpoint)
+	}
+	k.setEvidenceRecord(ctx, record)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventEvidenceRecorded{
+		Id:             record.Id,
+		Kind:           record.Kind,
+		EvmChainPrefix: record.EvmChainPrefix,
+		Offender:       record.Offender,
+		Reporter:       record.Reporter,
+		SlashAmount:    record.SlashAmount.String(),
+		ReporterReward: record.ReporterReward.String(),
+	})
+}
+
+// isOffenderAccount tells whether the reporter is the operator account or the orchestrator of the offending validator
+func (k Keeper) isOffenderAccount(ctx sdk.Context, reporter string, offender sdk.ValAddress) bool {
+	reporterAddr, err := sdk.AccAddressFromBech32(reporter)
+	if err != nil {
+		return false
+	}
+	if reporterAddr.Equals(sdk.AccAddress(offender)) {
+		return true
+	}
+	orchValidator, found := k.GetOrchestratorValidatorAddr(ctx, reporterAddr)
+	return found && orchValidator.Equals(offender)
+}
+
+// payEvidenceReward pays the reporter of evidence the EvidenceReporterReward fraction of the slashed self-delegation
+// of the offender. The staking module burns the slashed tokens itself and offers no way to redirect part of them, so
+// the reward is minted back, relying on the Minter permission of the gravity module account. The reward never exceeds
+// MaxEvidenceReporterReward of the slashed tokens, so the bond denom supply still shrinks by the slash less the reward
+func (k Keeper) payEvidenceReward(ctx sdk.Context, evmChainPrefix string, reporter string, selfSlashed sdk.Coin) (sdk.Coin, error) {
+	reward := sdk.NewCoin(selfSlashed.Denom, sdk.ZeroInt())
+	evmChainParam := k.GetEvmChainParam(ctx, evmChainPrefix)
+	if evmChainParam == nil || evmChainParam.EvidenceReporterReward.IsNil() || !evmChainParam.EvidenceReporterReward.IsPositive() {
+		return reward, nil
+	}
+	reporterAddr, err := sdk.AccAddressFromBech32(reporter)
+	if err != nil {
+		k.logger(ctx).Info("Evidence reporter can not be rewarded", "reporter", reporter, "error", err)
+		return reward, nil
+	}
+	rewardFraction := sdk.MinDec(evmChainParam.EvidenceReporterReward, types.MaxEvidenceReporterReward)
+	reward.Amount = rewardFraction.MulInt(selfSlashed.Amount).TruncateInt()
+	if !reward.IsPositive() {
+		return reward, nil
+	}
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(reward)); err != nil {
+		return reward, sdkerrors.Wrap(err, "mint evidence reward")
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, reporterAddr, sdk.NewCoins(reward)); err != nil {
+		return reward, sdkerrors.Wrap(err, "send evidence reward")
 	}
+	return reward, nil
+}
+
+// GetEvidenceRecord returns the evidence record with the given id on the evm chain, nil if there is none
+func (k Keeper) GetEvidenceRecord(ctx sdk.Context, evmChainPrefix string, id []byte) *types.EvidenceRecord {
+	return getChainRecord[types.EvidenceRecord](ctx, k, types.GetEvidenceRecordKey(evmChainPrefix, id))
+}
+
+func (k Keeper) setEvidenceRecord(ctx sdk.Context, record types.EvidenceRecord) {
+	id, err := hex.DecodeString(record.Id)
+	if err != nil {
+		panic(sdkerrors.Wrapf(err, "invalid evidence id %s", record.Id))
+	}
+	k.setChainRecord(ctx, types.GetEvidenceRecordKey(record.EvmChainPrefix, id), &record)
+}
+
+// IterateEvidenceRecords executes the given callback on every evidence record of the evm chain, ordered by id.
+// cb should return true to stop iteration, false to continue
+func (k Keeper) IterateEvidenceRecords(ctx sdk.Context, evmChainPrefix string, cb func(record types.EvidenceRecord) (stop bool)) {
+	iterateChainRecords(ctx, k, types.EvidenceRecordKey, evmChainPrefix, false, cb)
+}
 
-	return nil
+// EvidenceRecords returns the evidence records of the evm chain, ordered by id
+func (k Keeper) EvidenceRecords(ctx sdk.Context, evmChainPrefix string) []types.EvidenceRecord {
+	return chainRecords[types.EvidenceRecord](ctx, k, types.EvidenceRecordKey, evmChainPrefix, false, 0)
 }
 
 // SetPastEthSignatureCheckpoint puts the checkpoint of a valset, batch, or logic call into a set
@@ -15,6 +15,15 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+// signEvidence returns the hex encoded signature of the checkpoint by a new ethereum key
+func signEvidence(t *testing.T, checkpoint []byte) string {
+	privKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	signature, err := types.NewEthereumSignature(checkpoint, privKey)
+	require.NoError(t, err)
+	return hex.EncodeToString(signature)
+}
+
 // nolint: exhaustruct
 func TestSubmitBadSignatureEvidenceBatchExists(t *testing.T) {
 	input := CreateTestEnv(t)
@@ -71,7 +80,7 @@ func TestSubmitBadSignatureEvidenceBatchExists(t *testing.T) {
 
 	msg := types.MsgSubmitBadSignatureEvidence{
 		Subject:        any,
-		Signature:      "foo",
+		Signature:      signEvidence(t, goodBatchExternal.GetCheckpoint(input.GravityKeeper.GetGravityID(ctx, evmChain.EvmChainPrefix))),
 		EvmChainPrefix: evmChain.EvmChainPrefix,
 	}
 
@@ -88,14 +97,14 @@ func TestSubmitBadSignatureEvidenceValsetExists(t *testing.T) {
 
 	// ctx := input.Context
 
-	valset := input.GravityKeeper.SetValsetRequest(ctx, evmChain.EvmChainPrefix)
+	valset := input.GravityKeeper.SetValsetRequest(ctx, evmChain.EvmChainPrefix, types.VALSET_REASON_UNSPECIFIED)
 
 	any, err := codectypes.NewAnyWithValue(&valset)
 	require.NoError(t, err)
 
 	msg := types.MsgSubmitBadSignatureEvidence{
 		Subject:        any,
-		Signature:      "foo",
+		Signature:      signEvidence(t, valset.GetCheckpoint(input.GravityKeeper.GetGravityID(ctx, evmChain.EvmChainPrefix))),
 		EvmChainPrefix: evmChain.EvmChainPrefix,
 	}
 
@@ -130,7 +139,7 @@ func TestSubmitBadSignatureEvidenceLogicCallExists(t *testing.T) {
 
 	msg := types.MsgSubmitBadSignatureEvidence{
 		Subject:        any,
-		Signature:      "foo",
+		Signature:      signEvidence(t, logicCall.GetCheckpoint(input.GravityKeeper.GetGravityID(ctx, evmChain.EvmChainPrefix))),
 		EvmChainPrefix: evmChain.EvmChainPrefix,
 	}
 
@@ -178,3 +187,209 @@ func TestSubmitBadSignatureEvidenceSlash(t *testing.T) {
 	val := input.StakingKeeper.Validator(ctx, ValAddrs[0])
 	require.True(t, val.IsJailed())
 }
+
+// nolint: exhaustruct
+func TestSubmitBadSignatureEvidenceJailedValidator(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+
+	batch := types.OutgoingTxBatch{
+		TokenContract: "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7",
+		BatchTimeout:  420,
+	}
+	checkpoint := batch.GetCheckpoint(input.GravityKeeper.GetGravityID(ctx, EthChainPrefix))
+	any, err := codectypes.NewAnyWithValue(&batch)
+	require.NoError(t, err)
+
+	privKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	ethAddress, err := types.NewEthAddress(crypto.PubkeyToAddress(privKey.PublicKey).String())
+	require.NoError(t, err)
+	input.GravityKeeper.SetEvmAddressForValidator(ctx, ValAddrs[0], *ethAddress)
+	ethSignature, err := types.NewEthereumSignature(checkpoint, privKey)
+	require.NoError(t, err)
+
+	msg := types.MsgSubmitBadSignatureEvidence{
+		Subject:        any,
+		Signature:      hex.EncodeToString(ethSignature),
+		Sender:         AccAddrs[2].String(),
+		EvmChainPrefix: EthChainPrefix,
+	}
+
+	// evidence against a jailed validator is refused and not recorded
+	val := input.StakingKeeper.Validator(ctx, ValAddrs[0])
+	cons, err := val.GetConsAddr()
+	require.NoError(t, err)
+	input.StakingKeeper.Jail(ctx, cons)
+	require.Error(t, input.GravityKeeper.CheckBadSignatureEvidence(ctx, &msg))
+	require.Empty(t, input.GravityKeeper.EvidenceRecords(ctx, EthChainPrefix))
+	require.Equal(t, val.GetTokens(), input.StakingKeeper.Validator(ctx, ValAddrs[0]).GetTokens())
+
+	// so that it can still be punished once the validator is unjailed
+	input.StakingKeeper.Unjail(ctx, cons)
+	require.NoError(t, input.GravityKeeper.CheckBadSignatureEvidence(ctx, &msg))
+	require.True(t, input.StakingKeeper.Validator(ctx, ValAddrs[0]).IsJailed())
+	records := input.GravityKeeper.EvidenceRecords(ctx, EthChainPrefix)
+	require.Len(t, records, 1)
+	require.True(t, records[0].SlashAmount.IsPositive())
+	require.True(t, input.StakingKeeper.Validator(ctx, ValAddrs[0]).GetTokens().LT(val.GetTokens()))
+}
+
+// nolint: exhaustruct
+func TestSubmitBadSignatureEvidenceOtherChain(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+
+	// a confirm meant for ethereum replayed under the gravity id of bsc
+	valset := types.Valset{Nonce: 42, Height: 42, RewardAmount: sdk.ZeroInt(), RewardToken: types.ZeroAddressString}
+	checkpoint := valset.GetCheckpoint(input.GravityKeeper.GetGravityID(ctx, BscChainPrefix))
+
+	any, err := codectypes.NewAnyWithValue(&valset)
+	require.NoError(t, err)
+
+	privKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	ethAddress, err := types.NewEthAddress(crypto.PubkeyToAddress(privKey.PublicKey).String())
+	require.NoError(t, err)
+	input.GravityKeeper.SetEvmAddressForValidator(ctx, ValAddrs[1], *ethAddress)
+
+	ethSignature, err := types.NewEthereumSignature(checkpoint, privKey)
+	require.NoError(t, err)
+
+	msg := types.MsgSubmitBadSignatureEvidence{
+		Subject:        any,
+		Signature:      hex.EncodeToString(ethSignature),
+		Sender:         AccAddrs[2].String(),
+		EvmChainPrefix: EthChainPrefix,
+	}
+	require.NoError(t, input.GravityKeeper.CheckBadSignatureEvidence(ctx, &msg))
+	require.True(t, input.StakingKeeper.Validator(ctx, ValAddrs[1]).IsJailed())
+
+	// the evidence is recorded against the chain whose gravity id was signed
+	require.Empty(t, input.GravityKeeper.EvidenceRecords(ctx, EthChainPrefix))
+	records := input.GravityKeeper.EvidenceRecords(ctx, BscChainPrefix)
+	require.Len(t, records, 1)
+	require.Equal(t, types.EvidenceKindBadSignature, records[0].Kind)
+	require.Equal(t, ValAddrs[1].String(), records[0].Offender)
+	require.Equal(t, ethAddress.GetAddress().Hex(), records[0].OffenderEthAddress)
+	require.Equal(t, []string{hex.EncodeToString(checkpoint)}, records[0].Checkpoints)
+	require.True(t, records[0].SlashAmount.IsPositive())
+	require.True(t, records[0].ReporterReward.IsZero())
+
+	// the same misbehaviour can not be reported twice
+	err = input.GravityKeeper.CheckBadSignatureEvidence(ctx, &msg)
+	require.ErrorIs(t, err, types.ErrDuplicate)
+}
+
+// nolint: exhaustruct
+func TestSubmitDoubleSignEvidence(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+
+	// pay a tenth of the slash to the reporter
+	params := input.GravityKeeper.GetParams(ctx)
+	for _, chain := range params.EvmChainParams {
+		if chain.EvmChainPrefix == EthChainPrefix {
+			chain.EvidenceReporterReward = sdk.NewDecWithPrec(1, 1)
+		}
+	}
+	input.GravityKeeper.SetParams(ctx, params)
+
+	gravityID := input.GravityKeeper.GetGravityID(ctx, EthChainPrefix)
+	valsetA := types.Valset{Nonce: 7, Height: 7, RewardAmount: sdk.ZeroInt(), RewardToken: types.ZeroAddressString}
+	valsetB := types.Valset{Nonce: 7, Height: 8, RewardAmount: sdk.OneInt(), RewardToken: types.ZeroAddressString}
+	otherNonce := types.Valset{Nonce: 8, Height: 8, RewardAmount: sdk.ZeroInt(), RewardToken: types.ZeroAddressString}
+
+	privKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	ethAddress, err := types.NewEthAddress(crypto.PubkeyToAddress(privKey.PublicKey).String())
+	require.NoError(t, err)
+	input.GravityKeeper.SetEvmAddressForValidator(ctx, ValAddrs[0], *ethAddress)
+
+	sign := func(subject *types.Valset) (*codectypes.Any, string) {
+		any, err := codectypes.NewAnyWithValue(subject)
+		require.NoError(t, err)
+		signature, err := types.NewEthereumSignature(subject.GetCheckpoint(gravityID), privKey)
+		require.NoError(t, err)
+		return any, hex.EncodeToString(signature)
+	}
+	anyA, sigA := sign(&valsetA)
+	anyB, sigB := sign(&valsetB)
+	anyOther, sigOther := sign(&otherNonce)
+
+	reporter := AccAddrs[3]
+	msg := types.MsgSubmitDoubleSignEvidence{
+		SubjectA:       anyA,
+		SignatureA:     sigA,
+		SubjectB:       anyOther,
+		SignatureB:     sigOther,
+		Sender:         reporter.String(),
+		EvmChainPrefix: EthChainPrefix,
+	}
+	// different nonces are not a double sign
+	require.Error(t, input.GravityKeeper.CheckDoubleSignEvidence(ctx, &msg))
+
+	// nor is signing the same checkpoint twice
+	msg.SubjectB, msg.SignatureB = anyA, sigA
+	require.Error(t, input.GravityKeeper.CheckDoubleSignEvidence(ctx, &msg))
+
+	// both signatures must be from the same key
+	msg.SubjectB, msg.SignatureB = anyB, signEvidence(t, valsetB.GetCheckpoint(gravityID))
+	require.Error(t, input.GravityKeeper.CheckDoubleSignEvidence(ctx, &msg))
+	require.False(t, input.StakingKeeper.Validator(ctx, ValAddrs[0]).IsJailed())
+
+	bondDenom := input.StakingKeeper.BondDenom(ctx)
+	balance := input.BankKeeper.GetBalance(ctx, reporter, bondDenom)
+	supply := input.BankKeeper.GetSupply(ctx, bondDenom)
+
+	// the offender can not report itself, from its operator account or its orchestrator
+	msg.SignatureB = sigB
+	for _, self := range []sdk.AccAddress{sdk.AccAddress(ValAddrs[0]), OrchAddrs[0]} {
+		msg.Sender = self.String()
+		require.Error(t, input.GravityKeeper.CheckDoubleSignEvidence(ctx, &msg))
+	}
+	require.False(t, input.StakingKeeper.Validator(ctx, ValAddrs[0]).IsJailed())
+
+	msg.Sender = reporter.String()
+	_, err = NewMsgServerImpl(input.GravityKeeper).SubmitDoubleSignEvidence(sdk.WrapSDKContext(ctx), &msg)
+	require.NoError(t, err)
+	require.True(t, input.StakingKeeper.Validator(ctx, ValAddrs[0]).IsJailed())
+
+	records := input.GravityKeeper.EvidenceRecords(ctx, EthChainPrefix)
+	require.Len(t, records, 1)
+	record := records[0]
+	require.NoError(t, record.ValidateBasic())
+	require.Equal(t, types.EvidenceKindDoubleSign, record.Kind)
+	require.Equal(t, reporter.String(), record.Reporter)
+	require.Equal(t, bondDenom, record.SlashAmount.Denom)
+	require.True(t, record.SlashAmount.IsPositive())
+	// the validator's stake is all self-delegated, so a tenth of the slash is paid
+	require.Equal(t, record.SlashAmount.Amount.QuoRaw(10), record.ReporterReward.Amount)
+	require.Equal(t, balance.Add(record.ReporterReward), input.BankKeeper.GetBalance(ctx, reporter, bondDenom))
+	// the reward is minted back out of the burned slash, the gravity module keeps none of it
+	require.Equal(t, supply.Sub(record.SlashAmount).Add(record.ReporterReward), input.BankKeeper.GetSupply(ctx, bondDenom))
+	require.True(t, input.BankKeeper.GetBalance(ctx, input.AccountKeeper.GetModuleAddress(types.ModuleName), bondDenom).IsZero())
+
+	// the subjects may be given in either order
+	msg.SubjectA, msg.SignatureA, msg.SubjectB, msg.SignatureB = anyB, sigB, anyA, sigA
+	err = input.GravityKeeper.CheckDoubleSignEvidence(ctx, &msg)
+	require.ErrorIs(t, err, types.ErrDuplicate)
+
+	// query the records by chain and by offender
+	res, err := input.GravityKeeper.GetEvidenceRecords(sdk.WrapSDKContext(ctx), &types.QueryEvidenceRecordsRequest{EvmChainPrefix: EthChainPrefix})
+	require.NoError(t, err)
+	require.Equal(t, records, res.Records)
+	res, err = input.GravityKeeper.GetEvidenceRecords(sdk.WrapSDKContext(ctx), &types.QueryEvidenceRecordsRequest{EvmChainPrefix: EthChainPrefix, Offender: ValAddrs[1].String()})
+	require.NoError(t, err)
+	require.Empty(t, res.Records)
+	_, err = input.GravityKeeper.GetEvidenceRecords(sdk.WrapSDKContext(ctx), &types.QueryEvidenceRecordsRequest{EvmChainPrefix: "unknown"})
+	require.Error(t, err)
+
+	// the records survive a genesis round trip
+	genesis := ExportGenesis(ctx, input.GravityKeeper)
+	for _, chain := range genesis.EvmChains {
+		if chain.EvmChain.EvmChainPrefix == EthChainPrefix {
+			require.Equal(t, records, chain.EvidenceRecords)
+		}
+	}
+}
@@ -0,0 +1,248 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v3 "github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/migrations/v3"
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+/*	EVM Chain Decommissioning
+	A RemoveEvmChainProposal does not remove the evm chain at once, it first puts the chain in a draining state until
+	its deadline: new withdrawals and batches are rejected, every block the outgoing pool and the batches which have
+	not been confirmed by any validator are refunded, held deposits are released and pending IBC Auto-Forwards are
+	sent, while pending claims keep being attested so that batches already signed can still execute or time out.
+	Once the deadline has passed the chain is removed as soon as the gravity module escrows nothing more for it, that
+	is as soon as its terms of the module balance invariant are all zero. The decommissioning report outlives the chain.
+*/
+
+// EvmChainDecommission returns the decommissioning report of the evm chain, or nil if it was never decommissioned
+func (k Keeper) EvmChainDecommission(ctx sdk.Context, evmChainPrefix string) *types.EvmChainDecommission {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetEvmChainDecommissionKey(evmChainPrefix))
+	if len(bz) == 0 {
+		return nil
+	}
+	var decommission types.EvmChainDecommission
+	k.cdc.MustUnmarshal(bz, &decommission)
+	return &decommission
+}
+
+// setEvmChainDecommission stores the decommissioning report of an evm chain
+func (k Keeper) setEvmChainDecommission(ctx sdk.Context, decommission types.EvmChainDecommission) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetEvmChainDecommissionKey(decommission.EvmChainPrefix), k.cdc.MustMarshal(&decommission))
+}
+
+// IterateEvmChainDecommissions executes the given callback on the decommissioning report of every evm chain
+// cb should return true to stop iteration, false to continue
+func (k Keeper) IterateEvmChainDecommissions(ctx sdk.Context, cb func(decommission types.EvmChainDecommission) (stop bool)) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.EvmChainDecommissionKey)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var decommission types.EvmChainDecommission
+		k.cdc.MustUnmarshal(iter.Value(), &decommission)
+		if cb(decommission) {
+			break
+		}
+	}
+}
+
+// EvmChainDecommissions returns the decommissioning report of every evm chain
+func (k Keeper) EvmChainDecommissions(ctx sdk.Context) []types.EvmChainDecommission {
+	var decommissions []types.EvmChainDecommission
+	k.IterateEvmChainDecommissions(ctx, func(decommission types.EvmChainDecommission) (stop bool) {
+		decommissions = append(decommissions, decommission)
+		return false
+	})
+	return decommissions
+}
+
+// IsEvmChainDraining returns true while the evm chain is being decommissioned and must not accept new withdrawals
+func (k Keeper) IsEvmChainDraining(ctx sdk.Context, evmChainPrefix string) bool {
+	decommission := k.EvmChainDecommission(ctx, evmChainPrefix)
+	return decommission != nil && decommission.IsDraining()
+}
+
+// startEvmChainDecommission puts the evm chain in the draining state for `drainBlocks` blocks, draining it a first
+// time immediately, the chain is removed right away if it has no deadline and nothing is escrowed for it
+func (k Keeper) startEvmChainDecommission(ctx sdk.Context, evmChainPrefix string, drainBlocks uint64) error {
+	height := uint64(ctx.BlockHeight())
+	decommission := types.EvmChainDecommission{
+		EvmChainPrefix:      evmChainPrefix,
+		Status:              types.EVM_CHAIN_DECOMMISSION_STATUS_DRAINING,
+		StartBlockHeight:    height,
+		DeadlineBlockHeight: height + drainBlocks,
+		RefundedAmounts:     sdk.Coins{},
+		EscrowedBalances:    sdk.Coins{},
+		OutstandingVouchers: sdk.Coins{},
+	}
+	k.setEvmChainDecommission(ctx, decommission)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventEvmChainDecommissionStarted{
+		EvmChainPrefix:      evmChainPrefix,
+		DeadlineBlockHeight: fmt.Sprint(decommission.DeadlineBlockHeight),
+	}); err != nil {
+		return err
+	}
+
+	k.ProcessEvmChainDecommission(ctx, evmChainPrefix)
+	return nil
+}
+
+// ProcessEvmChainDecommission drains a draining evm chain once more and removes it if its deadline has passed and
+// nothing is escrowed for it anymore, chains which are not draining are left untouched
+func (k Keeper) ProcessEvmChainDecommission(ctx sdk.Context, evmChainPrefix string) {
+	decommission := k.EvmChainDecommission(ctx, evmChainPrefix)
+	if decommission == nil || !decommission.IsDraining() {
+		return
+	}
+
+	k.drainEvmChain(ctx, decommission)
+	decommission.EscrowedBalances = k.evmChainEscrowedBalances(ctx, evmChainPrefix)
+	if uint64(ctx.BlockHeight()) < decommission.DeadlineBlockHeight || !decommission.EscrowedBalances.IsZero() {
+		k.setEvmChainDecommission(ctx, *decommission)
+		return
+	}
+
+	// remove in a cache context so that a failed removal leaves the chain draining for the next attempt
+	xCtx, commit := ctx.CacheContext()
+	if err := k.removeEvmChain(xCtx, *decommission); err != nil {
+		k.logger(ctx).Error("Unable to remove decommissioned evm chain", "evmChainPrefix", evmChainPrefix,
+			"cause", err.Error(),
+		)
+		k.setEvmChainDecommission(ctx, *decommission)
+		return
+	}
+	commit()
+	ctx.EventManager().EmitEvents(xCtx.EventManager().Events())
+}
+
+// drainEvmChain refunds every unbatched tx of the chain and cancels every batch which no validator confirmed, both
+// can no longer reach the evm chain. Held deposits are released regardless of the rate limits and pending IBC
+// Auto-Forwards are sent, so that the funds the chain escrows only go down. The refunds are tallied on `decommission`
+func (k Keeper) drainEvmChain(ctx sdk.Context, decommission *types.EvmChainDecommission) {
+	evmChainPrefix := decommission.EvmChainPrefix
+
+	for _, batch := range k.GetOutgoingTxBatches(ctx, evmChainPrefix) {
+		if len(k.GetBatchConfirmByNonceAndTokenContract(ctx, evmChainPrefix, batch.BatchNonce, batch.TokenContract)) != 0 {
+			continue // a confirmed batch may still be submitted, it must execute or time out
+		}
+		// cancel in a cache context so that a failed cancellation leaves the batch for the next attempt
+		xCtx, commit := ctx.CacheContext()
+		if err := k.CancelOutgoingTxBatch(xCtx, evmChainPrefix, batch.TokenContract, batch.BatchNonce); err != nil {
+			k.logger(ctx).Error("Unable to cancel unconfirmed batch of a draining chain", "evmChainPrefix", evmChainPrefix,
+				"batchNonce", batch.BatchNonce, "cause", err.Error(),
+			)
+			continue
+		}
+		commit()
+		ctx.EventManager().EmitEvents(xCtx.EventManager().Events())
+		decommission.CancelledBatches++
+	}
+
+	for _, tx := range k.GetUnbatchedTransactions(ctx, evmChainPrefix) {
+		_, denom := k.ERC20ToDenomLookup(ctx, evmChainPrefix, tx.Erc20Token.Contract)
+		refund := sdk.NewCoin(denom, tx.Erc20Token.Amount.Add(tx.Erc20Fee.Amount))
+
+		// refund in a cache context so that a failed refund leaves the tx in the pool for the next attempt
+		xCtx, commit := ctx.CacheContext()
+		if err := k.RemoveFromOutgoingPoolAndRefund(xCtx, evmChainPrefix, tx.Id, tx.Sender); err != nil {
+			k.logger(ctx).Error("Unable to refund withdrawal of a draining chain", "evmChainPrefix", evmChainPrefix,
+				"txId", tx.Id, "cause", err.Error(),
+			)
+			continue
+		}
+		commit()
+		ctx.EventManager().EmitEvents(xCtx.EventManager().Events())
+		decommission.RefundedTxs++
+		decommission.RefundedAmounts = decommission.RefundedAmounts.Add(refund)
+	}
+
+	for _, held := range k.AllHeldSendToCosmos(ctx, evmChainPrefix) {
+		xCtx, commit := ctx.CacheContext()
+		if err := k.releaseHeldSendToCosmos(xCtx, evmChainPrefix, held); err != nil {
+			k.logger(ctx).Error("Unable to release held SendToCosmos of a draining chain", "evmChainPrefix", evmChainPrefix,
+				"nonce", held.EventNonce, "cause", err.Error(),
+			)
+			continue
+		}
+		commit()
+		ctx.EventManager().EmitEvents(xCtx.EventManager().Events())
+	}
+
+	xCtx, commit := ctx.CacheContext()
+	if err := k.ProcessPendingIbcAutoForwards(xCtx, evmChainPrefix, types.MaxIbcAutoForwardsPerBlock); err != nil {
+		k.logger(ctx).Error("Unable to process Pending IBC Auto-Forwards of a draining chain", "evmChainPrefix", evmChainPrefix,
+			"cause", err.Error(),
+		)
+	} else {
+		commit()
+		ctx.EventManager().EmitEvents(xCtx.EventManager().Events())
+	}
+}
+
+// evmChainEscrowedBalances returns what the gravity module holds on behalf of the evm chain according to the module
+// balance invariant: unbatched txs, batches, logic calls, pending IBC Auto-Forwards and held deposits
+func (k Keeper) evmChainEscrowedBalances(ctx sdk.Context, evmChainPrefix string) sdk.Coins {
+	expectedBals := make(map[string]*sdk.Int)
+	expectedBals = sumUnconfirmedBatchModuleBalances(ctx, evmChainPrefix, k, expectedBals)
+	expectedBals = sumUnbatchedTxModuleBalances(ctx, evmChainPrefix, k, expectedBals)
+	expectedBals = sumOutgoingLogicCallModuleBalances(ctx, evmChainPrefix, k, expectedBals)
+	expectedBals = sumPendingIbcAutoForwards(ctx, evmChainPrefix, k, expectedBals)
+	expectedBals = sumHeldSendToCosmos(ctx, evmChainPrefix, k, expectedBals)
+
+	escrowed := sdk.Coins{}
+	for denom, amount := range expectedBals {
+		if amount.IsPositive() {
+			escrowed = escrowed.Add(sdk.NewCoin(denom, *amount))
+		}
+	}
+	return escrowed
+}
+
+// evmChainOutstandingVouchers returns the supply of every voucher minted for a token originating on the evm chain
+func (k Keeper) evmChainOutstandingVouchers(ctx sdk.Context, evmChainPrefix string) sdk.Coins {
+	outstanding := sdk.Coins{}
+	k.bankKeeper.IterateTotalSupply(ctx, func(c sdk.Coin) bool {
+		if _, err := types.GravityDenomToERC20(evmChainPrefix, c.Denom); err == nil {
+			outstanding = outstanding.Add(c)
+		}
+		return false
+	})
+	return outstanding
+}
+
+// removeEvmChain deletes the params and the whole state of a drained evm chain and completes its report
+func (k Keeper) removeEvmChain(ctx sdk.Context, decommission types.EvmChainDecommission) error {
+	evmChainPrefix := decommission.EvmChainPrefix
+	outstanding := k.evmChainOutstandingVouchers(ctx, evmChainPrefix)
+
+	var evmChainParams []*types.EvmChainParam
+	params := k.GetParams(ctx)
+	for _, param := range params.EvmChainParams {
+		if param.EvmChainPrefix == evmChainPrefix {
+			continue
+		}
+		evmChainParams = append(evmChainParams, param)
+	}
+	params.EvmChainParams = evmChainParams
+	k.SetParams(ctx, params)
+	if err := v3.RemoveEvmChainFromStore(ctx, k.storeKey, k.cdc, evmChainPrefix); err != nil {
+		return err
+	}
+
+	decommission.Status = types.EVM_CHAIN_DECOMMISSION_STATUS_REMOVED
+	decommission.RemovalBlockHeight = uint64(ctx.BlockHeight())
+	decommission.OutstandingVouchers = outstanding
+	k.setEvmChainDecommission(ctx, decommission)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventEvmChainRemoved{
+		EvmChainPrefix:      evmChainPrefix,
+		OutstandingVouchers: outstanding.String(),
+	})
+}
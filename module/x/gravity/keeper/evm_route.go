@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// parseEvmRoute returns the send to eth instructions of a SendToCosmos receiver which names an evm chain of the bridge,
+// either as <evm chain prefix>0x<dest>[:<bridge fee>[:<chain fee>]] or as a {"gravity":{...}} memo. Receivers which
+// are not meant for an evm chain return nil
+func (k Keeper) parseEvmRoute(ctx sdk.Context, receiver string) *types.SendToEthMemo {
+	memo, err := types.ParseSendToEthMemo(receiver)
+	if err != nil || memo == nil {
+		return nil
+	}
+	if k.GetEvmChainData(ctx, memo.EvmChainPrefix) == nil {
+		return nil
+	}
+	return memo
+}
+
+// evmRouteOwner returns the local account owning a routed deposit, which sends the forwarded transfer, receives it
+// if it is cancelled and receives the deposit itself if it can not be forwarded: the refund address of the memo.
+// Routes without a valid refund address have no owner, nobody could sign for an account derived from the evm sender
+func evmRouteOwner(memo types.SendToEthMemo) (sdk.AccAddress, bool) {
+	if memo.Refund == "" {
+		return nil, false
+	}
+	refund, err := sdk.AccAddressFromBech32(memo.Refund)
+	if err != nil {
+		return nil, false
+	}
+	return refund, true
+}
+
+// routeSendToEvmChain forwards `coin`, already minted or unlocked into the gravity module, to the outgoing pool of the
+// evm chain named by the receiver of the claim on behalf of the owner, the bridge and chain fees are paid out of the
+// deposit. Deposits which can not be forwarded, for example because the evm chain has no erc20 for the denom, are
+// delivered to the owner
+func (a AttestationHandler) routeSendToEvmChain(
+	ctx sdk.Context, claim types.MsgSendToCosmosClaim, tokenAddress types.EthAddress, evmChainSender types.EthAddress,
+	coin sdk.Coin, memo types.SendToEthMemo, owner sdk.AccAddress,
+) error {
+	xCtx, commit := ctx.CacheContext()
+	txID, err := a.forwardToEvmChain(xCtx, memo, owner, coin)
+	if err != nil {
+		a.keeper.logger(ctx).Info("SendToCosmos could not be forwarded to evm chain, sending to owner instead",
+			"cause", err.Error(), "evm chain prefix", memo.EvmChainPrefix, "owner", owner.String(),
+			"denom", coin.Denom, "amount", coin.Amount.String(), "nonce", claim.EventNonce,
+		)
+		localClaim := claim
+		localClaim.CosmosReceiver = owner.String()
+		return a.deliverSendToCosmos(ctx, localClaim, tokenAddress, evmChainSender, coin)
+	}
+	commit()
+	ctx.EventManager().EmitEvents(xCtx.EventManager().Events())
+
+	return ctx.EventManager().EmitTypedEvent(
+		&types.EventSendToCosmosForwardedToEvm{
+			Nonce:          strconv.Itoa(int(claim.GetEventNonce())),
+			Token:          tokenAddress.GetAddress().Hex(),
+			Amount:         claim.Amount.String(),
+			EvmChainPrefix: memo.EvmChainPrefix,
+			Destination:    memo.Destination,
+			TxId:           fmt.Sprint(txID),
+			Owner:          owner.String(),
+		},
+	)
+}
+
+// forwardToEvmChain moves `coin` from the gravity module to the owner and sends it on to the evm chain of the memo,
+// performing the same checks as MsgSendToEth
+func (a AttestationHandler) forwardToEvmChain(ctx sdk.Context, memo types.SendToEthMemo, owner sdk.AccAddress, coin sdk.Coin) (uint64, error) {
+	dest, err := types.NewEthAddress(memo.Destination)
+	if err != nil {
+		return 0, sdkerrors.Wrap(err, "invalid eth dest")
+	}
+	_, erc20, err := a.keeper.DenomToERC20Lookup(ctx, memo.EvmChainPrefix, coin.Denom)
+	if err != nil {
+		return 0, sdkerrors.Wrap(err, "invalid denom")
+	}
+	if a.keeper.InvalidSendToEthAddress(ctx, memo.EvmChainPrefix, *dest, *erc20) {
+		return 0, sdkerrors.Wrap(types.ErrInvalid, "destination address is invalid or blacklisted")
+	}
+	if a.keeper.IsOnCosmosBlacklist(ctx, memo.EvmChainPrefix, owner) {
+		return 0, sdkerrors.Wrapf(types.ErrBlacklisted, "owner %s", owner.String())
+	}
+
+	sendAmount, bridgeFee, chainFee, err := a.keeper.ibcSendToEthFees(ctx, memo, coin)
+	if err != nil {
+		return 0, err
+	}
+	if err := a.keeper.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, sdk.NewCoins(coin)); err != nil {
+		return 0, err
+	}
+	if err := a.keeper.checkAndDeductSendToEthFees(ctx, owner, sendAmount, chainFee); err != nil {
+		return 0, err
+	}
+	return a.keeper.AddToOutgoingPool(ctx, memo.EvmChainPrefix, owner, *dest, sendAmount, bridgeFee)
+}
@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// GetFailedAttestation returns the quarantined attestation of `eventNonce`, or nil if the attestation of that nonce
+// was applied successfully or has since been resolved
+func (k Keeper) GetFailedAttestation(ctx sdk.Context, evmChainPrefix string, eventNonce uint64) *types.FailedAttestation {
+	return getChainRecord[types.FailedAttestation](ctx, k, types.GetFailedAttestationKey(evmChainPrefix, eventNonce))
+}
+
+// setFailedAttestation stores a quarantined attestation under its evm chain and event nonce
+func (k Keeper) setFailedAttestation(ctx sdk.Context, failed types.FailedAttestation) {
+	k.setChainRecord(ctx, types.GetFailedAttestationKey(failed.EvmChainPrefix, failed.EventNonce), &failed)
+}
+
+// DeleteFailedAttestation removes the quarantined attestation of `eventNonce`, if any
+func (k Keeper) DeleteFailedAttestation(ctx sdk.Context, evmChainPrefix string, eventNonce uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetFailedAttestationKey(evmChainPrefix, eventNonce))
+}
+
+// IterateFailedAttestations executes the given callback on each quarantined attestation of the evm chain in order of
+// event nonce
+// cb should return true to stop iteration, false to continue
+func (k Keeper) IterateFailedAttestations(ctx sdk.Context, evmChainPrefix string, cb func(failed types.FailedAttestation) (stop bool)) {
+	iterateChainRecords(ctx, k, types.FailedAttestationKey, evmChainPrefix, false, cb)
+}
+
+// FailedAttestations returns every quarantined attestation of the evm chain, ordered by event nonce
+func (k Keeper) FailedAttestations(ctx sdk.Context, evmChainPrefix string) []types.FailedAttestation {
+	return chainRecords[types.FailedAttestation](ctx, k, types.FailedAttestationKey, evmChainPrefix, false, 0)
+}
+
+// quarantineAttestation records an observed attestation whose handler returned `cause`, so that governance may
+// retry it once the problem has been fixed, and emits an EventAttestationFailed type event
+func (k Keeper) quarantineAttestation(ctx sdk.Context, att types.Attestation, claim types.EthereumClaim, cause error) {
+	k.setFailedAttestation(ctx, types.FailedAttestation{
+		EvmChainPrefix:    claim.GetEvmChainPrefix(),
+		EventNonce:        claim.GetEventNonce(),
+		Attestation:       att,
+		Error:             cause.Error(),
+		FailedBlockHeight: uint64(ctx.BlockHeight()),
+	})
+
+	ctx.EventManager().EmitTypedEvent(&types.EventAttestationFailed{
+		Nonce:           fmt.Sprint(claim.GetEventNonce()),
+		EvmChainPrefix:  claim.GetEvmChainPrefix(),
+		AttestationType: string(claim.GetType()),
+		Error:           cause.Error(),
+	})
+}
+
+// resolveFailedAttestation applies the quarantined attestation of `eventNonce` to the state once more. Given a
+// `refundReceiver` the failed SendToCosmos deposit is delivered there instead of its original receiver, other claim
+// types can only be re-executed unchanged. Should the handler fail again nothing is written and the attestation stays
+// quarantined.
+func (k Keeper) resolveFailedAttestation(ctx sdk.Context, evmChainPrefix string, eventNonce uint64, refundReceiver string) error {
+	failed := k.GetFailedAttestation(ctx, evmChainPrefix, eventNonce)
+	if failed == nil {
+		return sdkerrors.Wrapf(types.ErrInvalid, "no failed attestation with nonce %d on %s", eventNonce, evmChainPrefix)
+	}
+	claim, err := k.UnpackAttestationClaim(&failed.Attestation)
+	if err != nil {
+		return sdkerrors.Wrap(err, "unable to unpack failed attestation claim")
+	}
+	if refundReceiver != "" {
+		sendToCosmos, ok := claim.(*types.MsgSendToCosmosClaim)
+		if !ok {
+			return sdkerrors.Wrapf(types.ErrInvalid, "cannot refund a failed %s attestation", claim.GetType())
+		}
+		refund := *sendToCosmos
+		refund.CosmosReceiver = refundReceiver
+		claim = &refund
+	}
+
+	// the expected change depends on the state before the claim is applied, as in TryAttestation
+	expectedSupplyChange, err := k.ExpectedSupplyChange(ctx, claim)
+	if err != nil || expectedSupplyChange == nil {
+		return sdkerrors.Wrapf(types.ErrInvalid, "unable to compute the supply change of failed attestation with nonce %d on %s: %v", eventNonce, evmChainPrefix, err)
+	}
+
+	xCtx, commit := ctx.CacheContext()
+	if err := k.AttestationHandler.Handle(xCtx, failed.Attestation, claim); err != nil {
+		return sdkerrors.Wrapf(err, "failed attestation with nonce %d on %s failed again", eventNonce, evmChainPrefix)
+	}
+	commit()
+	ctx.EventManager().EmitEvents(xCtx.EventManager().Events())
+	k.DeleteFailedAttestation(ctx, evmChainPrefix, eventNonce)
+
+	// the bridged supply only changes now, the latest snapshot must account for it
+	if err := k.refreshBridgeBalanceSnapshot(ctx, claim, *expectedSupplyChange); err != nil {
+		k.logger(ctx).Error("Bridge balance snapshot mismatch after resolving failed attestation",
+			"cause", err.Error(), "nonce", eventNonce, "evmChainPrefix", evmChainPrefix)
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventFailedAttestationResolved{
+		Nonce:           fmt.Sprint(eventNonce),
+		EvmChainPrefix:  evmChainPrefix,
+		AttestationType: string(claim.GetType()),
+		RefundReceiver:  refundReceiver,
+	})
+}
@@ -153,6 +153,106 @@ func initBridgeDataFromGenesis(ctx sdk.Context, k Keeper, data types.EvmChainDat
 		k.setCosmosOriginatedDenomToERC20(ctx, evmChainPrefix, item.Denom, *ethAddr)
 	}
 
+	// reset rate limits and the deposits they hold back in state
+	for _, rateLimit := range data.RateLimits {
+		if rateLimit.EvmChainPrefix != evmChainPrefix {
+			panic(fmt.Sprintf("rate limit on %s found in the genesis data of %s", rateLimit.EvmChainPrefix, evmChainPrefix))
+		}
+		k.SetRateLimit(ctx, rateLimit)
+	}
+	for _, held := range data.HeldSendToCosmos {
+		k.setHeldSendToCosmos(ctx, evmChainPrefix, held)
+	}
+	for _, flow := range data.RateLimitFlows {
+		if err := flow.ValidateBasic(); err != nil {
+			panic(sdkerrors.Wrapf(err, "invalid rate limit flow in the genesis data of %s", evmChainPrefix))
+		}
+		if k.GetRateLimit(ctx, evmChainPrefix, flow.Denom) == nil {
+			panic(fmt.Sprintf("rate limit flow of %s without a rate limit in the genesis data of %s", flow.Denom, evmChainPrefix))
+		}
+		k.setRateLimitFlow(ctx, evmChainPrefix, flow.Denom, flow)
+	}
+
+	// reset the default bridge fees of IBC transfers in state
+	for _, fee := range data.IbcBridgeFees {
+		if fee.EvmChainPrefix != evmChainPrefix {
+			panic(fmt.Sprintf("IBC bridge fee on %s found in the genesis data of %s", fee.EvmChainPrefix, evmChainPrefix))
+		}
+		k.SetIbcBridgeFee(ctx, fee)
+	}
+
+	// reset the IBC packet origins of pool txs created by the ibc middleware in state
+	for _, origin := range data.IbcTransferOrigins {
+		if origin.EvmChainPrefix != evmChainPrefix {
+			panic(fmt.Sprintf("IBC transfer origin on %s found in the genesis data of %s", origin.EvmChainPrefix, evmChainPrefix))
+		}
+		k.SetIbcTransferOrigin(ctx, origin)
+	}
+
+	// reset the logs of processed IBC auto forwards in state
+	for _, log := range data.IbcAutoForwardLogs {
+		if log.EvmChainPrefix != evmChainPrefix {
+			panic(fmt.Sprintf("IBC auto forward log on %s found in the genesis data of %s", log.EvmChainPrefix, evmChainPrefix))
+		}
+		k.setIbcAutoForwardLog(ctx, log)
+	}
+
+	// reset the ibc transfers sent for IBC auto forwards in state
+	for _, transfer := range data.IbcAutoForwardTransfers {
+		if transfer.EvmChainPrefix != evmChainPrefix {
+			panic(fmt.Sprintf("IBC auto forward transfer on %s found in the genesis data of %s", transfer.EvmChainPrefix, evmChainPrefix))
+		}
+		k.setIbcAutoForwardTransfer(ctx, transfer)
+	}
+
+	// reset the quarantined attestations in state
+	for _, failed := range data.FailedAttestations {
+		if failed.EvmChainPrefix != evmChainPrefix {
+			panic(fmt.Sprintf("Failed attestation on %s found in the genesis data of %s", failed.EvmChainPrefix, evmChainPrefix))
+		}
+		k.setFailedAttestation(ctx, failed)
+	}
+
+	// reset the blacklist in state
+	for _, entry := range data.Blacklist {
+		if entry.EvmChainPrefix != evmChainPrefix {
+			panic(fmt.Sprintf("Blacklist entry on %s found in the genesis data of %s", entry.EvmChainPrefix, evmChainPrefix))
+		}
+		if err := k.setBlacklistEntry(ctx, entry); err != nil {
+			panic(sdkerrors.Wrapf(err, "invalid blacklist entry %v in genesis", entry))
+		}
+	}
+
+	// reset the batch strategies in state
+	for _, strategy := range data.BatchStrategies {
+		if strategy.EvmChainPrefix != evmChainPrefix {
+			panic(fmt.Sprintf("Batch strategy on %s found in the genesis data of %s", strategy.EvmChainPrefix, evmChainPrefix))
+		}
+		if err := k.SetBatchStrategy(ctx, strategy); err != nil {
+			panic(sdkerrors.Wrapf(err, "invalid batch strategy %v in genesis", strategy))
+		}
+	}
+
+	// reset the bridged supply ledger in state
+	for _, supply := range data.BridgedSupply {
+		token, err := supply.ToInternal()
+		if err != nil {
+			panic(sdkerrors.Wrapf(err, "invalid bridged supply %v in genesis", supply))
+		}
+		k.setBridgedSupply(ctx, evmChainPrefix, token.Contract, token.Amount)
+	}
+
+	// reset the evidence records in state
+	for _, record := range data.EvidenceRecords {
+		if record.EvmChainPrefix != evmChainPrefix {
+			panic(fmt.Sprintf("Evidence record on %s found in the genesis data of %s", record.EvmChainPrefix, evmChainPrefix))
+		}
+		if err := record.ValidateBasic(); err != nil {
+			panic(sdkerrors.Wrapf(err, "invalid evidence record %v in genesis", record))
+		}
+		k.setEvidenceRecord(ctx, record)
+	}
+
 	// now that we have the denom-erc20 mapping we need to validate
 	// that the valset reward is possible and cosmos originated remove
 	// this if you want a non-cosmos originated reward
@@ -184,6 +284,17 @@ func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 
 		initBridgeDataFromGenesis(ctx, k, evmChain)
 	}
+
+	// reset the decommissioning reports, a draining chain must still be part of the genesis
+	for _, decommission := range data.EvmChainDecommissions {
+		if decommission.IsDraining() && k.GetEvmChainData(ctx, decommission.EvmChainPrefix) == nil {
+			panic(fmt.Sprintf("Draining evm chain %s not found in genesis", decommission.EvmChainPrefix))
+		}
+		k.setEvmChainDecommission(ctx, decommission)
+	}
+
+	// genesis files exported before v5 may still list blacklisted addresses in the deprecated param
+	k.migrateParamBlacklist(ctx)
 }
 
 func hasDuplicates(d []types.MsgSetOrchestratorAddress) bool {
@@ -274,21 +385,34 @@ func ExportGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
 				LastTxPoolId:               k.getID(ctx, types.AppendChainPrefix(types.KeyLastTXPoolID, evmChain.EvmChainPrefix)),
 				LastBatchId:                k.getID(ctx, types.AppendChainPrefix(types.KeyLastOutgoingBatchID, evmChain.EvmChainPrefix)),
 			},
-			Valsets:            valsets,
-			ValsetConfirms:     vsconfs,
-			Batches:            extBatches,
-			BatchConfirms:      batchconfs,
-			LogicCalls:         calls,
-			LogicCallConfirms:  callconfs,
-			Attestations:       attestations,
-			DelegateKeys:       delegates,
-			Erc20ToDenoms:      erc20ToDenoms,
-			UnbatchedTransfers: unbatchedTxs,
+			Valsets:                 valsets,
+			ValsetConfirms:          vsconfs,
+			Batches:                 extBatches,
+			BatchConfirms:           batchconfs,
+			LogicCalls:              calls,
+			LogicCallConfirms:       callconfs,
+			Attestations:            attestations,
+			DelegateKeys:            delegates,
+			Erc20ToDenoms:           erc20ToDenoms,
+			UnbatchedTransfers:      unbatchedTxs,
+			RateLimits:              k.RateLimits(ctx, evmChain.EvmChainPrefix),
+			HeldSendToCosmos:        k.AllHeldSendToCosmos(ctx, evmChain.EvmChainPrefix),
+			RateLimitFlows:          k.RateLimitFlows(ctx, evmChain.EvmChainPrefix),
+			IbcBridgeFees:           k.IbcBridgeFees(ctx, evmChain.EvmChainPrefix),
+			IbcTransferOrigins:      k.IbcTransferOrigins(ctx, evmChain.EvmChainPrefix),
+			IbcAutoForwardLogs:      k.IbcAutoForwardLogs(ctx, evmChain.EvmChainPrefix, 0),
+			IbcAutoForwardTransfers: k.IbcAutoForwardTransfers(ctx, evmChain.EvmChainPrefix),
+			FailedAttestations:      k.FailedAttestations(ctx, evmChain.EvmChainPrefix),
+			Blacklist:               k.Blacklist(ctx, evmChain.EvmChainPrefix),
+			BatchStrategies:         k.BatchStrategies(ctx, evmChain.EvmChainPrefix),
+			BridgedSupply:           k.BridgedSupply(ctx, evmChain.EvmChainPrefix),
+			EvidenceRecords:         k.EvidenceRecords(ctx, evmChain.EvmChainPrefix),
 		}
 	}
 
 	return types.GenesisState{
-		Params:    &p,
-		EvmChains: evmChains,
+		Params:                &p,
+		EvmChains:             evmChains,
+		EvmChainDecommissions: k.EvmChainDecommissions(ctx),
 	}
 }
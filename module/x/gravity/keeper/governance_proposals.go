@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"strings"
 
-	v3 "github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/migrations/v3"
 	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -57,6 +56,54 @@ func RegisterProposalTypes() {
 		govtypes.RegisterProposalType(types.ProposalTypeMonitoredERC20Tokens)
 		govtypes.RegisterProposalTypeCodec(&types.MonitoredERC20TokensProposal{}, monitoredERC20Tokens)
 	}
+
+	outgoingLogicCall := "gravity/OutgoingLogicCall"
+	if !govtypes.IsValidProposalType(strings.TrimPrefix(outgoingLogicCall, prefix)) {
+		govtypes.RegisterProposalType(types.ProposalTypeOutgoingLogicCall)
+		govtypes.RegisterProposalTypeCodec(&types.OutgoingLogicCallProposal{}, outgoingLogicCall)
+	}
+
+	setRateLimit := "gravity/SetRateLimit"
+	if !govtypes.IsValidProposalType(strings.TrimPrefix(setRateLimit, prefix)) {
+		govtypes.RegisterProposalType(types.ProposalTypeSetRateLimit)
+		govtypes.RegisterProposalTypeCodec(&types.SetRateLimitProposal{}, setRateLimit)
+	}
+
+	releaseHeldSendToCosmos := "gravity/ReleaseHeldSendToCosmos"
+	if !govtypes.IsValidProposalType(strings.TrimPrefix(releaseHeldSendToCosmos, prefix)) {
+		govtypes.RegisterProposalType(types.ProposalTypeReleaseHeldSendToCosmos)
+		govtypes.RegisterProposalTypeCodec(&types.ReleaseHeldSendToCosmosProposal{}, releaseHeldSendToCosmos)
+	}
+
+	setIbcBridgeFee := "gravity/SetIbcBridgeFee"
+	if !govtypes.IsValidProposalType(strings.TrimPrefix(setIbcBridgeFee, prefix)) {
+		govtypes.RegisterProposalType(types.ProposalTypeSetIbcBridgeFee)
+		govtypes.RegisterProposalTypeCodec(&types.SetIbcBridgeFeeProposal{}, setIbcBridgeFee)
+	}
+
+	resolveFailedAttestation := "gravity/ResolveFailedAttestation"
+	if !govtypes.IsValidProposalType(strings.TrimPrefix(resolveFailedAttestation, prefix)) {
+		govtypes.RegisterProposalType(types.ProposalTypeResolveFailedAttestation)
+		govtypes.RegisterProposalTypeCodec(&types.ResolveFailedAttestationProposal{}, resolveFailedAttestation)
+	}
+
+	addBlacklist := "gravity/AddBlacklist"
+	if !govtypes.IsValidProposalType(strings.TrimPrefix(addBlacklist, prefix)) {
+		govtypes.RegisterProposalType(types.ProposalTypeAddBlacklist)
+		govtypes.RegisterProposalTypeCodec(&types.AddBlacklistProposal{}, addBlacklist)
+	}
+
+	removeBlacklist := "gravity/RemoveBlacklist"
+	if !govtypes.IsValidProposalType(strings.TrimPrefix(removeBlacklist, prefix)) {
+		govtypes.RegisterProposalType(types.ProposalTypeRemoveBlacklist)
+		govtypes.RegisterProposalTypeCodec(&types.RemoveBlacklistProposal{}, removeBlacklist)
+	}
+
+	setBatchStrategy := "gravity/SetBatchStrategy"
+	if !govtypes.IsValidProposalType(strings.TrimPrefix(setBatchStrategy, prefix)) {
+		govtypes.RegisterProposalType(types.ProposalTypeSetBatchStrategy)
+		govtypes.RegisterProposalTypeCodec(&types.SetBatchStrategyProposal{}, setBatchStrategy)
+	}
 }
 
 func NewGravityProposalHandler(k Keeper) govtypes.Handler {
@@ -74,6 +121,23 @@ func NewGravityProposalHandler(k Keeper) govtypes.Handler {
 			return k.HandleRemoveEvmChainProposal(ctx, c)
 		case *types.MonitoredERC20TokensProposal:
 			return k.HandleMonitoredERC20TokensProposal(ctx, c)
+		case *types.OutgoingLogicCallProposal:
+			return k.HandleOutgoingLogicCallProposal(ctx, c)
+		case *types.SetRateLimitProposal:
+			return k.HandleSetRateLimitProposal(ctx, c)
+		case *types.ReleaseHeldSendToCosmosProposal:
+			return k.HandleReleaseHeldSendToCosmosProposal(ctx, c)
+		case *types.SetIbcBridgeFeeProposal:
+			return k.HandleSetIbcBridgeFeeProposal(ctx, c)
+		case *types.ResolveFailedAttestationProposal:
+			return k.HandleResolveFailedAttestationProposal(ctx, c)
+		case *types.AddBlacklistProposal:
+			return k.HandleAddBlacklistProposal(ctx, c)
+		case *types.RemoveBlacklistProposal:
+			return k.HandleRemoveBlacklistProposal(ctx, c)
+
+		case *types.SetBatchStrategyProposal:
+			return k.HandleSetBatchStrategyProposal(ctx, c)
 
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized Gravity proposal content type: %T", c)
@@ -107,6 +171,9 @@ func (k Keeper) HandleAddEvmChainProposal(ctx sdk.Context, p *types.AddEvmChainP
 	// }
 
 	ctx.Logger().Info("Gov vote passed: Adding new EVM chain", "evm chain prefix", p.EvmChainPrefix)
+	if k.IsEvmChainDraining(ctx, p.EvmChainPrefix) {
+		return sdkerrors.Wrapf(types.ErrEvmChainDraining, "cannot re-add %s before it has been removed", p.EvmChainPrefix)
+	}
 	evmChain := types.EvmChainData{
 		EvmChain:           types.EvmChain{EvmChainPrefix: p.EvmChainPrefix, EvmChainName: p.EvmChainName, EvmChainNetVersion: p.EvmChainNetVersion},
 		GravityNonces:      types.GravityNonces{},
@@ -153,6 +220,18 @@ func (k Keeper) HandleAddEvmChainProposal(ctx sdk.Context, p *types.AddEvmChainP
 		AverageEthereumBlockTime: 15000,
 		BridgeActive:             true,
 		EthereumBlacklist:        []string{},
+
+		SignedValsetsWindow:         0,
+		SignedBatchesWindow:         0,
+		SignedLogicCallsWindow:      0,
+		UnbondSlashingValsetsWindow: 0,
+		SlashFractionValset:         sdk.ZeroDec(),
+		SlashFractionBatch:          sdk.ZeroDec(),
+		SlashFractionLogicCall:      sdk.ZeroDec(),
+		ValsetPowerDiffThreshold:    sdk.ZeroDec(),
+
+		AttestationVotesPowerThreshold: p.AttestationVotesPowerThreshold,
+		AttestationPowerDelegatedOnly:  p.AttestationPowerDelegatedOnly,
 	}
 
 	var evmChainParams []*types.EvmChainParam
@@ -174,23 +253,20 @@ func (k Keeper) HandleAddEvmChainProposal(ctx sdk.Context, p *types.AddEvmChainP
 	return nil
 }
 
-// In the event we need to remove an evm chains, we can create a new proposal, but call remove evm chain method from store migration
+// In the event we need to remove an evm chains, we can create a new proposal. The chain is drained until the deadline
+// of the proposal and then removed with the remove evm chain method from store migration, see evm_chain_decommission.go
 func (k Keeper) HandleRemoveEvmChainProposal(ctx sdk.Context, p *types.RemoveEvmChainProposal) error {
-	// remove params for current evm chain first
-	var evmChainParams []*types.EvmChainParam
-
-	params := k.GetParams(ctx)
-	for _, param := range params.EvmChainParams {
-		if param.EvmChainPrefix == p.EvmChainPrefix {
-			continue
-		}
-		evmChainParams = append(evmChainParams, param)
+	ctx.Logger().Info("Gov vote passed: Decommissioning EVM chain", "evm chain prefix", p.EvmChainPrefix,
+		"drain blocks", p.DrainBlocks)
 
+	if k.GetEvmChainData(ctx, p.EvmChainPrefix) == nil {
+		return sdkerrors.Wrapf(types.ErrEvmChainNotFound, "invalid RemoveEvmChainProposal: %s", p.EvmChainPrefix)
+	}
+	if k.IsEvmChainDraining(ctx, p.EvmChainPrefix) {
+		return sdkerrors.Wrapf(types.ErrEvmChainDraining, "invalid RemoveEvmChainProposal: %s", p.EvmChainPrefix)
 	}
 
-	params.EvmChainParams = evmChainParams
-	k.SetParams(ctx, params)
-	return v3.RemoveEvmChainFromStore(ctx, k.storeKey, k.cdc, p.EvmChainPrefix)
+	return k.startEvmChainDecommission(ctx, p.EvmChainPrefix, p.DrainBlocks)
 }
 
 // Iterate over all attestations currently being voted on in order of nonce
@@ -415,7 +491,203 @@ func (k Keeper) HandleMonitoredERC20TokensProposal(ctx sdk.Context, p *types.Mon
 		// If the above checks pass, add it to the list of contracts to use in the store
 		tokens = append(tokens, *addr)
 	}
-	k.setMonitoredERC20Tokens(ctx, tokens)
+	k.setMonitoredERC20Tokens(ctx, p.EvmChainPrefix, tokens)
 
 	return nil
 }
+
+// Allows governance to create an arbitrary logic call on an evm chain, the transfers and fees of the call
+// are taken from the community pool and locked in the gravity module so that the bridge remains fully backed
+// once the call is executed. If the call times out or is otherwise canceled the funds are returned.
+func (k Keeper) HandleOutgoingLogicCallProposal(ctx sdk.Context, p *types.OutgoingLogicCallProposal) error {
+	ctx.Logger().Info("Gov vote passed: Creating outgoing logic call", "evm chain prefix", p.EvmChainPrefix,
+		"invalidation id", fmt.Sprintf("%x", p.InvalidationId), "invalidation nonce", p.InvalidationNonce)
+
+	if err := p.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "invalid OutgoingLogicCallProposal")
+	}
+	// Address validation already occurred, so we can ignore address errors
+	logicContract, _ := types.NewEthAddress(p.LogicContractAddress)
+
+	coins, err := k.LogicCallCoins(ctx, p.EvmChainPrefix, p.ToOutgoingLogicCall())
+	if err != nil {
+		return err
+	}
+
+	// check that we have enough tokens in the community pool to fund the logic call
+	feePool := k.DistKeeper.GetFeePool(ctx)
+	newPool, insufficient := feePool.CommunityPool.SafeSub(sdk.NewDecCoinsFromCoins(coins...))
+	if insufficient {
+		ctx.Logger().Info("Logic call failed to execute insufficient tokens in the community pool!")
+		return sdkerrors.Wrap(types.ErrInvalid, "Insufficient tokens in community pool")
+	}
+
+	call, err := k.CreateOutgoingLogicCall(ctx, p.EvmChainPrefix, p.Transfers, p.Fees, *logicContract,
+		p.Payload, p.Timeout, p.InvalidationId, p.InvalidationNonce, true)
+	if err != nil {
+		return err
+	}
+	if coins.IsZero() {
+		return nil
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, disttypes.ModuleName, types.ModuleName, coins); err != nil {
+		return sdkerrors.Wrap(err, "unable to lock logic call funds")
+	}
+	cosmosOriginated, err := k.logicCallCosmosOriginatedCoins(ctx, p.EvmChainPrefix, *call)
+	if err != nil {
+		return err
+	}
+	k.increaseBridgedSupplyOfCoins(ctx, p.EvmChainPrefix, cosmosOriginated)
+	feePool.CommunityPool = newPool
+	k.DistKeeper.SetFeePool(ctx, feePool)
+
+	return nil
+}
+
+// Allows governance to cap the amount of a denom which may cross the bridge to or from an evm chain within a window
+// of blocks, a proposal without any maximum removes the rate limit of the denom. Deposits which are already held
+// stay queued and are released by the EndBlocker as capacity allows.
+func (k Keeper) HandleSetRateLimitProposal(ctx sdk.Context, p *types.SetRateLimitProposal) error {
+	ctx.Logger().Info("Gov vote passed: Setting rate limit", "evm chain prefix", p.EvmChainPrefix, "denom", p.Denom,
+		"window blocks", p.WindowBlocks, "max outbound", p.MaxOutbound, "max inbound", p.MaxInbound)
+
+	if err := p.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "invalid SetRateLimitProposal")
+	}
+	if k.GetEvmChainData(ctx, p.EvmChainPrefix) == nil {
+		return sdkerrors.Wrapf(types.ErrEvmChainNotFound, "invalid SetRateLimitProposal: %s", p.EvmChainPrefix)
+	}
+
+	if p.IsRemoval() {
+		k.DeleteRateLimit(ctx, p.EvmChainPrefix, p.Denom)
+		return nil
+	}
+	k.SetRateLimit(ctx, p.ToRateLimit())
+
+	return nil
+}
+
+// Allows governance to deliver deposits held back by an inbound rate limit regardless of the remaining capacity,
+// an empty list of event nonces releases every held deposit of the evm chain
+func (k Keeper) HandleReleaseHeldSendToCosmosProposal(ctx sdk.Context, p *types.ReleaseHeldSendToCosmosProposal) error {
+	ctx.Logger().Info("Gov vote passed: Releasing held SendToCosmos", "evm chain prefix", p.EvmChainPrefix,
+		"event nonces", p.EventNonces)
+
+	if err := p.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "invalid ReleaseHeldSendToCosmosProposal")
+	}
+
+	// check every deposit exists before releasing any of them
+	var toRelease []types.HeldSendToCosmos
+	if len(p.EventNonces) == 0 {
+		toRelease = k.AllHeldSendToCosmos(ctx, p.EvmChainPrefix)
+	}
+	for _, nonce := range p.EventNonces {
+		held := k.HeldSendToCosmosByNonce(ctx, p.EvmChainPrefix, nonce)
+		if held == nil {
+			return sdkerrors.Wrapf(types.ErrInvalid, "no held SendToCosmos with nonce %d on %s", nonce, p.EvmChainPrefix)
+		}
+		toRelease = append(toRelease, *held)
+	}
+
+	for _, held := range toRelease {
+		if err := k.releaseHeldSendToCosmos(ctx, p.EvmChainPrefix, held); err != nil {
+			return sdkerrors.Wrapf(err, "unable to release held SendToCosmos with nonce %d", held.EventNonce)
+		}
+	}
+
+	return nil
+}
+
+// Allows governance to set the bridge fee paid by IBC transfers of a denom which are forwarded to an evm chain without
+// naming a bridge fee in their memo, a zero fee removes the default so that such transfers pay no bridge fee
+func (k Keeper) HandleSetIbcBridgeFeeProposal(ctx sdk.Context, p *types.SetIbcBridgeFeeProposal) error {
+	ctx.Logger().Info("Gov vote passed: Setting IBC bridge fee", "evm chain prefix", p.EvmChainPrefix, "denom", p.Denom,
+		"bridge fee", p.BridgeFee)
+
+	if err := p.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "invalid SetIbcBridgeFeeProposal")
+	}
+	if k.GetEvmChainData(ctx, p.EvmChainPrefix) == nil {
+		return sdkerrors.Wrapf(types.ErrEvmChainNotFound, "invalid SetIbcBridgeFeeProposal: %s", p.EvmChainPrefix)
+	}
+
+	if p.IsRemoval() {
+		k.DeleteIbcBridgeFee(ctx, p.EvmChainPrefix, p.Denom)
+		return nil
+	}
+	k.SetIbcBridgeFee(ctx, p.ToIbcBridgeFee())
+
+	return nil
+}
+
+// Allows governance to retry an attestation whose handler failed and which was quarantined, once the cause of the
+// failure has been fixed, or to deliver a failed SendToCosmos deposit to a new receiver
+func (k Keeper) HandleResolveFailedAttestationProposal(ctx sdk.Context, p *types.ResolveFailedAttestationProposal) error {
+	ctx.Logger().Info("Gov vote passed: Resolving failed attestation", "evm chain prefix", p.EvmChainPrefix,
+		"event nonce", p.EventNonce, "refund receiver", p.RefundReceiver)
+
+	if err := p.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "invalid ResolveFailedAttestationProposal")
+	}
+	if k.GetEvmChainData(ctx, p.EvmChainPrefix) == nil {
+		return sdkerrors.Wrapf(types.ErrEvmChainNotFound, "invalid ResolveFailedAttestationProposal: %s", p.EvmChainPrefix)
+	}
+
+	return k.resolveFailedAttestation(ctx, p.EvmChainPrefix, p.EventNonce, p.RefundReceiver)
+}
+
+// Allows governance to blacklist evm and bech32 addresses on an evm chain, optionally until an expiry block height
+func (k Keeper) HandleAddBlacklistProposal(ctx sdk.Context, p *types.AddBlacklistProposal) error {
+	ctx.Logger().Info("Gov vote passed: Adding addresses to the blacklist", "evm chain prefix", p.EvmChainPrefix,
+		"addresses", p.Addresses, "expiry block height", p.ExpiryBlockHeight)
+
+	if err := p.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "invalid AddBlacklistProposal")
+	}
+	if k.GetEvmChainData(ctx, p.EvmChainPrefix) == nil {
+		return sdkerrors.Wrapf(types.ErrEvmChainNotFound, "invalid AddBlacklistProposal: %s", p.EvmChainPrefix)
+	}
+
+	return k.addToBlacklist(ctx, p.EvmChainPrefix, p.Addresses, p.ExpiryBlockHeight)
+}
+
+// Allows governance to lift the blacklisting of evm and bech32 addresses on an evm chain
+func (k Keeper) HandleRemoveBlacklistProposal(ctx sdk.Context, p *types.RemoveBlacklistProposal) error {
+	ctx.Logger().Info("Gov vote passed: Removing addresses from the blacklist", "evm chain prefix", p.EvmChainPrefix,
+		"addresses", p.Addresses)
+
+	if err := p.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "invalid RemoveBlacklistProposal")
+	}
+	if k.GetEvmChainData(ctx, p.EvmChainPrefix) == nil {
+		return sdkerrors.Wrapf(types.ErrEvmChainNotFound, "invalid RemoveBlacklistProposal: %s", p.EvmChainPrefix)
+	}
+
+	return k.removeFromBlacklist(ctx, p.EvmChainPrefix, p.Addresses)
+}
+
+// Allows governance to choose how and when the batches of a token are built, an unspecified strategy without a max
+// batch size or auto batch threshold removes the token's strategy so that it is batched by fee desc again
+func (k Keeper) HandleSetBatchStrategyProposal(ctx sdk.Context, p *types.SetBatchStrategyProposal) error {
+	ctx.Logger().Info("Gov vote passed: Setting batch strategy", "evm chain prefix", p.EvmChainPrefix,
+		"token contract", p.TokenContract, "strategy", p.Strategy, "max batch size", p.MaxBatchSize,
+		"max wait blocks", p.MaxWaitBlocks, "min fee per tx", p.MinFeePerTx, "auto batch min fees", p.AutoBatchMinFees,
+		"auto batch max age", p.AutoBatchMaxAge)
+
+	if err := p.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "invalid SetBatchStrategyProposal")
+	}
+	if k.GetEvmChainData(ctx, p.EvmChainPrefix) == nil {
+		return sdkerrors.Wrapf(types.ErrEvmChainNotFound, "invalid SetBatchStrategyProposal: %s", p.EvmChainPrefix)
+	}
+
+	if p.IsRemoval() {
+		// ValidateBasic checked the token contract
+		tokenContract, _ := types.NewEthAddress(p.TokenContract)
+		k.DeleteBatchStrategy(ctx, p.EvmChainPrefix, *tokenContract)
+		return nil
+	}
+	return k.SetBatchStrategy(ctx, p.ToTokenBatchStrategy())
+}
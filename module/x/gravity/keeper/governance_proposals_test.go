@@ -1,10 +1,14 @@
 package keeper
 
 import (
+	"math/big"
+	"strings"
 	"testing"
 
 	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/stretchr/testify/assert"
@@ -315,3 +319,465 @@ func TestRemoveEvmChainProposal(t *testing.T) {
 	recentAttestations = gk.GetMostRecentAttestations(ctx, "Dummy", uint64(length))
 	require.Equal(t, len(recentAttestations), 0)
 }
+
+// nolint: exhaustruct
+func TestOutgoingLogicCallProposal(t *testing.T) {
+	input := CreateTestEnv(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+
+	ctx := input.Context
+	gk := input.GravityKeeper
+
+	tokenContract, err := types.NewEthAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	require.NoError(t, err)
+	denom := types.GravityDenom(EthChainPrefix, *tokenContract)
+
+	// fund the community pool with some bridged vouchers
+	feePoolBalance := sdk.NewInt64Coin(denom, 10000)
+	feePool := gk.DistKeeper.GetFeePool(ctx)
+	feePool.CommunityPool = feePool.CommunityPool.Add(sdk.NewDecCoinFromCoin(feePoolBalance))
+	gk.DistKeeper.SetFeePool(ctx, feePool)
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(feePoolBalance)))
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, disttypes.ModuleName, sdk.NewCoins(feePoolBalance)))
+
+	gk.SetLastObservedEvmChainBlockHeight(ctx, EthChainPrefix, 1000)
+
+	goodProposal := types.OutgoingLogicCallProposal{
+		Title:                "test title",
+		Description:          "test description",
+		EvmChainPrefix:       EthChainPrefix,
+		Transfers:            []types.ERC20Token{{Contract: tokenContract.GetAddress().Hex(), Amount: sdk.NewInt(5000)}},
+		Fees:                 []types.ERC20Token{{Contract: tokenContract.GetAddress().Hex(), Amount: sdk.NewInt(100)}},
+		LogicContractAddress: "0x510ab76899430424d209a6c9a5b9951fb8a6f47d",
+		Payload:              []byte("payload"),
+		Timeout:              2000,
+		InvalidationId:       []byte("invalidation id"),
+		InvalidationNonce:    1,
+	}
+	tooExpensive := goodProposal
+	tooExpensive.Transfers = []types.ERC20Token{{Contract: tokenContract.GetAddress().Hex(), Amount: sdk.NewInt(20000)}}
+	timedOut := goodProposal
+	timedOut.Timeout = 999
+	badChain := goodProposal
+	badChain.EvmChainPrefix = "notreal"
+	badContract := goodProposal
+	badContract.LogicContractAddress = "0xnotanaddress"
+
+	require.Error(t, gk.HandleOutgoingLogicCallProposal(ctx, &tooExpensive))
+	require.Error(t, gk.HandleOutgoingLogicCallProposal(ctx, &timedOut))
+	require.Error(t, gk.HandleOutgoingLogicCallProposal(ctx, &badChain))
+	require.Error(t, gk.HandleOutgoingLogicCallProposal(ctx, &badContract))
+	require.Empty(t, gk.GetOutgoingLogicCalls(ctx, EthChainPrefix))
+
+	require.NoError(t, gk.HandleOutgoingLogicCallProposal(ctx, &goodProposal))
+	input.AssertInvariants()
+
+	call := gk.GetOutgoingLogicCall(ctx, EthChainPrefix, goodProposal.InvalidationId, goodProposal.InvalidationNonce)
+	require.NotNil(t, call)
+	assert.Equal(t, uint64(ctx.BlockHeight()), call.CosmosBlockCreated)
+	assert.True(t, gk.GetPastEthSignatureCheckpoint(ctx, EthChainPrefix, call.GetCheckpoint(gk.GetGravityID(ctx, EthChainPrefix))))
+
+	// the transfers and fees are locked in the gravity module
+	feePool = gk.DistKeeper.GetFeePool(ctx)
+	assert.Equal(t, sdk.NewDec(4900), feePool.CommunityPool.AmountOf(denom))
+	modAcc := input.AccountKeeper.GetModuleAddress(types.ModuleName)
+	assert.Equal(t, sdk.NewInt(5100), input.BankKeeper.GetBalance(ctx, modAcc, denom).Amount)
+
+	// the same invalidation id and nonce can not be reused
+	require.Error(t, gk.HandleOutgoingLogicCallProposal(ctx, &goodProposal))
+
+	// canceling the call returns the funds to the community pool
+	require.NoError(t, gk.CancelOutgoingLogicCall(ctx, EthChainPrefix, call.InvalidationId, call.InvalidationNonce))
+	require.Nil(t, gk.GetOutgoingLogicCall(ctx, EthChainPrefix, call.InvalidationId, call.InvalidationNonce))
+	feePool = gk.DistKeeper.GetFeePool(ctx)
+	assert.Equal(t, sdk.NewDec(10000), feePool.CommunityPool.AmountOf(denom))
+	assert.True(t, input.BankKeeper.GetBalance(ctx, modAcc, denom).Amount.IsZero())
+
+	// a call the module never funded, as when imported from genesis, refunds nothing
+	assert.True(t, call.Funded)
+	imported := *call
+	imported.InvalidationNonce = 2
+	imported.Funded = false
+	gk.SetOutgoingLogicCall(ctx, EthChainPrefix, imported)
+	require.NoError(t, gk.CancelOutgoingLogicCall(ctx, EthChainPrefix, imported.InvalidationId, imported.InvalidationNonce))
+	require.Nil(t, gk.GetOutgoingLogicCall(ctx, EthChainPrefix, imported.InvalidationId, imported.InvalidationNonce))
+	feePool = gk.DistKeeper.GetFeePool(ctx)
+	assert.Equal(t, sdk.NewDec(10000), feePool.CommunityPool.AmountOf(denom))
+}
+
+func TestRateLimitProposals(t *testing.T) {
+	input := CreateTestEnv(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+
+	ctx := input.Context
+	gk := input.GravityKeeper
+
+	tokenContract, err := types.NewEthAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	require.NoError(t, err)
+	denom := types.GravityDenom(EthChainPrefix, *tokenContract)
+	receiver := AccAddrs[0]
+
+	setLimit := types.SetRateLimitProposal{
+		Title:          "test title",
+		Description:    "test description",
+		EvmChainPrefix: EthChainPrefix,
+		Denom:          denom,
+		WindowBlocks:   100,
+		MaxOutbound:    sdk.ZeroInt(),
+		MaxInbound:     sdk.NewInt(10),
+	}
+	badChain := setLimit
+	badChain.EvmChainPrefix = "notreal"
+	require.Error(t, gk.HandleSetRateLimitProposal(ctx, &badChain))
+	require.NoError(t, gk.HandleSetRateLimitProposal(ctx, &setLimit))
+	require.Len(t, gk.RateLimits(ctx, EthChainPrefix), 1)
+	require.Empty(t, gk.RateLimits(ctx, BscChainPrefix))
+
+	// hold two deposits which exceed the inbound cap
+	handler := AttestationHandler{keeper: &gk}
+	for nonce := uint64(1); nonce <= 2; nonce++ {
+		claim := types.MsgSendToCosmosClaim{
+			EventNonce:     nonce,
+			EthBlockHeight: 1000,
+			TokenContract:  tokenContract.GetAddress().Hex(),
+			Amount:         sdk.NewInt(100),
+			EthereumSender: EthAddrs[0].String(),
+			CosmosReceiver: receiver.String(),
+			Orchestrator:   OrchAddrs[0].String(),
+			EvmChainPrefix: EthChainPrefix,
+		}
+		require.NoError(t, handler.handleSendToCosmos(ctx, claim))
+	}
+	require.Len(t, gk.AllHeldSendToCosmos(ctx, EthChainPrefix), 2)
+	require.True(t, input.BankKeeper.GetBalance(ctx, receiver, denom).Amount.IsZero())
+
+	// releasing an unknown deposit fails without releasing anything
+	release := types.ReleaseHeldSendToCosmosProposal{
+		Title:          "test title",
+		Description:    "test description",
+		EvmChainPrefix: EthChainPrefix,
+		EventNonces:    []uint64{2, 3},
+	}
+	require.Error(t, gk.HandleReleaseHeldSendToCosmosProposal(ctx, &release))
+	require.Len(t, gk.AllHeldSendToCosmos(ctx, EthChainPrefix), 2)
+
+	// governance releases regardless of the remaining capacity
+	release.EventNonces = []uint64{2}
+	require.NoError(t, gk.HandleReleaseHeldSendToCosmosProposal(ctx, &release))
+	held := gk.AllHeldSendToCosmos(ctx, EthChainPrefix)
+	require.Len(t, held, 1)
+	assert.Equal(t, uint64(1), held[0].EventNonce)
+	assert.Equal(t, sdk.NewInt(100), input.BankKeeper.GetBalance(ctx, receiver, denom).Amount)
+
+	// removing the limit lets the EndBlocker release the rest of the queue
+	removal := setLimit
+	removal.MaxInbound = sdk.ZeroInt()
+	require.True(t, removal.IsRemoval())
+	require.NoError(t, gk.HandleSetRateLimitProposal(ctx, &removal))
+	require.Nil(t, gk.GetRateLimit(ctx, EthChainPrefix, denom))
+	gk.ReleaseHeldSendToCosmosWithinLimits(ctx, EthChainPrefix, types.MaxHeldSendToCosmosReleasesPerBlock)
+	require.Empty(t, gk.AllHeldSendToCosmos(ctx, EthChainPrefix))
+	assert.Equal(t, sdk.NewInt(200), input.BankKeeper.GetBalance(ctx, receiver, denom).Amount)
+}
+
+func TestResolveFailedAttestationProposal(t *testing.T) {
+	input := CreateTestEnv(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+
+	ctx := input.Context
+	gk := input.GravityKeeper
+
+	tokenContract, err := types.NewEthAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	require.NoError(t, err)
+	denom := types.GravityDenom(EthChainPrefix, *tokenContract)
+	receiver := AccAddrs[0]
+	refundReceiver := AccAddrs[1]
+
+	process := func(nonce uint64, amount sdk.Int) {
+		claim := types.MsgSendToCosmosClaim{
+			EventNonce:     nonce,
+			EthBlockHeight: 1000,
+			TokenContract:  tokenContract.GetAddress().Hex(),
+			Amount:         amount,
+			EthereumSender: EthAddrs[0].String(),
+			CosmosReceiver: receiver.String(),
+			Orchestrator:   OrchAddrs[0].String(),
+			EvmChainPrefix: EthChainPrefix,
+		}
+		anyClaim, err := codectypes.NewAnyWithValue(&claim)
+		require.NoError(t, err)
+		att := types.Attestation{
+			Observed: true,
+			Votes:    []string{ValAddrs[0].String()},
+			Height:   uint64(ctx.BlockHeight()),
+			Claim:    anyClaim,
+		}
+		gk.processAttestation(ctx, &att, &claim)
+	}
+
+	// the first deposit fills the voucher supply, so the second one overflows and is quarantined
+	maxSupply := sdk.NewIntFromBigInt(new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1)))
+	process(1, maxSupply)
+	require.Empty(t, gk.FailedAttestations(ctx, EthChainPrefix))
+	process(2, sdk.NewInt(10))
+	failed := gk.FailedAttestations(ctx, EthChainPrefix)
+	require.Len(t, failed, 1)
+	assert.Equal(t, uint64(2), failed[0].EventNonce)
+	assert.Contains(t, failed[0].Error, "invalid supply")
+	require.Empty(t, gk.FailedAttestations(ctx, BscChainPrefix))
+
+	resolve := types.ResolveFailedAttestationProposal{
+		Title:          "test title",
+		Description:    "test description",
+		EvmChainPrefix: EthChainPrefix,
+		EventNonce:     3,
+	}
+	require.Error(t, gk.HandleResolveFailedAttestationProposal(ctx, &resolve))
+
+	// retrying before the cause is fixed fails and keeps the attestation quarantined
+	resolve.EventNonce = 2
+	require.Error(t, gk.HandleResolveFailedAttestationProposal(ctx, &resolve))
+	require.NotNil(t, gk.GetFailedAttestation(ctx, EthChainPrefix, 2))
+
+	// once the supply has been burned the deposit can be refunded to a new receiver
+	burn := sdk.NewCoins(sdk.NewCoin(denom, maxSupply))
+	require.NoError(t, input.BankKeeper.SendCoinsFromAccountToModule(ctx, receiver, types.ModuleName, burn))
+	require.NoError(t, input.BankKeeper.BurnCoins(ctx, types.ModuleName, burn))
+	require.NoError(t, gk.decreaseBridgedSupplyOfCoins(ctx, EthChainPrefix, burn))
+	gk.storeBridgeBalanceSnapshot(ctx, types.NewBridgeBalanceSnapshot(
+		uint64(ctx.BlockHeight()), 1000, EthChainPrefix, gk.FetchBridgedTokenBalances(ctx, EthChainPrefix), 2,
+	))
+	resolve.RefundReceiver = refundReceiver.String()
+	require.NoError(t, gk.HandleResolveFailedAttestationProposal(ctx, &resolve))
+	require.Nil(t, gk.GetFailedAttestation(ctx, EthChainPrefix, 2))
+	assert.Equal(t, sdk.NewInt(10), input.BankKeeper.GetBalance(ctx, refundReceiver, denom).Amount)
+	assert.True(t, input.BankKeeper.GetBalance(ctx, receiver, denom).Amount.IsZero())
+
+	// the latest snapshot includes the deposit, as the bridge did all along
+	snapshots := gk.CollectBridgeBalanceSnapshots(ctx, EthChainPrefix, true, 0)
+	require.Len(t, snapshots, 1)
+	assert.Equal(t, uint64(2), snapshots[0].EventNonce)
+	require.NoError(t, assertSnapshotsDiff(&types.MsgSendToCosmosClaim{EvmChainPrefix: EthChainPrefix}, *snapshots[0],
+		types.BridgeBalanceSnapshot{}, sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(10)))))
+	require.Error(t, gk.HandleResolveFailedAttestationProposal(ctx, &resolve))
+}
+
+func TestRemoveEvmChainProposalDrainsChain(t *testing.T) {
+	input := CreateTestEnv(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+
+	ctx := input.Context
+	gk := input.GravityKeeper
+
+	tokenContract, err := types.NewEthAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	require.NoError(t, err)
+	denom := types.GravityDenom(EthChainPrefix, *tokenContract)
+	receiver, err := types.NewEthAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+	require.NoError(t, err)
+	sender := AccAddrs[0]
+	vouchers := sdk.NewCoins(sdk.NewInt64Coin(denom, 1000))
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, vouchers))
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, vouchers))
+
+	send := func(fee int64) {
+		_, err := gk.AddToOutgoingPool(ctx, EthChainPrefix, sender, *receiver, sdk.NewInt64Coin(denom, 100), sdk.NewInt64Coin(denom, fee))
+		require.NoError(t, err)
+	}
+	// an unconfirmed batch, a confirmed batch and an unbatched tx
+	send(1)
+	_, err = gk.BuildOutgoingTxBatch(ctx, EthChainPrefix, *tokenContract, 1)
+	require.NoError(t, err)
+	send(2)
+	send(3)
+	confirmed, err := gk.BuildOutgoingTxBatch(ctx, EthChainPrefix, *tokenContract, 1)
+	require.NoError(t, err)
+	gk.SetBatchConfirm(ctx, &types.MsgConfirmBatch{
+		Nonce:          confirmed.BatchNonce,
+		TokenContract:  tokenContract.GetAddress().Hex(),
+		EthSigner:      EthAddrs[0].String(),
+		Orchestrator:   OrchAddrs[0].String(),
+		Signature:      "abcd",
+		EvmChainPrefix: EthChainPrefix,
+	})
+
+	remove := types.RemoveEvmChainProposal{
+		Title:          "test title",
+		Description:    "test description",
+		EvmChainPrefix: EthChainPrefix,
+		DrainBlocks:    10,
+	}
+	require.NoError(t, gk.HandleRemoveEvmChainProposal(ctx, &remove))
+	require.ErrorIs(t, gk.HandleRemoveEvmChainProposal(ctx, &remove), types.ErrEvmChainDraining)
+
+	// everything but the confirmed batch was refunded and the chain accepts no new withdrawals or batches
+	require.True(t, gk.IsEvmChainDraining(ctx, EthChainPrefix))
+	require.NotNil(t, gk.GetEvmChainData(ctx, EthChainPrefix))
+	assert.Empty(t, gk.GetUnbatchedTransactions(ctx, EthChainPrefix))
+	assert.Len(t, gk.GetOutgoingTxBatches(ctx, EthChainPrefix), 1)
+	assert.Equal(t, sdk.NewInt(897), input.BankKeeper.GetBalance(ctx, sender, denom).Amount)
+	_, err = gk.AddToOutgoingPool(ctx, EthChainPrefix, sender, *receiver, sdk.NewInt64Coin(denom, 100), sdk.NewInt64Coin(denom, 1))
+	require.ErrorIs(t, err, types.ErrEvmChainDraining)
+	_, err = gk.BuildOutgoingTxBatch(ctx, EthChainPrefix, *tokenContract, 1)
+	require.ErrorIs(t, err, types.ErrEvmChainDraining)
+
+	report := gk.EvmChainDecommission(ctx, EthChainPrefix)
+	require.NotNil(t, report)
+	assert.Equal(t, uint64(ctx.BlockHeight())+10, report.DeadlineBlockHeight)
+	assert.Equal(t, uint64(1), report.CancelledBatches)
+	assert.Equal(t, uint64(2), report.RefundedTxs)
+	assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(denom, 203)), report.RefundedAmounts)
+	assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(denom, 103)), report.EscrowedBalances)
+
+	// deposits still arriving from the draining chain are delivered at once, even beyond the inbound rate limit
+	gk.SetRateLimit(ctx, types.RateLimit{EvmChainPrefix: EthChainPrefix, Denom: denom, WindowBlocks: 100, MaxOutbound: sdk.ZeroInt(), MaxInbound: sdk.NewInt(10)})
+	depositor := AccAddrs[1]
+	require.NoError(t, AttestationHandler{keeper: &gk}.handleSendToCosmos(ctx, types.MsgSendToCosmosClaim{
+		EventNonce:     1,
+		EthBlockHeight: 1000,
+		TokenContract:  tokenContract.GetAddress().Hex(),
+		Amount:         sdk.NewInt(50),
+		EthereumSender: EthAddrs[0].String(),
+		CosmosReceiver: depositor.String(),
+		Orchestrator:   OrchAddrs[0].String(),
+		EvmChainPrefix: EthChainPrefix,
+	}))
+	assert.Empty(t, gk.AllHeldSendToCosmos(ctx, EthChainPrefix))
+	assert.Equal(t, sdk.NewInt(50), input.BankKeeper.GetBalance(ctx, depositor, denom).Amount)
+
+	// past the deadline the chain is kept while the confirmed batch is still escrowed
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 10)
+	gk.ProcessEvmChainDecommission(ctx, EthChainPrefix)
+	require.True(t, gk.IsEvmChainDraining(ctx, EthChainPrefix))
+	require.NotNil(t, gk.GetEvmChainData(ctx, EthChainPrefix))
+
+	// once the batch is cancelled, as on a timeout, the tx is refunded and the chain removed
+	require.NoError(t, gk.CancelOutgoingTxBatch(ctx, EthChainPrefix, *tokenContract, confirmed.BatchNonce))
+	gk.ProcessEvmChainDecommission(ctx, EthChainPrefix)
+	require.False(t, gk.IsEvmChainDraining(ctx, EthChainPrefix))
+	require.Nil(t, gk.GetEvmChainData(ctx, EthChainPrefix))
+	require.Nil(t, gk.GetEvmChainParam(ctx, EthChainPrefix))
+	assert.Equal(t, sdk.NewInt(1000), input.BankKeeper.GetBalance(ctx, sender, denom).Amount)
+
+	res, err := gk.GetEvmChainDecommission(sdk.WrapSDKContext(ctx), &types.QueryEvmChainDecommissionRequest{EvmChainPrefix: EthChainPrefix})
+	require.NoError(t, err)
+	assert.Equal(t, types.EVM_CHAIN_DECOMMISSION_STATUS_REMOVED, res.Decommission.Status)
+	assert.Equal(t, uint64(ctx.BlockHeight()), res.Decommission.RemovalBlockHeight)
+	assert.Equal(t, uint64(3), res.Decommission.RefundedTxs)
+	assert.Empty(t, res.Decommission.EscrowedBalances)
+	assert.Equal(t, vouchers.Add(sdk.NewInt64Coin(denom, 50)), res.Decommission.OutstandingVouchers)
+}
+
+func TestBlacklistProposals(t *testing.T) {
+	input := CreateTestEnv(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+
+	ctx := input.Context
+	gk := input.GravityKeeper
+
+	tokenContract, err := types.NewEthAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	require.NoError(t, err)
+	denom := types.GravityDenom(EthChainPrefix, *tokenContract)
+	evmAddr := EthAddrs[0]
+	// bech32 addresses are matched on their account bytes, whatever their prefix
+	foreignAddr, err := bech32.ConvertAndEncode("cosmos", AccAddrs[1])
+	require.NoError(t, err)
+
+	add := types.AddBlacklistProposal{
+		Title:          "test title",
+		Description:    "test description",
+		EvmChainPrefix: EthChainPrefix,
+		Addresses:      []string{strings.ToLower(evmAddr.String()), foreignAddr},
+	}
+	badChain := add
+	badChain.EvmChainPrefix = "notreal"
+	require.Error(t, gk.HandleAddBlacklistProposal(ctx, &badChain))
+	expired := add
+	expired.ExpiryBlockHeight = uint64(ctx.BlockHeight())
+	require.Error(t, gk.HandleAddBlacklistProposal(ctx, &expired))
+	require.NoError(t, gk.HandleAddBlacklistProposal(ctx, &add))
+
+	ethAddr, err := types.NewEthAddress(evmAddr.String())
+	require.NoError(t, err)
+	assert.True(t, gk.IsOnBlacklist(ctx, EthChainPrefix, *ethAddr))
+	assert.False(t, gk.IsOnBlacklist(ctx, BscChainPrefix, *ethAddr))
+	assert.True(t, gk.IsOnCosmosBlacklist(ctx, EthChainPrefix, AccAddrs[1]))
+	assert.False(t, gk.IsOnCosmosBlacklist(ctx, EthChainPrefix, AccAddrs[0]))
+
+	res, err := gk.GetBlacklist(sdk.WrapSDKContext(ctx), &types.QueryBlacklistRequest{EvmChainPrefix: EthChainPrefix})
+	require.NoError(t, err)
+	require.Len(t, res.Entries, 2)
+	res, err = gk.GetBlacklist(sdk.WrapSDKContext(ctx), &types.QueryBlacklistRequest{EvmChainPrefix: EthChainPrefix, Address: AccAddrs[1].String()})
+	require.NoError(t, err)
+	require.Len(t, res.Entries, 1)
+	assert.Equal(t, foreignAddr, res.Entries[0].Address)
+
+	// a deposit to a blacklisted cosmos receiver goes to the community pool
+	handler := AttestationHandler{keeper: &gk}
+	claim := types.MsgSendToCosmosClaim{
+		EventNonce:     1,
+		EthBlockHeight: 1000,
+		TokenContract:  tokenContract.GetAddress().Hex(),
+		Amount:         sdk.NewInt(100),
+		EthereumSender: EthAddrs[1].String(),
+		CosmosReceiver: AccAddrs[1].String(),
+		Orchestrator:   OrchAddrs[0].String(),
+		EvmChainPrefix: EthChainPrefix,
+	}
+	require.NoError(t, handler.handleSendToCosmos(ctx, claim))
+	assert.True(t, input.BankKeeper.GetBalance(ctx, AccAddrs[1], denom).Amount.IsZero())
+
+	// a blacklisted sender may not send to the evm chain
+	msgServer := NewMsgServerImpl(gk)
+	claim.EventNonce = 2
+	claim.CosmosReceiver = AccAddrs[2].String()
+	require.NoError(t, handler.handleSendToCosmos(ctx, claim))
+	require.NoError(t, input.BankKeeper.SendCoins(ctx, AccAddrs[2], AccAddrs[1], sdk.NewCoins(sdk.NewInt64Coin(denom, 50))))
+	sendToEth := types.MsgSendToEth{
+		Sender:         AccAddrs[1].String(),
+		EthDest:        EthAddrs[2].String(),
+		Amount:         sdk.NewInt64Coin(denom, 40),
+		BridgeFee:      sdk.NewInt64Coin(denom, 1),
+		ChainFee:       sdk.NewInt64Coin(denom, 0),
+		EvmChainPrefix: EthChainPrefix,
+	}
+	_, err = msgServer.SendToEth(sdk.WrapSDKContext(ctx), &sendToEth)
+	require.ErrorIs(t, err, types.ErrBlacklisted)
+
+	// an entry no longer applies from its expiry and is pruned
+	expiring := add
+	expiring.Addresses = []string{AccAddrs[3].String()}
+	expiring.ExpiryBlockHeight = uint64(ctx.BlockHeight()) + 10
+	require.NoError(t, gk.HandleAddBlacklistProposal(ctx, &expiring))
+	assert.True(t, gk.IsOnCosmosBlacklist(ctx, EthChainPrefix, AccAddrs[3]))
+	later := ctx.WithBlockHeight(ctx.BlockHeight() + 10)
+	assert.False(t, gk.IsOnCosmosBlacklist(later, EthChainPrefix, AccAddrs[3]))
+	gk.PruneExpiredBlacklistEntries(later, EthChainPrefix)
+	require.Len(t, gk.Blacklist(ctx, EthChainPrefix), 2)
+
+	// the addresses of the deprecated EthereumBlacklist param are only honoured once moved to the blacklist
+	params := gk.GetParams(ctx)
+	params.GetEvmChain(EthChainPrefix).EthereumBlacklist = []string{EthAddrs[3].String()}
+	gk.SetParams(ctx, params)
+	legacyAddr, err := types.NewEthAddress(EthAddrs[3].String())
+	require.NoError(t, err)
+	require.False(t, gk.IsOnBlacklist(ctx, EthChainPrefix, *legacyAddr))
+	gk.migrateParamBlacklist(ctx)
+	require.True(t, gk.IsOnBlacklist(ctx, EthChainPrefix, *legacyAddr))
+	params = gk.GetParams(ctx)
+	require.Empty(t, params.GetEvmChain(EthChainPrefix).EthereumBlacklist)
+	require.Equal(t, uint64(0), gk.GetBlacklistEntry(ctx, EthChainPrefix, EthAddrs[3].String()).ExpiryBlockHeight)
+
+	remove := types.RemoveBlacklistProposal{
+		Title:          "test title",
+		Description:    "test description",
+		EvmChainPrefix: EthChainPrefix,
+		Addresses:      []string{evmAddr.String(), foreignAddr, EthAddrs[3].String(), AccAddrs[3].String()},
+	}
+	require.Error(t, gk.HandleRemoveBlacklistProposal(ctx, &remove)) // AccAddrs[3] was pruned
+	remove.Addresses = remove.Addresses[:3]
+	require.NoError(t, gk.HandleRemoveBlacklistProposal(ctx, &remove))
+	assert.False(t, gk.IsOnBlacklist(ctx, EthChainPrefix, *ethAddr))
+	assert.False(t, gk.IsOnBlacklist(ctx, EthChainPrefix, *legacyAddr))
+	assert.False(t, gk.IsOnCosmosBlacklist(ctx, EthChainPrefix, AccAddrs[1]))
+	assert.Empty(t, gk.Blacklist(ctx, EthChainPrefix))
+}
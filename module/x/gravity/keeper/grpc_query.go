@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
 	"strings"
 
@@ -597,7 +598,7 @@ func (k Keeper) GetMonitoredERC20Addresses(
 	c context.Context, req *types.QueryMonitoredERC20Addresses,
 ) (*types.QueryMonitoredERC20AddressesResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
-	addresses := k.MonitoredERC20Tokens(ctx)
+	addresses := k.MonitoredERC20Tokens(ctx, req.EvmChainPrefix)
 	var tokenStrs []string
 	for _, addr := range addresses {
 		tokenStrs = append(tokenStrs, addr.GetAddress().String())
@@ -616,7 +617,7 @@ func (k Keeper) GetBridgeBalanceSnapshots(
 	limit := req.Limit
 	reverse := req.NewestFirst
 
-	snapshots := k.CollectBridgeBalanceSnapshots(ctx, reverse, limit)
+	snapshots := k.CollectBridgeBalanceSnapshots(ctx, req.EvmChainPrefix, reverse, limit)
 
 	return &types.QueryBridgeBalanceSnapshotsResponse{Snapshots: snapshots}, nil
 }
@@ -642,3 +643,346 @@ func (k Keeper) GetBridgeBalanceSnapshotByEventNonce(
 
 	return &types.QueryBridgeBalanceSnapshotByEventNonceResponse{Snapshot: &snapshot}, nil
 }
+
+// GetRateLimits returns the rate limits of an evm chain along with the usage of their current window
+func (k Keeper) GetRateLimits(
+	c context.Context,
+	req *types.QueryRateLimitsRequest,
+) (*types.QueryRateLimitsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	var statuses []types.RateLimitStatus
+	if req.Denom != "" {
+		rateLimit := k.GetRateLimit(ctx, req.EvmChainPrefix, req.Denom)
+		if rateLimit == nil {
+			return nil, sdkerrors.Wrapf(types.ErrInvalid, "no rate limit on %s for %s", req.Denom, req.EvmChainPrefix)
+		}
+		statuses = append(statuses, k.GetRateLimitStatus(ctx, *rateLimit))
+	} else {
+		for _, rateLimit := range k.RateLimits(ctx, req.EvmChainPrefix) {
+			statuses = append(statuses, k.GetRateLimitStatus(ctx, rateLimit))
+		}
+	}
+
+	return &types.QueryRateLimitsResponse{RateLimits: statuses}, nil
+}
+
+// GetHeldSendToCosmos returns the deposits of an evm chain held back by an inbound rate limit
+func (k Keeper) GetHeldSendToCosmos(
+	c context.Context,
+	req *types.QueryHeldSendToCosmosRequest,
+) (*types.QueryHeldSendToCosmosResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	held := k.AllHeldSendToCosmos(ctx, req.EvmChainPrefix)
+	return &types.QueryHeldSendToCosmosResponse{Held: held}, nil
+}
+
+// GetIbcBridgeFees returns the default bridge fees of IBC transfers forwarded to an evm chain
+func (k Keeper) GetIbcBridgeFees(
+	c context.Context,
+	req *types.QueryIbcBridgeFeesRequest,
+) (*types.QueryIbcBridgeFeesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	var fees []types.IbcBridgeFee
+	if req.Denom != "" {
+		fee := k.GetIbcBridgeFee(ctx, req.EvmChainPrefix, req.Denom)
+		if fee == nil {
+			return nil, sdkerrors.Wrapf(types.ErrInvalid, "no bridge fee for %s on %s", req.Denom, req.EvmChainPrefix)
+		}
+		fees = append(fees, *fee)
+	} else {
+		fees = k.IbcBridgeFees(ctx, req.EvmChainPrefix)
+	}
+
+	return &types.QueryIbcBridgeFeesResponse{BridgeFees: fees}, nil
+}
+
+// GetIbcAutoForwardLogs returns the recorded outcomes of processed IBC Auto-Forwards on an evm chain
+func (k Keeper) GetIbcAutoForwardLogs(
+	c context.Context,
+	req *types.QueryIbcAutoForwardLogsRequest,
+) (*types.QueryIbcAutoForwardLogsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	var logs []types.IbcAutoForwardLog
+	if req.EventNonce != 0 {
+		log := k.GetIbcAutoForwardLog(ctx, req.EvmChainPrefix, req.EventNonce)
+		if log == nil {
+			return nil, sdkerrors.Wrapf(types.ErrInvalid, "no ibc auto forward log for nonce %d on %s", req.EventNonce, req.EvmChainPrefix)
+		}
+		logs = append(logs, *log)
+	} else {
+		logs = k.IbcAutoForwardLogs(ctx, req.EvmChainPrefix, req.Limit)
+	}
+
+	return &types.QueryIbcAutoForwardLogsResponse{Logs: logs}, nil
+}
+
+// GetIbcAutoForwardTransfer returns the status of the ibc transfer sent for the deposit with the given event nonce
+func (k Keeper) GetIbcAutoForwardTransfer(
+	c context.Context,
+	req *types.QueryIbcAutoForwardTransferRequest,
+) (*types.QueryIbcAutoForwardTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	transfer := k.IbcAutoForwardTransferByNonce(ctx, req.EvmChainPrefix, req.EventNonce)
+	if transfer == nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalid, "no ibc auto forward transfer for nonce %d on %s", req.EventNonce, req.EvmChainPrefix)
+	}
+
+	return &types.QueryIbcAutoForwardTransferResponse{Transfer: *transfer}, nil
+}
+
+// GetFailedAttestations returns the attestations of the evm chain whose handler failed and which await resolution by
+// governance
+func (k Keeper) GetFailedAttestations(
+	c context.Context,
+	req *types.QueryFailedAttestationsRequest,
+) (*types.QueryFailedAttestationsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	if k.GetEvmChainData(ctx, req.EvmChainPrefix) == nil {
+		return nil, sdkerrors.Wrapf(types.ErrEvmChainNotFound, "evm chain prefix %s", req.EvmChainPrefix)
+	}
+
+	return &types.QueryFailedAttestationsResponse{FailedAttestations: k.FailedAttestations(ctx, req.EvmChainPrefix)}, nil
+}
+
+// GetEvmChainDecommission returns the decommissioning report of an evm chain removed by governance
+func (k Keeper) GetEvmChainDecommission(
+	c context.Context,
+	req *types.QueryEvmChainDecommissionRequest,
+) (*types.QueryEvmChainDecommissionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	decommission := k.EvmChainDecommission(ctx, req.EvmChainPrefix)
+	if decommission == nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalid, "evm chain %s has not been decommissioned", req.EvmChainPrefix)
+	}
+
+	return &types.QueryEvmChainDecommissionResponse{Decommission: *decommission}, nil
+}
+
+// GetBlacklist returns the blacklist entries of the evm chain which have not expired, or only the entry of the given
+// address
+func (k Keeper) GetBlacklist(
+	c context.Context,
+	req *types.QueryBlacklistRequest,
+) (*types.QueryBlacklistResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	if k.GetEvmChainData(ctx, req.EvmChainPrefix) == nil {
+		return nil, sdkerrors.Wrapf(types.ErrEvmChainNotFound, "evm chain prefix %s", req.EvmChainPrefix)
+	}
+	height := uint64(ctx.BlockHeight())
+
+	if req.Address != "" {
+		if _, err := types.BlacklistAddressBytes(req.Address); err != nil {
+			return nil, sdkerrors.Wrap(types.ErrInvalid, err.Error())
+		}
+		entries := []types.BlacklistEntry{}
+		if entry := k.GetBlacklistEntry(ctx, req.EvmChainPrefix, req.Address); entry != nil && !entry.IsExpired(height) {
+			entries = append(entries, *entry)
+		}
+		return &types.QueryBlacklistResponse{Entries: entries}, nil
+	}
+
+	entries := []types.BlacklistEntry{}
+	k.IterateBlacklist(ctx, req.EvmChainPrefix, func(entry types.BlacklistEntry) (stop bool) {
+		if !entry.IsExpired(height) {
+			entries = append(entries, entry)
+		}
+		return false
+	})
+	return &types.QueryBlacklistResponse{Entries: entries}, nil
+}
+
+// GetBatchStrategies returns the batch strategy of every token of the evm chain which has one, tokens without a
+// strategy are batched by fee desc
+func (k Keeper) GetBatchStrategies(
+	c context.Context,
+	req *types.QueryBatchStrategiesRequest,
+) (*types.QueryBatchStrategiesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	if k.GetEvmChainData(ctx, req.EvmChainPrefix) == nil {
+		return nil, sdkerrors.Wrapf(types.ErrEvmChainNotFound, "evm chain prefix %s", req.EvmChainPrefix)
+	}
+	strategies := k.BatchStrategies(ctx, req.EvmChainPrefix)
+	if strategies == nil {
+		strategies = []types.TokenBatchStrategy{}
+	}
+	return &types.QueryBatchStrategiesResponse{
+		Strategies:   strategies,
+		MaxBatchSize: uint64(k.MaxBatchSize(ctx, req.EvmChainPrefix)),
+	}, nil
+}
+
+// GetBridgedSupply returns the bridged supply ledger of the evm chain: the outstanding vouchers of evm originated
+// tokens and the coins locked for the chain of cosmos originated tokens
+func (k Keeper) GetBridgedSupply(
+	c context.Context,
+	req *types.QueryBridgedSupplyRequest,
+) (*types.QueryBridgedSupplyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	if k.GetEvmChainData(ctx, req.EvmChainPrefix) == nil {
+		return nil, sdkerrors.Wrapf(types.ErrEvmChainNotFound, "evm chain prefix %s", req.EvmChainPrefix)
+	}
+	supply := k.BridgedSupply(ctx, req.EvmChainPrefix)
+	if supply == nil {
+		supply = []types.ERC20Token{}
+	}
+	return &types.QueryBridgedSupplyResponse{Supply: supply}, nil
+}
+
+// GetCheckpoint computes the checkpoint of a stored valset, batch or logic call and recovers the signer of each of its
+// confirms, flagging those which were not signed by the delegate ethereum key of the orchestrator's validator
+func (k Keeper) GetCheckpoint(
+	c context.Context,
+	req *types.QueryCheckpointRequest,
+) (*types.QueryCheckpointResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	if k.GetEvmChainData(ctx, req.EvmChainPrefix) == nil {
+		return nil, sdkerrors.Wrapf(types.ErrEvmChainNotFound, "evm chain prefix %s", req.EvmChainPrefix)
+	}
+
+	if _, err := types.NewCheckpointSubject(req.Kind); err != nil {
+		return nil, err
+	}
+
+	type confirm struct{ orchestrator, ethSigner, signature string }
+	var (
+		subject  types.EthereumSigned
+		confirms []confirm
+	)
+	switch req.Kind {
+	case types.CheckpointKindValset:
+		valset := k.GetValset(ctx, req.EvmChainPrefix, req.Nonce)
+		if valset == nil {
+			return nil, sdkerrors.Wrapf(types.ErrInvalid, "no valset with nonce %d", req.Nonce)
+		}
+		subject = valset
+		for _, conf := range k.GetValsetConfirms(ctx, req.EvmChainPrefix, req.Nonce) {
+			confirms = append(confirms, confirm{conf.Orchestrator, conf.EthAddress, conf.Signature})
+		}
+
+	case types.CheckpointKindBatch:
+		batch, err := k.checkpointBatch(ctx, req.EvmChainPrefix, req.Nonce, req.TokenContract)
+		if err != nil {
+			return nil, err
+		}
+		subject = batch.ToExternal()
+		for _, conf := range k.GetBatchConfirmByNonceAndTokenContract(ctx, req.EvmChainPrefix, batch.BatchNonce, batch.TokenContract) {
+			confirms = append(confirms, confirm{conf.Orchestrator, conf.EthSigner, conf.Signature})
+		}
+
+	case types.CheckpointKindLogicCall:
+		call, err := k.checkpointLogicCall(ctx, req.EvmChainPrefix, req.Nonce, req.InvalidationId)
+		if err != nil {
+			return nil, err
+		}
+		subject = call
+		for _, conf := range k.GetLogicConfirmsByInvalidationIDAndNonce(ctx, req.EvmChainPrefix, call.InvalidationId, call.InvalidationNonce) {
+			confirms = append(confirms, confirm{conf.Orchestrator, conf.EthSigner, conf.Signature})
+		}
+	}
+
+	res := types.NewQueryCheckpointResponse(k.GetGravityID(ctx, req.EvmChainPrefix), subject)
+	checkpoint := subject.GetCheckpoint(res.GravityId)
+	for _, conf := range confirms {
+		res.Confirms = append(res.Confirms, types.NewCheckpointConfirm(checkpoint, conf.orchestrator, conf.ethSigner, conf.signature, k.orchestratorDelegateEthAddress(ctx, conf.orchestrator)))
+	}
+	return &res, nil
+}
+
+// checkpointBatch finds the batch with the given nonce, of the given token contract unless it is empty
+func (k Keeper) checkpointBatch(ctx sdk.Context, evmChainPrefix string, nonce uint64, tokenContract string) (*types.InternalOutgoingTxBatch, error) {
+	if tokenContract != "" {
+		contract, err := types.NewEthAddress(tokenContract)
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, "invalid token contract")
+		}
+		if batch := k.GetOutgoingTxBatch(ctx, evmChainPrefix, *contract, nonce); batch != nil {
+			return batch, nil
+		}
+		return nil, sdkerrors.Wrapf(types.ErrInvalid, "no batch of %s with nonce %d", tokenContract, nonce)
+	}
+	var found *types.InternalOutgoingTxBatch
+	k.IterateOutgoingTxBatches(ctx, evmChainPrefix, func(_ []byte, batch types.InternalOutgoingTxBatch) bool {
+		if batch.BatchNonce == nonce {
+			found = &batch
+			return true
+		}
+		return false
+	})
+	if found == nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalid, "no batch with nonce %d", nonce)
+	}
+	return found, nil
+}
+
+// checkpointLogicCall finds the logic call with the given invalidation nonce, of the given hex invalidation id unless
+// it is empty, in which case the nonce must identify a single logic call
+func (k Keeper) checkpointLogicCall(ctx sdk.Context, evmChainPrefix string, nonce uint64, invalidationID string) (*types.OutgoingLogicCall, error) {
+	if invalidationID != "" {
+		id, err := hex.DecodeString(invalidationID)
+		if err != nil {
+			return nil, sdkerrors.Wrap(types.ErrInvalid, "invalidation id encoding")
+		}
+		if call := k.GetOutgoingLogicCall(ctx, evmChainPrefix, id, nonce); call != nil {
+			return call, nil
+		}
+		return nil, sdkerrors.Wrapf(types.ErrInvalid, "no logic call %s with invalidation nonce %d", invalidationID, nonce)
+	}
+	var found []types.OutgoingLogicCall
+	k.IterateOutgoingLogicCalls(ctx, evmChainPrefix, func(_ []byte, call types.OutgoingLogicCall) bool {
+		if call.InvalidationNonce == nonce {
+			found = append(found, call)
+		}
+		return false
+	})
+	switch len(found) {
+	case 0:
+		return nil, sdkerrors.Wrapf(types.ErrInvalid, "no logic call with invalidation nonce %d", nonce)
+	case 1:
+		return &found[0], nil
+	default:
+		return nil, sdkerrors.Wrapf(types.ErrInvalid, "%d logic calls with invalidation nonce %d, specify the invalidation id", len(found), nonce)
+	}
+}
+
+// orchestratorDelegateEthAddress returns the delegate ethereum key of the orchestrator's validator, nil if it has none
+func (k Keeper) orchestratorDelegateEthAddress(ctx sdk.Context, orchestrator string) *types.EthAddress {
+	orch, err := sdk.AccAddressFromBech32(orchestrator)
+	if err != nil {
+		return nil
+	}
+	validator, found := k.GetOrchestratorValidatorAddr(ctx, orch)
+	if !found {
+		return nil
+	}
+	ethAddress, found := k.GetEvmAddressByValidator(ctx, validator)
+	if !found {
+		return nil
+	}
+	return ethAddress
+}
+
+// GetEvidenceRecords queries the evidence of bad and double signing accepted on an evm chain
+func (k Keeper) GetEvidenceRecords(
+	c context.Context,
+	req *types.QueryEvidenceRecordsRequest,
+) (*types.QueryEvidenceRecordsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	if k.GetEvmChainData(ctx, req.EvmChainPrefix) == nil {
+		return nil, sdkerrors.Wrapf(types.ErrEvmChainNotFound, "evm chain prefix %s", req.EvmChainPrefix)
+	}
+	if req.Offender == "" {
+		return &types.QueryEvidenceRecordsResponse{Records: k.EvidenceRecords(ctx, req.EvmChainPrefix)}, nil
+	}
+	offender, err := sdk.ValAddressFromBech32(req.Offender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, req.Offender)
+	}
+	records := []types.EvidenceRecord{}
+	k.IterateEvidenceRecords(ctx, req.EvmChainPrefix, func(record types.EvidenceRecord) (stop bool) {
+		if record.Offender == offender.String() {
+			records = append(records, record)
+		}
+		return false
+	})
+	return &types.QueryEvidenceRecordsResponse{Records: records}, nil
+}
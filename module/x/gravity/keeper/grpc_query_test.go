@@ -2,8 +2,13 @@ package keeper_test
 
 import (
 	gocontext "context"
+	"crypto/ecdsa"
+	"encoding/hex"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+
 	"github.com/Gravity-Bridge/Gravity-Bridge/module/app"
 	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/keeper"
 	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
@@ -167,3 +172,89 @@ func createAttestations(t *testing.T, k keeper.Keeper, ctx sdk.Context, evmChain
 		k.SetAttestation(ctx, evmChainPrefix, nonce, hash, att)
 	}
 }
+
+// nolint: exhaustruct
+func TestQueryGetCheckpoint(t *testing.T) {
+	input := keeper.CreateTestEnv(t)
+	encCfg := app.MakeEncodingConfig()
+	k := input.GravityKeeper
+	ctx := input.Context
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, encCfg.InterfaceRegistry)
+	types.RegisterQueryServer(queryHelper, k)
+	queryClient := types.NewQueryClient(queryHelper)
+
+	// two validators with delegate keys, the second of which confirms with another key
+	var ethAddrs []*types.EthAddress
+	var privKeys []*ecdsa.PrivateKey
+	for i := 0; i < 3; i++ {
+		privKey, err := crypto.GenerateKey()
+		require.NoError(t, err)
+		ethAddr, err := types.NewEthAddress(crypto.PubkeyToAddress(privKey.PublicKey).Hex())
+		require.NoError(t, err)
+		privKeys = append(privKeys, privKey)
+		ethAddrs = append(ethAddrs, ethAddr)
+	}
+	for i := 0; i < 2; i++ {
+		k.SetEvmAddressForValidator(ctx, keeper.ValAddrs[i], *ethAddrs[i])
+		k.SetOrchestratorValidator(ctx, keeper.ValAddrs[i], keeper.AccAddrs[i])
+	}
+
+	valset := types.Valset{
+		Nonce:        7,
+		Members:      []types.BridgeValidator{{Power: 100, EthereumAddress: ethAddrs[0].GetAddress().Hex()}},
+		Height:       1,
+		RewardAmount: sdk.ZeroInt(),
+		RewardToken:  types.ZeroAddressString,
+	}
+	k.StoreValset(ctx, keeper.EthChainPrefix, valset)
+	gravityID := k.GetGravityID(ctx, keeper.EthChainPrefix)
+	checkpoint := valset.GetCheckpoint(gravityID)
+	for i, signer := range []int{0, 2} {
+		signature, err := types.NewEthereumSignature(checkpoint, privKeys[signer])
+		require.NoError(t, err)
+		k.SetValsetConfirm(ctx, types.MsgValsetConfirm{
+			Nonce:          valset.Nonce,
+			Orchestrator:   keeper.AccAddrs[i].String(),
+			EthAddress:     ethAddrs[signer].GetAddress().Hex(),
+			Signature:      hex.EncodeToString(signature),
+			EvmChainPrefix: keeper.EthChainPrefix,
+		})
+	}
+
+	res, err := queryClient.GetCheckpoint(gocontext.Background(), &types.QueryCheckpointRequest{
+		EvmChainPrefix: keeper.EthChainPrefix,
+		Kind:           types.CheckpointKindValset,
+		Nonce:          valset.Nonce,
+	})
+	require.NoError(t, err)
+	require.Equal(t, gravityID, res.GravityId)
+	require.Equal(t, hex.EncodeToString(checkpoint), res.Checkpoint)
+	require.Equal(t, hex.EncodeToString(crypto.Keccak256(hexutil.MustDecode("0x"+res.AbiEncoded))), res.Checkpoint)
+	require.Len(t, res.Confirms, 2)
+	confirms := map[string]types.CheckpointConfirm{}
+	for _, confirm := range res.Confirms {
+		confirms[confirm.Orchestrator] = confirm
+	}
+	valid := confirms[keeper.AccAddrs[0].String()]
+	require.True(t, valid.Valid)
+	require.Equal(t, ethAddrs[0].GetAddress().Hex(), valid.RecoveredSigner)
+	invalid := confirms[keeper.AccAddrs[1].String()]
+	require.False(t, invalid.Valid)
+	require.Equal(t, ethAddrs[2].GetAddress().Hex(), invalid.RecoveredSigner)
+	require.Equal(t, ethAddrs[1].GetAddress().Hex(), invalid.DelegateEthAddress)
+	require.NotEmpty(t, invalid.Error)
+
+	// unknown kinds, nonces and chains are rejected
+	for _, req := range []types.QueryCheckpointRequest{
+		{EvmChainPrefix: keeper.EthChainPrefix, Kind: "checkpoint", Nonce: valset.Nonce},
+		{EvmChainPrefix: keeper.EthChainPrefix, Kind: types.CheckpointKindValset, Nonce: valset.Nonce + 1},
+		{EvmChainPrefix: keeper.EthChainPrefix, Kind: types.CheckpointKindBatch, Nonce: 1},
+		{EvmChainPrefix: keeper.EthChainPrefix, Kind: types.CheckpointKindLogicCall, Nonce: 1},
+		{EvmChainPrefix: "unknown", Kind: types.CheckpointKindValset, Nonce: valset.Nonce},
+	} {
+		req := req
+		_, err := queryClient.GetCheckpoint(gocontext.Background(), &req)
+		require.Error(t, err, req.String())
+	}
+}
@@ -6,6 +6,8 @@ Flow: On processing a SendToCosmos attestation in attestation handler with a for
   clear the queue and move the funds to their destination chains over IBC.
 This queue is necessary due to a Tendermint bug where ctx.EventManager().EmitEvent() has no effect when called from
   EndBlocker. The queue allows processing SendToCosmos attestations from EndBlocker while emitting events from DeliverTx.
+An evm chain may opt in to draining the queue from EndBlocker by setting IbcAutoForwardsPerBlock, the outcome of every
+  processed forward is recorded in the IbcAutoForwardLog store so that forwards processed there remain observable.
 */
 
 package keeper
@@ -205,12 +207,25 @@ func (k Keeper) ProcessNextPendingIbcAutoForward(ctx sdk.Context, evmChainPrefix
 
 	// Make the ibc-transfer attempt
 	wCtx := sdk.WrapSDKContext(ctx)
-	_, recoverableErr := k.ibcTransferKeeper.Transfer(wCtx, &msgTransfer)
+	res, recoverableErr := k.ibcTransferKeeper.Transfer(wCtx, &msgTransfer)
 	ctx = sdk.UnwrapSDKContext(wCtx)
 
 	// Log + emit event
 	if recoverableErr == nil {
-		k.logEmitIbcForwardSuccessEvent(ctx, *forward, msgTransfer)
+		// track the packet until the counterparty acknowledges it or it times out
+		k.setIbcAutoForwardTransfer(ctx, types.IbcAutoForwardTransfer{
+			EvmChainPrefix:  evmChainPrefix,
+			EventNonce:      forward.EventNonce,
+			Port:            portId,
+			Channel:         forward.IbcChannel,
+			Sequence:        res.Sequence,
+			ForeignReceiver: forward.ForeignReceiver,
+			Fallback:        msgTransfer.Sender,
+			Token:           *forward.Token,
+			Status:          types.IBC_AUTO_FORWARD_STATUS_IN_FLIGHT,
+			SentBlockHeight: uint64(ctx.BlockHeight()),
+		})
+		k.logEmitIbcForwardSuccessEvent(ctx, evmChainPrefix, *forward, msgTransfer)
 	} else {
 		// Funds have already been sent to the fallback user, emit a failure log
 		/*
@@ -228,7 +243,7 @@ func (k Keeper) ProcessNextPendingIbcAutoForward(ctx sdk.Context, evmChainPrefix
 			9. Could not send packet to the channel e.g. connection issues, misconfigured packet, timeouts, sequences
 			    (local receiver)
 		*/
-		k.logEmitIbcForwardFailureEvent(ctx, *forward, recoverableErr)
+		k.logEmitIbcForwardFailureEvent(ctx, evmChainPrefix, *forward, recoverableErr)
 	}
 	return false, nil // Error case has been handled, funds are in receiver's control locally or on IBC chain
 }
@@ -262,10 +277,11 @@ func thirtyDaysInFuture(ctx sdk.Context) time.Time {
 	return approxNow.Add(time.Hour * 24 * 30)
 }
 
-// logEmitIbcForwardSuccessEvent logs for successful IBC Auto-Forwarding and emits a
-// EventSendToCosmosExecutedIbcAutoForward type event
+// logEmitIbcForwardSuccessEvent logs for successful IBC Auto-Forwarding, records it in the IbcAutoForwardLog and
+// emits a EventSendToCosmosExecutedIbcAutoForward type event
 func (k Keeper) logEmitIbcForwardSuccessEvent(
 	ctx sdk.Context,
+	evmChainPrefix string,
 	forward types.PendingIbcAutoForward,
 	msgTransfer ibctransfertypes.MsgTransfer,
 ) {
@@ -275,6 +291,17 @@ func (k Keeper) logEmitIbcForwardSuccessEvent(
 		"claimNonce", forward.EventNonce, "cosmosBlockHeight", ctx.BlockHeight(),
 	)
 
+	k.setIbcAutoForwardLog(ctx, types.IbcAutoForwardLog{
+		EvmChainPrefix:    evmChainPrefix,
+		EventNonce:        forward.EventNonce,
+		ForeignReceiver:   forward.ForeignReceiver,
+		Token:             *forward.Token,
+		IbcChannel:        forward.IbcChannel,
+		Forwarded:         true,
+		TimeoutTimestamp:  msgTransfer.TimeoutTimestamp,
+		CosmosBlockHeight: uint64(ctx.BlockHeight()),
+	})
+
 	err := ctx.EventManager().EmitTypedEvent(&types.EventSendToCosmosExecutedIbcAutoForward{
 		Nonce:         fmt.Sprint(forward.EventNonce),
 		Receiver:      forward.ForeignReceiver,
@@ -289,14 +316,26 @@ func (k Keeper) logEmitIbcForwardSuccessEvent(
 	}
 }
 
-// logEmitIbcForwardFailureEvent logs failed IBC Auto-Forwarding and emits a EventSendToCosmosLocal type event
-func (k Keeper) logEmitIbcForwardFailureEvent(ctx sdk.Context, forward types.PendingIbcAutoForward, err error) {
+// logEmitIbcForwardFailureEvent logs failed IBC Auto-Forwarding, records it in the IbcAutoForwardLog and emits a
+// EventSendToCosmosLocal type event
+func (k Keeper) logEmitIbcForwardFailureEvent(ctx sdk.Context, evmChainPrefix string, forward types.PendingIbcAutoForward, err error) {
 	k.logger(ctx).Error("SendToCosmos IBC Auto-Forward Failure: funds sent to local address",
 		"foreignReceiver", forward.ForeignReceiver, "denom", forward.Token.Denom, "amount", forward.Token.Amount.String(),
 		"failedIbcPort", ibctransfertypes.PortID, "failedIbcChannel", forward.IbcChannel,
 		"claimNonce", forward.EventNonce, "cosmosBlockHeight", ctx.BlockHeight(), "err", err,
 	)
 
+	k.setIbcAutoForwardLog(ctx, types.IbcAutoForwardLog{
+		EvmChainPrefix:    evmChainPrefix,
+		EventNonce:        forward.EventNonce,
+		ForeignReceiver:   forward.ForeignReceiver,
+		Token:             *forward.Token,
+		IbcChannel:        forward.IbcChannel,
+		Forwarded:         false,
+		Error:             err.Error(),
+		CosmosBlockHeight: uint64(ctx.BlockHeight()),
+	})
+
 	er := ctx.EventManager().EmitTypedEvent(&types.EventSendToCosmosLocal{
 		Nonce:    fmt.Sprint(forward.EventNonce),
 		Receiver: forward.ForeignReceiver,
@@ -307,3 +346,33 @@ func (k Keeper) logEmitIbcForwardFailureEvent(ctx sdk.Context, forward types.Pen
 		panic(err)
 	}
 }
+
+// GetIbcAutoForwardLog returns the recorded outcome of the IBC Auto-Forward with the given event nonce, or nil if
+// it has not been processed or its log has since been pruned
+func (k Keeper) GetIbcAutoForwardLog(ctx sdk.Context, evmChainPrefix string, eventNonce uint64) *types.IbcAutoForwardLog {
+	return getChainRecord[types.IbcAutoForwardLog](ctx, k, types.GetIbcAutoForwardLogKey(evmChainPrefix, eventNonce))
+}
+
+// setIbcAutoForwardLog records the outcome of processing an IBC Auto-Forward
+func (k Keeper) setIbcAutoForwardLog(ctx sdk.Context, log types.IbcAutoForwardLog) {
+	k.setChainRecord(ctx, types.GetIbcAutoForwardLogKey(log.EvmChainPrefix, log.EventNonce), &log)
+}
+
+// DeleteIbcAutoForwardLog removes the log of the IBC Auto-Forward with the given event nonce
+func (k Keeper) DeleteIbcAutoForwardLog(ctx sdk.Context, evmChainPrefix string, eventNonce uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetIbcAutoForwardLogKey(evmChainPrefix, eventNonce))
+}
+
+// IterateIbcAutoForwardLogs executes the given callback on each IBC Auto-Forward log of the evm chain in order of
+// event nonce, newest first when reverse is true
+// cb should return true to stop iteration, false to continue
+func (k Keeper) IterateIbcAutoForwardLogs(ctx sdk.Context, evmChainPrefix string, reverse bool, cb func(log types.IbcAutoForwardLog) (stop bool)) {
+	iterateChainRecords(ctx, k, types.IbcAutoForwardLogKey, evmChainPrefix, reverse, cb)
+}
+
+// IbcAutoForwardLogs returns the IBC Auto-Forward logs of the evm chain newest first, up to `limit` logs when
+// limit is non zero
+func (k Keeper) IbcAutoForwardLogs(ctx sdk.Context, evmChainPrefix string, limit uint64) []types.IbcAutoForwardLog {
+	return chainRecords[types.IbcAutoForwardLog](ctx, k, types.IbcAutoForwardLogKey, evmChainPrefix, true, limit)
+}
@@ -0,0 +1,146 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// IbcAutoForwardTransferByPacket returns the IBC Auto-Forward transfer sent as packet `sequence` on `channel`, or nil if
+// the packet was not sent for an IBC Auto-Forward
+func (k Keeper) IbcAutoForwardTransferByPacket(ctx sdk.Context, channel string, sequence uint64) *types.IbcAutoForwardTransfer {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetIbcAutoForwardTransferKey(channel, sequence))
+	if len(bz) == 0 {
+		return nil
+	}
+	var transfer types.IbcAutoForwardTransfer
+	k.cdc.MustUnmarshal(bz, &transfer)
+	return &transfer
+}
+
+// IbcAutoForwardTransferByNonce returns the IBC Auto-Forward transfer sent for the deposit with the given event
+// nonce, or nil if no transfer was sent for it or its record has since been pruned
+func (k Keeper) IbcAutoForwardTransferByNonce(ctx sdk.Context, evmChainPrefix string, eventNonce uint64) *types.IbcAutoForwardTransfer {
+	store := ctx.KVStore(k.storeKey)
+	key := store.Get(types.GetIbcAutoForwardTransferByNonceKey(evmChainPrefix, eventNonce))
+	if len(key) == 0 {
+		return nil
+	}
+	bz := store.Get(key)
+	if len(bz) == 0 {
+		return nil
+	}
+	var transfer types.IbcAutoForwardTransfer
+	k.cdc.MustUnmarshal(bz, &transfer)
+	return &transfer
+}
+
+// setIbcAutoForwardTransfer stores the IBC Auto-Forward transfer under its packet and indexes it by event nonce
+func (k Keeper) setIbcAutoForwardTransfer(ctx sdk.Context, transfer types.IbcAutoForwardTransfer) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetIbcAutoForwardTransferKey(transfer.Channel, transfer.Sequence)
+	store.Set(key, k.cdc.MustMarshal(&transfer))
+	store.Set(types.GetIbcAutoForwardTransferByNonceKey(transfer.EvmChainPrefix, transfer.EventNonce), key)
+}
+
+// DeleteIbcAutoForwardTransfer removes the IBC Auto-Forward transfer and its event nonce index
+func (k Keeper) DeleteIbcAutoForwardTransfer(ctx sdk.Context, transfer types.IbcAutoForwardTransfer) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetIbcAutoForwardTransferKey(transfer.Channel, transfer.Sequence))
+	store.Delete(types.GetIbcAutoForwardTransferByNonceKey(transfer.EvmChainPrefix, transfer.EventNonce))
+}
+
+// IterateIbcAutoForwardTransfers executes the given callback on each IBC Auto-Forward transfer of the evm chain in
+// order of event nonce
+// cb should return true to stop iteration, false to continue
+func (k Keeper) IterateIbcAutoForwardTransfers(ctx sdk.Context, evmChainPrefix string, cb func(transfer types.IbcAutoForwardTransfer) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.AppendDelimitedChainPrefix(types.IbcAutoForwardTransferByNonceKey, evmChainPrefix))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		bz := store.Get(iter.Value())
+		if len(bz) == 0 {
+			panic(fmt.Sprintf("IBC Auto-Forward transfer index %X points to nothing", iter.Value()))
+		}
+		var transfer types.IbcAutoForwardTransfer
+		k.cdc.MustUnmarshal(bz, &transfer)
+		if cb(transfer) {
+			break
+		}
+	}
+}
+
+// IbcAutoForwardTransfers returns every IBC Auto-Forward transfer of the evm chain, ordered by event nonce
+func (k Keeper) IbcAutoForwardTransfers(ctx sdk.Context, evmChainPrefix string) []types.IbcAutoForwardTransfer {
+	transfers := []types.IbcAutoForwardTransfer{}
+	k.IterateIbcAutoForwardTransfers(ctx, evmChainPrefix, func(transfer types.IbcAutoForwardTransfer) (stop bool) {
+		transfers = append(transfers, transfer)
+		return false
+	})
+	return transfers
+}
+
+// OnAcknowledgementPacket records the final status of the IBC Auto-Forward transfer sent as `packet`, packets which
+// were not sent for an IBC Auto-Forward are ignored.
+// CONTRACT: This must be called after the ICS20 OnAcknowledgementPacket succeeded, which refunds the fallback account
+// on an error acknowledgement
+func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte) error {
+	transfer := k.IbcAutoForwardTransferByPacket(ctx, packet.SourceChannel, packet.Sequence)
+	if transfer == nil || transfer.IsCompleted() {
+		return nil
+	}
+
+	var ack channeltypes.Acknowledgement
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet acknowledgement: %v", err)
+	}
+	switch resp := ack.Response.(type) {
+	case *channeltypes.Acknowledgement_Error:
+		transfer.Status = types.IBC_AUTO_FORWARD_STATUS_FAILED
+		transfer.Error = resp.Error
+	default:
+		transfer.Status = types.IBC_AUTO_FORWARD_STATUS_ACKNOWLEDGED
+	}
+	return k.completeIbcAutoForwardTransfer(ctx, *transfer)
+}
+
+// OnTimeoutPacket records the timeout of the IBC Auto-Forward transfer sent as `packet`, packets which were not sent
+// for an IBC Auto-Forward are ignored.
+// CONTRACT: This must be called after the ICS20 OnTimeoutPacket succeeded, which refunds the fallback account
+func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet) error {
+	transfer := k.IbcAutoForwardTransferByPacket(ctx, packet.SourceChannel, packet.Sequence)
+	if transfer == nil || transfer.IsCompleted() {
+		return nil
+	}
+	transfer.Status = types.IBC_AUTO_FORWARD_STATUS_TIMED_OUT
+	return k.completeIbcAutoForwardTransfer(ctx, *transfer)
+}
+
+// completeIbcAutoForwardTransfer stores the final status of an IBC Auto-Forward transfer and emits a
+// EventIbcAutoForwardCompleted type event
+func (k Keeper) completeIbcAutoForwardTransfer(ctx sdk.Context, transfer types.IbcAutoForwardTransfer) error {
+	transfer.CompletedBlockHeight = uint64(ctx.BlockHeight())
+	k.setIbcAutoForwardTransfer(ctx, transfer)
+
+	k.logger(ctx).Info("SendToCosmos IBC Auto-Forward completed", "status", transfer.Status.String(),
+		"ibcChannel", transfer.Channel, "sequence", transfer.Sequence, "claimNonce", transfer.EventNonce,
+		"evmChainPrefix", transfer.EvmChainPrefix, "error", transfer.Error,
+	)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventIbcAutoForwardCompleted{
+		Nonce:          fmt.Sprint(transfer.EventNonce),
+		EvmChainPrefix: transfer.EvmChainPrefix,
+		Channel:        transfer.Channel,
+		Sequence:       fmt.Sprint(transfer.Sequence),
+		Status:         transfer.Status.String(),
+		Error:          transfer.Error,
+	})
+}
@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// GetIbcBridgeFee returns the default bridge fee of IBC transfers of `denom` forwarded to the evm chain, or nil
+// if governance has not set one
+func (k Keeper) GetIbcBridgeFee(ctx sdk.Context, evmChainPrefix string, denom string) *types.IbcBridgeFee {
+	return getChainRecord[types.IbcBridgeFee](ctx, k, types.GetIbcBridgeFeeKey(evmChainPrefix, denom))
+}
+
+// SetIbcBridgeFee stores the given default bridge fee
+func (k Keeper) SetIbcBridgeFee(ctx sdk.Context, fee types.IbcBridgeFee) {
+	k.setChainRecord(ctx, types.GetIbcBridgeFeeKey(fee.EvmChainPrefix, fee.Denom), &fee)
+}
+
+// DeleteIbcBridgeFee removes the default bridge fee of `denom` on the evm chain
+func (k Keeper) DeleteIbcBridgeFee(ctx sdk.Context, evmChainPrefix string, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetIbcBridgeFeeKey(evmChainPrefix, denom))
+}
+
+// IterateIbcBridgeFees executes the given callback on each default bridge fee of the evm chain, ordered by denom
+// cb should return true to stop iteration, false to continue
+func (k Keeper) IterateIbcBridgeFees(ctx sdk.Context, evmChainPrefix string, cb func(fee types.IbcBridgeFee) (stop bool)) {
+	iterateChainRecords(ctx, k, types.IbcBridgeFeeKey, evmChainPrefix, false, cb)
+}
+
+// IbcBridgeFees returns every default bridge fee of the evm chain, ordered by denom
+func (k Keeper) IbcBridgeFees(ctx sdk.Context, evmChainPrefix string) []types.IbcBridgeFee {
+	return chainRecords[types.IbcBridgeFee](ctx, k, types.IbcBridgeFeeKey, evmChainPrefix, false, 0)
+}
+
+// ibcSendToEthFees determines the bridge and chain fees paid by an IBC transfer of `amount` forwarded to the evm
+// chain. Fees named in the memo take precedence, otherwise the bridge fee falls back to the governance set default
+// of the denom and the chain fee to the minimum required by MinChainFeeBasisPoints. The returned send amount is what
+// remains of `amount` once both fees are taken out.
+func (k Keeper) ibcSendToEthFees(
+	ctx sdk.Context, memo types.SendToEthMemo, amount sdk.Coin,
+) (sendAmount sdk.Coin, bridgeFee sdk.Coin, chainFee sdk.Coin, err error) {
+	bridgeFee = sdk.NewCoin(amount.Denom, sdk.ZeroInt())
+	if memo.BridgeFee != nil {
+		bridgeFee.Amount = *memo.BridgeFee
+	} else if fee := k.GetIbcBridgeFee(ctx, memo.EvmChainPrefix, amount.Denom); fee != nil {
+		bridgeFee.Amount = fee.BridgeFee
+	}
+
+	chainFee = sdk.NewCoin(amount.Denom, sdk.ZeroInt())
+	if memo.ChainFee != nil {
+		chainFee.Amount = *memo.ChainFee
+	} else {
+		// the minimum is computed on the amount actually bridged, which itself depends on the chain fee,
+		// so use the minimum of the whole transfer which is never less than required
+		chainFee.Amount = k.minChainFee(ctx, amount)
+	}
+
+	fees := bridgeFee.Add(chainFee)
+	if !amount.IsGTE(fees) || amount.IsEqual(fees) {
+		return sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, sdkerrors.Wrapf(
+			sdkerrors.ErrInsufficientFunds, "transfer of %s cannot pay bridge fee %s and chain fee %s", amount, bridgeFee, chainFee,
+		)
+	}
+	return amount.Sub(fees), bridgeFee, chainFee, nil
+}
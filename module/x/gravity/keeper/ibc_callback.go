@@ -2,10 +2,10 @@ package keeper
 
 import (
 	"fmt"
-	"strings"
 
 	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
@@ -75,8 +75,11 @@ func GetReceivedCoin(srcPort, srcChannel, dstPort, dstChannel, rawDenom, rawAmt
 // CONTRACT: This middleware MUST be executed transfer after the ICS20 OnRecvPacket
 // Return acknowledgement and continue with the next layer of the IBC middleware
 // stack if:
-// - memo is not EthDest
+// - memo holds no instructions for gravity, see types.ParseSendToEthMemo
 // - The base denomination is not registered as ERC20
+//
+// The originating packet of every pool transaction created here is recorded, cancelling the transaction or having
+// its batch time out sends the funds back over IBC to the counterparty sender, see refundToIbcOrigin
 func (k Keeper) OnRecvPacket(
 	ctx sdk.Context,
 	packet channeltypes.Packet,
@@ -90,14 +93,15 @@ func (k Keeper) OnRecvPacket(
 		return ack
 	}
 
-	// check memo format is <evm chain prefix>0xabc...
-	var ind int
-	if ind = strings.Index(data.Memo, "0x"); ind == -1 {
+	// check memo format is {"gravity":{...}} or the legacy <evm chain prefix>0xabc..., optionally followed by fees
+	memo, err := types.ParseSendToEthMemo(data.Memo)
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+	if memo == nil {
 		return ack
 	}
-
-	evmChainPrefix := data.Memo[:ind]
-	ethDest := data.Memo[ind:]
+	evmChainPrefix := memo.EvmChainPrefix
 
 	// Receiver become sender when send evm_prefix + contract_address token to evm
 	sender, err := sdk.AccAddressFromBech32(data.Receiver)
@@ -119,7 +123,24 @@ func (k Keeper) OnRecvPacket(
 		data.Denom, data.Amount,
 	)
 
-	dest, err := types.NewEthAddress(ethDest)
+	// the refund address becomes the owner of the transfer, receiving the funds if it is ever cancelled
+	if memo.Refund != "" {
+		refund, err := sdk.AccAddressFromBech32(memo.Refund)
+		if err != nil {
+			return channeltypes.NewErrorAcknowledgement(sdkerrors.Wrap(err, "invalid memo refund address"))
+		}
+		if refundAcc := k.accountKeeper.GetAccount(ctx, refund); refundAcc != nil && IsModuleAccount(refundAcc) {
+			return channeltypes.NewErrorAcknowledgement(sdkerrors.Wrap(types.ErrInvalid, "memo refund address is a module account"))
+		}
+		if !refund.Equals(sender) {
+			if err := k.bankKeeper.SendCoins(ctx, sender, refund, sdk.NewCoins(coin)); err != nil {
+				return channeltypes.NewErrorAcknowledgement(sdkerrors.Wrap(err, "unable to move funds to memo refund address"))
+			}
+			sender = refund
+		}
+	}
+
+	dest, err := types.NewEthAddress(memo.Destination)
 	if err != nil {
 		return channeltypes.NewErrorAcknowledgement(err)
 	}
@@ -131,20 +152,43 @@ func (k Keeper) OnRecvPacket(
 	if k.InvalidSendToEthAddress(ctx, evmChainPrefix, *dest, *erc20) {
 		return channeltypes.NewErrorAcknowledgement(sdkerrors.Wrap(types.ErrInvalid, "destination address is invalid or blacklisted"))
 	}
+	// the counterparty sender is matched on its account bytes, senders which are not bech32 cannot be blacklisted
+	if _, counterpartySender, err := bech32.DecodeAndConvert(data.Sender); err == nil && k.IsOnCosmosBlacklist(ctx, evmChainPrefix, counterpartySender) {
+		return channeltypes.NewErrorAcknowledgement(sdkerrors.Wrapf(types.ErrBlacklisted, "counterparty sender %s", data.Sender))
+	}
+	if k.IsOnCosmosBlacklist(ctx, evmChainPrefix, sender) {
+		return channeltypes.NewErrorAcknowledgement(sdkerrors.Wrapf(types.ErrBlacklisted, "sender %s", sender.String()))
+	}
 
-	batchFees := sdk.ZeroInt()
-	params, err := k.GetParamsIfSet(ctx)
-	if err == nil {
-		// The params have been set, get the min send to eth fee
-		batchFees = sdk.NewInt(int64(params.MinChainFeeBasisPoints))
+	sendAmount, bridgeFee, chainFee, err := k.ibcSendToEthFees(ctx, *memo, coin)
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	// Collect the chain fee and give to stakers, ensuring it meets MinChainFeeBasisPoints
+	if err := k.checkAndDeductSendToEthFees(ctx, sender, sendAmount, chainFee); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
 	}
 
 	// finally add to outgoing pool and waiting for gbt to submit it via MsgRequestBatch
-	txID, err := k.AddToOutgoingPool(ctx, evmChainPrefix, sender, *dest, coin, sdk.Coin{Denom: coin.Denom, Amount: batchFees})
+	txID, err := k.AddToOutgoingPool(ctx, evmChainPrefix, sender, *dest, sendAmount, bridgeFee)
 	if err != nil {
 		return channeltypes.NewErrorAcknowledgement(err)
 	}
 
+	// remember where the transfer came from so that cancelling it or timing it out returns the funds to the
+	// counterparty sender, unless the memo named a local refund address to own the transfer instead
+	if memo.Refund == "" {
+		k.SetIbcTransferOrigin(ctx, types.IbcTransferOrigin{
+			EvmChainPrefix: evmChainPrefix,
+			TxId:           txID,
+			Port:           packet.DestinationPort,
+			Channel:        packet.DestinationChannel,
+			Sequence:       packet.Sequence,
+			Sender:         data.Sender,
+		})
+	}
+
 	ctx.EventManager().EmitTypedEvent(
 		&types.EventOutgoingTxId{
 			Message: "send_to_eth",
@@ -8,6 +8,7 @@ import (
 	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	transfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v4/modules/core/04-channel/types"
@@ -96,6 +97,7 @@ func TestOnRecvPacket(t *testing.T) {
 							Contract: tokenContractAddr,
 							Amount:   sdk.NewInt(int64(params.MinChainFeeBasisPoints)),
 						},
+						CosmosBlockCreated: 1234567,
 					},
 				},
 
@@ -156,3 +158,339 @@ func TestOnRecvPacket(t *testing.T) {
 	}
 
 }
+
+func TestOnRecvPacketBridgeFees(t *testing.T) {
+	input := CreateTestEnv(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+
+	ctx := input.Context
+
+	var (
+		sourceChannel     = "channel-0"
+		oraibChannel      = "channel-1"
+		tokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+		ethDestAddr       = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+		myTokenDenom      = "bsc" + tokenContractAddr
+		ibcDenom          = fmt.Sprintf("ibc/%X", sha256.Sum256([]byte("transfer/"+oraibChannel+"/"+myTokenDenom)))
+	)
+
+	tokenAddr, err := types.NewEthAddress(tokenContractAddr)
+	require.NoError(t, err)
+	secpPk := secp256k1.GenPrivKey()
+	gravityAddr := sdk.AccAddress(secpPk.PubKey().Address())
+	oraiAddr := sdk.MustBech32ifyAddressBytes("orai", gravityAddr)
+
+	input.GravityKeeper.setCosmosOriginatedDenomToERC20(ctx, EthChainPrefix, ibcDenom, *tokenAddr)
+	input.IbcTransferKeeper.SetDenomTrace(ctx, transfertypes.DenomTrace{
+		Path:      fmt.Sprintf("%s/%s", transfertypes.PortID, oraibChannel),
+		BaseDenom: myTokenDenom,
+	})
+
+	// 1% minimum chain fee and a default bridge fee of 5 tokens
+	params := input.GravityKeeper.GetParams(ctx)
+	params.MinChainFeeBasisPoints = 100
+	input.GravityKeeper.SetParams(ctx, params)
+	require.NoError(t, input.GravityKeeper.HandleSetIbcBridgeFeeProposal(ctx, &types.SetIbcBridgeFeeProposal{
+		Title:          "test title",
+		Description:    "test description",
+		EvmChainPrefix: EthChainPrefix,
+		Denom:          ibcDenom,
+		BridgeFee:      sdk.NewInt(5),
+	}))
+
+	feeCollector := input.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	recv := func(sequence uint64, memo string) (ack bool, collected sdk.Int) {
+		transfer := transfertypes.NewFungibleTokenPacketData(myTokenDenom, "1000", oraiAddr, gravityAddr.String())
+		transfer.Memo = memo
+		bz := transfertypes.ModuleCdc.MustMarshalJSON(&transfer)
+		packet := channeltypes.NewPacket(bz, sequence, transfertypes.PortID, sourceChannel, transfertypes.PortID, oraibChannel, clienttypes.NewHeight(0, 100), 0)
+
+		// like the IBC core, only keep the state changes of successful acknowledgements
+		xCtx, commit := ctx.CacheContext()
+		coins := sdk.NewCoins(sdk.NewInt64Coin(ibcDenom, 1000))
+		require.NoError(t, input.BankKeeper.MintCoins(xCtx, types.ModuleName, coins))
+		require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(xCtx, types.ModuleName, gravityAddr, coins))
+		before := input.BankKeeper.GetBalance(xCtx, feeCollector, ibcDenom).Amount
+		res := input.GravityKeeper.OnRecvPacket(xCtx, packet, ibcmock.MockAcknowledgement)
+		if !res.Success() {
+			return false, sdk.ZeroInt()
+		}
+		commit()
+		return true, input.BankKeeper.GetBalance(ctx, feeCollector, ibcDenom).Amount.Sub(before)
+	}
+
+	// without fees in the memo the default bridge fee and the minimum chain fee are paid
+	ok, collected := recv(1, EthChainPrefix+ethDestAddr)
+	require.True(t, ok)
+	require.Equal(t, sdk.NewInt(10), collected)
+	// explicit fees in the memo take precedence
+	ok, collected = recv(2, EthChainPrefix+ethDestAddr+":20:30")
+	require.True(t, ok)
+	require.Equal(t, sdk.NewInt(30), collected)
+
+	unbatched := input.GravityKeeper.GetUnbatchedTransactions(ctx, EthChainPrefix)
+	require.Len(t, unbatched, 2)
+	byId := map[uint64]*types.InternalOutgoingTransferTx{unbatched[0].Id: unbatched[0], unbatched[1].Id: unbatched[1]}
+	require.Equal(t, sdk.NewInt(985), byId[1].Erc20Token.Amount)
+	require.Equal(t, sdk.NewInt(5), byId[1].Erc20Fee.Amount)
+	require.Equal(t, sdk.NewInt(950), byId[2].Erc20Token.Amount)
+	require.Equal(t, sdk.NewInt(20), byId[2].Erc20Fee.Amount)
+
+	// a chain fee below the minimum, fees exceeding the transfer and malformed fees are rejected
+	for i, memo := range []string{
+		EthChainPrefix + ethDestAddr + ":0:1",
+		EthChainPrefix + ethDestAddr + ":1000",
+		EthChainPrefix + ethDestAddr + ":abc",
+		EthChainPrefix + ethDestAddr + ":1:2:3",
+	} {
+		ok, _ := recv(uint64(3+i), memo)
+		require.False(t, ok, memo)
+	}
+	require.Len(t, input.GravityKeeper.GetUnbatchedTransactions(ctx, EthChainPrefix), 2)
+}
+
+func TestOnRecvPacketJSONMemo(t *testing.T) {
+	input := CreateTestEnv(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+
+	ctx := input.Context
+
+	var (
+		sourceChannel     = "channel-0"
+		oraibChannel      = "channel-1"
+		tokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+		ethDestAddr       = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+		myTokenDenom      = "bsc" + tokenContractAddr
+		ibcDenom          = fmt.Sprintf("ibc/%X", sha256.Sum256([]byte("transfer/"+oraibChannel+"/"+myTokenDenom)))
+		refundAddr        = AccAddrs[1]
+	)
+
+	tokenAddr, err := types.NewEthAddress(tokenContractAddr)
+	require.NoError(t, err)
+	secpPk := secp256k1.GenPrivKey()
+	gravityAddr := sdk.AccAddress(secpPk.PubKey().Address())
+	oraiAddr := sdk.MustBech32ifyAddressBytes("orai", gravityAddr)
+
+	input.GravityKeeper.setCosmosOriginatedDenomToERC20(ctx, EthChainPrefix, ibcDenom, *tokenAddr)
+	input.IbcTransferKeeper.SetDenomTrace(ctx, transfertypes.DenomTrace{
+		Path:      fmt.Sprintf("%s/%s", transfertypes.PortID, oraibChannel),
+		BaseDenom: myTokenDenom,
+	})
+
+	recv := func(sequence uint64, memo string) bool {
+		transfer := transfertypes.NewFungibleTokenPacketData(myTokenDenom, "1000", oraiAddr, gravityAddr.String())
+		transfer.Memo = memo
+		bz := transfertypes.ModuleCdc.MustMarshalJSON(&transfer)
+		packet := channeltypes.NewPacket(bz, sequence, transfertypes.PortID, sourceChannel, transfertypes.PortID, oraibChannel, clienttypes.NewHeight(0, 100), 0)
+
+		xCtx, commit := ctx.CacheContext()
+		coins := sdk.NewCoins(sdk.NewInt64Coin(ibcDenom, 1000))
+		require.NoError(t, input.BankKeeper.MintCoins(xCtx, types.ModuleName, coins))
+		require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(xCtx, types.ModuleName, gravityAddr, coins))
+		res := input.GravityKeeper.OnRecvPacket(xCtx, packet, ibcmock.MockAcknowledgement)
+		if !res.Success() {
+			return false
+		}
+		commit()
+		return true
+	}
+
+	// memos meant for other middleware do not create a transfer
+	require.True(t, recv(1, `{"forward":{"receiver":"`+ethDestAddr+`","port":"transfer","channel":"channel-2"}}`))
+	require.Empty(t, input.GravityKeeper.GetUnbatchedTransactions(ctx, EthChainPrefix))
+	require.Equal(t, sdk.NewInt(1000), input.BankKeeper.GetBalance(ctx, gravityAddr, ibcDenom).Amount)
+
+	// the refund address owns the transfer created from a json memo
+	memo := fmt.Sprintf(`{"gravity":{"version":1,"evm_chain_prefix":%q,"dest":%q,"bridge_fee":"7","refund":%q}}`,
+		EthChainPrefix, ethDestAddr, refundAddr.String())
+	require.True(t, recv(2, memo))
+	unbatched := input.GravityKeeper.GetUnbatchedTransactions(ctx, EthChainPrefix)
+	require.Len(t, unbatched, 1)
+	require.Equal(t, refundAddr, unbatched[0].Sender)
+	require.Equal(t, sdk.NewInt(993), unbatched[0].Erc20Token.Amount)
+	require.Equal(t, sdk.NewInt(7), unbatched[0].Erc20Fee.Amount)
+	require.Equal(t, sdk.NewInt(1000), input.BankKeeper.GetBalance(ctx, gravityAddr, ibcDenom).Amount)
+
+	// cancelling returns the funds to the refund address
+	require.NoError(t, input.GravityKeeper.RemoveFromOutgoingPoolAndRefund(ctx, EthChainPrefix, unbatched[0].Id, refundAddr))
+	require.Equal(t, sdk.NewInt(1000), input.BankKeeper.GetBalance(ctx, refundAddr, ibcDenom).Amount)
+
+	// malformed gravity memos are rejected
+	require.False(t, recv(3, `{"gravity":{"evm_chain_prefix":"`+EthChainPrefix+`","dest":"`+ethDestAddr+`","fee":"1"}}`))
+	require.False(t, recv(4, `{"gravity":{"evm_chain_prefix":"`+EthChainPrefix+`"`))
+	require.False(t, recv(5, `{"gravity":{"evm_chain_prefix":"`+EthChainPrefix+`","dest":"`+ethDestAddr+`","refund":"`+authtypes.NewModuleAddress(types.ModuleName).String()+`"}}`))
+}
+
+func TestOnRecvPacketIbcTransferOrigin(t *testing.T) {
+	input := CreateTestEnv(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+
+	ctx := input.Context
+
+	var (
+		sourceChannel     = "channel-0"
+		oraibChannel      = "channel-1"
+		tokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+		ethDestAddr       = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+		myTokenDenom      = "bsc" + tokenContractAddr
+		ibcDenom          = fmt.Sprintf("ibc/%X", sha256.Sum256([]byte("transfer/"+oraibChannel+"/"+myTokenDenom)))
+	)
+
+	tokenAddr, err := types.NewEthAddress(tokenContractAddr)
+	require.NoError(t, err)
+	secpPk := secp256k1.GenPrivKey()
+	gravityAddr := sdk.AccAddress(secpPk.PubKey().Address())
+	oraiAddr := sdk.MustBech32ifyAddressBytes("orai", gravityAddr)
+
+	input.IbcTransferKeeper.SetParams(ctx, transfertypes.DefaultParams())
+	input.GravityKeeper.setCosmosOriginatedDenomToERC20(ctx, EthChainPrefix, ibcDenom, *tokenAddr)
+	input.IbcTransferKeeper.SetDenomTrace(ctx, transfertypes.DenomTrace{
+		Path:      fmt.Sprintf("%s/%s", transfertypes.PortID, oraibChannel),
+		BaseDenom: myTokenDenom,
+	})
+
+	recv := func(sequence uint64, memo string) uint64 {
+		transfer := transfertypes.NewFungibleTokenPacketData(myTokenDenom, "1000", oraiAddr, gravityAddr.String())
+		transfer.Memo = memo
+		bz := transfertypes.ModuleCdc.MustMarshalJSON(&transfer)
+		packet := channeltypes.NewPacket(bz, sequence, transfertypes.PortID, sourceChannel, transfertypes.PortID, oraibChannel, clienttypes.NewHeight(0, 100), 0)
+
+		coins := sdk.NewCoins(sdk.NewInt64Coin(ibcDenom, 1000))
+		require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, coins))
+		require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, gravityAddr, coins))
+		res := input.GravityKeeper.OnRecvPacket(ctx, packet, ibcmock.MockAcknowledgement)
+		require.True(t, res.Success(), string(res.Acknowledgement()))
+		txId := input.GravityKeeper.getID(ctx, types.AppendChainPrefix(types.KeyLastTXPoolID, EthChainPrefix))
+		return txId
+	}
+	refundEvents := func() (events []sdk.Event) {
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == "gravity.v1.EventWithdrawRefundedOverIbc" {
+				events = append(events, event)
+			}
+		}
+		return events
+	}
+
+	// the originating packet is recorded for the created pool tx
+	txId := recv(1, EthChainPrefix+ethDestAddr)
+	origin := input.GravityKeeper.GetIbcTransferOrigin(ctx, EthChainPrefix, txId)
+	require.NotNil(t, origin)
+	require.Equal(t, types.IbcTransferOrigin{
+		EvmChainPrefix: EthChainPrefix,
+		TxId:           txId,
+		Port:           transfertypes.PortID,
+		Channel:        oraibChannel,
+		Sequence:       1,
+		Sender:         oraiAddr,
+	}, *origin)
+	require.Nil(t, input.GravityKeeper.GetIbcTransferOrigin(ctx, BscChainPrefix, txId))
+
+	// a refund address in the memo owns the transfer, no origin is recorded
+	refundMemo := fmt.Sprintf(`{"gravity":{"evm_chain_prefix":%q,"dest":%q,"refund":%q}}`,
+		EthChainPrefix, ethDestAddr, AccAddrs[1].String())
+	require.Nil(t, input.GravityKeeper.GetIbcTransferOrigin(ctx, EthChainPrefix, recv(2, refundMemo)))
+	require.NoError(t, input.GravityKeeper.RemoveFromOutgoingPoolAndRefund(ctx, EthChainPrefix, txId+1, AccAddrs[1]))
+	require.Empty(t, refundEvents())
+
+	// cancelling attempts the IBC refund, without an open channel the funds remain with the local owner
+	require.NoError(t, input.GravityKeeper.RemoveFromOutgoingPoolAndRefund(ctx, EthChainPrefix, txId, gravityAddr))
+	require.Nil(t, input.GravityKeeper.GetIbcTransferOrigin(ctx, EthChainPrefix, txId))
+	require.Equal(t, sdk.NewInt(1000), input.BankKeeper.GetBalance(ctx, gravityAddr, ibcDenom).Amount)
+	events := refundEvents()
+	require.Len(t, events, 1)
+	var refundErr string
+	for _, attr := range events[0].Attributes {
+		if string(attr.Key) == "error" {
+			refundErr = string(attr.Value)
+		}
+	}
+	require.NotEmpty(t, refundErr)
+
+	// a timed out batch refunds only the IBC originated txs and leaves the others in the pool
+	ibcTxId := recv(3, EthChainPrefix+ethDestAddr)
+	localTxId, err := input.GravityKeeper.AddToOutgoingPool(ctx, EthChainPrefix, gravityAddr, *tokenAddr,
+		sdk.NewInt64Coin(ibcDenom, 100), sdk.NewInt64Coin(ibcDenom, 0))
+	require.NoError(t, err)
+	batch, err := input.GravityKeeper.BuildOutgoingTxBatch(ctx, EthChainPrefix, *tokenAddr, 2)
+	require.NoError(t, err)
+	require.Len(t, batch.Transactions, 2)
+	require.NoError(t, input.GravityKeeper.CancelOutgoingTxBatch(ctx, EthChainPrefix, *tokenAddr, batch.BatchNonce))
+	input.GravityKeeper.RefundIbcOriginatedTxs(ctx, EthChainPrefix, batch.Transactions)
+	require.Nil(t, input.GravityKeeper.GetIbcTransferOrigin(ctx, EthChainPrefix, ibcTxId))
+	unbatched := input.GravityKeeper.GetUnbatchedTransactions(ctx, EthChainPrefix)
+	require.Len(t, unbatched, 1)
+	require.Equal(t, localTxId, unbatched[0].Id)
+	require.Len(t, refundEvents(), 2)
+}
+
+func TestIbcAutoForwardTransferStatus(t *testing.T) {
+	input := CreateTestEnv(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+
+	ctx := input.Context
+	k := input.GravityKeeper
+
+	inFlight := func(nonce uint64, sequence uint64) types.IbcAutoForwardTransfer {
+		transfer := types.IbcAutoForwardTransfer{
+			EvmChainPrefix:  EthChainPrefix,
+			EventNonce:      nonce,
+			Port:            transfertypes.PortID,
+			Channel:         "channel-0",
+			Sequence:        sequence,
+			ForeignReceiver: "oraib14n3tx8s5ftzhlxvq0w5962v60vd82h305kec0j",
+			Fallback:        AccAddrs[0].String(),
+			Token:           sdk.NewInt64Coin("stake", 100),
+			Status:          types.IBC_AUTO_FORWARD_STATUS_IN_FLIGHT,
+			SentBlockHeight: uint64(ctx.BlockHeight()),
+		}
+		k.setIbcAutoForwardTransfer(ctx, transfer)
+		return transfer
+	}
+	packet := func(channel string, sequence uint64) channeltypes.Packet {
+		return channeltypes.NewPacket(nil, sequence, transfertypes.PortID, channel, transfertypes.PortID, "channel-7", clienttypes.NewHeight(0, 100), 0)
+	}
+	status := func(nonce uint64) types.IbcAutoForwardTransfer {
+		res, err := k.GetIbcAutoForwardTransfer(sdk.WrapSDKContext(ctx), &types.QueryIbcAutoForwardTransferRequest{
+			EvmChainPrefix: EthChainPrefix,
+			EventNonce:     nonce,
+		})
+		require.NoError(t, err)
+		return res.Transfer
+	}
+
+	inFlight(1, 10)
+	inFlight(2, 11)
+	inFlight(3, 12)
+	require.Equal(t, types.IBC_AUTO_FORWARD_STATUS_IN_FLIGHT, status(1).Status)
+
+	// packets of other channels or sequences are ignored
+	require.NoError(t, k.OnTimeoutPacket(ctx, packet("channel-1", 10)))
+	require.NoError(t, k.OnTimeoutPacket(ctx, packet("channel-0", 13)))
+	require.Equal(t, types.IBC_AUTO_FORWARD_STATUS_IN_FLIGHT, status(1).Status)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 10)
+	require.NoError(t, k.OnAcknowledgementPacket(ctx, packet("channel-0", 10), channeltypes.NewResultAcknowledgement([]byte{1}).Acknowledgement()))
+	require.NoError(t, k.OnAcknowledgementPacket(ctx, packet("channel-0", 11), channeltypes.NewErrorAcknowledgement(types.ErrInvalid).Acknowledgement()))
+	require.NoError(t, k.OnTimeoutPacket(ctx, packet("channel-0", 12)))
+
+	acked := status(1)
+	require.Equal(t, types.IBC_AUTO_FORWARD_STATUS_ACKNOWLEDGED, acked.Status)
+	require.Equal(t, uint64(ctx.BlockHeight()), acked.CompletedBlockHeight)
+	failed := status(2)
+	require.Equal(t, types.IBC_AUTO_FORWARD_STATUS_FAILED, failed.Status)
+	require.NotEmpty(t, failed.Error)
+	require.Equal(t, types.IBC_AUTO_FORWARD_STATUS_TIMED_OUT, status(3).Status)
+
+	// a completed transfer keeps its final status
+	require.NoError(t, k.OnTimeoutPacket(ctx, packet("channel-0", 10)))
+	require.Equal(t, types.IBC_AUTO_FORWARD_STATUS_ACKNOWLEDGED, status(1).Status)
+
+	// unknown nonces are an error
+	_, err := k.GetIbcAutoForwardTransfer(sdk.WrapSDKContext(ctx), &types.QueryIbcAutoForwardTransferRequest{
+		EvmChainPrefix: BscChainPrefix,
+		EventNonce:     1,
+	})
+	require.Error(t, err)
+	require.Len(t, k.IbcAutoForwardTransfers(ctx, EthChainPrefix), 3)
+}
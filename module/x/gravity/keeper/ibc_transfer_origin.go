@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v4/modules/apps/transfer/types"
+	ibcclienttypes "github.com/cosmos/ibc-go/v4/modules/core/02-client/types"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// GetIbcTransferOrigin returns the IBC packet which created the outgoing pool tx `txId`, or nil if the tx was not
+// created by the ibc middleware
+func (k Keeper) GetIbcTransferOrigin(ctx sdk.Context, evmChainPrefix string, txId uint64) *types.IbcTransferOrigin {
+	return getChainRecord[types.IbcTransferOrigin](ctx, k, types.GetIbcTransferOriginKey(evmChainPrefix, txId))
+}
+
+// SetIbcTransferOrigin stores the IBC packet origin of an outgoing pool tx
+func (k Keeper) SetIbcTransferOrigin(ctx sdk.Context, origin types.IbcTransferOrigin) {
+	k.setChainRecord(ctx, types.GetIbcTransferOriginKey(origin.EvmChainPrefix, origin.TxId), &origin)
+}
+
+// DeleteIbcTransferOrigin removes the IBC packet origin of the outgoing pool tx `txId`, if any
+func (k Keeper) DeleteIbcTransferOrigin(ctx sdk.Context, evmChainPrefix string, txId uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetIbcTransferOriginKey(evmChainPrefix, txId))
+}
+
+// IterateIbcTransferOrigins executes the given callback on each IBC packet origin of the evm chain, ordered by tx id
+// cb should return true to stop iteration, false to continue
+func (k Keeper) IterateIbcTransferOrigins(ctx sdk.Context, evmChainPrefix string, cb func(origin types.IbcTransferOrigin) (stop bool)) {
+	iterateChainRecords(ctx, k, types.IbcTransferOriginKey, evmChainPrefix, false, cb)
+}
+
+// IbcTransferOrigins returns every IBC packet origin of the evm chain, ordered by tx id
+func (k Keeper) IbcTransferOrigins(ctx sdk.Context, evmChainPrefix string) []types.IbcTransferOrigin {
+	return chainRecords[types.IbcTransferOrigin](ctx, k, types.IbcTransferOriginKey, evmChainPrefix, false, 0)
+}
+
+// refundToIbcOrigin sends `refund`, which has just been returned to the local `owner` of the outgoing pool tx `txId`,
+// back over IBC to the counterparty sender of the packet which created the tx. Txs without a recorded origin are
+// left untouched. Should the transfer fail to be sent the funds simply remain with the local owner, and should the
+// refund packet later time out or be rejected the ibc-transfer module returns them to the local owner as well.
+func (k Keeper) refundToIbcOrigin(ctx sdk.Context, evmChainPrefix string, txId uint64, owner sdk.AccAddress, refund sdk.Coin) {
+	origin := k.GetIbcTransferOrigin(ctx, evmChainPrefix, txId)
+	if origin == nil {
+		return
+	}
+	k.DeleteIbcTransferOrigin(ctx, evmChainPrefix, txId)
+
+	msgTransfer := ibctransfertypes.MsgTransfer{
+		SourcePort:       origin.Port,
+		SourceChannel:    origin.Channel,
+		Token:            refund,
+		Sender:           owner.String(),
+		Receiver:         origin.Sender,
+		TimeoutHeight:    ibcclienttypes.Height{}, // Do not use block height based timeout
+		TimeoutTimestamp: uint64(thirtyDaysInFuture(ctx).UnixNano()),
+	}
+
+	// transfer in a cache context so that a failed attempt leaves no partial state behind
+	xCtx, commit := ctx.CacheContext()
+	_, err := k.ibcTransferKeeper.Transfer(sdk.WrapSDKContext(xCtx), &msgTransfer)
+
+	event := types.EventWithdrawRefundedOverIbc{
+		EvmChainPrefix: evmChainPrefix,
+		TxId:           fmt.Sprint(txId),
+		Port:           origin.Port,
+		Channel:        origin.Channel,
+		Sequence:       fmt.Sprint(origin.Sequence),
+		Sender:         owner.String(),
+		Receiver:       origin.Sender,
+		Amount:         refund.String(),
+	}
+	if err != nil {
+		k.logger(ctx).Error("Unable to refund withdrawal over IBC, funds remain with the local owner",
+			"evmChainPrefix", evmChainPrefix, "txId", txId, "owner", owner.String(), "cause", err.Error(),
+		)
+		event.Error = err.Error()
+	} else {
+		commit()
+		ctx.EventManager().EmitEvents(xCtx.EventManager().Events())
+	}
+	ctx.EventManager().EmitTypedEvent(&event)
+}
+
+// RefundIbcOriginatedTxs cancels every tx among `txs` which was created by the ibc middleware and is currently in the
+// pool, refunding it to its counterparty sender, the remaining txs are left in the pool. This is used when a batch
+// times out on the evm chain, returning IBC originated transfers to where they came from instead of waiting for a
+// new batch.
+func (k Keeper) RefundIbcOriginatedTxs(ctx sdk.Context, evmChainPrefix string, txs []*types.InternalOutgoingTransferTx) {
+	for _, tx := range txs {
+		if k.GetIbcTransferOrigin(ctx, evmChainPrefix, tx.Id) == nil {
+			continue
+		}
+		// refund in a cache context so that a failed refund leaves the tx in the pool
+		xCtx, commit := ctx.CacheContext()
+		if err := k.RemoveFromOutgoingPoolAndRefund(xCtx, evmChainPrefix, tx.Id, tx.Sender); err != nil {
+			k.logger(ctx).Error("Unable to refund timed out IBC originated withdrawal",
+				"evmChainPrefix", evmChainPrefix, "txId", tx.Id, "cause", err.Error(),
+			)
+			continue
+		}
+		commit()
+		ctx.EventManager().EmitEvents(xCtx.EventManager().Events())
+	}
+}
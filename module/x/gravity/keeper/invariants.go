@@ -1,11 +1,13 @@
 package keeper
 
 import (
+	"bytes"
 	"fmt"
 	"sort"
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
 )
@@ -40,7 +42,7 @@ func AllInvariants(k Keeper) sdk.Invariant {
 }
 
 // ModuleBalanceInvariant checks that the module account's balance is equal to the balance of unbatched transactions, unobserved batches,
-// and pending IBC auto-forwards
+// pending logic calls and pending IBC auto-forwards
 // Note that the returned bool should be true if there is an error, e.g. an unexpected module balance
 func ModuleBalanceInvariant(k Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
@@ -57,7 +59,13 @@ func ModuleBalanceInvariant(k Keeper) sdk.Invariant {
 			}
 			expectedBals = sumUnconfirmedBatchModuleBalances(ctx, evmChain.EvmChainPrefix, k, expectedBals)
 			expectedBals = sumUnbatchedTxModuleBalances(ctx, evmChain.EvmChainPrefix, k, expectedBals)
+			expectedBals = sumOutgoingLogicCallModuleBalances(ctx, evmChain.EvmChainPrefix, k, expectedBals)
 			expectedBals = sumPendingIbcAutoForwards(ctx, evmChain.EvmChainPrefix, k, expectedBals)
+			expectedBals = sumHeldSendToCosmos(ctx, evmChain.EvmChainPrefix, k, expectedBals)
+			// the extra fees of any evm chain may be paid in the vouchers of this one
+			for _, feeChain := range evmChains {
+				expectedBals = sumExtraFeeModuleBalances(ctx, feeChain.EvmChainPrefix, k, expectedBals)
+			}
 
 			// Compare actual vs expected balances
 			for _, actual := range actualBals {
@@ -80,19 +88,81 @@ func ModuleBalanceInvariant(k Keeper) sdk.Invariant {
 					return fmt.Sprint("Could not find expected balance for actual module balance of ", actual), true
 				}
 
-				if cosmosOriginated { // Cosmos originated mismatched balance
-					// We cannot make any assertions about cosmosOriginated assets because we do not have enough information.
-					// There is no index of denom => amount bridged, which would force us to parse all logs in existence
-				} else if !actual.Amount.Equal(*expected) { // Eth originated mismatched balance
+				// Cosmos originated balances are checked against the bridged supply ledger below
+				if !cosmosOriginated && !actual.Amount.Equal(*expected) { // Eth originated mismatched balance
 					return fmt.Sprint("Mismatched balance of eth-originated ", denom, ": actual balance ", actual.Amount, " != expected balance ", expected), true
 				}
 			}
 		}
+
+		// The module must hold at least the cosmos originated coins the ledger has locked for the evm chains, it may
+		// hold more, for example deposits held by rate limits which no longer back any erc20
+		modAcc := k.accountKeeper.GetModuleAddress(types.ModuleName)
+		lockedBals := sumLockedCosmosOriginated(ctx, k)
+		for _, denom := range sortedDenoms(lockedBals) {
+			locked := lockedBals[denom]
+			actual := k.bankKeeper.GetBalance(ctx, modAcc, denom)
+			if actual.Amount.LT(locked) {
+				return fmt.Sprint("Insufficient balance of cosmos-originated ", denom, ": actual balance ", actual.Amount, " < locked balance ", locked), true
+			}
+		}
 		return "", false
 
 	}
 }
 
+// BridgedSupplyInvariant checks the bridged supply ledger of every evm chain against the bank supply: the ledger of
+// each evm originated token must equal the supply of its vouchers, and no voucher of the chain may exist without an
+// entry in the ledger
+// Note that the returned bool should be true if there is an error, e.g. an unexpected voucher supply
+func BridgedSupplyInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, evmChain := range k.GetEvmChains(ctx) {
+			prefix := evmChain.EvmChainPrefix
+			ledger := make(map[string]sdk.Int)
+			k.IterateBridgedSupply(ctx, prefix, func(tokenContract types.EthAddress, amount sdk.Int) (stop bool) {
+				if isCosmosOriginated, _ := k.ERC20ToDenomLookup(ctx, prefix, tokenContract); !isCosmosOriginated {
+					ledger[types.GravityDenom(prefix, tokenContract)] = amount
+				}
+				return false
+			})
+
+			var res string
+			var broken bool
+			k.bankKeeper.IterateTotalSupply(ctx, func(supply sdk.Coin) bool {
+				contract, err := types.GravityDenomToERC20(prefix, supply.Denom)
+				if err != nil {
+					return false // not a voucher of this chain
+				}
+				if isCosmosOriginated, _ := k.ERC20ToDenomLookup(ctx, prefix, *contract); isCosmosOriginated {
+					return false
+				}
+				bridged, ok := ledger[supply.Denom]
+				if !ok {
+					bridged = sdk.ZeroInt()
+				}
+				delete(ledger, supply.Denom)
+				if !supply.Amount.Equal(bridged) {
+					res = fmt.Sprint("Mismatched supply of eth-originated ", supply.Denom, ": bank supply ", supply.Amount, " != bridged supply ", bridged)
+					broken = true
+					return true
+				}
+				return false
+			})
+			if broken {
+				return res, true
+			}
+			// vouchers without any supply left are not visited by the bank, their ledger must be empty as well
+			for _, denom := range sortedDenoms(ledger) {
+				if bridged := ledger[denom]; !bridged.IsZero() {
+					return fmt.Sprint("Mismatched supply of eth-originated ", denom, ": bank supply 0 != bridged supply ", bridged), true
+				}
+			}
+		}
+		return "", false
+	}
+}
+
 /////// MODULE BALANCE HELPERS
 
 // sumUnconfirmedBatchModuleBalances calculate the value the module should have stored due to unconfirmed batches
@@ -143,19 +213,112 @@ func sumUnbatchedTxModuleBalances(ctx sdk.Context, evmChainPrefix string, k Keep
 	return expectedBals
 }
 
+// sumExtraFeeModuleBalances calculates the value the module should have stored due to the extra fees of unbatched
+// and batched txs
+func sumExtraFeeModuleBalances(ctx sdk.Context, evmChainPrefix string, k Keeper, expectedBals map[string]*sdk.Int) map[string]*sdk.Int {
+	addExtraFee := func(tx *types.InternalOutgoingTransferTx) {
+		if tx.ExtraFee == nil {
+			return
+		}
+		_, ok := expectedBals[tx.ExtraFee.Denom]
+		if !ok {
+			zero := sdk.ZeroInt()
+			expectedBals[tx.ExtraFee.Denom] = &zero
+		}
+		*expectedBals[tx.ExtraFee.Denom] = expectedBals[tx.ExtraFee.Denom].Add(tx.ExtraFee.Amount)
+	}
+	k.filterAndIterateUnbatchedTransactions(ctx, types.AppendChainPrefix(types.OutgoingTXPoolKey, evmChainPrefix), func(_ []byte, tx *types.InternalOutgoingTransferTx) bool {
+		addExtraFee(tx)
+		return false // continue iterating
+	})
+	k.IterateOutgoingTxBatches(ctx, evmChainPrefix, func(_ []byte, batch types.InternalOutgoingTxBatch) bool {
+		for _, tx := range batch.Transactions {
+			addExtraFee(tx)
+		}
+		return false // continue iterating
+	})
+
+	return expectedBals
+}
+
+// sumOutgoingLogicCallModuleBalances calculates the value the module should have stored due to pending logic calls
+func sumOutgoingLogicCallModuleBalances(ctx sdk.Context, evmChainPrefix string, k Keeper, expectedBals map[string]*sdk.Int) map[string]*sdk.Int {
+	k.IterateOutgoingLogicCalls(ctx, evmChainPrefix, func(_ []byte, call types.OutgoingLogicCall) bool {
+		coins, err := k.lockedLogicCallCoins(ctx, evmChainPrefix, call)
+		if err != nil {
+			panic(sdkerrors.Wrap(err, "found invalid logic call in store"))
+		}
+		for _, coin := range coins {
+			_, ok := expectedBals[coin.Denom]
+			if !ok {
+				zero := sdk.ZeroInt()
+				expectedBals[coin.Denom] = &zero
+			}
+			*expectedBals[coin.Denom] = expectedBals[coin.Denom].Add(coin.Amount)
+		}
+
+		return false // continue iterating
+	})
+
+	return expectedBals
+}
+
 func sumPendingIbcAutoForwards(ctx sdk.Context, evmChainPrefix string, k Keeper, expectedBals map[string]*sdk.Int) map[string]*sdk.Int {
 	for _, forward := range k.PendingIbcAutoForwards(ctx, evmChainPrefix, uint64(0)) {
 		if _, ok := expectedBals[forward.Token.Denom]; !ok {
 			zero := sdk.ZeroInt()
 			expectedBals[forward.Token.Denom] = &zero
-		} else {
-			*expectedBals[forward.Token.Denom] = expectedBals[forward.Token.Denom].Add(forward.Token.Amount)
 		}
+		*expectedBals[forward.Token.Denom] = expectedBals[forward.Token.Denom].Add(forward.Token.Amount)
 	}
 
 	return expectedBals
 }
 
+// sumHeldSendToCosmos calculates the value the module should have stored due to deposits held by inbound rate limits
+func sumHeldSendToCosmos(ctx sdk.Context, evmChainPrefix string, k Keeper, expectedBals map[string]*sdk.Int) map[string]*sdk.Int {
+	k.IterateHeldSendToCosmos(ctx, evmChainPrefix, func(held types.HeldSendToCosmos) (stop bool) {
+		if _, ok := expectedBals[held.Token.Denom]; !ok {
+			zero := sdk.ZeroInt()
+			expectedBals[held.Token.Denom] = &zero
+		}
+		*expectedBals[held.Token.Denom] = expectedBals[held.Token.Denom].Add(held.Token.Amount)
+		return false // continue iterating
+	})
+
+	return expectedBals
+}
+
+// sumLockedCosmosOriginated sums the bridged supply ledger of the cosmos originated tokens of every evm chain by denom,
+// which is the amount of each denom locked in the gravity module on behalf of the evm chains
+func sumLockedCosmosOriginated(ctx sdk.Context, k Keeper) map[string]sdk.Int {
+	locked := make(map[string]sdk.Int)
+	for _, evmChain := range k.GetEvmChains(ctx) {
+		k.IterateBridgedSupply(ctx, evmChain.EvmChainPrefix, func(tokenContract types.EthAddress, amount sdk.Int) (stop bool) {
+			isCosmosOriginated, denom := k.ERC20ToDenomLookup(ctx, evmChain.EvmChainPrefix, tokenContract)
+			if !isCosmosOriginated {
+				return false
+			}
+			if prev, ok := locked[denom]; ok {
+				amount = amount.Add(prev)
+			}
+			locked[denom] = amount
+			return false
+		})
+	}
+	return locked
+}
+
+// sortedDenoms returns the keys of `bals` in order, so that invariants report the same violation on every node
+func sortedDenoms(bals map[string]sdk.Int) []string {
+	denoms := make([]string, 0, len(bals))
+	for denom := range bals {
+		denoms = append(denoms, denom)
+	}
+	sort.Strings(denoms)
+	return denoms
+}
+
 // StoreValidityInvariant checks that the currently stored objects are not corrupted and all pass ValidateBasic checks
 // Note that the returned bool should be true if there is an error, e.g. an unexpected batch was processed
 func StoreValidityInvariant(k Keeper) sdk.Invariant {
@@ -186,6 +349,12 @@ func StoreValidityInvariant(k Keeper) sdk.Invariant {
 			}
 		}
 
+		// Assert that the decommissioning reports are valid and that draining chains still exist
+		err := CheckEvmChainDecommissions(ctx, k)
+		if err != nil {
+			return err.Error(), true
+		}
+
 		// SUCCESS: If execution made it here, everything passes the sanity checks
 		return "", false
 	}
@@ -293,17 +462,29 @@ func ValidateStore(ctx sdk.Context, evmChainPrefix string, k Keeper) error {
 	}
 
 	// OutgoingTXPoolKey
+	store := ctx.KVStore(k.storeKey)
+	poolSize := 0
 	k.IterateUnbatchedTransactions(ctx, evmChainPrefix, func(key []byte, tx *types.InternalOutgoingTransferTx) (stop bool) {
 		err = tx.ValidateBasic()
 		if err != nil {
 			err = fmt.Errorf("Invalid unbatched transaction %v under key %v in IterateUnbatchedTransactions: %v", tx, key, err)
 			return true
 		}
+		if !bytes.Equal(store.Get(types.GetOutgoingTxPoolAgeKey(evmChainPrefix, tx.Erc20Fee.Contract, tx.Id)), key) {
+			err = fmt.Errorf("Unbatched transaction %d of %s is missing from the pool age index", tx.Id, evmChainPrefix)
+			return true
+		}
+		poolSize++
 		return false
 	})
 	if err != nil {
 		return err
 	}
+	// OutgoingTxPoolAgeKey
+	indexSize := countKeysWithPrefix(store, types.AppendDelimitedChainPrefix(types.OutgoingTxPoolAgeKey, evmChainPrefix))
+	if indexSize != poolSize {
+		return fmt.Errorf("Pool age index of %s holds %d txs, the pool holds %d", evmChainPrefix, indexSize, poolSize)
+	}
 	// OutgoingTxBatchKey
 	k.IterateOutgoingTxBatches(ctx, evmChainPrefix, func(key []byte, batch types.InternalOutgoingTxBatch) (stop bool) {
 		err = batch.ValidateBasic()
@@ -351,6 +532,11 @@ func ValidateStore(ctx sdk.Context, evmChainPrefix string, k Keeper) error {
 			err = fmt.Errorf("Invalid validator %v under key %v in IterateValidatorsByOrchestratorAddress", addr, key)
 			return true
 		}
+		// OrchestratorByValidatorKey
+		if _, found := k.GetOrchestratorByValidator(ctx, addr); !found {
+			err = fmt.Errorf("Missing orchestrator %v of validator %v under OrchestratorByValidatorKey", sdk.AccAddress(key), addr)
+			return true
+		}
 		return false
 	})
 	if err != nil {
@@ -440,6 +626,8 @@ func ValidateStore(ctx sdk.Context, evmChainPrefix string, k Keeper) error {
 
 	// LastUnBondingBlockHeight (type is checked when fetching)
 	_ = k.GetLastUnBondingBlockHeight(ctx)
+	// LastDelegateKeyChangeBlockHeight (type is checked when fetching)
+	_ = k.GetLastDelegateKeyChangeBlockHeight(ctx)
 
 	// LastObservedValsetKey
 	valset := k.GetLastObservedValset(ctx, evmChainPrefix)
@@ -468,23 +656,185 @@ func ValidateStore(ctx sdk.Context, evmChainPrefix string, k Keeper) error {
 		return err
 	}
 
-	// BridgeBalanceSnapshotsKey
-	k.IterateBridgeBalanceSnapshots(ctx, false, func(key []byte, snapshot types.BridgeBalanceSnapshot) (stop bool) {
-		var expNonce uint64
-		expNonce, _, err = types.ExtractNonceFromBridgeBalanceSnapshotKey(key)
-		if err != nil || expNonce != snapshot.EventNonce {
-			err = fmt.Errorf("Key (%v) encodes nonce (%v) but extracting nonce results in (%v, %v)", key, expNonce, snapshot.EventNonce, err)
+	// RateLimitKey
+	k.IterateRateLimits(ctx, evmChainPrefix, func(rateLimit types.RateLimit) (stop bool) {
+		if err = rateLimit.ValidateBasic(); err != nil {
+			err = fmt.Errorf("Discovered invalid RateLimit %v: %v", rateLimit, err)
 			return true
 		}
-		err = snapshot.ValidateBasic()
-		if err != nil {
-			err = fmt.Errorf("ValidateBasic() failed: Key (%v) nonce (%v): %v", key, snapshot.EventNonce, err)
+		return false
+	})
+	if err != nil {
+		return err
+	}
+	// RateLimitFlowKey
+	for _, flow := range k.RateLimitFlows(ctx, evmChainPrefix) {
+		if err := flow.ValidateBasic(); err != nil {
+			return fmt.Errorf("Discovered invalid RateLimitFlow %v: %v", flow, err)
+		}
+	}
+
+	// HeldSendToCosmosKey
+	heldCounts := make(map[string]uint64)
+	heldTotal := 0
+	k.IterateHeldSendToCosmos(ctx, evmChainPrefix, func(held types.HeldSendToCosmos) (stop bool) {
+		if err = held.ValidateBasic(); err != nil {
+			err = fmt.Errorf("Discovered invalid HeldSendToCosmos %v: %v", held, err)
+			return true
+		}
+		if !store.Has(types.GetHeldSendToCosmosByDenomKey(evmChainPrefix, held.Token.Denom, held.EventNonce)) {
+			err = fmt.Errorf("Discovered HeldSendToCosmos %v missing from the queue of its denom", held)
+			return true
+		}
+		heldCounts[held.Token.Denom]++
+		heldTotal++
+		return false
+	})
+	if err != nil {
+		return err
+	}
+	// HeldSendToCosmosByDenomKey
+	if queued := countKeysWithPrefix(store, types.AppendDelimitedChainPrefix(types.HeldSendToCosmosByDenomKey, evmChainPrefix)); queued != heldTotal {
+		return fmt.Errorf("Discovered %d held SendToCosmos queued by denom but %d held", queued, heldTotal)
+	}
+	// HeldSendToCosmosDenomKey
+	for denom, count := range heldCounts {
+		if stored := k.heldSendToCosmosCount(ctx, evmChainPrefix, denom); stored != count {
+			return fmt.Errorf("Discovered %d held SendToCosmos of %s counted as %d", count, denom, stored)
+		}
+	}
+
+	// IbcBridgeFeeKey
+	k.IterateIbcBridgeFees(ctx, evmChainPrefix, func(fee types.IbcBridgeFee) (stop bool) {
+		if err = fee.ValidateBasic(); err != nil {
+			err = fmt.Errorf("Discovered invalid IbcBridgeFee %v: %v", fee, err)
+			return true
+		}
+		return false
+	})
+	if err != nil {
+		return err
+	}
+
+	// IbcTransferOriginKey
+	k.IterateIbcTransferOrigins(ctx, evmChainPrefix, func(origin types.IbcTransferOrigin) (stop bool) {
+		if err = origin.ValidateBasic(); err != nil {
+			err = fmt.Errorf("Discovered invalid IbcTransferOrigin %v: %v", origin, err)
+			return true
+		}
+		return false
+	})
+	if err != nil {
+		return err
+	}
+
+	// IbcAutoForwardLogKey
+	k.IterateIbcAutoForwardLogs(ctx, evmChainPrefix, false, func(log types.IbcAutoForwardLog) (stop bool) {
+		if err = log.ValidateBasic(); err != nil {
+			err = fmt.Errorf("Discovered invalid IbcAutoForwardLog %v: %v", log, err)
 			return true
 		}
 		return false
 	})
 	if err != nil {
-		return fmt.Errorf("Discovered invalid BridgeBalanceSnapshot: %v", err)
+		return err
+	}
+
+	// IbcAutoForwardTransferKey
+	k.IterateIbcAutoForwardTransfers(ctx, evmChainPrefix, func(transfer types.IbcAutoForwardTransfer) (stop bool) {
+		if err = transfer.ValidateBasic(); err != nil {
+			err = fmt.Errorf("Discovered invalid IbcAutoForwardTransfer %v: %v", transfer, err)
+			return true
+		}
+		return false
+	})
+	if err != nil {
+		return err
+	}
+
+	// FailedAttestationKey
+	k.IterateFailedAttestations(ctx, evmChainPrefix, func(failed types.FailedAttestation) (stop bool) {
+		if err = failed.ValidateBasic(k.cdc); err != nil {
+			err = fmt.Errorf("Discovered invalid FailedAttestation %v: %v", failed, err)
+			return true
+		}
+		return false
+	})
+	if err != nil {
+		return err
+	}
+
+	// BlacklistKey
+	k.IterateBlacklist(ctx, evmChainPrefix, func(entry types.BlacklistEntry) (stop bool) {
+		if err = entry.ValidateBasic(); err != nil {
+			err = fmt.Errorf("Discovered invalid BlacklistEntry %v: %v", entry, err)
+			return true
+		}
+		return false
+	})
+	if err != nil {
+		return err
+	}
+
+	// BridgedSupplyKey
+	k.IterateBridgedSupply(ctx, evmChainPrefix, func(tokenContract types.EthAddress, amount sdk.Int) (stop bool) {
+		if amount.IsNil() || amount.IsNegative() {
+			err = fmt.Errorf("Discovered invalid bridged supply %v of %s", amount, tokenContract.GetAddress().Hex())
+			return true
+		}
+		return false
+	})
+	if err != nil {
+		return err
+	}
+
+	// EvidenceRecordKey
+	k.IterateEvidenceRecords(ctx, evmChainPrefix, func(record types.EvidenceRecord) (stop bool) {
+		if err = record.ValidateBasic(); err != nil {
+			err = fmt.Errorf("Discovered invalid EvidenceRecord %v: %v", record, err)
+			return true
+		}
+		return false
+	})
+	if err != nil {
+		return err
+	}
+
+	// BatchStrategyKey
+	k.IterateBatchStrategies(ctx, evmChainPrefix, func(strategy types.TokenBatchStrategy) (stop bool) {
+		if err = strategy.ValidateBasic(); err != nil {
+			err = fmt.Errorf("Discovered invalid TokenBatchStrategy %v: %v", strategy, err)
+			return true
+		}
+		return false
+	})
+	if err != nil {
+		return err
+	}
+
+	// BridgeBalanceSnapshotsKey
+	for _, evmChain := range k.GetEvmChains(ctx) {
+		k.IterateBridgeBalanceSnapshots(ctx, evmChain.EvmChainPrefix, false, func(key []byte, snapshot types.BridgeBalanceSnapshot) (stop bool) {
+			var expNonce uint64
+			var expPrefix string
+			expNonce, expPrefix, err = types.ExtractNonceFromBridgeBalanceSnapshotKey(key)
+			if err != nil || expNonce != snapshot.EventNonce || expPrefix != snapshot.EvmChainPrefix {
+				err = fmt.Errorf(
+					"Key (%v) encodes nonce (%v) and evm chain (%v) but snapshot has (%v, %v): %v",
+					key, expNonce, expPrefix, snapshot.EventNonce, snapshot.EvmChainPrefix, err,
+				)
+				return true
+			}
+			err = snapshot.ValidateBasic()
+			if err != nil {
+				err = fmt.Errorf("ValidateBasic() failed: Key (%v) nonce (%v): %v", key, snapshot.EventNonce, err)
+				return true
+			}
+			return false
+		})
+		if err != nil {
+			return fmt.Errorf("Discovered invalid BridgeBalanceSnapshot: %v", err)
+		}
 	}
 
 	// Finally the params, which are not placed in the store
@@ -642,3 +992,31 @@ func CheckPendingIbcAutoForwards(ctx sdk.Context, evmChainPrefix string, k Keepe
 
 	return nil
 }
+
+// CheckEvmChainDecommissions checks that every decommissioning report passes ValidateBasic and that the chains which
+// are draining have not been removed yet
+func CheckEvmChainDecommissions(ctx sdk.Context, k Keeper) (err error) {
+	k.IterateEvmChainDecommissions(ctx, func(decommission types.EvmChainDecommission) (stop bool) {
+		if err = decommission.ValidateBasic(); err != nil {
+			err = fmt.Errorf("Discovered invalid EvmChainDecommission %v: %v", decommission, err)
+			return true
+		}
+		if decommission.IsDraining() && k.GetEvmChainData(ctx, decommission.EvmChainPrefix) == nil {
+			err = fmt.Errorf("Discovered draining evm chain %s which does not exist", decommission.EvmChainPrefix)
+			return true
+		}
+		return false
+	})
+	return err
+}
+
+// countKeysWithPrefix returns the number of keys in the store which begin with keyPrefix
+func countKeysWithPrefix(store sdk.KVStore, keyPrefix []byte) int {
+	iter := sdk.KVStorePrefixIterator(store, keyPrefix)
+	defer iter.Close()
+	count := 0
+	for ; iter.Valid(); iter.Next() {
+		count++
+	}
+	return count
+}
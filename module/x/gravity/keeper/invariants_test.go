@@ -7,6 +7,7 @@ import (
 
 	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	"github.com/stretchr/testify/require"
 )
@@ -114,6 +115,7 @@ func TestModuleBalanceBatchedTxs(t *testing.T) {
 	// mint some voucher first
 	for _, v := range allVouchers {
 		require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, v))
+		input.GravityKeeper.increaseBridgedSupplyOfCoins(ctx, evmChain.EvmChainPrefix, v)
 		// set senders balance
 		input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
 		require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, v))
@@ -191,6 +193,203 @@ func TestModuleBalanceBatchedTxs(t *testing.T) {
 	checkImbalancedModule(t, ctx, input.GravityKeeper, input.BankKeeper, mySender, voucherCoins[1])
 }
 
+// Tests that the gravity module's balance is accounted for with pending IBC Auto-Forwards, including the first of a denom
+func TestModuleBalancePendingIbcAutoForwards(t *testing.T) {
+	input := CreateTestEnv(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+
+	ctx := input.Context
+	k := input.GravityKeeper
+	token, err := types.NewInternalERC20Token(sdk.NewInt(1000), "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+	require.NoError(t, err)
+	voucher := token.GravityCoin(EthChainPrefix)
+	foreignRcv, err := bech32.ConvertAndEncode("astro", AccAddrs[0])
+	require.NoError(t, err)
+	k.SetLastObservedEvmChainBlockHeight(ctx, EthChainPrefix, 100)
+
+	for nonce := uint64(1); nonce <= 2; nonce++ {
+		// the module holds the vouchers of every forward until it is sent over IBC
+		require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(voucher)))
+		k.increaseBridgedSupplyOfCoins(ctx, EthChainPrefix, sdk.NewCoins(voucher))
+		k.setLastObservedEventNonce(ctx, EthChainPrefix, nonce)
+		require.NoError(t, k.addPendingIbcAutoForward(ctx, types.PendingIbcAutoForward{
+			ForeignReceiver: foreignRcv,
+			Token:           &voucher,
+			IbcChannel:      "channel-0",
+			EventNonce:      nonce,
+		}, EthChainPrefix, token.Contract.GetAddress().Hex()))
+		checkInvariant(t, ctx, k, true)
+	}
+
+	// Clear the forwards so the module holds nothing at the end
+	k.IteratePendingIbcAutoForwards(ctx, EthChainPrefix, func(_ []byte, fwd *types.PendingIbcAutoForward) bool {
+		require.NoError(t, k.deletePendingIbcAutoForward(ctx, EthChainPrefix, fwd.EventNonce))
+		return false
+	})
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, AccAddrs[0], sdk.NewCoins(voucher.Add(voucher))))
+}
+
+// Tests that the bridged supply ledger follows deposits, sends and refunds of evm and cosmos originated tokens, and that
+// the invariants catch a ledger which disagrees with the bank
+func TestBridgedSupplyLedger(t *testing.T) {
+	input := CreateTestEnv(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+
+	ctx := input.Context
+	k := input.GravityKeeper
+	var (
+		sender           = AccAddrs[0]
+		evmSender, _     = types.NewEthAddress("0x993d06FC97F45f16e4805883b98a6c20BAb54964")
+		receiver, _      = types.NewEthAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		ethToken, _      = types.NewEthAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		stakeToken, _    = types.NewEthAddress("0x7580bFE88Dd3d07947908FAE12d95872a260F2D8")
+		voucherDenom     = types.GravityDenom(EthChainPrefix, *ethToken)
+		attestationHndlr = AttestationHandler{keeper: &k}
+	)
+	checkBridgedSupply := func(ethAmount, stakeAmount int64) {
+		require.Equal(t, sdk.NewInt(ethAmount), k.GetTokenBridgedSupply(ctx, EthChainPrefix, *ethToken))
+		require.Equal(t, sdk.NewInt(stakeAmount), k.GetTokenBridgedSupply(ctx, EthChainPrefix, *stakeToken))
+		res, broken := BridgedSupplyInvariant(k)(ctx)
+		require.False(t, broken, res)
+		checkInvariant(t, ctx, k, true)
+	}
+
+	// a deposit of an evm originated token mints vouchers and records them
+	require.NoError(t, attestationHndlr.handleSendToCosmos(ctx, types.MsgSendToCosmosClaim{
+		EventNonce:     1,
+		EthBlockHeight: 1,
+		TokenContract:  ethToken.GetAddress().Hex(),
+		Amount:         sdk.NewInt(1000),
+		EthereumSender: evmSender.GetAddress().Hex(),
+		CosmosReceiver: sender.String(),
+		Orchestrator:   sender.String(),
+		EvmChainPrefix: EthChainPrefix,
+	}))
+	checkBridgedSupply(1000, 0)
+	require.Empty(t, k.BridgedSupply(ctx, BscChainPrefix))
+
+	// sending vouchers out keeps them in existence until their batch executes
+	_, err := k.AddToOutgoingPool(ctx, EthChainPrefix, sender, *receiver, sdk.NewInt64Coin(voucherDenom, 100), sdk.NewInt64Coin(voucherDenom, 1))
+	require.NoError(t, err)
+	checkBridgedSupply(1000, 0)
+
+	// sending a cosmos originated token out locks the amount and fee, a refund unlocks them
+	k.setCosmosOriginatedDenomToERC20(ctx, EthChainPrefix, "stake", *stakeToken)
+	stake := sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, stake))
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, stake))
+	txID, err := k.AddToOutgoingPool(ctx, EthChainPrefix, sender, *receiver, sdk.NewInt64Coin("stake", 500), sdk.NewInt64Coin("stake", 5))
+	require.NoError(t, err)
+	checkBridgedSupply(1000, 505)
+	require.NoError(t, k.RemoveFromOutgoingPoolAndRefund(ctx, EthChainPrefix, txID, sender))
+	checkBridgedSupply(1000, 0)
+
+	// the snapshots and the query read the ledger
+	require.Len(t, k.FetchBridgedTokenBalances(ctx, EthChainPrefix), 2)
+	res, err := k.GetBridgedSupply(sdk.WrapSDKContext(ctx), &types.QueryBridgedSupplyRequest{EvmChainPrefix: EthChainPrefix})
+	require.NoError(t, err)
+	require.Len(t, res.Supply, 2)
+	_, err = k.GetBridgedSupply(sdk.WrapSDKContext(ctx), &types.QueryBridgedSupplyRequest{EvmChainPrefix: "unknown"})
+	require.Error(t, err)
+
+	// vouchers minted outside of the bridge break the bridged supply invariant
+	extra := sdk.NewCoins(sdk.NewInt64Coin(voucherDenom, 1))
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, extra))
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, extra))
+	_, broken := BridgedSupplyInvariant(k)(ctx)
+	require.True(t, broken)
+	k.increaseBridgedSupply(ctx, EthChainPrefix, *ethToken, sdk.OneInt())
+	_, broken = BridgedSupplyInvariant(k)(ctx)
+	require.False(t, broken)
+
+	// a ledger locking more cosmos originated coins than the module holds breaks the module balance invariant
+	k.increaseBridgedSupply(ctx, EthChainPrefix, *stakeToken, sdk.NewInt(10))
+	checkInvariant(t, ctx, k, false)
+	require.NoError(t, k.decreaseBridgedSupply(ctx, EthChainPrefix, *stakeToken, sdk.NewInt(10)))
+	checkInvariant(t, ctx, k, true)
+	require.Error(t, k.decreaseBridgedSupply(ctx, EthChainPrefix, *stakeToken, sdk.OneInt()))
+	checkBridgedSupply(1001, 0)
+
+	// the upgrade seeds a ledger which was never written from the bank
+	_, err = k.AddToOutgoingPool(ctx, EthChainPrefix, sender, *receiver, sdk.NewInt64Coin("stake", 500), sdk.NewInt64Coin("stake", 5))
+	require.NoError(t, err)
+	store := ctx.KVStore(k.storeKey)
+	for _, key := range [][]byte{types.GetBridgedSupplyKey(EthChainPrefix, *ethToken), types.GetBridgedSupplyKey(EthChainPrefix, *stakeToken)} {
+		store.Delete(key)
+	}
+	require.Empty(t, k.BridgedSupply(ctx, EthChainPrefix))
+	k.seedBridgedSupply(ctx)
+	checkBridgedSupply(1001, 505)
+	require.Empty(t, k.BridgedSupply(ctx, BscChainPrefix))
+}
+
+// Tests that the upgrade seeds the ledger of a cosmos originated denom deployed on two evm chains from the escrow of
+// each chain, and that deposits beyond the ledger of a chain fail instead of panicking
+func TestSeedBridgedSupplyMultipleChains(t *testing.T) {
+	input := CreateTestEnv(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+
+	ctx := input.Context
+	k := input.GravityKeeper
+	var (
+		sender           = AccAddrs[0]
+		evmSender, _     = types.NewEthAddress("0x993d06FC97F45f16e4805883b98a6c20BAb54964")
+		receiver, _      = types.NewEthAddress("0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7")
+		ethStake, _      = types.NewEthAddress("0x7580bFE88Dd3d07947908FAE12d95872a260F2D8")
+		bscStake, _      = types.NewEthAddress("0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5")
+		attestationHndlr = AttestationHandler{keeper: &k}
+	)
+	k.setCosmosOriginatedDenomToERC20(ctx, EthChainPrefix, "stake", *ethStake)
+	k.setCosmosOriginatedDenomToERC20(ctx, BscChainPrefix, "stake", *bscStake)
+	stake := sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, stake))
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, stake))
+
+	// the module holds 1000 stake circulating as erc20s from before the ledger existed, and each chain escrows a tx
+	circulating := sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, circulating))
+	ethTxID, err := k.AddToOutgoingPool(ctx, EthChainPrefix, sender, *receiver, sdk.NewInt64Coin("stake", 50), sdk.NewInt64Coin("stake", 5))
+	require.NoError(t, err)
+	bscTxID, err := k.AddToOutgoingPool(ctx, BscChainPrefix, sender, *receiver, sdk.NewInt64Coin("stake", 100), sdk.NewInt64Coin("stake", 10))
+	require.NoError(t, err)
+	_, err = k.AddToOutgoingPool(ctx, BscChainPrefix, sender, *receiver, sdk.NewInt64Coin("stake", 200), sdk.NewInt64Coin("stake", 20))
+	require.NoError(t, err)
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetBridgedSupplyKey(EthChainPrefix, *ethStake))
+	store.Delete(types.GetBridgedSupplyKey(BscChainPrefix, *bscStake))
+	k.seedBridgedSupply(ctx)
+	// bsc is the first chain by prefix, it is credited with the circulating stake on top of its escrow
+	require.Equal(t, sdk.NewInt(55), k.GetTokenBridgedSupply(ctx, EthChainPrefix, *ethStake))
+	require.Equal(t, sdk.NewInt(1330), k.GetTokenBridgedSupply(ctx, BscChainPrefix, *bscStake))
+	checkInvariant(t, ctx, k, true)
+
+	// the escrow of each chain can be refunded
+	require.NoError(t, k.RemoveFromOutgoingPoolAndRefund(ctx, EthChainPrefix, ethTxID, sender))
+	require.Equal(t, sdk.ZeroInt(), k.GetTokenBridgedSupply(ctx, EthChainPrefix, *ethStake))
+	require.NoError(t, k.RemoveFromOutgoingPoolAndRefund(ctx, BscChainPrefix, bscTxID, sender))
+	require.Equal(t, sdk.NewInt(1220), k.GetTokenBridgedSupply(ctx, BscChainPrefix, *bscStake))
+
+	// a deposit within the ledger of the chain is delivered, one beyond it fails and leaves the ledger untouched
+	deposit := func(evmChainPrefix string, tokenContract types.EthAddress, amount int64) error {
+		return attestationHndlr.handleSendToCosmos(ctx, types.MsgSendToCosmosClaim{
+			EventNonce:     1,
+			EthBlockHeight: 1,
+			TokenContract:  tokenContract.GetAddress().Hex(),
+			Amount:         sdk.NewInt(amount),
+			EthereumSender: evmSender.GetAddress().Hex(),
+			CosmosReceiver: sender.String(),
+			Orchestrator:   sender.String(),
+			EvmChainPrefix: evmChainPrefix,
+		})
+	}
+	require.NoError(t, deposit(BscChainPrefix, *bscStake, 200))
+	require.Equal(t, sdk.NewInt(1020), k.GetTokenBridgedSupply(ctx, BscChainPrefix, *bscStake))
+	require.Error(t, deposit(EthChainPrefix, *ethStake, 1))
+	require.Equal(t, sdk.ZeroInt(), k.GetTokenBridgedSupply(ctx, EthChainPrefix, *ethStake))
+	checkInvariant(t, ctx, k, true)
+}
+
 func checkInvariant(t *testing.T, ctx sdk.Context, k Keeper, succeed bool) {
 	res, ok := ModuleBalanceInvariant(k)(ctx)
 	if succeed {
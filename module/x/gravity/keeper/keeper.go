@@ -379,6 +379,25 @@ func (k Keeper) IterateValidatorsByOrchestratorAddress(ctx sdk.Context, cb func(
 	}
 }
 
+// skipSlashingBeforeUpgrade marks every valset, batch and logic call of every evm chain created before the current
+// block as handled by slashing, without slashing anyone for them. Slashing was disabled before the v5 upgrade, so
+// validators could not have been expected to sign them
+func (k Keeper) skipSlashingBeforeUpgrade(ctx sdk.Context) {
+	height := uint64(ctx.BlockHeight())
+	for _, evmChain := range k.GetEvmChains(ctx) {
+		prefix := evmChain.EvmChainPrefix
+		if nonce := k.GetLatestValsetNonce(ctx, prefix); nonce > k.GetLastSlashedValsetNonce(ctx, prefix) {
+			k.SetLastSlashedValsetNonce(ctx, prefix, nonce)
+		}
+		if height > k.GetLastSlashedBatchBlock(ctx, prefix) {
+			k.SetLastSlashedBatchBlock(ctx, prefix, height)
+		}
+		if height > k.GetLastSlashedLogicCallBlock(ctx, prefix) {
+			k.SetLastSlashedLogicCallBlock(ctx, prefix, height)
+		}
+	}
+}
+
 /////////////////////////////
 //// Logic Call Slashing ////
 /////////////////////////////
@@ -417,7 +436,7 @@ func (k Keeper) GetUnSlashedLogicCalls(ctx sdk.Context, evmChainPrefix string, m
 	lastSlashedLogicCallBlock := k.GetLastSlashedLogicCallBlock(ctx, evmChainPrefix)
 	calls := k.GetOutgoingLogicCalls(ctx, evmChainPrefix)
 	for _, call := range calls {
-		if call.CosmosBlockCreated > lastSlashedLogicCallBlock {
+		if call.CosmosBlockCreated > lastSlashedLogicCallBlock && call.CosmosBlockCreated < maxHeight {
 			out = append(out, call)
 		}
 	}
@@ -474,29 +493,9 @@ func (k Keeper) DeserializeValidatorIterator(vals []byte) stakingtypes.ValAddres
 	return validators
 }
 
-// Checks if the provided evm address is on the Governance blacklist
+// Checks if the provided evm address is on the Governance blacklist of the evm chain
 func (k Keeper) IsOnBlacklist(ctx sdk.Context, evmChainPrefix string, addr types.EthAddress) bool {
-	params := k.GetParams(ctx)
-
-	evmChainParam := params.GetEvmChain(evmChainPrefix)
-
-	if evmChainParam == nil {
-		return false
-	}
-
-	// Checks the address if it's inside the blacklisted address list and marks
-	// if it's inside the list.
-	for _, baddrStr := range evmChainParam.EthereumBlacklist {
-		baddr, err := types.NewEthAddress(baddrStr)
-		if err != nil {
-			// this should not be possible we validate on genesis load
-			panic("unvalidated black list address!")
-		}
-		if *baddr == addr {
-			return true
-		}
-	}
-	return false
+	return k.isBlacklisted(ctx, evmChainPrefix, types.BlacklistEvmAddressBytes(addr))
 }
 
 // Returns true if the provided address is invalid to send to evm chain this could be
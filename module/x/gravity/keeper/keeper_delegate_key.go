@@ -26,6 +26,17 @@ func (k Keeper) SetOrchestratorValidator(ctx sdk.Context, val sdk.ValAddress, or
 	}
 	store := ctx.KVStore(k.storeKey)
 	store.Set(types.GetOrchestratorAddressKey(orch), val.Bytes())
+	store.Set(types.GetOrchestratorByValidatorKey(val), orch.Bytes())
+}
+
+// GetOrchestratorByValidator returns the orchestrator key a validator has set
+func (k Keeper) GetOrchestratorByValidator(ctx sdk.Context, val sdk.ValAddress) (orch sdk.AccAddress, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetOrchestratorByValidatorKey(val))
+	if bz == nil {
+		return nil, false
+	}
+	return sdk.AccAddress(bz), true
 }
 
 // GetOrchestratorValidator returns the validator key associated with an orchestrator key
@@ -1,11 +1,14 @@
 package keeper
 
 import (
+	"bytes"
 	"encoding/hex"
 	"fmt"
+	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
 )
@@ -14,9 +17,13 @@ import (
 //// LOGIC CALLS ////
 /////////////////////
 
-// GetOutgoingLogicCall gets an outgoing logic call
+// GetOutgoingLogicCall gets an outgoing logic call, returns nil when not exists
 func (k Keeper) GetOutgoingLogicCall(ctx sdk.Context, evmChainPrefix string, invalidationID []byte, invalidationNonce uint64) *types.OutgoingLogicCall {
 	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetOutgoingLogicCallKey(evmChainPrefix, invalidationID, invalidationNonce))
+	if len(bz) == 0 {
+		return nil
+	}
 	call := types.OutgoingLogicCall{
 		Transfers:            []types.ERC20Token{},
 		Fees:                 []types.ERC20Token{},
@@ -26,11 +33,98 @@ func (k Keeper) GetOutgoingLogicCall(ctx sdk.Context, evmChainPrefix string, inv
 		InvalidationId:       invalidationID,
 		InvalidationNonce:    invalidationNonce,
 		CosmosBlockCreated:   0,
+		Funded:               false,
 	}
-	k.cdc.MustUnmarshal(store.Get(types.GetOutgoingLogicCallKey(evmChainPrefix, invalidationID, invalidationNonce)), &call)
+	k.cdc.MustUnmarshal(bz, &call)
 	return &call
 }
 
+// CreateOutgoingLogicCall builds a new logic call for the given evm chain and stores it along with
+// its checkpoint so that orchestrators can start collecting signatures for it. When `funded` is set the caller
+// is responsible for locking the transferred tokens and fees in the gravity module, see LogicCallCoins
+func (k Keeper) CreateOutgoingLogicCall(
+	ctx sdk.Context,
+	evmChainPrefix string,
+	transfers []types.ERC20Token,
+	fees []types.ERC20Token,
+	logicContract types.EthAddress,
+	payload []byte,
+	timeout uint64,
+	invalidationId []byte,
+	invalidationNonce uint64,
+	funded bool,
+) (*types.OutgoingLogicCall, error) {
+	evmChainParam := k.GetEvmChainParam(ctx, evmChainPrefix)
+	if evmChainParam == nil {
+		return nil, sdkerrors.Wrap(types.ErrEvmChainNotFound, evmChainPrefix)
+	}
+	if !evmChainParam.BridgeActive {
+		return nil, sdkerrors.Wrap(types.ErrInvalid, "bridge paused")
+	}
+
+	call := types.OutgoingLogicCall{
+		Transfers:            transfers,
+		Fees:                 fees,
+		LogicContractAddress: logicContract.GetAddress().Hex(),
+		Payload:              payload,
+		Timeout:              timeout,
+		InvalidationId:       invalidationId,
+		InvalidationNonce:    invalidationNonce,
+		CosmosBlockCreated:   uint64(ctx.BlockHeight()),
+		Funded:               funded,
+	}
+	if err := call.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	// a call which has already timed out would be canceled in the very next EndBlocker
+	evmChainHeight := k.GetLastObservedEvmChainBlockHeight(ctx, evmChainPrefix).EthereumBlockHeight
+	if timeout <= evmChainHeight {
+		return nil, sdkerrors.Wrapf(types.ErrTimeout, "logic call timeout %d is not after the last observed evm chain height %d", timeout, evmChainHeight)
+	}
+	if k.GetOutgoingLogicCall(ctx, evmChainPrefix, invalidationId, invalidationNonce) != nil {
+		return nil, sdkerrors.Wrap(types.ErrDuplicate, "logic call with this invalidation id and nonce already exists")
+	}
+
+	k.SetOutgoingLogicCall(ctx, evmChainPrefix, call)
+
+	return &call, ctx.EventManager().EmitTypedEvent(
+		&types.EventOutgoingLogicCall{
+			BridgeContract:             k.GetBridgeContractAddress(ctx, evmChainPrefix).GetAddress().Hex(),
+			BridgeChainId:              strconv.Itoa(int(k.GetBridgeChainID(ctx, evmChainPrefix))),
+			LogicCallInvalidationId:    hex.EncodeToString(call.InvalidationId),
+			LogicCallInvalidationNonce: fmt.Sprint(call.InvalidationNonce),
+			LogicContractAddress:       call.LogicContractAddress,
+			Timeout:                    fmt.Sprint(call.Timeout),
+		},
+	)
+}
+
+// LogicCallCoins computes the Cosmos side coins represented by the transfers and fees of a logic call,
+// these are the coins which must be locked in the gravity module while the call is pending
+func (k Keeper) LogicCallCoins(ctx sdk.Context, evmChainPrefix string, call types.OutgoingLogicCall) (sdk.Coins, error) {
+	coins := sdk.NewCoins()
+	tokens := append(append([]types.ERC20Token{}, call.Transfers...), call.Fees...)
+	for _, t := range tokens {
+		token, err := t.ToInternal()
+		if err != nil {
+			return nil, sdkerrors.Wrap(types.ErrInvalidLogicCall, err.Error())
+		}
+		_, denom := k.ERC20ToDenomLookup(ctx, evmChainPrefix, token.Contract)
+		coins = coins.Add(sdk.NewCoin(denom, token.Amount))
+	}
+	return coins, nil
+}
+
+// lockedLogicCallCoins returns the coins the gravity module locked for a logic call, none if the call was never
+// funded, for instance when imported from genesis
+func (k Keeper) lockedLogicCallCoins(ctx sdk.Context, evmChainPrefix string, call types.OutgoingLogicCall) (sdk.Coins, error) {
+	if !call.Funded {
+		return sdk.NewCoins(), nil
+	}
+	return k.LogicCallCoins(ctx, evmChainPrefix, call)
+}
+
 // SetOutogingLogicCall sets an outgoing logic call, panics if one already exists at this
 // index, since we collect signatures over logic calls no mutation can be valid
 func (k Keeper) SetOutgoingLogicCall(ctx sdk.Context, evmChainPrefix string, call types.OutgoingLogicCall) {
@@ -82,8 +176,29 @@ func (k Keeper) CancelOutgoingLogicCall(ctx sdk.Context, evmChainPrefix string,
 	if call == nil {
 		return types.ErrUnknown
 	}
-	// Delete batch since it is finished
+	// Return the locked transfers and fees to the community pool which funded them
+	coins, err := k.lockedLogicCallCoins(ctx, evmChainPrefix, *call)
+	if err != nil {
+		return err
+	}
+	if !coins.IsZero() {
+		cosmosOriginated, err := k.logicCallCosmosOriginatedCoins(ctx, evmChainPrefix, *call)
+		if err != nil {
+			return err
+		}
+		if err := k.decreaseBridgedSupplyOfCoins(ctx, evmChainPrefix, cosmosOriginated); err != nil {
+			return err
+		}
+		modAcc := k.accountKeeper.GetModuleAddress(types.ModuleName)
+		if err := k.DistKeeper.FundCommunityPool(ctx, coins, modAcc); err != nil {
+			return sdkerrors.Wrap(err, "unable to return logic call funds to the community pool")
+		}
+	}
+
+	// Delete logic call since it is finished
 	k.DeleteOutgoingLogicCall(ctx, evmChainPrefix, call.InvalidationId, call.InvalidationNonce)
+	// Delete it's confirmations as well
+	k.DeleteLogicCallConfirms(ctx, evmChainPrefix, call.InvalidationId, call.InvalidationNonce)
 
 	// a consuming application will have to watch for this event and act on it
 	return ctx.EventManager().EmitTypedEvent(
@@ -94,6 +209,86 @@ func (k Keeper) CancelOutgoingLogicCall(ctx sdk.Context, evmChainPrefix string,
 	)
 }
 
+// OutgoingLogicCallExecuted is run when the Cosmos chain detects that a logic call has been executed on the evm chain.
+// It burns the evm originated vouchers locked for the call, cancels all calls with the same invalidation id and a lower
+// invalidation nonce (the Gravity contract will refuse to execute those anymore) and cleans up the call and its
+// confirmations, this function panics instead of returning errors because any failure will cause a double spend.
+func (k Keeper) OutgoingLogicCallExecuted(ctx sdk.Context, claim types.MsgLogicCallExecutedClaim) {
+	call := k.GetOutgoingLogicCall(ctx, claim.EvmChainPrefix, claim.InvalidationId, claim.InvalidationNonce)
+	if call == nil {
+		panic(fmt.Sprintf("unknown logic call for invalidation id %x nonce %d", claim.InvalidationId, claim.InvalidationNonce))
+	}
+	if call.Timeout <= claim.EthBlockHeight {
+		panic(fmt.Sprintf("Logic call with invalidation nonce %d submitted after it timed out (submission %d >= timeout %d)?",
+			claim.InvalidationNonce, claim.EthBlockHeight, call.Timeout))
+	}
+
+	// Burn the vouchers of evm originated tokens, cosmos originated tokens remain locked in the module
+	// since they now back the erc20 representations sent out by the Gravity contract
+	burnVouchers, err := k.LogicCallEthOriginatedCoins(ctx, claim.EvmChainPrefix, *call)
+	if err != nil {
+		panic(sdkerrors.Wrap(err, "invalid executed logic call"))
+	}
+	if !burnVouchers.IsZero() {
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, burnVouchers); err != nil {
+			panic(err)
+		}
+		if err := k.decreaseBridgedSupplyOfCoins(ctx, claim.EvmChainPrefix, burnVouchers); err != nil {
+			panic(err)
+		}
+	}
+
+	// Cancel all earlier calls sharing this invalidation id
+	for _, other := range k.GetOutgoingLogicCalls(ctx, claim.EvmChainPrefix) {
+		if bytes.Equal(other.InvalidationId, call.InvalidationId) && other.InvalidationNonce < call.InvalidationNonce {
+			err := k.CancelOutgoingLogicCall(ctx, claim.EvmChainPrefix, other.InvalidationId, other.InvalidationNonce)
+			if err != nil {
+				panic(fmt.Sprintf("Failed cancel logic call %x %d while trying to execute %x %d with %s",
+					other.InvalidationId, other.InvalidationNonce, call.InvalidationId, call.InvalidationNonce, err))
+			}
+		}
+	}
+
+	// Delete logic call since it is finished
+	k.DeleteOutgoingLogicCall(ctx, claim.EvmChainPrefix, call.InvalidationId, call.InvalidationNonce)
+	// Delete it's confirmations as well
+	k.DeleteLogicCallConfirms(ctx, claim.EvmChainPrefix, call.InvalidationId, call.InvalidationNonce)
+}
+
+// LogicCallEthOriginatedCoins computes the evm originated vouchers which are burned when the given logic call executes,
+// which is exactly the change in bank supply caused by the execution. Only the vouchers of a funded call are burned
+func (k Keeper) LogicCallEthOriginatedCoins(ctx sdk.Context, evmChainPrefix string, call types.OutgoingLogicCall) (sdk.Coins, error) {
+	coins, err := k.lockedLogicCallCoins(ctx, evmChainPrefix, call)
+	if err != nil {
+		return nil, err
+	}
+	ethOriginated := sdk.NewCoins()
+	for _, coin := range coins {
+		contract, err := types.GravityDenomToERC20(evmChainPrefix, coin.Denom)
+		if err != nil {
+			continue // not a gravity voucher, so cosmos originated
+		}
+		if isCosmosOriginated, _ := k.ERC20ToDenomLookup(ctx, evmChainPrefix, *contract); !isCosmosOriginated {
+			ethOriginated = ethOriginated.Add(coin)
+		}
+	}
+	return ethOriginated, nil
+}
+
+// logicCallCosmosOriginatedCoins computes the cosmos originated coins locked for the given logic call, which remain
+// locked in the gravity module once the call executes
+func (k Keeper) logicCallCosmosOriginatedCoins(ctx sdk.Context, evmChainPrefix string, call types.OutgoingLogicCall) (sdk.Coins, error) {
+	coins, err := k.lockedLogicCallCoins(ctx, evmChainPrefix, call)
+	if err != nil {
+		return nil, err
+	}
+	ethOriginated, err := k.LogicCallEthOriginatedCoins(ctx, evmChainPrefix, call)
+	if err != nil {
+		return nil, err
+	}
+	return coins.Sub(ethOriginated), nil
+}
+
 /////////////////////////////
 ///// LOGIC CONFIRMS ////////
 /////////////////////////////
@@ -147,6 +342,16 @@ func (k Keeper) DeleteLogicCallConfirm(
 	ctx.KVStore(k.storeKey).Delete(types.GetLogicConfirmKey(evmChainPrefix, invalidationID, invalidationNonce, val))
 }
 
+// DeleteLogicCallConfirms deletes all the confirms for the given logic call
+func (k Keeper) DeleteLogicCallConfirms(ctx sdk.Context, evmChainPrefix string, invalidationID []byte, invalidationNonce uint64) {
+	for _, confirm := range k.GetLogicConfirmsByInvalidationIDAndNonce(ctx, evmChainPrefix, invalidationID, invalidationNonce) {
+		orchestrator, err := sdk.AccAddressFromBech32(confirm.Orchestrator)
+		if err == nil {
+			k.DeleteLogicCallConfirm(ctx, evmChainPrefix, invalidationID, invalidationNonce, orchestrator)
+		}
+	}
+}
+
 // IterateLogicConfirmsByInvalidationIDAndNonce iterates over all logic confirms stored by invalidation id and nonce,
 // applying the given callback on each discovered confirm.
 // cb should return true to stop iteration, false to continue
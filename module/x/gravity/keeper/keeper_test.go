@@ -270,3 +270,36 @@ func TestLastSlashedValsetNonce(t *testing.T) {
 		fmt.Println("unslashedValsetsRange", unslashedValsets)
 	}
 }
+
+func TestSkipSlashingBeforeUpgrade(t *testing.T) {
+	input, ctx := SetupFiveValChain(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+
+	k := input.GravityKeeper
+	for _, evmChain := range k.GetEvmChains(ctx) {
+		vs, err := k.GetCurrentValset(ctx, evmChain.EvmChainPrefix)
+		require.NoError(t, err)
+		for i := 1; i < 4; i++ {
+			vs.Height = uint64(i)
+			vs.Nonce = uint64(i)
+			k.StoreValset(ctx, evmChain.EvmChainPrefix, vs)
+			k.SetLatestValsetNonce(ctx, evmChain.EvmChainPrefix, vs.Nonce)
+		}
+	}
+
+	k.skipSlashingBeforeUpgrade(ctx)
+
+	height := uint64(ctx.BlockHeight())
+	for _, evmChain := range k.GetEvmChains(ctx) {
+		assert.Equal(t, uint64(3), k.GetLastSlashedValsetNonce(ctx, evmChain.EvmChainPrefix))
+		assert.Empty(t, k.GetUnSlashedValsets(ctx, evmChain.EvmChainPrefix, 0))
+		assert.Equal(t, height, k.GetLastSlashedBatchBlock(ctx, evmChain.EvmChainPrefix))
+		assert.Equal(t, height, k.GetLastSlashedLogicCallBlock(ctx, evmChain.EvmChainPrefix))
+	}
+
+	// the markers never move backwards
+	k.skipSlashingBeforeUpgrade(ctx.WithBlockHeight(1))
+	for _, evmChain := range k.GetEvmChains(ctx) {
+		assert.Equal(t, height, k.GetLastSlashedBatchBlock(ctx, evmChain.EvmChainPrefix))
+	}
+}
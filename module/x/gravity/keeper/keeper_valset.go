@@ -24,11 +24,13 @@ import (
 // is signed by consensus. If you want to peek at the present state of the set
 // and perhaps take action based on that use k.GetCurrentValset
 // i.e. {"nonce": 1, "memebers": [{"eth_addr": "foo", "power": 11223}]}
-func (k Keeper) SetValsetRequest(ctx sdk.Context, evmChainPrefix string) types.Valset {
+// The reason the valset was requested is recorded on it and emitted with the EventMultisigUpdateRequest
+func (k Keeper) SetValsetRequest(ctx sdk.Context, evmChainPrefix string, reason types.ValsetReason) types.Valset {
 	valset, err := k.GetCurrentValset(ctx, evmChainPrefix)
 	if err != nil {
 		panic(err)
 	}
+	valset.Reason = reason
 	k.StoreValset(ctx, evmChainPrefix, valset)
 	k.SetLatestValsetNonce(ctx, evmChainPrefix, valset.Nonce)
 
@@ -45,6 +47,7 @@ func (k Keeper) SetValsetRequest(ctx sdk.Context, evmChainPrefix string) types.V
 			BridgeChainId:  strconv.Itoa(int(k.GetBridgeChainID(ctx, evmChainPrefix))),
 			MultisigId:     fmt.Sprint(valset.Nonce),
 			Nonce:          fmt.Sprint(valset.Nonce),
+			Reason:         reason.String(),
 		},
 	); err != nil {
 		panic(err)
@@ -195,6 +198,25 @@ func (k Keeper) GetLastUnBondingBlockHeight(ctx sdk.Context) uint64 {
 	return types.UInt64FromBytesUnsafe(bytes)
 }
 
+// SetLastDelegateKeyChangeBlockHeight sets the last block height a validator set its delegate keys at. Like the last
+// unbonding block height this value is not saved and loaded in genesis
+func (k Keeper) SetLastDelegateKeyChangeBlockHeight(ctx sdk.Context, blockHeight uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LastDelegateKeyChangeBlockHeight, types.UInt64Bytes(blockHeight))
+}
+
+// GetLastDelegateKeyChangeBlockHeight returns the last block height a validator set its delegate keys at, returns
+// zero if not set
+func (k Keeper) GetLastDelegateKeyChangeBlockHeight(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bytes := store.Get(types.LastDelegateKeyChangeBlockHeight)
+
+	if len(bytes) == 0 {
+		return 0
+	}
+	return types.UInt64FromBytesUnsafe(bytes)
+}
+
 // GetUnSlashedValsets returns all the "ready-to-slash" unslashed validator sets in state (valsets at least signedValsetsWindow blocks old)
 func (k Keeper) GetUnSlashedValsets(ctx sdk.Context, evmChainPrefix string, signedValsetsWindow uint64) (out []*types.Valset) {
 	lastSlashedValsetNonce := k.GetLastSlashedValsetNonce(ctx, evmChainPrefix)
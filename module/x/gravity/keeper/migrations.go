@@ -5,6 +5,7 @@ import (
 	v2 "github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/migrations/v2"
 	v3 "github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/migrations/v3"
 	v4 "github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/migrations/v4"
+	v5 "github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/migrations/v5"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -38,3 +39,23 @@ func (m Migrator) Migrate3to4(ctx sdk.Context) error {
 	ctx.Logger().Info("Pleiades Upgrade part 2: Gravity module migration is complete!")
 	return nil
 }
+
+// Migrate4to5 migrates from consensus version 4 to 5.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	ctx.Logger().Info("v5 Upgrade: Enter Migrate4to5()")
+	if err := v5.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc); err != nil {
+		return err
+	}
+	// The bridged supply ledger is new in v5, it is seeded from the bank since the tokens bridged so far were never
+	// recorded in it
+	ctx.Logger().Info("v5 Upgrade: Seeding the bridged supply ledger")
+	m.keeper.seedBridgedSupply(ctx)
+	// Slashing is enabled again in v5, the valsets, batches and logic calls left unsigned while it was disabled must
+	// not be slashed for all at once
+	ctx.Logger().Info("v5 Upgrade: Skipping slashing of everything created before the upgrade")
+	m.keeper.skipSlashingBeforeUpgrade(ctx)
+	// The EthereumBlacklist param is deprecated in v5, its addresses join the indexed blacklist of their evm chain
+	ctx.Logger().Info("v5 Upgrade: Moving the ethereum blacklist params to the blacklist index")
+	m.keeper.migrateParamBlacklist(ctx)
+	return nil
+}
@@ -75,6 +75,8 @@ func (k msgServer) SetOrchestratorAddress(c context.Context, msg *types.MsgSetOr
 	k.SetOrchestratorValidator(ctx, val, orch)
 	// set the evm address
 	k.SetEvmAddressForValidator(ctx, val, *ethAddr)
+	// evm chains which refresh their valset on delegate key changes request one at the end of this block
+	k.SetLastDelegateKeyChangeBlockHeight(ctx, uint64(ctx.BlockHeight()))
 
 	return &types.MsgSetOrchestratorAddressResponse{}, ctx.EventManager().EmitTypedEvent(
 		&types.EventSetOperatorAddress{
@@ -137,13 +139,16 @@ func (k msgServer) SendToEth(c context.Context, msg *types.MsgSendToEth) (*types
 	if k.InvalidSendToEthAddress(ctx, msg.EvmChainPrefix, *dest, *erc20) {
 		return nil, sdkerrors.Wrap(types.ErrInvalid, "destination address is invalid or blacklisted")
 	}
+	if k.IsOnCosmosBlacklist(ctx, msg.EvmChainPrefix, sender) {
+		return nil, sdkerrors.Wrapf(types.ErrBlacklisted, "sender %s", msg.Sender)
+	}
 
 	// Collect the ChainFee and give to stakers, ensuring it meets MinChainFeeBasisPoints
 	if err := k.checkAndDeductSendToEthFees(ctx, sender, msg.Amount, msg.ChainFee); err != nil {
 		return nil, sdkerrors.Wrapf(err, "Could not deduct chainFee %v from account %v", msg.ChainFee.String(), msg.Sender)
 	}
 
-	txID, err := k.AddToOutgoingPool(ctx, msg.EvmChainPrefix, sender, *dest, msg.Amount, msg.BridgeFee)
+	txID, err := k.AddToOutgoingPoolWithExtraFee(ctx, msg.EvmChainPrefix, sender, *dest, msg.Amount, msg.BridgeFee, msg.ExtraFee)
 	if err != nil {
 		return nil, sdkerrors.Wrap(err, "Could not add to outgoing pool")
 	}
@@ -156,19 +161,24 @@ func (k msgServer) SendToEth(c context.Context, msg *types.MsgSendToEth) (*types
 	)
 }
 
-// checkAndDeductSendToEthFees asserts that the minimum chainFee has been met for the given sendAmount
-func (k msgServer) checkAndDeductSendToEthFees(ctx sdk.Context, sender sdk.AccAddress, sendAmount sdk.Coin, chainFee sdk.Coin) error {
-	// Compute the minimum fee which must be paid
+// minChainFee computes the minimum chainFee which must be paid to send sendAmount to an evm chain
+func (k Keeper) minChainFee(ctx sdk.Context, sendAmount sdk.Coin) sdk.Int {
 	minFeeBasisPoints := int64(0)
-	params, err := k.Keeper.GetParamsIfSet(ctx)
+	params, err := k.GetParamsIfSet(ctx)
 	if err == nil {
 		// The params have been set, get the min send to eth fee
 		minFeeBasisPoints = int64(params.MinChainFeeBasisPoints)
 	}
-	minFee := sdk.NewDecFromInt(sendAmount.Amount).
+	return sdk.NewDecFromInt(sendAmount.Amount).
 		QuoInt64(int64(BasisPointDivisor)).
 		MulInt64(minFeeBasisPoints).
 		TruncateInt()
+}
+
+// checkAndDeductSendToEthFees asserts that the minimum chainFee has been met for the given sendAmount
+func (k Keeper) checkAndDeductSendToEthFees(ctx sdk.Context, sender sdk.AccAddress, sendAmount sdk.Coin, chainFee sdk.Coin) error {
+	// Compute the minimum fee which must be paid
+	minFee := k.minChainFee(ctx, sendAmount)
 
 	// Require that the minimum has been met
 	if minFee.GT(sdk.ZeroInt()) { // Ignore fees too low to collect
@@ -189,7 +199,7 @@ func (k msgServer) checkAndDeductSendToEthFees(ctx sdk.Context, sender sdk.AccAd
 	if !(chainFee == sdk.Coin{}) && chainFee.Amount.IsPositive() {
 		senderAcc := k.accountKeeper.GetAccount(ctx, sender)
 
-		err = sdkante.DeductFees(k.bankKeeper, ctx, senderAcc, sdk.NewCoins(chainFee))
+		err := sdkante.DeductFees(k.bankKeeper, ctx, senderAcc, sdk.NewCoins(chainFee))
 		if err != nil {
 			ctx.Logger().Error("Could not deduct MsgSendToEth fee!", "error", err, "account", senderAcc, "chainFee", chainFee)
 			return err
@@ -454,6 +464,13 @@ func (k msgServer) BatchSendToEthClaim(c context.Context, msg *types.MsgBatchSen
 
 	additionalPatchChecks(ctx, k, msg)
 
+	// the relayer only takes part in the claim once every orchestrator of the chain reports it, otherwise claims
+	// of the same batch would hash differently and split the attesting power
+	params := k.GetParams(ctx)
+	if evmChainParam := params.GetEvmChain(msg.EvmChainPrefix); evmChainParam == nil || !evmChainParam.RelayerInBatchClaims {
+		msg.Relayer = ""
+	}
+
 	msgAny, err := codectypes.NewAnyWithValue(msg)
 	if err != nil {
 		panic(sdkerrors.Wrap(err, "Could not check Any value"))
@@ -561,6 +578,43 @@ func (k msgServer) CancelSendToEth(c context.Context, msg *types.MsgCancelSendTo
 	return &types.MsgCancelSendToEthResponse{}, nil
 }
 
+// IncreaseBridgeFee adds to the bridge fee of an unbatched MsgSendToEth owned by the sender
+func (k msgServer) IncreaseBridgeFee(c context.Context, msg *types.MsgIncreaseBridgeFee) (*types.MsgIncreaseBridgeFeeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid sender")
+	}
+	if k.IsOnCosmosBlacklist(ctx, msg.EvmChainPrefix, sender) {
+		return nil, sdkerrors.Wrapf(types.ErrBlacklisted, "sender %s", msg.Sender)
+	}
+	err = k.Keeper.IncreaseBridgeFee(ctx, msg.EvmChainPrefix, msg.TransactionId, sender, msg.AddedFee)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgIncreaseBridgeFeeResponse{}, nil
+}
+
+// RequestBatchCancellation cancels a stuck batch on behalf of the sender of one of its txs
+func (k msgServer) RequestBatchCancellation(c context.Context, msg *types.MsgRequestBatchCancellation) (*types.MsgRequestBatchCancellationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid sender")
+	}
+	tokenContract, err := types.NewEthAddress(msg.TokenContract)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "invalid token contract")
+	}
+	err = k.Keeper.RequestBatchCancellation(ctx, msg.EvmChainPrefix, *tokenContract, msg.Nonce, sender)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRequestBatchCancellationResponse{}, nil
+}
+
 func (k msgServer) SubmitBadSignatureEvidence(c context.Context, msg *types.MsgSubmitBadSignatureEvidence) (*types.MsgSubmitBadSignatureEvidenceResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
@@ -577,3 +631,14 @@ func (k msgServer) SubmitBadSignatureEvidence(c context.Context, msg *types.MsgS
 		},
 	)
 }
+
+func (k msgServer) SubmitDoubleSignEvidence(c context.Context, msg *types.MsgSubmitDoubleSignEvidence) (*types.MsgSubmitDoubleSignEvidenceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	err := k.CheckDoubleSignEvidence(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSubmitDoubleSignEvidenceResponse{}, nil
+}
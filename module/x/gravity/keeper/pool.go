@@ -25,26 +25,67 @@ func (k Keeper) AddToOutgoingPool(
 	counterpartReceiver types.EthAddress,
 	amount sdk.Coin,
 	fee sdk.Coin,
+) (uint64, error) {
+	return k.AddToOutgoingPoolWithExtraFee(ctx, evmChainPrefix, sender, counterpartReceiver, amount, fee, nil)
+}
+
+// AddToOutgoingPoolWithExtraFee is AddToOutgoingPool with an optional extraFee, which is escrowed by the module and
+// paid on cosmos to the orchestrator of the relayer once the batch of the tx executes. Its denom must be one of the
+// extra fee denoms of the evm chain. The extra fee is a tip for the relayer, it has no price in the bridged token so
+// batch strategies, auto batch thresholds and the profitability check of BuildOutgoingTxBatch only count `fee`
+func (k Keeper) AddToOutgoingPoolWithExtraFee(
+	ctx sdk.Context,
+	evmChainPrefix string,
+	sender sdk.AccAddress,
+	counterpartReceiver types.EthAddress,
+	amount sdk.Coin,
+	fee sdk.Coin,
+	extraFee *sdk.Coin,
 ) (uint64, error) {
 	if ctx.IsZero() || sdk.VerifyAddressFormat(sender) != nil || counterpartReceiver.ValidateBasic() != nil ||
 		!amount.IsValid() || !fee.IsValid() || fee.Denom != amount.Denom {
 		return 0, sdkerrors.Wrap(types.ErrInvalid, "arguments")
 	}
+	if extraFee != nil {
+		if !extraFee.IsValid() || !extraFee.IsPositive() {
+			return 0, sdkerrors.Wrap(types.ErrInvalid, "extra fee")
+		}
+		if !k.IsExtraFeeDenom(ctx, evmChainPrefix, extraFee.Denom) {
+			return 0, sdkerrors.Wrapf(types.ErrInvalid, "extra fee denom %s is not accepted by %s", extraFee.Denom, evmChainPrefix)
+		}
+	}
+	if k.IsEvmChainDraining(ctx, evmChainPrefix) {
+		return 0, sdkerrors.Wrapf(types.ErrEvmChainDraining, "no new withdrawals to %s", evmChainPrefix)
+	}
 	totalAmount := amount.Add(fee)
 	totalInVouchers := sdk.Coins{totalAmount}
 
 	// If the coin is a gravity voucher, burn the coins. If not, check if there is a deployed ERC20 contract representing it.
 	// If there is, lock the coins.
 
-	_, tokenContract, err := k.DenomToERC20Lookup(ctx, evmChainPrefix, totalAmount.Denom)
+	isCosmosOriginated, tokenContract, err := k.DenomToERC20Lookup(ctx, evmChainPrefix, totalAmount.Denom)
 	if err != nil {
 		return 0, err
 	}
 
+	// the amount and fee both leave for the evm chain, reject sends beyond the outbound rate limit of the denom
+	if err := k.consumeOutboundRateLimit(ctx, evmChainPrefix, totalAmount); err != nil {
+		return 0, err
+	}
+
 	// lock coins in module
 	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, totalInVouchers); err != nil {
 		return 0, err
 	}
+	if isCosmosOriginated {
+		k.increaseBridgedSupply(ctx, evmChainPrefix, *tokenContract, totalAmount.Amount)
+	}
+	// escrow the extra fee until the batch of the tx executes
+	if extraFee != nil {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, sdk.Coins{*extraFee}); err != nil {
+			return 0, sdkerrors.Wrap(err, "extra fee")
+		}
+	}
 
 	// get next tx id from keeper
 	nextID := k.autoIncrementID(ctx, types.AppendChainPrefix(types.KeyLastTXPoolID, evmChainPrefix))
@@ -63,11 +104,13 @@ func (k Keeper) AddToOutgoingPool(
 	// the token as an ERC20 token since it is preparing to go to evm
 	// rather than the denom that is the input to this function.
 	outgoing, err := types.OutgoingTransferTx{
-		Id:          nextID,
-		Sender:      sender.String(),
-		DestAddress: counterpartReceiver.GetAddress().Hex(),
-		Erc20Token:  erc20Token.ToExternal(),
-		Erc20Fee:    erc20Fee.ToExternal(),
+		Id:                 nextID,
+		Sender:             sender.String(),
+		DestAddress:        counterpartReceiver.GetAddress().Hex(),
+		Erc20Token:         erc20Token.ToExternal(),
+		Erc20Fee:           erc20Fee.ToExternal(),
+		CosmosBlockCreated: uint64(ctx.BlockHeight()),
+		ExtraFee:           extraFee,
 	}.ToInternal()
 	if err != nil { // This should never happen since all the components are validated
 		panic(sdkerrors.Wrap(err, "unable to create InternalOutgoingTransferTx"))
@@ -130,15 +173,30 @@ func (k Keeper) RemoveFromOutgoingPoolAndRefund(ctx sdk.Context, evmChainPrefix
 	}
 
 	// Calculate refund
-	_, denom := k.ERC20ToDenomLookup(ctx, evmChainPrefix, tx.Erc20Token.Contract)
+	isCosmosOriginated, denom := k.ERC20ToDenomLookup(ctx, evmChainPrefix, tx.Erc20Token.Contract)
 	totalToRefund := sdk.NewCoin(denom, tx.Erc20Token.Amount)
 	totalToRefund.Amount = totalToRefund.Amount.Add(tx.Erc20Fee.Amount)
 	totalToRefundCoins := sdk.NewCoins(totalToRefund)
 
 	// Perform refund
+	if isCosmosOriginated {
+		if err = k.decreaseBridgedSupply(ctx, evmChainPrefix, tx.Erc20Token.Contract, totalToRefund.Amount); err != nil {
+			return err
+		}
+	}
 	if err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, totalToRefundCoins); err != nil {
 		return sdkerrors.Wrap(err, "transfer vouchers")
 	}
+	// the refund never left for the evm chain, so it no longer counts against the outbound rate limit
+	k.returnOutboundRateLimit(ctx, evmChainPrefix, totalToRefund, tx.CosmosBlockCreated)
+	// the extra fee was paid by the sender on cosmos, so it always stays with the sender
+	if tx.ExtraFee != nil {
+		if err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, sdk.Coins{*tx.ExtraFee}); err != nil {
+			return sdkerrors.Wrap(err, "transfer extra fee")
+		}
+	}
+	// txs created by the ibc middleware continue on to their counterparty sender
+	k.refundToIbcOrigin(ctx, evmChainPrefix, txId, sender, totalToRefund)
 
 	return ctx.EventManager().EmitTypedEvent(
 		&types.EventWithdrawCanceled{
@@ -150,6 +208,63 @@ func (k Keeper) RemoveFromOutgoingPoolAndRefund(ctx sdk.Context, evmChainPrefix
 	)
 }
 
+// IncreaseBridgeFee adds addedFee to the bridge fee of a pending Send To Ethereum
+// - checks that the provided tx is still in the pool and was sent by sender
+// - locks or burns the added fee like AddToOutgoingPool does for the original fee
+// - re-indexes the tx under its new fee, keeping its id and creation height
+func (k Keeper) IncreaseBridgeFee(ctx sdk.Context, evmChainPrefix string, txId uint64, sender sdk.AccAddress, addedFee sdk.Coin) error {
+	if ctx.IsZero() || txId < 1 || sdk.VerifyAddressFormat(sender) != nil || !addedFee.IsValid() || !addedFee.IsPositive() {
+		return sdkerrors.Wrap(types.ErrInvalid, "arguments")
+	}
+	// txs which are already in a batch are no longer in the unbatched index and can not be found here
+	tx, err := k.GetUnbatchedTxById(ctx, evmChainPrefix, txId)
+	if err != nil {
+		return sdkerrors.Wrapf(err, "unknown unbatched transaction with id %d, it may already be in a batch", txId)
+	}
+	if !tx.Sender.Equals(sender) {
+		return sdkerrors.Wrapf(types.ErrInvalid, "Sender %s did not send Id %d", sender, txId)
+	}
+	isCosmosOriginated, denom := k.ERC20ToDenomLookup(ctx, evmChainPrefix, tx.Erc20Fee.Contract)
+	if addedFee.Denom != denom {
+		return sdkerrors.Wrapf(types.ErrInvalid, "added fee denom %s does not match tx denom %s", addedFee.Denom, denom)
+	}
+
+	// the added fee leaves for the evm chain as well
+	if err := k.consumeOutboundRateLimit(ctx, evmChainPrefix, addedFee); err != nil {
+		return err
+	}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, sdk.Coins{addedFee}); err != nil {
+		return err
+	}
+	if isCosmosOriginated {
+		k.increaseBridgedSupply(ctx, evmChainPrefix, tx.Erc20Fee.Contract, addedFee.Amount)
+	}
+
+	// the fee is part of the pool index key, so the tx must be removed and added again
+	if err := k.removeUnbatchedTX(ctx, evmChainPrefix, *tx.Erc20Fee, txId); err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalid, "txId %d not in unbatched index! Must be in a batch!", txId)
+	}
+	newFee, err := types.NewInternalERC20Token(tx.Erc20Fee.Amount.Add(addedFee.Amount), tx.Erc20Fee.Contract.GetAddress().Hex())
+	if err != nil {
+		return sdkerrors.Wrapf(err, "invalid Erc20Fee from amount %d and contract %v", addedFee.Amount, tx.Erc20Fee.Contract)
+	}
+	tx.Erc20Fee = newFee
+	if err := k.addUnbatchedTX(ctx, evmChainPrefix, tx); err != nil {
+		panic(err)
+	}
+
+	return ctx.EventManager().EmitTypedEvent(
+		&types.EventBridgeFeeIncreased{
+			Sender:         sender.String(),
+			TxId:           fmt.Sprint(txId),
+			BridgeContract: k.GetBridgeContractAddress(ctx, evmChainPrefix).GetAddress().Hex(),
+			BridgeChainId:  strconv.Itoa(int(k.GetBridgeChainID(ctx, evmChainPrefix))),
+			AddedFee:       addedFee.String(),
+			NewFee:         sdk.NewCoin(denom, newFee.Amount).String(),
+		},
+	)
+}
+
 // addUnbatchedTx creates a new transaction in the pool
 // WARNING: Do not make this function public
 func (k Keeper) addUnbatchedTX(ctx sdk.Context, evmChainPrefix string, val *types.InternalOutgoingTransferTx) error {
@@ -167,6 +282,7 @@ func (k Keeper) addUnbatchedTX(ctx sdk.Context, evmChainPrefix string, val *type
 	}
 
 	store.Set(idxKey, bz)
+	store.Set(types.GetOutgoingTxPoolAgeKey(evmChainPrefix, val.Erc20Fee.Contract, val.Id), idxKey)
 	return err
 }
 
@@ -179,6 +295,7 @@ func (k Keeper) removeUnbatchedTX(ctx sdk.Context, evmChainPrefix string, fee ty
 		return sdkerrors.Wrap(types.ErrUnknown, "pool transaction")
 	}
 	store.Delete(idxKey)
+	store.Delete(types.GetOutgoingTxPoolAgeKey(evmChainPrefix, fee.Contract, txID))
 	return nil
 }
 
@@ -251,6 +368,31 @@ func (k Keeper) IterateUnbatchedTransactionsByContract(ctx sdk.Context, evmChain
 	k.filterAndIterateUnbatchedTransactions(ctx, types.GetOutgoingTxPoolContractPrefix(evmChainPrefix, contractAddress), cb)
 }
 
+// IterateUnbatchedTransactionsByAge iterates through unbatched transactions from the tx pool for the given contract in
+// the order they entered the pool, lowest tx id first, executing the given callback on each discovered Tx.
+// Return true in cb to stop iteration, false to continue.
+func (k Keeper) IterateUnbatchedTransactionsByAge(ctx sdk.Context, evmChainPrefix string, contractAddress types.EthAddress, cb func(key []byte, tx *types.InternalOutgoingTransferTx) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetOutgoingTxPoolAgeContractPrefix(evmChainPrefix, contractAddress))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		bz := store.Get(iter.Value())
+		if bz == nil {
+			panic(fmt.Sprintf("unbatched transaction index %x points to a missing pool transaction", iter.Key()))
+		}
+		var transact types.OutgoingTransferTx
+		k.cdc.MustUnmarshal(bz, &transact)
+		intTx, err := transact.ToInternal()
+		if err != nil {
+			panic(sdkerrors.Wrapf(err, "invalid unbatched transaction in store: %v", transact))
+		}
+		// cb returns true to stop early
+		if cb(iter.Value(), intTx) {
+			break
+		}
+	}
+}
+
 // IterateUnbatchedTransactions iterates through all unbatched transactions in DESC order, executing the given callback
 // on each discovered Tx. Return true in cb to stop iteration, false to continue.
 // For finer grained control, use filterAndIterateUnbatchedTransactions or one of the above methods
@@ -288,27 +430,37 @@ func (k Keeper) filterAndIterateUnbatchedTransactions(ctx sdk.Context, prefixKey
 func (k Keeper) GetBatchFeeByTokenType(ctx sdk.Context, evmChainPrefix string, tokenContractAddr types.EthAddress, maxElements uint) *types.BatchFees {
 	batchFee := types.BatchFees{Token: tokenContractAddr.GetAddress().Hex(), TotalFees: sdk.NewInt(0), TxCount: 0}
 
-	// Since transactions are stored with keys [ prefix | contract | fee_amount] and since this iterator returns results
-	// in DESC order, we can safely pick the first N and have a batch with maximal fees for relaying
-	k.filterAndIterateUnbatchedTransactions(ctx, types.GetOutgoingTxPoolContractPrefix(evmChainPrefix, tokenContractAddr), func(key []byte, tx *types.InternalOutgoingTransferTx) bool {
-
-		if !k.IsOnBlacklist(ctx, evmChainPrefix, *tx.DestAddress) {
-			fee := tx.Erc20Fee
-			if fee.Contract.GetAddress() != tokenContractAddr.GetAddress() {
-				panic(fmt.Errorf("unexpected fee contract %s under key %v when getting batch fees for contract %s", fee.Contract.GetAddress().Hex(), key, tokenContractAddr.GetAddress().Hex()))
-			}
-			batchFee.TotalFees = batchFee.TotalFees.Add(fee.Amount)
-			batchFee.TxCount += 1
-			return batchFee.TxCount == uint64(maxElements)
-		} else {
-			// if the tx was on the blacklist we return false
-			// to continue to the next loop iteration
-			return false
+	// the token's BatchStrategy picks the same txs BuildOutgoingTxBatch would
+	selectedTxs, _ := k.selectBatchTxs(ctx, evmChainPrefix, tokenContractAddr, maxElements)
+	for _, tx := range selectedTxs {
+		fee := tx.Erc20Fee
+		if fee.Contract.GetAddress() != tokenContractAddr.GetAddress() {
+			panic(fmt.Errorf("unexpected fee contract %s for tx %d when getting batch fees for contract %s", fee.Contract.GetAddress().Hex(), tx.Id, tokenContractAddr.GetAddress().Hex()))
 		}
-	})
+		batchFee.TotalFees = batchFee.TotalFees.Add(fee.Amount)
+		if tx.ExtraFee != nil {
+			batchFee.ExtraFees = batchFee.ExtraFees.Add(*tx.ExtraFee)
+		}
+		batchFee.TxCount += 1
+	}
 	return &batchFee
 }
 
+// IsExtraFeeDenom returns true if txs to the evm chain may pay an extra fee in denom
+func (k Keeper) IsExtraFeeDenom(ctx sdk.Context, evmChainPrefix string, denom string) bool {
+	params := k.GetParams(ctx)
+	evmChainParam := params.GetEvmChain(evmChainPrefix)
+	if evmChainParam == nil {
+		return false
+	}
+	for _, extraFeeDenom := range evmChainParam.ExtraFeeDenoms {
+		if extraFeeDenom == denom {
+			return true
+		}
+	}
+	return false
+}
+
 // GetAllBatchFees creates a fee entry for every batch type currently in the store
 // this can be used by relayers to determine what batch types are desireable to request
 func (k Keeper) GetAllBatchFees(ctx sdk.Context, evmChainPrefix string, maxElements uint) (batchFees []types.BatchFees) {
@@ -327,32 +479,24 @@ func (k Keeper) GetAllBatchFees(ctx sdk.Context, evmChainPrefix string, maxEleme
 	return batchFees
 }
 
-// createBatchFees iterates over the unbatched transaction pool and creates batch token fee map
-// Implicitly creates batches with the highest potential fee because the transaction keys enforce an order which goes
-// fee contract address -> fee amount -> transaction nonce
+// createBatchFees iterates over the unbatched transaction pool to find every token with unbatched txs and creates
+// the batch token fee map, the fees of each token are those of the batch its BatchStrategy would create
 func (k Keeper) createBatchFees(ctx sdk.Context, evmChainPrefix string, maxElements uint) map[string]types.BatchFees {
-	batchFeesMap := make(map[string]types.BatchFees)
-
+	tokens := make(map[string]types.EthAddress)
 	k.filterAndIterateUnbatchedTransactions(ctx, types.AppendChainPrefix(types.OutgoingTXPoolKey, evmChainPrefix), func(_ []byte, tx *types.InternalOutgoingTransferTx) bool {
-		feeAddrStr := tx.Erc20Fee.Contract.GetAddress()
-
-		if fees, ok := batchFeesMap[feeAddrStr.Hex()]; ok {
-			if fees.TxCount < uint64(maxElements) {
-				fees.TotalFees = batchFeesMap[feeAddrStr.Hex()].TotalFees.Add(tx.Erc20Fee.Amount)
-				fees.TxCount++
-				batchFeesMap[feeAddrStr.Hex()] = fees
-			}
-		} else {
-			batchFeesMap[feeAddrStr.Hex()] = types.BatchFees{
-				Token:     feeAddrStr.Hex(),
-				TotalFees: tx.Erc20Fee.Amount,
-				TxCount:   1,
-			}
-		}
-
+		tokens[tx.Erc20Fee.Contract.GetAddress().Hex()] = tx.Erc20Fee.Contract
 		return false
 	})
 
+	batchFeesMap := make(map[string]types.BatchFees)
+	for token, contract := range tokens {
+		batchFee := k.GetBatchFeeByTokenType(ctx, evmChainPrefix, contract, maxElements)
+		// tokens whose every tx is blacklisted or below the strategy's floor can not be batched
+		if batchFee.TxCount > 0 {
+			batchFeesMap[token] = *batchFee
+		}
+	}
+
 	return batchFeesMap
 }
 
@@ -81,32 +81,36 @@ func TestAddToOutgoingPool(t *testing.T) {
 	require.NoError(t, err)
 	exp := []*types.InternalOutgoingTransferTx{
 		{
-			Id:          2,
-			Erc20Fee:    threeTok,
-			Sender:      mySender,
-			DestAddress: receiverAddr,
-			Erc20Token:  oneHundredOneTok,
+			Id:                 2,
+			Erc20Fee:           threeTok,
+			Sender:             mySender,
+			DestAddress:        receiverAddr,
+			Erc20Token:         oneHundredOneTok,
+			CosmosBlockCreated: 1234567,
 		},
 		{
-			Id:          3,
-			Erc20Fee:    twoTok,
-			Sender:      mySender,
-			DestAddress: receiverAddr,
-			Erc20Token:  oneHundredTwoTok,
+			Id:                 3,
+			Erc20Fee:           twoTok,
+			Sender:             mySender,
+			DestAddress:        receiverAddr,
+			Erc20Token:         oneHundredTwoTok,
+			CosmosBlockCreated: 1234567,
 		},
 		{
-			Id:          1,
-			Erc20Fee:    twoTok,
-			Sender:      mySender,
-			DestAddress: receiverAddr,
-			Erc20Token:  oneHundredTok,
+			Id:                 1,
+			Erc20Fee:           twoTok,
+			Sender:             mySender,
+			DestAddress:        receiverAddr,
+			Erc20Token:         oneHundredTok,
+			CosmosBlockCreated: 1234567,
 		},
 		{
-			Id:          4,
-			Erc20Fee:    oneTok,
-			Sender:      mySender,
-			DestAddress: receiverAddr,
-			Erc20Token:  oneHundredThreeTok,
+			Id:                 4,
+			Erc20Fee:           oneTok,
+			Sender:             mySender,
+			DestAddress:        receiverAddr,
+			Erc20Token:         oneHundredThreeTok,
+			CosmosBlockCreated: 1234567,
 		},
 	}
 	assert.Equal(t, exp, got)
@@ -744,11 +748,12 @@ func TestGetUnbatchedTransactions(t *testing.T) {
 		require.NoError(t, err)
 		ids1[i] = r
 		idToTxMap[r] = &types.OutgoingTransferTx{
-			Id:          r,
-			Sender:      mySender1.String(),
-			DestAddress: myReceiver,
-			Erc20Token:  amountToken1.ToExternal(),
-			Erc20Fee:    feeToken1.ToExternal(),
+			Id:                 r,
+			Sender:             mySender1.String(),
+			DestAddress:        myReceiver,
+			Erc20Token:         amountToken1.ToExternal(),
+			Erc20Fee:           feeToken1.ToExternal(),
+			CosmosBlockCreated: 1234567,
 		}
 		amountToken2, err := types.NewInternalERC20Token(sdk.NewIntFromUint64(amounts[i]), myTokenContractAddr2)
 		require.NoError(t, err)
@@ -761,11 +766,12 @@ func TestGetUnbatchedTransactions(t *testing.T) {
 		require.NoError(t, err)
 		ids2[i] = r
 		idToTxMap[r] = &types.OutgoingTransferTx{
-			Id:          r,
-			Sender:      mySender2.String(),
-			DestAddress: myReceiver,
-			Erc20Token:  amountToken2.ToExternal(),
-			Erc20Fee:    feeToken2.ToExternal(),
+			Id:                 r,
+			Sender:             mySender2.String(),
+			DestAddress:        myReceiver,
+			Erc20Token:         amountToken2.ToExternal(),
+			Erc20Fee:           feeToken2.ToExternal(),
+			CosmosBlockCreated: 1234567,
 		}
 	}
 
@@ -779,6 +785,7 @@ func TestGetUnbatchedTransactions(t *testing.T) {
 	require.NoError(t, err1)
 	expTx1, err1 := types.NewInternalOutgoingTransferTx(token1Id, mySender1.String(), myReceiver, token1Amount.ToExternal(), token1Fee.ToExternal())
 	require.NoError(t, err1)
+	expTx1.CosmosBlockCreated = uint64(ctx.BlockHeight())
 	require.Equal(t, *expTx1, *tx1)
 
 	token2Fee, err := types.NewInternalERC20Token(sdk.NewIntFromUint64(fees[3]), myTokenContractAddr2)
@@ -791,6 +798,7 @@ func TestGetUnbatchedTransactions(t *testing.T) {
 	require.NoError(t, err2)
 	expTx2, err2 := types.NewInternalOutgoingTransferTx(token2Id, mySender2.String(), myReceiver, token2Amount.ToExternal(), token2Fee.ToExternal())
 	require.NoError(t, err2)
+	expTx2.CosmosBlockCreated = uint64(ctx.BlockHeight())
 	require.Equal(t, *expTx2, *tx2)
 
 	// GetUnbatchedTxById
@@ -1002,11 +1010,12 @@ func TestAddToOutgoingPoolExportGenesis(t *testing.T) {
 		require.NoError(t, err)
 
 		unbatchedTxMap[r] = types.OutgoingTransferTx{
-			Id:          r,
-			Sender:      mySender.String(),
-			DestAddress: myReceiver,
-			Erc20Token:  amountToken.ToExternal(),
-			Erc20Fee:    feeToken.ToExternal(),
+			Id:                 r,
+			Sender:             mySender.String(),
+			DestAddress:        myReceiver,
+			Erc20Token:         amountToken.ToExternal(),
+			Erc20Fee:           feeToken.ToExternal(),
+			CosmosBlockCreated: 1234567,
 		}
 		foundTxsMap[r] = false
 
@@ -1030,3 +1039,147 @@ func TestAddToOutgoingPoolExportGenesis(t *testing.T) {
 		require.True(t, v)
 	}
 }
+
+// Checks that sends beyond the outbound rate limit of a denom are rejected until the window resets
+func TestAddToOutgoingPoolRateLimit(t *testing.T) {
+	input := CreateTestEnv(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+
+	ctx := input.Context
+	var (
+		mySender, e1        = sdk.AccAddressFromBech32("gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm")
+		myReceiver          = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+	)
+	require.NoError(t, e1)
+	receiver, err := types.NewEthAddress(myReceiver)
+	require.NoError(t, err)
+	tokenContract, err := types.NewEthAddress(myTokenContractAddr)
+	require.NoError(t, err)
+	denom := types.GravityDenom(EthChainPrefix, *tokenContract)
+	allVouchers := sdk.Coins{sdk.NewInt64Coin(denom, 99999)}
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, allVouchers))
+
+	input.GravityKeeper.SetRateLimit(ctx, types.RateLimit{
+		EvmChainPrefix: EthChainPrefix,
+		Denom:          denom,
+		WindowBlocks:   10,
+		MaxOutbound:    sdk.NewInt(250),
+		MaxInbound:     sdk.ZeroInt(),
+	})
+
+	amount := sdk.NewInt64Coin(denom, 100)
+	fee := sdk.NewInt64Coin(denom, 2)
+	var txIds []uint64
+	for i := 0; i < 2; i++ {
+		txId, err := input.GravityKeeper.AddToOutgoingPool(ctx, EthChainPrefix, mySender, *receiver, amount, fee)
+		require.NoError(t, err)
+		txIds = append(txIds, txId)
+	}
+
+	// the amount and fee of a third send exceed the cap, nothing is taken from the sender
+	_, err = input.GravityKeeper.AddToOutgoingPool(ctx, EthChainPrefix, mySender, *receiver, amount, fee)
+	require.ErrorIs(t, err, types.ErrRateLimitExceeded)
+	assert.Equal(t, sdk.NewInt(99999-204), input.BankKeeper.GetBalance(ctx, mySender, denom).Amount)
+
+	// the limit is per evm chain, so it must not leak into another chain
+	rateLimit := input.GravityKeeper.GetRateLimit(ctx, EthChainPrefix, denom)
+	require.NotNil(t, rateLimit)
+	require.Nil(t, input.GravityKeeper.GetRateLimit(ctx, BscChainPrefix, denom))
+	status := input.GravityKeeper.GetRateLimitStatus(ctx, *rateLimit)
+	assert.Equal(t, sdk.NewInt(204), status.OutboundUsed)
+	assert.Equal(t, sdk.NewInt(46), status.RemainingOutbound)
+	assert.Equal(t, uint64(ctx.BlockHeight())+10, status.WindowEnd)
+
+	// the usage survives an export and import of the chain
+	flows := input.GravityKeeper.RateLimitFlows(ctx, EthChainPrefix)
+	require.Len(t, flows, 1)
+	assert.Equal(t, denom, flows[0].Denom)
+	assert.Equal(t, sdk.NewInt(204), flows[0].Outbound)
+	genesis := ExportGenesis(ctx, input.GravityKeeper)
+	for _, chain := range genesis.EvmChains {
+		if chain.EvmChain.EvmChainPrefix == EthChainPrefix {
+			assert.Equal(t, flows, chain.RateLimitFlows)
+		}
+	}
+
+	// a cancelled send gives its quota back
+	require.NoError(t, input.GravityKeeper.RemoveFromOutgoingPoolAndRefund(ctx, EthChainPrefix, txIds[0], mySender))
+	status = input.GravityKeeper.GetRateLimitStatus(ctx, *rateLimit)
+	assert.Equal(t, sdk.NewInt(102), status.OutboundUsed)
+
+	// once the window has passed the send goes through
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 10)
+	_, err = input.GravityKeeper.AddToOutgoingPool(ctx, EthChainPrefix, mySender, *receiver, amount, fee)
+	require.NoError(t, err)
+	status = input.GravityKeeper.GetRateLimitStatus(ctx, *rateLimit)
+	assert.Equal(t, sdk.NewInt(102), status.OutboundUsed)
+
+	// a send of an earlier window gives nothing back to the current one
+	require.NoError(t, input.GravityKeeper.RemoveFromOutgoingPoolAndRefund(ctx, EthChainPrefix, txIds[1], mySender))
+	status = input.GravityKeeper.GetRateLimitStatus(ctx, *rateLimit)
+	assert.Equal(t, sdk.NewInt(102), status.OutboundUsed)
+}
+
+// Tests that the bridge fee of a pooled tx can be increased by its sender until it is batched
+func TestIncreaseBridgeFee(t *testing.T) {
+	input := CreateTestEnv(t)
+	defer func() { input.Context.Logger().Info("Asserting invariants at test end"); input.AssertInvariants() }()
+
+	ctx := input.Context
+	var (
+		mySender, e1        = sdk.AccAddressFromBech32("gravity1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm")
+		notSender, e2       = sdk.AccAddressFromBech32("gravity1add7f8wyertuus9r20284ej0asrs085c8ajr0y")
+		myReceiver          = "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+		myTokenContractAddr = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+	)
+	require.NoError(t, e1)
+	require.NoError(t, e2)
+	receiver, err := types.NewEthAddress(myReceiver)
+	require.NoError(t, err)
+	tokenContract, err := types.NewEthAddress(myTokenContractAddr)
+	require.NoError(t, err)
+	denom := types.GravityDenom(EthChainPrefix, *tokenContract)
+	allVouchers := sdk.Coins{sdk.NewInt64Coin(denom, 99999)}
+	require.NoError(t, input.BankKeeper.MintCoins(ctx, types.ModuleName, allVouchers))
+	input.AccountKeeper.NewAccountWithAddress(ctx, mySender)
+	require.NoError(t, input.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, mySender, allVouchers))
+
+	amount := sdk.NewInt64Coin(denom, 100)
+	lowId, err := input.GravityKeeper.AddToOutgoingPool(ctx, EthChainPrefix, mySender, *receiver, amount, sdk.NewInt64Coin(denom, 2))
+	require.NoError(t, err)
+	_, err = input.GravityKeeper.AddToOutgoingPool(ctx, EthChainPrefix, mySender, *receiver, amount, sdk.NewInt64Coin(denom, 3))
+	require.NoError(t, err)
+
+	// only the sender may increase the fee, and only in the denom of the tx
+	err = input.GravityKeeper.IncreaseBridgeFee(ctx, EthChainPrefix, lowId, notSender, sdk.NewInt64Coin(denom, 5))
+	require.Error(t, err)
+	err = input.GravityKeeper.IncreaseBridgeFee(ctx, EthChainPrefix, lowId, mySender, sdk.NewInt64Coin("stake", 5))
+	require.Error(t, err)
+	assert.Equal(t, sdk.NewInt(99999-205), input.BankKeeper.GetBalance(ctx, mySender, denom).Amount)
+
+	require.NoError(t, input.GravityKeeper.IncreaseBridgeFee(ctx, EthChainPrefix, lowId, mySender, sdk.NewInt64Coin(denom, 5)))
+	assert.Equal(t, sdk.NewInt(99999-210), input.BankKeeper.GetBalance(ctx, mySender, denom).Amount)
+
+	// the tx is re-indexed under its new fee and keeps its creation height
+	oldFee := types.InternalERC20Token{Amount: sdk.NewInt(2), Contract: *tokenContract}
+	_, err = input.GravityKeeper.GetUnbatchedTxByFeeAndId(ctx, EthChainPrefix, oldFee, lowId)
+	require.Error(t, err)
+	newFee := types.InternalERC20Token{Amount: sdk.NewInt(7), Contract: *tokenContract}
+	tx, err := input.GravityKeeper.GetUnbatchedTxByFeeAndId(ctx, EthChainPrefix, newFee, lowId)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(ctx.BlockHeight()), tx.CosmosBlockCreated)
+	assert.Equal(t, sdk.NewInt(100), tx.Erc20Token.Amount)
+	assert.Len(t, input.GravityKeeper.GetUnbatchedTransactions(ctx, EthChainPrefix), 2)
+
+	// the bumped tx is now the most profitable one and is batched first, after which its fee is fixed
+	batch, err := input.GravityKeeper.BuildOutgoingTxBatch(ctx, EthChainPrefix, *tokenContract, 1)
+	require.NoError(t, err)
+	require.Len(t, batch.Transactions, 1)
+	assert.Equal(t, lowId, batch.Transactions[0].Id)
+	err = input.GravityKeeper.IncreaseBridgeFee(ctx, EthChainPrefix, lowId, mySender, sdk.NewInt64Coin(denom, 1))
+	require.Error(t, err)
+	assert.Equal(t, sdk.NewInt(99999-210), input.BankKeeper.GetBalance(ctx, mySender, denom).Amount)
+}
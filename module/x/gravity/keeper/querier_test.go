@@ -311,7 +311,7 @@ func TestLastValsetRequests(t *testing.T) {
 	// Run the staking endblocker to ensure valset is correct in state
 	staking.EndBlocker(input.Context, input.StakingKeeper)
 
-	input.GravityKeeper.SetValsetRequest(input.Context, evmChain.EvmChainPrefix)
+	input.GravityKeeper.SetValsetRequest(input.Context, evmChain.EvmChainPrefix, types.VALSET_REASON_UNSPECIFIED)
 
 	k := input.GravityKeeper
 	for msg, spec := range specs {
@@ -481,7 +481,7 @@ func TestPendingValsetRequests(t *testing.T) {
 	// Run the staking endblocker to ensure valset is correct in state
 	staking.EndBlocker(input.Context, input.StakingKeeper)
 
-	input.GravityKeeper.SetValsetRequest(input.Context, evmChain.EvmChainPrefix)
+	input.GravityKeeper.SetValsetRequest(input.Context, evmChain.EvmChainPrefix, types.VALSET_REASON_UNSPECIFIED)
 
 	var valAddr sdk.AccAddress = bytes.Repeat([]byte{byte(1)}, 20)
 	for msg, spec := range specs {
@@ -520,6 +520,7 @@ func TestLastPendingBatchRequest(t *testing.T) {
 								Amount:   sdk.NewInt(3),
 								Contract: "0xAb5801a7D398351b8bE11C439e05C5B3259aeC9B",
 							},
+							CosmosBlockCreated: 1235067,
 						},
 						{
 							Id:          3,
@@ -533,6 +534,7 @@ func TestLastPendingBatchRequest(t *testing.T) {
 								Amount:   sdk.NewInt(2),
 								Contract: "0xAb5801a7D398351b8bE11C439e05C5B3259aeC9B",
 							},
+							CosmosBlockCreated: 1235067,
 						},
 					},
 					TokenContract:      "0xAb5801a7D398351b8bE11C439e05C5B3259aeC9B",
@@ -819,8 +821,9 @@ func TestQueryBatch(t *testing.T) {
 						Amount:   sdk.NewInt(101),
 						Contract: "0xAb5801a7D398351b8bE11C439e05C5B3259aeC9B",
 					},
-					Sender: "gravity1qyqszqgpqyqszqgpqyqszqgpqyqszqgpkrnxg5",
-					Id:     2,
+					Sender:             "gravity1qyqszqgpqyqszqgpqyqszqgpqyqszqgpkrnxg5",
+					Id:                 2,
+					CosmosBlockCreated: 1234567,
 				},
 				{
 					Erc20Fee: types.ERC20Token{
@@ -832,8 +835,9 @@ func TestQueryBatch(t *testing.T) {
 						Amount:   sdk.NewInt(102),
 						Contract: "0xAb5801a7D398351b8bE11C439e05C5B3259aeC9B",
 					},
-					Sender: "gravity1qyqszqgpqyqszqgpqyqszqgpqyqszqgpkrnxg5",
-					Id:     3,
+					Sender:             "gravity1qyqszqgpqyqszqgpqyqszqgpqyqszqgpkrnxg5",
+					Id:                 3,
+					CosmosBlockCreated: 1234567,
 				},
 			},
 			BatchNonce:         1,
@@ -876,8 +880,9 @@ func TestLastBatchesRequest(t *testing.T) {
 							Amount:   sdk.NewInt(101),
 							Contract: "0xAb5801a7D398351b8bE11C439e05C5B3259aeC9B",
 						},
-						Sender: "gravity1qyqszqgpqyqszqgpqyqszqgpqyqszqgpkrnxg5",
-						Id:     6,
+						Sender:             "gravity1qyqszqgpqyqszqgpqyqszqgpqyqszqgpkrnxg5",
+						Id:                 6,
+						CosmosBlockCreated: 1234567,
 					},
 					{
 						Erc20Fee: types.ERC20Token{
@@ -889,8 +894,9 @@ func TestLastBatchesRequest(t *testing.T) {
 							Amount:   sdk.NewInt(102),
 							Contract: "0xAb5801a7D398351b8bE11C439e05C5B3259aeC9B",
 						},
-						Sender: "gravity1qyqszqgpqyqszqgpqyqszqgpqyqszqgpkrnxg5",
-						Id:     7,
+						Sender:             "gravity1qyqszqgpqyqszqgpqyqszqgpqyqszqgpkrnxg5",
+						Id:                 7,
+						CosmosBlockCreated: 1234567,
 					},
 					{
 						Erc20Fee: types.ERC20Token{
@@ -902,8 +908,9 @@ func TestLastBatchesRequest(t *testing.T) {
 							Amount:   sdk.NewInt(100),
 							Contract: "0xAb5801a7D398351b8bE11C439e05C5B3259aeC9B",
 						},
-						Sender: "gravity1qyqszqgpqyqszqgpqyqszqgpqyqszqgpkrnxg5",
-						Id:     5,
+						Sender:             "gravity1qyqszqgpqyqszqgpqyqszqgpqyqszqgpkrnxg5",
+						Id:                 5,
+						CosmosBlockCreated: 1234567,
 					},
 				},
 				BatchNonce:         2,
@@ -923,8 +930,9 @@ func TestLastBatchesRequest(t *testing.T) {
 							Amount:   sdk.NewInt(101),
 							Contract: "0xAb5801a7D398351b8bE11C439e05C5B3259aeC9B",
 						},
-						Sender: "gravity1qyqszqgpqyqszqgpqyqszqgpqyqszqgpkrnxg5",
-						Id:     2,
+						Sender:             "gravity1qyqszqgpqyqszqgpqyqszqgpqyqszqgpkrnxg5",
+						Id:                 2,
+						CosmosBlockCreated: 1234567,
 					},
 					{
 						Erc20Fee: types.ERC20Token{
@@ -936,8 +944,9 @@ func TestLastBatchesRequest(t *testing.T) {
 							Amount:   sdk.NewInt(102),
 							Contract: "0xAb5801a7D398351b8bE11C439e05C5B3259aeC9B",
 						},
-						Sender: "gravity1qyqszqgpqyqszqgpqyqszqgpqyqszqgpkrnxg5",
-						Id:     3,
+						Sender:             "gravity1qyqszqgpqyqszqgpqyqszqgpqyqszqgpkrnxg5",
+						Id:                 3,
+						CosmosBlockCreated: 1234567,
 					},
 				},
 				BatchNonce:         1,
@@ -1122,6 +1131,7 @@ func TestQueryPendingSendToEth(t *testing.T) {
 				Contract: "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5",
 				Amount:   sdk.NewInt(3),
 			},
+			CosmosBlockCreated: 1234567,
 		},
 		{
 			Id:          3,
@@ -1135,6 +1145,7 @@ func TestQueryPendingSendToEth(t *testing.T) {
 				Contract: "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5",
 				Amount:   sdk.NewInt(2),
 			},
+			CosmosBlockCreated: 1234567,
 		},
 	},
 
@@ -1151,6 +1162,7 @@ func TestQueryPendingSendToEth(t *testing.T) {
 					Contract: "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5",
 					Amount:   sdk.NewInt(2),
 				},
+				CosmosBlockCreated: 1234567,
 			},
 			{
 				Id:          4,
@@ -1164,6 +1176,7 @@ func TestQueryPendingSendToEth(t *testing.T) {
 					Contract: "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5",
 					Amount:   sdk.NewInt(1),
 				},
+				CosmosBlockCreated: 1234567,
 			},
 		},
 	}
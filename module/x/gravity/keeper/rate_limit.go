@@ -0,0 +1,363 @@
+// Contains the logic for governance configured rate limits on the amount of each denom crossing the bridge
+/*
+Flow: Governance sets a RateLimit per (evm chain, denom) through a SetRateLimitProposal. Every MsgSendToEth adds to
+  the outbound flow of the current window in AddToOutgoingPool and is rejected once the outbound cap would be exceeded.
+  Every SendToCosmos attestation adds to the inbound flow of the current window in the attestation handler, deposits
+  which would exceed the inbound cap are minted or unlocked into the gravity module and wait in the HeldSendToCosmos
+  queue of their denom. The queues are drained from EndBlocker as windows reset, a bounded number of deposits per
+  block, or by a ReleaseHeldSendToCosmosProposal.
+*/
+
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// GetRateLimit returns the rate limit of `denom` on the given evm chain, or nil if the denom is not limited
+func (k Keeper) GetRateLimit(ctx sdk.Context, evmChainPrefix string, denom string) *types.RateLimit {
+	return getChainRecord[types.RateLimit](ctx, k, types.GetRateLimitKey(evmChainPrefix, denom))
+}
+
+// SetRateLimit stores the given rate limit, the usage of the current window is kept
+func (k Keeper) SetRateLimit(ctx sdk.Context, rateLimit types.RateLimit) {
+	k.setChainRecord(ctx, types.GetRateLimitKey(rateLimit.EvmChainPrefix, rateLimit.Denom), &rateLimit)
+}
+
+// DeleteRateLimit removes the rate limit of `denom` on the given evm chain along with its usage
+func (k Keeper) DeleteRateLimit(ctx sdk.Context, evmChainPrefix string, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetRateLimitKey(evmChainPrefix, denom))
+	store.Delete(types.GetRateLimitFlowKey(evmChainPrefix, denom))
+}
+
+// IterateRateLimits executes the given callback on each RateLimit of the evm chain, ordered by denom
+// cb should return true to stop iteration, false to continue
+func (k Keeper) IterateRateLimits(ctx sdk.Context, evmChainPrefix string, cb func(rateLimit types.RateLimit) (stop bool)) {
+	iterateChainRecords(ctx, k, types.RateLimitKey, evmChainPrefix, false, cb)
+}
+
+// RateLimits returns every RateLimit of the evm chain, ordered by denom
+func (k Keeper) RateLimits(ctx sdk.Context, evmChainPrefix string) []types.RateLimit {
+	return chainRecords[types.RateLimit](ctx, k, types.RateLimitKey, evmChainPrefix, false, 0)
+}
+
+// GetRateLimitFlow returns the amounts bridged within the current window of `rateLimit`, a fresh window is
+// returned if the stored one has ended
+func (k Keeper) GetRateLimitFlow(ctx sdk.Context, rateLimit types.RateLimit) types.RateLimitFlow {
+	height := uint64(ctx.BlockHeight())
+	flow := k.getStoredRateLimitFlow(ctx, rateLimit.EvmChainPrefix, rateLimit.Denom)
+	if flow == nil || height >= flow.WindowStart+rateLimit.WindowBlocks {
+		return types.NewRateLimitFlow(rateLimit.Denom, height)
+	}
+	return *flow
+}
+
+// getStoredRateLimitFlow returns the stored flow of `denom` on the evm chain whether its window has ended or not, nil
+// if nothing has crossed the bridge since the rate limit was set
+func (k Keeper) getStoredRateLimitFlow(ctx sdk.Context, evmChainPrefix string, denom string) *types.RateLimitFlow {
+	flow := getChainRecord[types.RateLimitFlow](ctx, k, types.GetRateLimitFlowKey(evmChainPrefix, denom))
+	if flow != nil {
+		// flows stored before the denom was recorded in them
+		flow.Denom = denom
+	}
+	return flow
+}
+
+// setRateLimitFlow stores the usage of the current window of a rate limit
+func (k Keeper) setRateLimitFlow(ctx sdk.Context, evmChainPrefix string, denom string, flow types.RateLimitFlow) {
+	flow.Denom = denom
+	k.setChainRecord(ctx, types.GetRateLimitFlowKey(evmChainPrefix, denom), &flow)
+}
+
+// RateLimitFlows returns the stored flow of every RateLimit of the evm chain, ordered by denom
+func (k Keeper) RateLimitFlows(ctx sdk.Context, evmChainPrefix string) []types.RateLimitFlow {
+	flows := []types.RateLimitFlow{}
+	k.IterateRateLimits(ctx, evmChainPrefix, func(rateLimit types.RateLimit) (stop bool) {
+		if flow := k.getStoredRateLimitFlow(ctx, evmChainPrefix, rateLimit.Denom); flow != nil {
+			flows = append(flows, *flow)
+		}
+		return false
+	})
+	return flows
+}
+
+// GetRateLimitStatus reports the usage and remaining capacity of `rateLimit` in its current window
+func (k Keeper) GetRateLimitStatus(ctx sdk.Context, rateLimit types.RateLimit) types.RateLimitStatus {
+	flow := k.GetRateLimitFlow(ctx, rateLimit)
+	status := types.RateLimitStatus{
+		RateLimit:         rateLimit,
+		WindowStart:       flow.WindowStart,
+		WindowEnd:         flow.WindowStart + rateLimit.WindowBlocks,
+		OutboundUsed:      flow.Outbound,
+		InboundUsed:       flow.Inbound,
+		RemainingOutbound: sdk.ZeroInt(),
+		RemainingInbound:  sdk.ZeroInt(),
+	}
+	if rateLimit.IsOutboundLimited() && rateLimit.MaxOutbound.GT(flow.Outbound) {
+		status.RemainingOutbound = rateLimit.MaxOutbound.Sub(flow.Outbound)
+	}
+	if rateLimit.IsInboundLimited() && rateLimit.MaxInbound.GT(flow.Inbound) {
+		status.RemainingInbound = rateLimit.MaxInbound.Sub(flow.Inbound)
+	}
+	return status
+}
+
+// consumeOutboundRateLimit records `coin` leaving for the evm chain, returning ErrRateLimitExceeded without
+// recording anything if the outbound cap of the current window would be exceeded
+func (k Keeper) consumeOutboundRateLimit(ctx sdk.Context, evmChainPrefix string, coin sdk.Coin) error {
+	rateLimit := k.GetRateLimit(ctx, evmChainPrefix, coin.Denom)
+	if rateLimit == nil {
+		return nil
+	}
+	flow := k.GetRateLimitFlow(ctx, *rateLimit)
+	newOutbound := flow.Outbound.Add(coin.Amount)
+	if rateLimit.IsOutboundLimited() && newOutbound.GT(rateLimit.MaxOutbound) {
+		return sdkerrors.Wrapf(
+			types.ErrRateLimitExceeded, "%s to %s: %v of %v already sent in the window ending at block %d",
+			coin, evmChainPrefix, flow.Outbound, rateLimit.MaxOutbound, flow.WindowStart+rateLimit.WindowBlocks,
+		)
+	}
+	flow.Outbound = newOutbound
+	k.setRateLimitFlow(ctx, evmChainPrefix, coin.Denom, flow)
+	return nil
+}
+
+// returnOutboundRateLimit gives `coin` back to the outbound flow of the evm chain once a send which entered the pool
+// at `sentHeight` has been cancelled and refunded. Sends counted in an earlier window are not given back since that
+// window has already been reset
+func (k Keeper) returnOutboundRateLimit(ctx sdk.Context, evmChainPrefix string, coin sdk.Coin, sentHeight uint64) {
+	rateLimit := k.GetRateLimit(ctx, evmChainPrefix, coin.Denom)
+	if rateLimit == nil {
+		return
+	}
+	flow := k.GetRateLimitFlow(ctx, *rateLimit)
+	if sentHeight < flow.WindowStart {
+		return
+	}
+	flow.Outbound = flow.Outbound.Sub(sdk.MinInt(flow.Outbound, coin.Amount))
+	k.setRateLimitFlow(ctx, evmChainPrefix, coin.Denom, flow)
+}
+
+// consumeInboundRateLimit records `coin` arriving from the evm chain, returning false without recording anything
+// if the inbound cap of the current window would be exceeded
+func (k Keeper) consumeInboundRateLimit(ctx sdk.Context, evmChainPrefix string, coin sdk.Coin) bool {
+	rateLimit := k.GetRateLimit(ctx, evmChainPrefix, coin.Denom)
+	if rateLimit == nil {
+		return true
+	}
+	flow := k.GetRateLimitFlow(ctx, *rateLimit)
+	newInbound := flow.Inbound.Add(coin.Amount)
+	if rateLimit.IsInboundLimited() && newInbound.GT(rateLimit.MaxInbound) {
+		return false
+	}
+	flow.Inbound = newInbound
+	k.setRateLimitFlow(ctx, evmChainPrefix, coin.Denom, flow)
+	return true
+}
+
+// HeldSendToCosmosByNonce returns the held deposit with the given event nonce, or nil if there is none
+func (k Keeper) HeldSendToCosmosByNonce(ctx sdk.Context, evmChainPrefix string, eventNonce uint64) *types.HeldSendToCosmos {
+	return getChainRecord[types.HeldSendToCosmos](ctx, k, types.GetHeldSendToCosmosKey(evmChainPrefix, eventNonce))
+}
+
+// setHeldSendToCosmos stores a new held deposit, queues it behind the held deposits of its denom and counts it among
+// them
+func (k Keeper) setHeldSendToCosmos(ctx sdk.Context, evmChainPrefix string, held types.HeldSendToCosmos) {
+	key := types.GetHeldSendToCosmosKey(evmChainPrefix, held.EventNonce)
+	k.setChainRecord(ctx, key, &held)
+	ctx.KVStore(k.storeKey).Set(types.GetHeldSendToCosmosByDenomKey(evmChainPrefix, held.Token.Denom, held.EventNonce), key)
+	k.setHeldSendToCosmosCount(ctx, evmChainPrefix, held.Token.Denom, k.heldSendToCosmosCount(ctx, evmChainPrefix, held.Token.Denom)+1)
+}
+
+// deleteHeldSendToCosmos removes a held deposit from the queue of its denom and its count among the held deposits of
+// its denom
+func (k Keeper) deleteHeldSendToCosmos(ctx sdk.Context, evmChainPrefix string, held types.HeldSendToCosmos) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetHeldSendToCosmosKey(evmChainPrefix, held.EventNonce))
+	store.Delete(types.GetHeldSendToCosmosByDenomKey(evmChainPrefix, held.Token.Denom, held.EventNonce))
+	count := k.heldSendToCosmosCount(ctx, evmChainPrefix, held.Token.Denom)
+	if count == 0 {
+		panic(fmt.Sprintf("held SendToCosmos %d of %s on %s was not counted", held.EventNonce, held.Token.Denom, evmChainPrefix))
+	}
+	k.setHeldSendToCosmosCount(ctx, evmChainPrefix, held.Token.Denom, count-1)
+}
+
+// heldSendToCosmosCount returns the number of deposits of `denom` waiting in the evm chain's held queue
+func (k Keeper) heldSendToCosmosCount(ctx sdk.Context, evmChainPrefix string, denom string) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetHeldSendToCosmosDenomKey(evmChainPrefix, denom))
+	if len(bz) == 0 {
+		return 0
+	}
+	return types.UInt64FromBytesUnsafe(bz)
+}
+
+func (k Keeper) setHeldSendToCosmosCount(ctx sdk.Context, evmChainPrefix string, denom string, count uint64) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetHeldSendToCosmosDenomKey(evmChainPrefix, denom)
+	if count == 0 {
+		store.Delete(key)
+		return
+	}
+	store.Set(key, types.UInt64Bytes(count))
+}
+
+// heldSendToCosmosDenoms returns the denoms with deposits waiting in the evm chain's held queue, in order
+func (k Keeper) heldSendToCosmosDenoms(ctx sdk.Context, evmChainPrefix string) []string {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.AppendDelimitedChainPrefix(types.HeldSendToCosmosDenomKey, evmChainPrefix))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+	var denoms []string
+	for ; iter.Valid(); iter.Next() {
+		denoms = append(denoms, string(iter.Key()))
+	}
+	return denoms
+}
+
+// firstHeldSendToCosmos returns the held deposit of `denom` with the lowest event nonce, or nil if there is none
+func (k Keeper) firstHeldSendToCosmos(ctx sdk.Context, evmChainPrefix string, denom string) *types.HeldSendToCosmos {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetHeldSendToCosmosByDenomPrefix(evmChainPrefix, denom))
+	defer iter.Close()
+	if !iter.Valid() {
+		return nil
+	}
+	held := getChainRecord[types.HeldSendToCosmos](ctx, k, iter.Value())
+	if held == nil {
+		panic(fmt.Sprintf("held SendToCosmos index %x of %s on %s points to nothing", iter.Key(), denom, evmChainPrefix))
+	}
+	return held
+}
+
+// IterateHeldSendToCosmos executes the given callback on each held deposit of the evm chain in order of event nonce
+// cb should return true to stop iteration, false to continue
+func (k Keeper) IterateHeldSendToCosmos(ctx sdk.Context, evmChainPrefix string, cb func(held types.HeldSendToCosmos) (stop bool)) {
+	iterateChainRecords(ctx, k, types.HeldSendToCosmosKey, evmChainPrefix, false, cb)
+}
+
+// AllHeldSendToCosmos returns every held deposit of the evm chain in order of event nonce
+func (k Keeper) AllHeldSendToCosmos(ctx sdk.Context, evmChainPrefix string) []types.HeldSendToCosmos {
+	return chainRecords[types.HeldSendToCosmos](ctx, k, types.HeldSendToCosmosKey, evmChainPrefix, false, 0)
+}
+
+// hasHeldSendToCosmos returns true if any deposit of `denom` is waiting in the evm chain's held queue
+func (k Keeper) hasHeldSendToCosmos(ctx sdk.Context, evmChainPrefix string, denom string) bool {
+	return k.heldSendToCosmosCount(ctx, evmChainPrefix, denom) > 0
+}
+
+// holdSendToCosmos queues a deposit which exceeded its inbound rate limit, `coin` must already be held by the
+// gravity module
+func (k Keeper) holdSendToCosmos(ctx sdk.Context, claim types.MsgSendToCosmosClaim, coin sdk.Coin) error {
+	held := types.HeldSendToCosmos{
+		EventNonce:     claim.EventNonce,
+		EthBlockHeight: claim.EthBlockHeight,
+		TokenContract:  claim.TokenContract,
+		EthereumSender: claim.EthereumSender,
+		CosmosReceiver: claim.CosmosReceiver,
+		Token:          coin,
+		HeldHeight:     uint64(ctx.BlockHeight()),
+	}
+	if err := held.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalid, err.Error())
+	}
+	if k.HeldSendToCosmosByNonce(ctx, claim.EvmChainPrefix, claim.EventNonce) != nil {
+		return sdkerrors.Wrapf(types.ErrDuplicate, "held SendToCosmos with nonce %d", claim.EventNonce)
+	}
+	k.setHeldSendToCosmos(ctx, claim.EvmChainPrefix, held)
+
+	k.logger(ctx).Info("SendToCosmos held by rate limit", "evmChainPrefix", claim.EvmChainPrefix,
+		"receiver", claim.CosmosReceiver, "denom", coin.Denom, "amount", coin.Amount.String(), "nonce", claim.EventNonce,
+	)
+	return ctx.EventManager().EmitTypedEvent(&types.EventSendToCosmosHeld{
+		Nonce:          fmt.Sprint(claim.EventNonce),
+		Receiver:       claim.CosmosReceiver,
+		Token:          claim.TokenContract,
+		Amount:         coin.Amount.String(),
+		EvmChainPrefix: claim.EvmChainPrefix,
+	})
+}
+
+// releaseHeldSendToCosmos removes a held deposit from the queue and delivers it as the attestation handler would have
+func (k Keeper) releaseHeldSendToCosmos(ctx sdk.Context, evmChainPrefix string, held types.HeldSendToCosmos) error {
+	tokenAddress, err := types.NewEthAddress(held.TokenContract)
+	if err != nil {
+		return sdkerrors.Wrap(err, "invalid token contract on held SendToCosmos")
+	}
+	evmChainSender, err := types.NewEthAddress(held.EthereumSender)
+	if err != nil {
+		return sdkerrors.Wrap(err, "invalid evm chain sender on held SendToCosmos")
+	}
+
+	k.deleteHeldSendToCosmos(ctx, evmChainPrefix, held)
+
+	handler := AttestationHandler{keeper: &k}
+	if err := handler.deliverSendToCosmos(ctx, held.ToClaim(evmChainPrefix), *tokenAddress, *evmChainSender, held.Token); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventSendToCosmosReleased{
+		Nonce:          fmt.Sprint(held.EventNonce),
+		Receiver:       held.CosmosReceiver,
+		Token:          held.TokenContract,
+		Amount:         held.Token.Amount.String(),
+		EvmChainPrefix: evmChainPrefix,
+	})
+}
+
+// ReleaseHeldSendToCosmosWithinLimits delivers at most `limit` held deposits of the evm chain. The deposits of each denom
+// are delivered in order of event nonce for as long as their rate limit has capacity left, a deposit which still does
+// not fit blocks later deposits of the same denom until the window resets
+func (k Keeper) ReleaseHeldSendToCosmosWithinLimits(ctx sdk.Context, evmChainPrefix string, limit uint64) {
+	released := uint64(0)
+	for _, denom := range k.heldSendToCosmosDenoms(ctx, evmChainPrefix) {
+		for ; released < limit; released++ {
+			held := k.firstHeldSendToCosmos(ctx, evmChainPrefix, denom)
+			if held == nil {
+				break
+			}
+
+			// release in a cache context so that a failed delivery leaves both the queue and the usage untouched
+			xCtx, commit := ctx.CacheContext()
+			if !k.consumeHeldInboundRateLimit(xCtx, evmChainPrefix, *held) {
+				break
+			}
+			if err := k.releaseHeldSendToCosmos(xCtx, evmChainPrefix, *held); err != nil {
+				k.logger(ctx).Error("Unable to release held SendToCosmos", "evmChainPrefix", evmChainPrefix,
+					"nonce", held.EventNonce, "cause", err.Error(),
+				)
+				break
+			}
+			commit()
+			ctx.EventManager().EmitEvents(xCtx.EventManager().Events())
+		}
+		if released >= limit {
+			return
+		}
+	}
+}
+
+// consumeHeldInboundRateLimit records a held deposit arriving from the evm chain, returning false without recording
+// anything if the inbound cap of the current window would be exceeded. A deposit larger than the whole inbound cap
+// never fits, once it has been held for a full window it is released in a window nothing else has used and fills it
+func (k Keeper) consumeHeldInboundRateLimit(ctx sdk.Context, evmChainPrefix string, held types.HeldSendToCosmos) bool {
+	if k.consumeInboundRateLimit(ctx, evmChainPrefix, held.Token) {
+		return true
+	}
+	rateLimit := k.GetRateLimit(ctx, evmChainPrefix, held.Token.Denom)
+	if rateLimit == nil || held.Token.Amount.LTE(rateLimit.MaxInbound) {
+		return false
+	}
+	flow := k.GetRateLimitFlow(ctx, *rateLimit)
+	if !flow.Inbound.IsZero() || uint64(ctx.BlockHeight()) < held.HeldHeight+rateLimit.WindowBlocks {
+		return false
+	}
+	flow.Inbound = held.Token.Amount
+	k.setRateLimitFlow(ctx, evmChainPrefix, held.Token.Denom, flow)
+	return true
+}
@@ -382,7 +382,7 @@ func SetupTestChain(t *testing.T, weights []uint64, setDelegateAddresses bool) (
 			staking.EndBlocker(input.Context, input.StakingKeeper)
 
 			// set a request every time.
-			input.GravityKeeper.SetValsetRequest(input.Context, EthChainPrefix)
+			input.GravityKeeper.SetValsetRequest(input.Context, EthChainPrefix, types.VALSET_REASON_UNSPECIFIED)
 		}
 
 	}
@@ -706,7 +706,9 @@ func CreateTestEnv(t *testing.T) TestInput {
 	k.SetParams(ctx, TestingGravityParams)
 
 	// Set the monitored token addresses for cross bridge balances checking
-	k.setMonitoredERC20Tokens(ctx, TokenContracts[0:2])
+	for _, evmChain := range EvmChains {
+		k.setMonitoredERC20Tokens(ctx, evmChain.EvmChainPrefix, TokenContracts[0:2])
+	}
 
 	testInput := TestInput{
 		GravityKeeper:     k,
@@ -809,6 +811,7 @@ func MintVouchersFromAir(t *testing.T, ctx sdk.Context, k Keeper, emvChainPrefix
 	vouchers := sdk.Coins{coin}
 	err := k.bankKeeper.MintCoins(ctx, types.ModuleName, vouchers)
 	require.NoError(t, err)
+	k.increaseBridgedSupply(ctx, emvChainPrefix, amount.Contract, amount.Amount)
 	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, dest, vouchers)
 	require.NoError(t, err)
 	return coin
@@ -93,6 +93,7 @@ func RemoveEvmChainFromStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc
 	removeKeyPrefixFromEvm(store, types.LastSlashedBatchBlock, evmChainPrefix)
 	removeKeyPrefixFromEvm(store, types.LastSlashedLogicCallBlock, evmChainPrefix)
 	removeKeyPrefixFromEvm(store, types.LastObservedValsetKey, evmChainPrefix)
+	removeKeyPrefixFromEvm(store, types.MonitoredERC20TokensKey, evmChainPrefix)
 
 	// multi key with chain
 	removeKeysPrefixFromEvm(store, types.ValsetRequestKey, evmChainPrefix)
@@ -109,6 +110,23 @@ func RemoveEvmChainFromStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc
 	removeKeysPrefixFromEvm(store, types.PastEvmSignatureCheckpointKey, evmChainPrefix)
 	// PendingIbcAutoForwards is only existed in v3
 	removeKeysPrefixFromEvm(store, types.PendingIbcAutoForwards, evmChainPrefix)
+	removeDelimitedKeysPrefixFromEvm(store, types.BridgeBalanceSnapshotsKey, evmChainPrefix)
+	removeDelimitedKeysPrefixFromEvm(store, types.RateLimitKey, evmChainPrefix)
+	removeDelimitedKeysPrefixFromEvm(store, types.RateLimitFlowKey, evmChainPrefix)
+	removeDelimitedKeysPrefixFromEvm(store, types.HeldSendToCosmosKey, evmChainPrefix)
+	removeDelimitedKeysPrefixFromEvm(store, types.HeldSendToCosmosDenomKey, evmChainPrefix)
+	removeDelimitedKeysPrefixFromEvm(store, types.HeldSendToCosmosByDenomKey, evmChainPrefix)
+	removeDelimitedKeysPrefixFromEvm(store, types.IbcBridgeFeeKey, evmChainPrefix)
+	removeDelimitedKeysPrefixFromEvm(store, types.IbcTransferOriginKey, evmChainPrefix)
+	removeDelimitedKeysPrefixFromEvm(store, types.IbcAutoForwardLogKey, evmChainPrefix)
+	// IbcAutoForwardTransferKey carries no chain, remove the transfers the chain's index points to with the index
+	removeIndexedKeysFromEvm(store, types.IbcAutoForwardTransferByNonceKey, evmChainPrefix)
+	removeDelimitedKeysPrefixFromEvm(store, types.FailedAttestationKey, evmChainPrefix)
+	removeDelimitedKeysPrefixFromEvm(store, types.BlacklistKey, evmChainPrefix)
+	removeDelimitedKeysPrefixFromEvm(store, types.BatchStrategyKey, evmChainPrefix)
+	removeDelimitedKeysPrefixFromEvm(store, types.BridgedSupplyKey, evmChainPrefix)
+	removeDelimitedKeysPrefixFromEvm(store, types.EvidenceRecordKey, evmChainPrefix)
+	removeDelimitedKeysPrefixFromEvm(store, types.OutgoingTxPoolAgeKey, evmChainPrefix)
 
 	return nil
 }
@@ -271,7 +289,16 @@ func removeKeyPrefixFromEvm(store sdk.KVStore, key []byte, evmChainPrefix string
 }
 
 func removeKeysPrefixFromEvm(store storetypes.KVStore, key []byte, evmChainPrefix string) {
-	keyPrefix := types.AppendChainPrefix(key, evmChainPrefix)
+	removeKeysWithPrefix(store, types.AppendChainPrefix(key, evmChainPrefix))
+}
+
+// removeDelimitedKeysPrefixFromEvm removes the keys of the chain from a store keyed by types.AppendDelimitedChainPrefix,
+// leaving the keys of chains whose prefix begins the same way in place
+func removeDelimitedKeysPrefixFromEvm(store storetypes.KVStore, key []byte, evmChainPrefix string) {
+	removeKeysWithPrefix(store, types.AppendDelimitedChainPrefix(key, evmChainPrefix))
+}
+
+func removeKeysWithPrefix(store storetypes.KVStore, keyPrefix []byte) {
 	prefixStore := prefix.NewStore(store, keyPrefix)
 	storeIter := prefixStore.Iterator(nil, nil)
 	defer storeIter.Close()
@@ -281,6 +308,22 @@ func removeKeysPrefixFromEvm(store storetypes.KVStore, key []byte, evmChainPrefi
 	}
 }
 
+// removeIndexedKeysFromEvm removes every key stored as a value under the chain's index, then the index itself
+func removeIndexedKeysFromEvm(store storetypes.KVStore, indexKey []byte, evmChainPrefix string) {
+	prefixStore := prefix.NewStore(store, types.AppendDelimitedChainPrefix(indexKey, evmChainPrefix))
+	storeIter := prefixStore.Iterator(nil, nil)
+	var indexed [][]byte
+	for ; storeIter.Valid(); storeIter.Next() {
+		indexed = append(indexed, storeIter.Value())
+	}
+	storeIter.Close()
+
+	for _, key := range indexed {
+		store.Delete(key)
+	}
+	removeDelimitedKeysPrefixFromEvm(store, indexKey, evmChainPrefix)
+}
+
 func migrateLastObservedEvmBlockHeight(ctx sdk.Context, store sdk.KVStore, cdc codec.BinaryCodec, observed bool, claimHeight uint64) {
 	key := types.AppendChainPrefix(types.LastObservedEvmBlockHeightKey, EthereumChainPrefix)
 	bytes := store.Get(key)
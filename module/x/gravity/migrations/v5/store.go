@@ -0,0 +1,185 @@
+package v5
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+// MigrateStore performs in-place store migrations from v4 to v5. The migration
+// includes:
+//
+//   - Moving the global MonitoredERC20Tokens list to every registered evm chain, since the global list used to be
+//     applied to the balance checks of every evm chain
+//   - Re-keying the BridgeBalanceSnapshots by delimited evm chain prefix first and event nonce second, snapshots which
+//     cannot be attributed to a registered evm chain are deleted
+//   - Indexing the unbatched txs of every evm chain by token contract and tx id under OutgoingTxPoolAgeKey
+//   - Indexing the orchestrator of every validator under OrchestratorByValidatorKey
+//
+// The bridged supply ledger is seeded from the bank by the keeper's Migrate4to5 once the store has been migrated
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey, cdc codec.BinaryCodec) error {
+	ctx.Logger().Info("v5 Upgrade: Beginning the migrations for the gravity module")
+	store := ctx.KVStore(storeKey)
+
+	evmChainPrefixes := getEvmChainPrefixes(store, cdc)
+
+	migrateMonitoredERC20Tokens(ctx, store, evmChainPrefixes)
+	if err := migrateBridgeBalanceSnapshots(ctx, store, cdc, evmChainPrefixes); err != nil {
+		return err
+	}
+	if err := indexOutgoingTxPoolByAge(ctx, store, cdc, evmChainPrefixes); err != nil {
+		return err
+	}
+	indexOrchestratorsByValidator(ctx, store)
+
+	ctx.Logger().Info("v5 Upgrade: Finished the migrations for the gravity module successfully!")
+	return nil
+}
+
+// getEvmChainPrefixes collects the prefixes of every evm chain registered in the store
+func getEvmChainPrefixes(store sdk.KVStore, cdc codec.BinaryCodec) []string {
+	prefixStore := prefix.NewStore(store, types.EvmChainKey)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	var evmChainPrefixes []string
+	for ; iter.Valid(); iter.Next() {
+		var evmChain types.EvmChain
+		cdc.MustUnmarshal(iter.Value(), &evmChain)
+		evmChainPrefixes = append(evmChainPrefixes, evmChain.EvmChainPrefix)
+	}
+	return evmChainPrefixes
+}
+
+// migrateMonitoredERC20Tokens copies the global list stored under MonitoredERC20TokensKey to each evm chain,
+// unless governance has already set a list for that chain, and then removes the global list
+func migrateMonitoredERC20Tokens(ctx sdk.Context, store sdk.KVStore, evmChainPrefixes []string) {
+	legacyKey := types.MonitoredERC20TokensKey
+	if !store.Has(legacyKey) {
+		return
+	}
+	tokens := store.Get(legacyKey)
+	for _, evmChainPrefix := range evmChainPrefixes {
+		key := types.GetMonitoredERC20TokensKey(evmChainPrefix)
+		if store.Has(key) {
+			continue
+		}
+		ctx.Logger().Info("v5 Upgrade: Moving the monitored ERC20 tokens", "evm_chain_prefix", evmChainPrefix)
+		store.Set(key, tokens)
+	}
+	store.Delete(legacyKey)
+}
+
+// migrateBridgeBalanceSnapshots moves every snapshot stored under the legacy
+// BridgeBalanceSnapshotsKey + EventNonce + evmChainPrefix key to
+// BridgeBalanceSnapshotsKey + len(evmChainPrefix) + evmChainPrefix + EventNonce
+func migrateBridgeBalanceSnapshots(ctx sdk.Context, store sdk.KVStore, cdc codec.BinaryCodec, evmChainPrefixes []string) error {
+	registered := make(map[string]bool, len(evmChainPrefixes))
+	for _, evmChainPrefix := range evmChainPrefixes {
+		registered[evmChainPrefix] = true
+	}
+
+	prefixStore := prefix.NewStore(store, types.BridgeBalanceSnapshotsKey)
+	iter := prefixStore.Iterator(nil, nil)
+	var oldKeys [][]byte
+	var snapshots []types.BridgeBalanceSnapshot
+	for ; iter.Valid(); iter.Next() {
+		oldKey := iter.Key()
+		if len(oldKey) < 8 {
+			iter.Close()
+			return fmt.Errorf("invalid legacy bridge balance snapshot key %x", oldKey)
+		}
+		var snapshot types.BridgeBalanceSnapshot
+		cdc.MustUnmarshal(iter.Value(), &snapshot)
+
+		// Snapshots were previously stored without their evm chain prefix, fall back to the key suffix
+		// and then to the only registered chain when there is no ambiguity
+		if snapshot.EvmChainPrefix == "" {
+			snapshot.EvmChainPrefix = string(oldKey[8:])
+		}
+		if snapshot.EvmChainPrefix == "" && len(evmChainPrefixes) == 1 {
+			snapshot.EvmChainPrefix = evmChainPrefixes[0]
+		}
+
+		oldKeys = append(oldKeys, oldKey)
+		snapshots = append(snapshots, snapshot)
+	}
+	iter.Close()
+
+	// The old and new keys share a prefix, so every legacy entry is removed before any new entry is written
+	for _, oldKey := range oldKeys {
+		prefixStore.Delete(oldKey)
+	}
+	for _, snapshot := range snapshots {
+		if !registered[snapshot.EvmChainPrefix] {
+			ctx.Logger().Info("v5 Upgrade: Dropping unattributable bridge balance snapshot", "event_nonce", snapshot.EventNonce)
+			continue
+		}
+		store.Set(types.GetBridgeBalanceSnapshotKey(snapshot.EventNonce, snapshot.EvmChainPrefix), cdc.MustMarshal(&snapshot))
+	}
+	return nil
+}
+
+// indexOutgoingTxPoolByAge stores the pool key of every unbatched tx under its OutgoingTxPoolAgeKey. Pool keys are
+// OutgoingTXPoolKey + evmChainPrefix + token contract + fee amount + tx id, keys of the same length under the prefix
+// of another chain are skipped
+func indexOutgoingTxPoolByAge(ctx sdk.Context, store sdk.KVStore, cdc codec.BinaryCodec, evmChainPrefixes []string) error {
+	const poolKeySuffixLen = 20 + 32 + 8
+	for _, evmChainPrefix := range evmChainPrefixes {
+		chainPrefix := types.AppendChainPrefix(types.OutgoingTXPoolKey, evmChainPrefix)
+		iter := sdk.KVStorePrefixIterator(store, chainPrefix)
+		var poolKeys, ageKeys [][]byte
+		for ; iter.Valid(); iter.Next() {
+			if len(iter.Key()) != len(chainPrefix)+poolKeySuffixLen {
+				continue
+			}
+			var tx types.OutgoingTransferTx
+			cdc.MustUnmarshal(iter.Value(), &tx)
+			intTx, err := tx.ToInternal()
+			if err != nil {
+				iter.Close()
+				return fmt.Errorf("invalid unbatched transaction under key %x: %v", iter.Key(), err)
+			}
+			poolKey := types.GetOutgoingTxPoolKey(evmChainPrefix, *intTx.Erc20Fee, intTx.Id)
+			if !bytes.Equal(poolKey, iter.Key()) {
+				continue
+			}
+			poolKeys = append(poolKeys, poolKey)
+			ageKeys = append(ageKeys, types.GetOutgoingTxPoolAgeKey(evmChainPrefix, intTx.Erc20Fee.Contract, intTx.Id))
+		}
+		iter.Close()
+
+		ctx.Logger().Info("v5 Upgrade: Indexing the unbatched txs by age", "evm_chain_prefix", evmChainPrefix, "txs", len(poolKeys))
+		for i, poolKey := range poolKeys {
+			store.Set(ageKeys[i], poolKey)
+		}
+	}
+	return nil
+}
+
+// indexOrchestratorsByValidator writes the reverse of every orchestrator to validator entry stored under
+// KeyOrchestratorAddress, delegate keys can not be reset so every validator has at most one orchestrator
+func indexOrchestratorsByValidator(ctx sdk.Context, store sdk.KVStore) {
+	prefixStore := prefix.NewStore(store, types.KeyOrchestratorAddress)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	indexed := 0
+	for ; iter.Valid(); iter.Next() {
+		orch := sdk.AccAddress(iter.Key())
+		val := sdk.ValAddress(iter.Value())
+		if sdk.VerifyAddressFormat(orch) != nil || sdk.VerifyAddressFormat(val) != nil {
+			ctx.Logger().Error("v5 Upgrade: Skipping invalid orchestrator entry", "key", iter.Key())
+			continue
+		}
+		store.Set(types.GetOrchestratorByValidatorKey(val), orch.Bytes())
+		indexed++
+	}
+	ctx.Logger().Info("v5 Upgrade: Indexed the orchestrators by validator", "count", indexed)
+}
@@ -0,0 +1,103 @@
+package v5_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	_ "github.com/Gravity-Bridge/Gravity-Bridge/module/config"
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/keeper"
+	v5 "github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/migrations/v5"
+	"github.com/Gravity-Bridge/Gravity-Bridge/module/x/gravity/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	keeper.SetupTestConfig()
+
+	gravityKey := sdk.NewKVStoreKey("gravity")
+	ctx := testutil.DefaultContext(gravityKey, sdk.NewTransientStoreKey("transient-test"))
+	store := ctx.KVStore(gravityKey)
+	marshaler := keeper.MakeTestMarshaler()
+
+	for _, evmChain := range keeper.EvmChains {
+		store.Set(types.GetEvmChainKey(evmChain.EvmChainPrefix), marshaler.MustMarshal(&evmChain))
+	}
+
+	// the v4 store holds a single global list of monitored tokens
+	monitored := types.EthAddresses(keeper.TokenContracts[0:2]).ToMonitoredERC20Addresses()
+	store.Set(types.MonitoredERC20TokensKey, marshaler.MustMarshal(&monitored))
+
+	// and snapshots keyed by event nonce first, some of them without any evm chain prefix
+	balances := []*types.ERC20Token{{Contract: keeper.TokenContractAddrs[0], Amount: sdk.OneInt()}}
+	legacySnapshots := []types.BridgeBalanceSnapshot{
+		{CosmosBlockHeight: 10, EthereumBlockHeight: 100, EvmChainPrefix: keeper.EthChainPrefix, Balances: balances, EventNonce: 1},
+		{CosmosBlockHeight: 11, EthereumBlockHeight: 200, EvmChainPrefix: keeper.BscChainPrefix, Balances: balances, EventNonce: 1},
+		{CosmosBlockHeight: 12, EthereumBlockHeight: 101, EvmChainPrefix: "", Balances: balances, EventNonce: 2},
+	}
+	for _, snapshot := range legacySnapshots {
+		legacyKey := types.AppendBytes(types.BridgeBalanceSnapshotsKey, types.UInt64Bytes(snapshot.EventNonce), []byte(snapshot.EvmChainPrefix))
+		store.Set(legacyKey, marshaler.MustMarshal(&snapshot))
+	}
+
+	// and unbatched txs which are only keyed by fee
+	poolTxs := []*types.InternalOutgoingTransferTx{}
+	for i, fee := range []int64{5, 1} {
+		tokenContract := keeper.TokenContracts[0]
+		token, err := types.NewInternalERC20Token(sdk.NewInt(100), tokenContract.GetAddress().Hex())
+		require.NoError(t, err)
+		feeToken, err := types.NewInternalERC20Token(sdk.NewInt(fee), tokenContract.GetAddress().Hex())
+		require.NoError(t, err)
+		tx, err := types.NewInternalOutgoingTransferTx(uint64(i+1), keeper.AccAddrs[0].String(), keeper.EthAddrs[0].Hex(), token.ToExternal(), feeToken.ToExternal())
+		require.NoError(t, err)
+		external := tx.ToExternal()
+		store.Set(types.GetOutgoingTxPoolKey(keeper.EthChainPrefix, *tx.Erc20Fee, tx.Id), marshaler.MustMarshal(&external))
+		poolTxs = append(poolTxs, tx)
+	}
+
+	// and orchestrators indexed by orchestrator only
+	for i, orch := range keeper.OrchAddrs[0:2] {
+		store.Set(types.GetOrchestratorAddressKey(orch), keeper.ValAddrs[i].Bytes())
+	}
+
+	require.NoError(t, v5.MigrateStore(ctx, gravityKey, marshaler))
+
+	// every validator's orchestrator is indexed by validator
+	for i, orch := range keeper.OrchAddrs[0:2] {
+		require.Equal(t, orch.Bytes(), store.Get(types.GetOrchestratorByValidatorKey(keeper.ValAddrs[i])))
+	}
+	require.Nil(t, store.Get(types.GetOrchestratorByValidatorKey(keeper.ValAddrs[2])))
+
+	// the unbatched txs are indexed by age
+	for _, tx := range poolTxs {
+		require.Equal(t, types.GetOutgoingTxPoolKey(keeper.EthChainPrefix, *tx.Erc20Fee, tx.Id),
+			store.Get(types.GetOutgoingTxPoolAgeKey(keeper.EthChainPrefix, tx.Erc20Fee.Contract, tx.Id)))
+	}
+
+	// every chain inherits the global list, which is then removed
+	require.False(t, store.Has(types.MonitoredERC20TokensKey))
+	for _, evmChain := range keeper.EvmChains {
+		bz := store.Get(types.GetMonitoredERC20TokensKey(evmChain.EvmChainPrefix))
+		require.NotNil(t, bz)
+		var addresses types.MonitoredERC20Addresses
+		marshaler.MustUnmarshal(bz, &addresses)
+		require.Equal(t, types.EthAddresses(keeper.TokenContracts[0:2]), types.FromMonitoredERC20Addresses(addresses))
+	}
+
+	// attributable snapshots are re-keyed by evm chain, the ambiguous one is dropped
+	for _, snapshot := range legacySnapshots[0:2] {
+		bz := store.Get(types.GetBridgeBalanceSnapshotKey(snapshot.EventNonce, snapshot.EvmChainPrefix))
+		require.NotNil(t, bz)
+		var migrated types.BridgeBalanceSnapshot
+		marshaler.MustUnmarshal(bz, &migrated)
+		require.Equal(t, snapshot, migrated)
+	}
+	iter := sdk.KVStorePrefixIterator(store, types.BridgeBalanceSnapshotsKey)
+	defer iter.Close()
+	count := 0
+	for ; iter.Valid(); iter.Next() {
+		count++
+	}
+	require.Equal(t, 2, count)
+}
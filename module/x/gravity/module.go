@@ -101,7 +101,7 @@ type AppModule struct {
 }
 
 func (am AppModule) ConsensusVersion() uint64 {
-	return 4
+	return 5
 }
 
 // NewAppModule creates a new AppModule Object
@@ -123,6 +123,7 @@ func (AppModule) Name() string {
 func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
 	ir.RegisterRoute(types.ModuleName, "module-balance", keeper.ModuleBalanceInvariant(am.keeper))
 	ir.RegisterRoute(types.ModuleName, "store-validity", keeper.StoreValidityInvariant(am.keeper))
+	ir.RegisterRoute(types.ModuleName, "bridged-supply", keeper.BridgedSupplyInvariant(am.keeper))
 }
 
 // Route implements app module
@@ -145,7 +146,7 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
 	types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
 
-	// m := keeper.NewMigrator(am.keeper, am.legacySubspace)
+	m := keeper.NewMigrator(am.keeper, am.legacySubspace)
 
 	// if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
 	// 	panic(fmt.Sprintf("failed to migrate x/gravity from version 1 to 2: %v", err))
@@ -159,6 +160,11 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	// if err := cfg.RegisterMigration(types.ModuleName, 3, m.Migrate3to4); err != nil {
 	// 	panic(fmt.Sprintf("failed to migrate x/gravity from version 3 to 4: %v", err))
 	// }
+
+	// per evm chain monitored erc20 tokens and bridge balance snapshots
+	if err := cfg.RegisterMigration(types.ModuleName, 4, m.Migrate4to5); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/gravity from version 4 to 5: %v", err))
+	}
 }
 
 // InitGenesis initializes the genesis state for this module and implements app module.
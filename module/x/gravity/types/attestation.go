@@ -27,6 +27,29 @@ func (m Attestation) ValidateBasic(cdc codec.BinaryCodec) error {
 	return nil
 }
 
+// ValidateBasic checks that a FailedAttestation quarantines a valid, observed attestation whose claim matches the
+// record's evm chain and event nonce
+func (m FailedAttestation) ValidateBasic(cdc codec.BinaryCodec) error {
+	if m.EvmChainPrefix == "" {
+		return sdkerrors.Wrap(ErrInvalidAttestation, "failed attestation has an empty evm chain prefix")
+	}
+	if !m.Attestation.Observed {
+		return sdkerrors.Wrap(ErrInvalidAttestation, "failed attestation was never observed")
+	}
+	if err := m.Attestation.ValidateBasic(cdc); err != nil {
+		return err
+	}
+	var claim EthereumClaim
+	if err := cdc.UnpackAny(m.Attestation.Claim, &claim); err != nil {
+		return sdkerrors.Wrap(ErrInvalidClaim, "unable to unmarshal claim")
+	}
+	if claim.GetEvmChainPrefix() != m.EvmChainPrefix || claim.GetEventNonce() != m.EventNonce {
+		return sdkerrors.Wrapf(ErrInvalidAttestation, "failed attestation %v on %v holds the claim %v on %v",
+			m.EventNonce, m.EvmChainPrefix, claim.GetEventNonce(), claim.GetEvmChainPrefix())
+	}
+	return nil
+}
+
 func ClaimValidateBasic(cdc codec.BinaryCodec, claim *codectypes.Any) error {
 	var ethClaim EthereumClaim
 	err := cdc.UnpackAny(claim, &ethClaim)
@@ -347,6 +347,100 @@ func (m *EventInvalidSendToCosmosReceiver) GetSender() string {
 	return ""
 }
 
+// EventBlacklistedSendToCosmos is emitted when a deposit is sent to the
+// community pool because its sender or receiver is blacklisted
+type EventBlacklistedSendToCosmos struct {
+	Amount             string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Nonce              string `protobuf:"bytes,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Token              string `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	Sender             string `protobuf:"bytes,4,opt,name=sender,proto3" json:"sender,omitempty"`
+	Receiver           string `protobuf:"bytes,5,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	EvmChainPrefix     string `protobuf:"bytes,6,opt,name=evm_chain_prefix,json=evmChainPrefix,proto3" json:"evm_chain_prefix,omitempty"`
+	BlacklistedAddress string `protobuf:"bytes,7,opt,name=blacklisted_address,json=blacklistedAddress,proto3" json:"blacklisted_address,omitempty"`
+}
+
+func (m *EventBlacklistedSendToCosmos) Reset()         { *m = EventBlacklistedSendToCosmos{} }
+func (m *EventBlacklistedSendToCosmos) String() string { return proto.CompactTextString(m) }
+func (*EventBlacklistedSendToCosmos) ProtoMessage()    {}
+func (*EventBlacklistedSendToCosmos) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3205613bbab7525, []int{4}
+}
+func (m *EventBlacklistedSendToCosmos) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBlacklistedSendToCosmos) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventBlacklistedSendToCosmos.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventBlacklistedSendToCosmos) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBlacklistedSendToCosmos.Merge(m, src)
+}
+func (m *EventBlacklistedSendToCosmos) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBlacklistedSendToCosmos) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBlacklistedSendToCosmos.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventBlacklistedSendToCosmos proto.InternalMessageInfo
+
+func (m *EventBlacklistedSendToCosmos) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *EventBlacklistedSendToCosmos) GetNonce() string {
+	if m != nil {
+		return m.Nonce
+	}
+	return ""
+}
+
+func (m *EventBlacklistedSendToCosmos) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *EventBlacklistedSendToCosmos) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *EventBlacklistedSendToCosmos) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *EventBlacklistedSendToCosmos) GetEvmChainPrefix() string {
+	if m != nil {
+		return m.EvmChainPrefix
+	}
+	return ""
+}
+
+func (m *EventBlacklistedSendToCosmos) GetBlacklistedAddress() string {
+	if m != nil {
+		return m.BlacklistedAddress
+	}
+	return ""
+}
+
 type EventSendToCosmos struct {
 	Amount string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
 	Nonce  string `protobuf:"bytes,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
@@ -357,7 +451,7 @@ func (m *EventSendToCosmos) Reset()         { *m = EventSendToCosmos{} }
 func (m *EventSendToCosmos) String() string { return proto.CompactTextString(m) }
 func (*EventSendToCosmos) ProtoMessage()    {}
 func (*EventSendToCosmos) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3205613bbab7525, []int{4}
+	return fileDescriptor_e3205613bbab7525, []int{5}
 }
 func (m *EventSendToCosmos) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -418,7 +512,7 @@ func (m *EventSendToCosmosLocal) Reset()         { *m = EventSendToCosmosLocal{}
 func (m *EventSendToCosmosLocal) String() string { return proto.CompactTextString(m) }
 func (*EventSendToCosmosLocal) ProtoMessage()    {}
 func (*EventSendToCosmosLocal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3205613bbab7525, []int{5}
+	return fileDescriptor_e3205613bbab7525, []int{6}
 }
 func (m *EventSendToCosmosLocal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -475,6 +569,98 @@ func (m *EventSendToCosmosLocal) GetAmount() string {
 	return ""
 }
 
+type EventSendToCosmosForwardedToEvm struct {
+	Nonce          string `protobuf:"bytes,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Token          string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	Amount         string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	EvmChainPrefix string `protobuf:"bytes,4,opt,name=evm_chain_prefix,json=evmChainPrefix,proto3" json:"evm_chain_prefix,omitempty"`
+	Destination    string `protobuf:"bytes,5,opt,name=destination,proto3" json:"destination,omitempty"`
+	TxId           string `protobuf:"bytes,6,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	Owner          string `protobuf:"bytes,7,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (m *EventSendToCosmosForwardedToEvm) Reset()         { *m = EventSendToCosmosForwardedToEvm{} }
+func (m *EventSendToCosmosForwardedToEvm) String() string { return proto.CompactTextString(m) }
+func (*EventSendToCosmosForwardedToEvm) ProtoMessage()    {}
+func (*EventSendToCosmosForwardedToEvm) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3205613bbab7525, []int{7}
+}
+func (m *EventSendToCosmosForwardedToEvm) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSendToCosmosForwardedToEvm) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSendToCosmosForwardedToEvm.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventSendToCosmosForwardedToEvm) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSendToCosmosForwardedToEvm.Merge(m, src)
+}
+func (m *EventSendToCosmosForwardedToEvm) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSendToCosmosForwardedToEvm) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSendToCosmosForwardedToEvm.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSendToCosmosForwardedToEvm proto.InternalMessageInfo
+
+func (m *EventSendToCosmosForwardedToEvm) GetNonce() string {
+	if m != nil {
+		return m.Nonce
+	}
+	return ""
+}
+
+func (m *EventSendToCosmosForwardedToEvm) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *EventSendToCosmosForwardedToEvm) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *EventSendToCosmosForwardedToEvm) GetEvmChainPrefix() string {
+	if m != nil {
+		return m.EvmChainPrefix
+	}
+	return ""
+}
+
+func (m *EventSendToCosmosForwardedToEvm) GetDestination() string {
+	if m != nil {
+		return m.Destination
+	}
+	return ""
+}
+
+func (m *EventSendToCosmosForwardedToEvm) GetTxId() string {
+	if m != nil {
+		return m.TxId
+	}
+	return ""
+}
+
+func (m *EventSendToCosmosForwardedToEvm) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
 type EventSendToCosmosPendingIbcAutoForward struct {
 	Nonce    string `protobuf:"bytes,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
 	Receiver string `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
@@ -489,7 +675,7 @@ func (m *EventSendToCosmosPendingIbcAutoForward) Reset() {
 func (m *EventSendToCosmosPendingIbcAutoForward) String() string { return proto.CompactTextString(m) }
 func (*EventSendToCosmosPendingIbcAutoForward) ProtoMessage()    {}
 func (*EventSendToCosmosPendingIbcAutoForward) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3205613bbab7525, []int{6}
+	return fileDescriptor_e3205613bbab7525, []int{8}
 }
 func (m *EventSendToCosmosPendingIbcAutoForward) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -569,7 +755,7 @@ func (m *EventSendToCosmosExecutedIbcAutoForward) Reset() {
 func (m *EventSendToCosmosExecutedIbcAutoForward) String() string { return proto.CompactTextString(m) }
 func (*EventSendToCosmosExecutedIbcAutoForward) ProtoMessage()    {}
 func (*EventSendToCosmosExecutedIbcAutoForward) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e3205613bbab7525, []int{7}
+	return fileDescriptor_e3205613bbab7525, []int{9}
 }
 func (m *EventSendToCosmosExecutedIbcAutoForward) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -647,375 +833,554 @@ func (m *EventSendToCosmosExecutedIbcAutoForward) GetTimeoutHeight() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterEnum("gravity.v1.ClaimType", ClaimType_name, ClaimType_value)
-	proto.RegisterType((*Attestation)(nil), "gravity.v1.Attestation")
-	proto.RegisterType((*ERC20Token)(nil), "gravity.v1.ERC20Token")
-	proto.RegisterType((*EventObservation)(nil), "gravity.v1.EventObservation")
-	proto.RegisterType((*EventInvalidSendToCosmosReceiver)(nil), "gravity.v1.EventInvalidSendToCosmosReceiver")
-	proto.RegisterType((*EventSendToCosmos)(nil), "gravity.v1.EventSendToCosmos")
-	proto.RegisterType((*EventSendToCosmosLocal)(nil), "gravity.v1.EventSendToCosmosLocal")
-	proto.RegisterType((*EventSendToCosmosPendingIbcAutoForward)(nil), "gravity.v1.EventSendToCosmosPendingIbcAutoForward")
-	proto.RegisterType((*EventSendToCosmosExecutedIbcAutoForward)(nil), "gravity.v1.EventSendToCosmosExecutedIbcAutoForward")
+type EventSendToCosmosHeld struct {
+	Nonce          string `protobuf:"bytes,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Receiver       string `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	Token          string `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	Amount         string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	EvmChainPrefix string `protobuf:"bytes,5,opt,name=evm_chain_prefix,json=evmChainPrefix,proto3" json:"evm_chain_prefix,omitempty"`
 }
 
-func init() { proto.RegisterFile("gravity/v1/attestation.proto", fileDescriptor_e3205613bbab7525) }
-
-var fileDescriptor_e3205613bbab7525 = []byte{
-	// 752 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0xc1, 0x4e, 0xdb, 0x4a,
-	0x14, 0x8d, 0x43, 0x12, 0xc8, 0xf0, 0x80, 0x3c, 0x0b, 0x21, 0x13, 0xf1, 0x4c, 0x9e, 0xa5, 0x07,
-	0x79, 0x48, 0xd8, 0x85, 0x7e, 0x40, 0xe5, 0x38, 0x06, 0x2c, 0x05, 0x12, 0x39, 0xa6, 0x2d, 0xdd,
-	0x58, 0x8e, 0x3d, 0x75, 0x2c, 0x92, 0x99, 0xc8, 0x9e, 0xb8, 0x64, 0xd3, 0x4d, 0x37, 0x5d, 0xf6,
-	0x1b, 0xda, 0x9f, 0x41, 0xea, 0x86, 0x65, 0xd5, 0x05, 0xaa, 0x60, 0xdd, 0x7f, 0xa8, 0x3c, 0x9e,
-	0x24, 0x16, 0xa8, 0xbb, 0x56, 0xea, 0xca, 0x9c, 0x73, 0xae, 0xcf, 0x3d, 0x77, 0xc6, 0xdc, 0x80,
-	0x2d, 0x3f, 0x74, 0xe2, 0x80, 0x4c, 0x94, 0xf8, 0x40, 0x71, 0x08, 0x81, 0x11, 0x71, 0x48, 0x80,
-	0x91, 0x3c, 0x0a, 0x31, 0xc1, 0x3c, 0x60, 0xaa, 0x1c, 0x1f, 0x54, 0xd7, 0x7d, 0xec, 0x63, 0x4a,
-	0x2b, 0xc9, 0x5f, 0x69, 0x45, 0x75, 0xd3, 0xc7, 0xd8, 0x1f, 0x40, 0x85, 0xa2, 0xde, 0xf8, 0xb5,
-	0xe2, 0xa0, 0x49, 0x2a, 0x49, 0xef, 0x38, 0xb0, 0xac, 0xce, 0x2d, 0xf9, 0x2a, 0x58, 0xc2, 0xbd,
-	0x08, 0x86, 0x31, 0xf4, 0x04, 0xae, 0xc6, 0xd5, 0x97, 0xcc, 0x19, 0xe6, 0xd7, 0x41, 0x31, 0xc6,
-	0x04, 0x46, 0x42, 0xbe, 0xb6, 0x50, 0x2f, 0x9b, 0x29, 0xe0, 0x37, 0x40, 0xa9, 0x0f, 0x03, 0xbf,
-	0x4f, 0x84, 0x85, 0x1a, 0x57, 0x2f, 0x98, 0x0c, 0xf1, 0x7b, 0xa0, 0xe8, 0x0e, 0x9c, 0x60, 0x28,
-	0x14, 0x6a, 0x5c, 0x7d, 0xf9, 0x70, 0x5d, 0x4e, 0x43, 0xc8, 0xd3, 0x10, 0xb2, 0x8a, 0x26, 0x66,
-	0x5a, 0x22, 0x8d, 0x00, 0xd0, 0x4d, 0xed, 0xf0, 0x89, 0x85, 0x2f, 0x21, 0xcd, 0xe0, 0x62, 0x44,
-	0x42, 0xc7, 0x25, 0x34, 0x43, 0xd9, 0x9c, 0x61, 0xfe, 0x08, 0x94, 0x9c, 0x21, 0x1e, 0x23, 0x22,
-	0xe4, 0x13, 0xa5, 0x21, 0x5f, 0xdf, 0x6e, 0xe7, 0xbe, 0xde, 0x6e, 0xef, 0xf8, 0x01, 0xe9, 0x8f,
-	0x7b, 0xb2, 0x8b, 0x87, 0x8a, 0x8b, 0xa3, 0x21, 0x8e, 0xd8, 0x63, 0x3f, 0xf2, 0x2e, 0x15, 0x32,
-	0x19, 0xc1, 0x48, 0x36, 0x10, 0x31, 0xd9, 0xdb, 0xd2, 0x67, 0x0e, 0x54, 0xf4, 0x18, 0x22, 0xd2,
-	0xa6, 0xd3, 0xa5, 0xc3, 0xff, 0x0f, 0x2a, 0x99, 0xe3, 0xb5, 0x93, 0xb7, 0x58, 0x80, 0xb5, 0x0c,
-	0x6f, 0x4d, 0x46, 0x90, 0xdf, 0x05, 0x6b, 0xbd, 0x30, 0xf0, 0x7c, 0x68, 0xcf, 0xa2, 0xd2, 0x40,
-	0xe6, 0x6a, 0x4a, 0x6b, 0xd3, 0xc0, 0x3b, 0xf3, 0xc2, 0xbe, 0x13, 0x20, 0x3b, 0xf0, 0xe8, 0x39,
-	0x95, 0xcd, 0x15, 0x56, 0x98, 0xb0, 0x86, 0xc7, 0xff, 0x07, 0x56, 0xb3, 0xbd, 0x03, 0x8f, 0x9e,
-	0x5b, 0xd9, 0x5c, 0xc9, 0xb0, 0x06, 0xbd, 0x03, 0x84, 0x91, 0x0b, 0x85, 0x22, 0x55, 0x53, 0x20,
-	0xbd, 0x05, 0x35, 0x3a, 0x8c, 0x81, 0x62, 0x67, 0x10, 0x78, 0x5d, 0x88, 0x3c, 0x0b, 0x6b, 0x74,
-	0x7e, 0x13, 0xba, 0x30, 0x88, 0x61, 0x98, 0xdc, 0x13, 0x3b, 0xb9, 0x74, 0x24, 0x86, 0xe6, 0x8e,
-	0xf9, 0x8c, 0x63, 0xc2, 0x92, 0xe4, 0x32, 0x58, 0xd8, 0x14, 0x24, 0x1e, 0x11, 0x44, 0x1e, 0x0c,
-	0x59, 0x38, 0x86, 0xa4, 0x17, 0xe0, 0x6f, 0xda, 0x3f, 0xdb, 0xf8, 0x57, 0x34, 0x94, 0xae, 0xc0,
-	0xc6, 0x23, 0xe3, 0x16, 0x76, 0x9d, 0xc1, 0xdc, 0x85, 0xcb, 0xba, 0x54, 0xc1, 0x52, 0xc8, 0x06,
-	0x66, 0xf6, 0x33, 0xfc, 0xf3, 0x91, 0x58, 0xca, 0x42, 0x36, 0xa5, 0xf4, 0x91, 0x03, 0x3b, 0x8f,
-	0x5a, 0x77, 0x20, 0xf2, 0x02, 0xe4, 0x1b, 0x3d, 0x57, 0x1d, 0x13, 0x7c, 0x84, 0xc3, 0x37, 0x4e,
-	0xe8, 0xfd, 0xee, 0x28, 0xbc, 0x00, 0x16, 0xdd, 0xbe, 0x83, 0x10, 0x1c, 0xb0, 0x5b, 0x9f, 0x42,
-	0xe9, 0x3b, 0x07, 0x76, 0x1f, 0x85, 0xd4, 0xaf, 0xa0, 0x3b, 0x26, 0xd0, 0xfb, 0x53, 0x52, 0xf2,
-	0xff, 0x82, 0xbf, 0x48, 0x30, 0x84, 0x78, 0x4c, 0xec, 0xe4, 0x29, 0x94, 0xa8, 0xbc, 0xcc, 0x38,
-	0x2b, 0x18, 0xc2, 0xe4, 0xeb, 0x9f, 0x96, 0xb0, 0x65, 0xb2, 0x98, 0x7e, 0xfd, 0x8c, 0x3d, 0xa1,
-	0xe4, 0xde, 0x0d, 0x07, 0xca, 0x5a, 0xb2, 0x31, 0xe8, 0xff, 0x60, 0x15, 0x6c, 0x68, 0x2d, 0xd5,
-	0x38, 0xb5, 0xad, 0x8b, 0x8e, 0x6e, 0x9f, 0x9f, 0x75, 0x3b, 0xba, 0x66, 0x1c, 0x19, 0x7a, 0xb3,
-	0x92, 0xe3, 0xff, 0x01, 0x9b, 0x19, 0xad, 0xab, 0x9f, 0x35, 0x6d, 0xab, 0x6d, 0x6b, 0xed, 0xee,
-	0x69, 0xbb, 0x5b, 0xe1, 0xf8, 0x1a, 0xd8, 0xca, 0xc8, 0x0d, 0xd5, 0xd2, 0x4e, 0x66, 0x45, 0xba,
-	0x75, 0x52, 0xc9, 0x3f, 0x30, 0xa0, 0xdb, 0xc9, 0x6e, 0xea, 0x9d, 0x56, 0xfb, 0x42, 0x6f, 0x56,
-	0x16, 0x78, 0x09, 0x88, 0x19, 0xb9, 0xd5, 0x3e, 0x36, 0x34, 0x5b, 0x53, 0x5b, 0x2d, 0x5b, 0x7f,
-	0xa9, 0x6b, 0xe7, 0x96, 0xde, 0xac, 0x14, 0x1e, 0x58, 0x3c, 0x57, 0x5b, 0x5d, 0xdd, 0xb2, 0xcf,
-	0x3b, 0x4d, 0x35, 0x91, 0x8b, 0xd5, 0xc2, 0xfb, 0x4f, 0x62, 0xae, 0x71, 0x71, 0x7d, 0x27, 0x72,
-	0x37, 0x77, 0x22, 0xf7, 0xed, 0x4e, 0xe4, 0x3e, 0xdc, 0x8b, 0xb9, 0x9b, 0x7b, 0x31, 0xf7, 0xe5,
-	0x5e, 0xcc, 0xbd, 0x7a, 0x96, 0x59, 0x69, 0xc7, 0xe9, 0x8a, 0xdf, 0x6f, 0xd0, 0x9d, 0xf1, 0x10,
-	0x0e, 0xb1, 0x37, 0x1e, 0x40, 0xe5, 0x4a, 0x99, 0xfe, 0x4e, 0xd0, 0x7d, 0xd7, 0x2b, 0xd1, 0x55,
-	0xfb, 0xf4, 0x47, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb6, 0xcc, 0xf3, 0xc0, 0x3f, 0x06, 0x00, 0x00,
+func (m *EventSendToCosmosHeld) Reset()         { *m = EventSendToCosmosHeld{} }
+func (m *EventSendToCosmosHeld) String() string { return proto.CompactTextString(m) }
+func (*EventSendToCosmosHeld) ProtoMessage()    {}
+func (*EventSendToCosmosHeld) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3205613bbab7525, []int{10}
 }
-
-func (m *Attestation) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventSendToCosmosHeld) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSendToCosmosHeld) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSendToCosmosHeld.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *Attestation) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventSendToCosmosHeld) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSendToCosmosHeld.Merge(m, src)
+}
+func (m *EventSendToCosmosHeld) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSendToCosmosHeld) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSendToCosmosHeld.DiscardUnknown(m)
 }
 
-func (m *Attestation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Claim != nil {
-		{
-			size, err := m.Claim.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintAttestation(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
+var xxx_messageInfo_EventSendToCosmosHeld proto.InternalMessageInfo
+
+func (m *EventSendToCosmosHeld) GetNonce() string {
+	if m != nil {
+		return m.Nonce
 	}
-	if m.Height != 0 {
-		i = encodeVarintAttestation(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x18
+	return ""
+}
+
+func (m *EventSendToCosmosHeld) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
 	}
-	if len(m.Votes) > 0 {
-		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Votes[iNdEx])
-			copy(dAtA[i:], m.Votes[iNdEx])
-			i = encodeVarintAttestation(dAtA, i, uint64(len(m.Votes[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+	return ""
+}
+
+func (m *EventSendToCosmosHeld) GetToken() string {
+	if m != nil {
+		return m.Token
 	}
-	if m.Observed {
-		i--
-		if m.Observed {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+	return ""
+}
+
+func (m *EventSendToCosmosHeld) GetAmount() string {
+	if m != nil {
+		return m.Amount
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *ERC20Token) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventSendToCosmosHeld) GetEvmChainPrefix() string {
+	if m != nil {
+		return m.EvmChainPrefix
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ERC20Token) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type EventSendToCosmosReleased struct {
+	Nonce          string `protobuf:"bytes,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Receiver       string `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	Token          string `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	Amount         string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	EvmChainPrefix string `protobuf:"bytes,5,opt,name=evm_chain_prefix,json=evmChainPrefix,proto3" json:"evm_chain_prefix,omitempty"`
 }
 
-func (m *ERC20Token) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *EventSendToCosmosReleased) Reset()         { *m = EventSendToCosmosReleased{} }
+func (m *EventSendToCosmosReleased) String() string { return proto.CompactTextString(m) }
+func (*EventSendToCosmosReleased) ProtoMessage()    {}
+func (*EventSendToCosmosReleased) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3205613bbab7525, []int{11}
+}
+func (m *EventSendToCosmosReleased) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSendToCosmosReleased) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSendToCosmosReleased.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintAttestation(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Contract) > 0 {
-		i -= len(m.Contract)
-		copy(dAtA[i:], m.Contract)
-		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Contract)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *EventSendToCosmosReleased) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSendToCosmosReleased.Merge(m, src)
+}
+func (m *EventSendToCosmosReleased) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSendToCosmosReleased) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSendToCosmosReleased.DiscardUnknown(m)
 }
 
-func (m *EventObservation) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventSendToCosmosReleased proto.InternalMessageInfo
+
+func (m *EventSendToCosmosReleased) GetNonce() string {
+	if m != nil {
+		return m.Nonce
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventObservation) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventSendToCosmosReleased) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
 }
 
-func (m *EventObservation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Nonce) > 0 {
-		i -= len(m.Nonce)
-		copy(dAtA[i:], m.Nonce)
-		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Nonce)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.AttestationId) > 0 {
-		i -= len(m.AttestationId)
-		copy(dAtA[i:], m.AttestationId)
-		i = encodeVarintAttestation(dAtA, i, uint64(len(m.AttestationId)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.BridgeChainId) > 0 {
-		i -= len(m.BridgeChainId)
-		copy(dAtA[i:], m.BridgeChainId)
-		i = encodeVarintAttestation(dAtA, i, uint64(len(m.BridgeChainId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.BridgeContract) > 0 {
-		i -= len(m.BridgeContract)
-		copy(dAtA[i:], m.BridgeContract)
-		i = encodeVarintAttestation(dAtA, i, uint64(len(m.BridgeContract)))
-		i--
-		dAtA[i] = 0x12
+func (m *EventSendToCosmosReleased) GetToken() string {
+	if m != nil {
+		return m.Token
 	}
-	if len(m.AttestationType) > 0 {
-		i -= len(m.AttestationType)
-		copy(dAtA[i:], m.AttestationType)
-		i = encodeVarintAttestation(dAtA, i, uint64(len(m.AttestationType)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventSendToCosmosReleased) GetAmount() string {
+	if m != nil {
+		return m.Amount
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventInvalidSendToCosmosReceiver) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventSendToCosmosReleased) GetEvmChainPrefix() string {
+	if m != nil {
+		return m.EvmChainPrefix
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventInvalidSendToCosmosReceiver) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type EventIbcAutoForwardCompleted struct {
+	Nonce          string `protobuf:"bytes,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	EvmChainPrefix string `protobuf:"bytes,2,opt,name=evm_chain_prefix,json=evmChainPrefix,proto3" json:"evm_chain_prefix,omitempty"`
+	Channel        string `protobuf:"bytes,3,opt,name=channel,proto3" json:"channel,omitempty"`
+	Sequence       string `protobuf:"bytes,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Status         string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Error          string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (m *EventInvalidSendToCosmosReceiver) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0x22
+func (m *EventIbcAutoForwardCompleted) Reset()         { *m = EventIbcAutoForwardCompleted{} }
+func (m *EventIbcAutoForwardCompleted) String() string { return proto.CompactTextString(m) }
+func (*EventIbcAutoForwardCompleted) ProtoMessage()    {}
+func (*EventIbcAutoForwardCompleted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3205613bbab7525, []int{12}
+}
+func (m *EventIbcAutoForwardCompleted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventIbcAutoForwardCompleted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventIbcAutoForwardCompleted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Token) > 0 {
-		i -= len(m.Token)
-		copy(dAtA[i:], m.Token)
-		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Token)))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *EventIbcAutoForwardCompleted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventIbcAutoForwardCompleted.Merge(m, src)
+}
+func (m *EventIbcAutoForwardCompleted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventIbcAutoForwardCompleted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventIbcAutoForwardCompleted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventIbcAutoForwardCompleted proto.InternalMessageInfo
+
+func (m *EventIbcAutoForwardCompleted) GetNonce() string {
+	if m != nil {
+		return m.Nonce
 	}
-	if len(m.Nonce) > 0 {
-		i -= len(m.Nonce)
-		copy(dAtA[i:], m.Nonce)
-		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Nonce)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *EventIbcAutoForwardCompleted) GetEvmChainPrefix() string {
+	if m != nil {
+		return m.EvmChainPrefix
 	}
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventIbcAutoForwardCompleted) GetChannel() string {
+	if m != nil {
+		return m.Channel
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventSendToCosmos) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventIbcAutoForwardCompleted) GetSequence() string {
+	if m != nil {
+		return m.Sequence
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventSendToCosmos) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventIbcAutoForwardCompleted) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
 }
 
-func (m *EventSendToCosmos) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Token) > 0 {
-		i -= len(m.Token)
-		copy(dAtA[i:], m.Token)
-		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Token)))
-		i--
-		dAtA[i] = 0x1a
+func (m *EventIbcAutoForwardCompleted) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	if len(m.Nonce) > 0 {
-		i -= len(m.Nonce)
-		copy(dAtA[i:], m.Nonce)
-		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Nonce)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+// FailedAttestation quarantines an observed attestation whose handler returned
+// an error, the attestation's effects were discarded and wait here until
+// governance resolves it with a ResolveFailedAttestationProposal
+type FailedAttestation struct {
+	EvmChainPrefix    string      `protobuf:"bytes,1,opt,name=evm_chain_prefix,json=evmChainPrefix,proto3" json:"evm_chain_prefix,omitempty"`
+	EventNonce        uint64      `protobuf:"varint,2,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
+	Attestation       Attestation `protobuf:"bytes,3,opt,name=attestation,proto3" json:"attestation"`
+	Error             string      `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	FailedBlockHeight uint64      `protobuf:"varint,5,opt,name=failed_block_height,json=failedBlockHeight,proto3" json:"failed_block_height,omitempty"`
+}
+
+func (m *FailedAttestation) Reset()         { *m = FailedAttestation{} }
+func (m *FailedAttestation) String() string { return proto.CompactTextString(m) }
+func (*FailedAttestation) ProtoMessage()    {}
+func (*FailedAttestation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3205613bbab7525, []int{13}
+}
+func (m *FailedAttestation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FailedAttestation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FailedAttestation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *FailedAttestation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FailedAttestation.Merge(m, src)
+}
+func (m *FailedAttestation) XXX_Size() int {
+	return m.Size()
+}
+func (m *FailedAttestation) XXX_DiscardUnknown() {
+	xxx_messageInfo_FailedAttestation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FailedAttestation proto.InternalMessageInfo
+
+func (m *FailedAttestation) GetEvmChainPrefix() string {
+	if m != nil {
+		return m.EvmChainPrefix
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventSendToCosmosLocal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *FailedAttestation) GetEventNonce() uint64 {
+	if m != nil {
+		return m.EventNonce
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EventSendToCosmosLocal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *FailedAttestation) GetAttestation() Attestation {
+	if m != nil {
+		return m.Attestation
+	}
+	return Attestation{}
 }
 
-func (m *EventSendToCosmosLocal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0x22
+func (m *FailedAttestation) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	if len(m.Token) > 0 {
-		i -= len(m.Token)
-		copy(dAtA[i:], m.Token)
-		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Token)))
-		i--
-		dAtA[i] = 0x1a
+	return ""
+}
+
+func (m *FailedAttestation) GetFailedBlockHeight() uint64 {
+	if m != nil {
+		return m.FailedBlockHeight
 	}
-	if len(m.Receiver) > 0 {
-		i -= len(m.Receiver)
-		copy(dAtA[i:], m.Receiver)
-		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Receiver)))
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+type EventAttestationFailed struct {
+	Nonce           string `protobuf:"bytes,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	EvmChainPrefix  string `protobuf:"bytes,2,opt,name=evm_chain_prefix,json=evmChainPrefix,proto3" json:"evm_chain_prefix,omitempty"`
+	AttestationType string `protobuf:"bytes,3,opt,name=attestation_type,json=attestationType,proto3" json:"attestation_type,omitempty"`
+	Error           string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *EventAttestationFailed) Reset()         { *m = EventAttestationFailed{} }
+func (m *EventAttestationFailed) String() string { return proto.CompactTextString(m) }
+func (*EventAttestationFailed) ProtoMessage()    {}
+func (*EventAttestationFailed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3205613bbab7525, []int{14}
+}
+func (m *EventAttestationFailed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAttestationFailed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAttestationFailed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Nonce) > 0 {
-		i -= len(m.Nonce)
-		copy(dAtA[i:], m.Nonce)
-		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Nonce)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *EventAttestationFailed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAttestationFailed.Merge(m, src)
+}
+func (m *EventAttestationFailed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAttestationFailed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAttestationFailed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAttestationFailed proto.InternalMessageInfo
+
+func (m *EventAttestationFailed) GetNonce() string {
+	if m != nil {
+		return m.Nonce
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventSendToCosmosPendingIbcAutoForward) Marshal() (dAtA []byte, err error) {
+func (m *EventAttestationFailed) GetEvmChainPrefix() string {
+	if m != nil {
+		return m.EvmChainPrefix
+	}
+	return ""
+}
+
+func (m *EventAttestationFailed) GetAttestationType() string {
+	if m != nil {
+		return m.AttestationType
+	}
+	return ""
+}
+
+func (m *EventAttestationFailed) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type EventFailedAttestationResolved struct {
+	Nonce           string `protobuf:"bytes,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	EvmChainPrefix  string `protobuf:"bytes,2,opt,name=evm_chain_prefix,json=evmChainPrefix,proto3" json:"evm_chain_prefix,omitempty"`
+	AttestationType string `protobuf:"bytes,3,opt,name=attestation_type,json=attestationType,proto3" json:"attestation_type,omitempty"`
+	RefundReceiver  string `protobuf:"bytes,4,opt,name=refund_receiver,json=refundReceiver,proto3" json:"refund_receiver,omitempty"`
+}
+
+func (m *EventFailedAttestationResolved) Reset()         { *m = EventFailedAttestationResolved{} }
+func (m *EventFailedAttestationResolved) String() string { return proto.CompactTextString(m) }
+func (*EventFailedAttestationResolved) ProtoMessage()    {}
+func (*EventFailedAttestationResolved) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e3205613bbab7525, []int{15}
+}
+func (m *EventFailedAttestationResolved) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventFailedAttestationResolved) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventFailedAttestationResolved.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventFailedAttestationResolved) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFailedAttestationResolved.Merge(m, src)
+}
+func (m *EventFailedAttestationResolved) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventFailedAttestationResolved) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFailedAttestationResolved.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventFailedAttestationResolved proto.InternalMessageInfo
+
+func (m *EventFailedAttestationResolved) GetNonce() string {
+	if m != nil {
+		return m.Nonce
+	}
+	return ""
+}
+
+func (m *EventFailedAttestationResolved) GetEvmChainPrefix() string {
+	if m != nil {
+		return m.EvmChainPrefix
+	}
+	return ""
+}
+
+func (m *EventFailedAttestationResolved) GetAttestationType() string {
+	if m != nil {
+		return m.AttestationType
+	}
+	return ""
+}
+
+func (m *EventFailedAttestationResolved) GetRefundReceiver() string {
+	if m != nil {
+		return m.RefundReceiver
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("gravity.v1.ClaimType", ClaimType_name, ClaimType_value)
+	proto.RegisterType((*Attestation)(nil), "gravity.v1.Attestation")
+	proto.RegisterType((*ERC20Token)(nil), "gravity.v1.ERC20Token")
+	proto.RegisterType((*EventObservation)(nil), "gravity.v1.EventObservation")
+	proto.RegisterType((*EventInvalidSendToCosmosReceiver)(nil), "gravity.v1.EventInvalidSendToCosmosReceiver")
+	proto.RegisterType((*EventBlacklistedSendToCosmos)(nil), "gravity.v1.EventBlacklistedSendToCosmos")
+	proto.RegisterType((*EventSendToCosmos)(nil), "gravity.v1.EventSendToCosmos")
+	proto.RegisterType((*EventSendToCosmosLocal)(nil), "gravity.v1.EventSendToCosmosLocal")
+	proto.RegisterType((*EventSendToCosmosForwardedToEvm)(nil), "gravity.v1.EventSendToCosmosForwardedToEvm")
+	proto.RegisterType((*EventSendToCosmosPendingIbcAutoForward)(nil), "gravity.v1.EventSendToCosmosPendingIbcAutoForward")
+	proto.RegisterType((*EventSendToCosmosExecutedIbcAutoForward)(nil), "gravity.v1.EventSendToCosmosExecutedIbcAutoForward")
+	proto.RegisterType((*EventSendToCosmosHeld)(nil), "gravity.v1.EventSendToCosmosHeld")
+	proto.RegisterType((*EventSendToCosmosReleased)(nil), "gravity.v1.EventSendToCosmosReleased")
+	proto.RegisterType((*EventIbcAutoForwardCompleted)(nil), "gravity.v1.EventIbcAutoForwardCompleted")
+	proto.RegisterType((*FailedAttestation)(nil), "gravity.v1.FailedAttestation")
+	proto.RegisterType((*EventAttestationFailed)(nil), "gravity.v1.EventAttestationFailed")
+	proto.RegisterType((*EventFailedAttestationResolved)(nil), "gravity.v1.EventFailedAttestationResolved")
+}
+
+func init() { proto.RegisterFile("gravity/v1/attestation.proto", fileDescriptor_e3205613bbab7525) }
+
+var fileDescriptor_e3205613bbab7525 = []byte{
+	// 1118 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcf, 0x6e, 0xe3, 0xd4,
+	0x17, 0xce, 0xcd, 0x9f, 0xce, 0xf4, 0xe6, 0x37, 0xd3, 0xd4, 0xed, 0xaf, 0xa4, 0x51, 0x49, 0x83,
+	0x25, 0xda, 0x32, 0xd2, 0x24, 0x4c, 0x79, 0x80, 0x51, 0xe2, 0xb8, 0xd3, 0x48, 0x99, 0x26, 0x72,
+	0x52, 0xa0, 0x6c, 0x2c, 0xc7, 0x3e, 0x4d, 0xad, 0xda, 0xbe, 0xc1, 0xbe, 0xf1, 0xa4, 0x1b, 0x36,
+	0x6c, 0x58, 0xb2, 0x65, 0x09, 0x88, 0x37, 0xe0, 0x09, 0x58, 0x8d, 0xc4, 0xa6, 0x4b, 0x84, 0xd0,
+	0x08, 0xb5, 0x62, 0xc9, 0x82, 0x37, 0x40, 0xbe, 0xf7, 0x26, 0x71, 0x1b, 0x77, 0x05, 0x45, 0xb3,
+	0x4a, 0xbf, 0x73, 0xae, 0xcf, 0xf9, 0xbe, 0x73, 0x8e, 0xaf, 0x4f, 0xf1, 0xd6, 0xd0, 0x37, 0x42,
+	0x9b, 0x5e, 0xd4, 0xc2, 0x67, 0x35, 0x83, 0x52, 0x08, 0xa8, 0x41, 0x6d, 0xe2, 0x55, 0x47, 0x3e,
+	0xa1, 0x44, 0xc2, 0xc2, 0x5b, 0x0d, 0x9f, 0x95, 0xd6, 0x87, 0x64, 0x48, 0x98, 0xb9, 0x16, 0xfd,
+	0xc5, 0x4f, 0x94, 0x36, 0x87, 0x84, 0x0c, 0x1d, 0xa8, 0x31, 0x34, 0x18, 0x9f, 0xd6, 0x0c, 0xef,
+	0x82, 0xbb, 0xe4, 0x2f, 0x11, 0xce, 0xd7, 0xe7, 0x21, 0xa5, 0x12, 0x7e, 0x48, 0x06, 0x01, 0xf8,
+	0x21, 0x58, 0x45, 0x54, 0x41, 0x7b, 0x0f, 0xb5, 0x19, 0x96, 0xd6, 0x71, 0x2e, 0x24, 0x14, 0x82,
+	0x62, 0xba, 0x92, 0xd9, 0x5b, 0xd6, 0x38, 0x90, 0x36, 0xf0, 0xd2, 0x19, 0xd8, 0xc3, 0x33, 0x5a,
+	0xcc, 0x54, 0xd0, 0x5e, 0x56, 0x13, 0x48, 0x7a, 0x82, 0x73, 0xa6, 0x63, 0xd8, 0x6e, 0x31, 0x5b,
+	0x41, 0x7b, 0xf9, 0xfd, 0xf5, 0x2a, 0x27, 0x51, 0x9d, 0x92, 0xa8, 0xd6, 0xbd, 0x0b, 0x8d, 0x1f,
+	0x91, 0x47, 0x18, 0xab, 0x9a, 0xb2, 0xff, 0x61, 0x9f, 0x9c, 0x03, 0xe3, 0x60, 0x12, 0x8f, 0xfa,
+	0x86, 0x49, 0x19, 0x87, 0x65, 0x6d, 0x86, 0xa5, 0x03, 0xbc, 0x64, 0xb8, 0x64, 0xec, 0xd1, 0x62,
+	0x3a, 0xf2, 0x34, 0xaa, 0xaf, 0xdf, 0x6c, 0xa7, 0x7e, 0x7d, 0xb3, 0xbd, 0x33, 0xb4, 0xe9, 0xd9,
+	0x78, 0x50, 0x35, 0x89, 0x5b, 0x33, 0x49, 0xe0, 0x92, 0x40, 0xfc, 0x3c, 0x0d, 0xac, 0xf3, 0x1a,
+	0xbd, 0x18, 0x41, 0x50, 0x6d, 0x79, 0x54, 0x13, 0x4f, 0xcb, 0x3f, 0x23, 0x5c, 0x50, 0x43, 0xf0,
+	0x68, 0x87, 0xa9, 0xe3, 0xe2, 0x3f, 0xc0, 0x85, 0x58, 0x79, 0xf5, 0xe8, 0x29, 0x41, 0x60, 0x25,
+	0x66, 0xef, 0x5f, 0x8c, 0x40, 0xda, 0xc5, 0x2b, 0x03, 0xdf, 0xb6, 0x86, 0xa0, 0xcf, 0xa8, 0x32,
+	0x42, 0xda, 0x63, 0x6e, 0x56, 0xa6, 0x84, 0x77, 0xe6, 0x07, 0xcf, 0x0c, 0xdb, 0xd3, 0x6d, 0x8b,
+	0xd5, 0x69, 0x59, 0x7b, 0x24, 0x0e, 0x46, 0xd6, 0x96, 0x25, 0xbd, 0x8f, 0x1f, 0xc7, 0x73, 0xdb,
+	0x16, 0xab, 0xdb, 0xb2, 0xf6, 0x28, 0x66, 0x6d, 0xb1, 0x1e, 0x78, 0xc4, 0x33, 0xa1, 0x98, 0x63,
+	0x5e, 0x0e, 0xe4, 0x2f, 0x70, 0x85, 0x89, 0x69, 0x79, 0xa1, 0xe1, 0xd8, 0x56, 0x0f, 0x3c, 0xab,
+	0x4f, 0x14, 0xa6, 0x5f, 0x03, 0x13, 0xec, 0x10, 0xfc, 0xa8, 0x4f, 0xa2, 0x72, 0x5c, 0x92, 0x40,
+	0xf3, 0x88, 0xe9, 0x58, 0xc4, 0xc8, 0x4a, 0xa3, 0x66, 0x08, 0xb2, 0x1c, 0x44, 0x31, 0x02, 0xf0,
+	0x2c, 0xf0, 0x05, 0x39, 0x81, 0xe4, 0xbf, 0x10, 0xde, 0x62, 0x04, 0x1a, 0x8e, 0x61, 0x9e, 0x3b,
+	0x76, 0x40, 0xe1, 0x06, 0x89, 0xfb, 0x4c, 0x1e, 0x8d, 0x8b, 0x2f, 0x44, 0x8a, 0xaa, 0xcc, 0xb0,
+	0xb4, 0x87, 0x0b, 0x10, 0xba, 0xa2, 0xf4, 0x23, 0x1f, 0x4e, 0xed, 0x49, 0x71, 0x89, 0xf7, 0x09,
+	0x42, 0x97, 0xd5, 0xbe, 0xcb, 0xac, 0x52, 0x0d, 0xaf, 0x0d, 0xe6, 0xe4, 0x75, 0xc3, 0xb2, 0x7c,
+	0x08, 0x82, 0xe2, 0x03, 0x76, 0x58, 0x8a, 0xb9, 0xea, 0xdc, 0x23, 0x7f, 0x82, 0x57, 0x99, 0xe4,
+	0x7f, 0x5b, 0xa7, 0x3c, 0xc1, 0x1b, 0x0b, 0x81, 0xdb, 0xc4, 0x34, 0x9c, 0x79, 0x14, 0x14, 0x8f,
+	0x12, 0xd7, 0x9f, 0xbe, 0xa5, 0xff, 0xce, 0x4a, 0x0a, 0x96, 0xd9, 0x38, 0x4b, 0xf9, 0x37, 0x84,
+	0xb7, 0x17, 0x52, 0x1f, 0x10, 0xff, 0x95, 0xe1, 0x5b, 0x60, 0xf5, 0x89, 0x1a, 0xba, 0x77, 0x70,
+	0x98, 0xe5, 0x49, 0x27, 0xe7, 0xc9, 0xdc, 0xa8, 0x46, 0x52, 0x57, 0xb2, 0x89, 0x5d, 0xa9, 0xe0,
+	0xbc, 0x05, 0x01, 0xb5, 0x3d, 0x36, 0xff, 0xa2, 0xbd, 0x71, 0x93, 0xb4, 0x86, 0x73, 0x74, 0x12,
+	0xbd, 0x2e, 0xbc, 0xad, 0x59, 0x3a, 0xe1, 0x6f, 0x09, 0x79, 0xe5, 0x81, 0x2f, 0xda, 0xc7, 0x81,
+	0xfc, 0x1d, 0xc2, 0x3b, 0x0b, 0xf2, 0xba, 0xe0, 0x59, 0xb6, 0x37, 0x6c, 0x0d, 0xcc, 0xfa, 0x98,
+	0x12, 0x21, 0xf6, 0xbe, 0x2b, 0x2d, 0x15, 0xf1, 0x03, 0xf3, 0xcc, 0xf0, 0x3c, 0x70, 0x84, 0xa6,
+	0x29, 0x94, 0xff, 0x44, 0x78, 0x77, 0x81, 0xa4, 0x3a, 0x01, 0x73, 0x4c, 0xc1, 0x7a, 0x5b, 0x58,
+	0x4a, 0xef, 0xe1, 0xff, 0x51, 0xdb, 0x05, 0x32, 0xa6, 0x7a, 0xf4, 0x2b, 0x8a, 0x9f, 0x17, 0xb6,
+	0xbe, 0xed, 0x42, 0x74, 0xa1, 0x4d, 0x8f, 0x88, 0xef, 0x03, 0x6f, 0xc6, 0x23, 0x61, 0x3d, 0x64,
+	0x46, 0xf9, 0x5b, 0x84, 0xff, 0xbf, 0xa0, 0xf7, 0x10, 0x9c, 0xfb, 0x57, 0x97, 0x34, 0x85, 0xb9,
+	0xa4, 0x29, 0x94, 0x7f, 0x40, 0x78, 0x73, 0x81, 0xa3, 0x06, 0x0e, 0x18, 0x01, 0xbc, 0x4d, 0x3c,
+	0x7f, 0x9a, 0x5e, 0xc3, 0x37, 0xe7, 0x44, 0x21, 0xee, 0xc8, 0x01, 0x7a, 0x27, 0xd5, 0xa4, 0x04,
+	0xe9, 0xc4, 0xd7, 0x31, 0x36, 0x10, 0x99, 0x9b, 0x03, 0x51, 0xc2, 0x0f, 0x03, 0xf8, 0x7c, 0x0c,
+	0x51, 0x70, 0x4e, 0x7f, 0x86, 0xd9, 0xc5, 0x4d, 0x0d, 0x3a, 0x0e, 0x04, 0x6d, 0x81, 0x22, 0x36,
+	0xe0, 0xfb, 0xc4, 0x17, 0xd3, 0xc3, 0x81, 0xfc, 0x07, 0xc2, 0xab, 0x07, 0x86, 0xed, 0x80, 0x15,
+	0xdf, 0x4b, 0x92, 0x38, 0xa2, 0x44, 0x8e, 0xdb, 0x38, 0x0f, 0x51, 0x0d, 0xf4, 0xf9, 0x85, 0x9b,
+	0xd5, 0x30, 0x33, 0x1d, 0x31, 0xb9, 0xcf, 0x71, 0x3e, 0xf6, 0x4d, 0x65, 0x42, 0xf2, 0xfb, 0xef,
+	0x54, 0xe7, 0x5b, 0x54, 0x35, 0x96, 0xb8, 0x91, 0x8d, 0x16, 0x0c, 0x2d, 0xfe, 0xc4, 0x9c, 0x77,
+	0x36, 0xc6, 0x5b, 0xaa, 0xe2, 0xb5, 0x53, 0x46, 0x5b, 0x1f, 0x38, 0xc4, 0x3c, 0x9f, 0x0e, 0x7d,
+	0x8e, 0xe5, 0x5f, 0xe5, 0xae, 0x46, 0xe4, 0x11, 0x83, 0xff, 0x0d, 0x12, 0xf7, 0x7c, 0x2c, 0x1b,
+	0xd7, 0xfd, 0x8f, 0xdb, 0x94, 0xb4, 0xc7, 0x64, 0x92, 0xf7, 0x98, 0x44, 0x2d, 0xf2, 0x8f, 0x08,
+	0x97, 0x19, 0xb7, 0x85, 0x46, 0x68, 0x10, 0x10, 0x27, 0xfc, 0x6f, 0x39, 0xee, 0xe2, 0x15, 0x1f,
+	0x4e, 0xc7, 0x9e, 0xa5, 0xcf, 0xde, 0x28, 0xf1, 0xb5, 0xe0, 0xe6, 0xe9, 0x8a, 0xf3, 0xe4, 0x12,
+	0xe1, 0x65, 0x25, 0x5a, 0x28, 0xd9, 0x63, 0x25, 0xbc, 0xa1, 0xb4, 0xeb, 0xad, 0x97, 0x7a, 0xff,
+	0xa4, 0xab, 0xea, 0xc7, 0x47, 0xbd, 0xae, 0xaa, 0xb4, 0x0e, 0x5a, 0x6a, 0xb3, 0x90, 0x92, 0xde,
+	0xc5, 0x9b, 0x31, 0x5f, 0x4f, 0x3d, 0x6a, 0xea, 0xfd, 0x8e, 0xae, 0x74, 0x7a, 0x2f, 0x3b, 0xbd,
+	0x02, 0x92, 0x2a, 0x78, 0x2b, 0xe6, 0x6e, 0xd4, 0xfb, 0xca, 0xe1, 0xec, 0x90, 0xda, 0x3f, 0x2c,
+	0xa4, 0x6f, 0x05, 0x60, 0xcb, 0xab, 0xde, 0x54, 0xbb, 0xed, 0xce, 0x89, 0xda, 0x2c, 0x64, 0x24,
+	0x19, 0x97, 0x63, 0xee, 0x76, 0xe7, 0x45, 0x4b, 0xd1, 0x95, 0x7a, 0xbb, 0xad, 0xab, 0x9f, 0xaa,
+	0xca, 0x71, 0x5f, 0x6d, 0x16, 0xb2, 0xb7, 0x42, 0x7c, 0x5c, 0x6f, 0xf7, 0xd4, 0xbe, 0x7e, 0xdc,
+	0x6d, 0xd6, 0x23, 0x77, 0xae, 0x94, 0xfd, 0xea, 0xfb, 0x72, 0xaa, 0x71, 0xf2, 0xfa, 0xaa, 0x8c,
+	0x2e, 0xaf, 0xca, 0xe8, 0xf7, 0xab, 0x32, 0xfa, 0xfa, 0xba, 0x9c, 0xba, 0xbc, 0x2e, 0xa7, 0x7e,
+	0xb9, 0x2e, 0xa7, 0x3e, 0x7b, 0x1e, 0xdb, 0x78, 0x5f, 0xf0, 0xd9, 0x7d, 0xda, 0x60, 0x2b, 0xe5,
+	0x6d, 0xe8, 0x12, 0x6b, 0xec, 0x40, 0x6d, 0x52, 0x9b, 0xfe, 0x1b, 0xc1, 0xd6, 0xe1, 0xc1, 0x12,
+	0xdb, 0xc4, 0x3f, 0xfa, 0x7b, 0x00, 0x8e, 0x00, 0x83, 0xc4, 0x5e, 0x0c, 0x00, 0x00,
+}
+
+func (m *Attestation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1025,55 +1390,56 @@ func (m *EventSendToCosmosPendingIbcAutoForward) Marshal() (dAtA []byte, err err
 	return dAtA[:n], nil
 }
 
-func (m *EventSendToCosmosPendingIbcAutoForward) MarshalTo(dAtA []byte) (int, error) {
+func (m *Attestation) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventSendToCosmosPendingIbcAutoForward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Attestation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Channel) > 0 {
-		i -= len(m.Channel)
-		copy(dAtA[i:], m.Channel)
-		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Channel)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Amount)))
+	if m.Claim != nil {
+		{
+			size, err := m.Claim.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAttestation(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x22
 	}
-	if len(m.Token) > 0 {
-		i -= len(m.Token)
-		copy(dAtA[i:], m.Token)
-		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Token)))
+	if m.Height != 0 {
+		i = encodeVarintAttestation(dAtA, i, uint64(m.Height))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x18
 	}
-	if len(m.Receiver) > 0 {
-		i -= len(m.Receiver)
-		copy(dAtA[i:], m.Receiver)
-		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Receiver)))
-		i--
-		dAtA[i] = 0x12
+	if len(m.Votes) > 0 {
+		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Votes[iNdEx])
+			copy(dAtA[i:], m.Votes[iNdEx])
+			i = encodeVarintAttestation(dAtA, i, uint64(len(m.Votes[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if len(m.Nonce) > 0 {
-		i -= len(m.Nonce)
-		copy(dAtA[i:], m.Nonce)
-		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Nonce)))
+	if m.Observed {
 		i--
-		dAtA[i] = 0xa
+		if m.Observed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventSendToCosmosExecutedIbcAutoForward) Marshal() (dAtA []byte, err error) {
+func (m *ERC20Token) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1083,292 +1449,2851 @@ func (m *EventSendToCosmosExecutedIbcAutoForward) Marshal() (dAtA []byte, err er
 	return dAtA[:n], nil
 }
 
-func (m *EventSendToCosmosExecutedIbcAutoForward) MarshalTo(dAtA []byte) (int, error) {
+func (m *ERC20Token) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventSendToCosmosExecutedIbcAutoForward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ERC20Token) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.TimeoutHeight) > 0 {
-		i -= len(m.TimeoutHeight)
-		copy(dAtA[i:], m.TimeoutHeight)
-		i = encodeVarintAttestation(dAtA, i, uint64(len(m.TimeoutHeight)))
-		i--
-		dAtA[i] = 0x3a
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAttestation(dAtA, i, uint64(size))
 	}
-	if len(m.TimeoutTime) > 0 {
-		i -= len(m.TimeoutTime)
-		copy(dAtA[i:], m.TimeoutTime)
-		i = encodeVarintAttestation(dAtA, i, uint64(len(m.TimeoutTime)))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Contract)))
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0xa
 	}
-	if len(m.Channel) > 0 {
-		i -= len(m.Channel)
-		copy(dAtA[i:], m.Channel)
-		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Channel)))
+	return len(dAtA) - i, nil
+}
+
+func (m *EventObservation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventObservation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventObservation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Nonce) > 0 {
+		i -= len(m.Nonce)
+		copy(dAtA[i:], m.Nonce)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Nonce)))
 		i--
 		dAtA[i] = 0x2a
 	}
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Amount)))
+	if len(m.AttestationId) > 0 {
+		i -= len(m.AttestationId)
+		copy(dAtA[i:], m.AttestationId)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.AttestationId)))
 		i--
 		dAtA[i] = 0x22
 	}
-	if len(m.Token) > 0 {
-		i -= len(m.Token)
-		copy(dAtA[i:], m.Token)
-		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Token)))
+	if len(m.BridgeChainId) > 0 {
+		i -= len(m.BridgeChainId)
+		copy(dAtA[i:], m.BridgeChainId)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.BridgeChainId)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.Receiver) > 0 {
-		i -= len(m.Receiver)
-		copy(dAtA[i:], m.Receiver)
-		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Receiver)))
-		i--
-		dAtA[i] = 0x12
+	if len(m.BridgeContract) > 0 {
+		i -= len(m.BridgeContract)
+		copy(dAtA[i:], m.BridgeContract)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.BridgeContract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AttestationType) > 0 {
+		i -= len(m.AttestationType)
+		copy(dAtA[i:], m.AttestationType)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.AttestationType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventInvalidSendToCosmosReceiver) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventInvalidSendToCosmosReceiver) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventInvalidSendToCosmosReceiver) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x1a
 	}
 	if len(m.Nonce) > 0 {
 		i -= len(m.Nonce)
 		copy(dAtA[i:], m.Nonce)
 		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Nonce)))
 		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Amount)))
+		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintAttestation(dAtA []byte, offset int, v uint64) int {
-	offset -= sovAttestation(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventBlacklistedSendToCosmos) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Attestation) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *EventBlacklistedSendToCosmos) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBlacklistedSendToCosmos) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BlacklistedAddress) > 0 {
+		i -= len(m.BlacklistedAddress)
+		copy(dAtA[i:], m.BlacklistedAddress)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.BlacklistedAddress)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Nonce) > 0 {
+		i -= len(m.Nonce)
+		copy(dAtA[i:], m.Nonce)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Nonce)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSendToCosmos) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSendToCosmos) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSendToCosmos) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Observed {
-		n += 2
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.Votes) > 0 {
-		for _, s := range m.Votes {
-			l = len(s)
-			n += 1 + l + sovAttestation(uint64(l))
+	if len(m.Nonce) > 0 {
+		i -= len(m.Nonce)
+		copy(dAtA[i:], m.Nonce)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Nonce)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSendToCosmosLocal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSendToCosmosLocal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSendToCosmosLocal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Nonce) > 0 {
+		i -= len(m.Nonce)
+		copy(dAtA[i:], m.Nonce)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Nonce)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSendToCosmosForwardedToEvm) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSendToCosmosForwardedToEvm) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSendToCosmosForwardedToEvm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.TxId) > 0 {
+		i -= len(m.TxId)
+		copy(dAtA[i:], m.TxId)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.TxId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Destination) > 0 {
+		i -= len(m.Destination)
+		copy(dAtA[i:], m.Destination)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Destination)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Nonce) > 0 {
+		i -= len(m.Nonce)
+		copy(dAtA[i:], m.Nonce)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Nonce)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSendToCosmosPendingIbcAutoForward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSendToCosmosPendingIbcAutoForward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSendToCosmosPendingIbcAutoForward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Nonce) > 0 {
+		i -= len(m.Nonce)
+		copy(dAtA[i:], m.Nonce)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Nonce)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSendToCosmosExecutedIbcAutoForward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSendToCosmosExecutedIbcAutoForward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSendToCosmosExecutedIbcAutoForward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TimeoutHeight) > 0 {
+		i -= len(m.TimeoutHeight)
+		copy(dAtA[i:], m.TimeoutHeight)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.TimeoutHeight)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.TimeoutTime) > 0 {
+		i -= len(m.TimeoutTime)
+		copy(dAtA[i:], m.TimeoutTime)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.TimeoutTime)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Nonce) > 0 {
+		i -= len(m.Nonce)
+		copy(dAtA[i:], m.Nonce)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Nonce)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSendToCosmosHeld) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSendToCosmosHeld) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSendToCosmosHeld) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Nonce) > 0 {
+		i -= len(m.Nonce)
+		copy(dAtA[i:], m.Nonce)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Nonce)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSendToCosmosReleased) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSendToCosmosReleased) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSendToCosmosReleased) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Nonce) > 0 {
+		i -= len(m.Nonce)
+		copy(dAtA[i:], m.Nonce)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Nonce)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventIbcAutoForwardCompleted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventIbcAutoForwardCompleted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventIbcAutoForwardCompleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Sequence) > 0 {
+		i -= len(m.Sequence)
+		copy(dAtA[i:], m.Sequence)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Sequence)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Nonce) > 0 {
+		i -= len(m.Nonce)
+		copy(dAtA[i:], m.Nonce)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Nonce)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FailedAttestation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FailedAttestation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FailedAttestation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FailedBlockHeight != 0 {
+		i = encodeVarintAttestation(dAtA, i, uint64(m.FailedBlockHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Attestation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAttestation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.EventNonce != 0 {
+		i = encodeVarintAttestation(dAtA, i, uint64(m.EventNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAttestationFailed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAttestationFailed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAttestationFailed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.AttestationType) > 0 {
+		i -= len(m.AttestationType)
+		copy(dAtA[i:], m.AttestationType)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.AttestationType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Nonce) > 0 {
+		i -= len(m.Nonce)
+		copy(dAtA[i:], m.Nonce)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Nonce)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventFailedAttestationResolved) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFailedAttestationResolved) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFailedAttestationResolved) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RefundReceiver) > 0 {
+		i -= len(m.RefundReceiver)
+		copy(dAtA[i:], m.RefundReceiver)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.RefundReceiver)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.AttestationType) > 0 {
+		i -= len(m.AttestationType)
+		copy(dAtA[i:], m.AttestationType)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.AttestationType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Nonce) > 0 {
+		i -= len(m.Nonce)
+		copy(dAtA[i:], m.Nonce)
+		i = encodeVarintAttestation(dAtA, i, uint64(len(m.Nonce)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAttestation(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAttestation(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Attestation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Observed {
+		n += 2
+	}
+	if len(m.Votes) > 0 {
+		for _, s := range m.Votes {
+			l = len(s)
+			n += 1 + l + sovAttestation(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovAttestation(uint64(m.Height))
+	}
+	if m.Claim != nil {
+		l = m.Claim.Size()
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	return n
+}
+
+func (m *ERC20Token) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovAttestation(uint64(l))
+	return n
+}
+
+func (m *EventObservation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AttestationType)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = len(m.BridgeContract)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = len(m.BridgeChainId)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = len(m.AttestationId)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = len(m.Nonce)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	return n
+}
+
+func (m *EventInvalidSendToCosmosReceiver) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = len(m.Nonce)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	return n
+}
+
+func (m *EventBlacklistedSendToCosmos) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = len(m.Nonce)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = len(m.BlacklistedAddress)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	return n
+}
+
+func (m *EventSendToCosmos) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = len(m.Nonce)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	return n
+}
+
+func (m *EventSendToCosmosLocal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Nonce)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	return n
+}
+
+func (m *EventSendToCosmosForwardedToEvm) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Nonce)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = len(m.TxId)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	return n
+}
+
+func (m *EventSendToCosmosPendingIbcAutoForward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Nonce)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	return n
+}
+
+func (m *EventSendToCosmosExecutedIbcAutoForward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Nonce)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = len(m.TimeoutTime)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = len(m.TimeoutHeight)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	return n
+}
+
+func (m *EventSendToCosmosHeld) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Nonce)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	return n
+}
+
+func (m *EventSendToCosmosReleased) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Nonce)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	return n
+}
+
+func (m *EventIbcAutoForwardCompleted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Nonce)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = len(m.Sequence)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	return n
+}
+
+func (m *FailedAttestation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	if m.EventNonce != 0 {
+		n += 1 + sovAttestation(uint64(m.EventNonce))
+	}
+	l = m.Attestation.Size()
+	n += 1 + l + sovAttestation(uint64(l))
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	if m.FailedBlockHeight != 0 {
+		n += 1 + sovAttestation(uint64(m.FailedBlockHeight))
+	}
+	return n
+}
+
+func (m *EventAttestationFailed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Nonce)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = len(m.AttestationType)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	return n
+}
+
+func (m *EventFailedAttestationResolved) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Nonce)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = len(m.AttestationType)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	l = len(m.RefundReceiver)
+	if l > 0 {
+		n += 1 + l + sovAttestation(uint64(l))
+	}
+	return n
+}
+
+func sovAttestation(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAttestation(x uint64) (n int) {
+	return sovAttestation(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Attestation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttestation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Attestation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Attestation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Observed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Observed = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Votes = append(m.Votes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claim", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Claim == nil {
+				m.Claim = &types.Any{}
+			}
+			if err := m.Claim.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttestation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ERC20Token) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttestation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ERC20Token: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ERC20Token: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttestation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventObservation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttestation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventObservation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventObservation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttestationType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttestationId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nonce = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttestation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventInvalidSendToCosmosReceiver) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttestation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventInvalidSendToCosmosReceiver: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventInvalidSendToCosmosReceiver: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nonce = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttestation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventBlacklistedSendToCosmos) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttestation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBlacklistedSendToCosmos: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBlacklistedSendToCosmos: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nonce = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlacklistedAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlacklistedAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttestation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSendToCosmos) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttestation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSendToCosmos: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSendToCosmos: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nonce = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttestation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSendToCosmosLocal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttestation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSendToCosmosLocal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSendToCosmosLocal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nonce = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttestation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSendToCosmosForwardedToEvm) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttestation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSendToCosmosForwardedToEvm: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSendToCosmosForwardedToEvm: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nonce = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destination = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttestation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Height != 0 {
-		n += 1 + sovAttestation(uint64(m.Height))
-	}
-	if m.Claim != nil {
-		l = m.Claim.Size()
-		n += 1 + l + sovAttestation(uint64(l))
-	}
-	return n
-}
-
-func (m *ERC20Token) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Contract)
-	if l > 0 {
-		n += 1 + l + sovAttestation(uint64(l))
-	}
-	l = m.Amount.Size()
-	n += 1 + l + sovAttestation(uint64(l))
-	return n
-}
-
-func (m *EventObservation) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.AttestationType)
-	if l > 0 {
-		n += 1 + l + sovAttestation(uint64(l))
-	}
-	l = len(m.BridgeContract)
-	if l > 0 {
-		n += 1 + l + sovAttestation(uint64(l))
-	}
-	l = len(m.BridgeChainId)
-	if l > 0 {
-		n += 1 + l + sovAttestation(uint64(l))
-	}
-	l = len(m.AttestationId)
-	if l > 0 {
-		n += 1 + l + sovAttestation(uint64(l))
-	}
-	l = len(m.Nonce)
-	if l > 0 {
-		n += 1 + l + sovAttestation(uint64(l))
-	}
-	return n
-}
-
-func (m *EventInvalidSendToCosmosReceiver) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovAttestation(uint64(l))
-	}
-	l = len(m.Nonce)
-	if l > 0 {
-		n += 1 + l + sovAttestation(uint64(l))
-	}
-	l = len(m.Token)
-	if l > 0 {
-		n += 1 + l + sovAttestation(uint64(l))
-	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovAttestation(uint64(l))
-	}
-	return n
-}
-
-func (m *EventSendToCosmos) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovAttestation(uint64(l))
-	}
-	l = len(m.Nonce)
-	if l > 0 {
-		n += 1 + l + sovAttestation(uint64(l))
-	}
-	l = len(m.Token)
-	if l > 0 {
-		n += 1 + l + sovAttestation(uint64(l))
-	}
-	return n
-}
-
-func (m *EventSendToCosmosLocal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Nonce)
-	if l > 0 {
-		n += 1 + l + sovAttestation(uint64(l))
-	}
-	l = len(m.Receiver)
-	if l > 0 {
-		n += 1 + l + sovAttestation(uint64(l))
-	}
-	l = len(m.Token)
-	if l > 0 {
-		n += 1 + l + sovAttestation(uint64(l))
-	}
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovAttestation(uint64(l))
-	}
-	return n
-}
-
-func (m *EventSendToCosmosPendingIbcAutoForward) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Nonce)
-	if l > 0 {
-		n += 1 + l + sovAttestation(uint64(l))
-	}
-	l = len(m.Receiver)
-	if l > 0 {
-		n += 1 + l + sovAttestation(uint64(l))
-	}
-	l = len(m.Token)
-	if l > 0 {
-		n += 1 + l + sovAttestation(uint64(l))
-	}
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovAttestation(uint64(l))
-	}
-	l = len(m.Channel)
-	if l > 0 {
-		n += 1 + l + sovAttestation(uint64(l))
-	}
-	return n
-}
 
-func (m *EventSendToCosmosExecutedIbcAutoForward) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Nonce)
-	if l > 0 {
-		n += 1 + l + sovAttestation(uint64(l))
-	}
-	l = len(m.Receiver)
-	if l > 0 {
-		n += 1 + l + sovAttestation(uint64(l))
-	}
-	l = len(m.Token)
-	if l > 0 {
-		n += 1 + l + sovAttestation(uint64(l))
-	}
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovAttestation(uint64(l))
-	}
-	l = len(m.Channel)
-	if l > 0 {
-		n += 1 + l + sovAttestation(uint64(l))
-	}
-	l = len(m.TimeoutTime)
-	if l > 0 {
-		n += 1 + l + sovAttestation(uint64(l))
-	}
-	l = len(m.TimeoutHeight)
-	if l > 0 {
-		n += 1 + l + sovAttestation(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovAttestation(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozAttestation(x uint64) (n int) {
-	return sovAttestation(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Attestation) Unmarshal(dAtA []byte) error {
+func (m *EventSendToCosmosPendingIbcAutoForward) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1391,17 +4316,17 @@ func (m *Attestation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Attestation: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSendToCosmosPendingIbcAutoForward: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Attestation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSendToCosmosPendingIbcAutoForward: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Observed", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAttestation
@@ -1411,15 +4336,59 @@ func (m *Attestation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nonce = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Observed = bool(v != 0)
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1447,13 +4416,13 @@ func (m *Attestation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Votes = append(m.Votes, string(dAtA[iNdEx:postIndex]))
+			m.Token = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.Height = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAttestation
@@ -1463,16 +4432,29 @@ func (m *Attestation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Claim", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAttestation
@@ -1482,27 +4464,23 @@ func (m *Attestation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAttestation
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAttestation
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Claim == nil {
-				m.Claim = &types.Any{}
-			}
-			if err := m.Claim.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1525,7 +4503,7 @@ func (m *Attestation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ERC20Token) Unmarshal(dAtA []byte) error {
+func (m *EventSendToCosmosExecutedIbcAutoForward) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1548,15 +4526,15 @@ func (m *ERC20Token) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ERC20Token: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSendToCosmosExecutedIbcAutoForward: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ERC20Token: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSendToCosmosExecutedIbcAutoForward: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1584,11 +4562,11 @@ func (m *ERC20Token) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Contract = string(dAtA[iNdEx:postIndex])
+			m.Nonce = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1616,63 +4594,11 @@ func (m *ERC20Token) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAttestation(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthAttestation
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventObservation) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowAttestation
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventObservation: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventObservation: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AttestationType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1700,11 +4626,11 @@ func (m *EventObservation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AttestationType = string(dAtA[iNdEx:postIndex])
+			m.Token = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeContract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1732,11 +4658,11 @@ func (m *EventObservation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BridgeContract = string(dAtA[iNdEx:postIndex])
+			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1764,11 +4690,11 @@ func (m *EventObservation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BridgeChainId = string(dAtA[iNdEx:postIndex])
+			m.Channel = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AttestationId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTime", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1796,11 +4722,11 @@ func (m *EventObservation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AttestationId = string(dAtA[iNdEx:postIndex])
+			m.TimeoutTime = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutHeight", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1828,7 +4754,7 @@ func (m *EventObservation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Nonce = string(dAtA[iNdEx:postIndex])
+			m.TimeoutHeight = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1851,7 +4777,7 @@ func (m *EventObservation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventInvalidSendToCosmosReceiver) Unmarshal(dAtA []byte) error {
+func (m *EventSendToCosmosHeld) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1874,15 +4800,15 @@ func (m *EventInvalidSendToCosmosReceiver) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventInvalidSendToCosmosReceiver: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSendToCosmosHeld: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventInvalidSendToCosmosReceiver: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSendToCosmosHeld: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1910,11 +4836,11 @@ func (m *EventInvalidSendToCosmosReceiver) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
+			m.Nonce = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1942,7 +4868,7 @@ func (m *EventInvalidSendToCosmosReceiver) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Nonce = string(dAtA[iNdEx:postIndex])
+			m.Receiver = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
@@ -1978,7 +4904,7 @@ func (m *EventInvalidSendToCosmosReceiver) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2006,7 +4932,39 @@ func (m *EventInvalidSendToCosmosReceiver) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2029,7 +4987,7 @@ func (m *EventInvalidSendToCosmosReceiver) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSendToCosmos) Unmarshal(dAtA []byte) error {
+func (m *EventSendToCosmosReleased) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2052,15 +5010,79 @@ func (m *EventSendToCosmos) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSendToCosmos: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSendToCosmosReleased: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSendToCosmos: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSendToCosmosReleased: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nonce = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2088,11 +5110,11 @@ func (m *EventSendToCosmos) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
+			m.Token = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2120,11 +5142,11 @@ func (m *EventSendToCosmos) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Nonce = string(dAtA[iNdEx:postIndex])
+			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2152,7 +5174,7 @@ func (m *EventSendToCosmos) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Token = string(dAtA[iNdEx:postIndex])
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2175,7 +5197,7 @@ func (m *EventSendToCosmos) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSendToCosmosLocal) Unmarshal(dAtA []byte) error {
+func (m *EventIbcAutoForwardCompleted) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2198,10 +5220,10 @@ func (m *EventSendToCosmosLocal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSendToCosmosLocal: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventIbcAutoForwardCompleted: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSendToCosmosLocal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventIbcAutoForwardCompleted: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2238,7 +5260,7 @@ func (m *EventSendToCosmosLocal) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2266,11 +5288,11 @@ func (m *EventSendToCosmosLocal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Receiver = string(dAtA[iNdEx:postIndex])
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2298,11 +5320,11 @@ func (m *EventSendToCosmosLocal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Token = string(dAtA[iNdEx:postIndex])
+			m.Channel = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2330,7 +5352,71 @@ func (m *EventSendToCosmosLocal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
+			m.Sequence = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2353,7 +5439,7 @@ func (m *EventSendToCosmosLocal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSendToCosmosPendingIbcAutoForward) Unmarshal(dAtA []byte) error {
+func (m *FailedAttestation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2376,15 +5462,15 @@ func (m *EventSendToCosmosPendingIbcAutoForward) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSendToCosmosPendingIbcAutoForward: wiretype end group for non-group")
+			return fmt.Errorf("proto: FailedAttestation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSendToCosmosPendingIbcAutoForward: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FailedAttestation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2412,13 +5498,13 @@ func (m *EventSendToCosmosPendingIbcAutoForward) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Nonce = string(dAtA[iNdEx:postIndex])
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
 			}
-			var stringLen uint64
+			m.EventNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAttestation
@@ -2428,29 +5514,16 @@ func (m *EventSendToCosmosPendingIbcAutoForward) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.EventNonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAttestation
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAttestation
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Receiver = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestation", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAttestation
@@ -2460,27 +5533,28 @@ func (m *EventSendToCosmosPendingIbcAutoForward) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAttestation
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAttestation
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Token = string(dAtA[iNdEx:postIndex])
+			if err := m.Attestation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2508,13 +5582,13 @@ func (m *EventSendToCosmosPendingIbcAutoForward) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedBlockHeight", wireType)
 			}
-			var stringLen uint64
+			m.FailedBlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAttestation
@@ -2524,24 +5598,11 @@ func (m *EventSendToCosmosPendingIbcAutoForward) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.FailedBlockHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAttestation
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAttestation
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Channel = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAttestation(dAtA[iNdEx:])
@@ -2563,7 +5624,7 @@ func (m *EventSendToCosmosPendingIbcAutoForward) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSendToCosmosExecutedIbcAutoForward) Unmarshal(dAtA []byte) error {
+func (m *EventAttestationFailed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2586,10 +5647,10 @@ func (m *EventSendToCosmosExecutedIbcAutoForward) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSendToCosmosExecutedIbcAutoForward: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventAttestationFailed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSendToCosmosExecutedIbcAutoForward: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventAttestationFailed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2626,7 +5687,7 @@ func (m *EventSendToCosmosExecutedIbcAutoForward) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2654,11 +5715,11 @@ func (m *EventSendToCosmosExecutedIbcAutoForward) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Receiver = string(dAtA[iNdEx:postIndex])
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2686,11 +5747,11 @@ func (m *EventSendToCosmosExecutedIbcAutoForward) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Token = string(dAtA[iNdEx:postIndex])
+			m.AttestationType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2718,11 +5779,61 @@ func (m *EventSendToCosmosExecutedIbcAutoForward) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttestation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventFailedAttestationResolved) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttestation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventFailedAttestationResolved: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventFailedAttestationResolved: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2750,11 +5861,11 @@ func (m *EventSendToCosmosExecutedIbcAutoForward) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Channel = string(dAtA[iNdEx:postIndex])
+			m.Nonce = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2782,11 +5893,11 @@ func (m *EventSendToCosmosExecutedIbcAutoForward) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TimeoutTime = string(dAtA[iNdEx:postIndex])
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2814,7 +5925,39 @@ func (m *EventSendToCosmosExecutedIbcAutoForward) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TimeoutHeight = string(dAtA[iNdEx:postIndex])
+			m.AttestationType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundReceiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundReceiver = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
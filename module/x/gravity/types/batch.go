@@ -14,7 +14,13 @@ import (
 )
 
 func (o OutgoingTransferTx) ToInternal() (*InternalOutgoingTransferTx, error) {
-	return NewInternalOutgoingTransferTx(o.Id, o.Sender, o.DestAddress, o.Erc20Token, o.Erc20Fee)
+	tx, err := NewInternalOutgoingTransferTx(o.Id, o.Sender, o.DestAddress, o.Erc20Token, o.Erc20Fee)
+	if err != nil {
+		return nil, err
+	}
+	tx.CosmosBlockCreated = o.CosmosBlockCreated
+	tx.ExtraFee = o.ExtraFee
+	return tx, nil
 }
 
 // InternalOutgoingTransferTx is an internal duplicate of OutgoingTransferTx with validation
@@ -24,6 +30,10 @@ type InternalOutgoingTransferTx struct {
 	DestAddress *EthAddress
 	Erc20Token  *InternalERC20Token
 	Erc20Fee    *InternalERC20Token
+	// CosmosBlockCreated is the height at which the tx entered the pool
+	CosmosBlockCreated uint64
+	// ExtraFee is the optional bridge fee paid on cosmos, nil if none was paid
+	ExtraFee *sdk.Coin
 }
 
 func NewInternalOutgoingTransferTx(
@@ -61,11 +71,13 @@ func NewInternalOutgoingTransferTx(
 
 func (i InternalOutgoingTransferTx) ToExternal() OutgoingTransferTx {
 	return OutgoingTransferTx{
-		Id:          i.Id,
-		Sender:      i.Sender.String(),
-		DestAddress: i.DestAddress.GetAddress().Hex(),
-		Erc20Token:  i.Erc20Token.ToExternal(),
-		Erc20Fee:    i.Erc20Fee.ToExternal(),
+		Id:                 i.Id,
+		Sender:             i.Sender.String(),
+		DestAddress:        i.DestAddress.GetAddress().Hex(),
+		Erc20Token:         i.Erc20Token.ToExternal(),
+		Erc20Fee:           i.Erc20Fee.ToExternal(),
+		CosmosBlockCreated: i.CosmosBlockCreated,
+		ExtraFee:           i.ExtraFee,
 	}
 }
 
@@ -84,6 +96,9 @@ func (i InternalOutgoingTransferTx) ValidateBasic() error {
 	if err != nil {
 		return sdkerrors.Wrap(err, "invalid Erc20Fee")
 	}
+	if i.ExtraFee != nil && (!i.ExtraFee.IsValid() || !i.ExtraFee.IsPositive()) {
+		return sdkerrors.Wrapf(ErrInvalid, "invalid ExtraFee %v", i.ExtraFee)
+	}
 	return nil
 }
 
@@ -203,8 +218,22 @@ func (o OutgoingTxBatch) GetCheckpoint(gravityIDstring string) []byte {
 	return i.GetCheckpoint(gravityIDstring)
 }
 
+// Required for EvmSigned interface
+func (o OutgoingTxBatch) GetCheckpointABIEncoding(gravityIDstring string) []byte {
+	i, err := o.ToInternal()
+	if err != nil {
+		panic(sdkerrors.Wrap(err, "invalid OutgoingTxBatch"))
+	}
+	return i.GetCheckpointABIEncoding(gravityIDstring)
+}
+
 // GetCheckpoint gets the checkpoint signature from the given outgoing tx batch
 func (i InternalOutgoingTxBatch) GetCheckpoint(gravityIDstring string) []byte {
+	return crypto.Keccak256Hash(i.GetCheckpointABIEncoding(gravityIDstring)).Bytes()
+}
+
+// GetCheckpointABIEncoding returns the abi encoding of the outgoing tx batch which is hashed into its checkpoint
+func (i InternalOutgoingTxBatch) GetCheckpointABIEncoding(gravityIDstring string) []byte {
 
 	abi, err := abi.JSON(strings.NewReader(OutgoingBatchTxCheckpointABIJSON))
 	if err != nil {
@@ -255,10 +284,10 @@ func (i InternalOutgoingTxBatch) GetCheckpoint(gravityIDstring string) []byte {
 		panic(fmt.Sprintf("Error packing checkpoint! %s/n", err))
 	}
 
-	// we hash the resulting encoded bytes discarding the first 4 bytes these 4 bytes are the constant
+	// we discard the first 4 bytes of the resulting encoded bytes, these 4 bytes are the constant
 	// method name 'checkpoint'. If you were to replace the checkpoint constant in this code you would
 	// then need to adjust how many bytes you truncate off the front to get the output of abi.encode()
-	return crypto.Keccak256Hash(abiEncodedBatch[4:]).Bytes()
+	return abiEncodedBatch[4:]
 }
 
 // TotalValue computes the total amounts plus fees for this batch as a sdk.Coin
@@ -297,8 +326,13 @@ func (c OutgoingLogicCall) ValidateBasic() error {
 	return nil
 }
 
-// GetCheckpoint gets the checkpoint signature from the given outgoing tx batch
+// GetCheckpoint gets the checkpoint signature from the given outgoing logic call
 func (c OutgoingLogicCall) GetCheckpoint(gravityIDstring string) []byte {
+	return crypto.Keccak256Hash(c.GetCheckpointABIEncoding(gravityIDstring)).Bytes()
+}
+
+// GetCheckpointABIEncoding returns the abi encoding of the outgoing logic call which is hashed into its checkpoint
+func (c OutgoingLogicCall) GetCheckpointABIEncoding(gravityIDstring string) []byte {
 
 	abi, err := abi.JSON(strings.NewReader(OutgoingLogicCallABIJSON))
 	if err != nil {
@@ -360,7 +394,7 @@ func (c OutgoingLogicCall) GetCheckpoint(gravityIDstring string) []byte {
 		panic(fmt.Sprintf("Error packing checkpoint! %s/n", err))
 	}
 
-	return crypto.Keccak256Hash(abiEncodedCall[4:]).Bytes()
+	return abiEncodedCall[4:]
 }
 
 func (o OutgoingLogicCall) ToInternal() (*InternalOutgoingLogicCall, error) {
@@ -394,6 +428,7 @@ func (o OutgoingLogicCall) ToInternal() (*InternalOutgoingLogicCall, error) {
 		InvalidationId:       o.InvalidationId,
 		InvalidationNonce:    o.InvalidationNonce,
 		CosmosBlockCreated:   o.CosmosBlockCreated,
+		Funded:               o.Funded,
 	}, nil
 }
 
@@ -406,6 +441,7 @@ type InternalOutgoingLogicCall struct {
 	InvalidationId       []byte
 	InvalidationNonce    uint64
 	CosmosBlockCreated   uint64
+	Funded               bool
 }
 
 func (i InternalOutgoingLogicCall) ToExternal() OutgoingLogicCall {
@@ -428,6 +464,7 @@ func (i InternalOutgoingLogicCall) ToExternal() OutgoingLogicCall {
 		InvalidationId:       i.InvalidationId,
 		InvalidationNonce:    i.InvalidationNonce,
 		CosmosBlockCreated:   i.CosmosBlockCreated,
+		Funded:               i.Funded,
 	}
 }
 
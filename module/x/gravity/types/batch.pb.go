@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -107,6 +108,15 @@ type OutgoingTransferTx struct {
 	DestAddress string     `protobuf:"bytes,3,opt,name=dest_address,json=destAddress,proto3" json:"dest_address,omitempty"`
 	Erc20Token  ERC20Token `protobuf:"bytes,4,opt,name=erc20_token,json=erc20Token,proto3" json:"erc20_token"`
 	Erc20Fee    ERC20Token `protobuf:"bytes,5,opt,name=erc20_fee,json=erc20Fee,proto3" json:"erc20_fee"`
+	// the cosmos block height at which the tx entered the pool, zero for txs
+	// which entered it before the height was recorded
+	CosmosBlockCreated uint64 `protobuf:"varint,6,opt,name=cosmos_block_created,json=cosmosBlockCreated,proto3" json:"cosmos_block_created,omitempty"`
+	// an optional bridge fee in a denom whitelisted by the extra_fee_denoms of
+	// the evm chain, escrowed by the module and paid to the orchestrator of the
+	// relayer once the batch of the tx executes. It is a tip for the relayer,
+	// batch strategies, auto batch thresholds and the profitability check of a
+	// new batch only count erc20_fee
+	ExtraFee *types.Coin `protobuf:"bytes,7,opt,name=extra_fee,json=extraFee,proto3" json:"extra_fee,omitempty"`
 }
 
 func (m *OutgoingTransferTx) Reset()         { *m = OutgoingTransferTx{} }
@@ -177,6 +187,20 @@ func (m *OutgoingTransferTx) GetErc20Fee() ERC20Token {
 	return ERC20Token{}
 }
 
+func (m *OutgoingTransferTx) GetCosmosBlockCreated() uint64 {
+	if m != nil {
+		return m.CosmosBlockCreated
+	}
+	return 0
+}
+
+func (m *OutgoingTransferTx) GetExtraFee() *types.Coin {
+	if m != nil {
+		return m.ExtraFee
+	}
+	return nil
+}
+
 // OutgoingLogicCall represents an individual logic call from gravity to ETH
 type OutgoingLogicCall struct {
 	Transfers            []ERC20Token `protobuf:"bytes,1,rep,name=transfers,proto3" json:"transfers"`
@@ -187,6 +211,9 @@ type OutgoingLogicCall struct {
 	InvalidationId       []byte       `protobuf:"bytes,6,opt,name=invalidation_id,json=invalidationId,proto3" json:"invalidation_id,omitempty"`
 	InvalidationNonce    uint64       `protobuf:"varint,7,opt,name=invalidation_nonce,json=invalidationNonce,proto3" json:"invalidation_nonce,omitempty"`
 	CosmosBlockCreated   uint64       `protobuf:"varint,8,opt,name=cosmos_block_created,json=cosmosBlockCreated,proto3" json:"cosmos_block_created,omitempty"`
+	// set when the gravity module locked the transfers and fees of the call,
+	// only then are they refunded on cancel or burned on execution
+	Funded bool `protobuf:"varint,9,opt,name=funded,proto3" json:"funded,omitempty"`
 }
 
 func (m *OutgoingLogicCall) Reset()         { *m = OutgoingLogicCall{} }
@@ -278,6 +305,13 @@ func (m *OutgoingLogicCall) GetCosmosBlockCreated() uint64 {
 	return 0
 }
 
+func (m *OutgoingLogicCall) GetFunded() bool {
+	if m != nil {
+		return m.Funded
+	}
+	return false
+}
+
 type EventOutgoingBatchCanceled struct {
 	BridgeContract string `protobuf:"bytes,1,opt,name=bridge_contract,json=bridgeContract,proto3" json:"bridge_contract,omitempty"`
 	BridgeChainId  string `protobuf:"bytes,2,opt,name=bridge_chain_id,json=bridgeChainId,proto3" json:"bridge_chain_id,omitempty"`
@@ -346,6 +380,174 @@ func (m *EventOutgoingBatchCanceled) GetNonce() string {
 	return ""
 }
 
+type EventBatchExtraFeesPaid struct {
+	BridgeContract string `protobuf:"bytes,1,opt,name=bridge_contract,json=bridgeContract,proto3" json:"bridge_contract,omitempty"`
+	BridgeChainId  string `protobuf:"bytes,2,opt,name=bridge_chain_id,json=bridgeChainId,proto3" json:"bridge_chain_id,omitempty"`
+	TokenContract  string `protobuf:"bytes,3,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	Nonce          string `protobuf:"bytes,4,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Relayer        string `protobuf:"bytes,5,opt,name=relayer,proto3" json:"relayer,omitempty"`
+	Recipient      string `protobuf:"bytes,6,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Fees           string `protobuf:"bytes,7,opt,name=fees,proto3" json:"fees,omitempty"`
+}
+
+func (m *EventBatchExtraFeesPaid) Reset()         { *m = EventBatchExtraFeesPaid{} }
+func (m *EventBatchExtraFeesPaid) String() string { return proto.CompactTextString(m) }
+func (*EventBatchExtraFeesPaid) ProtoMessage()    {}
+func (*EventBatchExtraFeesPaid) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4453b445b0660cab, []int{4}
+}
+func (m *EventBatchExtraFeesPaid) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBatchExtraFeesPaid) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventBatchExtraFeesPaid.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventBatchExtraFeesPaid) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBatchExtraFeesPaid.Merge(m, src)
+}
+func (m *EventBatchExtraFeesPaid) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBatchExtraFeesPaid) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBatchExtraFeesPaid.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventBatchExtraFeesPaid proto.InternalMessageInfo
+
+func (m *EventBatchExtraFeesPaid) GetBridgeContract() string {
+	if m != nil {
+		return m.BridgeContract
+	}
+	return ""
+}
+
+func (m *EventBatchExtraFeesPaid) GetBridgeChainId() string {
+	if m != nil {
+		return m.BridgeChainId
+	}
+	return ""
+}
+
+func (m *EventBatchExtraFeesPaid) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
+	}
+	return ""
+}
+
+func (m *EventBatchExtraFeesPaid) GetNonce() string {
+	if m != nil {
+		return m.Nonce
+	}
+	return ""
+}
+
+func (m *EventBatchExtraFeesPaid) GetRelayer() string {
+	if m != nil {
+		return m.Relayer
+	}
+	return ""
+}
+
+func (m *EventBatchExtraFeesPaid) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *EventBatchExtraFeesPaid) GetFees() string {
+	if m != nil {
+		return m.Fees
+	}
+	return ""
+}
+
+type EventBatchCancellationRequested struct {
+	Sender         string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	BridgeContract string `protobuf:"bytes,2,opt,name=bridge_contract,json=bridgeContract,proto3" json:"bridge_contract,omitempty"`
+	BridgeChainId  string `protobuf:"bytes,3,opt,name=bridge_chain_id,json=bridgeChainId,proto3" json:"bridge_chain_id,omitempty"`
+	TokenContract  string `protobuf:"bytes,4,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	Nonce          string `protobuf:"bytes,5,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (m *EventBatchCancellationRequested) Reset()         { *m = EventBatchCancellationRequested{} }
+func (m *EventBatchCancellationRequested) String() string { return proto.CompactTextString(m) }
+func (*EventBatchCancellationRequested) ProtoMessage()    {}
+func (*EventBatchCancellationRequested) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4453b445b0660cab, []int{5}
+}
+func (m *EventBatchCancellationRequested) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBatchCancellationRequested) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventBatchCancellationRequested.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventBatchCancellationRequested) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBatchCancellationRequested.Merge(m, src)
+}
+func (m *EventBatchCancellationRequested) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBatchCancellationRequested) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBatchCancellationRequested.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventBatchCancellationRequested proto.InternalMessageInfo
+
+func (m *EventBatchCancellationRequested) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *EventBatchCancellationRequested) GetBridgeContract() string {
+	if m != nil {
+		return m.BridgeContract
+	}
+	return ""
+}
+
+func (m *EventBatchCancellationRequested) GetBridgeChainId() string {
+	if m != nil {
+		return m.BridgeChainId
+	}
+	return ""
+}
+
+func (m *EventBatchCancellationRequested) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
+	}
+	return ""
+}
+
+func (m *EventBatchCancellationRequested) GetNonce() string {
+	if m != nil {
+		return m.Nonce
+	}
+	return ""
+}
+
 type EventOutgoingBatch struct {
 	BridgeContract string `protobuf:"bytes,1,opt,name=bridge_contract,json=bridgeContract,proto3" json:"bridge_contract,omitempty"`
 	BridgeChainId  string `protobuf:"bytes,2,opt,name=bridge_chain_id,json=bridgeChainId,proto3" json:"bridge_chain_id,omitempty"`
@@ -357,7 +559,7 @@ func (m *EventOutgoingBatch) Reset()         { *m = EventOutgoingBatch{} }
 func (m *EventOutgoingBatch) String() string { return proto.CompactTextString(m) }
 func (*EventOutgoingBatch) ProtoMessage()    {}
 func (*EventOutgoingBatch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4453b445b0660cab, []int{4}
+	return fileDescriptor_4453b445b0660cab, []int{6}
 }
 func (m *EventOutgoingBatch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -414,58 +616,160 @@ func (m *EventOutgoingBatch) GetNonce() string {
 	return ""
 }
 
+type EventOutgoingLogicCall struct {
+	BridgeContract             string `protobuf:"bytes,1,opt,name=bridge_contract,json=bridgeContract,proto3" json:"bridge_contract,omitempty"`
+	BridgeChainId              string `protobuf:"bytes,2,opt,name=bridge_chain_id,json=bridgeChainId,proto3" json:"bridge_chain_id,omitempty"`
+	LogicCallInvalidationId    string `protobuf:"bytes,3,opt,name=logic_call_invalidation_id,json=logicCallInvalidationId,proto3" json:"logic_call_invalidation_id,omitempty"`
+	LogicCallInvalidationNonce string `protobuf:"bytes,4,opt,name=logic_call_invalidation_nonce,json=logicCallInvalidationNonce,proto3" json:"logic_call_invalidation_nonce,omitempty"`
+	LogicContractAddress       string `protobuf:"bytes,5,opt,name=logic_contract_address,json=logicContractAddress,proto3" json:"logic_contract_address,omitempty"`
+	Timeout                    string `protobuf:"bytes,6,opt,name=timeout,proto3" json:"timeout,omitempty"`
+}
+
+func (m *EventOutgoingLogicCall) Reset()         { *m = EventOutgoingLogicCall{} }
+func (m *EventOutgoingLogicCall) String() string { return proto.CompactTextString(m) }
+func (*EventOutgoingLogicCall) ProtoMessage()    {}
+func (*EventOutgoingLogicCall) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4453b445b0660cab, []int{7}
+}
+func (m *EventOutgoingLogicCall) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventOutgoingLogicCall) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventOutgoingLogicCall.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventOutgoingLogicCall) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventOutgoingLogicCall.Merge(m, src)
+}
+func (m *EventOutgoingLogicCall) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventOutgoingLogicCall) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventOutgoingLogicCall.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventOutgoingLogicCall proto.InternalMessageInfo
+
+func (m *EventOutgoingLogicCall) GetBridgeContract() string {
+	if m != nil {
+		return m.BridgeContract
+	}
+	return ""
+}
+
+func (m *EventOutgoingLogicCall) GetBridgeChainId() string {
+	if m != nil {
+		return m.BridgeChainId
+	}
+	return ""
+}
+
+func (m *EventOutgoingLogicCall) GetLogicCallInvalidationId() string {
+	if m != nil {
+		return m.LogicCallInvalidationId
+	}
+	return ""
+}
+
+func (m *EventOutgoingLogicCall) GetLogicCallInvalidationNonce() string {
+	if m != nil {
+		return m.LogicCallInvalidationNonce
+	}
+	return ""
+}
+
+func (m *EventOutgoingLogicCall) GetLogicContractAddress() string {
+	if m != nil {
+		return m.LogicContractAddress
+	}
+	return ""
+}
+
+func (m *EventOutgoingLogicCall) GetTimeout() string {
+	if m != nil {
+		return m.Timeout
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*OutgoingTxBatch)(nil), "gravity.v1.OutgoingTxBatch")
 	proto.RegisterType((*OutgoingTransferTx)(nil), "gravity.v1.OutgoingTransferTx")
 	proto.RegisterType((*OutgoingLogicCall)(nil), "gravity.v1.OutgoingLogicCall")
 	proto.RegisterType((*EventOutgoingBatchCanceled)(nil), "gravity.v1.EventOutgoingBatchCanceled")
+	proto.RegisterType((*EventBatchExtraFeesPaid)(nil), "gravity.v1.EventBatchExtraFeesPaid")
+	proto.RegisterType((*EventBatchCancellationRequested)(nil), "gravity.v1.EventBatchCancellationRequested")
 	proto.RegisterType((*EventOutgoingBatch)(nil), "gravity.v1.EventOutgoingBatch")
+	proto.RegisterType((*EventOutgoingLogicCall)(nil), "gravity.v1.EventOutgoingLogicCall")
 }
 
 func init() { proto.RegisterFile("gravity/v1/batch.proto", fileDescriptor_4453b445b0660cab) }
 
 var fileDescriptor_4453b445b0660cab = []byte{
-	// 634 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x54, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0x8d, 0xd3, 0xf4, 0x23, 0x93, 0x34, 0x55, 0x57, 0x51, 0x64, 0x2a, 0xe4, 0x96, 0x20, 0xa0,
-	0x97, 0x26, 0x69, 0xe0, 0x02, 0x12, 0x42, 0x24, 0x2a, 0x50, 0x09, 0x81, 0x64, 0xe5, 0x02, 0x17,
-	0x6b, 0xb3, 0xbb, 0x75, 0x57, 0x75, 0xbc, 0x95, 0xbd, 0x89, 0xda, 0x7f, 0xc1, 0x89, 0x23, 0xbf,
-	0xa7, 0xc7, 0x1e, 0xe1, 0x82, 0x50, 0xfb, 0x1b, 0xb8, 0x70, 0x42, 0x3b, 0x6b, 0x27, 0x69, 0xab,
-	0x02, 0x37, 0x6e, 0x9e, 0x37, 0x6f, 0x76, 0xe6, 0xcd, 0xbe, 0x35, 0x34, 0xc2, 0x84, 0x4e, 0xa4,
-	0x3e, 0x6d, 0x4f, 0x76, 0xdb, 0x43, 0xaa, 0xd9, 0x61, 0xeb, 0x38, 0x51, 0x5a, 0x11, 0xc8, 0xf0,
-	0xd6, 0x64, 0x77, 0xa3, 0x1e, 0xaa, 0x50, 0x21, 0xdc, 0x36, 0x5f, 0x96, 0xb1, 0x71, 0x77, 0xae,
-	0x92, 0x6a, 0x2d, 0x52, 0x4d, 0xb5, 0x54, 0xb1, 0xcd, 0x36, 0x7f, 0x39, 0xb0, 0xf6, 0x7e, 0xac,
-	0x43, 0x25, 0xe3, 0x70, 0x70, 0xd2, 0x33, 0x27, 0x93, 0x4d, 0xa8, 0x60, 0x8b, 0x20, 0x56, 0x31,
-	0x13, 0xae, 0xb3, 0xe5, 0x6c, 0x97, 0x7c, 0x40, 0xe8, 0x9d, 0x41, 0xc8, 0x7d, 0x58, 0xb5, 0x04,
-	0x2d, 0x47, 0x42, 0x8d, 0xb5, 0x5b, 0x44, 0x4a, 0x15, 0xc1, 0x81, 0xc5, 0xc8, 0x1b, 0xa8, 0xea,
-	0x84, 0xc6, 0x29, 0x65, 0xa6, 0x5d, 0xea, 0x2e, 0x6c, 0x2d, 0x6c, 0x57, 0xba, 0x5e, 0x6b, 0x36,
-	0x70, 0x6b, 0xda, 0xd8, 0xf0, 0x0e, 0x44, 0x32, 0x38, 0xe9, 0x95, 0xce, 0xbe, 0x6f, 0x16, 0xfc,
-	0x2b, 0x95, 0xe4, 0x01, 0xd4, 0xb4, 0x3a, 0x12, 0x71, 0xc0, 0x54, 0xac, 0x13, 0xca, 0xb4, 0x5b,
-	0xda, 0x72, 0xb6, 0xcb, 0xfe, 0x2a, 0xa2, 0xfd, 0x0c, 0x24, 0x1d, 0xa8, 0x33, 0x95, 0x8e, 0x54,
-	0x1a, 0x0c, 0x23, 0xc5, 0x8e, 0x02, 0x96, 0x08, 0xaa, 0x05, 0x77, 0x17, 0x71, 0x38, 0x62, 0x73,
-	0x3d, 0x93, 0xea, 0xdb, 0x4c, 0xf3, 0x9b, 0x03, 0xe4, 0xe6, 0x0c, 0xa4, 0x06, 0x45, 0xc9, 0x33,
-	0xd9, 0x45, 0xc9, 0x49, 0x03, 0x96, 0x52, 0x11, 0x73, 0x91, 0xa0, 0xce, 0xb2, 0x9f, 0x45, 0xe4,
-	0x1e, 0x54, 0xb9, 0x48, 0x75, 0x40, 0x39, 0x4f, 0x44, 0x6a, 0x14, 0x9a, 0x6c, 0xc5, 0x60, 0x2f,
-	0x2d, 0x44, 0x9e, 0x43, 0x45, 0x24, 0xac, 0xdb, 0x09, 0x70, 0x54, 0x9c, 0xbb, 0xd2, 0x6d, 0xcc,
-	0xef, 0x60, 0xcf, 0xef, 0x77, 0x3b, 0x03, 0x93, 0xcd, 0xb4, 0x03, 0x16, 0x20, 0x42, 0x9e, 0x42,
-	0xd9, 0x96, 0x1f, 0x08, 0x81, 0x3a, 0xfe, 0x56, 0xbc, 0x82, 0xf4, 0x57, 0x42, 0x34, 0x7f, 0x16,
-	0x61, 0x3d, 0xd7, 0xf6, 0x56, 0x85, 0x92, 0xf5, 0x69, 0x14, 0x91, 0x67, 0x50, 0xd6, 0x99, 0xd0,
-	0xd4, 0x75, 0xf0, 0x46, 0xfe, 0x7c, 0xe0, 0x8c, 0x4e, 0x3a, 0x50, 0x3a, 0x10, 0x22, 0x75, 0x8b,
-	0xff, 0x50, 0x86, 0x4c, 0xf2, 0x04, 0x1a, 0x91, 0x69, 0x3d, 0xbd, 0xb8, 0x6b, 0xab, 0xaa, 0x63,
-	0x36, 0xbf, 0xc0, 0x7c, 0x67, 0x2e, 0x2c, 0x1f, 0xd3, 0xd3, 0x48, 0x51, 0x8e, 0xfb, 0xaa, 0xfa,
-	0x79, 0x68, 0x32, 0xb9, 0xe3, 0xec, 0xa5, 0xe6, 0x21, 0x79, 0x04, 0x6b, 0x32, 0x9e, 0xd0, 0x48,
-	0x72, 0x34, 0x77, 0x20, 0xb9, 0xbb, 0x84, 0xb5, 0xb5, 0x79, 0x78, 0x9f, 0x93, 0x1d, 0x20, 0x57,
-	0x88, 0xd6, 0xe2, 0xcb, 0x78, 0xda, 0xfa, 0x7c, 0xc6, 0x3a, 0xfd, 0x36, 0x4f, 0xad, 0xdc, 0xea,
-	0xa9, 0x2f, 0x0e, 0x6c, 0xec, 0x4d, 0x44, 0xac, 0xf3, 0xe5, 0xe3, 0x9b, 0xea, 0xd3, 0x98, 0x89,
-	0x48, 0x70, 0x33, 0xe8, 0x30, 0x91, 0x3c, 0x14, 0x33, 0x33, 0x3b, 0xb8, 0x8b, 0x9a, 0x85, 0xa7,
-	0x6e, 0x7e, 0x38, 0x23, 0x1e, 0x52, 0x89, 0x8a, 0xac, 0xfb, 0x56, 0x33, 0xa2, 0x41, 0xf7, 0x39,
-	0xb9, 0x03, 0x2b, 0xf6, 0x2d, 0x4a, 0x9e, 0x6d, 0x75, 0x19, 0xe3, 0x7d, 0x4e, 0xea, 0xb0, 0x68,
-	0xe5, 0xd9, 0xe7, 0x62, 0x83, 0xe6, 0x67, 0x07, 0xc8, 0xcd, 0x01, 0xff, 0xff, 0x60, 0xbd, 0x0f,
-	0x67, 0x17, 0x9e, 0x73, 0x7e, 0xe1, 0x39, 0x3f, 0x2e, 0x3c, 0xe7, 0xd3, 0xa5, 0x57, 0x38, 0xbf,
-	0xf4, 0x0a, 0x5f, 0x2f, 0xbd, 0xc2, 0xc7, 0x17, 0xa1, 0xd4, 0x87, 0xe3, 0x61, 0x8b, 0xa9, 0x51,
-	0xfb, 0xb5, 0x75, 0xdd, 0x4e, 0x0f, 0x9b, 0x5d, 0x0f, 0x47, 0x8a, 0x8f, 0x23, 0xd1, 0x3e, 0x69,
-	0xe7, 0x3f, 0x3d, 0x7d, 0x7a, 0x2c, 0xd2, 0xe1, 0x12, 0xfe, 0xec, 0x1e, 0xff, 0x0e, 0x00, 0x00,
-	0xff, 0xff, 0x6a, 0xec, 0xc8, 0x7d, 0x46, 0x05, 0x00, 0x00,
+	// 869 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x56, 0xcd, 0x6e, 0x23, 0x45,
+	0x10, 0xce, 0xf8, 0x27, 0xf6, 0x94, 0x9d, 0xac, 0xb6, 0x15, 0x79, 0x67, 0xad, 0xc5, 0x31, 0x46,
+	0x40, 0x2e, 0x3b, 0x93, 0x04, 0x84, 0x04, 0x08, 0xa1, 0xb5, 0x95, 0x85, 0x48, 0x08, 0xd0, 0x28,
+	0x17, 0xb8, 0x58, 0xed, 0xe9, 0xca, 0xa4, 0xb5, 0x93, 0xe9, 0x30, 0xd3, 0xb6, 0x92, 0x87, 0x40,
+	0xe2, 0xc4, 0x91, 0xf7, 0xe0, 0xcc, 0x81, 0x3d, 0xee, 0x91, 0x13, 0x42, 0xc9, 0x23, 0x70, 0xe3,
+	0x84, 0xa6, 0xba, 0x27, 0xb6, 0x93, 0x78, 0xc9, 0x01, 0x24, 0x6e, 0xae, 0xaf, 0xaa, 0xdc, 0xf5,
+	0xf3, 0xd5, 0xa7, 0x81, 0x4e, 0x9c, 0xf1, 0x99, 0xd4, 0x17, 0xc1, 0x6c, 0x2f, 0x98, 0x70, 0x1d,
+	0x9d, 0xf8, 0x67, 0x99, 0xd2, 0x8a, 0x81, 0xc5, 0xfd, 0xd9, 0x5e, 0x77, 0x2b, 0x56, 0xb1, 0x22,
+	0x38, 0x28, 0x7e, 0x99, 0x88, 0xee, 0x93, 0x85, 0x4c, 0xae, 0x35, 0xe6, 0x9a, 0x6b, 0xa9, 0x52,
+	0xeb, 0xed, 0x45, 0x2a, 0x3f, 0x55, 0x79, 0x30, 0xe1, 0x39, 0x06, 0xb3, 0xbd, 0x09, 0x6a, 0xbe,
+	0x17, 0x44, 0x4a, 0x5a, 0xff, 0xe0, 0x2f, 0x07, 0x1e, 0x7c, 0x35, 0xd5, 0xb1, 0x92, 0x69, 0x7c,
+	0x74, 0x3e, 0x2c, 0x5e, 0x66, 0xdb, 0xd0, 0xa2, 0x12, 0xc6, 0xa9, 0x4a, 0x23, 0xf4, 0x9c, 0xbe,
+	0xb3, 0x53, 0x0b, 0x81, 0xa0, 0x2f, 0x0b, 0x84, 0xbd, 0x05, 0x1b, 0x26, 0x40, 0xcb, 0x53, 0x54,
+	0x53, 0xed, 0x55, 0x28, 0xa4, 0x4d, 0xe0, 0x91, 0xc1, 0xd8, 0xe7, 0xd0, 0xd6, 0x19, 0x4f, 0x73,
+	0x1e, 0x15, 0xe5, 0xe4, 0x5e, 0xb5, 0x5f, 0xdd, 0x69, 0xed, 0xf7, 0xfc, 0x79, 0x43, 0xfe, 0xf5,
+	0xc3, 0x45, 0xdc, 0x31, 0x66, 0x47, 0xe7, 0xc3, 0xda, 0xcb, 0xdf, 0xb7, 0xd7, 0xc2, 0xa5, 0x4c,
+	0xf6, 0x36, 0x6c, 0x6a, 0xf5, 0x02, 0xd3, 0x71, 0xa4, 0x52, 0x9d, 0xf1, 0x48, 0x7b, 0xb5, 0xbe,
+	0xb3, 0xe3, 0x86, 0x1b, 0x84, 0x8e, 0x2c, 0xc8, 0x76, 0x61, 0xcb, 0x34, 0x3b, 0x9e, 0x24, 0x2a,
+	0x7a, 0x31, 0x8e, 0x32, 0xe4, 0x1a, 0x85, 0x57, 0xa7, 0xe2, 0x98, 0xf1, 0x0d, 0x0b, 0xd7, 0xc8,
+	0x78, 0x06, 0xbf, 0x54, 0x80, 0xdd, 0xae, 0x81, 0x6d, 0x42, 0x45, 0x0a, 0xdb, 0x76, 0x45, 0x0a,
+	0xd6, 0x81, 0xf5, 0x1c, 0x53, 0x81, 0x19, 0xf5, 0xe9, 0x86, 0xd6, 0x62, 0x6f, 0x42, 0x5b, 0x60,
+	0xae, 0xc7, 0x5c, 0x88, 0x0c, 0xf3, 0xa2, 0xc3, 0xc2, 0xdb, 0x2a, 0xb0, 0x67, 0x06, 0x62, 0x9f,
+	0x40, 0x0b, 0xb3, 0x68, 0x7f, 0x77, 0x4c, 0xa5, 0x52, 0xdd, 0xad, 0xfd, 0xce, 0xe2, 0x0c, 0x0e,
+	0xc2, 0xd1, 0xfe, 0xee, 0x51, 0xe1, 0xb5, 0xbd, 0x03, 0x25, 0x10, 0xc2, 0x3e, 0x04, 0xd7, 0xa4,
+	0x1f, 0x23, 0x7a, 0xf5, 0x7b, 0x24, 0x37, 0x29, 0xfc, 0x39, 0xe2, 0xca, 0x69, 0xac, 0xaf, 0x9a,
+	0x06, 0xfb, 0x00, 0x5c, 0x3c, 0xd7, 0x19, 0xa7, 0xc7, 0x1a, 0xf4, 0xd8, 0x63, 0xdf, 0xc4, 0xf9,
+	0x05, 0x7d, 0x7c, 0x4b, 0x1f, 0x7f, 0xa4, 0x64, 0x1a, 0x36, 0x29, 0xf6, 0x39, 0xe2, 0xe0, 0xfb,
+	0x2a, 0x3c, 0x2c, 0xa7, 0xf8, 0x85, 0x8a, 0x65, 0x34, 0xe2, 0x49, 0xc2, 0x3e, 0x02, 0x57, 0xdb,
+	0x91, 0xe6, 0x9e, 0xd3, 0xaf, 0xfe, 0x63, 0xe9, 0xf3, 0x70, 0xb6, 0x0b, 0xb5, 0x63, 0xc4, 0xdc,
+	0xab, 0xdc, 0x23, 0x8d, 0x22, 0xd9, 0xfb, 0xd0, 0x49, 0x8a, 0xa7, 0xaf, 0x29, 0x72, 0x63, 0x29,
+	0x5b, 0xe4, 0x2d, 0xa9, 0x52, 0x6e, 0xc7, 0x83, 0xc6, 0x19, 0xbf, 0x48, 0x14, 0x17, 0xb4, 0x99,
+	0x76, 0x58, 0x9a, 0x85, 0xa7, 0xe4, 0xb6, 0xa1, 0x4f, 0x69, 0xb2, 0x77, 0xe1, 0x81, 0x4c, 0x67,
+	0x3c, 0x91, 0x82, 0xce, 0x6c, 0x2c, 0xcd, 0x48, 0xdb, 0xe1, 0xe6, 0x22, 0x7c, 0x28, 0xd8, 0x53,
+	0x60, 0x4b, 0x81, 0xe6, 0x98, 0x1a, 0xf4, 0x6f, 0x0f, 0x17, 0x3d, 0xe6, 0xa6, 0x56, 0xed, 0xab,
+	0xb9, 0x72, 0x5f, 0x1d, 0x58, 0x3f, 0x9e, 0xa6, 0x02, 0x85, 0xe7, 0xf6, 0x9d, 0x9d, 0x66, 0x68,
+	0xad, 0xc1, 0x4f, 0x0e, 0x74, 0x0f, 0x66, 0x98, 0xea, 0x72, 0x29, 0x74, 0xd5, 0x23, 0x9e, 0x46,
+	0x98, 0xa0, 0x28, 0x1a, 0x98, 0x64, 0x52, 0xc4, 0x38, 0x3f, 0x27, 0x87, 0x66, 0xb4, 0x69, 0xe0,
+	0xeb, 0x7b, 0x7a, 0x67, 0x1e, 0x78, 0xc2, 0x25, 0x75, 0x6a, 0xf8, 0xbf, 0x61, 0x03, 0x0b, 0xf4,
+	0x50, 0xb0, 0xc7, 0xd0, 0x34, 0x6a, 0x20, 0x85, 0x9d, 0x76, 0x83, 0xec, 0x43, 0xc1, 0xb6, 0xa0,
+	0x6e, 0xda, 0x36, 0x07, 0x6b, 0x8c, 0xc1, 0x9f, 0x0e, 0x3c, 0xa2, 0x02, 0xa9, 0xb0, 0x03, 0xcb,
+	0xa3, 0xfc, 0x6b, 0x2e, 0xff, 0x83, 0xea, 0x6e, 0x8b, 0x47, 0xf5, 0x2e, 0xf1, 0xb8, 0xb3, 0xd2,
+	0x82, 0x06, 0x19, 0x26, 0xfc, 0x02, 0x33, 0xa2, 0x81, 0x1b, 0x96, 0x26, 0x7b, 0x02, 0x6e, 0x86,
+	0x91, 0x3c, 0x93, 0x98, 0x6a, 0x22, 0x80, 0x1b, 0xce, 0x01, 0xc6, 0x2c, 0x81, 0x1b, 0xe4, 0xa0,
+	0xdf, 0x83, 0x5f, 0x1d, 0xd8, 0x9e, 0x77, 0x6d, 0xd6, 0x91, 0x10, 0x01, 0x42, 0xfc, 0x6e, 0x8a,
+	0xb9, 0x5d, 0xa9, 0x55, 0x1a, 0x67, 0x49, 0x69, 0xee, 0x98, 0x4a, 0xe5, 0xbe, 0x53, 0xa9, 0xde,
+	0x6f, 0x2a, 0xb5, 0xd7, 0x4e, 0xa5, 0xbe, 0xb8, 0xbf, 0x1f, 0x1d, 0x60, 0xb7, 0x09, 0xf6, 0x3f,
+	0x20, 0xd6, 0xcf, 0x15, 0xe8, 0x2c, 0x15, 0x36, 0x97, 0xa3, 0x7f, 0xbd, 0xb8, 0x8f, 0xa1, 0x6b,
+	0x15, 0x87, 0x27, 0xc9, 0xf8, 0xa6, 0x24, 0x98, 0x72, 0x1f, 0x25, 0xe5, 0xfb, 0x87, 0xcb, 0xda,
+	0xf0, 0x0c, 0xde, 0x58, 0x95, 0xbc, 0xd8, 0x56, 0xf7, 0xce, 0x7c, 0xa3, 0x17, 0xab, 0x15, 0xaf,
+	0xfe, 0x7a, 0xc5, 0x2b, 0x75, 0xcd, 0x90, 0xb6, 0x34, 0x87, 0xdf, 0xbc, 0xbc, 0xec, 0x39, 0xaf,
+	0x2e, 0x7b, 0xce, 0x1f, 0x97, 0x3d, 0xe7, 0x87, 0xab, 0xde, 0xda, 0xab, 0xab, 0xde, 0xda, 0x6f,
+	0x57, 0xbd, 0xb5, 0x6f, 0x3f, 0x8d, 0xa5, 0x3e, 0x99, 0x4e, 0xfc, 0x48, 0x9d, 0x06, 0x9f, 0x19,
+	0x25, 0x7e, 0x3a, 0xa4, 0x59, 0xdc, 0x34, 0x4f, 0x95, 0x98, 0x26, 0x18, 0x9c, 0x07, 0xe5, 0x27,
+	0x89, 0xbe, 0x38, 0xc3, 0x7c, 0xb2, 0x4e, 0x9f, 0x1a, 0xef, 0xfd, 0x3d, 0x00, 0x65, 0xd5, 0x59,
+	0xbc, 0xe4, 0x08, 0x00, 0x00,
 }
 
 func (m *OutgoingTxBatch) Marshal() (dAtA []byte, err error) {
@@ -547,6 +851,23 @@ func (m *OutgoingTransferTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExtraFee != nil {
+		{
+			size, err := m.ExtraFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBatch(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.CosmosBlockCreated != 0 {
+		i = encodeVarintBatch(dAtA, i, uint64(m.CosmosBlockCreated))
+		i--
+		dAtA[i] = 0x30
+	}
 	{
 		size, err := m.Erc20Fee.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -609,6 +930,16 @@ func (m *OutgoingLogicCall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Funded {
+		i--
+		if m.Funded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
 	if m.CosmosBlockCreated != 0 {
 		i = encodeVarintBatch(dAtA, i, uint64(m.CosmosBlockCreated))
 		i--
@@ -727,7 +1058,7 @@ func (m *EventOutgoingBatchCanceled) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *EventOutgoingBatch) Marshal() (dAtA []byte, err error) {
+func (m *EventBatchExtraFeesPaid) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -737,16 +1068,37 @@ func (m *EventOutgoingBatch) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventOutgoingBatch) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventBatchExtraFeesPaid) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventOutgoingBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventBatchExtraFeesPaid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Fees) > 0 {
+		i -= len(m.Fees)
+		copy(dAtA[i:], m.Fees)
+		i = encodeVarintBatch(dAtA, i, uint64(len(m.Fees)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintBatch(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Relayer) > 0 {
+		i -= len(m.Relayer)
+		copy(dAtA[i:], m.Relayer)
+		i = encodeVarintBatch(dAtA, i, uint64(len(m.Relayer)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.Nonce) > 0 {
 		i -= len(m.Nonce)
 		copy(dAtA[i:], m.Nonce)
@@ -754,10 +1106,10 @@ func (m *EventOutgoingBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x22
 	}
-	if len(m.BatchId) > 0 {
-		i -= len(m.BatchId)
-		copy(dAtA[i:], m.BatchId)
-		i = encodeVarintBatch(dAtA, i, uint64(len(m.BatchId)))
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintBatch(dAtA, i, uint64(len(m.TokenContract)))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -778,30 +1130,204 @@ func (m *EventOutgoingBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintBatch(dAtA []byte, offset int, v uint64) int {
-	offset -= sovBatch(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventBatchCancellationRequested) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *OutgoingTxBatch) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *EventBatchCancellationRequested) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBatchCancellationRequested) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.BatchNonce != 0 {
-		n += 1 + sovBatch(uint64(m.BatchNonce))
-	}
-	if m.BatchTimeout != 0 {
-		n += 1 + sovBatch(uint64(m.BatchTimeout))
+	if len(m.Nonce) > 0 {
+		i -= len(m.Nonce)
+		copy(dAtA[i:], m.Nonce)
+		i = encodeVarintBatch(dAtA, i, uint64(len(m.Nonce)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if len(m.Transactions) > 0 {
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintBatch(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.BridgeChainId) > 0 {
+		i -= len(m.BridgeChainId)
+		copy(dAtA[i:], m.BridgeChainId)
+		i = encodeVarintBatch(dAtA, i, uint64(len(m.BridgeChainId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BridgeContract) > 0 {
+		i -= len(m.BridgeContract)
+		copy(dAtA[i:], m.BridgeContract)
+		i = encodeVarintBatch(dAtA, i, uint64(len(m.BridgeContract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintBatch(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventOutgoingBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOutgoingBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOutgoingBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Nonce) > 0 {
+		i -= len(m.Nonce)
+		copy(dAtA[i:], m.Nonce)
+		i = encodeVarintBatch(dAtA, i, uint64(len(m.Nonce)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.BatchId) > 0 {
+		i -= len(m.BatchId)
+		copy(dAtA[i:], m.BatchId)
+		i = encodeVarintBatch(dAtA, i, uint64(len(m.BatchId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BridgeChainId) > 0 {
+		i -= len(m.BridgeChainId)
+		copy(dAtA[i:], m.BridgeChainId)
+		i = encodeVarintBatch(dAtA, i, uint64(len(m.BridgeChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BridgeContract) > 0 {
+		i -= len(m.BridgeContract)
+		copy(dAtA[i:], m.BridgeContract)
+		i = encodeVarintBatch(dAtA, i, uint64(len(m.BridgeContract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventOutgoingLogicCall) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOutgoingLogicCall) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOutgoingLogicCall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Timeout) > 0 {
+		i -= len(m.Timeout)
+		copy(dAtA[i:], m.Timeout)
+		i = encodeVarintBatch(dAtA, i, uint64(len(m.Timeout)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.LogicContractAddress) > 0 {
+		i -= len(m.LogicContractAddress)
+		copy(dAtA[i:], m.LogicContractAddress)
+		i = encodeVarintBatch(dAtA, i, uint64(len(m.LogicContractAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.LogicCallInvalidationNonce) > 0 {
+		i -= len(m.LogicCallInvalidationNonce)
+		copy(dAtA[i:], m.LogicCallInvalidationNonce)
+		i = encodeVarintBatch(dAtA, i, uint64(len(m.LogicCallInvalidationNonce)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.LogicCallInvalidationId) > 0 {
+		i -= len(m.LogicCallInvalidationId)
+		copy(dAtA[i:], m.LogicCallInvalidationId)
+		i = encodeVarintBatch(dAtA, i, uint64(len(m.LogicCallInvalidationId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BridgeChainId) > 0 {
+		i -= len(m.BridgeChainId)
+		copy(dAtA[i:], m.BridgeChainId)
+		i = encodeVarintBatch(dAtA, i, uint64(len(m.BridgeChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BridgeContract) > 0 {
+		i -= len(m.BridgeContract)
+		copy(dAtA[i:], m.BridgeContract)
+		i = encodeVarintBatch(dAtA, i, uint64(len(m.BridgeContract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBatch(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBatch(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *OutgoingTxBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BatchNonce != 0 {
+		n += 1 + sovBatch(uint64(m.BatchNonce))
+	}
+	if m.BatchTimeout != 0 {
+		n += 1 + sovBatch(uint64(m.BatchTimeout))
+	}
+	if len(m.Transactions) > 0 {
 		for _, e := range m.Transactions {
 			l = e.Size()
 			n += 1 + l + sovBatch(uint64(l))
@@ -838,6 +1364,13 @@ func (m *OutgoingTransferTx) Size() (n int) {
 	n += 1 + l + sovBatch(uint64(l))
 	l = m.Erc20Fee.Size()
 	n += 1 + l + sovBatch(uint64(l))
+	if m.CosmosBlockCreated != 0 {
+		n += 1 + sovBatch(uint64(m.CosmosBlockCreated))
+	}
+	if m.ExtraFee != nil {
+		l = m.ExtraFee.Size()
+		n += 1 + l + sovBatch(uint64(l))
+	}
 	return n
 }
 
@@ -880,6 +1413,9 @@ func (m *OutgoingLogicCall) Size() (n int) {
 	if m.CosmosBlockCreated != 0 {
 		n += 1 + sovBatch(uint64(m.CosmosBlockCreated))
 	}
+	if m.Funded {
+		n += 2
+	}
 	return n
 }
 
@@ -908,6 +1444,72 @@ func (m *EventOutgoingBatchCanceled) Size() (n int) {
 	return n
 }
 
+func (m *EventBatchExtraFeesPaid) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BridgeContract)
+	if l > 0 {
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	l = len(m.BridgeChainId)
+	if l > 0 {
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	l = len(m.Nonce)
+	if l > 0 {
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	l = len(m.Relayer)
+	if l > 0 {
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	l = len(m.Fees)
+	if l > 0 {
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	return n
+}
+
+func (m *EventBatchCancellationRequested) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	l = len(m.BridgeContract)
+	if l > 0 {
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	l = len(m.BridgeChainId)
+	if l > 0 {
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	l = len(m.Nonce)
+	if l > 0 {
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	return n
+}
+
 func (m *EventOutgoingBatch) Size() (n int) {
 	if m == nil {
 		return 0
@@ -933,6 +1535,39 @@ func (m *EventOutgoingBatch) Size() (n int) {
 	return n
 }
 
+func (m *EventOutgoingLogicCall) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BridgeContract)
+	if l > 0 {
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	l = len(m.BridgeChainId)
+	if l > 0 {
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	l = len(m.LogicCallInvalidationId)
+	if l > 0 {
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	l = len(m.LogicCallInvalidationNonce)
+	if l > 0 {
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	l = len(m.LogicContractAddress)
+	if l > 0 {
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	l = len(m.Timeout)
+	if l > 0 {
+		n += 1 + l + sovBatch(uint64(l))
+	}
+	return n
+}
+
 func sovBatch(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1160,9 +1795,813 @@ func (m *OutgoingTransferTx) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Token", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Erc20Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Erc20Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosBlockCreated", wireType)
+			}
+			m.CosmosBlockCreated = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CosmosBlockCreated |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtraFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExtraFee == nil {
+				m.ExtraFee = &types.Coin{}
+			}
+			if err := m.ExtraFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBatch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OutgoingLogicCall) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBatch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OutgoingLogicCall: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OutgoingLogicCall: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transfers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transfers = append(m.Transfers, ERC20Token{})
+			if err := m.Transfers[len(m.Transfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fees = append(m.Fees, ERC20Token{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogicContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LogicContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			m.Timeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InvalidationId = append(m.InvalidationId[:0], dAtA[iNdEx:postIndex]...)
+			if m.InvalidationId == nil {
+				m.InvalidationId = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationNonce", wireType)
+			}
+			m.InvalidationNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InvalidationNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosBlockCreated", wireType)
+			}
+			m.CosmosBlockCreated = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CosmosBlockCreated |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Funded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Funded = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBatch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventOutgoingBatchCanceled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBatch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventOutgoingBatchCanceled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventOutgoingBatchCanceled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BatchId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nonce = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBatch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventBatchExtraFeesPaid) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBatch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBatchExtraFeesPaid: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBatchExtraFeesPaid: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1190,11 +2629,11 @@ func (m *OutgoingTransferTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Nonce = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1222,13 +2661,13 @@ func (m *OutgoingTransferTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DestAddress = string(dAtA[iNdEx:postIndex])
+			m.Relayer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Token", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBatch
@@ -1238,30 +2677,29 @@ func (m *OutgoingTransferTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthBatch
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBatch
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Erc20Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Fee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBatch
@@ -1271,24 +2709,23 @@ func (m *OutgoingTransferTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthBatch
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBatch
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Erc20Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Fees = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1311,7 +2748,7 @@ func (m *OutgoingTransferTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OutgoingLogicCall) Unmarshal(dAtA []byte) error {
+func (m *EventBatchCancellationRequested) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1334,17 +2771,17 @@ func (m *OutgoingLogicCall) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OutgoingLogicCall: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventBatchCancellationRequested: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OutgoingLogicCall: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventBatchCancellationRequested: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Transfers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBatch
@@ -1354,31 +2791,29 @@ func (m *OutgoingLogicCall) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthBatch
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBatch
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Transfers = append(m.Transfers, ERC20Token{})
-			if err := m.Transfers[len(m.Transfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeContract", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBatch
@@ -1388,29 +2823,27 @@ func (m *OutgoingLogicCall) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthBatch
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBatch
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fees = append(m.Fees, ERC20Token{})
-			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.BridgeContract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogicContractAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1438,13 +2871,13 @@ func (m *OutgoingLogicCall) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LogicContractAddress = string(dAtA[iNdEx:postIndex])
+			m.BridgeChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBatch
@@ -1454,50 +2887,29 @@ func (m *OutgoingLogicCall) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthBatch
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBatch
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
-			if m.Payload == nil {
-				m.Payload = []byte{}
-			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
-			}
-			m.Timeout = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBatch
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Timeout |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBatch
@@ -1507,64 +2919,24 @@ func (m *OutgoingLogicCall) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthBatch
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBatch
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InvalidationId = append(m.InvalidationId[:0], dAtA[iNdEx:postIndex]...)
-			if m.InvalidationId == nil {
-				m.InvalidationId = []byte{}
-			}
+			m.Nonce = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationNonce", wireType)
-			}
-			m.InvalidationNonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBatch
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.InvalidationNonce |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CosmosBlockCreated", wireType)
-			}
-			m.CosmosBlockCreated = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBatch
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CosmosBlockCreated |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBatch(dAtA[iNdEx:])
@@ -1586,7 +2958,7 @@ func (m *OutgoingLogicCall) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventOutgoingBatchCanceled) Unmarshal(dAtA []byte) error {
+func (m *EventOutgoingBatch) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1609,10 +2981,10 @@ func (m *EventOutgoingBatchCanceled) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventOutgoingBatchCanceled: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventOutgoingBatch: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventOutgoingBatchCanceled: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventOutgoingBatch: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1764,7 +3136,7 @@ func (m *EventOutgoingBatchCanceled) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventOutgoingBatch) Unmarshal(dAtA []byte) error {
+func (m *EventOutgoingLogicCall) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1787,10 +3159,10 @@ func (m *EventOutgoingBatch) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventOutgoingBatch: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventOutgoingLogicCall: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventOutgoingBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventOutgoingLogicCall: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1859,7 +3231,7 @@ func (m *EventOutgoingBatch) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LogicCallInvalidationId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1887,11 +3259,11 @@ func (m *EventOutgoingBatch) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BatchId = string(dAtA[iNdEx:postIndex])
+			m.LogicCallInvalidationId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LogicCallInvalidationNonce", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1919,7 +3291,71 @@ func (m *EventOutgoingBatch) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Nonce = string(dAtA[iNdEx:postIndex])
+			m.LogicCallInvalidationNonce = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogicContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LogicContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBatch
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Timeout = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
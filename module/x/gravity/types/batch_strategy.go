@@ -0,0 +1,65 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ValidateBasic performs stateless checks on a TokenBatchStrategy
+func (s TokenBatchStrategy) ValidateBasic() error {
+	if len(strings.TrimSpace(s.EvmChainPrefix)) == 0 {
+		return fmt.Errorf("evm chain prefix cannot be empty")
+	}
+	if _, err := NewEthAddress(s.TokenContract); err != nil {
+		return fmt.Errorf("invalid token contract: %v", err)
+	}
+	if _, ok := BatchStrategy_name[int32(s.Strategy)]; !ok {
+		return fmt.Errorf("unknown batch strategy %d", s.Strategy)
+	}
+	if s.Strategy == BATCH_STRATEGY_UNSPECIFIED && s.MaxBatchSize == 0 && !s.IsAutoBatched() {
+		return fmt.Errorf("batch strategy neither selects a strategy, a max batch size nor an auto batch threshold")
+	}
+	if s.MaxWaitBlocks != 0 && s.Strategy != BATCH_STRATEGY_FIFO {
+		return fmt.Errorf("max wait blocks is only used by %s", BATCH_STRATEGY_FIFO)
+	}
+	if s.Strategy == BATCH_STRATEGY_FIFO && s.MaxWaitBlocks == 0 {
+		return fmt.Errorf("%s requires positive max wait blocks", BATCH_STRATEGY_FIFO)
+	}
+	minFee := s.EffectiveMinFeePerTx()
+	if minFee.IsNegative() {
+		return fmt.Errorf("invalid min fee per tx %v", minFee)
+	}
+	if s.Strategy == BATCH_STRATEGY_MIN_FEE && minFee.IsZero() {
+		return fmt.Errorf("%s requires a positive min fee per tx", BATCH_STRATEGY_MIN_FEE)
+	}
+	if s.Strategy != BATCH_STRATEGY_MIN_FEE && !minFee.IsZero() {
+		return fmt.Errorf("min fee per tx is only used by %s", BATCH_STRATEGY_MIN_FEE)
+	}
+	if autoMinFees := s.EffectiveAutoBatchMinFees(); autoMinFees.IsNegative() {
+		return fmt.Errorf("invalid auto batch min fees %v", autoMinFees)
+	}
+	return nil
+}
+
+// IsAutoBatched returns true if the EndBlocker creates the batches of the token once a threshold is met
+func (s TokenBatchStrategy) IsAutoBatched() bool {
+	return s.EffectiveAutoBatchMinFees().IsPositive() || s.AutoBatchMaxAge != 0
+}
+
+// EffectiveAutoBatchMinFees returns the auto batch fee threshold, treating an unset value as zero
+func (s TokenBatchStrategy) EffectiveAutoBatchMinFees() sdk.Int {
+	if s.AutoBatchMinFees.IsNil() {
+		return sdk.ZeroInt()
+	}
+	return s.AutoBatchMinFees
+}
+
+// EffectiveMinFeePerTx returns the min fee per tx, treating an unset value as zero
+func (s TokenBatchStrategy) EffectiveMinFeePerTx() sdk.Int {
+	if s.MinFeePerTx.IsNil() {
+		return sdk.ZeroInt()
+	}
+	return s.MinFeePerTx
+}
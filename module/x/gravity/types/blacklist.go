@@ -0,0 +1,81 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+)
+
+const (
+	// blacklistEvmAddress marks the blacklist key of an evm address
+	blacklistEvmAddress byte = 0x01
+	// blacklistCosmosAddress marks the blacklist key of the account bytes of a bech32 address
+	blacklistCosmosAddress byte = 0x02
+)
+
+// BlacklistAddressBytes returns the bytes an address is indexed by on the blacklist: the 20 bytes of an evm address or
+// the account bytes of a bech32 address, whatever its prefix, each marked with the kind of address. Evm addresses are
+// recognised by their 0x prefix
+func BlacklistAddressBytes(address string) ([]byte, error) {
+	if strings.HasPrefix(address, "0x") {
+		ethAddr, err := NewEthAddress(address)
+		if err != nil {
+			return nil, err
+		}
+		return append([]byte{blacklistEvmAddress}, ethAddr.GetAddress().Bytes()...), nil
+	}
+	_, bz, err := bech32.DecodeAndConvert(address)
+	if err != nil {
+		return nil, fmt.Errorf("%s is neither an evm nor a bech32 address: %v", address, err)
+	}
+	if len(bz) == 0 {
+		return nil, fmt.Errorf("%s has empty account bytes", address)
+	}
+	return append([]byte{blacklistCosmosAddress}, bz...), nil
+}
+
+// BlacklistCosmosAddressBytes returns the bytes the account `addr` is indexed by on the blacklist
+func BlacklistCosmosAddressBytes(addr []byte) []byte {
+	return append([]byte{blacklistCosmosAddress}, addr...)
+}
+
+// BlacklistEvmAddressBytes returns the bytes the evm address `addr` is indexed by on the blacklist
+func BlacklistEvmAddressBytes(addr EthAddress) []byte {
+	return append([]byte{blacklistEvmAddress}, addr.GetAddress().Bytes()...)
+}
+
+// IsExpired returns true once the entry no longer applies at `height`
+func (e BlacklistEntry) IsExpired(height uint64) bool {
+	return e.ExpiryBlockHeight != 0 && height >= e.ExpiryBlockHeight
+}
+
+// ValidateBasic performs stateless checks on a BlacklistEntry
+func (e BlacklistEntry) ValidateBasic() error {
+	if len(strings.TrimSpace(e.EvmChainPrefix)) == 0 {
+		return fmt.Errorf("evm chain prefix cannot be empty")
+	}
+	if _, err := BlacklistAddressBytes(e.Address); err != nil {
+		return fmt.Errorf("invalid blacklisted address: %v", err)
+	}
+	return nil
+}
+
+// validateBlacklistAddresses checks that the addresses of a blacklist proposal are valid and appear only once
+func validateBlacklistAddresses(addresses []string) error {
+	if len(addresses) == 0 {
+		return fmt.Errorf("no addresses given")
+	}
+	seen := make(map[string]bool, len(addresses))
+	for _, address := range addresses {
+		bz, err := BlacklistAddressBytes(address)
+		if err != nil {
+			return err
+		}
+		if seen[string(bz)] {
+			return fmt.Errorf("duplicate address %s", address)
+		}
+		seen[string(bz)] = true
+	}
+	return nil
+}
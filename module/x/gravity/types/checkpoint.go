@@ -0,0 +1,100 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// The kinds of EthereumSigned subjects whose checkpoints orchestrators sign
+const (
+	CheckpointKindValset    = "valset"
+	CheckpointKindBatch     = "batch"
+	CheckpointKindLogicCall = "logic-call"
+)
+
+// NewCheckpointSubject returns an empty EthereumSigned of the given kind, ready to be unmarshalled into
+func NewCheckpointSubject(kind string) (EthereumSigned, error) {
+	switch kind {
+	case CheckpointKindValset:
+		return &Valset{}, nil
+	case CheckpointKindBatch:
+		return &OutgoingTxBatch{}, nil
+	case CheckpointKindLogicCall:
+		return &OutgoingLogicCall{}, nil
+	default:
+		return nil, sdkerrors.Wrapf(ErrInvalid, "unknown checkpoint kind %s, expected %s, %s or %s",
+			kind, CheckpointKindValset, CheckpointKindBatch, CheckpointKindLogicCall)
+	}
+}
+
+// ValidateCheckpointSubject checks a subject unmarshalled into the EthereumSigned of NewCheckpointSubject, so that
+// its checkpoint may be computed without panicking
+func ValidateCheckpointSubject(subject EthereumSigned) error {
+	switch s := subject.(type) {
+	case *Valset:
+		return s.ValidateBasic()
+	case *OutgoingTxBatch:
+		batch, err := s.ToInternal()
+		if err != nil {
+			return err
+		}
+		return batch.ValidateBasic()
+	case *OutgoingLogicCall:
+		return s.ValidateBasic()
+	default:
+		return sdkerrors.Wrapf(ErrInvalid, "unknown checkpoint subject %T", subject)
+	}
+}
+
+// NewQueryCheckpointResponse computes the abi encoding and checkpoint of the subject under the given gravity id,
+// without any confirm
+func NewQueryCheckpointResponse(gravityID string, subject EthereumSigned) QueryCheckpointResponse {
+	return QueryCheckpointResponse{
+		GravityId:  gravityID,
+		AbiEncoded: hex.EncodeToString(subject.GetCheckpointABIEncoding(gravityID)),
+		Checkpoint: hex.EncodeToString(subject.GetCheckpoint(gravityID)),
+		Confirms:   []CheckpointConfirm{},
+	}
+}
+
+// NewCheckpointConfirm recovers the signer of a hex encoded confirm signature over the checkpoint and compares it to
+// the delegate ethereum key of the orchestrator's validator, nil if the validator has none
+func NewCheckpointConfirm(checkpoint []byte, orchestrator string, ethSigner string, signature string, delegate *EthAddress) CheckpointConfirm {
+	confirm := CheckpointConfirm{
+		Orchestrator:       orchestrator,
+		EthSigner:          ethSigner,
+		Signature:          signature,
+		RecoveredSigner:    "",
+		DelegateEthAddress: "",
+		Valid:              false,
+		Error:              "",
+	}
+	if delegate != nil {
+		confirm.DelegateEthAddress = delegate.GetAddress().Hex()
+	}
+
+	sigBytes, err := hex.DecodeString(strings.TrimPrefix(signature, "0x"))
+	if err != nil {
+		confirm.Error = fmt.Sprintf("signature decoding: %v", err)
+		return confirm
+	}
+	recovered, err := EthAddressFromSignature(checkpoint, sigBytes)
+	if err != nil {
+		confirm.Error = fmt.Sprintf("signer recovery: %v", err)
+		return confirm
+	}
+	confirm.RecoveredSigner = recovered.GetAddress().Hex()
+
+	switch {
+	case delegate == nil:
+		confirm.Error = "no delegate ethereum key registered for the orchestrator"
+	case recovered.GetAddress() != delegate.GetAddress():
+		confirm.Error = fmt.Sprintf("signed by %s instead of the delegate ethereum key %s", confirm.RecoveredSigner, confirm.DelegateEthAddress)
+	default:
+		confirm.Valid = true
+	}
+	return confirm
+}
@@ -31,7 +31,10 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgLogicCallExecutedClaim{},
 		&MsgValsetUpdatedClaim{},
 		&MsgCancelSendToEth{},
+		&MsgIncreaseBridgeFee{},
+		&MsgRequestBatchCancellation{},
 		&MsgSubmitBadSignatureEvidence{},
+		&MsgSubmitDoubleSignEvidence{},
 	)
 
 	registry.RegisterInterface(
@@ -44,7 +47,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgValsetUpdatedClaim{},
 	)
 
-	registry.RegisterImplementations((*govtypes.Content)(nil), &UnhaltBridgeProposal{}, &AirdropProposal{}, &IBCMetadataProposal{}, &AddEvmChainProposal{}, &RemoveEvmChainProposal{}, &MonitoredERC20TokensProposal{})
+	registry.RegisterImplementations((*govtypes.Content)(nil), &UnhaltBridgeProposal{}, &AirdropProposal{}, &IBCMetadataProposal{}, &AddEvmChainProposal{}, &RemoveEvmChainProposal{}, &MonitoredERC20TokensProposal{}, &OutgoingLogicCallProposal{}, &SetRateLimitProposal{}, &ReleaseHeldSendToCosmosProposal{}, &SetIbcBridgeFeeProposal{}, &ResolveFailedAttestationProposal{}, &AddBlacklistProposal{}, &RemoveBlacklistProposal{}, &SetBatchStrategyProposal{})
 
 	registry.RegisterInterface("gravity.v1beta1.EthereumSigned", (*EthereumSigned)(nil), &Valset{}, &OutgoingTxBatch{}, &OutgoingLogicCall{})
 
@@ -69,9 +72,12 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgValsetUpdatedClaim{}, "gravity/MsgValsetUpdatedClaim", nil)
 	cdc.RegisterConcrete(&OutgoingTxBatch{}, "gravity/OutgoingTxBatch", nil)
 	cdc.RegisterConcrete(&MsgCancelSendToEth{}, "gravity/MsgCancelSendToEth", nil)
+	cdc.RegisterConcrete(&MsgIncreaseBridgeFee{}, "gravity/MsgIncreaseBridgeFee", nil)
+	cdc.RegisterConcrete(&MsgRequestBatchCancellation{}, "gravity/MsgRequestBatchCancellation", nil)
 	cdc.RegisterConcrete(&OutgoingTransferTx{}, "gravity/OutgoingTransferTx", nil)
 	cdc.RegisterConcrete(&ERC20Token{}, "gravity/ERC20Token", nil)
 	cdc.RegisterConcrete(&IDSet{}, "gravity/IDSet", nil)
 	cdc.RegisterConcrete(&Attestation{}, "gravity/Attestation", nil)
 	cdc.RegisterConcrete(&MsgSubmitBadSignatureEvidence{}, "gravity/MsgSubmitBadSignatureEvidence", nil)
+	cdc.RegisterConcrete(&MsgSubmitDoubleSignEvidence{}, "gravity/MsgSubmitDoubleSignEvidence", nil)
 }
@@ -5,11 +5,12 @@ import "fmt"
 // NewBridgeBalanceSnapshot constructs a BridgeBalanceSnapshot conveniently from the Cosmos block height,
 // an attestation with Ethereum block height, and a list of monitored tokens with their balances
 func NewBridgeBalanceSnapshot(
-	cosmosHeight uint64, ethBlockHeight uint64, monitoredBalances []*ERC20Token, eventNonce uint64,
+	cosmosHeight uint64, ethBlockHeight uint64, evmChainPrefix string, monitoredBalances []*ERC20Token, eventNonce uint64,
 ) BridgeBalanceSnapshot {
 	return BridgeBalanceSnapshot{
 		CosmosBlockHeight:   cosmosHeight,
 		EthereumBlockHeight: ethBlockHeight,
+		EvmChainPrefix:      evmChainPrefix,
 		Balances:            monitoredBalances,
 		EventNonce:          eventNonce,
 	}
@@ -25,6 +26,9 @@ func (m BridgeBalanceSnapshot) ValidateBasic() error {
 	if m.EthereumBlockHeight == 0 {
 		return fmt.Errorf("bridge balance snapshot has a zero ethereum height")
 	}
+	if m.EvmChainPrefix == "" {
+		return fmt.Errorf("bridge balance snapshot has an empty evm chain prefix")
+	}
 	if len(m.Balances) == 0 {
 		return fmt.Errorf("bridge balance snapshot has no balances")
 	}
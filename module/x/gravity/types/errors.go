@@ -26,4 +26,7 @@ var (
 	ErrInvalidClaim             = sdkerrors.Register(ModuleName, 19, "invalid claim submitted")
 	ErrInvalidLogicCall         = sdkerrors.Register(ModuleName, 20, "invalid logic call submitted")
 	ErrEvmChainNotFound         = sdkerrors.Register(ModuleName, 21, "EVM Chain not found")
+	ErrRateLimitExceeded        = sdkerrors.Register(ModuleName, 22, "rate limit exceeded")
+	ErrEvmChainDraining         = sdkerrors.Register(ModuleName, 23, "EVM Chain is being decommissioned")
+	ErrBlacklisted              = sdkerrors.Register(ModuleName, 24, "address is blacklisted")
 )
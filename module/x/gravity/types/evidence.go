@@ -0,0 +1,99 @@
+package types
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// The kinds of evidence of misbehaving ethereum signatures
+const (
+	// EvidenceKindBadSignature is a signature over a checkpoint which was never requested by the chain
+	EvidenceKindBadSignature = "bad-signature"
+	// EvidenceKindDoubleSign is a pair of signatures over different checkpoints at the same nonce
+	EvidenceKindDoubleSign = "double-sign"
+)
+
+// EvidenceID returns the id of the evidence of the given kind against the signer of the checkpoints, the keccak256
+// hash of the kind, the signer and the sorted checkpoints. The signatures themselves are left out since a signature
+// can be altered without changing its signer, the same misbehaviour is always recorded under the same id
+func EvidenceID(kind string, signer EthAddress, checkpoints ...[]byte) []byte {
+	sorted := make([][]byte, len(checkpoints))
+	copy(sorted, checkpoints)
+	sort.Slice(sorted, func(i, j int) bool { return bytes.Compare(sorted[i], sorted[j]) < 0 })
+	return crypto.Keccak256(append([][]byte{[]byte(kind), signer.GetAddress().Bytes()}, sorted...)...)
+}
+
+// SameSigningSlot returns an error unless both subjects are of the same kind and nonce, so that an honest validator
+// would never sign both of them: valsets of the same nonce, batches of the same token contract and nonce, or logic
+// calls of the same invalidation id and nonce
+func SameSigningSlot(a EthereumSigned, b EthereumSigned) error {
+	switch a := a.(type) {
+	case *Valset:
+		if b, ok := b.(*Valset); ok && a.Nonce == b.Nonce {
+			return nil
+		}
+	case *OutgoingTxBatch:
+		if b, ok := b.(*OutgoingTxBatch); ok && a.BatchNonce == b.BatchNonce &&
+			strings.EqualFold(a.TokenContract, b.TokenContract) {
+			return nil
+		}
+	case *OutgoingLogicCall:
+		if b, ok := b.(*OutgoingLogicCall); ok && a.InvalidationNonce == b.InvalidationNonce &&
+			bytes.Equal(a.InvalidationId, b.InvalidationId) {
+			return nil
+		}
+	default:
+		return sdkerrors.Wrapf(ErrInvalid, "evidence must be over a batch, valset, or logic call got %T", a)
+	}
+	return sdkerrors.Wrapf(ErrInvalid, "%T and %T are not of the same kind and nonce", a, b)
+}
+
+// ValidateBasic performs stateless checks on an EvidenceRecord
+func (r EvidenceRecord) ValidateBasic() error {
+	if id, err := hex.DecodeString(r.Id); err != nil || len(id) != 32 {
+		return fmt.Errorf("invalid evidence id %s", r.Id)
+	}
+	checkpoints := 0
+	switch r.Kind {
+	case EvidenceKindBadSignature:
+		checkpoints = 1
+	case EvidenceKindDoubleSign:
+		checkpoints = 2
+	default:
+		return fmt.Errorf("unknown evidence kind %s", r.Kind)
+	}
+	if len(r.Checkpoints) != checkpoints {
+		return fmt.Errorf("%s evidence with %d checkpoints", r.Kind, len(r.Checkpoints))
+	}
+	for _, checkpoint := range r.Checkpoints {
+		if bz, err := hex.DecodeString(checkpoint); err != nil || len(bz) != 32 {
+			return fmt.Errorf("invalid checkpoint %s", checkpoint)
+		}
+	}
+	if len(strings.TrimSpace(r.EvmChainPrefix)) == 0 {
+		return fmt.Errorf("evm chain prefix cannot be empty")
+	}
+	if _, err := sdk.ValAddressFromBech32(r.Offender); err != nil {
+		return fmt.Errorf("invalid offender: %v", err)
+	}
+	if err := ValidateEthAddress(r.OffenderEthAddress); err != nil {
+		return fmt.Errorf("invalid offender eth address: %v", err)
+	}
+	if err := r.SlashAmount.Validate(); err != nil {
+		return fmt.Errorf("invalid slash amount: %v", err)
+	}
+	if err := r.ReporterReward.Validate(); err != nil {
+		return fmt.Errorf("invalid reporter reward: %v", err)
+	}
+	if r.ReporterReward.Denom != r.SlashAmount.Denom || r.ReporterReward.Amount.GT(r.SlashAmount.Amount) {
+		return fmt.Errorf("reporter reward %s exceeds the slash amount %s", r.ReporterReward, r.SlashAmount)
+	}
+	return nil
+}
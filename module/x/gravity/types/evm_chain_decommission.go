@@ -0,0 +1,45 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// IsDraining returns true while the chain rejects new withdrawals and batches and waits to be removed
+func (d EvmChainDecommission) IsDraining() bool {
+	return d.Status == EVM_CHAIN_DECOMMISSION_STATUS_DRAINING
+}
+
+// ValidateBasic performs stateless checks on an EvmChainDecommission
+func (d EvmChainDecommission) ValidateBasic() error {
+	if len(strings.TrimSpace(d.EvmChainPrefix)) == 0 {
+		return fmt.Errorf("evm chain prefix cannot be empty")
+	}
+	switch d.Status {
+	case EVM_CHAIN_DECOMMISSION_STATUS_DRAINING:
+		if d.RemovalBlockHeight != 0 {
+			return fmt.Errorf("draining evm chain %s has a removal height", d.EvmChainPrefix)
+		}
+	case EVM_CHAIN_DECOMMISSION_STATUS_REMOVED:
+		if d.RemovalBlockHeight < d.DeadlineBlockHeight {
+			return fmt.Errorf("evm chain %s was removed at %d before its deadline %d",
+				d.EvmChainPrefix, d.RemovalBlockHeight, d.DeadlineBlockHeight)
+		}
+	default:
+		return fmt.Errorf("evm chain decommission has an invalid status %v", d.Status)
+	}
+	if d.DeadlineBlockHeight < d.StartBlockHeight {
+		return fmt.Errorf("evm chain %s has a deadline %d before its start %d",
+			d.EvmChainPrefix, d.DeadlineBlockHeight, d.StartBlockHeight)
+	}
+	if err := d.RefundedAmounts.Validate(); err != nil {
+		return fmt.Errorf("invalid refunded amounts: %v", err)
+	}
+	if err := d.EscrowedBalances.Validate(); err != nil {
+		return fmt.Errorf("invalid escrowed balances: %v", err)
+	}
+	if err := d.OutstandingVouchers.Validate(); err != nil {
+		return fmt.Errorf("invalid outstanding vouchers: %v", err)
+	}
+	return nil
+}
@@ -15,12 +15,36 @@ import (
 const (
 	// todo: implement oracle constants as params
 	DefaultParamspace = ModuleName + "v2"
+
+	// MaxIbcAutoForwardsPerBlock bounds the number of ibc transfers a single evm chain may send from EndBlocker
+	MaxIbcAutoForwardsPerBlock uint64 = 100
+
+	// MaxHeldSendToCosmosReleasesPerBlock bounds the number of held deposits a single evm chain may release from
+	// EndBlocker, the rest wait for the following blocks
+	MaxHeldSendToCosmosReleasesPerBlock uint64 = 100
+
+	// MinAttestationVotesPowerThreshold is the lowest attestation threshold an evm chain may set, so that an observed
+	// event always has the support of a majority of the power
+	MinAttestationVotesPowerThreshold uint64 = 51
+
+	// MinAttestationDelegatedPowerShare is the lowest percentage of the total bonded power an evm chain counting
+	// delegated power only computes its attestation threshold over, so that a handful of validators with delegate keys
+	// can never observe events on their own
+	MinAttestationDelegatedPowerShare int64 = 50
 )
 
 var (
-	// AttestationVotesPowerThreshold threshold of votes power to succeed
+	// AttestationVotesPowerThreshold threshold of votes power to succeed, for evm chains which do not set their own
 	AttestationVotesPowerThreshold = sdk.NewInt(66)
 
+	// DefaultValsetPowerDiffThreshold is the power difference which triggers a valset request, for evm chains which
+	// do not set their own
+	DefaultValsetPowerDiffThreshold = sdk.NewDecWithPrec(5, 2)
+
+	// MaxEvidenceReporterReward is the largest fraction of the slashed self-delegation an evm chain may pay to the
+	// reporter of evidence, the reward is minted so it must stay well below the slashed amount
+	MaxEvidenceReporterReward = sdk.NewDecWithPrec(1, 1)
+
 	// ParamsStoreKeySignedValsetsWindow stores the signed blocks window
 	ParamsStoreKeySignedValsetsWindow = []byte("SignedValsetsWindow")
 
@@ -96,6 +120,16 @@ var (
 				AverageEthereumBlockTime: 0,
 				BridgeActive:             true,
 				EthereumBlacklist:        []string{},
+
+				SignedValsetsWindow:         0,
+				SignedBatchesWindow:         0,
+				SignedLogicCallsWindow:      0,
+				UnbondSlashingValsetsWindow: 0,
+				SlashFractionValset:         sdk.ZeroDec(),
+				SlashFractionBatch:          sdk.ZeroDec(),
+				SlashFractionLogicCall:      sdk.ZeroDec(),
+				ValsetPowerDiffThreshold:    sdk.ZeroDec(),
+				EvidenceReporterReward:      sdk.ZeroDec(),
 			},
 		},
 	}
@@ -107,6 +141,11 @@ func (s GenesisState) ValidateBasic() error {
 	if err := s.Params.ValidateBasic(); err != nil {
 		return sdkerrors.Wrap(err, "params")
 	}
+	for _, decommission := range s.EvmChainDecommissions {
+		if err := decommission.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "evm chain decommissions")
+		}
+	}
 	return nil
 }
 
@@ -114,26 +153,39 @@ func (s GenesisState) ValidateBasic() error {
 // nolint: exhaustruct
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params:    DefaultParams(),
-		EvmChains: []EvmChainData{},
+		Params:                DefaultParams(),
+		EvmChains:             []EvmChainData{},
+		EvmChainDecommissions: []EvmChainDecommission{},
 	}
 }
 
 func DefaultEvmChains() []EvmChainData {
 	return []EvmChainData{
 		{
-			EvmChain:           EvmChain{EvmChainPrefix: "gravity", EvmChainName: "gravity"},
-			GravityNonces:      GravityNonces{},
-			Valsets:            []Valset{},
-			ValsetConfirms:     []MsgValsetConfirm{},
-			Batches:            []OutgoingTxBatch{},
-			BatchConfirms:      []MsgConfirmBatch{},
-			LogicCalls:         []OutgoingLogicCall{},
-			LogicCallConfirms:  []MsgConfirmLogicCall{},
-			Attestations:       []Attestation{},
-			DelegateKeys:       []MsgSetOrchestratorAddress{},
-			Erc20ToDenoms:      []ERC20ToDenom{},
-			UnbatchedTransfers: []OutgoingTransferTx{},
+			EvmChain:                EvmChain{EvmChainPrefix: "gravity", EvmChainName: "gravity"},
+			GravityNonces:           GravityNonces{},
+			Valsets:                 []Valset{},
+			ValsetConfirms:          []MsgValsetConfirm{},
+			Batches:                 []OutgoingTxBatch{},
+			BatchConfirms:           []MsgConfirmBatch{},
+			LogicCalls:              []OutgoingLogicCall{},
+			LogicCallConfirms:       []MsgConfirmLogicCall{},
+			Attestations:            []Attestation{},
+			DelegateKeys:            []MsgSetOrchestratorAddress{},
+			Erc20ToDenoms:           []ERC20ToDenom{},
+			UnbatchedTransfers:      []OutgoingTransferTx{},
+			RateLimits:              []RateLimit{},
+			HeldSendToCosmos:        []HeldSendToCosmos{},
+			RateLimitFlows:          []RateLimitFlow{},
+			IbcBridgeFees:           []IbcBridgeFee{},
+			IbcTransferOrigins:      []IbcTransferOrigin{},
+			IbcAutoForwardLogs:      []IbcAutoForwardLog{},
+			IbcAutoForwardTransfers: []IbcAutoForwardTransfer{},
+			FailedAttestations:      []FailedAttestation{},
+			Blacklist:               []BlacklistEntry{},
+			BatchStrategies:         []TokenBatchStrategy{},
+			BridgedSupply:           []ERC20Token{},
+			EvidenceRecords:         []EvidenceRecord{},
 		},
 	}
 }
@@ -165,6 +217,16 @@ func DefaultParams() *Params {
 				AverageEthereumBlockTime: 15000,
 				BridgeActive:             true,
 				EthereumBlacklist:        []string{},
+
+				SignedValsetsWindow:         0,
+				SignedBatchesWindow:         0,
+				SignedLogicCallsWindow:      0,
+				UnbondSlashingValsetsWindow: 0,
+				SlashFractionValset:         sdk.ZeroDec(),
+				SlashFractionBatch:          sdk.ZeroDec(),
+				SlashFractionLogicCall:      sdk.ZeroDec(),
+				ValsetPowerDiffThreshold:    sdk.ZeroDec(),
+				EvidenceReporterReward:      sdk.ZeroDec(),
 			},
 		},
 	}
@@ -180,6 +242,45 @@ func (p *Params) GetEvmChain(evmChainPrefix string) *EvmChainParam {
 	return nil
 }
 
+// EffectiveSlashingParams returns a copy of the given evm chain param where every slashing window or fraction
+// left unset (zero) on the chain is replaced by the module wide value of the same name
+func (p *Params) EffectiveSlashingParams(evmChainParam EvmChainParam) EvmChainParam {
+	if evmChainParam.SignedValsetsWindow == 0 {
+		evmChainParam.SignedValsetsWindow = p.SignedValsetsWindow
+	}
+	if evmChainParam.SignedBatchesWindow == 0 {
+		evmChainParam.SignedBatchesWindow = p.SignedBatchesWindow
+	}
+	if evmChainParam.SignedLogicCallsWindow == 0 {
+		evmChainParam.SignedLogicCallsWindow = p.SignedLogicCallsWindow
+	}
+	if evmChainParam.UnbondSlashingValsetsWindow == 0 {
+		evmChainParam.UnbondSlashingValsetsWindow = p.UnbondSlashingValsetsWindow
+	}
+	if evmChainParam.SlashFractionValset.IsNil() || evmChainParam.SlashFractionValset.IsZero() {
+		evmChainParam.SlashFractionValset = p.SlashFractionValset
+	}
+	if evmChainParam.SlashFractionBatch.IsNil() || evmChainParam.SlashFractionBatch.IsZero() {
+		evmChainParam.SlashFractionBatch = p.SlashFractionBatch
+	}
+	if evmChainParam.SlashFractionLogicCall.IsNil() || evmChainParam.SlashFractionLogicCall.IsZero() {
+		evmChainParam.SlashFractionLogicCall = p.SlashFractionLogicCall
+	}
+	// the module wide logic call slash fraction is not part of the param set, so may never have been populated
+	for _, fraction := range []*sdk.Dec{&evmChainParam.SlashFractionValset, &evmChainParam.SlashFractionBatch, &evmChainParam.SlashFractionLogicCall} {
+		if fraction.IsNil() {
+			*fraction = sdk.ZeroDec()
+		}
+	}
+	return evmChainParam
+}
+
+// SlashingActive returns whether validators may be slashed and jailed for failing to sign the valsets, batches and
+// logic calls of the evm chain
+func (p EvmChainParam) SlashingActive() bool {
+	return p.BridgeActive && !p.SlashingDisabled
+}
+
 // ValidateBasic checks that the parameters have valid values.
 func (p *EvmChainParam) ValidateBasic() error {
 	if err := validateGravityID(p.GravityId); err != nil {
@@ -203,9 +304,54 @@ func (p *EvmChainParam) ValidateBasic() error {
 	if err := validateEthereumBlacklistAddresses(p.EthereumBlacklist); err != nil {
 		return sdkerrors.Wrap(err, "ethereum blacklist parameter")
 	}
+	if err := validateEvmChainSlashFraction(p.SlashFractionValset); err != nil {
+		return sdkerrors.Wrap(err, "slash fraction valset")
+	}
+	if err := validateEvmChainSlashFraction(p.SlashFractionBatch); err != nil {
+		return sdkerrors.Wrap(err, "slash fraction batch")
+	}
+	if err := validateEvmChainSlashFraction(p.SlashFractionLogicCall); err != nil {
+		return sdkerrors.Wrap(err, "slash fraction logic call")
+	}
+	if err := validateIbcAutoForwardsPerBlock(p.IbcAutoForwardsPerBlock); err != nil {
+		return sdkerrors.Wrap(err, "ibc auto forwards per block")
+	}
+	if err := validateAttestationVotesPowerThreshold(p.AttestationVotesPowerThreshold); err != nil {
+		return sdkerrors.Wrap(err, "attestation votes power threshold")
+	}
+	if err := validateValsetPowerDiffThreshold(p.ValsetPowerDiffThreshold); err != nil {
+		return sdkerrors.Wrap(err, "valset power diff threshold")
+	}
+	if p.MaxValsetInterval != 0 && p.MinValsetInterval > p.MaxValsetInterval {
+		return fmt.Errorf("min valset interval %d exceeds max valset interval %d", p.MinValsetInterval, p.MaxValsetInterval)
+	}
+	if err := validateExtraFeeDenoms(p.ExtraFeeDenoms); err != nil {
+		return sdkerrors.Wrap(err, "extra fee denoms")
+	}
+	if err := validateEvidenceReporterReward(p.EvidenceReporterReward); err != nil {
+		return sdkerrors.Wrap(err, "evidence reporter reward")
+	}
 	return nil
 }
 
+// EffectiveValsetPowerDiffThreshold returns the power difference which triggers a valset request on the evm chain,
+// DefaultValsetPowerDiffThreshold unless the chain sets its own
+func (p EvmChainParam) EffectiveValsetPowerDiffThreshold() sdk.Dec {
+	if p.ValsetPowerDiffThreshold.IsNil() || p.ValsetPowerDiffThreshold.IsZero() {
+		return DefaultValsetPowerDiffThreshold
+	}
+	return p.ValsetPowerDiffThreshold
+}
+
+// EffectiveAttestationVotesPowerThreshold returns the percentage of the power which must attest to an event of the
+// evm chain, AttestationVotesPowerThreshold unless the chain sets its own
+func (p EvmChainParam) EffectiveAttestationVotesPowerThreshold() sdk.Int {
+	if p.AttestationVotesPowerThreshold == 0 {
+		return AttestationVotesPowerThreshold
+	}
+	return sdk.NewIntFromUint64(p.AttestationVotesPowerThreshold)
+}
+
 // ValidateBasic checks that the parameters have valid values.
 func (p *Params) ValidateBasic() error {
 
@@ -401,6 +547,57 @@ func validateSlashFractionValset(i interface{}) error {
 	return nil
 }
 
+// validateEvmChainSlashFraction checks an optional per evm chain slash fraction, which may be left unset
+func validateEvmChainSlashFraction(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() {
+		return nil
+	}
+	if v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("slash fraction must be between 0 and 1: %s", v)
+	}
+	return nil
+}
+
+func validateIbcAutoForwardsPerBlock(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v > MaxIbcAutoForwardsPerBlock {
+		return fmt.Errorf("at most %d ibc auto forwards may be processed per block: %d", MaxIbcAutoForwardsPerBlock, v)
+	}
+	return nil
+}
+
+func validateValsetPowerDiffThreshold(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() {
+		return nil
+	}
+	if v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("power diff threshold must be between 0 and 1: %s", v)
+	}
+	return nil
+}
+
+func validateAttestationVotesPowerThreshold(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v != 0 && (v < MinAttestationVotesPowerThreshold || v > 100) {
+		return fmt.Errorf("threshold must be a percentage between %d and 100: %d", MinAttestationVotesPowerThreshold, v)
+	}
+	return nil
+}
+
 func validateSignedBatchesWindow(i interface{}) error {
 	// TODO: do we want to set some bounds on this value?
 	if _, ok := i.(uint64); !ok {
@@ -471,6 +668,38 @@ func validateEthereumBlacklistAddresses(i interface{}) error {
 	return nil
 }
 
+func validateEvidenceReporterReward(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() {
+		return nil
+	}
+	if v.IsNegative() || v.GT(MaxEvidenceReporterReward) {
+		return fmt.Errorf("evidence reporter reward must be between 0 and %s: %s", MaxEvidenceReporterReward, v)
+	}
+	return nil
+}
+
+func validateExtraFeeDenoms(i interface{}) error {
+	denoms, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	seen := make(map[string]bool, len(denoms))
+	for _, denom := range denoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return err
+		}
+		if seen[denom] {
+			return fmt.Errorf("duplicate denom %s", denom)
+		}
+		seen[denom] = true
+	}
+	return nil
+}
+
 func validateMinChainFeeBasisPoints(i interface{}) error {
 	v, ok := i.(uint64)
 	if !ok {
@@ -182,8 +182,9 @@ func (m *Params) GetEvmChainParams() []*EvmChainParam {
 // GenesisState struct, containing all persistant data required by the Gravity
 // module
 type GenesisState struct {
-	Params    *Params        `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
-	EvmChains []EvmChainData `protobuf:"bytes,2,rep,name=evm_chains,json=evmChains,proto3" json:"evm_chains"`
+	Params                *Params                `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	EvmChains             []EvmChainData         `protobuf:"bytes,2,rep,name=evm_chains,json=evmChains,proto3" json:"evm_chains"`
+	EvmChainDecommissions []EvmChainDecommission `protobuf:"bytes,3,rep,name=evm_chain_decommissions,json=evmChainDecommissions,proto3" json:"evm_chain_decommissions"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -233,6 +234,13 @@ func (m *GenesisState) GetEvmChains() []EvmChainData {
 	return nil
 }
 
+func (m *GenesisState) GetEvmChainDecommissions() []EvmChainDecommission {
+	if m != nil {
+		return m.EvmChainDecommissions
+	}
+	return nil
+}
+
 // bridge_chain_id:
 // the unique identifier of the Ethereum chain, this is a reference value
 // only and is not actually used by any Gravity code
@@ -245,10 +253,80 @@ type EvmChainParam struct {
 	// net id of evm chain
 	BridgeChainId            uint64 `protobuf:"varint,5,opt,name=bridge_chain_id,json=bridgeChainId,proto3" json:"bridge_chain_id,omitempty"`
 	AverageEthereumBlockTime uint64 `protobuf:"varint,6,opt,name=average_ethereum_block_time,json=averageEthereumBlockTime,proto3" json:"average_ethereum_block_time,omitempty"`
-	// addresses on this blacklist are forbidden from depositing or withdrawing
+	// deprecated, the v5 upgrade and InitGenesis move its addresses to the
+	// blacklist of the evm chain and it is ignored otherwise, addresses are
+	// blacklisted with an AddBlacklistProposal instead
 	EthereumBlacklist []string `protobuf:"bytes,7,rep,name=ethereum_blacklist,json=ethereumBlacklist,proto3" json:"ethereum_blacklist,omitempty"`
 	// use this for matching
 	EvmChainPrefix string `protobuf:"bytes,8,opt,name=evm_chain_prefix,json=evmChainPrefix,proto3" json:"evm_chain_prefix,omitempty"`
+	// slashing windows and fractions applied to validators who fail to sign
+	// valsets, batches and logic calls for this evm chain, validators are only
+	// ever slashed for a chain while its bridge is active and slashing_disabled
+	// is not set. A zero value means the module wide value of the same name in
+	// Params is used instead
+	SignedValsetsWindow         uint64                                 `protobuf:"varint,9,opt,name=signed_valsets_window,json=signedValsetsWindow,proto3" json:"signed_valsets_window,omitempty"`
+	SignedBatchesWindow         uint64                                 `protobuf:"varint,10,opt,name=signed_batches_window,json=signedBatchesWindow,proto3" json:"signed_batches_window,omitempty"`
+	SignedLogicCallsWindow      uint64                                 `protobuf:"varint,11,opt,name=signed_logic_calls_window,json=signedLogicCallsWindow,proto3" json:"signed_logic_calls_window,omitempty"`
+	UnbondSlashingValsetsWindow uint64                                 `protobuf:"varint,12,opt,name=unbond_slashing_valsets_window,json=unbondSlashingValsetsWindow,proto3" json:"unbond_slashing_valsets_window,omitempty"`
+	SlashFractionValset         github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=slash_fraction_valset,json=slashFractionValset,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_valset"`
+	SlashFractionBatch          github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,14,opt,name=slash_fraction_batch,json=slashFractionBatch,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_batch"`
+	SlashFractionLogicCall      github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,15,opt,name=slash_fraction_logic_call,json=slashFractionLogicCall,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_logic_call"`
+	// the number of pending IBC auto forwards of this evm chain processed at the
+	// end of every block, zero leaves the queue to MsgExecuteIbcAutoForwards
+	IbcAutoForwardsPerBlock uint64 `protobuf:"varint,16,opt,name=ibc_auto_forwards_per_block,json=ibcAutoForwardsPerBlock,proto3" json:"ibc_auto_forwards_per_block,omitempty"`
+	// the percentage of the voting power which must attest to an event of this
+	// evm chain for it to be observed, zero means the module wide threshold of
+	// 66 is used
+	AttestationVotesPowerThreshold uint64 `protobuf:"varint,17,opt,name=attestation_votes_power_threshold,json=attestationVotesPowerThreshold,proto3" json:"attestation_votes_power_threshold,omitempty"`
+	// when set the attestation threshold is computed over the power of the
+	// bonded validators which have set their delegate keys only, instead of over
+	// the total bonded power, so that a new chain may be bootstrapped before
+	// every validator runs an orchestrator for it. The power counted is never
+	// less than half of the total bonded power
+	AttestationPowerDelegatedOnly bool `protobuf:"varint,18,opt,name=attestation_power_delegated_only,json=attestationPowerDelegatedOnly,proto3" json:"attestation_power_delegated_only,omitempty"`
+	// a valset is requested when the power of the current validators differs
+	// from the latest valset by more than this fraction, zero means 5%
+	ValsetPowerDiffThreshold github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,19,opt,name=valset_power_diff_threshold,json=valsetPowerDiffThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"valset_power_diff_threshold"`
+	// the minimum number of blocks between two valsets requested for a power
+	// difference, zero for no minimum. Unbonding validators and delegate key
+	// changes are not subject to it
+	MinValsetInterval uint64 `protobuf:"varint,20,opt,name=min_valset_interval,json=minValsetInterval,proto3" json:"min_valset_interval,omitempty"`
+	// the number of blocks after which a valset is requested even if the power
+	// did not change, zero for no maximum
+	MaxValsetInterval uint64 `protobuf:"varint,21,opt,name=max_valset_interval,json=maxValsetInterval,proto3" json:"max_valset_interval,omitempty"`
+	// request a valset in every block where a validator sets its delegate keys
+	ValsetRefreshOnDelegateKeyChange bool `protobuf:"varint,22,opt,name=valset_refresh_on_delegate_key_change,json=valsetRefreshOnDelegateKeyChange,proto3" json:"valset_refresh_on_delegate_key_change,omitempty"`
+	// the maximum number of txs in a batch of any token of this chain, tokens
+	// may lower it with their TokenBatchStrategy. Zero means 100
+	MaxBatchSize uint64 `protobuf:"varint,23,opt,name=max_batch_size,json=maxBatchSize,proto3" json:"max_batch_size,omitempty"`
+	// the number of blocks after which the sender of any tx in a batch of this
+	// chain may cancel the batch with MsgRequestBatchCancellation, as long as
+	// no BatchSendToEthClaim has been submitted for it and its confirms fall
+	// short of the power the Gravity contract requires. Zero disables user
+	// requested cancellations
+	BatchCancellationAge uint64 `protobuf:"varint,24,opt,name=batch_cancellation_age,json=batchCancellationAge,proto3" json:"batch_cancellation_age,omitempty"`
+	// the cosmos denoms in which a MsgSendToEth may pay an extra bridge fee on
+	// top of its fee in the bridged token, such as the staking token or
+	// stablecoins. Empty disables extra fees
+	ExtraFeeDenoms []string `protobuf:"bytes,25,rep,name=extra_fee_denoms,json=extraFeeDenoms,proto3" json:"extra_fee_denoms,omitempty"`
+	// the fraction of the self-delegation slashed for evidence of bad or double
+	// signing on this chain which is paid to the reporter of the evidence, at
+	// most 10%. Zero pays no reward. The slashed tokens are burned and the
+	// reward is minted in the bond denom, so the evidence burns the slashed
+	// amount less the reward. The offending validator's operator account and
+	// orchestrator can not report its evidence
+	EvidenceReporterReward github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,26,opt,name=evidence_reporter_reward,json=evidenceReporterReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"evidence_reporter_reward"`
+	// when set the relayer reported by a MsgBatchSendToEthClaim is part of its
+	// claim hash and decides who is paid the extra fees of the batch. Until it
+	// is set the relayer is dropped from every claim, so that orchestrators
+	// which do not report it yet still attest to the same batch as those which
+	// do. Enable it once every orchestrator reports the relayer
+	RelayerInBatchClaims bool `protobuf:"varint,27,opt,name=relayer_in_batch_claims,json=relayerInBatchClaims,proto3" json:"relayer_in_batch_claims,omitempty"`
+	// when set validators are never slashed or jailed for failing to sign the
+	// valsets, batches and logic calls of this chain, they are only marked as
+	// handled as while its bridge is inactive. A zero slash fraction can not opt
+	// out since it means the module wide fraction is used
+	SlashingDisabled bool `protobuf:"varint,28,opt,name=slashing_disabled,json=slashingDisabled,proto3" json:"slashing_disabled,omitempty"`
 }
 
 func (m *EvmChainParam) Reset()         { *m = EvmChainParam{} }
@@ -340,22 +418,139 @@ func (m *EvmChainParam) GetEvmChainPrefix() string {
 	return ""
 }
 
+func (m *EvmChainParam) GetSignedValsetsWindow() uint64 {
+	if m != nil {
+		return m.SignedValsetsWindow
+	}
+	return 0
+}
+
+func (m *EvmChainParam) GetSignedBatchesWindow() uint64 {
+	if m != nil {
+		return m.SignedBatchesWindow
+	}
+	return 0
+}
+
+func (m *EvmChainParam) GetSignedLogicCallsWindow() uint64 {
+	if m != nil {
+		return m.SignedLogicCallsWindow
+	}
+	return 0
+}
+
+func (m *EvmChainParam) GetUnbondSlashingValsetsWindow() uint64 {
+	if m != nil {
+		return m.UnbondSlashingValsetsWindow
+	}
+	return 0
+}
+
+func (m *EvmChainParam) GetIbcAutoForwardsPerBlock() uint64 {
+	if m != nil {
+		return m.IbcAutoForwardsPerBlock
+	}
+	return 0
+}
+
+func (m *EvmChainParam) GetAttestationVotesPowerThreshold() uint64 {
+	if m != nil {
+		return m.AttestationVotesPowerThreshold
+	}
+	return 0
+}
+
+func (m *EvmChainParam) GetAttestationPowerDelegatedOnly() bool {
+	if m != nil {
+		return m.AttestationPowerDelegatedOnly
+	}
+	return false
+}
+
+func (m *EvmChainParam) GetMinValsetInterval() uint64 {
+	if m != nil {
+		return m.MinValsetInterval
+	}
+	return 0
+}
+
+func (m *EvmChainParam) GetMaxValsetInterval() uint64 {
+	if m != nil {
+		return m.MaxValsetInterval
+	}
+	return 0
+}
+
+func (m *EvmChainParam) GetValsetRefreshOnDelegateKeyChange() bool {
+	if m != nil {
+		return m.ValsetRefreshOnDelegateKeyChange
+	}
+	return false
+}
+
+func (m *EvmChainParam) GetMaxBatchSize() uint64 {
+	if m != nil {
+		return m.MaxBatchSize
+	}
+	return 0
+}
+
+func (m *EvmChainParam) GetBatchCancellationAge() uint64 {
+	if m != nil {
+		return m.BatchCancellationAge
+	}
+	return 0
+}
+
+func (m *EvmChainParam) GetExtraFeeDenoms() []string {
+	if m != nil {
+		return m.ExtraFeeDenoms
+	}
+	return nil
+}
+
+func (m *EvmChainParam) GetRelayerInBatchClaims() bool {
+	if m != nil {
+		return m.RelayerInBatchClaims
+	}
+	return false
+}
+
+func (m *EvmChainParam) GetSlashingDisabled() bool {
+	if m != nil {
+		return m.SlashingDisabled
+	}
+	return false
+}
+
 // EvmChainData struct, containing all persistant data per EVM chain required by
 // the Gravity module
 type EvmChainData struct {
-	EvmChain               EvmChain                    `protobuf:"bytes,1,opt,name=evm_chain,json=evmChain,proto3" json:"evm_chain"`
-	GravityNonces          GravityNonces               `protobuf:"bytes,2,opt,name=gravity_nonces,json=gravityNonces,proto3" json:"gravity_nonces"`
-	Valsets                []Valset                    `protobuf:"bytes,3,rep,name=valsets,proto3" json:"valsets"`
-	ValsetConfirms         []MsgValsetConfirm          `protobuf:"bytes,4,rep,name=valset_confirms,json=valsetConfirms,proto3" json:"valset_confirms"`
-	Batches                []OutgoingTxBatch           `protobuf:"bytes,5,rep,name=batches,proto3" json:"batches"`
-	BatchConfirms          []MsgConfirmBatch           `protobuf:"bytes,6,rep,name=batch_confirms,json=batchConfirms,proto3" json:"batch_confirms"`
-	LogicCalls             []OutgoingLogicCall         `protobuf:"bytes,7,rep,name=logic_calls,json=logicCalls,proto3" json:"logic_calls"`
-	LogicCallConfirms      []MsgConfirmLogicCall       `protobuf:"bytes,8,rep,name=logic_call_confirms,json=logicCallConfirms,proto3" json:"logic_call_confirms"`
-	Attestations           []Attestation               `protobuf:"bytes,9,rep,name=attestations,proto3" json:"attestations"`
-	DelegateKeys           []MsgSetOrchestratorAddress `protobuf:"bytes,10,rep,name=delegate_keys,json=delegateKeys,proto3" json:"delegate_keys"`
-	Erc20ToDenoms          []ERC20ToDenom              `protobuf:"bytes,11,rep,name=erc20_to_denoms,json=erc20ToDenoms,proto3" json:"erc20_to_denoms"`
-	UnbatchedTransfers     []OutgoingTransferTx        `protobuf:"bytes,12,rep,name=unbatched_transfers,json=unbatchedTransfers,proto3" json:"unbatched_transfers"`
-	PendingIbcAutoForwards []PendingIbcAutoForward     `protobuf:"bytes,13,rep,name=pending_ibc_auto_forwards,json=pendingIbcAutoForwards,proto3" json:"pending_ibc_auto_forwards"`
+	EvmChain                EvmChain                    `protobuf:"bytes,1,opt,name=evm_chain,json=evmChain,proto3" json:"evm_chain"`
+	GravityNonces           GravityNonces               `protobuf:"bytes,2,opt,name=gravity_nonces,json=gravityNonces,proto3" json:"gravity_nonces"`
+	Valsets                 []Valset                    `protobuf:"bytes,3,rep,name=valsets,proto3" json:"valsets"`
+	ValsetConfirms          []MsgValsetConfirm          `protobuf:"bytes,4,rep,name=valset_confirms,json=valsetConfirms,proto3" json:"valset_confirms"`
+	Batches                 []OutgoingTxBatch           `protobuf:"bytes,5,rep,name=batches,proto3" json:"batches"`
+	BatchConfirms           []MsgConfirmBatch           `protobuf:"bytes,6,rep,name=batch_confirms,json=batchConfirms,proto3" json:"batch_confirms"`
+	LogicCalls              []OutgoingLogicCall         `protobuf:"bytes,7,rep,name=logic_calls,json=logicCalls,proto3" json:"logic_calls"`
+	LogicCallConfirms       []MsgConfirmLogicCall       `protobuf:"bytes,8,rep,name=logic_call_confirms,json=logicCallConfirms,proto3" json:"logic_call_confirms"`
+	Attestations            []Attestation               `protobuf:"bytes,9,rep,name=attestations,proto3" json:"attestations"`
+	DelegateKeys            []MsgSetOrchestratorAddress `protobuf:"bytes,10,rep,name=delegate_keys,json=delegateKeys,proto3" json:"delegate_keys"`
+	Erc20ToDenoms           []ERC20ToDenom              `protobuf:"bytes,11,rep,name=erc20_to_denoms,json=erc20ToDenoms,proto3" json:"erc20_to_denoms"`
+	UnbatchedTransfers      []OutgoingTransferTx        `protobuf:"bytes,12,rep,name=unbatched_transfers,json=unbatchedTransfers,proto3" json:"unbatched_transfers"`
+	PendingIbcAutoForwards  []PendingIbcAutoForward     `protobuf:"bytes,13,rep,name=pending_ibc_auto_forwards,json=pendingIbcAutoForwards,proto3" json:"pending_ibc_auto_forwards"`
+	RateLimits              []RateLimit                 `protobuf:"bytes,14,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits"`
+	HeldSendToCosmos        []HeldSendToCosmos          `protobuf:"bytes,15,rep,name=held_send_to_cosmos,json=heldSendToCosmos,proto3" json:"held_send_to_cosmos"`
+	IbcBridgeFees           []IbcBridgeFee              `protobuf:"bytes,16,rep,name=ibc_bridge_fees,json=ibcBridgeFees,proto3" json:"ibc_bridge_fees"`
+	IbcTransferOrigins      []IbcTransferOrigin         `protobuf:"bytes,17,rep,name=ibc_transfer_origins,json=ibcTransferOrigins,proto3" json:"ibc_transfer_origins"`
+	IbcAutoForwardLogs      []IbcAutoForwardLog         `protobuf:"bytes,18,rep,name=ibc_auto_forward_logs,json=ibcAutoForwardLogs,proto3" json:"ibc_auto_forward_logs"`
+	IbcAutoForwardTransfers []IbcAutoForwardTransfer    `protobuf:"bytes,19,rep,name=ibc_auto_forward_transfers,json=ibcAutoForwardTransfers,proto3" json:"ibc_auto_forward_transfers"`
+	FailedAttestations      []FailedAttestation         `protobuf:"bytes,20,rep,name=failed_attestations,json=failedAttestations,proto3" json:"failed_attestations"`
+	Blacklist               []BlacklistEntry            `protobuf:"bytes,21,rep,name=blacklist,proto3" json:"blacklist"`
+	BatchStrategies         []TokenBatchStrategy        `protobuf:"bytes,22,rep,name=batch_strategies,json=batchStrategies,proto3" json:"batch_strategies"`
+	BridgedSupply           []ERC20Token                `protobuf:"bytes,23,rep,name=bridged_supply,json=bridgedSupply,proto3" json:"bridged_supply"`
+	EvidenceRecords         []EvidenceRecord            `protobuf:"bytes,24,rep,name=evidence_records,json=evidenceRecords,proto3" json:"evidence_records"`
+	RateLimitFlows          []RateLimitFlow             `protobuf:"bytes,25,rep,name=rate_limit_flows,json=rateLimitFlows,proto3" json:"rate_limit_flows"`
 }
 
 func (m *EvmChainData) Reset()         { *m = EvmChainData{} }
@@ -482,6 +677,90 @@ func (m *EvmChainData) GetPendingIbcAutoForwards() []PendingIbcAutoForward {
 	return nil
 }
 
+func (m *EvmChainData) GetRateLimits() []RateLimit {
+	if m != nil {
+		return m.RateLimits
+	}
+	return nil
+}
+
+func (m *EvmChainData) GetHeldSendToCosmos() []HeldSendToCosmos {
+	if m != nil {
+		return m.HeldSendToCosmos
+	}
+	return nil
+}
+
+func (m *EvmChainData) GetIbcBridgeFees() []IbcBridgeFee {
+	if m != nil {
+		return m.IbcBridgeFees
+	}
+	return nil
+}
+
+func (m *EvmChainData) GetIbcTransferOrigins() []IbcTransferOrigin {
+	if m != nil {
+		return m.IbcTransferOrigins
+	}
+	return nil
+}
+
+func (m *EvmChainData) GetIbcAutoForwardLogs() []IbcAutoForwardLog {
+	if m != nil {
+		return m.IbcAutoForwardLogs
+	}
+	return nil
+}
+
+func (m *EvmChainData) GetIbcAutoForwardTransfers() []IbcAutoForwardTransfer {
+	if m != nil {
+		return m.IbcAutoForwardTransfers
+	}
+	return nil
+}
+
+func (m *EvmChainData) GetFailedAttestations() []FailedAttestation {
+	if m != nil {
+		return m.FailedAttestations
+	}
+	return nil
+}
+
+func (m *EvmChainData) GetBlacklist() []BlacklistEntry {
+	if m != nil {
+		return m.Blacklist
+	}
+	return nil
+}
+
+func (m *EvmChainData) GetBatchStrategies() []TokenBatchStrategy {
+	if m != nil {
+		return m.BatchStrategies
+	}
+	return nil
+}
+
+func (m *EvmChainData) GetBridgedSupply() []ERC20Token {
+	if m != nil {
+		return m.BridgedSupply
+	}
+	return nil
+}
+
+func (m *EvmChainData) GetEvidenceRecords() []EvidenceRecord {
+	if m != nil {
+		return m.EvidenceRecords
+	}
+	return nil
+}
+
+func (m *EvmChainData) GetRateLimitFlows() []RateLimitFlow {
+	if m != nil {
+		return m.RateLimitFlows
+	}
+	return nil
+}
+
 // EvmChain struct contains EVM chain specific data
 type EvmChain struct {
 	EvmChainPrefix     string `protobuf:"bytes,1,opt,name=evm_chain_prefix,json=evmChainPrefix,proto3" json:"evm_chain_prefix,omitempty"`
@@ -669,96 +948,140 @@ func init() {
 func init() { proto.RegisterFile("gravity/v1/genesis.proto", fileDescriptor_387b0aba880adb60) }
 
 var fileDescriptor_387b0aba880adb60 = []byte{
-	// 1413 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0x4f, 0x6f, 0x14, 0x37,
-	0x14, 0xcf, 0x92, 0x90, 0x64, 0x9d, 0xdd, 0x04, 0x9c, 0x3f, 0x4c, 0x02, 0x2c, 0x69, 0x5a, 0x50,
-	0x54, 0x95, 0x5d, 0x92, 0x4a, 0x45, 0x50, 0xa1, 0x36, 0x9b, 0x3f, 0x10, 0x51, 0x20, 0x9a, 0xa4,
-	0x54, 0xed, 0xc5, 0xf5, 0xcc, 0x38, 0xb3, 0x56, 0x76, 0xc6, 0xab, 0xb1, 0x77, 0x48, 0x6e, 0x95,
-	0x7a, 0xae, 0xd4, 0x0f, 0xd3, 0xcf, 0x50, 0x71, 0xe8, 0x81, 0x63, 0x55, 0x55, 0xa8, 0x82, 0x2f,
-	0x52, 0xf9, 0xd9, 0x33, 0xeb, 0x5d, 0xf6, 0xc4, 0x29, 0x89, 0x7f, 0x7f, 0xfc, 0xc6, 0xcf, 0xef,
-	0x3d, 0x07, 0x79, 0x71, 0x46, 0x73, 0xae, 0x2e, 0x5a, 0xf9, 0x56, 0x2b, 0x66, 0x29, 0x93, 0x5c,
-	0x36, 0x7b, 0x99, 0x50, 0x02, 0x23, 0x8b, 0x34, 0xf3, 0xad, 0xb5, 0xa5, 0x58, 0xc4, 0x02, 0x96,
-	0x5b, 0xfa, 0x37, 0xc3, 0x58, 0x5b, 0x71, 0xb4, 0xea, 0xa2, 0xc7, 0xac, 0x72, 0x6d, 0xd9, 0x59,
-	0x4f, 0x64, 0x2c, 0xc7, 0xd0, 0x03, 0xaa, 0xc2, 0x8e, 0x5d, 0xbf, 0xe1, 0xac, 0x53, 0xa5, 0x98,
-	0x54, 0x54, 0x71, 0x91, 0x5a, 0xb4, 0x11, 0x0a, 0x99, 0x08, 0xd9, 0x0a, 0xa8, 0x64, 0xad, 0x7c,
-	0x2b, 0x60, 0x8a, 0x6e, 0xb5, 0x42, 0xc1, 0x2d, 0xbe, 0xf1, 0xe7, 0x0c, 0x9a, 0x3e, 0xa2, 0x19,
-	0x4d, 0x24, 0xde, 0x46, 0xcb, 0x92, 0xc7, 0x29, 0x8b, 0x48, 0x4e, 0xbb, 0x92, 0x29, 0x49, 0x5e,
-	0xf1, 0x34, 0x12, 0xaf, 0xbc, 0xca, 0x7a, 0x65, 0x73, 0xca, 0x5f, 0x34, 0xe0, 0x4b, 0x83, 0xfd,
-	0x00, 0x90, 0xa3, 0x81, 0x90, 0x58, 0xa9, 0xb9, 0xe4, 0x6a, 0xda, 0x06, 0xb3, 0x9a, 0x07, 0x68,
-	0xd5, 0x6a, 0xba, 0x22, 0xe6, 0x21, 0x09, 0x69, 0xb7, 0x5b, 0xea, 0x26, 0x41, 0xb7, 0x62, 0x08,
-	0xdf, 0x69, 0x7c, 0x57, 0xc3, 0x56, 0x7a, 0x0f, 0x2d, 0x29, 0x9a, 0xc5, 0x4c, 0x99, 0xed, 0x88,
-	0xe2, 0x09, 0x13, 0x7d, 0xe5, 0x4d, 0x81, 0x0a, 0x1b, 0x0c, 0x76, 0x3b, 0x31, 0x08, 0xfe, 0x02,
-	0x61, 0x9a, 0xb3, 0x8c, 0xc6, 0x8c, 0x04, 0x5d, 0x11, 0x9e, 0x81, 0xc4, 0xbb, 0x0c, 0xfc, 0x2b,
-	0x16, 0x69, 0x6b, 0x40, 0x0b, 0x70, 0x80, 0x96, 0x65, 0x97, 0xca, 0x0e, 0x39, 0xcd, 0x68, 0xa8,
-	0x4f, 0xd1, 0x1e, 0x85, 0x37, 0xbd, 0x5e, 0xd9, 0xac, 0xb5, 0x9b, 0xaf, 0xdf, 0xde, 0x9a, 0xf8,
-	0xe7, 0xed, 0xad, 0x3b, 0x31, 0x57, 0x9d, 0x7e, 0xd0, 0x0c, 0x45, 0xd2, 0xb2, 0xe7, 0x6b, 0x7e,
-	0xdc, 0x95, 0xd1, 0x99, 0xcd, 0xe5, 0x1e, 0x0b, 0xfd, 0x45, 0x30, 0x3b, 0xb0, 0x5e, 0xe6, 0xe4,
-	0xf0, 0xcf, 0x68, 0x69, 0x64, 0x0f, 0xf8, 0x16, 0x6f, 0xe6, 0xa3, 0xb6, 0xc0, 0x43, 0x5b, 0xc0,
-	0xa7, 0x63, 0x8e, 0x56, 0x47, 0x76, 0x18, 0x1c, 0xb4, 0x37, 0xfb, 0x51, 0xdb, 0xac, 0x0c, 0x6d,
-	0x53, 0xe6, 0x05, 0xef, 0xa2, 0x46, 0x3f, 0x0d, 0x44, 0x1a, 0x11, 0x20, 0xf0, 0x34, 0x1e, 0xbd,
-	0x3c, 0x55, 0x38, 0xea, 0xeb, 0x86, 0x75, 0x6c, 0x49, 0xc3, 0x97, 0x28, 0x47, 0xeb, 0x1f, 0x9c,
-	0x48, 0x44, 0x98, 0xea, 0x10, 0x7d, 0x0d, 0xa8, 0xea, 0x67, 0xcc, 0x43, 0x1f, 0x15, 0xf6, 0x8d,
-	0x91, 0xd3, 0x89, 0xf6, 0x55, 0xe7, 0xb8, 0xf0, 0xc4, 0x7b, 0xa8, 0x6e, 0x82, 0x25, 0x19, 0x7b,
-	0x45, 0xb3, 0xc8, 0x9b, 0x5b, 0xaf, 0x6c, 0xce, 0x6d, 0xaf, 0x36, 0x8d, 0x57, 0x53, 0xd7, 0x4c,
-	0xd3, 0xd6, 0x4c, 0x73, 0x57, 0xf0, 0xb4, 0x3d, 0xa5, 0xf7, 0xf7, 0x6b, 0x46, 0xe5, 0x83, 0x08,
-	0x3f, 0x44, 0x6b, 0x09, 0x4f, 0x49, 0xd8, 0xa1, 0x3c, 0x25, 0xa7, 0x8c, 0x91, 0x80, 0x4a, 0x2e,
-	0x49, 0x4f, 0xf0, 0x54, 0x49, 0xaf, 0x66, 0xee, 0x73, 0xc2, 0xd3, 0x5d, 0x4d, 0x38, 0x60, 0xac,
-	0xad, 0xe1, 0x23, 0x40, 0xf1, 0x2e, 0xba, 0xc2, 0xf2, 0xc4, 0x6a, 0x7b, 0x50, 0x86, 0x5e, 0x7d,
-	0x7d, 0x12, 0x82, 0x18, 0xf4, 0x8f, 0xe6, 0x7e, 0x9e, 0x80, 0x1a, 0x0a, 0xd5, 0x9f, 0x67, 0xee,
-	0x9f, 0xf2, 0xe1, 0xd4, 0x2f, 0xff, 0xae, 0x4f, 0x6c, 0x5c, 0xa0, 0xda, 0x63, 0xd3, 0x80, 0x8e,
-	0x15, 0x55, 0x0c, 0x7f, 0x8e, 0xa6, 0xad, 0x61, 0x05, 0xbe, 0x0a, 0xbb, 0x86, 0x46, 0xe9, 0x5b,
-	0x06, 0x7e, 0x84, 0x50, 0x19, 0x86, 0xf4, 0x2e, 0x41, 0x00, 0xde, 0xb8, 0x00, 0xf6, 0xa8, 0xa2,
-	0xf6, 0x10, 0xaa, 0x45, 0x14, 0x72, 0xe3, 0xd7, 0x49, 0x54, 0x1f, 0x0a, 0x11, 0xdf, 0x44, 0x45,
-	0xfb, 0x23, 0x3c, 0x82, 0x00, 0xaa, 0x7e, 0xd5, 0xae, 0x1c, 0x46, 0xf8, 0x53, 0x54, 0x0f, 0x32,
-	0x1e, 0xc5, 0x8c, 0xe8, 0xc4, 0xe4, 0x0c, 0xba, 0xc5, 0xac, 0x5f, 0x33, 0x8b, 0x3b, 0xb0, 0xa6,
-	0x6b, 0x3d, 0x14, 0xa9, 0xd2, 0xb9, 0x23, 0x52, 0xf4, 0xb3, 0x90, 0x91, 0x0e, 0x95, 0x1d, 0xe8,
-	0x10, 0x55, 0x1f, 0x17, 0xd8, 0x31, 0x40, 0x4f, 0xa8, 0xec, 0xe0, 0xaf, 0xd0, 0x35, 0x6b, 0xcb,
-	0x54, 0x87, 0x65, 0xac, 0x9f, 0x10, 0x1a, 0x45, 0x19, 0x93, 0x12, 0x1a, 0x44, 0xd5, 0x5f, 0x36,
-	0xf0, 0xbe, 0x45, 0x77, 0x0c, 0x88, 0xef, 0xa0, 0x05, 0xab, 0x33, 0x89, 0xe0, 0x91, 0x6d, 0x10,
-	0x36, 0x4a, 0xf8, 0xb0, 0xc3, 0x08, 0x3f, 0x42, 0xd7, 0x8b, 0x5e, 0x52, 0x6e, 0xe0, 0x34, 0x95,
-	0x69, 0xd0, 0x78, 0x96, 0x52, 0x6c, 0x32, 0x68, 0x2e, 0x77, 0x11, 0x76, 0x64, 0x34, 0x3c, 0xeb,
-	0x72, 0xa9, 0xbc, 0x99, 0xf5, 0xc9, 0xcd, 0xaa, 0x7f, 0x95, 0x95, 0x74, 0x0b, 0xe0, 0xcd, 0xa1,
-	0xbb, 0x91, 0xb1, 0x53, 0x7e, 0x0e, 0xc5, 0x5b, 0x75, 0x2e, 0x00, 0xac, 0xda, 0x0b, 0xf0, 0xd7,
-	0x0c, 0xaa, 0xb9, 0x79, 0xc2, 0xf7, 0x51, 0xb5, 0x34, 0xb0, 0x97, 0x60, 0x69, 0x5c, 0x52, 0x6d,
-	0x42, 0x67, 0x0b, 0x57, 0x7c, 0x80, 0xe6, 0x8b, 0xec, 0xa5, 0x22, 0x0d, 0x99, 0x84, 0xfc, 0x8c,
-	0xdc, 0xc9, 0xc7, 0xe6, 0xd7, 0xe7, 0x40, 0xb0, 0x16, 0xf5, 0xd8, 0x5d, 0xc4, 0xdb, 0x68, 0xc6,
-	0x36, 0x03, 0x6f, 0x12, 0xee, 0xd4, 0xd0, 0x1d, 0x34, 0x3d, 0xc0, 0x2a, 0x0b, 0x22, 0x7e, 0x8a,
-	0x16, 0x6c, 0x4d, 0x86, 0x22, 0x3d, 0xe5, 0x59, 0xa2, 0x73, 0xa7, 0xb5, 0x37, 0x5c, 0xed, 0x33,
-	0x69, 0x5b, 0xc8, 0xae, 0x21, 0x59, 0x97, 0xf9, 0xdc, 0x5d, 0x94, 0xf8, 0x6b, 0x34, 0x63, 0xc7,
-	0x92, 0x77, 0x19, 0x4c, 0xae, 0xbb, 0x26, 0x2f, 0xfa, 0x2a, 0x16, 0x3c, 0x8d, 0x4f, 0xce, 0xa1,
-	0x6d, 0x16, 0x91, 0x58, 0x05, 0x7e, 0x82, 0xe6, 0xcd, 0x90, 0x29, 0x03, 0x99, 0xfe, 0xd0, 0xe3,
-	0x99, 0x8c, 0x8b, 0x10, 0x1c, 0x8f, 0x3a, 0x08, 0xcb, 0x30, 0xf6, 0xd0, 0x9c, 0x33, 0xe9, 0x20,
-	0xe3, 0x73, 0xdb, 0x37, 0xc7, 0x85, 0x52, 0x36, 0x56, 0x6b, 0x84, 0xba, 0xe5, 0x04, 0xc4, 0xdf,
-	0xa3, 0xc5, 0x81, 0xcb, 0x20, 0xa8, 0x59, 0x70, 0xbb, 0x35, 0x3e, 0xa8, 0x51, 0xbf, 0xab, 0xa5,
-	0x5f, 0x19, 0xdc, 0x0e, 0xaa, 0x39, 0xaf, 0x06, 0xe9, 0x55, 0xc1, 0xef, 0x9a, 0xeb, 0xb7, 0x33,
-	0xc0, 0x8b, 0x0e, 0xe8, 0x4a, 0xf0, 0x11, 0xaa, 0x47, 0xac, 0xcb, 0x62, 0xaa, 0x18, 0x39, 0x63,
-	0x17, 0xd2, 0x43, 0xe0, 0x71, 0x7b, 0x24, 0xa6, 0x63, 0xa6, 0x5e, 0x64, 0xfa, 0x68, 0x55, 0x46,
-	0x95, 0xc8, 0x6c, 0xf5, 0x15, 0x8e, 0x85, 0xc3, 0x53, 0x76, 0x21, 0xf1, 0x01, 0x5a, 0x60, 0x59,
-	0xb8, 0x7d, 0x8f, 0x28, 0x41, 0x22, 0x96, 0x8a, 0x44, 0x7a, 0x73, 0x63, 0xba, 0x92, 0xbf, 0xbb,
-	0x7d, 0xef, 0x44, 0xec, 0x69, 0x42, 0x71, 0xf2, 0x20, 0xb3, 0x6b, 0x70, 0x66, 0xfd, 0xd4, 0x24,
-	0x34, 0x22, 0x2a, 0xa3, 0xa9, 0x3c, 0x65, 0x99, 0x6e, 0xca, 0xda, 0xab, 0x31, 0xf6, 0x32, 0x58,
-	0xd2, 0xc9, 0xb9, 0x75, 0xc4, 0xa5, 0x41, 0x01, 0x49, 0x1c, 0xa0, 0xd5, 0x1e, 0x4b, 0x23, 0x3d,
-	0xed, 0x78, 0x10, 0x12, 0xda, 0x57, 0x82, 0x9c, 0x8a, 0x4c, 0x8f, 0x83, 0xa2, 0x7f, 0x7f, 0x32,
-	0xd4, 0x6e, 0x0d, 0xf9, 0x30, 0x08, 0x77, 0xfa, 0x4a, 0x1c, 0x18, 0xa6, 0xf5, 0x5f, 0xe9, 0x8d,
-	0x03, 0xe5, 0xc6, 0x6f, 0x15, 0x34, 0x5b, 0x54, 0xe8, 0xd8, 0x5e, 0x50, 0x19, 0xd7, 0x0b, 0xf0,
-	0x67, 0x68, 0x7e, 0xc0, 0x4c, 0x69, 0x62, 0x7a, 0x6b, 0xd5, 0xaf, 0x15, 0xbc, 0xe7, 0x34, 0x61,
-	0x78, 0x0b, 0x2d, 0x3b, 0x2c, 0xa6, 0x48, 0xce, 0x32, 0xc9, 0x45, 0x6a, 0x9f, 0x5f, 0xb8, 0x24,
-	0x33, 0xf5, 0xd2, 0x20, 0x1b, 0x7f, 0x4c, 0xa2, 0xfa, 0x50, 0xcd, 0xe3, 0x26, 0x5a, 0xec, 0x52,
-	0x7d, 0x0d, 0xec, 0xc8, 0x37, 0xcd, 0xc2, 0xbe, 0x16, 0xaf, 0x1a, 0xc8, 0x54, 0x29, 0x08, 0x0c,
-	0x5f, 0x2a, 0x22, 0x02, 0xc9, 0xb2, 0x9c, 0x45, 0x96, 0x7f, 0xa9, 0xe0, 0x4b, 0xf5, 0xc2, 0x22,
-	0x86, 0xff, 0x00, 0xad, 0x02, 0x1f, 0x66, 0x78, 0xf9, 0x2a, 0xb5, 0x2a, 0xfb, 0x4e, 0xd4, 0x84,
-	0x63, 0x83, 0xbb, 0x5b, 0xdd, 0x47, 0xde, 0x90, 0xd4, 0x14, 0x32, 0xf4, 0x6a, 0xfb, 0x56, 0x5c,
-	0x76, 0x94, 0xa6, 0x74, 0x35, 0x88, 0xbf, 0x45, 0x37, 0x87, 0x84, 0x4e, 0xc5, 0x19, 0xb5, 0x19,
-	0x0c, 0xab, 0x8e, 0x7a, 0x50, 0x63, 0xe0, 0x70, 0x1b, 0x2d, 0x80, 0x83, 0x3a, 0x27, 0x3d, 0x21,
-	0xba, 0x7a, 0x98, 0x98, 0xc1, 0x50, 0xd3, 0xcb, 0x27, 0xe7, 0x47, 0x42, 0x74, 0x0f, 0x23, 0xbc,
-	0x81, 0xea, 0x40, 0x33, 0x91, 0xf1, 0x08, 0x9e, 0x7f, 0x53, 0xfe, 0x9c, 0x5e, 0x84, 0x78, 0x0e,
-	0x23, 0xdc, 0x46, 0x8d, 0xe1, 0x03, 0xd3, 0x39, 0x33, 0x03, 0xa7, 0xc3, 0x78, 0xdc, 0x51, 0x30,
-	0x0f, 0xa6, 0xfc, 0x35, 0xf7, 0xec, 0xf6, 0x73, 0x33, 0x72, 0x9e, 0x00, 0xa3, 0xfd, 0xe3, 0xeb,
-	0x77, 0x8d, 0xca, 0x9b, 0x77, 0x8d, 0xca, 0x7f, 0xef, 0x1a, 0x95, 0xdf, 0xdf, 0x37, 0x26, 0xde,
-	0xbc, 0x6f, 0x4c, 0xfc, 0xfd, 0xbe, 0x31, 0xf1, 0xd3, 0x37, 0xce, 0x1b, 0xca, 0x26, 0xf6, 0x6e,
-	0x1b, 0xe6, 0xdd, 0xe8, 0x9f, 0x89, 0x88, 0xfa, 0x5d, 0xd6, 0x3a, 0x6f, 0x15, 0xff, 0x69, 0xc0,
-	0x03, 0x2b, 0x98, 0x86, 0xff, 0x20, 0xbe, 0xfc, 0x3f, 0x00, 0x00, 0xff, 0xff, 0x03, 0x06, 0x09,
-	0x63, 0x04, 0x0d, 0x00, 0x00,
+	// 2124 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xdd, 0x6e, 0x1b, 0xb9,
+	0x15, 0x8e, 0x12, 0xaf, 0x13, 0xd1, 0x92, 0x6c, 0xd3, 0x96, 0x3d, 0xb6, 0x13, 0xc5, 0xeb, 0x6e,
+	0x16, 0x41, 0xdb, 0xd8, 0x89, 0xfb, 0xb3, 0xd8, 0xed, 0x6e, 0x5b, 0x5b, 0xb6, 0x13, 0x21, 0xd9,
+	0xb5, 0x2b, 0xb9, 0x29, 0xda, 0x8b, 0xb2, 0xd4, 0xcc, 0xd1, 0x88, 0xf0, 0xcc, 0x50, 0x18, 0x52,
+	0xb2, 0xbd, 0x57, 0x7d, 0x81, 0x02, 0x7d, 0x98, 0x3e, 0x43, 0xb1, 0x97, 0x7b, 0xd9, 0x16, 0xc5,
+	0xa2, 0x48, 0x1e, 0xa4, 0x05, 0x0f, 0x39, 0xd2, 0xe8, 0x27, 0x5d, 0xc0, 0x68, 0xf7, 0xca, 0xf2,
+	0xf9, 0xbe, 0xf3, 0xf1, 0xf0, 0x90, 0x3c, 0x87, 0x1c, 0xe2, 0x85, 0x29, 0x1f, 0x08, 0x7d, 0xbd,
+	0x37, 0x78, 0xb6, 0x17, 0x42, 0x02, 0x4a, 0xa8, 0xdd, 0x5e, 0x2a, 0xb5, 0xa4, 0xc4, 0x21, 0xbb,
+	0x83, 0x67, 0x9b, 0xab, 0xa1, 0x0c, 0x25, 0x9a, 0xf7, 0xcc, 0x2f, 0xcb, 0xd8, 0x5c, 0xcb, 0xf9,
+	0xea, 0xeb, 0x1e, 0x38, 0xcf, 0xcd, 0x6a, 0xce, 0x1e, 0xab, 0x50, 0xcd, 0xa0, 0xb7, 0xb9, 0xf6,
+	0xbb, 0xce, 0x7e, 0x3f, 0x67, 0xe7, 0x5a, 0x83, 0xd2, 0x5c, 0x0b, 0x99, 0x38, 0xb4, 0xe6, 0x4b,
+	0x15, 0x4b, 0xb5, 0xd7, 0xe6, 0x0a, 0xf6, 0x06, 0xcf, 0xda, 0xa0, 0xf9, 0xb3, 0x3d, 0x5f, 0x0a,
+	0x87, 0xef, 0xfc, 0xf5, 0x2e, 0x99, 0x3f, 0xe3, 0x29, 0x8f, 0x15, 0xdd, 0x27, 0x55, 0x25, 0xc2,
+	0x04, 0x02, 0x36, 0xe0, 0x91, 0x02, 0xad, 0xd8, 0xa5, 0x48, 0x02, 0x79, 0xe9, 0x15, 0xb6, 0x0b,
+	0x8f, 0xe7, 0x9a, 0x2b, 0x16, 0x7c, 0x6d, 0xb1, 0xdf, 0x20, 0x94, 0xf3, 0xc1, 0x90, 0x60, 0xe8,
+	0x73, 0x3b, 0xef, 0x73, 0x68, 0x31, 0xe7, 0xf3, 0x31, 0xd9, 0x70, 0x3e, 0x91, 0x0c, 0x85, 0xcf,
+	0x7c, 0x1e, 0x45, 0x43, 0xbf, 0x3b, 0xe8, 0xb7, 0x66, 0x09, 0xaf, 0x0c, 0x5e, 0x37, 0xb0, 0x73,
+	0x7d, 0x4a, 0x56, 0x35, 0x4f, 0x43, 0xd0, 0x76, 0x38, 0xa6, 0x45, 0x0c, 0xb2, 0xaf, 0xbd, 0x39,
+	0xf4, 0xa2, 0x16, 0xc3, 0xd1, 0xce, 0x2d, 0x42, 0x7f, 0x48, 0x28, 0x1f, 0x40, 0xca, 0x43, 0x60,
+	0xed, 0x48, 0xfa, 0x17, 0xe8, 0xe2, 0xbd, 0x87, 0xfc, 0x25, 0x87, 0x1c, 0x1a, 0xc0, 0x38, 0xd0,
+	0x36, 0xa9, 0xaa, 0x88, 0xab, 0x2e, 0xeb, 0xa4, 0xdc, 0x37, 0x59, 0x74, 0xa9, 0xf0, 0xe6, 0xb7,
+	0x0b, 0x8f, 0x4b, 0x87, 0xbb, 0x5f, 0x7d, 0xf3, 0xf0, 0xd6, 0x3f, 0xbe, 0x79, 0xf8, 0x61, 0x28,
+	0x74, 0xb7, 0xdf, 0xde, 0xf5, 0x65, 0xbc, 0xe7, 0xf2, 0x6b, 0xff, 0x3c, 0x51, 0xc1, 0x85, 0x5b,
+	0xcb, 0x23, 0xf0, 0x9b, 0x2b, 0x28, 0x76, 0xe2, 0xb4, 0x6c, 0xe6, 0xe8, 0x1f, 0xc8, 0xea, 0xc4,
+	0x18, 0x38, 0x17, 0xef, 0xee, 0x8d, 0x86, 0xa0, 0x63, 0x43, 0xe0, 0xd4, 0xa9, 0x20, 0x1b, 0x13,
+	0x23, 0x8c, 0x12, 0xed, 0xdd, 0xbb, 0xd1, 0x30, 0x6b, 0x63, 0xc3, 0x0c, 0xd7, 0x85, 0xd6, 0x49,
+	0xad, 0x9f, 0xb4, 0x65, 0x12, 0x30, 0x24, 0x88, 0x24, 0x9c, 0xdc, 0x3c, 0x45, 0x4c, 0xf5, 0x96,
+	0x65, 0xb5, 0x1c, 0x69, 0x7c, 0x13, 0x0d, 0xc8, 0xf6, 0x54, 0x46, 0x02, 0x06, 0xba, 0xcb, 0xcc,
+	0x36, 0xe0, 0xba, 0x9f, 0x82, 0x47, 0x6e, 0x14, 0xf6, 0xfd, 0x89, 0xec, 0x04, 0xc7, 0xba, 0xdb,
+	0xca, 0x34, 0xe9, 0x11, 0x29, 0xdb, 0x60, 0x59, 0x0a, 0x97, 0x3c, 0x0d, 0xbc, 0x85, 0xed, 0xc2,
+	0xe3, 0x85, 0xfd, 0x8d, 0x5d, 0xab, 0xb5, 0x6b, 0xce, 0xcc, 0xae, 0x3b, 0x33, 0xbb, 0x75, 0x29,
+	0x92, 0xc3, 0x39, 0x33, 0x7e, 0xb3, 0x64, 0xbd, 0x9a, 0xe8, 0x44, 0x3f, 0x21, 0x9b, 0xb1, 0x48,
+	0x98, 0xdf, 0xe5, 0x22, 0x61, 0x1d, 0x00, 0xd6, 0xe6, 0x4a, 0x28, 0xd6, 0x93, 0x22, 0xd1, 0xca,
+	0x2b, 0xd9, 0xfd, 0x1c, 0x8b, 0xa4, 0x6e, 0x08, 0x27, 0x00, 0x87, 0x06, 0x3e, 0x43, 0x94, 0xd6,
+	0xc9, 0x12, 0x0c, 0x62, 0xe7, 0xdb, 0xc3, 0x63, 0xe8, 0x95, 0xb7, 0xef, 0x60, 0x10, 0xa3, 0xfa,
+	0xb1, 0x7b, 0x3c, 0x88, 0xd1, 0x1b, 0x0f, 0x6a, 0xb3, 0x02, 0xf9, 0x7f, 0xd5, 0x27, 0x73, 0x7f,
+	0xfc, 0xe7, 0xf6, 0xad, 0x9d, 0xbf, 0x17, 0x48, 0xe9, 0xb9, 0xad, 0x40, 0x2d, 0xcd, 0x35, 0xd0,
+	0xef, 0x93, 0x79, 0xa7, 0x58, 0xc0, 0x69, 0xd1, 0xbc, 0xa2, 0x75, 0x6d, 0x3a, 0x06, 0xfd, 0x8c,
+	0x90, 0x61, 0x1c, 0xca, 0xbb, 0x8d, 0x11, 0x78, 0xb3, 0x22, 0x38, 0xe2, 0x9a, 0xbb, 0x2c, 0x14,
+	0xb3, 0x30, 0x14, 0xfd, 0x3d, 0x59, 0x1f, 0x4d, 0x23, 0x00, 0x5f, 0xc6, 0xb1, 0x50, 0x4a, 0xc8,
+	0x44, 0x79, 0x77, 0x50, 0x6b, 0x7b, 0xa6, 0x56, 0x8e, 0xe8, 0x34, 0xab, 0x30, 0x03, 0x53, 0x3b,
+	0x6f, 0xca, 0xa4, 0x3c, 0x96, 0x03, 0xfa, 0x80, 0x64, 0xf5, 0x95, 0x89, 0x00, 0x27, 0x58, 0x6c,
+	0x16, 0x9d, 0xa5, 0x11, 0xd0, 0xef, 0x91, 0x72, 0x3b, 0x15, 0x41, 0x08, 0xcc, 0xac, 0xfc, 0x00,
+	0xb0, 0x1c, 0xdd, 0x6b, 0x96, 0xac, 0xf1, 0x00, 0x6d, 0xa6, 0x98, 0xf8, 0x32, 0xd1, 0x66, 0x73,
+	0x30, 0x25, 0xfb, 0xa9, 0x0f, 0xac, 0xcb, 0x55, 0x17, 0x4b, 0x50, 0xb1, 0x49, 0x33, 0xac, 0x85,
+	0xd0, 0x0b, 0xae, 0xba, 0xf4, 0xa7, 0x64, 0xdd, 0xc9, 0x82, 0xee, 0x42, 0x0a, 0xfd, 0x98, 0xf1,
+	0x20, 0x48, 0x41, 0x29, 0xac, 0x40, 0xc5, 0x66, 0xd5, 0xc2, 0xc7, 0x0e, 0x3d, 0xb0, 0x20, 0xfd,
+	0x90, 0x2c, 0x3a, 0x3f, 0x9b, 0x22, 0x11, 0xb8, 0x0a, 0xe4, 0xa2, 0xc4, 0x89, 0x35, 0x02, 0xfa,
+	0x19, 0xd9, 0xca, 0x8a, 0xd5, 0x70, 0x80, 0x5c, 0xd5, 0x9a, 0x47, 0x1f, 0xcf, 0x51, 0xb2, 0x41,
+	0x46, 0xd5, 0xeb, 0x09, 0xa1, 0x39, 0x37, 0xee, 0x5f, 0x44, 0x42, 0x69, 0xef, 0xee, 0xf6, 0x9d,
+	0xc7, 0xc5, 0xe6, 0x32, 0x0c, 0xe9, 0x0e, 0xa0, 0x8f, 0xc7, 0x36, 0x5f, 0x0a, 0x1d, 0x71, 0x85,
+	0xd5, 0xa1, 0x98, 0xdb, 0x61, 0x68, 0x7d, 0x77, 0x67, 0x28, 0xde, 0xa0, 0x33, 0x90, 0x1b, 0x76,
+	0x86, 0x85, 0xff, 0xda, 0x19, 0xbe, 0xbd, 0x10, 0x95, 0xbe, 0xbd, 0x10, 0xbd, 0xb3, 0xfc, 0x97,
+	0xff, 0xff, 0xe5, 0xbf, 0xf2, 0xdd, 0x94, 0xff, 0xc5, 0xff, 0x69, 0xf9, 0xff, 0x94, 0x6c, 0x89,
+	0xb6, 0xcf, 0x78, 0x5f, 0x4b, 0xd6, 0x91, 0xa9, 0xa9, 0x87, 0x8a, 0xf5, 0x20, 0xb5, 0xbb, 0xd6,
+	0x5b, 0xc2, 0x94, 0xaf, 0x8b, 0xb6, 0x7f, 0xd0, 0xd7, 0xf2, 0xc4, 0x11, 0xce, 0x20, 0xc5, 0x3d,
+	0x4b, 0x1b, 0xe4, 0xfd, 0xdc, 0x85, 0x85, 0x0d, 0xa4, 0x06, 0x53, 0x37, 0x2f, 0x21, 0x65, 0xba,
+	0x9b, 0x82, 0xea, 0xca, 0x28, 0xf0, 0x96, 0x51, 0xa3, 0x96, 0x23, 0xbe, 0x36, 0xbc, 0x33, 0x43,
+	0x3b, 0xcf, 0x58, 0xf4, 0x39, 0xd9, 0xce, 0x4b, 0x59, 0x91, 0x00, 0x22, 0x08, 0xb9, 0x86, 0x80,
+	0xc9, 0x24, 0xba, 0xf6, 0x28, 0xd6, 0x80, 0x07, 0x39, 0x1e, 0x8a, 0x1c, 0x65, 0xac, 0xd3, 0x24,
+	0xba, 0xa6, 0x31, 0xd9, 0x72, 0x3d, 0xc1, 0x69, 0x88, 0x4e, 0x27, 0x17, 0xcd, 0xca, 0x8d, 0xd2,
+	0xe7, 0x59, 0x49, 0x3b, 0x9c, 0xe8, 0x74, 0x46, 0x71, 0xef, 0x92, 0x15, 0xd3, 0x3c, 0xdc, 0x90,
+	0x22, 0xd1, 0x90, 0x0e, 0x78, 0xe4, 0xad, 0xe2, 0xa4, 0x97, 0x63, 0xe1, 0x76, 0x4d, 0xc3, 0x01,
+	0xc8, 0xe7, 0x57, 0x53, 0xfc, 0xaa, 0xe3, 0xf3, 0xab, 0x09, 0xfe, 0x29, 0x79, 0x34, 0x6c, 0x71,
+	0x1d, 0x33, 0x28, 0x93, 0xc9, 0x30, 0x2f, 0xec, 0x02, 0xae, 0xcd, 0xf1, 0x4f, 0x42, 0xf0, 0xd6,
+	0x30, 0x39, 0xdb, 0x59, 0x67, 0x43, 0xee, 0x69, 0x92, 0xe5, 0xe6, 0x25, 0x5c, 0xd7, 0x91, 0x47,
+	0x3f, 0x20, 0x15, 0x13, 0x80, 0xbd, 0x7e, 0x29, 0xf1, 0x25, 0x78, 0xeb, 0x38, 0x76, 0x29, 0xe6,
+	0x57, 0xb8, 0xfd, 0x5a, 0xe2, 0x4b, 0xa0, 0x3f, 0x26, 0x6b, 0x96, 0xe1, 0xf3, 0xc4, 0x87, 0x28,
+	0xb2, 0xab, 0xc2, 0x43, 0xf0, 0x3c, 0x64, 0xaf, 0x22, 0x5a, 0xcf, 0x81, 0x07, 0x21, 0x60, 0x41,
+	0xba, 0xd2, 0x29, 0xc7, 0x2e, 0x1a, 0x40, 0x22, 0x63, 0xe5, 0x6d, 0x60, 0xf5, 0xaa, 0xa0, 0xfd,
+	0x04, 0xe0, 0x08, 0xad, 0xb4, 0x4b, 0x3c, 0x18, 0x88, 0x00, 0x12, 0x1f, 0x58, 0x0a, 0x3d, 0x99,
+	0x6a, 0x48, 0xb3, 0x26, 0xbe, 0x79, 0xb3, 0x1d, 0x9e, 0xe9, 0x35, 0x9d, 0x9c, 0xeb, 0xee, 0x3f,
+	0x21, 0xeb, 0x29, 0x44, 0xfc, 0x1a, 0x52, 0x26, 0xdc, 0x51, 0x65, 0x7e, 0xc4, 0x45, 0xac, 0xbc,
+	0x2d, 0x4c, 0xd9, 0xaa, 0x83, 0x1b, 0xf6, 0xf4, 0xd5, 0x11, 0xa3, 0x3f, 0x20, 0xcb, 0xc3, 0x3a,
+	0x14, 0x08, 0xc5, 0xdb, 0x11, 0x04, 0xde, 0x7d, 0x74, 0x58, 0xca, 0x80, 0x23, 0x67, 0x77, 0x0d,
+	0xfc, 0xdf, 0x15, 0x52, 0xca, 0xb7, 0x59, 0xfa, 0x11, 0x29, 0x0e, 0xeb, 0xb3, 0xeb, 0xe1, 0xab,
+	0xb3, 0xfa, 0xa8, 0xeb, 0x9d, 0xf7, 0xb2, 0xa2, 0x4d, 0x4f, 0x48, 0xc5, 0xd1, 0x58, 0x22, 0x13,
+	0x1f, 0x14, 0xb6, 0xbf, 0x89, 0x3b, 0xc5, 0x73, 0xfb, 0xf3, 0x0b, 0x24, 0x38, 0x89, 0x72, 0x98,
+	0x37, 0xd2, 0x7d, 0x72, 0xd7, 0xd5, 0x50, 0xd7, 0xc6, 0xc7, 0xae, 0x10, 0x76, 0xa7, 0x39, 0xcf,
+	0x8c, 0x48, 0x5f, 0x92, 0x45, 0xfb, 0x93, 0xf9, 0x32, 0xe9, 0x88, 0x34, 0x36, 0xad, 0xd1, 0xf8,
+	0xde, 0xcf, 0xfb, 0x7e, 0xae, 0x5c, 0xe5, 0xad, 0x5b, 0x92, 0x53, 0xa9, 0x0c, 0xf2, 0x46, 0x45,
+	0x7f, 0x46, 0xee, 0xba, 0xe6, 0xe1, 0xbd, 0x87, 0x22, 0x5b, 0x79, 0x91, 0xd3, 0xbe, 0x0e, 0xa5,
+	0x48, 0xc2, 0x73, 0xbb, 0xf1, 0xb2, 0x48, 0x9c, 0x07, 0x7d, 0x41, 0x2a, 0x6e, 0xb9, 0xb2, 0x40,
+	0xe6, 0xa7, 0x35, 0x3e, 0x57, 0x61, 0x16, 0x42, 0x4e, 0xa3, 0x6c, 0xb7, 0x67, 0x16, 0xc6, 0x11,
+	0x59, 0xc8, 0xf5, 0x23, 0x6c, 0xa8, 0x0b, 0xfb, 0x0f, 0x66, 0x85, 0x32, 0xac, 0x8c, 0x4e, 0x88,
+	0x44, 0x99, 0x41, 0xd1, 0x5f, 0x93, 0x95, 0x91, 0xca, 0x28, 0xa8, 0x7b, 0xa8, 0xf6, 0x70, 0x76,
+	0x50, 0x93, 0x7a, 0xcb, 0x43, 0xbd, 0x61, 0x70, 0x07, 0xa4, 0x94, 0xab, 0x68, 0xca, 0x2b, 0xa2,
+	0xde, 0x7a, 0x5e, 0xef, 0x60, 0x84, 0x67, 0x37, 0xd8, 0xbc, 0x0b, 0x3d, 0x23, 0xe5, 0x7c, 0x49,
+	0x50, 0x1e, 0x41, 0x8d, 0x47, 0x13, 0x31, 0xb5, 0x40, 0x9f, 0xa6, 0x26, 0xb5, 0x3a, 0xe5, 0x5a,
+	0xa6, 0xee, 0x72, 0x93, 0x29, 0x06, 0xa3, 0x52, 0xa1, 0xe8, 0x09, 0x59, 0x84, 0xd4, 0xdf, 0x7f,
+	0xca, 0xb4, 0xcc, 0x0e, 0xf2, 0xc2, 0x8c, 0x4b, 0x65, 0xb3, 0xbe, 0xff, 0xf4, 0x5c, 0xe2, 0x99,
+	0xce, 0x32, 0x8f, 0x6e, 0xce, 0x86, 0x39, 0xeb, 0x27, 0x76, 0x41, 0x03, 0xa6, 0x53, 0x9e, 0xa8,
+	0x0e, 0xa4, 0xe6, 0x52, 0x6d, 0xb4, 0x6a, 0x33, 0x37, 0x83, 0x23, 0x9d, 0x5f, 0x39, 0x45, 0x3a,
+	0x14, 0xc8, 0x20, 0x45, 0xdb, 0x64, 0xa3, 0x07, 0x49, 0x60, 0x0e, 0xe7, 0x54, 0xfb, 0x72, 0xf7,
+	0xef, 0xf7, 0xc7, 0x6e, 0xcb, 0x96, 0xdc, 0x18, 0xeb, 0x63, 0x4e, 0x7f, 0xad, 0x37, 0x0b, 0x54,
+	0xf4, 0x53, 0xb2, 0x90, 0x9a, 0x84, 0x46, 0x22, 0x16, 0x5a, 0x79, 0x15, 0x54, 0xad, 0xe6, 0x55,
+	0x9b, 0x5c, 0xc3, 0x2b, 0x83, 0x66, 0x9b, 0x25, 0xcd, 0x0c, 0x8a, 0xfe, 0x8a, 0xac, 0x74, 0x21,
+	0x0a, 0x98, 0x82, 0x24, 0x30, 0x49, 0xb4, 0x35, 0xcb, 0x5b, 0x9c, 0x3e, 0x4a, 0x2f, 0x20, 0x0a,
+	0x5a, 0x90, 0x04, 0xe7, 0xb2, 0x8e, 0x1c, 0x27, 0xb6, 0xd4, 0x9d, 0xb0, 0x9b, 0x35, 0x31, 0x93,
+	0x75, 0x17, 0xd1, 0x0e, 0x80, 0xf2, 0x96, 0xa6, 0xd7, 0xa4, 0xd1, 0xf6, 0x0f, 0x91, 0x61, 0x5e,
+	0x2a, 0x6e, 0x4d, 0x44, 0xce, 0x66, 0xd6, 0x64, 0xd5, 0xe8, 0x64, 0xab, 0xc1, 0x64, 0x2a, 0x42,
+	0xf3, 0x6a, 0x58, 0x9e, 0x3e, 0x16, 0x8d, 0xb6, 0x9f, 0x25, 0xfd, 0x14, 0x59, 0xd9, 0x9a, 0x88,
+	0x49, 0x40, 0xd1, 0xd7, 0xa4, 0x3a, 0xb9, 0x16, 0xe6, 0xde, 0xa2, 0x3c, 0x3a, 0x53, 0x37, 0x97,
+	0xeb, 0x57, 0x32, 0xcc, 0xe9, 0x8e, 0x03, 0x8a, 0x02, 0xd9, 0x9c, 0xd2, 0x1d, 0xed, 0xa4, 0x15,
+	0x14, 0xdf, 0x79, 0xb7, 0x78, 0x16, 0xa6, 0x1b, 0x61, 0x5d, 0xcc, 0x44, 0x15, 0x3d, 0x27, 0x2b,
+	0x1d, 0x2e, 0x22, 0x08, 0xd8, 0xd8, 0x69, 0x5c, 0x9d, 0x0e, 0xfe, 0x04, 0x69, 0xd3, 0x67, 0x92,
+	0x76, 0x26, 0x01, 0x45, 0x7f, 0x4e, 0x8a, 0xa3, 0x8b, 0x7c, 0x15, 0xb5, 0x36, 0xf3, 0x5a, 0xc3,
+	0xcb, 0xfc, 0x71, 0xa2, 0xd3, 0xeb, 0xec, 0x61, 0x36, 0x74, 0xa1, 0xa7, 0x64, 0xc9, 0x75, 0x6a,
+	0x73, 0x66, 0x21, 0x14, 0xa0, 0xbc, 0xb5, 0xe9, 0xc3, 0x73, 0x2e, 0x2f, 0xc0, 0xb6, 0xaf, 0x96,
+	0xe5, 0x65, 0x52, 0x8b, 0xed, 0x9c, 0x51, 0x80, 0x79, 0xb0, 0x56, 0xec, 0x06, 0x0a, 0x98, 0xea,
+	0xf7, 0x7a, 0xd1, 0xb5, 0xb7, 0x8e, 0x72, 0x6b, 0x33, 0xce, 0xb5, 0xd1, 0xcc, 0xea, 0xa9, 0xf5,
+	0x69, 0xa1, 0x0b, 0x7d, 0x49, 0x96, 0xb2, 0x6e, 0xcb, 0x52, 0xf0, 0xa5, 0x39, 0x75, 0xde, 0xf4,
+	0xe4, 0x8e, 0x87, 0x1d, 0xd9, 0x50, 0xb2, 0x88, 0x60, 0xcc, 0xaa, 0x68, 0x83, 0x2c, 0x8d, 0xce,
+	0x19, 0xeb, 0x44, 0xf2, 0xd2, 0x5e, 0x1a, 0x26, 0xda, 0xdd, 0xf0, 0xb0, 0x9d, 0x44, 0xf2, 0x32,
+	0x6b, 0x37, 0x69, 0xde, 0xa8, 0x76, 0xfe, 0x54, 0x20, 0xf7, 0xb2, 0xa6, 0x3a, 0xf3, 0x75, 0x54,
+	0x98, 0xf9, 0x3a, 0xfa, 0x80, 0x54, 0x46, 0xcc, 0x84, 0xc7, 0xf6, 0xb5, 0x59, 0x6c, 0x96, 0x32,
+	0xde, 0x17, 0x3c, 0x06, 0xfa, 0x8c, 0x54, 0x73, 0x2c, 0xd0, 0x6c, 0x00, 0xa9, 0x79, 0xdd, 0xba,
+	0x2f, 0x5e, 0x74, 0x48, 0x06, 0xfd, 0xda, 0x22, 0x3b, 0x7f, 0xb9, 0x43, 0xca, 0x63, 0x6d, 0xda,
+	0x5c, 0xff, 0x22, 0x6e, 0xf6, 0x47, 0x76, 0x03, 0xc4, 0xfe, 0xee, 0x3e, 0xd0, 0x2d, 0x5b, 0xc8,
+	0x36, 0x56, 0x74, 0xb0, 0x7c, 0xa5, 0x99, 0x6c, 0x2b, 0x48, 0x07, 0x10, 0x38, 0xfe, 0xed, 0x8c,
+	0xaf, 0xf4, 0xa9, 0x43, 0x2c, 0xff, 0x63, 0xb2, 0x81, 0x7c, 0xbc, 0xa2, 0x0c, 0x9f, 0x7b, 0xce,
+	0xcb, 0x7d, 0x9a, 0x33, 0x84, 0x96, 0xc5, 0xf3, 0x43, 0x7d, 0x44, 0xbc, 0x31, 0x57, 0xbb, 0xef,
+	0xec, 0x3b, 0xc0, 0x7e, 0x9e, 0xab, 0xe6, 0x3c, 0x6d, 0xb7, 0x35, 0x20, 0xfd, 0x25, 0x79, 0x30,
+	0xe6, 0x98, 0x6b, 0x92, 0xd6, 0xdb, 0x3e, 0x95, 0x37, 0x72, 0xde, 0xa3, 0xb6, 0x88, 0x0a, 0x8f,
+	0xc8, 0x22, 0x2a, 0xe8, 0x2b, 0xd6, 0x93, 0x32, 0x32, 0xcf, 0x6b, 0xfb, 0x54, 0x2e, 0x19, 0xf3,
+	0xf9, 0xd5, 0x99, 0x94, 0x51, 0x23, 0xa0, 0x3b, 0xa4, 0x8c, 0x34, 0x1b, 0x99, 0x08, 0xf0, 0x8b,
+	0xdb, 0x5c, 0x73, 0xc1, 0x18, 0x31, 0x9e, 0x46, 0x40, 0x0f, 0x49, 0x6d, 0x3c, 0x61, 0x66, 0xcd,
+	0xec, 0x13, 0xbc, 0x0b, 0x22, 0xec, 0x6a, 0x7c, 0x21, 0xcf, 0x35, 0x37, 0xf3, 0xb9, 0x3b, 0x1e,
+	0xd8, 0x47, 0xf8, 0x0b, 0x64, 0x1c, 0xfe, 0xf6, 0xab, 0x37, 0xb5, 0xc2, 0xd7, 0x6f, 0x6a, 0x85,
+	0x7f, 0xbd, 0xa9, 0x15, 0xfe, 0xfc, 0xb6, 0x76, 0xeb, 0xeb, 0xb7, 0xb5, 0x5b, 0x7f, 0x7b, 0x5b,
+	0xbb, 0xf5, 0xbb, 0x5f, 0xe4, 0x2e, 0xa3, 0x6e, 0x61, 0x9f, 0xd8, 0xca, 0x3a, 0xf9, 0x6f, 0x2c,
+	0x83, 0x7e, 0x04, 0x7b, 0x57, 0x7b, 0xd9, 0xc7, 0x5d, 0xbc, 0xa9, 0xb6, 0xe7, 0xf1, 0xa3, 0xed,
+	0x8f, 0xfe, 0x33, 0x00, 0x19, 0x54, 0x6c, 0x42, 0x77, 0x16, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -903,6 +1226,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.EvmChainDecommissions) > 0 {
+		for iNdEx := len(m.EvmChainDecommissions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EvmChainDecommissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.EvmChains) > 0 {
 		for iNdEx := len(m.EvmChains) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -952,86 +1289,449 @@ func (m *EvmChainParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if len(m.EvmChainPrefix) > 0 {
-		i -= len(m.EvmChainPrefix)
-		copy(dAtA[i:], m.EvmChainPrefix)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.EvmChainPrefix)))
+	if m.SlashingDisabled {
 		i--
-		dAtA[i] = 0x42
+		if m.SlashingDisabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe0
 	}
-	if len(m.EthereumBlacklist) > 0 {
-		for iNdEx := len(m.EthereumBlacklist) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.EthereumBlacklist[iNdEx])
-			copy(dAtA[i:], m.EthereumBlacklist[iNdEx])
-			i = encodeVarintGenesis(dAtA, i, uint64(len(m.EthereumBlacklist[iNdEx])))
+	if m.RelayerInBatchClaims {
+		i--
+		if m.RelayerInBatchClaims {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd8
+	}
+	{
+		size := m.EvidenceReporterReward.Size()
+		i -= size
+		if _, err := m.EvidenceReporterReward.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xd2
+	if len(m.ExtraFeeDenoms) > 0 {
+		for iNdEx := len(m.ExtraFeeDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExtraFeeDenoms[iNdEx])
+			copy(dAtA[i:], m.ExtraFeeDenoms[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.ExtraFeeDenoms[iNdEx])))
 			i--
-			dAtA[i] = 0x3a
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
 		}
 	}
-	if m.AverageEthereumBlockTime != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.AverageEthereumBlockTime))
+	if m.BatchCancellationAge != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BatchCancellationAge))
 		i--
-		dAtA[i] = 0x30
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
 	}
-	if m.BridgeChainId != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.BridgeChainId))
+	if m.MaxBatchSize != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxBatchSize))
 		i--
-		dAtA[i] = 0x28
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
 	}
-	if len(m.BridgeEthereumAddress) > 0 {
-		i -= len(m.BridgeEthereumAddress)
-		copy(dAtA[i:], m.BridgeEthereumAddress)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.BridgeEthereumAddress)))
+	if m.ValsetRefreshOnDelegateKeyChange {
 		i--
-		dAtA[i] = 0x22
+		if m.ValsetRefreshOnDelegateKeyChange {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
 	}
-	if len(m.ContractSourceHash) > 0 {
-		i -= len(m.ContractSourceHash)
-		copy(dAtA[i:], m.ContractSourceHash)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ContractSourceHash)))
+	if m.MaxValsetInterval != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxValsetInterval))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
 	}
-	if m.BridgeActive {
+	if m.MinValsetInterval != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MinValsetInterval))
 		i--
-		if m.BridgeActive {
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	{
+		size := m.ValsetPowerDiffThreshold.Size()
+		i -= size
+		if _, err := m.ValsetPowerDiffThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x9a
+	if m.AttestationPowerDelegatedOnly {
+		i--
+		if m.AttestationPowerDelegatedOnly {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
 	}
-	if len(m.GravityId) > 0 {
-		i -= len(m.GravityId)
-		copy(dAtA[i:], m.GravityId)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.GravityId)))
+	if m.AttestationVotesPowerThreshold != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.AttestationVotesPowerThreshold))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *EvmChainData) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.IbcAutoForwardsPerBlock != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.IbcAutoForwardsPerBlock))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
 	}
-	return dAtA[:n], nil
-}
-
-func (m *EvmChainData) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *EvmChainData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
+	{
+		size := m.SlashFractionLogicCall.Size()
+		i -= size
+		if _, err := m.SlashFractionLogicCall.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	{
+		size := m.SlashFractionBatch.Size()
+		i -= size
+		if _, err := m.SlashFractionBatch.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	{
+		size := m.SlashFractionValset.Size()
+		i -= size
+		if _, err := m.SlashFractionValset.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	if m.UnbondSlashingValsetsWindow != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.UnbondSlashingValsetsWindow))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.SignedLogicCallsWindow != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SignedLogicCallsWindow))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.SignedBatchesWindow != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SignedBatchesWindow))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.SignedValsetsWindow != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SignedValsetsWindow))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.EthereumBlacklist) > 0 {
+		for iNdEx := len(m.EthereumBlacklist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.EthereumBlacklist[iNdEx])
+			copy(dAtA[i:], m.EthereumBlacklist[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.EthereumBlacklist[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.AverageEthereumBlockTime != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.AverageEthereumBlockTime))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.BridgeChainId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BridgeChainId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.BridgeEthereumAddress) > 0 {
+		i -= len(m.BridgeEthereumAddress)
+		copy(dAtA[i:], m.BridgeEthereumAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.BridgeEthereumAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ContractSourceHash) > 0 {
+		i -= len(m.ContractSourceHash)
+		copy(dAtA[i:], m.ContractSourceHash)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ContractSourceHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BridgeActive {
+		i--
+		if m.BridgeActive {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.GravityId) > 0 {
+		i -= len(m.GravityId)
+		copy(dAtA[i:], m.GravityId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.GravityId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EvmChainData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EvmChainData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EvmChainData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.RateLimitFlows) > 0 {
+		for iNdEx := len(m.RateLimitFlows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimitFlows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if len(m.EvidenceRecords) > 0 {
+		for iNdEx := len(m.EvidenceRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EvidenceRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if len(m.BridgedSupply) > 0 {
+		for iNdEx := len(m.BridgedSupply) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BridgedSupply[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if len(m.BatchStrategies) > 0 {
+		for iNdEx := len(m.BatchStrategies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BatchStrategies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.Blacklist) > 0 {
+		for iNdEx := len(m.Blacklist) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Blacklist[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.FailedAttestations) > 0 {
+		for iNdEx := len(m.FailedAttestations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FailedAttestations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.IbcAutoForwardTransfers) > 0 {
+		for iNdEx := len(m.IbcAutoForwardTransfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IbcAutoForwardTransfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.IbcAutoForwardLogs) > 0 {
+		for iNdEx := len(m.IbcAutoForwardLogs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IbcAutoForwardLogs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.IbcTransferOrigins) > 0 {
+		for iNdEx := len(m.IbcTransferOrigins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IbcTransferOrigins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.IbcBridgeFees) > 0 {
+		for iNdEx := len(m.IbcBridgeFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IbcBridgeFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.HeldSendToCosmos) > 0 {
+		for iNdEx := len(m.HeldSendToCosmos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HeldSendToCosmos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.RateLimits) > 0 {
+		for iNdEx := len(m.RateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
 	if len(m.PendingIbcAutoForwards) > 0 {
 		for iNdEx := len(m.PendingIbcAutoForwards) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1387,6 +2087,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.EvmChainDecommissions) > 0 {
+		for _, e := range m.EvmChainDecommissions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1427,6 +2133,64 @@ func (m *EvmChainParam) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if m.SignedValsetsWindow != 0 {
+		n += 1 + sovGenesis(uint64(m.SignedValsetsWindow))
+	}
+	if m.SignedBatchesWindow != 0 {
+		n += 1 + sovGenesis(uint64(m.SignedBatchesWindow))
+	}
+	if m.SignedLogicCallsWindow != 0 {
+		n += 1 + sovGenesis(uint64(m.SignedLogicCallsWindow))
+	}
+	if m.UnbondSlashingValsetsWindow != 0 {
+		n += 1 + sovGenesis(uint64(m.UnbondSlashingValsetsWindow))
+	}
+	l = m.SlashFractionValset.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.SlashFractionBatch.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.SlashFractionLogicCall.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.IbcAutoForwardsPerBlock != 0 {
+		n += 2 + sovGenesis(uint64(m.IbcAutoForwardsPerBlock))
+	}
+	if m.AttestationVotesPowerThreshold != 0 {
+		n += 2 + sovGenesis(uint64(m.AttestationVotesPowerThreshold))
+	}
+	if m.AttestationPowerDelegatedOnly {
+		n += 3
+	}
+	l = m.ValsetPowerDiffThreshold.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if m.MinValsetInterval != 0 {
+		n += 2 + sovGenesis(uint64(m.MinValsetInterval))
+	}
+	if m.MaxValsetInterval != 0 {
+		n += 2 + sovGenesis(uint64(m.MaxValsetInterval))
+	}
+	if m.ValsetRefreshOnDelegateKeyChange {
+		n += 3
+	}
+	if m.MaxBatchSize != 0 {
+		n += 2 + sovGenesis(uint64(m.MaxBatchSize))
+	}
+	if m.BatchCancellationAge != 0 {
+		n += 2 + sovGenesis(uint64(m.BatchCancellationAge))
+	}
+	if len(m.ExtraFeeDenoms) > 0 {
+		for _, s := range m.ExtraFeeDenoms {
+			l = len(s)
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.EvidenceReporterReward.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if m.RelayerInBatchClaims {
+		n += 3
+	}
+	if m.SlashingDisabled {
+		n += 3
+	}
 	return n
 }
 
@@ -1506,6 +2270,78 @@ func (m *EvmChainData) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.RateLimits) > 0 {
+		for _, e := range m.RateLimits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.HeldSendToCosmos) > 0 {
+		for _, e := range m.HeldSendToCosmos {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.IbcBridgeFees) > 0 {
+		for _, e := range m.IbcBridgeFees {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.IbcTransferOrigins) > 0 {
+		for _, e := range m.IbcTransferOrigins {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.IbcAutoForwardLogs) > 0 {
+		for _, e := range m.IbcAutoForwardLogs {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.IbcAutoForwardTransfers) > 0 {
+		for _, e := range m.IbcAutoForwardTransfers {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FailedAttestations) > 0 {
+		for _, e := range m.FailedAttestations {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Blacklist) > 0 {
+		for _, e := range m.Blacklist {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BatchStrategies) > 0 {
+		for _, e := range m.BatchStrategies {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BridgedSupply) > 0 {
+		for _, e := range m.BridgedSupply {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EvidenceRecords) > 0 {
+		for _, e := range m.EvidenceRecords {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RateLimitFlows) > 0 {
+		for _, e := range m.RateLimitFlows {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2049,6 +2885,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainDecommissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmChainDecommissions = append(m.EvmChainDecommissions, EvmChainDecommission{})
+			if err := m.EvmChainDecommissions[len(m.EvmChainDecommissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -2101,9 +2971,912 @@ func (m *EvmChainParam) Unmarshal(dAtA []byte) error {
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GravityId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GravityId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GravityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeActive", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BridgeActive = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSourceHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractSourceHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeEthereumAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeEthereumAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeChainId", wireType)
+			}
+			m.BridgeChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BridgeChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageEthereumBlockTime", wireType)
+			}
+			m.AverageEthereumBlockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AverageEthereumBlockTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumBlacklist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumBlacklist = append(m.EthereumBlacklist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedValsetsWindow", wireType)
+			}
+			m.SignedValsetsWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedValsetsWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedBatchesWindow", wireType)
+			}
+			m.SignedBatchesWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedBatchesWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedLogicCallsWindow", wireType)
+			}
+			m.SignedLogicCallsWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedLogicCallsWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondSlashingValsetsWindow", wireType)
+			}
+			m.UnbondSlashingValsetsWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondSlashingValsetsWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionValset", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionValset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionBatch", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionBatch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionLogicCall", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionLogicCall.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcAutoForwardsPerBlock", wireType)
+			}
+			m.IbcAutoForwardsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IbcAutoForwardsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationVotesPowerThreshold", wireType)
+			}
+			m.AttestationVotesPowerThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AttestationVotesPowerThreshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationPowerDelegatedOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AttestationPowerDelegatedOnly = bool(v != 0)
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetPowerDiffThreshold", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValsetPowerDiffThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinValsetInterval", wireType)
+			}
+			m.MinValsetInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinValsetInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValsetInterval", wireType)
+			}
+			m.MaxValsetInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxValsetInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetRefreshOnDelegateKeyChange", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ValsetRefreshOnDelegateKeyChange = bool(v != 0)
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBatchSize", wireType)
+			}
+			m.MaxBatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBatchSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchCancellationAge", wireType)
+			}
+			m.BatchCancellationAge = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchCancellationAge |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtraFeeDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExtraFeeDenoms = append(m.ExtraFeeDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvidenceReporterReward", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EvidenceReporterReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerInBatchClaims", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RelayerInBatchClaims = bool(v != 0)
+		case 28:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingDisabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SlashingDisabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EvmChainData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EvmChainData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EvmChainData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChain", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EvmChain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GravityNonces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GravityNonces.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valsets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Valsets = append(m.Valsets, Valset{})
+			if err := m.Valsets[len(m.Valsets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetConfirms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValsetConfirms = append(m.ValsetConfirms, MsgValsetConfirm{})
+			if err := m.ValsetConfirms[len(m.ValsetConfirms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Batches = append(m.Batches, OutgoingTxBatch{})
+			if err := m.Batches[len(m.Batches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchConfirms", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -2113,29 +3886,31 @@ func (m *EvmChainParam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GravityId = string(dAtA[iNdEx:postIndex])
+			m.BatchConfirms = append(m.BatchConfirms, MsgConfirmBatch{})
+			if err := m.BatchConfirms[len(m.BatchConfirms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeActive", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogicCalls", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -2145,17 +3920,31 @@ func (m *EvmChainParam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.BridgeActive = bool(v != 0)
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LogicCalls = append(m.LogicCalls, OutgoingLogicCall{})
+			if err := m.LogicCalls[len(m.LogicCalls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractSourceHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LogicCallConfirms", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -2165,29 +3954,31 @@ func (m *EvmChainParam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractSourceHash = string(dAtA[iNdEx:postIndex])
+			m.LogicCallConfirms = append(m.LogicCallConfirms, MsgConfirmLogicCall{})
+			if err := m.LogicCallConfirms[len(m.LogicCallConfirms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeEthereumAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -2197,29 +3988,31 @@ func (m *EvmChainParam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BridgeEthereumAddress = string(dAtA[iNdEx:postIndex])
+			m.Attestations = append(m.Attestations, Attestation{})
+			if err := m.Attestations[len(m.Attestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeChainId", wireType)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegateKeys", wireType)
 			}
-			m.BridgeChainId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -2229,35 +4022,31 @@ func (m *EvmChainParam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BridgeChainId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AverageEthereumBlockTime", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			m.AverageEthereumBlockTime = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AverageEthereumBlockTime |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
 			}
-		case 7:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegateKeys = append(m.DelegateKeys, MsgSetOrchestratorAddress{})
+			if err := m.DelegateKeys[len(m.DelegateKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumBlacklist", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20ToDenoms", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -2267,29 +4056,31 @@ func (m *EvmChainParam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EthereumBlacklist = append(m.EthereumBlacklist, string(dAtA[iNdEx:postIndex]))
+			m.Erc20ToDenoms = append(m.Erc20ToDenoms, ERC20ToDenom{})
+			if err := m.Erc20ToDenoms[len(m.Erc20ToDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 8:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbatchedTransfers", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -2299,77 +4090,29 @@ func (m *EvmChainParam) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
+			m.UnbatchedTransfers = append(m.UnbatchedTransfers, OutgoingTransferTx{})
+			if err := m.UnbatchedTransfers[len(m.UnbatchedTransfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenesis
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EvmChainData) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EvmChainData: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EvmChainData: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EvmChain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingIbcAutoForwards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2396,13 +4139,14 @@ func (m *EvmChainData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.EvmChain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PendingIbcAutoForwards = append(m.PendingIbcAutoForwards, PendingIbcAutoForward{})
+			if err := m.PendingIbcAutoForwards[len(m.PendingIbcAutoForwards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GravityNonces", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2429,13 +4173,14 @@ func (m *EvmChainData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GravityNonces.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RateLimits = append(m.RateLimits, RateLimit{})
+			if err := m.RateLimits[len(m.RateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Valsets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HeldSendToCosmos", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2462,14 +4207,14 @@ func (m *EvmChainData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Valsets = append(m.Valsets, Valset{})
-			if err := m.Valsets[len(m.Valsets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.HeldSendToCosmos = append(m.HeldSendToCosmos, HeldSendToCosmos{})
+			if err := m.HeldSendToCosmos[len(m.HeldSendToCosmos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValsetConfirms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcBridgeFees", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2496,14 +4241,14 @@ func (m *EvmChainData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValsetConfirms = append(m.ValsetConfirms, MsgValsetConfirm{})
-			if err := m.ValsetConfirms[len(m.ValsetConfirms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.IbcBridgeFees = append(m.IbcBridgeFees, IbcBridgeFee{})
+			if err := m.IbcBridgeFees[len(m.IbcBridgeFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Batches", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcTransferOrigins", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2530,14 +4275,14 @@ func (m *EvmChainData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Batches = append(m.Batches, OutgoingTxBatch{})
-			if err := m.Batches[len(m.Batches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.IbcTransferOrigins = append(m.IbcTransferOrigins, IbcTransferOrigin{})
+			if err := m.IbcTransferOrigins[len(m.IbcTransferOrigins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchConfirms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcAutoForwardLogs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2564,14 +4309,14 @@ func (m *EvmChainData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BatchConfirms = append(m.BatchConfirms, MsgConfirmBatch{})
-			if err := m.BatchConfirms[len(m.BatchConfirms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.IbcAutoForwardLogs = append(m.IbcAutoForwardLogs, IbcAutoForwardLog{})
+			if err := m.IbcAutoForwardLogs[len(m.IbcAutoForwardLogs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogicCalls", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcAutoForwardTransfers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2598,14 +4343,14 @@ func (m *EvmChainData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LogicCalls = append(m.LogicCalls, OutgoingLogicCall{})
-			if err := m.LogicCalls[len(m.LogicCalls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.IbcAutoForwardTransfers = append(m.IbcAutoForwardTransfers, IbcAutoForwardTransfer{})
+			if err := m.IbcAutoForwardTransfers[len(m.IbcAutoForwardTransfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LogicCallConfirms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedAttestations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2632,14 +4377,14 @@ func (m *EvmChainData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LogicCallConfirms = append(m.LogicCallConfirms, MsgConfirmLogicCall{})
-			if err := m.LogicCallConfirms[len(m.LogicCallConfirms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FailedAttestations = append(m.FailedAttestations, FailedAttestation{})
+			if err := m.FailedAttestations[len(m.FailedAttestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Blacklist", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2666,14 +4411,14 @@ func (m *EvmChainData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Attestations = append(m.Attestations, Attestation{})
-			if err := m.Attestations[len(m.Attestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Blacklist = append(m.Blacklist, BlacklistEntry{})
+			if err := m.Blacklist[len(m.Blacklist)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegateKeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchStrategies", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2700,14 +4445,14 @@ func (m *EvmChainData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegateKeys = append(m.DelegateKeys, MsgSetOrchestratorAddress{})
-			if err := m.DelegateKeys[len(m.DelegateKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BatchStrategies = append(m.BatchStrategies, TokenBatchStrategy{})
+			if err := m.BatchStrategies[len(m.BatchStrategies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 23:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Erc20ToDenoms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgedSupply", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2734,14 +4479,14 @@ func (m *EvmChainData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Erc20ToDenoms = append(m.Erc20ToDenoms, ERC20ToDenom{})
-			if err := m.Erc20ToDenoms[len(m.Erc20ToDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BridgedSupply = append(m.BridgedSupply, ERC20Token{})
+			if err := m.BridgedSupply[len(m.BridgedSupply)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
+		case 24:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbatchedTransfers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EvidenceRecords", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2768,14 +4513,14 @@ func (m *EvmChainData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UnbatchedTransfers = append(m.UnbatchedTransfers, OutgoingTransferTx{})
-			if err := m.UnbatchedTransfers[len(m.UnbatchedTransfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.EvidenceRecords = append(m.EvidenceRecords, EvidenceRecord{})
+			if err := m.EvidenceRecords[len(m.EvidenceRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
+		case 25:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingIbcAutoForwards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimitFlows", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2802,8 +4547,8 @@ func (m *EvmChainData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PendingIbcAutoForwards = append(m.PendingIbcAutoForwards, PendingIbcAutoForward{})
-			if err := m.PendingIbcAutoForwards[len(m.PendingIbcAutoForwards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RateLimitFlows = append(m.RateLimitFlows, RateLimitFlow{})
+			if err := m.RateLimitFlows[len(m.RateLimitFlows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
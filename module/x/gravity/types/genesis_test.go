@@ -110,3 +110,45 @@ func TestStringToByteArray(t *testing.T) {
 		})
 	}
 }
+
+// nolint: exhaustruct
+func TestEffectiveSlashingParams(t *testing.T) {
+	params := DefaultParams()
+	params.SlashFractionLogicCall = types.Dec{}
+
+	// an evm chain with no slashing params of its own uses the module wide values
+	unset := params.EffectiveSlashingParams(EvmChainParam{EvmChainPrefix: "unset"})
+	require.Equal(t, params.SignedValsetsWindow, unset.SignedValsetsWindow)
+	require.Equal(t, params.SignedBatchesWindow, unset.SignedBatchesWindow)
+	require.Equal(t, params.SignedLogicCallsWindow, unset.SignedLogicCallsWindow)
+	require.Equal(t, params.UnbondSlashingValsetsWindow, unset.UnbondSlashingValsetsWindow)
+	require.True(t, params.SlashFractionValset.Equal(unset.SlashFractionValset))
+	require.True(t, params.SlashFractionBatch.Equal(unset.SlashFractionBatch))
+	require.True(t, unset.SlashFractionLogicCall.IsZero())
+
+	// chain specific values take precedence
+	custom := params.EffectiveSlashingParams(EvmChainParam{
+		EvmChainPrefix:      "custom",
+		SignedValsetsWindow: 20,
+		SignedBatchesWindow: 30,
+		SlashFractionBatch:  types.NewDecWithPrec(5, 2),
+	})
+	require.Equal(t, uint64(20), custom.SignedValsetsWindow)
+	require.Equal(t, uint64(30), custom.SignedBatchesWindow)
+	require.Equal(t, params.SignedLogicCallsWindow, custom.SignedLogicCallsWindow)
+	require.True(t, types.NewDecWithPrec(5, 2).Equal(custom.SlashFractionBatch))
+
+	// slash fractions must be a fraction
+	invalid := DefaultParams().EvmChainParams[0]
+	invalid.SlashFractionValset = types.NewDec(2)
+	require.Error(t, invalid.ValidateBasic())
+	invalid.SlashFractionValset = types.NewDecWithPrec(-1, 2)
+	require.Error(t, invalid.ValidateBasic())
+
+	// the evidence reporter reward is capped well below the slash
+	reward := DefaultParams().EvmChainParams[0]
+	reward.EvidenceReporterReward = MaxEvidenceReporterReward
+	require.NoError(t, reward.ValidateBasic())
+	reward.EvidenceReporterReward = types.NewDecWithPrec(5, 1)
+	require.Error(t, reward.ValidateBasic())
+}
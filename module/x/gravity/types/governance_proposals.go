@@ -9,12 +9,20 @@ import (
 )
 
 const (
-	ProposalTypeUnhaltBridge         = "UnhaltBridge"
-	ProposalTypeAirdrop              = "Airdrop"
-	ProposalTypeIBCMetadata          = "IBCMetadata"
-	ProposalTypeAddEvmChain          = "AddEvmChain"
-	ProposalTypeRemoveEvmChain       = "RemoveEvmChain"
-	ProposalTypeMonitoredERC20Tokens = "MonitoredERC20Tokens"
+	ProposalTypeUnhaltBridge             = "UnhaltBridge"
+	ProposalTypeAirdrop                  = "Airdrop"
+	ProposalTypeIBCMetadata              = "IBCMetadata"
+	ProposalTypeAddEvmChain              = "AddEvmChain"
+	ProposalTypeRemoveEvmChain           = "RemoveEvmChain"
+	ProposalTypeMonitoredERC20Tokens     = "MonitoredERC20Tokens"
+	ProposalTypeOutgoingLogicCall        = "OutgoingLogicCall"
+	ProposalTypeSetRateLimit             = "SetRateLimit"
+	ProposalTypeReleaseHeldSendToCosmos  = "ReleaseHeldSendToCosmos"
+	ProposalTypeSetIbcBridgeFee          = "SetIbcBridgeFee"
+	ProposalTypeResolveFailedAttestation = "ResolveFailedAttestation"
+	ProposalTypeAddBlacklist             = "AddBlacklist"
+	ProposalTypeRemoveBlacklist          = "RemoveBlacklist"
+	ProposalTypeSetBatchStrategy         = "SetBatchStrategy"
 )
 
 func (p *UnhaltBridgeProposal) GetTitle() string { return p.Title }
@@ -148,6 +156,9 @@ func (p *AddEvmChainProposal) ValidateBasic() error {
 	if p.EvmChainNetVersion == 0 {
 		return fmt.Errorf("EVM Chain net version cannot be zero")
 	}
+	if err := validateAttestationVotesPowerThreshold(p.AttestationVotesPowerThreshold); err != nil {
+		return fmt.Errorf("attestation votes power threshold: %v", err)
+	}
 	return nil
 }
 
@@ -160,7 +171,10 @@ func (p AddEvmChainProposal) String() string {
   Evm Chain Prefix: %s
   Evm Chain Net Version : %d
   Evm Chain Gravity Id: %s
-`, p.Title, p.Description, p.EvmChainName, p.EvmChainPrefix, p.EvmChainNetVersion, p.GravityId))
+  Attestation Votes Power Threshold: %d
+  Attestation Power Delegated Only: %t
+`, p.Title, p.Description, p.EvmChainName, p.EvmChainPrefix, p.EvmChainNetVersion, p.GravityId,
+		p.AttestationVotesPowerThreshold, p.AttestationPowerDelegatedOnly))
 	return b.String()
 }
 
@@ -179,6 +193,9 @@ func (p *RemoveEvmChainProposal) ValidateBasic() error {
 	if err != nil {
 		return err
 	}
+	if len(strings.TrimSpace(p.EvmChainPrefix)) == 0 {
+		return fmt.Errorf("evm chain prefix cannot be empty")
+	}
 
 	return nil
 }
@@ -187,7 +204,8 @@ func (p RemoveEvmChainProposal) String() string {
 	var b strings.Builder
 	b.WriteString(fmt.Sprintf(`Remove EVM Chain Proposal:  
   Evm Chain Prefix: %s  
-`, p.EvmChainPrefix))
+  Drain Blocks:     %d  
+`, p.EvmChainPrefix, p.DrainBlocks))
 	return b.String()
 }
 
@@ -236,3 +254,387 @@ func (p MonitoredERC20TokensProposal) String() string {
 `, p.Title, p.Description, tokens))
 	return b.String()
 }
+
+func (p *OutgoingLogicCallProposal) GetTitle() string { return p.Title }
+
+func (p *OutgoingLogicCallProposal) GetDescription() string { return p.Description }
+
+func (p *OutgoingLogicCallProposal) ProposalRoute() string { return RouterKey }
+
+func (p *OutgoingLogicCallProposal) ProposalType() string {
+	return ProposalTypeOutgoingLogicCall
+}
+
+func (p *OutgoingLogicCallProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	if len(strings.TrimSpace(p.EvmChainPrefix)) == 0 {
+		return fmt.Errorf("evm chain prefix cannot be empty")
+	}
+	if len(p.InvalidationId) == 0 || len(p.InvalidationId) > 32 {
+		return fmt.Errorf("invalidation id must be between 1 and 32 bytes")
+	}
+	if p.Timeout == 0 {
+		return fmt.Errorf("timeout cannot be zero")
+	}
+	return p.ToOutgoingLogicCall().ValidateBasic()
+}
+
+// ToOutgoingLogicCall builds the OutgoingLogicCall described by this proposal, CosmosBlockCreated
+// is left unset and must be populated by the caller when the call is stored
+func (p OutgoingLogicCallProposal) ToOutgoingLogicCall() OutgoingLogicCall {
+	return OutgoingLogicCall{
+		Transfers:            p.Transfers,
+		Fees:                 p.Fees,
+		LogicContractAddress: p.LogicContractAddress,
+		Payload:              p.Payload,
+		Timeout:              p.Timeout,
+		InvalidationId:       p.InvalidationId,
+		InvalidationNonce:    p.InvalidationNonce,
+		CosmosBlockCreated:   0,
+		Funded:               false,
+	}
+}
+
+func (p OutgoingLogicCallProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Outgoing Logic Call Proposal:
+  Title:              %s
+  Description:        %s
+  Evm Chain Prefix:   %s
+  Logic Contract:     %s
+  Transfers:          %v
+  Fees:               %v
+  Payload:            %x
+  Timeout:            %d
+  Invalidation Id:    %x
+  Invalidation Nonce: %d
+`, p.Title, p.Description, p.EvmChainPrefix, p.LogicContractAddress, p.Transfers, p.Fees, p.Payload, p.Timeout, p.InvalidationId, p.InvalidationNonce))
+	return b.String()
+}
+
+func (p *SetRateLimitProposal) GetTitle() string { return p.Title }
+
+func (p *SetRateLimitProposal) GetDescription() string { return p.Description }
+
+func (p *SetRateLimitProposal) ProposalRoute() string { return RouterKey }
+
+func (p *SetRateLimitProposal) ProposalType() string {
+	return ProposalTypeSetRateLimit
+}
+
+func (p *SetRateLimitProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	if p.IsRemoval() {
+		// only the rate limit's identity matters when removing it
+		if len(strings.TrimSpace(p.EvmChainPrefix)) == 0 {
+			return fmt.Errorf("evm chain prefix cannot be empty")
+		}
+		return sdk.ValidateDenom(p.Denom)
+	}
+	return p.ToRateLimit().ValidateBasic()
+}
+
+// IsRemoval returns true when the proposal removes the rate limit instead of setting one
+func (p SetRateLimitProposal) IsRemoval() bool {
+	return (p.MaxOutbound.IsNil() || p.MaxOutbound.IsZero()) && (p.MaxInbound.IsNil() || p.MaxInbound.IsZero())
+}
+
+// ToRateLimit builds the RateLimit described by this proposal
+func (p SetRateLimitProposal) ToRateLimit() RateLimit {
+	return RateLimit{
+		EvmChainPrefix: p.EvmChainPrefix,
+		Denom:          p.Denom,
+		WindowBlocks:   p.WindowBlocks,
+		MaxOutbound:    p.MaxOutbound,
+		MaxInbound:     p.MaxInbound,
+	}
+}
+
+func (p SetRateLimitProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Set Rate Limit Proposal:
+  Title:            %s
+  Description:      %s
+  Evm Chain Prefix: %s
+  Denom:            %s
+  Window Blocks:    %d
+  Max Outbound:     %s
+  Max Inbound:      %s
+`, p.Title, p.Description, p.EvmChainPrefix, p.Denom, p.WindowBlocks, p.MaxOutbound, p.MaxInbound))
+	return b.String()
+}
+
+func (p *ReleaseHeldSendToCosmosProposal) GetTitle() string { return p.Title }
+
+func (p *ReleaseHeldSendToCosmosProposal) GetDescription() string { return p.Description }
+
+func (p *ReleaseHeldSendToCosmosProposal) ProposalRoute() string { return RouterKey }
+
+func (p *ReleaseHeldSendToCosmosProposal) ProposalType() string {
+	return ProposalTypeReleaseHeldSendToCosmos
+}
+
+func (p *ReleaseHeldSendToCosmosProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	if len(strings.TrimSpace(p.EvmChainPrefix)) == 0 {
+		return fmt.Errorf("evm chain prefix cannot be empty")
+	}
+	seen := make(map[uint64]bool, len(p.EventNonces))
+	for _, nonce := range p.EventNonces {
+		if nonce == 0 {
+			return fmt.Errorf("event nonce cannot be zero")
+		}
+		if seen[nonce] {
+			return fmt.Errorf("duplicate event nonce %d", nonce)
+		}
+		seen[nonce] = true
+	}
+	return nil
+}
+
+func (p ReleaseHeldSendToCosmosProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Release Held SendToCosmos Proposal:
+  Title:            %s
+  Description:      %s
+  Evm Chain Prefix: %s
+  Event Nonces:     %v
+`, p.Title, p.Description, p.EvmChainPrefix, p.EventNonces))
+	return b.String()
+}
+
+func (p *SetIbcBridgeFeeProposal) GetTitle() string { return p.Title }
+
+func (p *SetIbcBridgeFeeProposal) GetDescription() string { return p.Description }
+
+func (p *SetIbcBridgeFeeProposal) ProposalRoute() string { return RouterKey }
+
+func (p *SetIbcBridgeFeeProposal) ProposalType() string {
+	return ProposalTypeSetIbcBridgeFee
+}
+
+func (p *SetIbcBridgeFeeProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	return p.ToIbcBridgeFee().ValidateBasic()
+}
+
+// IsRemoval returns true when the proposal removes the default bridge fee instead of setting one
+func (p SetIbcBridgeFeeProposal) IsRemoval() bool {
+	return p.BridgeFee.IsNil() || p.BridgeFee.IsZero()
+}
+
+// ToIbcBridgeFee builds the IbcBridgeFee described by this proposal
+func (p SetIbcBridgeFeeProposal) ToIbcBridgeFee() IbcBridgeFee {
+	bridgeFee := p.BridgeFee
+	if bridgeFee.IsNil() {
+		bridgeFee = sdk.ZeroInt()
+	}
+	return IbcBridgeFee{
+		EvmChainPrefix: p.EvmChainPrefix,
+		Denom:          p.Denom,
+		BridgeFee:      bridgeFee,
+	}
+}
+
+func (p SetIbcBridgeFeeProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Set IBC Bridge Fee Proposal:
+  Title:            %s
+  Description:      %s
+  Evm Chain Prefix: %s
+  Denom:            %s
+  Bridge Fee:       %s
+`, p.Title, p.Description, p.EvmChainPrefix, p.Denom, p.BridgeFee))
+	return b.String()
+}
+
+func (p *ResolveFailedAttestationProposal) GetTitle() string { return p.Title }
+
+func (p *ResolveFailedAttestationProposal) GetDescription() string { return p.Description }
+
+func (p *ResolveFailedAttestationProposal) ProposalRoute() string { return RouterKey }
+
+func (p *ResolveFailedAttestationProposal) ProposalType() string {
+	return ProposalTypeResolveFailedAttestation
+}
+
+func (p *ResolveFailedAttestationProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	if len(strings.TrimSpace(p.EvmChainPrefix)) == 0 {
+		return fmt.Errorf("evm chain prefix cannot be empty")
+	}
+	if p.EventNonce == 0 {
+		return fmt.Errorf("event nonce cannot be zero")
+	}
+	if p.IsRefund() {
+		if _, _, _, _, _, err := ParseReceiver(p.RefundReceiver); err != nil {
+			return fmt.Errorf("invalid refund receiver %s: %v", p.RefundReceiver, err)
+		}
+	}
+	return nil
+}
+
+// IsRefund returns true when the proposal redirects the failed deposit to a new receiver instead of re-executing
+// the claim unchanged
+func (p ResolveFailedAttestationProposal) IsRefund() bool {
+	return p.RefundReceiver != ""
+}
+
+func (p ResolveFailedAttestationProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Resolve Failed Attestation Proposal:
+  Title:            %s
+  Description:      %s
+  Evm Chain Prefix: %s
+  Event Nonce:      %d
+  Refund Receiver:  %s
+`, p.Title, p.Description, p.EvmChainPrefix, p.EventNonce, p.RefundReceiver))
+	return b.String()
+}
+
+func (p *AddBlacklistProposal) GetTitle() string { return p.Title }
+
+func (p *AddBlacklistProposal) GetDescription() string { return p.Description }
+
+func (p *AddBlacklistProposal) ProposalRoute() string { return RouterKey }
+
+func (p *AddBlacklistProposal) ProposalType() string {
+	return ProposalTypeAddBlacklist
+}
+
+func (p *AddBlacklistProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	if len(strings.TrimSpace(p.EvmChainPrefix)) == 0 {
+		return fmt.Errorf("evm chain prefix cannot be empty")
+	}
+	return validateBlacklistAddresses(p.Addresses)
+}
+
+func (p AddBlacklistProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Add Blacklist Proposal:
+  Title:               %s
+  Description:         %s
+  Evm Chain Prefix:    %s
+  Addresses:           %s
+  Expiry Block Height: %d
+`, p.Title, p.Description, p.EvmChainPrefix, strings.Join(p.Addresses, ", "), p.ExpiryBlockHeight))
+	return b.String()
+}
+
+func (p *RemoveBlacklistProposal) GetTitle() string { return p.Title }
+
+func (p *RemoveBlacklistProposal) GetDescription() string { return p.Description }
+
+func (p *RemoveBlacklistProposal) ProposalRoute() string { return RouterKey }
+
+func (p *RemoveBlacklistProposal) ProposalType() string {
+	return ProposalTypeRemoveBlacklist
+}
+
+func (p *RemoveBlacklistProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	if len(strings.TrimSpace(p.EvmChainPrefix)) == 0 {
+		return fmt.Errorf("evm chain prefix cannot be empty")
+	}
+	return validateBlacklistAddresses(p.Addresses)
+}
+
+func (p RemoveBlacklistProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Remove Blacklist Proposal:
+  Title:            %s
+  Description:      %s
+  Evm Chain Prefix: %s
+  Addresses:        %s
+`, p.Title, p.Description, p.EvmChainPrefix, strings.Join(p.Addresses, ", ")))
+	return b.String()
+}
+
+func (p *SetBatchStrategyProposal) GetTitle() string { return p.Title }
+
+func (p *SetBatchStrategyProposal) GetDescription() string { return p.Description }
+
+func (p *SetBatchStrategyProposal) ProposalRoute() string { return RouterKey }
+
+func (p *SetBatchStrategyProposal) ProposalType() string {
+	return ProposalTypeSetBatchStrategy
+}
+
+func (p *SetBatchStrategyProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	if p.IsRemoval() {
+		// only the strategy's identity matters when removing it
+		if len(strings.TrimSpace(p.EvmChainPrefix)) == 0 {
+			return fmt.Errorf("evm chain prefix cannot be empty")
+		}
+		if _, err := NewEthAddress(p.TokenContract); err != nil {
+			return fmt.Errorf("invalid token contract: %v", err)
+		}
+		return nil
+	}
+	return p.ToTokenBatchStrategy().ValidateBasic()
+}
+
+// IsRemoval returns true when the proposal removes the batch strategy of the token instead of setting one
+func (p SetBatchStrategyProposal) IsRemoval() bool {
+	return p.Strategy == BATCH_STRATEGY_UNSPECIFIED && p.MaxBatchSize == 0 && p.MaxWaitBlocks == 0 &&
+		(p.MinFeePerTx.IsNil() || p.MinFeePerTx.IsZero()) &&
+		(p.AutoBatchMinFees.IsNil() || p.AutoBatchMinFees.IsZero()) && p.AutoBatchMaxAge == 0
+}
+
+// ToTokenBatchStrategy builds the TokenBatchStrategy described by this proposal
+func (p SetBatchStrategyProposal) ToTokenBatchStrategy() TokenBatchStrategy {
+	return TokenBatchStrategy{
+		EvmChainPrefix:   p.EvmChainPrefix,
+		TokenContract:    p.TokenContract,
+		Strategy:         p.Strategy,
+		MaxBatchSize:     p.MaxBatchSize,
+		MaxWaitBlocks:    p.MaxWaitBlocks,
+		MinFeePerTx:      p.MinFeePerTx,
+		AutoBatchMinFees: p.AutoBatchMinFees,
+		AutoBatchMaxAge:  p.AutoBatchMaxAge,
+	}
+}
+
+func (p SetBatchStrategyProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Set Batch Strategy Proposal:
+  Title:               %s
+  Description:         %s
+  Evm Chain Prefix:    %s
+  Token Contract:      %s
+  Strategy:            %s
+  Max Batch Size:      %d
+  Max Wait Blocks:     %d
+  Min Fee Per Tx:      %s
+  Auto Batch Min Fees: %s
+  Auto Batch Max Age:  %d
+`, p.Title, p.Description, p.EvmChainPrefix, p.TokenContract, p.Strategy, p.MaxBatchSize, p.MaxWaitBlocks, p.MinFeePerTx,
+		p.AutoBatchMinFees, p.AutoBatchMaxAge))
+	return b.String()
+}
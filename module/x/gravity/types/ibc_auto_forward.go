@@ -1,6 +1,7 @@
 package types
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
@@ -26,3 +27,66 @@ func (p PendingIbcAutoForward) ValidateBasic() error {
 
 	return nil
 }
+
+// ValidateBasic performs stateless checks on an IbcAutoForwardLog
+func (l IbcAutoForwardLog) ValidateBasic() error {
+	if l.EvmChainPrefix == "" {
+		return sdkerrors.Wrap(ErrInvalid, "EvmChainPrefix is empty")
+	}
+
+	if l.EventNonce == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "EventNonce must be non-zero")
+	}
+
+	if l.ForeignReceiver == "" {
+		return sdkerrors.Wrapf(ErrInvalid, "ForeignReceiver is empty")
+	}
+
+	if !l.Token.IsValid() || l.Token.IsZero() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "Token %v must be valid and non-zero", l.Token)
+	}
+
+	if l.Forwarded && l.Error != "" {
+		return sdkerrors.Wrap(ErrInvalid, "a forwarded log cannot carry an error")
+	}
+
+	return nil
+}
+
+// ValidateBasic performs stateless checks on an IbcAutoForwardTransfer
+func (t IbcAutoForwardTransfer) ValidateBasic() error {
+	if t.EvmChainPrefix == "" {
+		return sdkerrors.Wrap(ErrInvalid, "EvmChainPrefix is empty")
+	}
+
+	if t.EventNonce == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "EventNonce must be non-zero")
+	}
+
+	if t.Channel == "" || t.Port == "" {
+		return sdkerrors.Wrap(ErrInvalid, "Port and Channel must not be empty strings")
+	}
+
+	if t.Sequence == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "Sequence must be non-zero")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(t.Fallback); err != nil {
+		return sdkerrors.Wrapf(err, "invalid Fallback %s", t.Fallback)
+	}
+
+	if !t.Token.IsValid() || t.Token.IsZero() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "Token %v must be valid and non-zero", t.Token)
+	}
+
+	if _, ok := IbcAutoForwardStatus_name[int32(t.Status)]; !ok || t.Status == IBC_AUTO_FORWARD_STATUS_UNSPECIFIED {
+		return sdkerrors.Wrapf(ErrInvalid, "invalid Status %v", t.Status)
+	}
+
+	return nil
+}
+
+// IsCompleted returns true once the transfer has been acknowledged or has timed out
+func (t IbcAutoForwardTransfer) IsCompleted() bool {
+	return t.Status != IBC_AUTO_FORWARD_STATUS_IN_FLIGHT
+}
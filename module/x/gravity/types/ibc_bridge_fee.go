@@ -0,0 +1,22 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ValidateBasic performs stateless checks on an IbcBridgeFee
+func (f IbcBridgeFee) ValidateBasic() error {
+	if len(strings.TrimSpace(f.EvmChainPrefix)) == 0 {
+		return fmt.Errorf("evm chain prefix cannot be empty")
+	}
+	if err := sdk.ValidateDenom(f.Denom); err != nil {
+		return err
+	}
+	if f.BridgeFee.IsNil() || f.BridgeFee.IsNegative() {
+		return fmt.Errorf("invalid bridge fee %v", f.BridgeFee)
+	}
+	return nil
+}
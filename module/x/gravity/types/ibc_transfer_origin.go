@@ -0,0 +1,32 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	host "github.com/cosmos/ibc-go/v4/modules/core/24-host"
+)
+
+// ValidateBasic performs stateless checks on an IbcTransferOrigin, the sender is an address on the
+// counterparty chain and so is only required to be present
+func (o IbcTransferOrigin) ValidateBasic() error {
+	if len(strings.TrimSpace(o.EvmChainPrefix)) == 0 {
+		return fmt.Errorf("evm chain prefix cannot be empty")
+	}
+	if o.TxId == 0 {
+		return fmt.Errorf("ibc transfer origin has a zero tx id")
+	}
+	if err := host.PortIdentifierValidator(o.Port); err != nil {
+		return fmt.Errorf("ibc transfer origin has an invalid port: %v", err)
+	}
+	if err := host.ChannelIdentifierValidator(o.Channel); err != nil {
+		return fmt.Errorf("ibc transfer origin has an invalid channel: %v", err)
+	}
+	if o.Sequence == 0 {
+		return fmt.Errorf("ibc transfer origin has a zero sequence")
+	}
+	if len(strings.TrimSpace(o.Sender)) == 0 {
+		return fmt.Errorf("ibc transfer origin has an empty sender")
+	}
+	return nil
+}
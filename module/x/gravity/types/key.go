@@ -3,6 +3,7 @@ package types
 import (
 	"encoding/hex"
 	"fmt"
+	"math"
 	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -103,6 +104,10 @@ var (
 	// [0x391e8708521fb085676169e8fb232cda]
 	KeyOrchestratorAddress = HashString("KeyOrchestratorAddress")
 
+	// OrchestratorByValidatorKey indexes the orchestrator of a validator, the reverse of KeyOrchestratorAddress
+	// [0x93a4ebefe3e88f30e1f828cb94d95e9f]
+	OrchestratorByValidatorKey = HashString("OrchestratorByValidatorKey")
+
 	// KeyOutgoingLogicCall indexes the outgoing logic calls
 	// [0x98dfff23346c13a1747fbbed5b23d248]
 	KeyOutgoingLogicCall = HashString("KeyOutgoingLogicCall")
@@ -143,6 +148,10 @@ var (
 	// [0x06a6b30651341e80276e0d2e19449250]
 	LastUnBondingBlockHeight = HashString("LastUnBondingBlockHeight")
 
+	// LastDelegateKeyChangeBlockHeight indexes the last block height a validator set its delegate keys at
+	// [0x0fdfa45ca3c1acfc8b5c3152a3014bdb]
+	LastDelegateKeyChangeBlockHeight = HashString("LastDelegateKeyChangeBlockHeight")
+
 	// PastEthSignatureCheckpointKey indexes eth signature checkpoints that have existed
 	// [0x1cbe0be407a979331b98e599eeedd09f]
 	PastEthSignatureCheckpointKey = HashString("PastEthSignatureCheckpointKey")
@@ -166,15 +175,88 @@ var (
 	// EvmChainKey indexes EVM chains supported on cosmos
 	// [0x0a4fce7411f743f9198f56c8f706cd0d]
 	EvmChainKey = HashString("EvmChainKey")
-	// MonitoredERC20TokensKey indexes the list of ERC20 tokens which orchestrators are required to monitor
+	// MonitoredERC20TokensKey indexes the list of ERC20 tokens which orchestrators are required to monitor,
+	// one list per evm chain
+	// [0x69b5440573e04e78e75a636ee89e0d01]
 	MonitoredERC20TokensKey = HashString("MonitoredERC20Tokens")
 
 	// BridgeBalanceSnapshotsKey indexes the x/bank supply of Ethereum originated tokens and
 	// Cosmos originated tokens which have been deployed on Ethereum for every
 	// Attestation application along with Attestation Eth block height and Cosmos application height
-	// The entries are indexed by Attestation Event Nonce
+	// The entries are indexed by evm chain prefix and Attestation Event Nonce
 	// [0xcd68f89bc0dc4b49109abf2f433e2321]
 	BridgeBalanceSnapshotsKey = HashString("BridgeBalanceSnapshots")
+
+	// RateLimitKey indexes the governance set RateLimits by evm chain prefix and denom
+	// [0xbf02ca69fe906e05fc1aa83a908dc407]
+	RateLimitKey = HashString("RateLimitKey")
+
+	// RateLimitFlowKey indexes the amounts bridged in the current window of each RateLimit
+	// [0x04e0481414cd7ee19d92b50993cecc14]
+	RateLimitFlowKey = HashString("RateLimitFlowKey")
+
+	// HeldSendToCosmosKey indexes SendToCosmos deposits held back by an inbound RateLimit, queued by event nonce
+	// [0x7b42dbf7491d25882a823f2741144ebb]
+	HeldSendToCosmosKey = HashString("HeldSendToCosmosKey")
+
+	// HeldSendToCosmosDenomKey counts the held SendToCosmos deposits of each evm chain and denom
+	// [0xc23730acf8ec255a02f216b794100b10]
+	HeldSendToCosmosDenomKey = HashString("HeldSendToCosmosDenomKey")
+
+	// HeldSendToCosmosByDenomKey indexes the held SendToCosmos deposits of each evm chain by denom and event nonce, so
+	// that the queue of a denom is released without walking the deposits of other denoms
+	// [0x59050cb50f2e9c83a8cfe404eccd22f8]
+	HeldSendToCosmosByDenomKey = HashString("HeldSendToCosmosByDenomKey")
+
+	// IbcBridgeFeeKey indexes the governance set default bridge fees of IBC to evm chain transfers by evm chain and denom
+	// [0xad336b37ffe79a8d3d0b0c7650ffec31]
+	IbcBridgeFeeKey = HashString("IbcBridgeFeeKey")
+
+	// IbcTransferOriginKey indexes the IBC packet origins of outgoing pool txs created by the ibc middleware by evm chain and tx id
+	// [0x7911a3bede133240f1d3511cd226f4f6]
+	IbcTransferOriginKey = HashString("IbcTransferOriginKey")
+
+	// IbcAutoForwardLogKey indexes the outcomes of processed IBC auto forwards by evm chain and event nonce
+	// [0x11862c1816ed83e7aa22a11a7bdbd9d9]
+	IbcAutoForwardLogKey = HashString("IbcAutoForwardLogKey")
+
+	// IbcAutoForwardTransferKey indexes the ibc transfers sent for IBC auto forwards by source channel and sequence,
+	// the key carries no evm chain as acknowledgements and timeouts only identify the packet
+	// [0x92e8b400e9c30fb0497b6601ed774b70]
+	IbcAutoForwardTransferKey = HashString("IbcAutoForwardTransferKey")
+
+	// IbcAutoForwardTransferByNonceKey indexes the keys of ibc transfers sent for IBC auto forwards by evm chain and event nonce
+	// [0x518d7b653da6427a563579a032e66042]
+	IbcAutoForwardTransferByNonceKey = HashString("IbcAutoForwardTransferByNonceKey")
+
+	// FailedAttestationKey indexes observed attestations whose handler failed by evm chain and event nonce
+	// [0x9f204082835a78337ff9c6c12c1ce4b0]
+	FailedAttestationKey = HashString("FailedAttestationKey")
+
+	// EvmChainDecommissionKey indexes the decommissioning reports of evm chains removed by governance
+	// [0x59cf6188f043a3605a1e52677379a7e4]
+	EvmChainDecommissionKey = HashString("EvmChainDecommissionKey")
+
+	// BlacklistKey indexes the evm and bech32 addresses blacklisted by governance by evm chain
+	// [0x2c4448fb202de7d30b69aa1582e8d85e]
+	BlacklistKey = HashString("BlacklistKey")
+
+	// BatchStrategyKey indexes the governance set TokenBatchStrategies by evm chain prefix and token contract
+	// [0x46612c47fede960f7c865eccb2cb9421]
+	BatchStrategyKey = HashString("BatchStrategyKey")
+
+	// BridgedSupplyKey indexes the bridged supply ledger by evm chain prefix and token contract
+	// [0xf41ac357c12ceb66dba727c72925897b]
+	BridgedSupplyKey = HashString("BridgedSupplyKey")
+
+	// EvidenceRecordKey indexes the accepted evidence of bad and double signing by evm chain prefix and evidence id
+	// [0xf0fe4beca9082779f7390b9a0fb12318]
+	EvidenceRecordKey = HashString("EvidenceRecordKey")
+
+	// OutgoingTxPoolAgeKey indexes the pool keys of the unbatched txs by evm chain prefix, token contract and tx id, so
+	// that the pool of a token can be visited in the order its txs entered it
+	// [0xfbf08b2b7f390a4db55cafd3fd48c0c6]
+	OutgoingTxPoolAgeKey = HashString("OutgoingTxPoolAgeKey")
 )
 
 // GetOrchestratorAddressKey returns the following key format
@@ -187,6 +269,16 @@ func GetOrchestratorAddressKey(orc sdk.AccAddress) []byte {
 	return AppendBytes(KeyOrchestratorAddress, orc.Bytes())
 }
 
+// GetOrchestratorByValidatorKey returns the following key format
+// prefix              cosmos-validator
+// [0x0][gravityvaloper1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm]
+func GetOrchestratorByValidatorKey(validator sdk.ValAddress) []byte {
+	if err := sdk.VerifyAddressFormat(validator); err != nil {
+		panic(sdkerrors.Wrap(err, "invalid validator address"))
+	}
+	return AppendBytes(OrchestratorByValidatorKey, validator.Bytes())
+}
+
 // GetEthAddressByValidatorKey returns the following key format
 // prefix              cosmos-validator
 // [0x0][gravityvaloper1ahx7f8wyertuus9r20284ej0asrs085ceqtfnm]
@@ -357,6 +449,18 @@ func AppendChainPrefix(prefix []byte, evmChainPrefix string) []byte {
 	return AppendBytes(prefix, []byte(evmChainPrefix))
 }
 
+// AppendDelimitedChainPrefix appends the length of the chain prefix and the chain prefix to prefix. Unlike with
+// AppendChainPrefix the keys of one chain never begin with the prefix of another chain, such as "eth" and "ethereum",
+// so iterating over the returned prefix yields exactly the keys of the chain
+// prefix  length  chain
+// [0x0][8][ethereum]
+func AppendDelimitedChainPrefix(prefix []byte, evmChainPrefix string) []byte {
+	if len(evmChainPrefix) > math.MaxUint8 {
+		panic(fmt.Sprintf("evm chain prefix %s is longer than %d bytes", evmChainPrefix, math.MaxUint8))
+	}
+	return AppendBytes(prefix, []byte{byte(len(evmChainPrefix))}, []byte(evmChainPrefix))
+}
+
 // This function is broken and it should not be used in other places except in GetPastEthSignatureCheckpointKey
 func convertByteArrToString(value []byte) string {
 	var ret strings.Builder
@@ -366,22 +470,166 @@ func convertByteArrToString(value []byte) string {
 	return ret.String()
 }
 
+// GetMonitoredERC20TokensKey returns the following key format
+// prefix		evmChainPrefix
+// [0x69b5440573e04e78e75a636ee89e0d01][ethereum]
+func GetMonitoredERC20TokensKey(evmChainPrefix string) []byte {
+	return AppendChainPrefix(MonitoredERC20TokensKey, evmChainPrefix)
+}
+
 // GetBridgeBalanceSnapshotKey returns the following key format
-// prefix		EventNonce
-// [0xcd68f89bc0dc4b49109abf2f433e2321][0 0 0 0 0 0 0 1]
+// prefix		length	evmChainPrefix	EventNonce
+// [0xcd68f89bc0dc4b49109abf2f433e2321][8][ethereum][0 0 0 0 0 0 0 1]
 func GetBridgeBalanceSnapshotKey(eventNonce uint64, evmChainPrefix string) []byte {
-	return AppendBytes(BridgeBalanceSnapshotsKey, UInt64Bytes(eventNonce), []byte(evmChainPrefix))
+	return AppendBytes(AppendDelimitedChainPrefix(BridgeBalanceSnapshotsKey, evmChainPrefix), UInt64Bytes(eventNonce))
 }
 
-// ExtractNonceFromBridgeBalanceSnapshotKey will return only the EventNonce portion of a
+// ExtractNonceFromBridgeBalanceSnapshotKey will return the EventNonce and evm chain prefix portions of a
 // BridgeBalanceSnapshot's store key, see GetBridgeBalanceSnapshotKey() for more info
 func ExtractNonceFromBridgeBalanceSnapshotKey(key []byte) (uint64, string, error) {
 	prefixLen := len(BridgeBalanceSnapshotsKey) // the length of the index to these values
 
-	nonce := key[prefixLen : prefixLen+8] // These keys only have a prefix and the nonce, so grab the end of the key
-	if len(nonce) < 8 {
-		return 0, "", fmt.Errorf("invalid uint64 event nonce bytes %v", hex.EncodeToString(nonce))
+	if len(key) < prefixLen+1 || len(key) != prefixLen+1+int(key[prefixLen])+8 {
+		return 0, "", fmt.Errorf("invalid bridge balance snapshot key %v", hex.EncodeToString(key))
 	}
-	evmChainPrefix := string(key[prefixLen+8:])
+	nonce := key[len(key)-8:] // The nonce is always the last 8 bytes of the key
+	evmChainPrefix := string(key[prefixLen+1 : len(key)-8])
 	return UInt64FromBytesUnsafe(nonce), evmChainPrefix, nil
 }
+
+// GetRateLimitKey returns the following key format
+// prefix		length	evmChainPrefix	denom
+// [0xbf02ca69fe906e05fc1aa83a908dc407][8][ethereum][ugraviton]
+func GetRateLimitKey(evmChainPrefix string, denom string) []byte {
+	return AppendBytes(AppendDelimitedChainPrefix(RateLimitKey, evmChainPrefix), []byte(denom))
+}
+
+// GetRateLimitFlowKey returns the following key format
+// prefix		length	evmChainPrefix	denom
+// [0x04e0481414cd7ee19d92b50993cecc14][8][ethereum][ugraviton]
+func GetRateLimitFlowKey(evmChainPrefix string, denom string) []byte {
+	return AppendBytes(AppendDelimitedChainPrefix(RateLimitFlowKey, evmChainPrefix), []byte(denom))
+}
+
+// GetHeldSendToCosmosKey returns the following key format
+// prefix		length	evmChainPrefix	EventNonce
+// [0x7b42dbf7491d25882a823f2741144ebb][8][ethereum][0 0 0 0 0 0 0 1]
+func GetHeldSendToCosmosKey(evmChainPrefix string, eventNonce uint64) []byte {
+	return AppendBytes(AppendDelimitedChainPrefix(HeldSendToCosmosKey, evmChainPrefix), UInt64Bytes(eventNonce))
+}
+
+// GetHeldSendToCosmosDenomKey returns the following key format
+// prefix		length	evmChainPrefix	denom
+// [0xc23730acf8ec255a02f216b794100b10][8][ethereum][ugraviton]
+func GetHeldSendToCosmosDenomKey(evmChainPrefix string, denom string) []byte {
+	return AppendBytes(AppendDelimitedChainPrefix(HeldSendToCosmosDenomKey, evmChainPrefix), []byte(denom))
+}
+
+// GetHeldSendToCosmosByDenomPrefix returns the following key format
+// prefix		length	evmChainPrefix	length	denom
+// [0x59050cb50f2e9c83a8cfe404eccd22f8][8][ethereum][9][ugraviton]
+func GetHeldSendToCosmosByDenomPrefix(evmChainPrefix string, denom string) []byte {
+	if len(denom) > math.MaxUint8 {
+		panic(fmt.Sprintf("denom %s is longer than %d bytes", denom, math.MaxUint8))
+	}
+	return AppendBytes(AppendDelimitedChainPrefix(HeldSendToCosmosByDenomKey, evmChainPrefix), []byte{byte(len(denom))}, []byte(denom))
+}
+
+// GetHeldSendToCosmosByDenomKey returns the following key format
+// prefix		length	evmChainPrefix	length	denom	EventNonce
+// [0x59050cb50f2e9c83a8cfe404eccd22f8][8][ethereum][9][ugraviton][0 0 0 0 0 0 0 1]
+func GetHeldSendToCosmosByDenomKey(evmChainPrefix string, denom string, eventNonce uint64) []byte {
+	return AppendBytes(GetHeldSendToCosmosByDenomPrefix(evmChainPrefix, denom), UInt64Bytes(eventNonce))
+}
+
+// GetIbcBridgeFeeKey returns the following key format
+// prefix		length	evmChainPrefix	denom
+// [0xad336b37ffe79a8d3d0b0c7650ffec31][8][ethereum][ugraviton]
+func GetIbcBridgeFeeKey(evmChainPrefix string, denom string) []byte {
+	return AppendBytes(AppendDelimitedChainPrefix(IbcBridgeFeeKey, evmChainPrefix), []byte(denom))
+}
+
+// GetIbcTransferOriginKey returns the following key format
+// prefix		length	evmChainPrefix	txId
+// [0x7911a3bede133240f1d3511cd226f4f6][8][ethereum][0 0 0 0 0 0 0 1]
+func GetIbcTransferOriginKey(evmChainPrefix string, txId uint64) []byte {
+	return AppendBytes(AppendDelimitedChainPrefix(IbcTransferOriginKey, evmChainPrefix), UInt64Bytes(txId))
+}
+
+// GetIbcAutoForwardLogKey returns the following key format
+// prefix		length	evmChainPrefix	eventNonce
+// [0x11862c1816ed83e7aa22a11a7bdbd9d9][8][ethereum][0 0 0 0 0 0 0 1]
+func GetIbcAutoForwardLogKey(evmChainPrefix string, eventNonce uint64) []byte {
+	return AppendBytes(AppendDelimitedChainPrefix(IbcAutoForwardLogKey, evmChainPrefix), UInt64Bytes(eventNonce))
+}
+
+// GetIbcAutoForwardTransferKey returns the following key format
+// prefix		channel		sequence
+// [0x92e8b400e9c30fb0497b6601ed774b70][channel-0][0 0 0 0 0 0 0 1]
+func GetIbcAutoForwardTransferKey(channel string, sequence uint64) []byte {
+	return AppendBytes(IbcAutoForwardTransferKey, []byte(channel), UInt64Bytes(sequence))
+}
+
+// GetIbcAutoForwardTransferByNonceKey returns the following key format
+// prefix		length	evmChainPrefix	eventNonce
+// [0x518d7b653da6427a563579a032e66042][8][ethereum][0 0 0 0 0 0 0 1]
+func GetIbcAutoForwardTransferByNonceKey(evmChainPrefix string, eventNonce uint64) []byte {
+	return AppendBytes(AppendDelimitedChainPrefix(IbcAutoForwardTransferByNonceKey, evmChainPrefix), UInt64Bytes(eventNonce))
+}
+
+// GetFailedAttestationKey returns the following key format
+// prefix		length	evmChainPrefix	eventNonce
+// [0x9f204082835a78337ff9c6c12c1ce4b0][8][ethereum][0 0 0 0 0 0 0 1]
+func GetFailedAttestationKey(evmChainPrefix string, eventNonce uint64) []byte {
+	return AppendBytes(AppendDelimitedChainPrefix(FailedAttestationKey, evmChainPrefix), UInt64Bytes(eventNonce))
+}
+
+// GetEvmChainDecommissionKey returns the following key format
+// prefix		evmChainPrefix
+// [0x59cf6188f043a3605a1e52677379a7e4][ethereum]
+func GetEvmChainDecommissionKey(evmChainPrefix string) []byte {
+	return AppendChainPrefix(EvmChainDecommissionKey, evmChainPrefix)
+}
+
+// GetBlacklistKey returns the following key format, see BlacklistAddressBytes for the address bytes
+// prefix		length	evmChainPrefix	addressBytes
+// [0x2c4448fb202de7d30b69aa1582e8d85e][8][ethereum][0x01 0xc783df8a850f42e7F7e57013759C285caa701eB6]
+func GetBlacklistKey(evmChainPrefix string, addressBytes []byte) []byte {
+	return AppendBytes(AppendDelimitedChainPrefix(BlacklistKey, evmChainPrefix), addressBytes)
+}
+
+// GetBatchStrategyKey returns the following key format
+// prefix		length	evmChainPrefix	tokenContract
+// [0x46612c47fede960f7c865eccb2cb9421][8][ethereum][0xc783df8a850f42e7F7e57013759C285caa701eB6]
+func GetBatchStrategyKey(evmChainPrefix string, tokenContract EthAddress) []byte {
+	return AppendBytes(AppendDelimitedChainPrefix(BatchStrategyKey, evmChainPrefix), tokenContract.GetAddress().Bytes())
+}
+
+// GetBridgedSupplyKey returns the following key format
+// prefix		length	evmChainPrefix	tokenContract
+// [0xf41ac357c12ceb66dba727c72925897b][8][ethereum][0xc783df8a850f42e7F7e57013759C285caa701eB6]
+func GetBridgedSupplyKey(evmChainPrefix string, tokenContract EthAddress) []byte {
+	return AppendBytes(AppendDelimitedChainPrefix(BridgedSupplyKey, evmChainPrefix), tokenContract.GetAddress().Bytes())
+}
+
+// GetEvidenceRecordKey returns the following key format, see EvidenceID for the id
+// prefix		length	evmChainPrefix	id
+// [0xf0fe4beca9082779f7390b9a0fb12318][8][ethereum][0x9c960b2cf03ca53de4c7f77d0d16afe5dbad4b568b0e89c040c760701aa0b57c]
+func GetEvidenceRecordKey(evmChainPrefix string, id []byte) []byte {
+	return AppendBytes(AppendDelimitedChainPrefix(EvidenceRecordKey, evmChainPrefix), id)
+}
+
+// GetOutgoingTxPoolAgeContractPrefix returns the following format
+// prefix		length	evmChainPrefix	tokenContract
+// [0xfbf08b2b7f390a4db55cafd3fd48c0c6][8][ethereum][0xc783df8a850f42e7F7e57013759C285caa701eB6]
+// This prefix is used for iterating over the unbatched transactions of a token contract by tx id
+func GetOutgoingTxPoolAgeContractPrefix(evmChainPrefix string, tokenContract EthAddress) []byte {
+	return AppendBytes(AppendDelimitedChainPrefix(OutgoingTxPoolAgeKey, evmChainPrefix), tokenContract.GetAddress().Bytes())
+}
+
+// GetOutgoingTxPoolAgeKey returns the following key format
+// prefix		length	evmChainPrefix	tokenContract									id
+// [0xfbf08b2b7f390a4db55cafd3fd48c0c6][8][ethereum][0xc783df8a850f42e7F7e57013759C285caa701eB6][0 0 0 0 0 0 0 1]
+func GetOutgoingTxPoolAgeKey(evmChainPrefix string, tokenContract EthAddress, id uint64) []byte {
+	return AppendBytes(GetOutgoingTxPoolAgeContractPrefix(evmChainPrefix, tokenContract), UInt64Bytes(id))
+}
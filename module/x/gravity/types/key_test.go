@@ -1,6 +1,8 @@
 package types
 
 import (
+	"bytes"
+	"strings"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -27,6 +29,34 @@ func TestNoDuplicateKeys(t *testing.T) {
 	}
 }
 
+func TestAppendDelimitedChainPrefix(t *testing.T) {
+	// the keys of a chain never begin with the prefix of a chain whose prefix begins the same way
+	eth := AppendDelimitedChainPrefix(RateLimitKey, "eth")
+	ethereum := AppendDelimitedChainPrefix(RateLimitKey, "ethereum")
+	require.False(t, bytes.HasPrefix(GetRateLimitKey("ethereum", "footoken"), eth))
+	require.False(t, bytes.HasPrefix(GetRateLimitKey("eth", "ereumfootoken"), ethereum))
+	require.True(t, bytes.HasPrefix(GetRateLimitKey("eth", "footoken"), eth))
+
+	require.Panics(t, func() { AppendDelimitedChainPrefix(RateLimitKey, strings.Repeat("x", 256)) })
+}
+
+func TestBridgeBalanceSnapshotKey(t *testing.T) {
+	// the snapshots of "eth" are not iterated with those of "ethereum"
+	eth := AppendDelimitedChainPrefix(BridgeBalanceSnapshotsKey, "eth")
+	require.False(t, bytes.HasPrefix(GetBridgeBalanceSnapshotKey(1, "ethereum"), eth))
+	require.True(t, bytes.HasPrefix(GetBridgeBalanceSnapshotKey(1, "eth"), eth))
+
+	nonce, evmChainPrefix, err := ExtractNonceFromBridgeBalanceSnapshotKey(GetBridgeBalanceSnapshotKey(7, "ethereum"))
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), nonce)
+	require.Equal(t, "ethereum", evmChainPrefix)
+
+	// legacy keys without the length of the chain prefix are rejected
+	legacy := AppendBytes(BridgeBalanceSnapshotsKey, UInt64Bytes(7), []byte("ethereum"))
+	_, _, err = ExtractNonceFromBridgeBalanceSnapshotKey(legacy)
+	require.Error(t, err)
+}
+
 func getAllKeys() [][]byte {
 	i := 0
 	inc := func(i *int) *int { *i += 1; return i }
@@ -6,6 +6,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/gogo/protobuf/proto"
 	"github.com/tendermint/tendermint/crypto/tmhash"
 )
@@ -16,6 +17,8 @@ var (
 	_ sdk.Msg = &MsgValsetConfirm{}
 	_ sdk.Msg = &MsgSendToEth{}
 	_ sdk.Msg = &MsgCancelSendToEth{}
+	_ sdk.Msg = &MsgIncreaseBridgeFee{}
+	_ sdk.Msg = &MsgRequestBatchCancellation{}
 	_ sdk.Msg = &MsgRequestBatch{}
 	_ sdk.Msg = &MsgConfirmBatch{}
 	_ sdk.Msg = &MsgERC20DeployedClaim{}
@@ -26,6 +29,7 @@ var (
 	_ sdk.Msg = &MsgBatchSendToEthClaim{}
 	_ sdk.Msg = &MsgValsetUpdatedClaim{}
 	_ sdk.Msg = &MsgSubmitBadSignatureEvidence{}
+	_ sdk.Msg = &MsgSubmitDoubleSignEvidence{}
 )
 
 // NewMsgSetOrchestratorAddress returns a new msgSetOrchestratorAddress
@@ -54,9 +58,28 @@ func (msg *MsgSetOrchestratorAddress) ValidateBasic() (err error) {
 	if err := ValidateEthAddress(msg.EthAddress); err != nil {
 		return sdkerrors.Wrap(err, "ethereum address")
 	}
+	if len(msg.EthSignature) > 0 {
+		ethAddr, _ := NewEthAddress(msg.EthAddress)
+		// validation recovers the address from a copy, it normalizes the V value of the signature it is given
+		signature := append([]byte{}, msg.EthSignature...)
+		if err := ValidateEthereumSignature(DelegateKeysSignHash(msg.Validator, msg.Orchestrator), signature, *ethAddr); err != nil {
+			return sdkerrors.Wrap(err, "ethereum signature")
+		}
+	}
 	return nil
 }
 
+// DelegateKeysSignHash returns the hash signed by the ethereum key of a MsgSetOrchestratorAddress, the keccak256 hash
+// of the encoded DelegateKeysSignMsg of the validator and orchestrator
+func DelegateKeysSignHash(validator string, orchestrator string) []byte {
+	signMsg := DelegateKeysSignMsg{ValidatorAddress: validator, OrchestratorAddress: orchestrator}
+	bz, err := signMsg.Marshal()
+	if err != nil {
+		panic(sdkerrors.Wrap(err, "unable to encode DelegateKeysSignMsg"))
+	}
+	return crypto.Keccak256(bz)
+}
+
 // GetSignBytes encodes the message for signing
 func (msg *MsgSetOrchestratorAddress) GetSignBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
@@ -171,6 +194,9 @@ func (msg MsgSendToEth) ValidateBasic() error {
 	if !msg.ChainFee.IsValid() {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "chain fee")
 	}
+	if msg.ExtraFee != nil && (!msg.ExtraFee.IsValid() || !msg.ExtraFee.IsPositive()) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "extra fee")
+	}
 	if err := ValidateEthAddress(msg.EthDest); err != nil {
 		return sdkerrors.Wrap(err, "ethereum address")
 	}
@@ -515,12 +541,22 @@ func (e *MsgBatchSendToEthClaim) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(e.Orchestrator); err != nil {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, e.Orchestrator)
 	}
+	if e.Relayer != "" {
+		if err := ValidateEthAddress(e.Relayer); err != nil {
+			return sdkerrors.Wrap(err, "relayer")
+		}
+	}
 	return nil
 }
 
 // Hash implements WithdrawBatch.Hash, add evm chain prefix at top
 func (msg *MsgBatchSendToEthClaim) ClaimHash() ([]byte, error) {
 	path := fmt.Sprintf("%d/%d/%d/%s", msg.EventNonce, msg.EthBlockHeight, msg.BatchNonce, msg.TokenContract)
+	// the relayer is only hashed when reported, which the msg server only lets through once the evm chain's
+	// RelayerInBatchClaims param is set
+	if msg.Relayer != "" {
+		path = fmt.Sprintf("%s/%s", path, msg.Relayer)
+	}
 	return tmhash.Sum([]byte(path)), nil
 }
 
@@ -832,6 +868,102 @@ func (msg *MsgCancelSendToEth) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{acc}
 }
 
+// NewMsgIncreaseBridgeFee returns a new MsgIncreaseBridgeFee
+func NewMsgIncreaseBridgeFee(evmChainPrefix string, user sdk.AccAddress, id uint64, addedFee sdk.Coin) *MsgIncreaseBridgeFee {
+	return &MsgIncreaseBridgeFee{
+		Sender:         user.String(),
+		TransactionId:  id,
+		EvmChainPrefix: evmChainPrefix,
+		AddedFee:       addedFee,
+	}
+}
+
+// Route should return the name of the module
+func (msg *MsgIncreaseBridgeFee) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg *MsgIncreaseBridgeFee) Type() string { return "increase_bridge_fee" }
+
+// ValidateBasic performs stateless checks
+func (msg *MsgIncreaseBridgeFee) ValidateBasic() (err error) {
+	_, err = sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Sender)
+	}
+	if msg.EvmChainPrefix == "" {
+		return fmt.Errorf("evm_chain_prefix is empty")
+	}
+	if msg.TransactionId == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "transaction id cannot be zero")
+	}
+	if !msg.AddedFee.IsValid() || !msg.AddedFee.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "added fee")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgIncreaseBridgeFee) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg *MsgIncreaseBridgeFee) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{acc}
+}
+
+// NewMsgRequestBatchCancellation returns a new MsgRequestBatchCancellation
+func NewMsgRequestBatchCancellation(evmChainPrefix string, user sdk.AccAddress, tokenContract EthAddress, nonce uint64) *MsgRequestBatchCancellation {
+	return &MsgRequestBatchCancellation{
+		Sender:         user.String(),
+		EvmChainPrefix: evmChainPrefix,
+		TokenContract:  tokenContract.GetAddress().Hex(),
+		Nonce:          nonce,
+	}
+}
+
+// Route should return the name of the module
+func (msg *MsgRequestBatchCancellation) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg *MsgRequestBatchCancellation) Type() string { return "request_batch_cancellation" }
+
+// ValidateBasic performs stateless checks
+func (msg *MsgRequestBatchCancellation) ValidateBasic() (err error) {
+	_, err = sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.Sender)
+	}
+	if msg.EvmChainPrefix == "" {
+		return fmt.Errorf("evm_chain_prefix is empty")
+	}
+	if err := ValidateEthAddress(msg.TokenContract); err != nil {
+		return sdkerrors.Wrap(err, "erc20 token")
+	}
+	if msg.Nonce == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "nonce cannot be zero")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgRequestBatchCancellation) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg *MsgRequestBatchCancellation) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{acc}
+}
+
 // MsgSubmitBadSignatureEvidence
 // ======================================================
 
@@ -863,3 +995,41 @@ func (msg MsgSubmitBadSignatureEvidence) Type() string { return "Submit_Bad_Sign
 
 // Route should return the name of the module
 func (msg MsgSubmitBadSignatureEvidence) Route() string { return RouterKey }
+
+// MsgSubmitDoubleSignEvidence
+// ======================================================
+
+// ValidateBasic performs stateless checks
+func (e *MsgSubmitDoubleSignEvidence) ValidateBasic() (err error) {
+	_, err = sdk.AccAddressFromBech32(e.Sender)
+	if err != nil {
+		return err
+	}
+	if e.SubjectA == nil || e.SubjectB == nil {
+		return sdkerrors.Wrap(ErrEmpty, "subject")
+	}
+	if e.SignatureA == "" || e.SignatureB == "" {
+		return sdkerrors.Wrap(ErrEmpty, "signature")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgSubmitDoubleSignEvidence) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgSubmitDoubleSignEvidence) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic("Invalid signer for MsgSubmitDoubleSignEvidence")
+	}
+	return []sdk.AccAddress{acc}
+}
+
+// Type should return the action
+func (msg MsgSubmitDoubleSignEvidence) Type() string { return "Submit_Double_Sign_Evidence" }
+
+// Route should return the name of the module
+func (msg MsgSubmitDoubleSignEvidence) Route() string { return RouterKey }
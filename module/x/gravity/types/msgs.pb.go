@@ -46,10 +46,15 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // ETH_ADDRESS
 // This is a hex encoded 0x Ethereum public key that will be used by this
 // validator on Ethereum
+// ETH_SIGNATURE
+// Optional proof that the owner of eth_address approves the delegation, an
+// Ethereum signature by eth_address over the keccak256 hash of the encoded
+// DelegateKeysSignMsg of the validator and orchestrator
 type MsgSetOrchestratorAddress struct {
 	Validator    string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
 	Orchestrator string `protobuf:"bytes,2,opt,name=orchestrator,proto3" json:"orchestrator,omitempty"`
 	EthAddress   string `protobuf:"bytes,3,opt,name=eth_address,json=ethAddress,proto3" json:"eth_address,omitempty"`
+	EthSignature []byte `protobuf:"bytes,4,opt,name=eth_signature,json=ethSignature,proto3" json:"eth_signature,omitempty"`
 }
 
 func (m *MsgSetOrchestratorAddress) Reset()         { *m = MsgSetOrchestratorAddress{} }
@@ -106,6 +111,67 @@ func (m *MsgSetOrchestratorAddress) GetEthAddress() string {
 	return ""
 }
 
+func (m *MsgSetOrchestratorAddress) GetEthSignature() []byte {
+	if m != nil {
+		return m.EthSignature
+	}
+	return nil
+}
+
+// DelegateKeysSignMsg is the message signed by the Ethereum key of a
+// MsgSetOrchestratorAddress, binding it to the validator and orchestrator
+type DelegateKeysSignMsg struct {
+	ValidatorAddress    string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	OrchestratorAddress string `protobuf:"bytes,2,opt,name=orchestrator_address,json=orchestratorAddress,proto3" json:"orchestrator_address,omitempty"`
+}
+
+func (m *DelegateKeysSignMsg) Reset()         { *m = DelegateKeysSignMsg{} }
+func (m *DelegateKeysSignMsg) String() string { return proto.CompactTextString(m) }
+func (*DelegateKeysSignMsg) ProtoMessage()    {}
+func (*DelegateKeysSignMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{1}
+}
+func (m *DelegateKeysSignMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegateKeysSignMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegateKeysSignMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelegateKeysSignMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegateKeysSignMsg.Merge(m, src)
+}
+func (m *DelegateKeysSignMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegateKeysSignMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegateKeysSignMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegateKeysSignMsg proto.InternalMessageInfo
+
+func (m *DelegateKeysSignMsg) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *DelegateKeysSignMsg) GetOrchestratorAddress() string {
+	if m != nil {
+		return m.OrchestratorAddress
+	}
+	return ""
+}
+
 type MsgSetOrchestratorAddressResponse struct {
 }
 
@@ -113,7 +179,7 @@ func (m *MsgSetOrchestratorAddressResponse) Reset()         { *m = MsgSetOrchest
 func (m *MsgSetOrchestratorAddressResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSetOrchestratorAddressResponse) ProtoMessage()    {}
 func (*MsgSetOrchestratorAddressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{1}
+	return fileDescriptor_2f8523f2f6feb451, []int{2}
 }
 func (m *MsgSetOrchestratorAddressResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -169,7 +235,7 @@ func (m *MsgValsetConfirm) Reset()         { *m = MsgValsetConfirm{} }
 func (m *MsgValsetConfirm) String() string { return proto.CompactTextString(m) }
 func (*MsgValsetConfirm) ProtoMessage()    {}
 func (*MsgValsetConfirm) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{2}
+	return fileDescriptor_2f8523f2f6feb451, []int{3}
 }
 func (m *MsgValsetConfirm) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -240,7 +306,7 @@ func (m *MsgValsetConfirmResponse) Reset()         { *m = MsgValsetConfirmRespon
 func (m *MsgValsetConfirmResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgValsetConfirmResponse) ProtoMessage()    {}
 func (*MsgValsetConfirmResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{3}
+	return fileDescriptor_2f8523f2f6feb451, []int{4}
 }
 func (m *MsgValsetConfirmResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -292,13 +358,17 @@ type MsgSendToEth struct {
 	BridgeFee      types.Coin `protobuf:"bytes,4,opt,name=bridge_fee,json=bridgeFee,proto3" json:"bridge_fee"`
 	ChainFee       types.Coin `protobuf:"bytes,5,opt,name=chain_fee,json=chainFee,proto3" json:"chain_fee"`
 	EvmChainPrefix string     `protobuf:"bytes,6,opt,name=evm_chain_prefix,json=evmChainPrefix,proto3" json:"evm_chain_prefix,omitempty"`
+	// an optional bridge fee in any denom of the extra_fee_denoms of the evm
+	// chain, paid on cosmos to the orchestrator of the relayer of the batch. It
+	// is a tip which does not count toward batch creation, only bridge_fee does
+	ExtraFee *types.Coin `protobuf:"bytes,7,opt,name=extra_fee,json=extraFee,proto3" json:"extra_fee,omitempty"`
 }
 
 func (m *MsgSendToEth) Reset()         { *m = MsgSendToEth{} }
 func (m *MsgSendToEth) String() string { return proto.CompactTextString(m) }
 func (*MsgSendToEth) ProtoMessage()    {}
 func (*MsgSendToEth) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{4}
+	return fileDescriptor_2f8523f2f6feb451, []int{5}
 }
 func (m *MsgSendToEth) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -369,6 +439,13 @@ func (m *MsgSendToEth) GetEvmChainPrefix() string {
 	return ""
 }
 
+func (m *MsgSendToEth) GetExtraFee() *types.Coin {
+	if m != nil {
+		return m.ExtraFee
+	}
+	return nil
+}
+
 type MsgSendToEthResponse struct {
 }
 
@@ -376,7 +453,7 @@ func (m *MsgSendToEthResponse) Reset()         { *m = MsgSendToEthResponse{} }
 func (m *MsgSendToEthResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSendToEthResponse) ProtoMessage()    {}
 func (*MsgSendToEthResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{5}
+	return fileDescriptor_2f8523f2f6feb451, []int{6}
 }
 func (m *MsgSendToEthResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -424,7 +501,7 @@ func (m *MsgRequestBatch) Reset()         { *m = MsgRequestBatch{} }
 func (m *MsgRequestBatch) String() string { return proto.CompactTextString(m) }
 func (*MsgRequestBatch) ProtoMessage()    {}
 func (*MsgRequestBatch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{6}
+	return fileDescriptor_2f8523f2f6feb451, []int{7}
 }
 func (m *MsgRequestBatch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -481,7 +558,7 @@ func (m *MsgRequestBatchResponse) Reset()         { *m = MsgRequestBatchResponse
 func (m *MsgRequestBatchResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRequestBatchResponse) ProtoMessage()    {}
 func (*MsgRequestBatchResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{7}
+	return fileDescriptor_2f8523f2f6feb451, []int{8}
 }
 func (m *MsgRequestBatchResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -510,6 +587,121 @@ func (m *MsgRequestBatchResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRequestBatchResponse proto.InternalMessageInfo
 
+// MsgRequestBatchCancellation
+// Once a batch is older than the batch_cancellation_age of its evm chain, no
+// BatchSendToEthClaim has been submitted for it and its confirms fall short
+// of the power the Gravity contract requires, the sender of any tx in the
+// batch may cancel it, returning its txs to the pool. A batch with enough
+// confirms may still be relayed, it is only cancelled once it times out
+// -------------
+// SENDER: the sender of one of the txs in the batch
+// EVM_CHAIN_PREFIX: the evm chain of the batch
+// TOKEN_CONTRACT: the token contract of the batch
+// NONCE: the nonce of the batch
+type MsgRequestBatchCancellation struct {
+	Sender         string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	EvmChainPrefix string `protobuf:"bytes,2,opt,name=evm_chain_prefix,json=evmChainPrefix,proto3" json:"evm_chain_prefix,omitempty"`
+	TokenContract  string `protobuf:"bytes,3,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	Nonce          uint64 `protobuf:"varint,4,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (m *MsgRequestBatchCancellation) Reset()         { *m = MsgRequestBatchCancellation{} }
+func (m *MsgRequestBatchCancellation) String() string { return proto.CompactTextString(m) }
+func (*MsgRequestBatchCancellation) ProtoMessage()    {}
+func (*MsgRequestBatchCancellation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{9}
+}
+func (m *MsgRequestBatchCancellation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRequestBatchCancellation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRequestBatchCancellation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRequestBatchCancellation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRequestBatchCancellation.Merge(m, src)
+}
+func (m *MsgRequestBatchCancellation) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRequestBatchCancellation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRequestBatchCancellation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRequestBatchCancellation proto.InternalMessageInfo
+
+func (m *MsgRequestBatchCancellation) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgRequestBatchCancellation) GetEvmChainPrefix() string {
+	if m != nil {
+		return m.EvmChainPrefix
+	}
+	return ""
+}
+
+func (m *MsgRequestBatchCancellation) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
+	}
+	return ""
+}
+
+func (m *MsgRequestBatchCancellation) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+type MsgRequestBatchCancellationResponse struct {
+}
+
+func (m *MsgRequestBatchCancellationResponse) Reset()         { *m = MsgRequestBatchCancellationResponse{} }
+func (m *MsgRequestBatchCancellationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRequestBatchCancellationResponse) ProtoMessage()    {}
+func (*MsgRequestBatchCancellationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{10}
+}
+func (m *MsgRequestBatchCancellationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRequestBatchCancellationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRequestBatchCancellationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRequestBatchCancellationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRequestBatchCancellationResponse.Merge(m, src)
+}
+func (m *MsgRequestBatchCancellationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRequestBatchCancellationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRequestBatchCancellationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRequestBatchCancellationResponse proto.InternalMessageInfo
+
 // MsgConfirmBatch
 // When validators observe a MsgRequestBatch they form a batch by ordering
 // transactions currently in the txqueue in order of highest to lowest fee,
@@ -531,7 +723,7 @@ func (m *MsgConfirmBatch) Reset()         { *m = MsgConfirmBatch{} }
 func (m *MsgConfirmBatch) String() string { return proto.CompactTextString(m) }
 func (*MsgConfirmBatch) ProtoMessage()    {}
 func (*MsgConfirmBatch) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{8}
+	return fileDescriptor_2f8523f2f6feb451, []int{11}
 }
 func (m *MsgConfirmBatch) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -609,7 +801,7 @@ func (m *MsgConfirmBatchResponse) Reset()         { *m = MsgConfirmBatchResponse
 func (m *MsgConfirmBatchResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgConfirmBatchResponse) ProtoMessage()    {}
 func (*MsgConfirmBatchResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{9}
+	return fileDescriptor_2f8523f2f6feb451, []int{12}
 }
 func (m *MsgConfirmBatchResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -659,7 +851,7 @@ func (m *MsgConfirmLogicCall) Reset()         { *m = MsgConfirmLogicCall{} }
 func (m *MsgConfirmLogicCall) String() string { return proto.CompactTextString(m) }
 func (*MsgConfirmLogicCall) ProtoMessage()    {}
 func (*MsgConfirmLogicCall) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{10}
+	return fileDescriptor_2f8523f2f6feb451, []int{13}
 }
 func (m *MsgConfirmLogicCall) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -737,7 +929,7 @@ func (m *MsgConfirmLogicCallResponse) Reset()         { *m = MsgConfirmLogicCall
 func (m *MsgConfirmLogicCallResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgConfirmLogicCallResponse) ProtoMessage()    {}
 func (*MsgConfirmLogicCallResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{11}
+	return fileDescriptor_2f8523f2f6feb451, []int{14}
 }
 func (m *MsgConfirmLogicCallResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -786,7 +978,7 @@ func (m *MsgSendToCosmosClaim) Reset()         { *m = MsgSendToCosmosClaim{} }
 func (m *MsgSendToCosmosClaim) String() string { return proto.CompactTextString(m) }
 func (*MsgSendToCosmosClaim) ProtoMessage()    {}
 func (*MsgSendToCosmosClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{12}
+	return fileDescriptor_2f8523f2f6feb451, []int{15}
 }
 func (m *MsgSendToCosmosClaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -871,7 +1063,7 @@ func (m *MsgSendToCosmosClaimResponse) Reset()         { *m = MsgSendToCosmosCla
 func (m *MsgSendToCosmosClaimResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSendToCosmosClaimResponse) ProtoMessage()    {}
 func (*MsgSendToCosmosClaimResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{13}
+	return fileDescriptor_2f8523f2f6feb451, []int{16}
 }
 func (m *MsgSendToCosmosClaimResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -915,7 +1107,7 @@ func (m *MsgExecuteIbcAutoForwards) Reset()         { *m = MsgExecuteIbcAutoForw
 func (m *MsgExecuteIbcAutoForwards) String() string { return proto.CompactTextString(m) }
 func (*MsgExecuteIbcAutoForwards) ProtoMessage()    {}
 func (*MsgExecuteIbcAutoForwards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{14}
+	return fileDescriptor_2f8523f2f6feb451, []int{17}
 }
 func (m *MsgExecuteIbcAutoForwards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -972,7 +1164,7 @@ func (m *MsgExecuteIbcAutoForwardsResponse) Reset()         { *m = MsgExecuteIbc
 func (m *MsgExecuteIbcAutoForwardsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgExecuteIbcAutoForwardsResponse) ProtoMessage()    {}
 func (*MsgExecuteIbcAutoForwardsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{15}
+	return fileDescriptor_2f8523f2f6feb451, []int{18}
 }
 func (m *MsgExecuteIbcAutoForwardsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1010,13 +1202,17 @@ type MsgBatchSendToEthClaim struct {
 	TokenContract  string `protobuf:"bytes,4,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
 	Orchestrator   string `protobuf:"bytes,5,opt,name=orchestrator,proto3" json:"orchestrator,omitempty"`
 	EvmChainPrefix string `protobuf:"bytes,6,opt,name=evm_chain_prefix,json=evmChainPrefix,proto3" json:"evm_chain_prefix,omitempty"`
+	// the evm address which submitted the batch, the extra fees of the batch are
+	// paid to the orchestrator of the validator owning it. Empty if unknown.
+	// Ignored unless the relayer_in_batch_claims param of the evm chain is set
+	Relayer string `protobuf:"bytes,7,opt,name=relayer,proto3" json:"relayer,omitempty"`
 }
 
 func (m *MsgBatchSendToEthClaim) Reset()         { *m = MsgBatchSendToEthClaim{} }
 func (m *MsgBatchSendToEthClaim) String() string { return proto.CompactTextString(m) }
 func (*MsgBatchSendToEthClaim) ProtoMessage()    {}
 func (*MsgBatchSendToEthClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{16}
+	return fileDescriptor_2f8523f2f6feb451, []int{19}
 }
 func (m *MsgBatchSendToEthClaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1087,6 +1283,13 @@ func (m *MsgBatchSendToEthClaim) GetEvmChainPrefix() string {
 	return ""
 }
 
+func (m *MsgBatchSendToEthClaim) GetRelayer() string {
+	if m != nil {
+		return m.Relayer
+	}
+	return ""
+}
+
 type MsgBatchSendToEthClaimResponse struct {
 }
 
@@ -1094,7 +1297,7 @@ func (m *MsgBatchSendToEthClaimResponse) Reset()         { *m = MsgBatchSendToEt
 func (m *MsgBatchSendToEthClaimResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgBatchSendToEthClaimResponse) ProtoMessage()    {}
 func (*MsgBatchSendToEthClaimResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{17}
+	return fileDescriptor_2f8523f2f6feb451, []int{20}
 }
 func (m *MsgBatchSendToEthClaimResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1142,7 +1345,7 @@ func (m *MsgERC20DeployedClaim) Reset()         { *m = MsgERC20DeployedClaim{} }
 func (m *MsgERC20DeployedClaim) String() string { return proto.CompactTextString(m) }
 func (*MsgERC20DeployedClaim) ProtoMessage()    {}
 func (*MsgERC20DeployedClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{18}
+	return fileDescriptor_2f8523f2f6feb451, []int{21}
 }
 func (m *MsgERC20DeployedClaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1241,7 +1444,7 @@ func (m *MsgERC20DeployedClaimResponse) Reset()         { *m = MsgERC20DeployedC
 func (m *MsgERC20DeployedClaimResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgERC20DeployedClaimResponse) ProtoMessage()    {}
 func (*MsgERC20DeployedClaimResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{19}
+	return fileDescriptor_2f8523f2f6feb451, []int{22}
 }
 func (m *MsgERC20DeployedClaimResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1285,7 +1488,7 @@ func (m *MsgLogicCallExecutedClaim) Reset()         { *m = MsgLogicCallExecutedC
 func (m *MsgLogicCallExecutedClaim) String() string { return proto.CompactTextString(m) }
 func (*MsgLogicCallExecutedClaim) ProtoMessage()    {}
 func (*MsgLogicCallExecutedClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{20}
+	return fileDescriptor_2f8523f2f6feb451, []int{23}
 }
 func (m *MsgLogicCallExecutedClaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1363,7 +1566,7 @@ func (m *MsgLogicCallExecutedClaimResponse) Reset()         { *m = MsgLogicCallE
 func (m *MsgLogicCallExecutedClaimResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgLogicCallExecutedClaimResponse) ProtoMessage()    {}
 func (*MsgLogicCallExecutedClaimResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{21}
+	return fileDescriptor_2f8523f2f6feb451, []int{24}
 }
 func (m *MsgLogicCallExecutedClaimResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1409,7 +1612,7 @@ func (m *MsgValsetUpdatedClaim) Reset()         { *m = MsgValsetUpdatedClaim{} }
 func (m *MsgValsetUpdatedClaim) String() string { return proto.CompactTextString(m) }
 func (*MsgValsetUpdatedClaim) ProtoMessage()    {}
 func (*MsgValsetUpdatedClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{22}
+	return fileDescriptor_2f8523f2f6feb451, []int{25}
 }
 func (m *MsgValsetUpdatedClaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1494,7 +1697,7 @@ func (m *MsgValsetUpdatedClaimResponse) Reset()         { *m = MsgValsetUpdatedC
 func (m *MsgValsetUpdatedClaimResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgValsetUpdatedClaimResponse) ProtoMessage()    {}
 func (*MsgValsetUpdatedClaimResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{23}
+	return fileDescriptor_2f8523f2f6feb451, []int{26}
 }
 func (m *MsgValsetUpdatedClaimResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1536,7 +1739,7 @@ func (m *MsgCancelSendToEth) Reset()         { *m = MsgCancelSendToEth{} }
 func (m *MsgCancelSendToEth) String() string { return proto.CompactTextString(m) }
 func (*MsgCancelSendToEth) ProtoMessage()    {}
 func (*MsgCancelSendToEth) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{24}
+	return fileDescriptor_2f8523f2f6feb451, []int{27}
 }
 func (m *MsgCancelSendToEth) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1593,7 +1796,7 @@ func (m *MsgCancelSendToEthResponse) Reset()         { *m = MsgCancelSendToEthRe
 func (m *MsgCancelSendToEthResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCancelSendToEthResponse) ProtoMessage()    {}
 func (*MsgCancelSendToEthResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{25}
+	return fileDescriptor_2f8523f2f6feb451, []int{28}
 }
 func (m *MsgCancelSendToEthResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1622,10 +1825,120 @@ func (m *MsgCancelSendToEthResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCancelSendToEthResponse proto.InternalMessageInfo
 
+// This call allows the sender (and only the sender) of a MsgSendToEth which
+// is still waiting in the pool to add `added_fee` to its bridge fee, the tx
+// keeps its id and is re-indexed under the new fee
+type MsgIncreaseBridgeFee struct {
+	TransactionId  uint64     `protobuf:"varint,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Sender         string     `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	EvmChainPrefix string     `protobuf:"bytes,3,opt,name=evm_chain_prefix,json=evmChainPrefix,proto3" json:"evm_chain_prefix,omitempty"`
+	AddedFee       types.Coin `protobuf:"bytes,4,opt,name=added_fee,json=addedFee,proto3" json:"added_fee"`
+}
+
+func (m *MsgIncreaseBridgeFee) Reset()         { *m = MsgIncreaseBridgeFee{} }
+func (m *MsgIncreaseBridgeFee) String() string { return proto.CompactTextString(m) }
+func (*MsgIncreaseBridgeFee) ProtoMessage()    {}
+func (*MsgIncreaseBridgeFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{29}
+}
+func (m *MsgIncreaseBridgeFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgIncreaseBridgeFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgIncreaseBridgeFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgIncreaseBridgeFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgIncreaseBridgeFee.Merge(m, src)
+}
+func (m *MsgIncreaseBridgeFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgIncreaseBridgeFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgIncreaseBridgeFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgIncreaseBridgeFee proto.InternalMessageInfo
+
+func (m *MsgIncreaseBridgeFee) GetTransactionId() uint64 {
+	if m != nil {
+		return m.TransactionId
+	}
+	return 0
+}
+
+func (m *MsgIncreaseBridgeFee) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgIncreaseBridgeFee) GetEvmChainPrefix() string {
+	if m != nil {
+		return m.EvmChainPrefix
+	}
+	return ""
+}
+
+func (m *MsgIncreaseBridgeFee) GetAddedFee() types.Coin {
+	if m != nil {
+		return m.AddedFee
+	}
+	return types.Coin{}
+}
+
+type MsgIncreaseBridgeFeeResponse struct {
+}
+
+func (m *MsgIncreaseBridgeFeeResponse) Reset()         { *m = MsgIncreaseBridgeFeeResponse{} }
+func (m *MsgIncreaseBridgeFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgIncreaseBridgeFeeResponse) ProtoMessage()    {}
+func (*MsgIncreaseBridgeFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{30}
+}
+func (m *MsgIncreaseBridgeFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgIncreaseBridgeFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgIncreaseBridgeFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgIncreaseBridgeFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgIncreaseBridgeFeeResponse.Merge(m, src)
+}
+func (m *MsgIncreaseBridgeFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgIncreaseBridgeFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgIncreaseBridgeFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgIncreaseBridgeFeeResponse proto.InternalMessageInfo
+
 // This call allows anyone to submit evidence that a
 // validator has signed a valset, batch, or logic call that never
 // existed on the Cosmos chain.
 // Subject contains the batch, valset, or logic call.
+// The signature is checked under the gravity id of every evm chain, starting
+// with evm_chain_prefix, so that a checkpoint of one chain replayed under the
+// gravity id of another is caught as well
 type MsgSubmitBadSignatureEvidence struct {
 	Subject        *types1.Any `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
 	Signature      string      `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
@@ -1637,7 +1950,7 @@ func (m *MsgSubmitBadSignatureEvidence) Reset()         { *m = MsgSubmitBadSigna
 func (m *MsgSubmitBadSignatureEvidence) String() string { return proto.CompactTextString(m) }
 func (*MsgSubmitBadSignatureEvidence) ProtoMessage()    {}
 func (*MsgSubmitBadSignatureEvidence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{26}
+	return fileDescriptor_2f8523f2f6feb451, []int{31}
 }
 func (m *MsgSubmitBadSignatureEvidence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1701,7 +2014,7 @@ func (m *MsgSubmitBadSignatureEvidenceResponse) Reset()         { *m = MsgSubmit
 func (m *MsgSubmitBadSignatureEvidenceResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSubmitBadSignatureEvidenceResponse) ProtoMessage()    {}
 func (*MsgSubmitBadSignatureEvidenceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{27}
+	return fileDescriptor_2f8523f2f6feb451, []int{32}
 }
 func (m *MsgSubmitBadSignatureEvidenceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1730,23 +2043,33 @@ func (m *MsgSubmitBadSignatureEvidenceResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSubmitBadSignatureEvidenceResponse proto.InternalMessageInfo
 
-type EventSetOperatorAddress struct {
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
-	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-}
-
-func (m *EventSetOperatorAddress) Reset()         { *m = EventSetOperatorAddress{} }
-func (m *EventSetOperatorAddress) String() string { return proto.CompactTextString(m) }
-func (*EventSetOperatorAddress) ProtoMessage()    {}
-func (*EventSetOperatorAddress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{28}
+// This call allows anyone to submit evidence that a validator has signed two
+// different valsets, batches or logic calls at the same nonce: valsets of the
+// same nonce, batches of the same token contract and nonce, or logic calls of
+// the same invalidation id and nonce. Like MsgSubmitBadSignatureEvidence the
+// signatures are checked under the gravity id of every evm chain, starting
+// with evm_chain_prefix
+type MsgSubmitDoubleSignEvidence struct {
+	SubjectA       *types1.Any `protobuf:"bytes,1,opt,name=subject_a,json=subjectA,proto3" json:"subject_a,omitempty"`
+	SignatureA     string      `protobuf:"bytes,2,opt,name=signature_a,json=signatureA,proto3" json:"signature_a,omitempty"`
+	SubjectB       *types1.Any `protobuf:"bytes,3,opt,name=subject_b,json=subjectB,proto3" json:"subject_b,omitempty"`
+	SignatureB     string      `protobuf:"bytes,4,opt,name=signature_b,json=signatureB,proto3" json:"signature_b,omitempty"`
+	Sender         string      `protobuf:"bytes,5,opt,name=sender,proto3" json:"sender,omitempty"`
+	EvmChainPrefix string      `protobuf:"bytes,6,opt,name=evm_chain_prefix,json=evmChainPrefix,proto3" json:"evm_chain_prefix,omitempty"`
+}
+
+func (m *MsgSubmitDoubleSignEvidence) Reset()         { *m = MsgSubmitDoubleSignEvidence{} }
+func (m *MsgSubmitDoubleSignEvidence) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitDoubleSignEvidence) ProtoMessage()    {}
+func (*MsgSubmitDoubleSignEvidence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{33}
 }
-func (m *EventSetOperatorAddress) XXX_Unmarshal(b []byte) error {
+func (m *MsgSubmitDoubleSignEvidence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventSetOperatorAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSubmitDoubleSignEvidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventSetOperatorAddress.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSubmitDoubleSignEvidence.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1756,13 +2079,129 @@ func (m *EventSetOperatorAddress) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *EventSetOperatorAddress) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventSetOperatorAddress.Merge(m, src)
+func (m *MsgSubmitDoubleSignEvidence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitDoubleSignEvidence.Merge(m, src)
 }
-func (m *EventSetOperatorAddress) XXX_Size() int {
+func (m *MsgSubmitDoubleSignEvidence) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventSetOperatorAddress) XXX_DiscardUnknown() {
+func (m *MsgSubmitDoubleSignEvidence) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitDoubleSignEvidence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitDoubleSignEvidence proto.InternalMessageInfo
+
+func (m *MsgSubmitDoubleSignEvidence) GetSubjectA() *types1.Any {
+	if m != nil {
+		return m.SubjectA
+	}
+	return nil
+}
+
+func (m *MsgSubmitDoubleSignEvidence) GetSignatureA() string {
+	if m != nil {
+		return m.SignatureA
+	}
+	return ""
+}
+
+func (m *MsgSubmitDoubleSignEvidence) GetSubjectB() *types1.Any {
+	if m != nil {
+		return m.SubjectB
+	}
+	return nil
+}
+
+func (m *MsgSubmitDoubleSignEvidence) GetSignatureB() string {
+	if m != nil {
+		return m.SignatureB
+	}
+	return ""
+}
+
+func (m *MsgSubmitDoubleSignEvidence) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSubmitDoubleSignEvidence) GetEvmChainPrefix() string {
+	if m != nil {
+		return m.EvmChainPrefix
+	}
+	return ""
+}
+
+type MsgSubmitDoubleSignEvidenceResponse struct {
+}
+
+func (m *MsgSubmitDoubleSignEvidenceResponse) Reset()         { *m = MsgSubmitDoubleSignEvidenceResponse{} }
+func (m *MsgSubmitDoubleSignEvidenceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitDoubleSignEvidenceResponse) ProtoMessage()    {}
+func (*MsgSubmitDoubleSignEvidenceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{34}
+}
+func (m *MsgSubmitDoubleSignEvidenceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitDoubleSignEvidenceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitDoubleSignEvidenceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitDoubleSignEvidenceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitDoubleSignEvidenceResponse.Merge(m, src)
+}
+func (m *MsgSubmitDoubleSignEvidenceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitDoubleSignEvidenceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitDoubleSignEvidenceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitDoubleSignEvidenceResponse proto.InternalMessageInfo
+
+type EventSetOperatorAddress struct {
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *EventSetOperatorAddress) Reset()         { *m = EventSetOperatorAddress{} }
+func (m *EventSetOperatorAddress) String() string { return proto.CompactTextString(m) }
+func (*EventSetOperatorAddress) ProtoMessage()    {}
+func (*EventSetOperatorAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{35}
+}
+func (m *EventSetOperatorAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSetOperatorAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSetOperatorAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventSetOperatorAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSetOperatorAddress.Merge(m, src)
+}
+func (m *EventSetOperatorAddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSetOperatorAddress) XXX_DiscardUnknown() {
 	xxx_messageInfo_EventSetOperatorAddress.DiscardUnknown(m)
 }
 
@@ -1791,7 +2230,7 @@ func (m *EventValsetConfirmKey) Reset()         { *m = EventValsetConfirmKey{} }
 func (m *EventValsetConfirmKey) String() string { return proto.CompactTextString(m) }
 func (*EventValsetConfirmKey) ProtoMessage()    {}
 func (*EventValsetConfirmKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{29}
+	return fileDescriptor_2f8523f2f6feb451, []int{36}
 }
 func (m *EventValsetConfirmKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1843,7 +2282,7 @@ func (m *EventBatchCreated) Reset()         { *m = EventBatchCreated{} }
 func (m *EventBatchCreated) String() string { return proto.CompactTextString(m) }
 func (*EventBatchCreated) ProtoMessage()    {}
 func (*EventBatchCreated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{30}
+	return fileDescriptor_2f8523f2f6feb451, []int{37}
 }
 func (m *EventBatchCreated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1895,7 +2334,7 @@ func (m *EventBatchConfirmKey) Reset()         { *m = EventBatchConfirmKey{} }
 func (m *EventBatchConfirmKey) String() string { return proto.CompactTextString(m) }
 func (*EventBatchConfirmKey) ProtoMessage()    {}
 func (*EventBatchConfirmKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{31}
+	return fileDescriptor_2f8523f2f6feb451, []int{38}
 }
 func (m *EventBatchConfirmKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1946,7 +2385,7 @@ func (m *EventBatchSendToEthClaim) Reset()         { *m = EventBatchSendToEthCla
 func (m *EventBatchSendToEthClaim) String() string { return proto.CompactTextString(m) }
 func (*EventBatchSendToEthClaim) ProtoMessage()    {}
 func (*EventBatchSendToEthClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{32}
+	return fileDescriptor_2f8523f2f6feb451, []int{39}
 }
 func (m *EventBatchSendToEthClaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1982,6 +2421,58 @@ func (m *EventBatchSendToEthClaim) GetNonce() string {
 	return ""
 }
 
+type EventLogicCallExecutedClaim struct {
+	LogicCallInvalidationId    string `protobuf:"bytes,1,opt,name=logic_call_invalidation_id,json=logicCallInvalidationId,proto3" json:"logic_call_invalidation_id,omitempty"`
+	LogicCallInvalidationNonce string `protobuf:"bytes,2,opt,name=logic_call_invalidation_nonce,json=logicCallInvalidationNonce,proto3" json:"logic_call_invalidation_nonce,omitempty"`
+}
+
+func (m *EventLogicCallExecutedClaim) Reset()         { *m = EventLogicCallExecutedClaim{} }
+func (m *EventLogicCallExecutedClaim) String() string { return proto.CompactTextString(m) }
+func (*EventLogicCallExecutedClaim) ProtoMessage()    {}
+func (*EventLogicCallExecutedClaim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{40}
+}
+func (m *EventLogicCallExecutedClaim) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventLogicCallExecutedClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventLogicCallExecutedClaim.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventLogicCallExecutedClaim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventLogicCallExecutedClaim.Merge(m, src)
+}
+func (m *EventLogicCallExecutedClaim) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventLogicCallExecutedClaim) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventLogicCallExecutedClaim.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventLogicCallExecutedClaim proto.InternalMessageInfo
+
+func (m *EventLogicCallExecutedClaim) GetLogicCallInvalidationId() string {
+	if m != nil {
+		return m.LogicCallInvalidationId
+	}
+	return ""
+}
+
+func (m *EventLogicCallExecutedClaim) GetLogicCallInvalidationNonce() string {
+	if m != nil {
+		return m.LogicCallInvalidationNonce
+	}
+	return ""
+}
+
 type EventClaim struct {
 	Message       string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 	ClaimHash     string `protobuf:"bytes,2,opt,name=claim_hash,json=claimHash,proto3" json:"claim_hash,omitempty"`
@@ -1992,7 +2483,7 @@ func (m *EventClaim) Reset()         { *m = EventClaim{} }
 func (m *EventClaim) String() string { return proto.CompactTextString(m) }
 func (*EventClaim) ProtoMessage()    {}
 func (*EventClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{33}
+	return fileDescriptor_2f8523f2f6feb451, []int{41}
 }
 func (m *EventClaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2052,7 +2543,7 @@ func (m *EventBadSignatureEvidence) Reset()         { *m = EventBadSignatureEvid
 func (m *EventBadSignatureEvidence) String() string { return proto.CompactTextString(m) }
 func (*EventBadSignatureEvidence) ProtoMessage()    {}
 func (*EventBadSignatureEvidence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{34}
+	return fileDescriptor_2f8523f2f6feb451, []int{42}
 }
 func (m *EventBadSignatureEvidence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2102,6 +2593,98 @@ func (m *EventBadSignatureEvidence) GetBadEthSignatureSubject() string {
 	return ""
 }
 
+type EventEvidenceRecorded struct {
+	Id             string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind           string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	EvmChainPrefix string `protobuf:"bytes,3,opt,name=evm_chain_prefix,json=evmChainPrefix,proto3" json:"evm_chain_prefix,omitempty"`
+	Offender       string `protobuf:"bytes,4,opt,name=offender,proto3" json:"offender,omitempty"`
+	Reporter       string `protobuf:"bytes,5,opt,name=reporter,proto3" json:"reporter,omitempty"`
+	SlashAmount    string `protobuf:"bytes,6,opt,name=slash_amount,json=slashAmount,proto3" json:"slash_amount,omitempty"`
+	ReporterReward string `protobuf:"bytes,7,opt,name=reporter_reward,json=reporterReward,proto3" json:"reporter_reward,omitempty"`
+}
+
+func (m *EventEvidenceRecorded) Reset()         { *m = EventEvidenceRecorded{} }
+func (m *EventEvidenceRecorded) String() string { return proto.CompactTextString(m) }
+func (*EventEvidenceRecorded) ProtoMessage()    {}
+func (*EventEvidenceRecorded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2f8523f2f6feb451, []int{43}
+}
+func (m *EventEvidenceRecorded) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventEvidenceRecorded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventEvidenceRecorded.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventEvidenceRecorded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventEvidenceRecorded.Merge(m, src)
+}
+func (m *EventEvidenceRecorded) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventEvidenceRecorded) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventEvidenceRecorded.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventEvidenceRecorded proto.InternalMessageInfo
+
+func (m *EventEvidenceRecorded) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *EventEvidenceRecorded) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *EventEvidenceRecorded) GetEvmChainPrefix() string {
+	if m != nil {
+		return m.EvmChainPrefix
+	}
+	return ""
+}
+
+func (m *EventEvidenceRecorded) GetOffender() string {
+	if m != nil {
+		return m.Offender
+	}
+	return ""
+}
+
+func (m *EventEvidenceRecorded) GetReporter() string {
+	if m != nil {
+		return m.Reporter
+	}
+	return ""
+}
+
+func (m *EventEvidenceRecorded) GetSlashAmount() string {
+	if m != nil {
+		return m.SlashAmount
+	}
+	return ""
+}
+
+func (m *EventEvidenceRecorded) GetReporterReward() string {
+	if m != nil {
+		return m.ReporterReward
+	}
+	return ""
+}
+
 type EventERC20DeployedClaim struct {
 	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
 	Nonce string `protobuf:"bytes,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
@@ -2111,7 +2694,7 @@ func (m *EventERC20DeployedClaim) Reset()         { *m = EventERC20DeployedClaim
 func (m *EventERC20DeployedClaim) String() string { return proto.CompactTextString(m) }
 func (*EventERC20DeployedClaim) ProtoMessage()    {}
 func (*EventERC20DeployedClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{35}
+	return fileDescriptor_2f8523f2f6feb451, []int{44}
 }
 func (m *EventERC20DeployedClaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2162,7 +2745,7 @@ func (m *EventValsetUpdatedClaim) Reset()         { *m = EventValsetUpdatedClaim
 func (m *EventValsetUpdatedClaim) String() string { return proto.CompactTextString(m) }
 func (*EventValsetUpdatedClaim) ProtoMessage()    {}
 func (*EventValsetUpdatedClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{36}
+	return fileDescriptor_2f8523f2f6feb451, []int{45}
 }
 func (m *EventValsetUpdatedClaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2203,13 +2786,14 @@ type EventMultisigUpdateRequest struct {
 	BridgeChainId  string `protobuf:"bytes,2,opt,name=bridge_chain_id,json=bridgeChainId,proto3" json:"bridge_chain_id,omitempty"`
 	MultisigId     string `protobuf:"bytes,3,opt,name=multisig_id,json=multisigId,proto3" json:"multisig_id,omitempty"`
 	Nonce          string `protobuf:"bytes,4,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Reason         string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
 func (m *EventMultisigUpdateRequest) Reset()         { *m = EventMultisigUpdateRequest{} }
 func (m *EventMultisigUpdateRequest) String() string { return proto.CompactTextString(m) }
 func (*EventMultisigUpdateRequest) ProtoMessage()    {}
 func (*EventMultisigUpdateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{37}
+	return fileDescriptor_2f8523f2f6feb451, []int{46}
 }
 func (m *EventMultisigUpdateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2266,6 +2850,13 @@ func (m *EventMultisigUpdateRequest) GetNonce() string {
 	return ""
 }
 
+func (m *EventMultisigUpdateRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 type EventOutgoingLogicCallCanceled struct {
 	LogicCallInvalidationId    string `protobuf:"bytes,1,opt,name=logic_call_invalidation_id,json=logicCallInvalidationId,proto3" json:"logic_call_invalidation_id,omitempty"`
 	LogicCallInvalidationNonce string `protobuf:"bytes,2,opt,name=logic_call_invalidation_nonce,json=logicCallInvalidationNonce,proto3" json:"logic_call_invalidation_nonce,omitempty"`
@@ -2275,7 +2866,7 @@ func (m *EventOutgoingLogicCallCanceled) Reset()         { *m = EventOutgoingLog
 func (m *EventOutgoingLogicCallCanceled) String() string { return proto.CompactTextString(m) }
 func (*EventOutgoingLogicCallCanceled) ProtoMessage()    {}
 func (*EventOutgoingLogicCallCanceled) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{38}
+	return fileDescriptor_2f8523f2f6feb451, []int{47}
 }
 func (m *EventOutgoingLogicCallCanceled) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2327,7 +2918,7 @@ func (m *EventSignatureSlashing) Reset()         { *m = EventSignatureSlashing{}
 func (m *EventSignatureSlashing) String() string { return proto.CompactTextString(m) }
 func (*EventSignatureSlashing) ProtoMessage()    {}
 func (*EventSignatureSlashing) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{39}
+	return fileDescriptor_2f8523f2f6feb451, []int{48}
 }
 func (m *EventSignatureSlashing) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2379,7 +2970,7 @@ func (m *EventOutgoingTxId) Reset()         { *m = EventOutgoingTxId{} }
 func (m *EventOutgoingTxId) String() string { return proto.CompactTextString(m) }
 func (*EventOutgoingTxId) ProtoMessage()    {}
 func (*EventOutgoingTxId) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{40}
+	return fileDescriptor_2f8523f2f6feb451, []int{49}
 }
 func (m *EventOutgoingTxId) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2432,7 +3023,7 @@ func (m *EventSendToEthFeeCollected) Reset()         { *m = EventSendToEthFeeCol
 func (m *EventSendToEthFeeCollected) String() string { return proto.CompactTextString(m) }
 func (*EventSendToEthFeeCollected) ProtoMessage()    {}
 func (*EventSendToEthFeeCollected) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2f8523f2f6feb451, []int{41}
+	return fileDescriptor_2f8523f2f6feb451, []int{50}
 }
 func (m *EventSendToEthFeeCollected) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2484,6 +3075,7 @@ func (m *EventSendToEthFeeCollected) GetFeeAmount() string {
 
 func init() {
 	proto.RegisterType((*MsgSetOrchestratorAddress)(nil), "gravity.v1.MsgSetOrchestratorAddress")
+	proto.RegisterType((*DelegateKeysSignMsg)(nil), "gravity.v1.DelegateKeysSignMsg")
 	proto.RegisterType((*MsgSetOrchestratorAddressResponse)(nil), "gravity.v1.MsgSetOrchestratorAddressResponse")
 	proto.RegisterType((*MsgValsetConfirm)(nil), "gravity.v1.MsgValsetConfirm")
 	proto.RegisterType((*MsgValsetConfirmResponse)(nil), "gravity.v1.MsgValsetConfirmResponse")
@@ -2491,6 +3083,8 @@ func init() {
 	proto.RegisterType((*MsgSendToEthResponse)(nil), "gravity.v1.MsgSendToEthResponse")
 	proto.RegisterType((*MsgRequestBatch)(nil), "gravity.v1.MsgRequestBatch")
 	proto.RegisterType((*MsgRequestBatchResponse)(nil), "gravity.v1.MsgRequestBatchResponse")
+	proto.RegisterType((*MsgRequestBatchCancellation)(nil), "gravity.v1.MsgRequestBatchCancellation")
+	proto.RegisterType((*MsgRequestBatchCancellationResponse)(nil), "gravity.v1.MsgRequestBatchCancellationResponse")
 	proto.RegisterType((*MsgConfirmBatch)(nil), "gravity.v1.MsgConfirmBatch")
 	proto.RegisterType((*MsgConfirmBatchResponse)(nil), "gravity.v1.MsgConfirmBatchResponse")
 	proto.RegisterType((*MsgConfirmLogicCall)(nil), "gravity.v1.MsgConfirmLogicCall")
@@ -2509,15 +3103,21 @@ func init() {
 	proto.RegisterType((*MsgValsetUpdatedClaimResponse)(nil), "gravity.v1.MsgValsetUpdatedClaimResponse")
 	proto.RegisterType((*MsgCancelSendToEth)(nil), "gravity.v1.MsgCancelSendToEth")
 	proto.RegisterType((*MsgCancelSendToEthResponse)(nil), "gravity.v1.MsgCancelSendToEthResponse")
+	proto.RegisterType((*MsgIncreaseBridgeFee)(nil), "gravity.v1.MsgIncreaseBridgeFee")
+	proto.RegisterType((*MsgIncreaseBridgeFeeResponse)(nil), "gravity.v1.MsgIncreaseBridgeFeeResponse")
 	proto.RegisterType((*MsgSubmitBadSignatureEvidence)(nil), "gravity.v1.MsgSubmitBadSignatureEvidence")
 	proto.RegisterType((*MsgSubmitBadSignatureEvidenceResponse)(nil), "gravity.v1.MsgSubmitBadSignatureEvidenceResponse")
+	proto.RegisterType((*MsgSubmitDoubleSignEvidence)(nil), "gravity.v1.MsgSubmitDoubleSignEvidence")
+	proto.RegisterType((*MsgSubmitDoubleSignEvidenceResponse)(nil), "gravity.v1.MsgSubmitDoubleSignEvidenceResponse")
 	proto.RegisterType((*EventSetOperatorAddress)(nil), "gravity.v1.EventSetOperatorAddress")
 	proto.RegisterType((*EventValsetConfirmKey)(nil), "gravity.v1.EventValsetConfirmKey")
 	proto.RegisterType((*EventBatchCreated)(nil), "gravity.v1.EventBatchCreated")
 	proto.RegisterType((*EventBatchConfirmKey)(nil), "gravity.v1.EventBatchConfirmKey")
 	proto.RegisterType((*EventBatchSendToEthClaim)(nil), "gravity.v1.EventBatchSendToEthClaim")
+	proto.RegisterType((*EventLogicCallExecutedClaim)(nil), "gravity.v1.EventLogicCallExecutedClaim")
 	proto.RegisterType((*EventClaim)(nil), "gravity.v1.EventClaim")
 	proto.RegisterType((*EventBadSignatureEvidence)(nil), "gravity.v1.EventBadSignatureEvidence")
+	proto.RegisterType((*EventEvidenceRecorded)(nil), "gravity.v1.EventEvidenceRecorded")
 	proto.RegisterType((*EventERC20DeployedClaim)(nil), "gravity.v1.EventERC20DeployedClaim")
 	proto.RegisterType((*EventValsetUpdatedClaim)(nil), "gravity.v1.EventValsetUpdatedClaim")
 	proto.RegisterType((*EventMultisigUpdateRequest)(nil), "gravity.v1.EventMultisigUpdateRequest")
@@ -2530,142 +3130,169 @@ func init() {
 func init() { proto.RegisterFile("gravity/v1/msgs.proto", fileDescriptor_2f8523f2f6feb451) }
 
 var fileDescriptor_2f8523f2f6feb451 = []byte{
-	// 2158 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xcd, 0x6f, 0x23, 0x49,
-	0x15, 0x9f, 0xb6, 0x9d, 0x4c, 0xfc, 0xf2, 0x35, 0xe9, 0xc9, 0x64, 0xec, 0x9e, 0xc4, 0x49, 0x7a,
-	0x36, 0x1f, 0x3b, 0x4b, 0xec, 0x49, 0x38, 0x20, 0xb4, 0x08, 0x14, 0x7b, 0x12, 0xd6, 0x82, 0xcc,
-	0x22, 0x67, 0x58, 0x09, 0x84, 0xd4, 0x6a, 0x77, 0x57, 0xda, 0xcd, 0xf4, 0x47, 0xe8, 0x2e, 0x7b,
-	0x93, 0x03, 0x2b, 0xc1, 0x09, 0xc4, 0x22, 0x21, 0xb8, 0x2e, 0x12, 0x02, 0xae, 0xdc, 0xe0, 0x1f,
-	0x80, 0xcb, 0x8a, 0xd3, 0x4a, 0x5c, 0x80, 0xc3, 0x0a, 0xcd, 0xf0, 0x07, 0x70, 0x84, 0x1b, 0xaa,
-	0x8f, 0x2e, 0x77, 0xb7, 0xcb, 0x8e, 0x41, 0x39, 0xec, 0x29, 0xae, 0x57, 0xaf, 0xea, 0xfd, 0xea,
-	0x7d, 0xbf, 0x0e, 0x3c, 0x70, 0x22, 0x73, 0xe0, 0xe2, 0xeb, 0xc6, 0xe0, 0xb0, 0xe1, 0xc7, 0x4e,
-	0x5c, 0xbf, 0x8c, 0x42, 0x1c, 0xaa, 0xc0, 0xc9, 0xf5, 0xc1, 0xa1, 0x56, 0xb3, 0xc2, 0xd8, 0x0f,
-	0xe3, 0x46, 0xd7, 0x8c, 0x51, 0x63, 0x70, 0xd8, 0x45, 0xd8, 0x3c, 0x6c, 0x58, 0xa1, 0x1b, 0x30,
-	0x5e, 0x6d, 0xd5, 0x09, 0x9d, 0x90, 0xfe, 0x6c, 0x90, 0x5f, 0x9c, 0xba, 0xee, 0x84, 0xa1, 0xe3,
-	0xa1, 0x86, 0x79, 0xe9, 0x36, 0xcc, 0x20, 0x08, 0xb1, 0x89, 0xdd, 0x30, 0xe0, 0xf7, 0x6b, 0x6b,
-	0x29, 0xb1, 0xf8, 0xfa, 0x12, 0x25, 0xf4, 0x2a, 0x3f, 0x45, 0x57, 0xdd, 0xfe, 0x45, 0xc3, 0x0c,
-	0xae, 0x93, 0x2d, 0x06, 0xc3, 0x60, 0x92, 0xd8, 0x82, 0x6d, 0xe9, 0x1f, 0x40, 0xf5, 0x2c, 0x76,
-	0xce, 0x11, 0x7e, 0x37, 0xb2, 0x7a, 0x28, 0xc6, 0x91, 0x89, 0xc3, 0xe8, 0xd8, 0xb6, 0x23, 0x14,
-	0xc7, 0xea, 0x3a, 0x94, 0x07, 0xa6, 0xe7, 0xda, 0x84, 0x56, 0x51, 0xb6, 0x94, 0xfd, 0x72, 0x67,
-	0x48, 0x50, 0x75, 0x58, 0x08, 0x53, 0x87, 0x2a, 0x05, 0xca, 0x90, 0xa1, 0xa9, 0x9b, 0x30, 0x8f,
-	0x70, 0xcf, 0x30, 0xd9, 0x85, 0x95, 0x22, 0x65, 0x01, 0x84, 0x7b, 0x5c, 0x84, 0xfe, 0x18, 0xb6,
-	0xc7, 0xca, 0xef, 0xa0, 0xf8, 0x32, 0x0c, 0x62, 0xa4, 0xff, 0x41, 0x81, 0x7b, 0x67, 0xb1, 0xf3,
-	0x9e, 0xe9, 0xc5, 0x08, 0xb7, 0xc2, 0xe0, 0xc2, 0x8d, 0x7c, 0x75, 0x15, 0x66, 0x82, 0x30, 0xb0,
-	0x10, 0x05, 0x56, 0xea, 0xb0, 0xc5, 0xad, 0x80, 0x22, 0xef, 0x8e, 0x5d, 0x27, 0x30, 0x71, 0x3f,
-	0x42, 0x95, 0x12, 0x7b, 0xb7, 0x20, 0xa8, 0xfb, 0x70, 0x0f, 0x0d, 0x7c, 0xc3, 0xea, 0x99, 0x6e,
-	0x60, 0x5c, 0x46, 0xe8, 0xc2, 0xbd, 0xaa, 0xcc, 0x50, 0xa6, 0x25, 0x34, 0xf0, 0x5b, 0x84, 0xfc,
-	0x0d, 0x4a, 0xd5, 0x35, 0xa8, 0xe4, 0x61, 0x8b, 0x37, 0xfd, 0xba, 0x00, 0x0b, 0xf4, 0xe5, 0x81,
-	0xfd, 0x22, 0x3c, 0xc1, 0x3d, 0x75, 0x0d, 0x66, 0x63, 0x14, 0xd8, 0x28, 0xd1, 0x34, 0x5f, 0xa9,
-	0x55, 0x98, 0x23, 0x68, 0x6d, 0x14, 0x63, 0xfe, 0x9a, 0xbb, 0x08, 0xf7, 0x9e, 0xa1, 0x18, 0xab,
-	0x5f, 0x80, 0x59, 0xd3, 0x0f, 0xfb, 0x01, 0xa6, 0x6f, 0x98, 0x3f, 0xaa, 0xd6, 0xb9, 0x6d, 0x89,
-	0xbf, 0xd5, 0xb9, 0xbf, 0xd5, 0x5b, 0xa1, 0x1b, 0x34, 0x4b, 0x1f, 0x7f, 0xba, 0x79, 0xa7, 0xc3,
-	0xd9, 0xd5, 0x2f, 0x03, 0x74, 0x23, 0xd7, 0x76, 0x90, 0x71, 0x81, 0xd8, 0x0b, 0xa7, 0x38, 0x5c,
-	0x66, 0x47, 0x4e, 0x11, 0x52, 0xbf, 0x04, 0x65, 0xf6, 0x7c, 0x72, 0x7c, 0x66, 0xba, 0xe3, 0x73,
-	0xf4, 0x04, 0x39, 0x2d, 0x53, 0xe0, 0xac, 0x54, 0x81, 0x6b, 0xb0, 0x9a, 0xd6, 0x91, 0x50, 0x9e,
-	0x0b, 0xcb, 0x67, 0xb1, 0xd3, 0x41, 0xdf, 0xeb, 0xa3, 0x18, 0x37, 0x4d, 0x6c, 0x8d, 0x57, 0xdf,
-	0x2a, 0xcc, 0xd8, 0x28, 0x08, 0x7d, 0xae, 0x3b, 0xb6, 0x90, 0x42, 0x28, 0x4a, 0x21, 0x54, 0xe1,
-	0x61, 0x4e, 0x94, 0x40, 0xf1, 0x37, 0x85, 0xc2, 0xe0, 0x96, 0x65, 0x30, 0xe4, 0x5e, 0xb9, 0x03,
-	0x4b, 0x38, 0x7c, 0x89, 0x02, 0xc3, 0x0a, 0x03, 0x1c, 0x99, 0x56, 0x62, 0xc9, 0x45, 0x4a, 0x6d,
-	0x71, 0xa2, 0xba, 0x01, 0xc4, 0x0b, 0x0d, 0xe2, 0x6a, 0x28, 0xe2, 0x78, 0xca, 0x08, 0xf7, 0xce,
-	0x29, 0x61, 0xc4, 0xb7, 0x4b, 0x12, 0xdf, 0xce, 0xb8, 0xee, 0xcc, 0x34, 0xae, 0x3b, 0x3b, 0xe1,
-	0xd9, 0xe9, 0xa7, 0x89, 0x67, 0xff, 0x5b, 0x81, 0xfb, 0xc3, 0xbd, 0xaf, 0x87, 0x8e, 0x6b, 0xb5,
-	0x4c, 0xcf, 0x53, 0xf7, 0x60, 0xd9, 0x0d, 0x78, 0x7a, 0x70, 0xc3, 0xc0, 0x70, 0x6d, 0x6e, 0x8a,
-	0xa5, 0x34, 0xb9, 0x6d, 0xab, 0x07, 0xa0, 0x66, 0x18, 0x99, 0xc2, 0x0a, 0x54, 0x61, 0x2b, 0xe9,
-	0x9d, 0xe7, 0x54, 0x79, 0x9f, 0x21, 0xad, 0x6c, 0xc0, 0x23, 0xc9, 0xcb, 0x85, 0x66, 0xfe, 0x55,
-	0x48, 0xf9, 0x6b, 0x8b, 0x86, 0x43, 0xcb, 0x33, 0x5d, 0x9f, 0x66, 0x9c, 0x01, 0x0a, 0xb0, 0x91,
-	0xf6, 0x0d, 0xa0, 0x24, 0xf6, 0x46, 0x02, 0x01, 0xf7, 0x8c, 0xae, 0x17, 0x5a, 0x2f, 0x8d, 0x1e,
-	0x72, 0x9d, 0x1e, 0xe6, 0x0a, 0x59, 0x42, 0xb8, 0xd7, 0x24, 0xe4, 0x77, 0x28, 0x55, 0xe2, 0x4a,
-	0x45, 0x99, 0x2b, 0x9d, 0x8a, 0xd4, 0x40, 0xf5, 0xd1, 0xac, 0x93, 0x18, 0xfc, 0xfb, 0xa7, 0x9b,
-	0xbb, 0x8e, 0x8b, 0x7b, 0xfd, 0x6e, 0xdd, 0x0a, 0x7d, 0x5e, 0x08, 0xf8, 0x9f, 0x83, 0xd8, 0x7e,
-	0xc9, 0xeb, 0x49, 0x3b, 0xc0, 0x22, 0x53, 0xec, 0xc1, 0x32, 0xc2, 0x3d, 0x14, 0xa1, 0xbe, 0x6f,
-	0xf0, 0xf8, 0x4a, 0x72, 0x1d, 0x27, 0x9f, 0xb3, 0x38, 0xdb, 0x83, 0x65, 0x5e, 0x65, 0x22, 0x64,
-	0x21, 0x77, 0x80, 0xa2, 0x44, 0x87, 0x8c, 0xdc, 0xe1, 0xd4, 0x11, 0x7b, 0xdd, 0x95, 0xd8, 0x4b,
-	0x66, 0x91, 0x39, 0xa9, 0x45, 0x6a, 0xb0, 0x2e, 0xd3, 0xb8, 0x30, 0xc9, 0x4f, 0x15, 0x5a, 0xe0,
-	0x4e, 0xae, 0x90, 0xd5, 0xc7, 0xa8, 0xdd, 0xb5, 0x8e, 0xfb, 0x38, 0x3c, 0x0d, 0xa3, 0xf7, 0xcd,
-	0xc8, 0x8e, 0xd5, 0x27, 0xb0, 0x72, 0xc1, 0x7f, 0x1b, 0x38, 0x34, 0x2c, 0x0f, 0x99, 0x11, 0xb7,
-	0xce, 0x72, 0xb2, 0xf1, 0x22, 0x6c, 0x11, 0xb2, 0xaa, 0xc1, 0x1c, 0xa2, 0xb7, 0x88, 0xaa, 0x22,
-	0xd6, 0xff, 0x43, 0x3a, 0x61, 0xf5, 0x4e, 0x0e, 0x47, 0x80, 0xfe, 0x8f, 0x02, 0x6b, 0x67, 0xb1,
-	0x43, 0xc3, 0x4e, 0x24, 0xbf, 0x5b, 0xf7, 0xa4, 0x4d, 0x98, 0xef, 0x12, 0x09, 0xfc, 0xaa, 0x22,
-	0xbb, 0x8a, 0x92, 0x9e, 0x8f, 0xc9, 0x5a, 0x25, 0x99, 0xab, 0xe5, 0x0d, 0x3a, 0x33, 0xa5, 0x41,
-	0xe5, 0x21, 0xb6, 0x05, 0x35, 0xf9, 0xd3, 0x85, 0x76, 0xfe, 0x58, 0x80, 0x07, 0x44, 0x87, 0x9d,
-	0xd6, 0xd1, 0xd3, 0x67, 0xe8, 0xd2, 0x0b, 0xaf, 0x91, 0x7d, 0xeb, 0xca, 0xd9, 0x86, 0x05, 0xee,
-	0xce, 0xac, 0x7a, 0x30, 0x6b, 0xce, 0x33, 0xda, 0x33, 0x5a, 0x43, 0xa6, 0x54, 0x8f, 0x0a, 0xa5,
-	0xc0, 0xf4, 0x93, 0xb4, 0x43, 0x7f, 0xd3, 0x62, 0x75, 0xed, 0x77, 0x43, 0x8f, 0x2b, 0x81, 0xaf,
-	0x88, 0x8f, 0xd9, 0xc8, 0x72, 0x7d, 0xd3, 0x8b, 0x69, 0x5c, 0x94, 0x3a, 0x62, 0x3d, 0xa2, 0xe6,
-	0xb9, 0x29, 0xd5, 0x5c, 0x96, 0xaa, 0x79, 0x13, 0x36, 0xa4, 0x3a, 0x14, 0x5a, 0xfe, 0xb0, 0x40,
-	0x03, 0x47, 0x24, 0x39, 0xee, 0xb2, 0xb7, 0xaf, 0x69, 0x49, 0xd9, 0x20, 0xca, 0x5e, 0x98, 0xb2,
-	0x6c, 0x94, 0xc6, 0x95, 0x8d, 0xdb, 0x75, 0x4b, 0x16, 0xb7, 0x72, 0x6d, 0x08, 0x9d, 0xfd, 0xa8,
-	0x48, 0x3d, 0x93, 0x35, 0x7c, 0xdf, 0xbc, 0xb4, 0xcd, 0xe9, 0xf5, 0xb5, 0x0d, 0x0b, 0x03, 0x7a,
-	0x2c, 0x53, 0x0d, 0xe7, 0x19, 0x6d, 0xbc, 0x4a, 0x8b, 0x52, 0x95, 0xbe, 0x0d, 0x77, 0x7d, 0xe4,
-	0x77, 0x51, 0x14, 0x57, 0x4a, 0x5b, 0xc5, 0xfd, 0xf9, 0xa3, 0x47, 0xf5, 0xe1, 0x50, 0x52, 0x6f,
-	0xd2, 0x36, 0xee, 0xbd, 0xa4, 0x8f, 0xe7, 0xed, 0x59, 0x72, 0x42, 0x3d, 0x87, 0xc5, 0x08, 0x91,
-	0x7c, 0x64, 0xf0, 0x02, 0x32, 0xf3, 0x7f, 0x15, 0x90, 0x05, 0x76, 0xc9, 0x31, 0x2b, 0x23, 0xdb,
-	0xc0, 0xd7, 0x06, 0x0d, 0x0e, 0xae, 0xe4, 0x79, 0x46, 0x7b, 0x41, 0x48, 0xb7, 0x5c, 0x17, 0x98,
-	0x7f, 0x8f, 0x5a, 0x42, 0xd8, 0xea, 0xfb, 0xa0, 0x92, 0x52, 0x6e, 0x06, 0x16, 0xf2, 0x86, 0x4d,
-	0x38, 0x89, 0xe9, 0xc8, 0x0c, 0x62, 0xd3, 0x4a, 0xb7, 0x30, 0xa5, 0xce, 0x62, 0x8a, 0xda, 0xb6,
-	0x53, 0xcd, 0x66, 0x21, 0xd3, 0x6c, 0x4e, 0x5f, 0x07, 0xd6, 0x41, 0x1b, 0x15, 0x2f, 0xc0, 0xfd,
-	0x49, 0xa1, 0xf0, 0xcf, 0xfb, 0x5d, 0xdf, 0xc5, 0x4d, 0xd3, 0x3e, 0x4f, 0x7a, 0x95, 0x93, 0x81,
-	0x6b, 0x23, 0xe2, 0x0c, 0x4d, 0xb8, 0x1b, 0xf7, 0xbb, 0xdf, 0x45, 0x16, 0xa6, 0x08, 0xe7, 0x8f,
-	0x56, 0xeb, 0x6c, 0xfe, 0xab, 0x27, 0xf3, 0x5f, 0xfd, 0x38, 0xb8, 0x6e, 0xaa, 0x7f, 0xfe, 0xfd,
-	0xc1, 0xd2, 0x49, 0x52, 0xa9, 0x49, 0xc3, 0x64, 0x77, 0x92, 0x83, 0xd9, 0xae, 0xa8, 0x90, 0xef,
-	0x8a, 0x86, 0x6f, 0x2c, 0xde, 0xf8, 0xc6, 0x92, 0xf4, 0x8d, 0x7b, 0xb0, 0x33, 0xf1, 0x11, 0xe2,
-	0xb9, 0x67, 0xf0, 0xf0, 0x84, 0x84, 0x02, 0x19, 0x03, 0x2f, 0x51, 0x66, 0x04, 0xad, 0x10, 0x57,
-	0x8e, 0x63, 0xd3, 0x41, 0xbc, 0x99, 0x4c, 0x96, 0x64, 0x27, 0x99, 0xe0, 0xf8, 0x58, 0xc4, 0x97,
-	0x7a, 0x0b, 0x1e, 0xd0, 0xeb, 0x32, 0x83, 0xd7, 0xd7, 0xd0, 0xf5, 0x84, 0xcb, 0xee, 0x41, 0xf1,
-	0x25, 0xba, 0xe6, 0x17, 0x91, 0x9f, 0xfa, 0x73, 0x58, 0xa1, 0x97, 0xd0, 0x4a, 0xd4, 0x8a, 0x10,
-	0xf1, 0xa0, 0x09, 0x17, 0xe4, 0x8a, 0x29, 0xbb, 0x28, 0x55, 0x4c, 0xf5, 0xef, 0xc0, 0x6a, 0xea,
-	0xbe, 0x69, 0x30, 0x3d, 0x81, 0x15, 0x76, 0xa5, 0xc5, 0xb8, 0x8d, 0x21, 0xc2, 0xe5, 0x6e, 0xf6,
-	0x16, 0xfd, 0x29, 0x54, 0x86, 0xb7, 0xe7, 0x5a, 0x86, 0xcc, 0x48, 0x52, 0xe6, 0x23, 0x89, 0xee,
-	0x01, 0xd0, 0x13, 0x8c, 0x67, 0x3c, 0x8a, 0x0d, 0x00, 0x8b, 0xb0, 0x18, 0x3d, 0x33, 0xee, 0x25,
-	0x5e, 0x42, 0x29, 0xef, 0x98, 0x31, 0x0d, 0x18, 0x13, 0x63, 0x14, 0xe3, 0x4c, 0xf2, 0x2e, 0x77,
-	0x16, 0x53, 0xd4, 0xb6, 0xad, 0x7f, 0xa4, 0x40, 0x95, 0x03, 0x94, 0x38, 0xf3, 0x0d, 0x3a, 0xb0,
-	0x8d, 0xa4, 0xff, 0x4f, 0xbb, 0xea, 0x72, 0xd7, 0xb4, 0x4f, 0xd8, 0x14, 0xc0, 0x1c, 0xf6, 0x8b,
-	0x50, 0x1d, 0xe1, 0x35, 0x92, 0x20, 0x61, 0xa8, 0xd6, 0x72, 0x67, 0xce, 0xd9, 0xae, 0x7e, 0xc2,
-	0x1d, 0x50, 0xd2, 0x53, 0xac, 0xc2, 0x0c, 0x4b, 0x59, 0x5c, 0x7b, 0x74, 0x31, 0xd4, 0x69, 0x21,
-	0xad, 0xd3, 0x06, 0xbf, 0x46, 0x52, 0x00, 0xe4, 0x46, 0xf8, 0xad, 0x02, 0x1a, 0x3d, 0x71, 0xd6,
-	0xf7, 0xb0, 0x1b, 0xbb, 0x0e, 0x3b, 0xc3, 0xa7, 0x4d, 0x52, 0x1a, 0xf9, 0x98, 0x2e, 0x5a, 0x0c,
-	0x3e, 0x51, 0x31, 0xb2, 0xe8, 0x31, 0x76, 0x87, 0x8c, 0x34, 0x2c, 0x5d, 0x3b, 0x19, 0x30, 0x39,
-	0x23, 0xa1, 0xb6, 0x6d, 0xe2, 0xa5, 0x3e, 0x97, 0x34, 0x34, 0x15, 0x24, 0xa4, 0xb6, 0x3d, 0x84,
-	0x59, 0x4a, 0xc3, 0xfc, 0x95, 0x02, 0x35, 0x0a, 0xf3, 0xdd, 0x3e, 0x76, 0x42, 0x37, 0x18, 0xd6,
-	0x41, 0x96, 0xc0, 0x90, 0xad, 0xbe, 0x0d, 0x9a, 0x47, 0x88, 0x86, 0x65, 0x7a, 0x9e, 0x21, 0x9f,
-	0x03, 0x1f, 0x7a, 0xc9, 0xb1, 0x76, 0xb6, 0xb2, 0x1f, 0xc3, 0xc6, 0xb8, 0xc3, 0x69, 0x2d, 0x6b,
-	0xd2, 0xf3, 0x2c, 0xbc, 0x4e, 0x61, 0x8d, 0xa5, 0x10, 0x61, 0x5a, 0xcf, 0x8c, 0x7b, 0x6e, 0xe0,
-	0x90, 0xfe, 0x8b, 0x54, 0x25, 0x8e, 0x81, 0xfe, 0x9e, 0x90, 0x3b, 0x9a, 0x3c, 0xec, 0x93, 0x97,
-	0xbe, 0xb8, 0x6a, 0x4f, 0x0a, 0xfb, 0xfb, 0x30, 0x83, 0xaf, 0x86, 0xea, 0x2e, 0xe1, 0xab, 0xb6,
-	0xad, 0x63, 0x6e, 0x54, 0x11, 0x87, 0xa7, 0x08, 0xb5, 0x42, 0xcf, 0x43, 0x16, 0xc9, 0x21, 0xe3,
-	0x3e, 0x54, 0x6c, 0xc2, 0x3c, 0xf9, 0x95, 0x54, 0x5d, 0x9e, 0x41, 0x08, 0x89, 0xd7, 0xd0, 0x0d,
-	0x80, 0x0b, 0x84, 0x8c, 0xd4, 0x17, 0x9f, 0x72, 0xa7, 0x7c, 0x81, 0x10, 0xdb, 0x3e, 0xfa, 0xcd,
-	0x32, 0x14, 0xcf, 0x62, 0x47, 0x7d, 0x1f, 0x16, 0xb3, 0x1f, 0xca, 0xd6, 0xd3, 0xc5, 0x3f, 0xff,
-	0x3d, 0x4a, 0x7b, 0x63, 0xd2, 0xae, 0xc8, 0xd0, 0xfa, 0x0f, 0xff, 0xf2, 0xcf, 0x5f, 0x14, 0xd6,
-	0x75, 0xad, 0x91, 0xfa, 0xfa, 0xc8, 0x1b, 0x16, 0x9e, 0x9e, 0xd4, 0x1e, 0x94, 0x87, 0x85, 0xb4,
-	0x92, 0xbb, 0x56, 0xec, 0x68, 0x5b, 0xe3, 0x76, 0x84, 0xb0, 0x4d, 0x2a, 0xac, 0xaa, 0x3f, 0x4c,
-	0x0b, 0xa3, 0xba, 0xc1, 0x21, 0x09, 0x6f, 0x35, 0x86, 0x85, 0xcc, 0xb7, 0x9f, 0x47, 0xb9, 0x2b,
-	0xd3, 0x9b, 0xda, 0xe3, 0x09, 0x9b, 0x42, 0xe4, 0x36, 0x15, 0xf9, 0x48, 0xaf, 0xa6, 0x45, 0x46,
-	0x8c, 0xd3, 0xa0, 0xc9, 0x96, 0x08, 0xcd, 0x7c, 0xe9, 0xc9, 0x0b, 0x4d, 0x6f, 0x8e, 0x08, 0x95,
-	0x7e, 0x48, 0x91, 0x0a, 0x4d, 0x92, 0x3d, 0x13, 0xfa, 0x01, 0xdc, 0x1b, 0xf9, 0xce, 0xb2, 0x29,
-	0xbf, 0x5b, 0x30, 0x68, 0x7b, 0x37, 0x30, 0x08, 0x00, 0x5b, 0x14, 0x80, 0xa6, 0x57, 0x46, 0x00,
-	0xf8, 0x06, 0x8d, 0x35, 0xf5, 0xc7, 0x0a, 0xac, 0x8c, 0x7e, 0xce, 0x90, 0x9b, 0x30, 0xc5, 0xa1,
-	0xed, 0xdf, 0xc4, 0x21, 0x30, 0xec, 0x53, 0x0c, 0xba, 0xbe, 0x25, 0x33, 0x36, 0x1f, 0xc1, 0x68,
-	0xb9, 0x51, 0x7f, 0xa9, 0xc0, 0xda, 0x98, 0x39, 0x7e, 0x27, 0x27, 0x4e, 0xce, 0xa6, 0x1d, 0x4c,
-	0xc5, 0x26, 0xa0, 0x1d, 0x50, 0x68, 0x7b, 0xfa, 0x4e, 0x1a, 0x1a, 0x9b, 0xf9, 0x91, 0xe1, 0x76,
-	0x2d, 0xc3, 0xec, 0xe3, 0xd0, 0x48, 0xbe, 0x13, 0xa8, 0x3f, 0x57, 0xe0, 0xbe, 0xac, 0xfe, 0xea,
-	0x39, 0xa9, 0x12, 0x1e, 0xed, 0xc9, 0xcd, 0x3c, 0x02, 0xd6, 0x5b, 0x14, 0xd6, 0x8e, 0xfe, 0x38,
-	0x0d, 0x8b, 0x75, 0x0a, 0xa9, 0x20, 0xe1, 0x4a, 0xfb, 0x89, 0x02, 0x2b, 0xe9, 0x72, 0xc4, 0x20,
-	0x6d, 0x4b, 0x83, 0x3e, 0x5d, 0xb0, 0xb4, 0x37, 0x6f, 0x64, 0x99, 0x6c, 0x42, 0x9e, 0x1c, 0xfa,
-	0xec, 0x00, 0x47, 0xf3, 0xa1, 0x02, 0xaa, 0xa4, 0xc6, 0xe6, 0xe1, 0x8c, 0xb2, 0x8c, 0xc0, 0x99,
-	0x30, 0xb9, 0x4a, 0xe1, 0xa0, 0xc8, 0x3a, 0x7a, 0x6a, 0xd8, 0xfc, 0x40, 0xca, 0xa3, 0xc6, 0x0c,
-	0xb8, 0x79, 0x8f, 0x92, 0xb3, 0x8d, 0x78, 0xd4, 0x0d, 0x03, 0xa2, 0xd4, 0xa3, 0x52, 0xa5, 0x8f,
-	0x3b, 0x57, 0x82, 0xef, 0x23, 0x05, 0xd6, 0xc6, 0xfc, 0x6b, 0x66, 0x67, 0x24, 0xc0, 0x64, 0x6c,
-	0x23, 0xf8, 0x6e, 0xf8, 0x47, 0xcb, 0xe7, 0x28, 0xbe, 0x5d, 0xfd, 0x8d, 0x6c, 0x30, 0x62, 0x23,
-	0x3d, 0x85, 0x25, 0xff, 0x38, 0x51, 0x7f, 0xa0, 0xc0, 0x72, 0x7e, 0x80, 0xaa, 0xe5, 0x73, 0x4f,
-	0x76, 0x5f, 0xdb, 0x9d, 0xbc, 0x2f, 0x90, 0xec, 0x52, 0x24, 0x5b, 0x7a, 0x2d, 0x93, 0x9a, 0x28,
-	0x73, 0xda, 0xcb, 0xd5, 0xdf, 0x29, 0xa0, 0x4d, 0x18, 0x93, 0xf2, 0x6e, 0x33, 0x9e, 0x55, 0x3b,
-	0x9c, 0x9a, 0x55, 0x80, 0x3c, 0xa4, 0x20, 0xdf, 0xd2, 0xdf, 0xcc, 0xa8, 0x8b, 0x9e, 0x33, 0x48,
-	0x2b, 0x3a, 0x6c, 0x43, 0x11, 0x3f, 0xda, 0xfc, 0xd6, 0xc7, 0xaf, 0x6a, 0xca, 0x27, 0xaf, 0x6a,
-	0xca, 0x3f, 0x5e, 0xd5, 0x94, 0x9f, 0xbd, 0xae, 0xdd, 0xf9, 0xe4, 0x75, 0xed, 0xce, 0x5f, 0x5f,
-	0xd7, 0xee, 0x7c, 0xfb, 0x2b, 0xa9, 0xc1, 0xfa, 0xab, 0xec, 0xba, 0x03, 0x36, 0xaa, 0xe7, 0x97,
-	0x7e, 0x68, 0xf7, 0x3d, 0xd4, 0xb8, 0x12, 0x52, 0xe9, 0xd4, 0xdd, 0x9d, 0xa5, 0x93, 0xdf, 0xe7,
-	0xff, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x64, 0x97, 0x7f, 0x25, 0x98, 0x1c, 0x00, 0x00,
+	// 2577 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0x4d, 0x6c, 0x23, 0x59,
+	0x11, 0x9e, 0x76, 0x9c, 0x49, 0x5c, 0xf9, 0x9b, 0xf4, 0x64, 0x32, 0x4e, 0x27, 0x71, 0x92, 0xce,
+	0xe6, 0x67, 0x76, 0x88, 0x3d, 0x09, 0x12, 0x08, 0x2d, 0x02, 0xc5, 0x9e, 0x84, 0x8d, 0x96, 0xcc,
+	0x22, 0x67, 0x58, 0x09, 0x84, 0xd4, 0x6a, 0x77, 0x57, 0xec, 0x66, 0xda, 0xdd, 0xa1, 0xfb, 0x39,
+	0x9b, 0x1c, 0x40, 0x82, 0xd3, 0x22, 0x16, 0x09, 0xb1, 0xd7, 0x5d, 0x09, 0x09, 0x38, 0x22, 0x2e,
+	0x70, 0x83, 0x0b, 0x5c, 0x56, 0x9c, 0x56, 0xe2, 0x02, 0x7b, 0x58, 0xa1, 0x19, 0x2e, 0xdc, 0x38,
+	0x72, 0x41, 0x42, 0xef, 0xa7, 0x9f, 0xbb, 0xdb, 0xed, 0x9f, 0x59, 0x45, 0xc0, 0xc9, 0x7e, 0xf5,
+	0xea, 0xbd, 0xfa, 0x5e, 0x55, 0xbd, 0xaa, 0x7a, 0xd5, 0x70, 0xaf, 0x19, 0x98, 0x97, 0x0e, 0xb9,
+	0xae, 0x5c, 0xee, 0x57, 0xda, 0x61, 0x33, 0x2c, 0x5f, 0x04, 0x3e, 0xf1, 0x55, 0x10, 0xe4, 0xf2,
+	0xe5, 0xbe, 0x56, 0xb2, 0xfc, 0xb0, 0xed, 0x87, 0x95, 0x86, 0x19, 0x62, 0xe5, 0x72, 0xbf, 0x81,
+	0xc4, 0xdc, 0xaf, 0x58, 0xbe, 0xe3, 0x71, 0x5e, 0x6d, 0xa1, 0xe9, 0x37, 0x7d, 0xf6, 0xb7, 0x42,
+	0xff, 0x09, 0xea, 0x4a, 0xd3, 0xf7, 0x9b, 0x2e, 0x56, 0xcc, 0x0b, 0xa7, 0x62, 0x7a, 0x9e, 0x4f,
+	0x4c, 0xe2, 0xf8, 0x9e, 0xd8, 0x5f, 0x5b, 0x8c, 0x89, 0x25, 0xd7, 0x17, 0x18, 0xd1, 0x97, 0xc4,
+	0x2a, 0x36, 0x6a, 0x74, 0xce, 0x2b, 0xa6, 0x77, 0x1d, 0x4d, 0x71, 0x18, 0x06, 0x97, 0xc4, 0x07,
+	0x7c, 0x4a, 0xff, 0xb9, 0x02, 0x4b, 0xa7, 0x61, 0xf3, 0x0c, 0xc9, 0x9b, 0x81, 0xd5, 0xc2, 0x90,
+	0x04, 0x26, 0xf1, 0x83, 0x43, 0xdb, 0x0e, 0x30, 0x0c, 0xd5, 0x15, 0x28, 0x5c, 0x9a, 0xae, 0x63,
+	0x53, 0x5a, 0x51, 0x59, 0x57, 0x76, 0x0b, 0xf5, 0x2e, 0x41, 0xd5, 0x61, 0xda, 0x8f, 0x2d, 0x2a,
+	0xe6, 0x18, 0x43, 0x82, 0xa6, 0xae, 0xc1, 0x14, 0x92, 0x96, 0x61, 0xf2, 0x0d, 0x8b, 0x63, 0x8c,
+	0x05, 0x90, 0xb4, 0x22, 0x11, 0x9b, 0x30, 0x43, 0x19, 0x42, 0xa7, 0xe9, 0x99, 0xa4, 0x13, 0x60,
+	0x31, 0xbf, 0xae, 0xec, 0x4e, 0xd7, 0xa7, 0x91, 0xb4, 0xce, 0x22, 0x9a, 0xde, 0x81, 0xbb, 0x8f,
+	0xd1, 0xc5, 0xa6, 0x49, 0xf0, 0x0d, 0xbc, 0x0e, 0xe9, 0xc4, 0x69, 0xd8, 0x54, 0x1f, 0xc2, 0xbc,
+	0x44, 0x23, 0x45, 0x70, 0x98, 0x77, 0xe4, 0x44, 0x24, 0x68, 0x1f, 0x16, 0xe2, 0xc8, 0x24, 0x3f,
+	0x47, 0x7d, 0xd7, 0xef, 0x3d, 0xbe, 0xbe, 0x09, 0x1b, 0x7d, 0x75, 0x53, 0xc7, 0xf0, 0xc2, 0xf7,
+	0x42, 0xd4, 0x7f, 0xab, 0xc0, 0x9d, 0xd3, 0xb0, 0xf9, 0x96, 0xe9, 0x86, 0x48, 0x6a, 0xbe, 0x77,
+	0xee, 0x04, 0x6d, 0x75, 0x01, 0xc6, 0x3d, 0xdf, 0xb3, 0x90, 0xa1, 0xc9, 0xd7, 0xf9, 0xe0, 0x66,
+	0x14, 0xb6, 0x02, 0x85, 0xa4, 0xb2, 0x0a, 0xf5, 0x2e, 0x41, 0xdd, 0x85, 0x3b, 0x78, 0xd9, 0x36,
+	0xac, 0x96, 0xe9, 0x78, 0xc6, 0x45, 0x80, 0xe7, 0xce, 0x55, 0x71, 0x9c, 0x31, 0xcd, 0xe2, 0x65,
+	0xbb, 0x46, 0xc9, 0x5f, 0x63, 0x54, 0x5d, 0x83, 0x62, 0x1a, 0xb6, 0x3c, 0xd3, 0xc7, 0x39, 0x98,
+	0x66, 0x27, 0xf7, 0xec, 0xa7, 0xfe, 0x11, 0x69, 0xa9, 0x8b, 0x70, 0x3b, 0x44, 0xcf, 0xc6, 0xc8,
+	0x0b, 0xc4, 0x48, 0x5d, 0x82, 0x49, 0x8a, 0xd6, 0xc6, 0x90, 0x88, 0xd3, 0x4c, 0x20, 0x69, 0x3d,
+	0xc6, 0x90, 0xa8, 0x9f, 0x87, 0xdb, 0x66, 0xdb, 0xef, 0x78, 0x84, 0x9d, 0x61, 0xea, 0x60, 0xa9,
+	0x2c, 0x1c, 0x8f, 0x5e, 0x86, 0xb2, 0xb8, 0x0c, 0xe5, 0x9a, 0xef, 0x78, 0xd5, 0xfc, 0x87, 0x9f,
+	0xac, 0xdd, 0xaa, 0x0b, 0x76, 0xf5, 0x4b, 0x00, 0x8d, 0xc0, 0xb1, 0x9b, 0x68, 0x9c, 0x23, 0x3f,
+	0xe1, 0x08, 0x8b, 0x0b, 0x7c, 0xc9, 0x31, 0xa2, 0xfa, 0x45, 0x28, 0xf0, 0xe3, 0xd3, 0xe5, 0xe3,
+	0xa3, 0x2d, 0x9f, 0x64, 0x2b, 0x8e, 0x31, 0x5b, 0x81, 0xb7, 0xb3, 0x14, 0xa8, 0x7e, 0x0e, 0x0a,
+	0x78, 0x45, 0x02, 0x93, 0xc9, 0x99, 0x18, 0x22, 0xa7, 0x3e, 0xc9, 0x78, 0x8f, 0x11, 0xf5, 0x45,
+	0x58, 0x88, 0xeb, 0x56, 0x2a, 0xdd, 0x81, 0xb9, 0xd3, 0xb0, 0x59, 0xc7, 0xef, 0x74, 0x30, 0x24,
+	0x55, 0x93, 0x58, 0xfd, 0xd5, 0xbe, 0x00, 0xe3, 0x36, 0x7a, 0x7e, 0x5b, 0xe8, 0x9c, 0x0f, 0x32,
+	0xa1, 0x8f, 0x65, 0xda, 0x7e, 0x09, 0xee, 0xa7, 0x44, 0x49, 0x14, 0xef, 0x2b, 0xb0, 0x9c, 0x9a,
+	0xab, 0x99, 0x9e, 0x85, 0xae, 0xcb, 0xa2, 0x50, 0x5f, 0x48, 0x59, 0xc2, 0x73, 0x99, 0x7a, 0xdb,
+	0x82, 0x59, 0xe2, 0x3f, 0x43, 0xcf, 0xb0, 0x7c, 0x8f, 0x04, 0xa6, 0x45, 0x04, 0xc8, 0x19, 0x46,
+	0xad, 0x09, 0x62, 0xf7, 0x0a, 0xe5, 0x63, 0x57, 0x48, 0xdf, 0x82, 0xcd, 0x01, 0xe8, 0xe4, 0x29,
+	0xfe, 0xaa, 0x30, 0x65, 0x0a, 0xbf, 0xe6, 0xca, 0xcc, 0xbe, 0x93, 0xbd, 0x68, 0x72, 0x59, 0x68,
+	0x56, 0x01, 0xa2, 0x30, 0x85, 0x81, 0x00, 0x5c, 0x10, 0x31, 0x0a, 0x7b, 0x43, 0x61, 0x3e, 0xe3,
+	0x66, 0x27, 0x2e, 0xee, 0xf8, 0x28, 0x17, 0xf7, 0xf6, 0x00, 0xe3, 0xc5, 0x8f, 0x26, 0x8f, 0xfd,
+	0x2f, 0x05, 0xee, 0x76, 0xe7, 0xbe, 0xea, 0x37, 0x1d, 0xab, 0x66, 0xba, 0xae, 0xba, 0x03, 0x73,
+	0x8e, 0x27, 0x22, 0xa2, 0xe3, 0x7b, 0x86, 0x63, 0x0b, 0xeb, 0xcd, 0xc6, 0xc9, 0x27, 0xb6, 0xba,
+	0x07, 0x6a, 0x82, 0x91, 0x2b, 0x2c, 0xc7, 0x14, 0x36, 0x1f, 0x9f, 0x79, 0xc2, 0x94, 0xf7, 0x7f,
+	0xa4, 0x95, 0x55, 0x58, 0xce, 0x38, 0xb9, 0xd4, 0xcc, 0x3f, 0x73, 0xb1, 0x5b, 0x57, 0x63, 0x97,
+	0xb4, 0xe6, 0x9a, 0x4e, 0x9b, 0xc5, 0xdb, 0x4b, 0xf4, 0x88, 0x11, 0xf7, 0x0d, 0x60, 0x24, 0x7e,
+	0x46, 0x0a, 0x81, 0xb4, 0x8c, 0x86, 0xeb, 0x5b, 0xcf, 0x8c, 0x16, 0x3a, 0xcd, 0x16, 0x11, 0x0a,
+	0x99, 0x45, 0xd2, 0xaa, 0x52, 0xf2, 0xeb, 0x8c, 0x3a, 0xaa, 0x63, 0x1f, 0xcb, 0xc0, 0xc8, 0xf4,
+	0x51, 0x2d, 0xd3, 0x08, 0xf4, 0xf1, 0x27, 0x6b, 0xdb, 0x4d, 0x87, 0xb4, 0x3a, 0x8d, 0xb2, 0xe5,
+	0xb7, 0x45, 0x8e, 0x16, 0x3f, 0x7b, 0xa1, 0xfd, 0x4c, 0xa4, 0xfa, 0x13, 0x8f, 0xc8, 0x38, 0xb9,
+	0x03, 0x73, 0x48, 0x5a, 0x18, 0x60, 0xa7, 0x6d, 0x88, 0x2b, 0x19, 0x45, 0x7a, 0x41, 0x3e, 0xe3,
+	0x57, 0x73, 0x07, 0xe6, 0x44, 0x01, 0x10, 0xa0, 0x85, 0xce, 0x25, 0x06, 0x91, 0x0e, 0x39, 0xb9,
+	0x2e, 0xa8, 0x3d, 0xf6, 0x9a, 0xc8, 0xb0, 0x57, 0x96, 0x45, 0x26, 0x33, 0x2d, 0x52, 0x82, 0x95,
+	0x2c, 0x8d, 0x4b, 0x93, 0xfc, 0x98, 0x97, 0x1e, 0x47, 0x57, 0x68, 0x75, 0x08, 0x9e, 0x34, 0xac,
+	0xc3, 0x0e, 0xf1, 0x8f, 0xfd, 0xe0, 0x6d, 0x33, 0xb0, 0x43, 0xf5, 0x55, 0x98, 0x3f, 0x17, 0xff,
+	0x0d, 0xe2, 0x1b, 0x96, 0x8b, 0x66, 0x20, 0xac, 0x33, 0x17, 0x4d, 0x3c, 0xf5, 0x6b, 0x94, 0xac,
+	0x6a, 0x30, 0x89, 0x6c, 0x17, 0x99, 0x53, 0xe5, 0xf8, 0x25, 0x82, 0x22, 0xcf, 0xf6, 0xd9, 0x70,
+	0x24, 0xe8, 0xf7, 0x72, 0xb0, 0x78, 0x1a, 0x36, 0xd9, 0xb5, 0x93, 0x21, 0xfc, 0xc6, 0x3d, 0x69,
+	0x0d, 0xa6, 0x1a, 0x54, 0x82, 0xd8, 0x6a, 0x8c, 0x6f, 0xc5, 0x48, 0x4f, 0xfa, 0x44, 0xad, 0x7c,
+	0x96, 0xab, 0xa5, 0x0d, 0x3a, 0x3e, 0xa2, 0x41, 0xb3, 0x13, 0x5e, 0x11, 0x26, 0x02, 0x74, 0xcd,
+	0x6b, 0x8c, 0x3c, 0x23, 0x1a, 0xea, 0xeb, 0x50, 0xca, 0x56, 0x8a, 0xd4, 0xdb, 0x1f, 0x72, 0x70,
+	0x8f, 0x6a, 0xb7, 0x5e, 0x3b, 0x78, 0xf4, 0x18, 0x2f, 0x5c, 0xff, 0x1a, 0xed, 0x1b, 0x57, 0xdb,
+	0x06, 0x4c, 0x0b, 0x47, 0xe7, 0xd9, 0x91, 0xdb, 0x79, 0x8a, 0xd3, 0x1e, 0x53, 0xd2, 0xa8, 0x8a,
+	0x53, 0x21, 0xef, 0x99, 0xed, 0x28, 0x20, 0xb1, 0xff, 0x2c, 0xf3, 0x5d, 0xb7, 0x1b, 0xbe, 0x2b,
+	0xd4, 0x23, 0x46, 0xd4, 0xfb, 0x6c, 0xb4, 0x9c, 0xb6, 0xe9, 0x86, 0x4c, 0x2f, 0xf9, 0xba, 0x1c,
+	0xf7, 0x18, 0x60, 0x72, 0x44, 0x03, 0x14, 0x32, 0x3d, 0x74, 0x0d, 0x56, 0x33, 0x75, 0x28, 0xb5,
+	0xfc, 0x6e, 0x8e, 0x5d, 0x29, 0x19, 0xfe, 0x84, 0x33, 0xdf, 0xbc, 0xa6, 0x33, 0x12, 0xca, 0x18,
+	0xab, 0xdb, 0x47, 0x4b, 0x28, 0xf9, 0x7e, 0x09, 0xe5, 0x46, 0x1d, 0x56, 0xdc, 0xe8, 0x6c, 0x6d,
+	0x48, 0x9d, 0xbd, 0x33, 0x06, 0xf7, 0x64, 0x21, 0xfc, 0xf5, 0x0b, 0xdb, 0x1c, 0x5d, 0x5f, 0x1b,
+	0x30, 0x7d, 0xc9, 0x96, 0x25, 0xf2, 0xe4, 0x14, 0xa7, 0xf5, 0x57, 0xe9, 0x58, 0xa6, 0x4a, 0x5f,
+	0x83, 0x89, 0x36, 0xb6, 0x1b, 0x18, 0x84, 0xc5, 0xfc, 0xfa, 0xd8, 0xee, 0xd4, 0xc1, 0x72, 0xb9,
+	0xfb, 0x92, 0x2c, 0x57, 0x59, 0x79, 0xfb, 0x56, 0xf4, 0xa8, 0x11, 0x65, 0x6b, 0xb4, 0x42, 0x3d,
+	0x83, 0x99, 0x00, 0x69, 0xa4, 0x32, 0x44, 0x6a, 0x19, 0xff, 0x54, 0xa9, 0x65, 0x9a, 0x6f, 0x72,
+	0xc8, 0x13, 0xcc, 0x06, 0x88, 0xb1, 0xc1, 0x2e, 0x87, 0x50, 0xf2, 0x14, 0xa7, 0x3d, 0xa5, 0xa4,
+	0x1b, 0xce, 0x18, 0xdc, 0xbf, 0x7b, 0x2d, 0x21, 0x6d, 0xf5, 0x5d, 0x50, 0x69, 0x92, 0x67, 0x15,
+	0x5f, 0xf7, 0x71, 0x42, 0xef, 0x74, 0x60, 0x7a, 0xa1, 0x69, 0xc5, 0x8b, 0x9b, 0x7c, 0x7d, 0x26,
+	0x46, 0x3d, 0xb1, 0x63, 0x95, 0x6b, 0x6e, 0x68, 0xe5, 0x9a, 0x9d, 0x21, 0x56, 0x40, 0xeb, 0x15,
+	0x2f, 0xc1, 0xfd, 0x5e, 0x61, 0x25, 0xc6, 0x89, 0x67, 0x05, 0x68, 0x86, 0x58, 0x95, 0x0f, 0x92,
+	0xff, 0x16, 0x3e, 0xfa, 0xf2, 0x31, 0x6d, 0x1b, 0xed, 0x97, 0x79, 0x38, 0x4d, 0xb2, 0x15, 0xf4,
+	0x5d, 0xc2, 0xf3, 0x75, 0x0f, 0x7c, 0x79, 0xbe, 0x3f, 0x2a, 0xcc, 0x3c, 0x67, 0x9d, 0x46, 0xdb,
+	0x21, 0x55, 0xd3, 0x96, 0xcf, 0xf3, 0xa3, 0x4b, 0xc7, 0x46, 0xea, 0xec, 0x55, 0x98, 0x08, 0x3b,
+	0x8d, 0x6f, 0xa3, 0x45, 0xd8, 0x09, 0xa7, 0x0e, 0x16, 0xca, 0xbc, 0x29, 0x51, 0x8e, 0x9a, 0x12,
+	0xe5, 0x43, 0xef, 0xba, 0xaa, 0xfe, 0xe9, 0x37, 0x7b, 0xb3, 0x47, 0x51, 0x8d, 0x42, 0x4b, 0x45,
+	0xbb, 0x1e, 0x2d, 0x4c, 0xd6, 0x83, 0xb9, 0x74, 0x3d, 0xd8, 0xd5, 0xd1, 0xd8, 0x50, 0x1d, 0xe5,
+	0x33, 0x6d, 0xb8, 0x03, 0x5b, 0x03, 0x0f, 0x21, 0x8f, 0xfb, 0xeb, 0x1c, 0x2c, 0x4b, 0xce, 0xc7,
+	0x7e, 0xa7, 0xe1, 0x22, 0x65, 0x96, 0x87, 0x3d, 0x82, 0x82, 0xc0, 0x6c, 0x98, 0x2f, 0x7d, 0xdc,
+	0x49, 0xb1, 0xf4, 0x90, 0x06, 0x19, 0x79, 0x3c, 0xc3, 0x14, 0x27, 0x06, 0x49, 0x3a, 0x8c, 0xcb,
+	0x69, 0x14, 0xc7, 0x3e, 0xa5, 0x9c, 0x6a, 0x52, 0x4e, 0xa3, 0x98, 0x4f, 0xc9, 0xa9, 0xc6, 0x54,
+	0x3b, 0x3e, 0x54, 0xb5, 0xd9, 0xe1, 0x96, 0xbf, 0xcd, 0xfa, 0x29, 0x4c, 0x2a, 0xf6, 0x14, 0xee,
+	0x1f, 0xd1, 0x18, 0x4a, 0xfb, 0x2a, 0x17, 0x98, 0xe8, 0x37, 0x15, 0x69, 0x0c, 0x0c, 0x43, 0xb3,
+	0x89, 0xe2, 0x7d, 0x12, 0x0d, 0xe9, 0x4c, 0xb2, 0x61, 0x13, 0x0d, 0xf5, 0x1a, 0xdc, 0x63, 0xdb,
+	0x25, 0x3a, 0x19, 0x6f, 0xe0, 0xf5, 0x80, 0xcd, 0xee, 0xc0, 0xd8, 0x33, 0xbc, 0x16, 0x1b, 0xd1,
+	0xbf, 0xfa, 0x13, 0x98, 0x67, 0x9b, 0xf0, 0x17, 0x65, 0x80, 0x34, 0xf4, 0x0c, 0xd8, 0x20, 0x55,
+	0x9f, 0x09, 0xa3, 0x75, 0xeb, 0x33, 0xfd, 0x5b, 0xb0, 0x10, 0xdb, 0x6f, 0x14, 0x4c, 0xaf, 0xc2,
+	0x3c, 0xdf, 0xd2, 0xe2, 0xdc, 0x46, 0x17, 0xe1, 0x5c, 0x23, 0xb9, 0x8b, 0xfe, 0x08, 0x8a, 0xdd,
+	0xdd, 0x53, 0x55, 0x68, 0xe2, 0x95, 0x5b, 0x88, 0x9e, 0xcd, 0x1f, 0x28, 0xb0, 0xcc, 0x96, 0xf4,
+	0x29, 0x0d, 0x5e, 0x03, 0xcd, 0xa5, 0x33, 0x86, 0x65, 0xba, 0xae, 0x91, 0xfd, 0x56, 0xbc, 0xef,
+	0x46, 0x6b, 0x4f, 0x92, 0x39, 0xfe, 0x10, 0x56, 0xfb, 0x2d, 0x8e, 0xeb, 0x47, 0xcb, 0x5c, 0xcf,
+	0xf5, 0xe5, 0x02, 0x30, 0x78, 0x1c, 0x4d, 0x7f, 0x2d, 0xad, 0x02, 0x58, 0x94, 0xc5, 0x68, 0x99,
+	0x61, 0x2b, 0x0a, 0x0f, 0x8c, 0xf2, 0xba, 0x19, 0xb2, 0x4c, 0x60, 0x12, 0x82, 0x21, 0x49, 0x54,
+	0x25, 0x85, 0xfa, 0x4c, 0x8c, 0x7a, 0x62, 0xd3, 0x1e, 0xc7, 0x92, 0x50, 0x60, 0x46, 0x14, 0x1b,
+	0x62, 0x23, 0xdb, 0x48, 0xf6, 0x2b, 0xa5, 0x8d, 0xec, 0xa3, 0x58, 0xcb, 0x52, 0xfd, 0x02, 0x2c,
+	0xf5, 0xf0, 0x1a, 0x51, 0x74, 0xe4, 0xa8, 0x16, 0x53, 0x6b, 0xce, 0xf8, 0xac, 0xfe, 0x0f, 0x45,
+	0xb8, 0x74, 0xf7, 0xea, 0x58, 0x7e, 0x60, 0xa3, 0xad, 0xce, 0x42, 0x4e, 0x9a, 0x23, 0xe7, 0xd8,
+	0xb4, 0x4c, 0x7d, 0xe6, 0x78, 0xb6, 0xc0, 0xc0, 0xfe, 0xbf, 0x44, 0xba, 0xd0, 0x60, 0xd2, 0x3f,
+	0x3f, 0xe7, 0x77, 0x9e, 0xc7, 0x03, 0x39, 0xa6, 0x73, 0x01, 0x5e, 0xf8, 0x01, 0x91, 0xf1, 0x40,
+	0x8e, 0x69, 0x5d, 0x10, 0xba, 0x66, 0xd8, 0x8a, 0x6a, 0x0d, 0x51, 0x17, 0x30, 0xda, 0xa1, 0x7c,
+	0x9b, 0x46, 0xec, 0x06, 0xaf, 0x17, 0x44, 0x69, 0x30, 0x1b, 0x91, 0xeb, 0x8c, 0xaa, 0x1f, 0x89,
+	0x60, 0x90, 0xf1, 0x30, 0x58, 0x80, 0x71, 0x5e, 0x77, 0x08, 0x4f, 0x66, 0x83, 0xae, 0x7f, 0xe7,
+	0xe2, 0xfe, 0x5d, 0x81, 0xfb, 0xb1, 0x20, 0x90, 0xa8, 0xe2, 0xb2, 0x2f, 0xc4, 0xef, 0x14, 0xd0,
+	0xd8, 0x8a, 0xd3, 0x8e, 0x4b, 0x9c, 0xd0, 0x69, 0xf2, 0x35, 0xa2, 0xb1, 0x44, 0xf1, 0x8b, 0x1e,
+	0xa4, 0x7c, 0x27, 0x88, 0x86, 0x09, 0x27, 0xcb, 0x87, 0xc2, 0x76, 0x97, 0x91, 0x29, 0xdc, 0x89,
+	0x8c, 0x31, 0x23, 0x18, 0x29, 0xf5, 0xc4, 0xa6, 0x11, 0xa3, 0x2d, 0x24, 0x75, 0xdd, 0x12, 0x22,
+	0xd2, 0x89, 0x9d, 0x6c, 0x77, 0x45, 0x30, 0x69, 0x50, 0xa6, 0xd9, 0xd8, 0xf7, 0xa2, 0xa0, 0xcc,
+	0x47, 0xfa, 0xcf, 0x14, 0x28, 0x31, 0xf8, 0x6f, 0x76, 0x48, 0xd3, 0x77, 0xbc, 0x6e, 0x91, 0xcb,
+	0xab, 0x13, 0xb4, 0xff, 0xe7, 0x57, 0xfa, 0x18, 0x16, 0x79, 0x98, 0x97, 0xee, 0x4d, 0xfd, 0xc3,
+	0xf1, 0x9a, 0xd4, 0x6b, 0x69, 0xc9, 0x29, 0x30, 0xb0, 0xff, 0x03, 0xe2, 0x7b, 0x15, 0xe6, 0x13,
+	0x27, 0x7d, 0x7a, 0x75, 0x32, 0x28, 0x34, 0xdf, 0x85, 0x71, 0x72, 0xd5, 0x35, 0x43, 0x9e, 0x5c,
+	0x9d, 0xd8, 0x3a, 0x11, 0xc6, 0x96, 0xb1, 0xf2, 0x18, 0xb1, 0xe6, 0xbb, 0x2e, 0x5a, 0x34, 0xce,
+	0xf7, 0x6b, 0x69, 0xd2, 0x94, 0x89, 0x9e, 0x2c, 0xa9, 0xa3, 0xd4, 0x8c, 0x5e, 0x54, 0x20, 0xaf,
+	0x02, 0x9c, 0x23, 0x1a, 0xb1, 0x36, 0x77, 0xa1, 0x5e, 0x38, 0x47, 0xe4, 0xd3, 0x07, 0xff, 0x56,
+	0x61, 0x8c, 0x7e, 0xa6, 0x78, 0x1b, 0x66, 0x92, 0x5f, 0x07, 0x56, 0xe2, 0x95, 0x7d, 0xba, 0x09,
+	0xaf, 0xbd, 0x32, 0x68, 0x56, 0x66, 0x51, 0xfd, 0x07, 0x7f, 0xfe, 0xfb, 0x7b, 0xb9, 0x15, 0x5d,
+	0xab, 0xc4, 0xbe, 0x07, 0x89, 0xd7, 0x88, 0x48, 0x21, 0x6a, 0x0b, 0x0a, 0xdd, 0x2a, 0xb9, 0x98,
+	0xda, 0x56, 0xce, 0x68, 0xeb, 0xfd, 0x66, 0xa4, 0xb0, 0x35, 0x26, 0x6c, 0x49, 0xbf, 0x1f, 0x17,
+	0xc6, 0x74, 0x43, 0x7c, 0x1a, 0xe2, 0xd4, 0x10, 0xa6, 0x13, 0x8d, 0xeb, 0xe5, 0xd4, 0x96, 0xf1,
+	0x49, 0x6d, 0x73, 0xc0, 0xa4, 0x14, 0xb9, 0xc1, 0x44, 0x2e, 0xeb, 0x4b, 0x71, 0x91, 0x01, 0xe7,
+	0x34, 0x58, 0x42, 0xa4, 0x42, 0x13, 0x0d, 0xde, 0xb4, 0xd0, 0xf8, 0xa4, 0xb6, 0x39, 0x60, 0x72,
+	0xb0, 0xd0, 0x28, 0x21, 0x73, 0xa1, 0xdf, 0x83, 0x3b, 0x3d, 0xed, 0xd5, 0xb5, 0xec, 0xbd, 0x25,
+	0x83, 0xb6, 0x33, 0x84, 0x41, 0x02, 0x58, 0x67, 0x00, 0x34, 0xbd, 0xd8, 0x03, 0xa0, 0x6d, 0xb0,
+	0xbb, 0xa6, 0xfe, 0x50, 0x81, 0xf9, 0xde, 0x2e, 0x66, 0xb6, 0x09, 0x63, 0x1c, 0xda, 0xee, 0x30,
+	0x0e, 0x89, 0x61, 0x97, 0x61, 0xd0, 0xf5, 0xf5, 0x2c, 0x63, 0x8b, 0xfe, 0x0a, 0x4b, 0xb9, 0xea,
+	0x07, 0x0a, 0x2c, 0xf6, 0x69, 0xdf, 0x6d, 0xa5, 0xc4, 0x65, 0xb3, 0x69, 0x7b, 0x23, 0xb1, 0x49,
+	0x68, 0x7b, 0x0c, 0xda, 0x8e, 0xbe, 0x15, 0x87, 0xc6, 0x5b, 0x7d, 0x68, 0x38, 0x0d, 0xcb, 0x30,
+	0x3b, 0xc4, 0x37, 0xa2, 0xf6, 0xa0, 0xfa, 0x53, 0x05, 0xee, 0x66, 0xd5, 0x48, 0x7a, 0x4a, 0x6a,
+	0x06, 0x8f, 0xf6, 0xea, 0x70, 0x1e, 0x09, 0xeb, 0x21, 0x83, 0xb5, 0xa5, 0x6f, 0xc6, 0x61, 0xf1,
+	0x6a, 0x2e, 0x76, 0x49, 0x84, 0xd2, 0x7e, 0xa4, 0xc0, 0x7c, 0x3c, 0x4d, 0x71, 0x48, 0x1b, 0x99,
+	0x97, 0x3e, 0x9e, 0xc8, 0xb4, 0x07, 0x43, 0x59, 0x06, 0x9b, 0x50, 0x04, 0x87, 0x0e, 0x5f, 0x20,
+	0xd0, 0xbc, 0xab, 0x80, 0x9a, 0x91, 0x7b, 0xd3, 0x70, 0x7a, 0x59, 0xb4, 0x07, 0x43, 0x59, 0x06,
+	0xc3, 0xc1, 0xc0, 0x3a, 0x78, 0x64, 0xd8, 0x62, 0x41, 0xcc, 0xa3, 0xfa, 0x94, 0xa8, 0x69, 0x8f,
+	0xca, 0x66, 0xd3, 0xf6, 0x46, 0x62, 0x1b, 0xec, 0x51, 0xb1, 0xd4, 0x27, 0x9c, 0x2b, 0xc2, 0xf7,
+	0xbe, 0x02, 0x8b, 0x7d, 0xbe, 0x95, 0x6f, 0xf5, 0x5c, 0xb0, 0x2c, 0x36, 0x6d, 0x6f, 0x24, 0x36,
+	0x89, 0xef, 0x33, 0x0c, 0xdf, 0xb6, 0xfe, 0x4a, 0xf2, 0x32, 0x12, 0x23, 0xeb, 0x5b, 0xb6, 0xfa,
+	0x7d, 0x05, 0xe6, 0xd2, 0xdd, 0x91, 0x52, 0x3a, 0xf6, 0x24, 0xe7, 0xb5, 0xed, 0xc1, 0xf3, 0x12,
+	0xc9, 0x36, 0x43, 0xb2, 0xae, 0x97, 0x12, 0xa1, 0x89, 0x31, 0xc7, 0xbd, 0x5c, 0xfd, 0x95, 0x02,
+	0xda, 0x80, 0x1e, 0x41, 0xda, 0x6d, 0xfa, 0xb3, 0x6a, 0xfb, 0x23, 0xb3, 0x4a, 0x90, 0xfb, 0x0c,
+	0xe4, 0x43, 0xfd, 0x41, 0x42, 0x5d, 0x6c, 0x9d, 0x41, 0xcb, 0xf1, 0x6e, 0x29, 0x8e, 0x11, 0xa0,
+	0x77, 0x14, 0x98, 0xef, 0xed, 0xd9, 0xa4, 0x03, 0x6a, 0x0f, 0x87, 0xb6, 0x3b, 0x8c, 0x43, 0x82,
+	0xda, 0x61, 0xa0, 0x36, 0xf4, 0xb5, 0x38, 0x28, 0x47, 0xb0, 0x1b, 0xdd, 0x6f, 0xdd, 0xea, 0x2f,
+	0x14, 0x28, 0xf6, 0xfd, 0xf0, 0xba, 0x33, 0x20, 0x6b, 0xc6, 0x19, 0xb5, 0xca, 0x88, 0x8c, 0x12,
+	0x5f, 0x99, 0xe1, 0xdb, 0xd5, 0xb7, 0xfb, 0xa6, 0x5a, 0xc3, 0x8a, 0x23, 0xf9, 0xa5, 0x02, 0xc5,
+	0xbe, 0x6d, 0x91, 0x9d, 0x4c, 0xa3, 0xf5, 0x32, 0x6a, 0x95, 0x11, 0x19, 0x25, 0xcc, 0x0a, 0x83,
+	0xf9, 0x40, 0xdf, 0xc9, 0xb0, 0xad, 0xcd, 0x96, 0x31, 0xf3, 0x4a, 0xcb, 0x56, 0xbf, 0xf1, 0xe1,
+	0xf3, 0x92, 0xf2, 0xd1, 0xf3, 0x92, 0xf2, 0xb7, 0xe7, 0x25, 0xe5, 0x27, 0x2f, 0x4a, 0xb7, 0x3e,
+	0x7a, 0x51, 0xba, 0xf5, 0x97, 0x17, 0xa5, 0x5b, 0xdf, 0xfc, 0x72, 0xac, 0x1f, 0xfa, 0x15, 0xbe,
+	0xd9, 0x1e, 0xb7, 0x5b, 0x7a, 0xd8, 0xf6, 0xed, 0x8e, 0x8b, 0x95, 0x2b, 0x29, 0x93, 0x35, 0x4b,
+	0x1b, 0xb7, 0x59, 0xe7, 0xe5, 0xb3, 0xff, 0x19, 0x00, 0xd5, 0x37, 0x32, 0x6e, 0x04, 0x24, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2694,6 +3321,9 @@ type MsgClient interface {
 	SetOrchestratorAddress(ctx context.Context, in *MsgSetOrchestratorAddress, opts ...grpc.CallOption) (*MsgSetOrchestratorAddressResponse, error)
 	CancelSendToEth(ctx context.Context, in *MsgCancelSendToEth, opts ...grpc.CallOption) (*MsgCancelSendToEthResponse, error)
 	SubmitBadSignatureEvidence(ctx context.Context, in *MsgSubmitBadSignatureEvidence, opts ...grpc.CallOption) (*MsgSubmitBadSignatureEvidenceResponse, error)
+	IncreaseBridgeFee(ctx context.Context, in *MsgIncreaseBridgeFee, opts ...grpc.CallOption) (*MsgIncreaseBridgeFeeResponse, error)
+	RequestBatchCancellation(ctx context.Context, in *MsgRequestBatchCancellation, opts ...grpc.CallOption) (*MsgRequestBatchCancellationResponse, error)
+	SubmitDoubleSignEvidence(ctx context.Context, in *MsgSubmitDoubleSignEvidence, opts ...grpc.CallOption) (*MsgSubmitDoubleSignEvidenceResponse, error)
 }
 
 type msgClient struct {
@@ -2830,6 +3460,33 @@ func (c *msgClient) SubmitBadSignatureEvidence(ctx context.Context, in *MsgSubmi
 	return out, nil
 }
 
+func (c *msgClient) IncreaseBridgeFee(ctx context.Context, in *MsgIncreaseBridgeFee, opts ...grpc.CallOption) (*MsgIncreaseBridgeFeeResponse, error) {
+	out := new(MsgIncreaseBridgeFeeResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Msg/IncreaseBridgeFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RequestBatchCancellation(ctx context.Context, in *MsgRequestBatchCancellation, opts ...grpc.CallOption) (*MsgRequestBatchCancellationResponse, error) {
+	out := new(MsgRequestBatchCancellationResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Msg/RequestBatchCancellation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SubmitDoubleSignEvidence(ctx context.Context, in *MsgSubmitDoubleSignEvidence, opts ...grpc.CallOption) (*MsgSubmitDoubleSignEvidenceResponse, error) {
+	out := new(MsgSubmitDoubleSignEvidenceResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Msg/SubmitDoubleSignEvidence", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	ValsetConfirm(context.Context, *MsgValsetConfirm) (*MsgValsetConfirmResponse, error)
@@ -2846,6 +3503,9 @@ type MsgServer interface {
 	SetOrchestratorAddress(context.Context, *MsgSetOrchestratorAddress) (*MsgSetOrchestratorAddressResponse, error)
 	CancelSendToEth(context.Context, *MsgCancelSendToEth) (*MsgCancelSendToEthResponse, error)
 	SubmitBadSignatureEvidence(context.Context, *MsgSubmitBadSignatureEvidence) (*MsgSubmitBadSignatureEvidenceResponse, error)
+	IncreaseBridgeFee(context.Context, *MsgIncreaseBridgeFee) (*MsgIncreaseBridgeFeeResponse, error)
+	RequestBatchCancellation(context.Context, *MsgRequestBatchCancellation) (*MsgRequestBatchCancellationResponse, error)
+	SubmitDoubleSignEvidence(context.Context, *MsgSubmitDoubleSignEvidence) (*MsgSubmitDoubleSignEvidenceResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -2894,6 +3554,15 @@ func (*UnimplementedMsgServer) CancelSendToEth(ctx context.Context, req *MsgCanc
 func (*UnimplementedMsgServer) SubmitBadSignatureEvidence(ctx context.Context, req *MsgSubmitBadSignatureEvidence) (*MsgSubmitBadSignatureEvidenceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitBadSignatureEvidence not implemented")
 }
+func (*UnimplementedMsgServer) IncreaseBridgeFee(ctx context.Context, req *MsgIncreaseBridgeFee) (*MsgIncreaseBridgeFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IncreaseBridgeFee not implemented")
+}
+func (*UnimplementedMsgServer) RequestBatchCancellation(ctx context.Context, req *MsgRequestBatchCancellation) (*MsgRequestBatchCancellationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestBatchCancellation not implemented")
+}
+func (*UnimplementedMsgServer) SubmitDoubleSignEvidence(ctx context.Context, req *MsgSubmitDoubleSignEvidence) (*MsgSubmitDoubleSignEvidenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitDoubleSignEvidence not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -3151,34 +3820,88 @@ func _Msg_SubmitBadSignatureEvidence_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "gravity.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "ValsetConfirm",
-			Handler:    _Msg_ValsetConfirm_Handler,
-		},
-		{
-			MethodName: "SendToEth",
-			Handler:    _Msg_SendToEth_Handler,
-		},
-		{
-			MethodName: "RequestBatch",
-			Handler:    _Msg_RequestBatch_Handler,
-		},
-		{
-			MethodName: "ConfirmBatch",
-			Handler:    _Msg_ConfirmBatch_Handler,
-		},
-		{
-			MethodName: "ConfirmLogicCall",
-			Handler:    _Msg_ConfirmLogicCall_Handler,
-		},
-		{
-			MethodName: "SendToCosmosClaim",
-			Handler:    _Msg_SendToCosmosClaim_Handler,
-		},
+func _Msg_IncreaseBridgeFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgIncreaseBridgeFee)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).IncreaseBridgeFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Msg/IncreaseBridgeFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).IncreaseBridgeFee(ctx, req.(*MsgIncreaseBridgeFee))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RequestBatchCancellation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRequestBatchCancellation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RequestBatchCancellation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Msg/RequestBatchCancellation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RequestBatchCancellation(ctx, req.(*MsgRequestBatchCancellation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitDoubleSignEvidence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitDoubleSignEvidence)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitDoubleSignEvidence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Msg/SubmitDoubleSignEvidence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitDoubleSignEvidence(ctx, req.(*MsgSubmitDoubleSignEvidence))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "gravity.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ValsetConfirm",
+			Handler:    _Msg_ValsetConfirm_Handler,
+		},
+		{
+			MethodName: "SendToEth",
+			Handler:    _Msg_SendToEth_Handler,
+		},
+		{
+			MethodName: "RequestBatch",
+			Handler:    _Msg_RequestBatch_Handler,
+		},
+		{
+			MethodName: "ConfirmBatch",
+			Handler:    _Msg_ConfirmBatch_Handler,
+		},
+		{
+			MethodName: "ConfirmLogicCall",
+			Handler:    _Msg_ConfirmLogicCall_Handler,
+		},
+		{
+			MethodName: "SendToCosmosClaim",
+			Handler:    _Msg_SendToCosmosClaim_Handler,
+		},
 		{
 			MethodName: "ExecuteIbcAutoForwards",
 			Handler:    _Msg_ExecuteIbcAutoForwards_Handler,
@@ -3211,6 +3934,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SubmitBadSignatureEvidence",
 			Handler:    _Msg_SubmitBadSignatureEvidence_Handler,
 		},
+		{
+			MethodName: "IncreaseBridgeFee",
+			Handler:    _Msg_IncreaseBridgeFee_Handler,
+		},
+		{
+			MethodName: "RequestBatchCancellation",
+			Handler:    _Msg_RequestBatchCancellation_Handler,
+		},
+		{
+			MethodName: "SubmitDoubleSignEvidence",
+			Handler:    _Msg_SubmitDoubleSignEvidence_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "gravity/v1/msgs.proto",
@@ -3236,6 +3971,13 @@ func (m *MsgSetOrchestratorAddress) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if len(m.EthSignature) > 0 {
+		i -= len(m.EthSignature)
+		copy(dAtA[i:], m.EthSignature)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthSignature)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.EthAddress) > 0 {
 		i -= len(m.EthAddress)
 		copy(dAtA[i:], m.EthAddress)
@@ -3260,6 +4002,43 @@ func (m *MsgSetOrchestratorAddress) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *DelegateKeysSignMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegateKeysSignMsg) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegateKeysSignMsg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OrchestratorAddress) > 0 {
+		i -= len(m.OrchestratorAddress)
+		copy(dAtA[i:], m.OrchestratorAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.OrchestratorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgSetOrchestratorAddressResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3382,6 +4161,18 @@ func (m *MsgSendToEth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExtraFee != nil {
+		{
+			size, err := m.ExtraFee.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMsgs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
 	if len(m.EvmChainPrefix) > 0 {
 		i -= len(m.EvmChainPrefix)
 		copy(dAtA[i:], m.EvmChainPrefix)
@@ -3526,6 +4317,78 @@ func (m *MsgRequestBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgRequestBatchCancellation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestBatchCancellation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestBatchCancellation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequestBatchCancellationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestBatchCancellationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestBatchCancellationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgConfirmBatch) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3884,6 +4747,13 @@ func (m *MsgBatchSendToEthClaim) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Relayer) > 0 {
+		i -= len(m.Relayer)
+		copy(dAtA[i:], m.Relayer)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Relayer)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if len(m.EvmChainPrefix) > 0 {
 		i -= len(m.EvmChainPrefix)
 		copy(dAtA[i:], m.EvmChainPrefix)
@@ -4302,6 +5172,81 @@ func (m *MsgCancelSendToEthResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgIncreaseBridgeFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgIncreaseBridgeFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgIncreaseBridgeFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.AddedFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TransactionId != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.TransactionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgIncreaseBridgeFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgIncreaseBridgeFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgIncreaseBridgeFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgSubmitBadSignatureEvidence) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4381,7 +5326,7 @@ func (m *MsgSubmitBadSignatureEvidenceResponse) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
-func (m *EventSetOperatorAddress) Marshal() (dAtA []byte, err error) {
+func (m *MsgSubmitDoubleSignEvidence) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4391,20 +5336,118 @@ func (m *EventSetOperatorAddress) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventSetOperatorAddress) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSubmitDoubleSignEvidence) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventSetOperatorAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSubmitDoubleSignEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Address)))
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.SignatureB) > 0 {
+		i -= len(m.SignatureB)
+		copy(dAtA[i:], m.SignatureB)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.SignatureB)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.SubjectB != nil {
+		{
+			size, err := m.SubjectB.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMsgs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SignatureA) > 0 {
+		i -= len(m.SignatureA)
+		copy(dAtA[i:], m.SignatureA)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.SignatureA)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.SubjectA != nil {
+		{
+			size, err := m.SubjectA.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMsgs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitDoubleSignEvidenceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitDoubleSignEvidenceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitDoubleSignEvidenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSetOperatorAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSetOperatorAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSetOperatorAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Address)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -4559,6 +5602,43 @@ func (m *EventBatchSendToEthClaim) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *EventLogicCallExecutedClaim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventLogicCallExecutedClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventLogicCallExecutedClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LogicCallInvalidationNonce) > 0 {
+		i -= len(m.LogicCallInvalidationNonce)
+		copy(dAtA[i:], m.LogicCallInvalidationNonce)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.LogicCallInvalidationNonce)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.LogicCallInvalidationId) > 0 {
+		i -= len(m.LogicCallInvalidationId)
+		copy(dAtA[i:], m.LogicCallInvalidationId)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.LogicCallInvalidationId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventClaim) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4647,6 +5727,78 @@ func (m *EventBadSignatureEvidence) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *EventEvidenceRecorded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventEvidenceRecorded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventEvidenceRecorded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReporterReward) > 0 {
+		i -= len(m.ReporterReward)
+		copy(dAtA[i:], m.ReporterReward)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.ReporterReward)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.SlashAmount) > 0 {
+		i -= len(m.SlashAmount)
+		copy(dAtA[i:], m.SlashAmount)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.SlashAmount)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Reporter) > 0 {
+		i -= len(m.Reporter)
+		copy(dAtA[i:], m.Reporter)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Reporter)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Offender) > 0 {
+		i -= len(m.Offender)
+		copy(dAtA[i:], m.Offender)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Offender)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Kind) > 0 {
+		i -= len(m.Kind)
+		copy(dAtA[i:], m.Kind)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Kind)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventERC20DeployedClaim) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4734,6 +5886,13 @@ func (m *EventMultisigUpdateRequest) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.Nonce) > 0 {
 		i -= len(m.Nonce)
 		copy(dAtA[i:], m.Nonce)
@@ -4949,6 +6108,27 @@ func (m *MsgSetOrchestratorAddress) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMsgs(uint64(l))
 	}
+	l = len(m.EthSignature)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *DelegateKeysSignMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.OrchestratorAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
 	return n
 }
 
@@ -5022,6 +6202,10 @@ func (m *MsgSendToEth) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMsgs(uint64(l))
 	}
+	if m.ExtraFee != nil {
+		l = m.ExtraFee.Size()
+		n += 1 + l + sovMsgs(uint64(l))
+	}
 	return n
 }
 
@@ -5064,6 +6248,39 @@ func (m *MsgRequestBatchResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgRequestBatchCancellation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovMsgs(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *MsgRequestBatchCancellationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgConfirmBatch) Size() (n int) {
 	if m == nil {
 		return 0
@@ -5248,6 +6465,10 @@ func (m *MsgBatchSendToEthClaim) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMsgs(uint64(l))
 	}
+	l = len(m.Relayer)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
 	return n
 }
 
@@ -5426,19 +6647,14 @@ func (m *MsgCancelSendToEthResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgSubmitBadSignatureEvidence) Size() (n int) {
+func (m *MsgIncreaseBridgeFee) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Subject != nil {
-		l = m.Subject.Size()
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	l = len(m.Signature)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if m.TransactionId != 0 {
+		n += 1 + sovMsgs(uint64(m.TransactionId))
 	}
 	l = len(m.Sender)
 	if l > 0 {
@@ -5448,10 +6664,12 @@ func (m *MsgSubmitBadSignatureEvidence) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMsgs(uint64(l))
 	}
+	l = m.AddedFee.Size()
+	n += 1 + l + sovMsgs(uint64(l))
 	return n
 }
 
-func (m *MsgSubmitBadSignatureEvidenceResponse) Size() (n int) {
+func (m *MsgIncreaseBridgeFeeResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -5460,41 +6678,117 @@ func (m *MsgSubmitBadSignatureEvidenceResponse) Size() (n int) {
 	return n
 }
 
-func (m *EventSetOperatorAddress) Size() (n int) {
+func (m *MsgSubmitBadSignatureEvidence) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Message)
-	if l > 0 {
+	if m.Subject != nil {
+		l = m.Subject.Size()
 		n += 1 + l + sovMsgs(uint64(l))
 	}
-	l = len(m.Address)
+	l = len(m.Signature)
 	if l > 0 {
 		n += 1 + l + sovMsgs(uint64(l))
 	}
-	return n
-}
-
-func (m *EventValsetConfirmKey) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Message)
+	l = len(m.Sender)
 	if l > 0 {
 		n += 1 + l + sovMsgs(uint64(l))
 	}
-	l = len(m.Key)
+	l = len(m.EvmChainPrefix)
 	if l > 0 {
 		n += 1 + l + sovMsgs(uint64(l))
 	}
 	return n
 }
 
-func (m *EventBatchCreated) Size() (n int) {
+func (m *MsgSubmitBadSignatureEvidenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitDoubleSignEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubjectA != nil {
+		l = m.SubjectA.Size()
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.SignatureA)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.SubjectB != nil {
+		l = m.SubjectB.Size()
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.SignatureB)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitDoubleSignEvidenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *EventSetOperatorAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *EventValsetConfirmKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *EventBatchCreated) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -5541,6 +6835,23 @@ func (m *EventBatchSendToEthClaim) Size() (n int) {
 	return n
 }
 
+func (m *EventLogicCallExecutedClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LogicCallInvalidationId)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.LogicCallInvalidationNonce)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
 func (m *EventClaim) Size() (n int) {
 	if m == nil {
 		return 0
@@ -5583,6 +6894,43 @@ func (m *EventBadSignatureEvidence) Size() (n int) {
 	return n
 }
 
+func (m *EventEvidenceRecorded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Offender)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Reporter)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.SlashAmount)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.ReporterReward)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
 func (m *EventERC20DeployedClaim) Size() (n int) {
 	if m == nil {
 		return 0
@@ -5635,6 +6983,10 @@ func (m *EventMultisigUpdateRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMsgs(uint64(l))
 	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
 	return n
 }
 
@@ -5841,56 +7193,40 @@ func (m *MsgSetOrchestratorAddress) Unmarshal(dAtA []byte) error {
 			}
 			m.EthAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMsgs(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthSignature", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMsgs
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if byteLen < 0 {
+				return ErrInvalidLengthMsgs
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgSetOrchestratorAddressResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMsgs
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.EthSignature = append(m.EthSignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.EthSignature == nil {
+				m.EthSignature = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetOrchestratorAddressResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetOrchestratorAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgs(dAtA[iNdEx:])
@@ -5912,7 +7248,7 @@ func (m *MsgSetOrchestratorAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgValsetConfirm) Unmarshal(dAtA []byte) error {
+func (m *DelegateKeysSignMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5935,34 +7271,15 @@ func (m *MsgValsetConfirm) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgValsetConfirm: wiretype end group for non-group")
+			return fmt.Errorf("proto: DelegateKeysSignMsg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgValsetConfirm: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DelegateKeysSignMsg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
-			}
-			m.Nonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Orchestrator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5990,11 +7307,11 @@ func (m *MsgValsetConfirm) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Orchestrator = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrchestratorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6022,41 +7339,224 @@ func (m *MsgValsetConfirm) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EthAddress = string(dAtA[iNdEx:postIndex])
+			m.OrchestratorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMsgs
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signature = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetOrchestratorAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetOrchestratorAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetOrchestratorAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgValsetConfirm) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgValsetConfirm: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgValsetConfirm: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orchestrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orchestrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
 			}
@@ -6383,6 +7883,42 @@ func (m *MsgSendToEth) Unmarshal(dAtA []byte) error {
 			}
 			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtraFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExtraFee == nil {
+				m.ExtraFee = &types.Coin{}
+			}
+			if err := m.ExtraFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgs(dAtA[iNdEx:])
@@ -6650,7 +8186,7 @@ func (m *MsgRequestBatchResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConfirmBatch) Unmarshal(dAtA []byte) error {
+func (m *MsgRequestBatchCancellation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6673,17 +8209,17 @@ func (m *MsgConfirmBatch) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConfirmBatch: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRequestBatchCancellation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConfirmBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRequestBatchCancellation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
-			m.Nonce = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -6693,18 +8229,233 @@ func (m *MsgConfirmBatch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRequestBatchCancellationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRequestBatchCancellationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRequestBatchCancellationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConfirmBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConfirmBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConfirmBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
 					return ErrIntOverflowMsgs
 				}
 				if iNdEx >= l {
@@ -7905,6 +9656,38 @@ func (m *MsgBatchSendToEthClaim) Unmarshal(dAtA []byte) error {
 			}
 			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgs(dAtA[iNdEx:])
@@ -9084,7 +10867,7 @@ func (m *MsgCancelSendToEthResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitBadSignatureEvidence) Unmarshal(dAtA []byte) error {
+func (m *MsgIncreaseBridgeFee) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9107,17 +10890,17 @@ func (m *MsgSubmitBadSignatureEvidence) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitBadSignatureEvidence: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgIncreaseBridgeFee: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitBadSignatureEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgIncreaseBridgeFee: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransactionId", wireType)
 			}
-			var msglen int
+			m.TransactionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -9127,7 +10910,223 @@ func (m *MsgSubmitBadSignatureEvidence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TransactionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddedFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AddedFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgIncreaseBridgeFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgIncreaseBridgeFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgIncreaseBridgeFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitBadSignatureEvidence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitBadSignatureEvidence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitBadSignatureEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -9316,7 +11315,7 @@ func (m *MsgSubmitBadSignatureEvidenceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSetOperatorAddress) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitDoubleSignEvidence) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9339,15 +11338,51 @@ func (m *EventSetOperatorAddress) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSetOperatorAddress: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitDoubleSignEvidence: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSetOperatorAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitDoubleSignEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubjectA", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SubjectA == nil {
+				m.SubjectA = &types1.Any{}
+			}
+			if err := m.SubjectA.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignatureA", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9375,11 +11410,699 @@ func (m *EventSetOperatorAddress) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.SignatureA = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubjectB", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SubjectB == nil {
+				m.SubjectB = &types1.Any{}
+			}
+			if err := m.SubjectB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignatureB", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignatureB = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitDoubleSignEvidenceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitDoubleSignEvidenceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitDoubleSignEvidenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSetOperatorAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSetOperatorAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSetOperatorAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventValsetConfirmKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventValsetConfirmKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventValsetConfirmKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventBatchCreated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBatchCreated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBatchCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchNonce", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BatchNonce = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventBatchConfirmKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBatchConfirmKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBatchConfirmKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchConfirmKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BatchConfirmKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventBatchSendToEthClaim) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBatchSendToEthClaim: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBatchSendToEthClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9407,7 +12130,7 @@ func (m *EventSetOperatorAddress) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Nonce = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9430,7 +12153,7 @@ func (m *EventSetOperatorAddress) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventValsetConfirmKey) Unmarshal(dAtA []byte) error {
+func (m *EventLogicCallExecutedClaim) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9453,15 +12176,15 @@ func (m *EventValsetConfirmKey) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventValsetConfirmKey: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventLogicCallExecutedClaim: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventValsetConfirmKey: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventLogicCallExecutedClaim: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LogicCallInvalidationId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9489,11 +12212,11 @@ func (m *EventValsetConfirmKey) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.LogicCallInvalidationId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LogicCallInvalidationNonce", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9521,7 +12244,7 @@ func (m *EventValsetConfirmKey) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.LogicCallInvalidationNonce = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9544,7 +12267,7 @@ func (m *EventValsetConfirmKey) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventBatchCreated) Unmarshal(dAtA []byte) error {
+func (m *EventClaim) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9567,10 +12290,10 @@ func (m *EventBatchCreated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventBatchCreated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventClaim: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventBatchCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventClaim: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9607,7 +12330,7 @@ func (m *EventBatchCreated) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchNonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9635,7 +12358,39 @@ func (m *EventBatchCreated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BatchNonce = string(dAtA[iNdEx:postIndex])
+			m.ClaimHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttestationId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9658,7 +12413,7 @@ func (m *EventBatchCreated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventBatchConfirmKey) Unmarshal(dAtA []byte) error {
+func (m *EventBadSignatureEvidence) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9681,10 +12436,10 @@ func (m *EventBatchConfirmKey) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventBatchConfirmKey: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventBadSignatureEvidence: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventBatchConfirmKey: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventBadSignatureEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9721,7 +12476,7 @@ func (m *EventBatchConfirmKey) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchConfirmKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BadEthSignature", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9749,61 +12504,11 @@ func (m *EventBatchConfirmKey) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BatchConfirmKey = string(dAtA[iNdEx:postIndex])
+			m.BadEthSignature = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMsgs(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventBatchSendToEthClaim) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMsgs
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventBatchSendToEthClaim: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventBatchSendToEthClaim: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BadEthSignatureSubject", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9831,7 +12536,7 @@ func (m *EventBatchSendToEthClaim) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Nonce = string(dAtA[iNdEx:postIndex])
+			m.BadEthSignatureSubject = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9854,7 +12559,7 @@ func (m *EventBatchSendToEthClaim) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventClaim) Unmarshal(dAtA []byte) error {
+func (m *EventEvidenceRecorded) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9877,15 +12582,15 @@ func (m *EventClaim) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventClaim: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventEvidenceRecorded: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventEvidenceRecorded: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9913,11 +12618,11 @@ func (m *EventClaim) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClaimHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9945,11 +12650,11 @@ func (m *EventClaim) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClaimHash = string(dAtA[iNdEx:postIndex])
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AttestationId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9977,61 +12682,43 @@ func (m *EventClaim) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AttestationId = string(dAtA[iNdEx:postIndex])
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMsgs(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Offender", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMsgs
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventBadSignatureEvidence) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMsgs
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventBadSignatureEvidence: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventBadSignatureEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Offender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10059,11 +12746,11 @@ func (m *EventBadSignatureEvidence) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.Reporter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BadEthSignature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10091,11 +12778,11 @@ func (m *EventBadSignatureEvidence) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BadEthSignature = string(dAtA[iNdEx:postIndex])
+			m.SlashAmount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BadEthSignatureSubject", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReporterReward", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10123,7 +12810,7 @@ func (m *EventBadSignatureEvidence) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BadEthSignatureSubject = string(dAtA[iNdEx:postIndex])
+			m.ReporterReward = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10499,6 +13186,38 @@ func (m *EventMultisigUpdateRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Nonce = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgs(dAtA[iNdEx:])
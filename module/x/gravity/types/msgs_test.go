@@ -6,7 +6,9 @@ import (
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestValidateMsgSetOrchestratorAddress(t *testing.T) {
@@ -66,3 +68,35 @@ func TestValidateMsgSetOrchestratorAddress(t *testing.T) {
 	}
 
 }
+
+func TestValidateMsgSetOrchestratorAddressSignature(t *testing.T) {
+	var (
+		cosmosAddress sdk.AccAddress = bytes.Repeat([]byte{0x1}, 20)
+		valAddress    sdk.ValAddress = bytes.Repeat([]byte{0x1}, 20)
+	)
+	privKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	ethAddr, err := NewEthAddress(crypto.PubkeyToAddress(privKey.PublicKey).Hex())
+	require.NoError(t, err)
+
+	msg := NewMsgSetOrchestratorAddress(valAddress, cosmosAddress, *ethAddr)
+	msg.EthSignature, err = NewEthereumSignature(DelegateKeysSignHash(msg.Validator, msg.Orchestrator), privKey)
+	require.NoError(t, err)
+	assert.NoError(t, msg.ValidateBasic())
+	// validation must leave the signature untouched so that it can be repeated
+	assert.NoError(t, msg.ValidateBasic())
+
+	// the signature binds the orchestrator
+	otherOrchestrator := *msg
+	otherOrchestrator.Orchestrator = sdk.AccAddress(bytes.Repeat([]byte{0x2}, 20)).String()
+	assert.Error(t, otherOrchestrator.ValidateBasic())
+
+	// and the ethereum address
+	otherEthAddress := *msg
+	otherEthAddress.EthAddress = "0xb462864E395d88d6bc7C5dd5F3F5eb4cc2599255"
+	assert.Error(t, otherEthAddress.ValidateBasic())
+
+	truncated := *msg
+	truncated.EthSignature = msg.EthSignature[:64]
+	assert.Error(t, truncated.ValidateBasic())
+}
@@ -6,6 +6,7 @@ package types
 import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -73,6 +74,9 @@ type BatchFees struct {
 	Token     string                                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
 	TotalFees github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=total_fees,json=totalFees,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_fees"`
 	TxCount   uint64                                 `protobuf:"varint,3,opt,name=tx_count,json=txCount,proto3" json:"tx_count,omitempty"`
+	// the sum of the extra fees of the same txs, paid on cosmos. They are not
+	// part of total_fees and do not count toward auto batching
+	ExtraFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=extra_fees,json=extraFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"extra_fees"`
 }
 
 func (m *BatchFees) Reset()         { *m = BatchFees{} }
@@ -122,6 +126,13 @@ func (m *BatchFees) GetTxCount() uint64 {
 	return 0
 }
 
+func (m *BatchFees) GetExtraFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ExtraFees
+	}
+	return nil
+}
+
 type EventWithdrawalReceived struct {
 	BridgeContract string `protobuf:"bytes,1,opt,name=bridge_contract,json=bridgeContract,proto3" json:"bridge_contract,omitempty"`
 	BridgeChainId  string `protobuf:"bytes,2,opt,name=bridge_chain_id,json=bridgeChainId,proto3" json:"bridge_chain_id,omitempty"`
@@ -258,44 +269,252 @@ func (m *EventWithdrawCanceled) GetBridgeChainId() string {
 	return ""
 }
 
+type EventBridgeFeeIncreased struct {
+	Sender         string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	TxId           string `protobuf:"bytes,2,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	BridgeContract string `protobuf:"bytes,3,opt,name=bridge_contract,json=bridgeContract,proto3" json:"bridge_contract,omitempty"`
+	BridgeChainId  string `protobuf:"bytes,4,opt,name=bridge_chain_id,json=bridgeChainId,proto3" json:"bridge_chain_id,omitempty"`
+	AddedFee       string `protobuf:"bytes,5,opt,name=added_fee,json=addedFee,proto3" json:"added_fee,omitempty"`
+	NewFee         string `protobuf:"bytes,6,opt,name=new_fee,json=newFee,proto3" json:"new_fee,omitempty"`
+}
+
+func (m *EventBridgeFeeIncreased) Reset()         { *m = EventBridgeFeeIncreased{} }
+func (m *EventBridgeFeeIncreased) String() string { return proto.CompactTextString(m) }
+func (*EventBridgeFeeIncreased) ProtoMessage()    {}
+func (*EventBridgeFeeIncreased) Descriptor() ([]byte, []int) {
+	return fileDescriptor_18d107f7cfc31f22, []int{4}
+}
+func (m *EventBridgeFeeIncreased) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBridgeFeeIncreased) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventBridgeFeeIncreased.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventBridgeFeeIncreased) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBridgeFeeIncreased.Merge(m, src)
+}
+func (m *EventBridgeFeeIncreased) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBridgeFeeIncreased) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBridgeFeeIncreased.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventBridgeFeeIncreased proto.InternalMessageInfo
+
+func (m *EventBridgeFeeIncreased) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *EventBridgeFeeIncreased) GetTxId() string {
+	if m != nil {
+		return m.TxId
+	}
+	return ""
+}
+
+func (m *EventBridgeFeeIncreased) GetBridgeContract() string {
+	if m != nil {
+		return m.BridgeContract
+	}
+	return ""
+}
+
+func (m *EventBridgeFeeIncreased) GetBridgeChainId() string {
+	if m != nil {
+		return m.BridgeChainId
+	}
+	return ""
+}
+
+func (m *EventBridgeFeeIncreased) GetAddedFee() string {
+	if m != nil {
+		return m.AddedFee
+	}
+	return ""
+}
+
+func (m *EventBridgeFeeIncreased) GetNewFee() string {
+	if m != nil {
+		return m.NewFee
+	}
+	return ""
+}
+
+type EventWithdrawRefundedOverIbc struct {
+	EvmChainPrefix string `protobuf:"bytes,1,opt,name=evm_chain_prefix,json=evmChainPrefix,proto3" json:"evm_chain_prefix,omitempty"`
+	TxId           string `protobuf:"bytes,2,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	Port           string `protobuf:"bytes,3,opt,name=port,proto3" json:"port,omitempty"`
+	Channel        string `protobuf:"bytes,4,opt,name=channel,proto3" json:"channel,omitempty"`
+	Sequence       string `protobuf:"bytes,5,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Sender         string `protobuf:"bytes,6,opt,name=sender,proto3" json:"sender,omitempty"`
+	Receiver       string `protobuf:"bytes,7,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	Amount         string `protobuf:"bytes,8,opt,name=amount,proto3" json:"amount,omitempty"`
+	Error          string `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *EventWithdrawRefundedOverIbc) Reset()         { *m = EventWithdrawRefundedOverIbc{} }
+func (m *EventWithdrawRefundedOverIbc) String() string { return proto.CompactTextString(m) }
+func (*EventWithdrawRefundedOverIbc) ProtoMessage()    {}
+func (*EventWithdrawRefundedOverIbc) Descriptor() ([]byte, []int) {
+	return fileDescriptor_18d107f7cfc31f22, []int{5}
+}
+func (m *EventWithdrawRefundedOverIbc) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventWithdrawRefundedOverIbc) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventWithdrawRefundedOverIbc.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventWithdrawRefundedOverIbc) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventWithdrawRefundedOverIbc.Merge(m, src)
+}
+func (m *EventWithdrawRefundedOverIbc) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventWithdrawRefundedOverIbc) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventWithdrawRefundedOverIbc.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventWithdrawRefundedOverIbc proto.InternalMessageInfo
+
+func (m *EventWithdrawRefundedOverIbc) GetEvmChainPrefix() string {
+	if m != nil {
+		return m.EvmChainPrefix
+	}
+	return ""
+}
+
+func (m *EventWithdrawRefundedOverIbc) GetTxId() string {
+	if m != nil {
+		return m.TxId
+	}
+	return ""
+}
+
+func (m *EventWithdrawRefundedOverIbc) GetPort() string {
+	if m != nil {
+		return m.Port
+	}
+	return ""
+}
+
+func (m *EventWithdrawRefundedOverIbc) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *EventWithdrawRefundedOverIbc) GetSequence() string {
+	if m != nil {
+		return m.Sequence
+	}
+	return ""
+}
+
+func (m *EventWithdrawRefundedOverIbc) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *EventWithdrawRefundedOverIbc) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *EventWithdrawRefundedOverIbc) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *EventWithdrawRefundedOverIbc) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*IDSet)(nil), "gravity.v1.IDSet")
 	proto.RegisterType((*BatchFees)(nil), "gravity.v1.BatchFees")
 	proto.RegisterType((*EventWithdrawalReceived)(nil), "gravity.v1.EventWithdrawalReceived")
 	proto.RegisterType((*EventWithdrawCanceled)(nil), "gravity.v1.EventWithdrawCanceled")
+	proto.RegisterType((*EventBridgeFeeIncreased)(nil), "gravity.v1.EventBridgeFeeIncreased")
+	proto.RegisterType((*EventWithdrawRefundedOverIbc)(nil), "gravity.v1.EventWithdrawRefundedOverIbc")
 }
 
 func init() { proto.RegisterFile("gravity/v1/pool.proto", fileDescriptor_18d107f7cfc31f22) }
 
 var fileDescriptor_18d107f7cfc31f22 = []byte{
-	// 426 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xce, 0x62, 0xa7, 0x90, 0x15, 0x14, 0xb4, 0xb4, 0xe0, 0x72, 0x70, 0xa3, 0x08, 0x95, 0x5c,
-	0x6a, 0xab, 0xe2, 0x01, 0x90, 0x12, 0x7e, 0x94, 0x03, 0x17, 0x83, 0x84, 0xe0, 0x62, 0x39, 0xbb,
-	0x83, 0xb3, 0xaa, 0xb3, 0x13, 0xd9, 0x13, 0xe3, 0x3e, 0x03, 0x17, 0x2e, 0xbc, 0x02, 0xcf, 0xd2,
-	0x63, 0x8f, 0x88, 0x43, 0x85, 0x92, 0x17, 0x41, 0xde, 0x75, 0xc5, 0x8f, 0x7a, 0xe0, 0xe4, 0xf9,
-	0x3e, 0x7f, 0x3b, 0xf3, 0xcd, 0x0f, 0xdf, 0xcf, 0xcb, 0xac, 0xd6, 0x74, 0x16, 0xd7, 0x27, 0xf1,
-	0x0a, 0xb1, 0x88, 0x56, 0x25, 0x12, 0x0a, 0xde, 0xd1, 0x51, 0x7d, 0xf2, 0x68, 0x2f, 0xc7, 0x1c,
-	0x2d, 0x1d, 0xb7, 0x91, 0x53, 0x8c, 0x0e, 0x78, 0x7f, 0xf6, 0xfc, 0x0d, 0x90, 0xb8, 0xc7, 0x3d,
-	0xad, 0xaa, 0x80, 0x0d, 0xbd, 0xb1, 0x9f, 0xb4, 0xe1, 0xe8, 0x33, 0xe3, 0x83, 0x49, 0x46, 0x72,
-	0xf1, 0x12, 0xa0, 0x12, 0x7b, 0xbc, 0x4f, 0x78, 0x0a, 0x26, 0x60, 0x43, 0x36, 0x1e, 0x24, 0x0e,
-	0x88, 0xd7, 0x9c, 0x13, 0x52, 0x56, 0xa4, 0x1f, 0x01, 0xaa, 0xe0, 0x46, 0xfb, 0x6b, 0x12, 0x9d,
-	0x5f, 0x1e, 0xf6, 0x7e, 0x5c, 0x1e, 0x1e, 0xe5, 0x9a, 0x16, 0xeb, 0x79, 0x24, 0x71, 0x19, 0x4b,
-	0xac, 0x96, 0x58, 0x75, 0x9f, 0xe3, 0x4a, 0x9d, 0xc6, 0x74, 0xb6, 0x82, 0x2a, 0x9a, 0x19, 0x4a,
-	0x06, 0x36, 0x83, 0x2d, 0x72, 0xc0, 0x6f, 0x51, 0x93, 0x4a, 0x5c, 0x1b, 0x0a, 0xbc, 0x21, 0x1b,
-	0xfb, 0xc9, 0x4d, 0x6a, 0xa6, 0x2d, 0x1c, 0x7d, 0x63, 0xfc, 0xe1, 0x8b, 0x1a, 0x0c, 0xbd, 0xd3,
-	0xb4, 0x50, 0x65, 0xf6, 0x29, 0x2b, 0x12, 0x90, 0xa0, 0x6b, 0x50, 0xe2, 0x09, 0xbf, 0x3b, 0x2f,
-	0xb5, 0xca, 0x21, 0x95, 0x68, 0xa8, 0xcc, 0x24, 0x75, 0x2e, 0x77, 0x1d, 0x3d, 0xed, 0x58, 0x71,
-	0xf4, 0x5b, 0xb8, 0xc8, 0xb4, 0x49, 0xb5, 0x72, 0x9e, 0x93, 0x3b, 0x9d, 0xb0, 0x65, 0x67, 0x4a,
-	0x3c, 0xe6, 0xbb, 0xb8, 0xa6, 0x1c, 0xb5, 0xc9, 0x53, 0x6a, 0x5a, 0x99, 0x67, 0x65, 0xb7, 0xaf,
-	0xd8, 0xb7, 0xcd, 0x4c, 0xb5, 0x23, 0x31, 0x68, 0x24, 0x04, 0xbe, 0x1b, 0x89, 0x05, 0xa3, 0xaf,
-	0x8c, 0xef, 0xff, 0x65, 0x74, 0x9a, 0x19, 0x09, 0x05, 0x28, 0xf1, 0x80, 0xef, 0x54, 0x60, 0x14,
-	0x94, 0x9d, 0xbb, 0x0e, 0x89, 0xfb, 0xbc, 0xef, 0x8a, 0x38, 0x2f, 0x3e, 0xb5, 0xc9, 0xaf, 0xe9,
-	0xc9, 0xfb, 0xdf, 0x9e, 0xfc, 0x6b, 0x7a, 0x9a, 0xbc, 0x3f, 0xdf, 0x84, 0xec, 0x62, 0x13, 0xb2,
-	0x9f, 0x9b, 0x90, 0x7d, 0xd9, 0x86, 0xbd, 0x8b, 0x6d, 0xd8, 0xfb, 0xbe, 0x0d, 0x7b, 0x1f, 0x9e,
-	0xfd, 0xb1, 0xa8, 0x57, 0xee, 0x60, 0x8e, 0x27, 0xf6, 0xed, 0xbf, 0x70, 0x89, 0x6a, 0x5d, 0x40,
-	0xdc, 0xc4, 0x57, 0xe7, 0x66, 0xb7, 0x38, 0xdf, 0xb1, 0xb7, 0xf4, 0xf4, 0x57, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0xa0, 0x6e, 0x11, 0xe9, 0x86, 0x02, 0x00, 0x00,
+	// 649 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x54, 0xcd, 0x6e, 0x13, 0x3b,
+	0x18, 0xcd, 0x34, 0xff, 0xbe, 0xf7, 0xf6, 0x56, 0xa6, 0xa5, 0xd3, 0x80, 0xd2, 0x28, 0x42, 0x25,
+	0x9b, 0xce, 0x10, 0x78, 0x00, 0xa4, 0x04, 0x82, 0x66, 0x81, 0x40, 0x03, 0x12, 0x82, 0x4d, 0xe4,
+	0xd8, 0x5f, 0x27, 0x43, 0x13, 0x3b, 0x78, 0x9c, 0xe9, 0xf4, 0x11, 0xd8, 0xb1, 0xe1, 0x15, 0x58,
+	0xf0, 0x24, 0xdd, 0x20, 0x75, 0x89, 0x58, 0x14, 0xd4, 0x3e, 0x04, 0x5b, 0x64, 0x7b, 0x86, 0xb6,
+	0xa8, 0x8b, 0xee, 0x58, 0xcd, 0x9c, 0xe3, 0xcf, 0xfe, 0xce, 0x39, 0xfa, 0x6c, 0xb4, 0x11, 0x49,
+	0x92, 0xc6, 0xea, 0xd0, 0x4f, 0xfb, 0xfe, 0x42, 0x88, 0x99, 0xb7, 0x90, 0x42, 0x09, 0x8c, 0x72,
+	0xda, 0x4b, 0xfb, 0xad, 0xf5, 0x48, 0x44, 0xc2, 0xd0, 0xbe, 0xfe, 0xb3, 0x15, 0xad, 0x36, 0x15,
+	0xc9, 0x5c, 0x24, 0xfe, 0x84, 0x24, 0xe0, 0xa7, 0xfd, 0x09, 0x28, 0xd2, 0xf7, 0xa9, 0x88, 0xb9,
+	0x5d, 0xef, 0x6e, 0xa1, 0x6a, 0xf0, 0xe8, 0x05, 0x28, 0xbc, 0x86, 0xca, 0x31, 0x4b, 0x5c, 0xa7,
+	0x53, 0xee, 0x55, 0x42, 0xfd, 0xdb, 0xfd, 0xe9, 0xa0, 0xe6, 0x80, 0x28, 0x3a, 0x1d, 0x01, 0x24,
+	0x78, 0x1d, 0x55, 0x95, 0xd8, 0x07, 0xee, 0x3a, 0x1d, 0xa7, 0xd7, 0x0c, 0x2d, 0xc0, 0x4f, 0x11,
+	0x52, 0x42, 0x91, 0xd9, 0x78, 0x0f, 0x20, 0x71, 0x57, 0xf4, 0xd2, 0xc0, 0x3b, 0x3a, 0xd9, 0x2e,
+	0x7d, 0x3b, 0xd9, 0xde, 0x89, 0x62, 0x35, 0x5d, 0x4e, 0x3c, 0x2a, 0xe6, 0x7e, 0xae, 0xc2, 0x7e,
+	0x76, 0x13, 0xb6, 0xef, 0xab, 0xc3, 0x05, 0x24, 0x5e, 0xc0, 0x55, 0xd8, 0x34, 0x27, 0x98, 0x26,
+	0x5b, 0xa8, 0xa1, 0xb2, 0x31, 0x15, 0x4b, 0xae, 0xdc, 0x72, 0xc7, 0xe9, 0x55, 0xc2, 0xba, 0xca,
+	0x86, 0x1a, 0xe2, 0xb7, 0x08, 0x41, 0xa6, 0x24, 0xb1, 0x9d, 0x2a, 0x9d, 0x72, 0xef, 0x9f, 0xfb,
+	0x5b, 0x9e, 0x3d, 0xd0, 0xd3, 0xee, 0xbc, 0xdc, 0x9d, 0x37, 0x14, 0x31, 0x1f, 0xdc, 0xd3, 0x22,
+	0x3e, 0x7f, 0xdf, 0xee, 0x5d, 0x43, 0x84, 0xde, 0x90, 0x84, 0x4d, 0x73, 0xbc, 0x96, 0xd1, 0xfd,
+	0xe4, 0xa0, 0xcd, 0xc7, 0x29, 0x70, 0xf5, 0x2a, 0x56, 0x53, 0x26, 0xc9, 0x01, 0x99, 0x85, 0x40,
+	0x21, 0x4e, 0x81, 0xe1, 0xbb, 0xe8, 0xff, 0x89, 0x8c, 0x59, 0x04, 0x63, 0x2a, 0xb8, 0x92, 0x84,
+	0xaa, 0x3c, 0x91, 0x55, 0x4b, 0x0f, 0x73, 0x16, 0xef, 0x9c, 0x17, 0x4e, 0x49, 0xcc, 0xc7, 0x31,
+	0xb3, 0xf9, 0x84, 0xff, 0xe5, 0x85, 0x9a, 0x0d, 0x18, 0xbe, 0x83, 0x56, 0xc5, 0x52, 0x45, 0x22,
+	0xe6, 0xd1, 0x58, 0x65, 0xba, 0xac, 0x6c, 0xca, 0xfe, 0x2d, 0xd8, 0x97, 0x59, 0xc0, 0x74, 0xfc,
+	0x5c, 0x70, 0x0a, 0x6e, 0xc5, 0xc6, 0x6f, 0x40, 0xf7, 0xa3, 0x83, 0x36, 0x2e, 0x09, 0x1d, 0x12,
+	0x4e, 0x61, 0x06, 0x0c, 0xdf, 0x44, 0xb5, 0x04, 0x38, 0x03, 0x99, 0xab, 0xcb, 0x11, 0xbe, 0x81,
+	0xaa, 0x2a, 0x3b, 0xd7, 0x52, 0x51, 0x59, 0x70, 0xa5, 0xa7, 0xf2, 0x75, 0x3d, 0x55, 0xae, 0xf0,
+	0xd4, 0xfd, 0x52, 0x04, 0x38, 0x30, 0xf4, 0x08, 0x20, 0xe0, 0x54, 0x02, 0x49, 0xfe, 0xb6, 0x32,
+	0x7c, 0x0b, 0x35, 0x09, 0x63, 0xc0, 0xf4, 0x18, 0xb9, 0x55, 0x53, 0xd1, 0x30, 0xc4, 0x08, 0x00,
+	0x6f, 0xa2, 0x3a, 0x87, 0x03, 0xb3, 0x54, 0xb3, 0xda, 0x38, 0x1c, 0x8c, 0x00, 0xba, 0xef, 0x57,
+	0xd0, 0xed, 0x4b, 0x39, 0x87, 0xb0, 0xb7, 0xe4, 0x0c, 0xd8, 0xb3, 0x14, 0x64, 0x30, 0xa1, 0xb8,
+	0x87, 0xd6, 0x20, 0x9d, 0xe7, 0xbd, 0x17, 0x12, 0xf6, 0xe2, 0xac, 0x18, 0x0b, 0x48, 0xe7, 0xa6,
+	0xf9, 0x73, 0xc3, 0x5e, 0x6d, 0x13, 0xa3, 0xca, 0x42, 0xc8, 0xc2, 0x9b, 0xf9, 0xc7, 0x2e, 0xaa,
+	0xd3, 0x29, 0xe1, 0x1c, 0x66, 0xb9, 0x93, 0x02, 0xe2, 0x16, 0x6a, 0x24, 0xf0, 0x6e, 0x09, 0x9c,
+	0xfe, 0xb6, 0x50, 0xe0, 0x0b, 0xe9, 0xd6, 0x2e, 0xa5, 0xdb, 0x42, 0x0d, 0x69, 0x47, 0x58, 0xba,
+	0x75, 0xbb, 0xa7, 0xc0, 0x7a, 0x0f, 0x99, 0x9b, 0x3b, 0xd7, 0xb0, 0x7b, 0x2c, 0xd2, 0x33, 0x07,
+	0x52, 0x0a, 0xe9, 0x36, 0xed, 0xcc, 0x19, 0x30, 0x78, 0x7d, 0x74, 0xda, 0x76, 0x8e, 0x4f, 0xdb,
+	0xce, 0x8f, 0xd3, 0xb6, 0xf3, 0xe1, 0xac, 0x5d, 0x3a, 0x3e, 0x6b, 0x97, 0xbe, 0x9e, 0xb5, 0x4b,
+	0x6f, 0x1e, 0x5e, 0xb8, 0x6b, 0x4f, 0xec, 0xc3, 0xb4, 0x6b, 0x07, 0xe0, 0x4f, 0x38, 0x17, 0x6c,
+	0x39, 0x03, 0x3f, 0xf3, 0x8b, 0x67, 0xcd, 0x5c, 0xc4, 0x49, 0xcd, 0xbc, 0x49, 0x0f, 0x7e, 0x0d,
+	0x00, 0x41, 0x83, 0x5f, 0x32, 0xee, 0x04, 0x00, 0x00,
 }
 
 func (m *IDSet) Marshal() (dAtA []byte, err error) {
@@ -359,6 +578,20 @@ func (m *BatchFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ExtraFees) > 0 {
+		for iNdEx := len(m.ExtraFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExtraFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPool(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.TxCount != 0 {
 		i = encodeVarintPool(dAtA, i, uint64(m.TxCount))
 		i--
@@ -486,6 +719,157 @@ func (m *EventWithdrawCanceled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventBridgeFeeIncreased) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBridgeFeeIncreased) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBridgeFeeIncreased) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewFee) > 0 {
+		i -= len(m.NewFee)
+		copy(dAtA[i:], m.NewFee)
+		i = encodeVarintPool(dAtA, i, uint64(len(m.NewFee)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.AddedFee) > 0 {
+		i -= len(m.AddedFee)
+		copy(dAtA[i:], m.AddedFee)
+		i = encodeVarintPool(dAtA, i, uint64(len(m.AddedFee)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.BridgeChainId) > 0 {
+		i -= len(m.BridgeChainId)
+		copy(dAtA[i:], m.BridgeChainId)
+		i = encodeVarintPool(dAtA, i, uint64(len(m.BridgeChainId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.BridgeContract) > 0 {
+		i -= len(m.BridgeContract)
+		copy(dAtA[i:], m.BridgeContract)
+		i = encodeVarintPool(dAtA, i, uint64(len(m.BridgeContract)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TxId) > 0 {
+		i -= len(m.TxId)
+		copy(dAtA[i:], m.TxId)
+		i = encodeVarintPool(dAtA, i, uint64(len(m.TxId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintPool(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventWithdrawRefundedOverIbc) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventWithdrawRefundedOverIbc) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventWithdrawRefundedOverIbc) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintPool(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintPool(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintPool(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintPool(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Sequence) > 0 {
+		i -= len(m.Sequence)
+		copy(dAtA[i:], m.Sequence)
+		i = encodeVarintPool(dAtA, i, uint64(len(m.Sequence)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintPool(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Port) > 0 {
+		i -= len(m.Port)
+		copy(dAtA[i:], m.Port)
+		i = encodeVarintPool(dAtA, i, uint64(len(m.Port)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TxId) > 0 {
+		i -= len(m.TxId)
+		copy(dAtA[i:], m.TxId)
+		i = encodeVarintPool(dAtA, i, uint64(len(m.TxId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintPool(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintPool(dAtA []byte, offset int, v uint64) int {
 	offset -= sovPool(v)
 	base := offset
@@ -528,6 +912,12 @@ func (m *BatchFees) Size() (n int) {
 	if m.TxCount != 0 {
 		n += 1 + sovPool(uint64(m.TxCount))
 	}
+	if len(m.ExtraFees) > 0 {
+		for _, e := range m.ExtraFees {
+			l = e.Size()
+			n += 1 + l + sovPool(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -581,21 +971,99 @@ func (m *EventWithdrawCanceled) Size() (n int) {
 	return n
 }
 
-func sovPool(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozPool(x uint64) (n int) {
-	return sovPool(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *IDSet) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPool
+func (m *EventBridgeFeeIncreased) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovPool(uint64(l))
+	}
+	l = len(m.TxId)
+	if l > 0 {
+		n += 1 + l + sovPool(uint64(l))
+	}
+	l = len(m.BridgeContract)
+	if l > 0 {
+		n += 1 + l + sovPool(uint64(l))
+	}
+	l = len(m.BridgeChainId)
+	if l > 0 {
+		n += 1 + l + sovPool(uint64(l))
+	}
+	l = len(m.AddedFee)
+	if l > 0 {
+		n += 1 + l + sovPool(uint64(l))
+	}
+	l = len(m.NewFee)
+	if l > 0 {
+		n += 1 + l + sovPool(uint64(l))
+	}
+	return n
+}
+
+func (m *EventWithdrawRefundedOverIbc) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovPool(uint64(l))
+	}
+	l = len(m.TxId)
+	if l > 0 {
+		n += 1 + l + sovPool(uint64(l))
+	}
+	l = len(m.Port)
+	if l > 0 {
+		n += 1 + l + sovPool(uint64(l))
+	}
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovPool(uint64(l))
+	}
+	l = len(m.Sequence)
+	if l > 0 {
+		n += 1 + l + sovPool(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovPool(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovPool(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovPool(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovPool(uint64(l))
+	}
+	return n
+}
+
+func sovPool(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPool(x uint64) (n int) {
+	return sovPool(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *IDSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPool
 			}
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
@@ -827,6 +1295,40 @@ func (m *BatchFees) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtraFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExtraFees = append(m.ExtraFees, types.Coin{})
+			if err := m.ExtraFees[len(m.ExtraFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPool(dAtA[iNdEx:])
@@ -1204,6 +1706,586 @@ func (m *EventWithdrawCanceled) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventBridgeFeeIncreased) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBridgeFeeIncreased: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBridgeFeeIncreased: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddedFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddedFee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewFee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventWithdrawRefundedOverIbc) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventWithdrawRefundedOverIbc: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventWithdrawRefundedOverIbc: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Port = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sequence = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipPool(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
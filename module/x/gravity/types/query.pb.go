@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -2725,6 +2726,7 @@ func (m *QueryListEvmChainsResponse) GetEvmChains() []EvmChain {
 }
 
 type QueryMonitoredERC20Addresses struct {
+	EvmChainPrefix string `protobuf:"bytes,1,opt,name=evm_chain_prefix,json=evmChainPrefix,proto3" json:"evm_chain_prefix,omitempty"`
 }
 
 func (m *QueryMonitoredERC20Addresses) Reset()         { *m = QueryMonitoredERC20Addresses{} }
@@ -2760,6 +2762,13 @@ func (m *QueryMonitoredERC20Addresses) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryMonitoredERC20Addresses proto.InternalMessageInfo
 
+func (m *QueryMonitoredERC20Addresses) GetEvmChainPrefix() string {
+	if m != nil {
+		return m.EvmChainPrefix
+	}
+	return ""
+}
+
 type QueryMonitoredERC20AddressesResponse struct {
 	Addresses []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
 }
@@ -2805,10 +2814,12 @@ func (m *QueryMonitoredERC20AddressesResponse) GetAddresses() []string {
 }
 
 // Query params for GetBridgeBalanceSnapshots, with a limit (0 for unlimited),
-// and boolean newest_first (true for descending by event nonce)
+// boolean newest_first (true for descending by event nonce) and the evm chain
+// whose snapshots should be returned
 type QueryBridgeBalanceSnapshots struct {
-	Limit       uint64 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
-	NewestFirst bool   `protobuf:"varint,2,opt,name=newest_first,json=newestFirst,proto3" json:"newest_first,omitempty"`
+	Limit          uint64 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	NewestFirst    bool   `protobuf:"varint,2,opt,name=newest_first,json=newestFirst,proto3" json:"newest_first,omitempty"`
+	EvmChainPrefix string `protobuf:"bytes,3,opt,name=evm_chain_prefix,json=evmChainPrefix,proto3" json:"evm_chain_prefix,omitempty"`
 }
 
 func (m *QueryBridgeBalanceSnapshots) Reset()         { *m = QueryBridgeBalanceSnapshots{} }
@@ -2858,6 +2869,13 @@ func (m *QueryBridgeBalanceSnapshots) GetNewestFirst() bool {
 	return false
 }
 
+func (m *QueryBridgeBalanceSnapshots) GetEvmChainPrefix() string {
+	if m != nil {
+		return m.EvmChainPrefix
+	}
+	return ""
+}
+
 type QueryBridgeBalanceSnapshotsResponse struct {
 	Snapshots []*BridgeBalanceSnapshot `protobuf:"bytes,1,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
 }
@@ -3006,3048 +3024,3286 @@ func (m *QueryBridgeBalanceSnapshotByEventNonceResponse) GetSnapshot() *BridgeBa
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "gravity.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "gravity.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryCurrentValsetRequest)(nil), "gravity.v1.QueryCurrentValsetRequest")
-	proto.RegisterType((*QueryCurrentValsetResponse)(nil), "gravity.v1.QueryCurrentValsetResponse")
-	proto.RegisterType((*QueryValsetRequestRequest)(nil), "gravity.v1.QueryValsetRequestRequest")
-	proto.RegisterType((*QueryValsetRequestResponse)(nil), "gravity.v1.QueryValsetRequestResponse")
-	proto.RegisterType((*QueryValsetConfirmRequest)(nil), "gravity.v1.QueryValsetConfirmRequest")
-	proto.RegisterType((*QueryValsetConfirmResponse)(nil), "gravity.v1.QueryValsetConfirmResponse")
-	proto.RegisterType((*QueryValsetConfirmsByNonceRequest)(nil), "gravity.v1.QueryValsetConfirmsByNonceRequest")
-	proto.RegisterType((*QueryValsetConfirmsByNonceResponse)(nil), "gravity.v1.QueryValsetConfirmsByNonceResponse")
-	proto.RegisterType((*QueryLastValsetRequestsRequest)(nil), "gravity.v1.QueryLastValsetRequestsRequest")
-	proto.RegisterType((*QueryLastValsetRequestsResponse)(nil), "gravity.v1.QueryLastValsetRequestsResponse")
-	proto.RegisterType((*QueryLastPendingValsetRequestByAddrRequest)(nil), "gravity.v1.QueryLastPendingValsetRequestByAddrRequest")
-	proto.RegisterType((*QueryLastPendingValsetRequestByAddrResponse)(nil), "gravity.v1.QueryLastPendingValsetRequestByAddrResponse")
-	proto.RegisterType((*QueryBatchFeeRequest)(nil), "gravity.v1.QueryBatchFeeRequest")
-	proto.RegisterType((*QueryBatchFeeResponse)(nil), "gravity.v1.QueryBatchFeeResponse")
-	proto.RegisterType((*QueryLastPendingBatchRequestByAddrRequest)(nil), "gravity.v1.QueryLastPendingBatchRequestByAddrRequest")
-	proto.RegisterType((*QueryLastPendingBatchRequestByAddrResponse)(nil), "gravity.v1.QueryLastPendingBatchRequestByAddrResponse")
-	proto.RegisterType((*QueryLastPendingLogicCallByAddrRequest)(nil), "gravity.v1.QueryLastPendingLogicCallByAddrRequest")
-	proto.RegisterType((*QueryLastPendingLogicCallByAddrResponse)(nil), "gravity.v1.QueryLastPendingLogicCallByAddrResponse")
-	proto.RegisterType((*QueryOutgoingTxBatchesRequest)(nil), "gravity.v1.QueryOutgoingTxBatchesRequest")
-	proto.RegisterType((*QueryOutgoingTxBatchesResponse)(nil), "gravity.v1.QueryOutgoingTxBatchesResponse")
-	proto.RegisterType((*QueryOutgoingLogicCallsRequest)(nil), "gravity.v1.QueryOutgoingLogicCallsRequest")
-	proto.RegisterType((*QueryOutgoingLogicCallsResponse)(nil), "gravity.v1.QueryOutgoingLogicCallsResponse")
-	proto.RegisterType((*QueryBatchRequestByNonceRequest)(nil), "gravity.v1.QueryBatchRequestByNonceRequest")
-	proto.RegisterType((*QueryBatchRequestByNonceResponse)(nil), "gravity.v1.QueryBatchRequestByNonceResponse")
-	proto.RegisterType((*QueryBatchConfirmsRequest)(nil), "gravity.v1.QueryBatchConfirmsRequest")
-	proto.RegisterType((*QueryBatchConfirmsResponse)(nil), "gravity.v1.QueryBatchConfirmsResponse")
-	proto.RegisterType((*QueryLogicConfirmsRequest)(nil), "gravity.v1.QueryLogicConfirmsRequest")
-	proto.RegisterType((*QueryLogicConfirmsResponse)(nil), "gravity.v1.QueryLogicConfirmsResponse")
-	proto.RegisterType((*QueryLastEventNonceByAddrRequest)(nil), "gravity.v1.QueryLastEventNonceByAddrRequest")
-	proto.RegisterType((*QueryLastEventNonceByAddrResponse)(nil), "gravity.v1.QueryLastEventNonceByAddrResponse")
-	proto.RegisterType((*QueryERC20ToDenomRequest)(nil), "gravity.v1.QueryERC20ToDenomRequest")
-	proto.RegisterType((*QueryERC20ToDenomResponse)(nil), "gravity.v1.QueryERC20ToDenomResponse")
-	proto.RegisterType((*QueryDenomToERC20Request)(nil), "gravity.v1.QueryDenomToERC20Request")
-	proto.RegisterType((*QueryDenomToERC20Response)(nil), "gravity.v1.QueryDenomToERC20Response")
-	proto.RegisterType((*QueryLastObservedEthBlockRequest)(nil), "gravity.v1.QueryLastObservedEthBlockRequest")
-	proto.RegisterType((*QueryLastObservedEthBlockResponse)(nil), "gravity.v1.QueryLastObservedEthBlockResponse")
-	proto.RegisterType((*QueryLastObservedEthNonceRequest)(nil), "gravity.v1.QueryLastObservedEthNonceRequest")
-	proto.RegisterType((*QueryLastObservedEthNonceResponse)(nil), "gravity.v1.QueryLastObservedEthNonceResponse")
-	proto.RegisterType((*QueryAttestationsRequest)(nil), "gravity.v1.QueryAttestationsRequest")
-	proto.RegisterType((*QueryAttestationsResponse)(nil), "gravity.v1.QueryAttestationsResponse")
-	proto.RegisterType((*QueryDelegateKeysByValidatorAddress)(nil), "gravity.v1.QueryDelegateKeysByValidatorAddress")
-	proto.RegisterType((*QueryDelegateKeysByValidatorAddressResponse)(nil), "gravity.v1.QueryDelegateKeysByValidatorAddressResponse")
-	proto.RegisterType((*QueryDelegateKeysByEthAddress)(nil), "gravity.v1.QueryDelegateKeysByEthAddress")
-	proto.RegisterType((*QueryDelegateKeysByEthAddressResponse)(nil), "gravity.v1.QueryDelegateKeysByEthAddressResponse")
-	proto.RegisterType((*QueryDelegateKeysByOrchestratorAddress)(nil), "gravity.v1.QueryDelegateKeysByOrchestratorAddress")
-	proto.RegisterType((*QueryDelegateKeysByOrchestratorAddressResponse)(nil), "gravity.v1.QueryDelegateKeysByOrchestratorAddressResponse")
-	proto.RegisterType((*QueryPendingSendToEth)(nil), "gravity.v1.QueryPendingSendToEth")
-	proto.RegisterType((*QueryPendingSendToEthResponse)(nil), "gravity.v1.QueryPendingSendToEthResponse")
-	proto.RegisterType((*QueryPendingIbcAutoForwards)(nil), "gravity.v1.QueryPendingIbcAutoForwards")
-	proto.RegisterType((*QueryPendingIbcAutoForwardsResponse)(nil), "gravity.v1.QueryPendingIbcAutoForwardsResponse")
-	proto.RegisterType((*QueryListEvmChains)(nil), "gravity.v1.QueryListEvmChains")
-	proto.RegisterType((*QueryListEvmChainsResponse)(nil), "gravity.v1.QueryListEvmChainsResponse")
-	proto.RegisterType((*QueryMonitoredERC20Addresses)(nil), "gravity.v1.QueryMonitoredERC20Addresses")
-	proto.RegisterType((*QueryMonitoredERC20AddressesResponse)(nil), "gravity.v1.QueryMonitoredERC20AddressesResponse")
-	proto.RegisterType((*QueryBridgeBalanceSnapshots)(nil), "gravity.v1.QueryBridgeBalanceSnapshots")
-	proto.RegisterType((*QueryBridgeBalanceSnapshotsResponse)(nil), "gravity.v1.QueryBridgeBalanceSnapshotsResponse")
-	proto.RegisterType((*QueryBridgeBalanceSnapshotByEventNonce)(nil), "gravity.v1.QueryBridgeBalanceSnapshotByEventNonce")
-	proto.RegisterType((*QueryBridgeBalanceSnapshotByEventNonceResponse)(nil), "gravity.v1.QueryBridgeBalanceSnapshotByEventNonceResponse")
+// Query params for GetRateLimits, an empty denom returns the rate limits of
+// every denom on the evm chain
+type QueryRateLimitsRequest struct {
+	EvmChainPrefix string `protobuf:"bytes,1,opt,name=evm_chain_prefix,json=evmChainPrefix,proto3" json:"evm_chain_prefix,omitempty"`
+	Denom          string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func init() { proto.RegisterFile("gravity/v1/query.proto", fileDescriptor_29a9d4192703013c) }
-
-var fileDescriptor_29a9d4192703013c = []byte{
-	// 2546 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x9a, 0x5b, 0x6f, 0x1b, 0xc7,
-	0x15, 0xc7, 0xbd, 0x8e, 0x65, 0x5b, 0xc7, 0xf7, 0xb1, 0xec, 0x48, 0x6b, 0x99, 0xb2, 0xd6, 0x96,
-	0xad, 0x8b, 0x25, 0x4a, 0x74, 0x6d, 0x37, 0x4e, 0x9b, 0x44, 0x94, 0x65, 0xd5, 0x8d, 0x63, 0xbb,
-	0xb4, 0xe2, 0xa2, 0x71, 0xd2, 0xc5, 0x92, 0x3b, 0x22, 0xb7, 0x26, 0x77, 0x98, 0xdd, 0x21, 0x23,
-	0x22, 0x48, 0x80, 0xb6, 0x40, 0x8a, 0x16, 0x7d, 0x28, 0xd0, 0xcb, 0x43, 0x81, 0x02, 0x7d, 0x6b,
-	0x5f, 0x5a, 0xa0, 0x2f, 0x7d, 0xed, 0x6b, 0xd0, 0x02, 0x45, 0x80, 0xbe, 0xf4, 0xa1, 0x28, 0x02,
-	0xbb, 0x1f, 0xa0, 0x1f, 0xa1, 0xd8, 0xb9, 0x2c, 0xf7, 0x32, 0x4b, 0x2e, 0x15, 0x07, 0x7d, 0xd3,
-	0x9e, 0x3d, 0x73, 0xce, 0xef, 0xcc, 0xce, 0x9e, 0x99, 0xfd, 0x8b, 0x70, 0xb6, 0xee, 0x59, 0x5d,
-	0x87, 0xf6, 0x8a, 0xdd, 0xb5, 0xe2, 0xfb, 0x1d, 0xec, 0xf5, 0x56, 0xda, 0x1e, 0xa1, 0x04, 0x81,
-	0xb0, 0xaf, 0x74, 0xd7, 0xf4, 0xc9, 0x88, 0x4f, 0x1d, 0xbb, 0xd8, 0x77, 0x7c, 0xee, 0xa5, 0x47,
-	0x47, 0xd3, 0x5e, 0x1b, 0x4b, 0xfb, 0x99, 0x88, 0xbd, 0xe5, 0xd7, 0x55, 0xe6, 0x36, 0x21, 0x4d,
-	0x45, 0x94, 0xaa, 0x45, 0x6b, 0x0d, 0x61, 0x9f, 0x8e, 0xd8, 0x2d, 0x4a, 0xb1, 0x4f, 0x2d, 0xea,
-	0x10, 0x37, 0xbc, 0x4b, 0x48, 0xbd, 0x89, 0x8b, 0x56, 0xdb, 0x29, 0x5a, 0xae, 0x4b, 0xf8, 0x4d,
-	0x99, 0x6a, 0xa2, 0x4e, 0xea, 0x84, 0xfd, 0x59, 0x0c, 0xfe, 0xe2, 0x56, 0x63, 0x02, 0xd0, 0xb7,
-	0x82, 0x22, 0x1f, 0x5a, 0x9e, 0xd5, 0xf2, 0x2b, 0xf8, 0xfd, 0x0e, 0xf6, 0xa9, 0xb1, 0x05, 0xa7,
-	0x63, 0x56, 0xbf, 0x4d, 0x5c, 0x1f, 0xa3, 0x55, 0x38, 0xd8, 0x66, 0x96, 0x49, 0xed, 0x82, 0x36,
-	0x7f, 0xa4, 0x84, 0x56, 0xfa, 0x73, 0xb2, 0xc2, 0x7d, 0xcb, 0x07, 0x3e, 0xfd, 0xf7, 0xcc, 0xbe,
-	0x8a, 0xf0, 0x33, 0x36, 0x61, 0x8a, 0x05, 0xda, 0xe8, 0x78, 0x1e, 0x76, 0xe9, 0x63, 0xab, 0xe9,
-	0x63, 0x2a, 0xb2, 0xa0, 0x79, 0x38, 0x89, 0xbb, 0x2d, 0xb3, 0xd6, 0xb0, 0x1c, 0xd7, 0x6c, 0x7b,
-	0x78, 0xc7, 0xd9, 0x65, 0x81, 0xc7, 0x2b, 0xc7, 0x71, 0xb7, 0xb5, 0x11, 0x98, 0x1f, 0x32, 0xab,
-	0x71, 0x1f, 0x74, 0x55, 0x98, 0x3e, 0x56, 0x97, 0x59, 0x54, 0x58, 0xdc, 0x57, 0x62, 0x71, 0x3f,
-	0xe3, 0x89, 0xc0, 0x8a, 0xf1, 0x48, 0xac, 0x09, 0x18, 0x73, 0x89, 0x5b, 0xc3, 0x2c, 0xda, 0x81,
-	0x0a, 0xbf, 0x50, 0xc2, 0xee, 0x57, 0xc2, 0x7e, 0x43, 0xc0, 0x26, 0x82, 0x0b, 0xd8, 0xc5, 0xe1,
-	0xb0, 0x21, 0x66, 0x27, 0x86, 0xb9, 0x41, 0xdc, 0x1d, 0xc7, 0x6b, 0x0d, 0xc6, 0x9c, 0x84, 0x43,
-	0x96, 0x6d, 0x7b, 0xd8, 0xf7, 0x05, 0x9d, 0xbc, 0x54, 0x16, 0xf0, 0x92, 0xb2, 0x80, 0xed, 0x58,
-	0x01, 0x61, 0x5a, 0x51, 0xc0, 0x0d, 0x38, 0x54, 0xe3, 0x26, 0x51, 0xc1, 0x74, 0xb4, 0x82, 0xb7,
-	0xfc, 0x7a, 0x7c, 0x98, 0x74, 0x36, 0x6a, 0x30, 0x9b, 0x8e, 0xea, 0x97, 0x7b, 0xf7, 0x03, 0xee,
-	0x17, 0x35, 0xf7, 0x36, 0x18, 0x83, 0x92, 0x88, 0x12, 0x5e, 0x83, 0xc3, 0x82, 0x2a, 0x58, 0xc9,
-	0x2f, 0x0d, 0xab, 0x41, 0x2c, 0x9e, 0x70, 0x8c, 0xf1, 0x4d, 0x28, 0xb0, 0x2c, 0xf7, 0x2c, 0x3f,
-	0xbe, 0xa4, 0xfd, 0xd1, 0x97, 0xf6, 0xdb, 0x30, 0x93, 0x19, 0x4b, 0xe0, 0x96, 0xe0, 0x10, 0x5f,
-	0x10, 0x92, 0x36, 0x7b, 0x81, 0x4b, 0x47, 0xa3, 0x0d, 0x8b, 0x61, 0xd8, 0x87, 0xd8, 0xb5, 0x1d,
-	0xb7, 0x1e, 0x8b, 0x5e, 0xee, 0xad, 0xdb, 0xb6, 0x27, 0x71, 0x23, 0xab, 0x46, 0x1b, 0xbe, 0x6a,
-	0xd4, 0x53, 0x6f, 0xc1, 0x52, 0xae, 0x8c, 0x5f, 0xa0, 0xa8, 0x37, 0x60, 0x82, 0xa5, 0x28, 0x07,
-	0x2d, 0xf1, 0x0e, 0xc6, 0xa3, 0xcf, 0xf6, 0x23, 0x38, 0x93, 0x88, 0x20, 0x70, 0x6e, 0x01, 0xb0,
-	0x46, 0x6b, 0xee, 0x60, 0x2c, 0x89, 0xce, 0x44, 0x89, 0xe4, 0x08, 0xd9, 0xe1, 0xc6, 0xab, 0xd2,
-	0x60, 0x10, 0x58, 0x48, 0x56, 0xce, 0xbc, 0xbf, 0xb4, 0xa9, 0xc6, 0xe9, 0x87, 0xab, 0x4a, 0x28,
-	0x4a, 0xbb, 0x09, 0x63, 0x8c, 0x55, 0x54, 0x75, 0x2e, 0x5a, 0xd5, 0x83, 0x0e, 0xad, 0x13, 0xc7,
-	0xad, 0x6f, 0xef, 0xb2, 0x00, 0xa2, 0x36, 0xee, 0x6f, 0x34, 0xe1, 0x72, 0x32, 0xcd, 0x3d, 0x52,
-	0x77, 0x6a, 0x1b, 0x56, 0xb3, 0xf9, 0xe2, 0x8b, 0xaa, 0xc2, 0x95, 0xa1, 0xd9, 0xc2, 0x8a, 0x0e,
-	0xd4, 0xac, 0x66, 0x53, 0x14, 0x74, 0x5e, 0x55, 0x50, 0x7f, 0x28, 0x2f, 0x89, 0x0d, 0x30, 0xee,
-	0xc2, 0x79, 0x96, 0x23, 0x51, 0x36, 0xde, 0xc3, 0x7b, 0xfb, 0x9e, 0xe8, 0x01, 0x8a, 0x50, 0x82,
-	0xf2, 0x55, 0x38, 0x54, 0xe5, 0xa6, 0xfc, 0x33, 0x2f, 0x47, 0x84, 0x2d, 0x26, 0x55, 0xcf, 0x1e,
-	0x50, 0xdf, 0x15, 0x2d, 0x46, 0x15, 0x4b, 0xb0, 0xbe, 0x02, 0x63, 0xc1, 0x04, 0xf9, 0xa3, 0x4c,
-	0x29, 0x1f, 0x61, 0xfc, 0x54, 0x13, 0xe1, 0xe3, 0x4b, 0x30, 0x47, 0x5b, 0x5f, 0x80, 0x93, 0x35,
-	0xe2, 0x52, 0xcf, 0xaa, 0x51, 0x33, 0xbe, 0x69, 0x9d, 0x90, 0xf6, 0xf5, 0x91, 0x37, 0xaf, 0x27,
-	0x70, 0x21, 0x9b, 0x26, 0xfd, 0x46, 0x68, 0x23, 0xbd, 0x11, 0x9f, 0x68, 0x62, 0x47, 0x66, 0xf7,
-	0xe4, 0xf6, 0xf2, 0x7f, 0xa9, 0x52, 0x57, 0x71, 0x88, 0xfa, 0xbe, 0x9e, 0xda, 0xdf, 0xce, 0x25,
-	0xf6, 0x37, 0xb9, 0xb3, 0x45, 0x4a, 0xec, 0x6f, 0x6f, 0xbf, 0x91, 0x55, 0xf2, 0x27, 0x9e, 0xa8,
-	0xf2, 0x0a, 0x9c, 0x70, 0xdc, 0xae, 0xd5, 0x74, 0x6c, 0x76, 0xbc, 0x34, 0x1d, 0x9b, 0xd5, 0x7b,
-	0xb4, 0x72, 0x3c, 0x6a, 0xbe, 0x6b, 0xa3, 0x65, 0x40, 0x31, 0x47, 0x3e, 0x37, 0xfb, 0xd9, 0xdc,
-	0x9c, 0x8a, 0xde, 0xb9, 0x9f, 0xb9, 0xc9, 0xab, 0x8b, 0x37, 0x45, 0xf1, 0x09, 0x3c, 0x51, 0xfc,
-	0x7a, 0xaa, 0xf8, 0x19, 0x75, 0xf1, 0xc9, 0xf5, 0xdc, 0x9f, 0x80, 0x1d, 0xb1, 0x86, 0x82, 0x56,
-	0xb4, 0xd9, 0xc5, 0x2e, 0x65, 0x84, 0x2f, 0xbe, 0xe5, 0xdd, 0x16, 0x47, 0x22, 0x75, 0x1e, 0x51,
-	0xcf, 0x0c, 0x1c, 0xc1, 0xc1, 0x3d, 0x33, 0xba, 0xb6, 0x00, 0x87, 0xee, 0xc6, 0x3b, 0x30, 0xc9,
-	0xa2, 0x6c, 0x56, 0x36, 0x4a, 0xab, 0xdb, 0xe4, 0x36, 0x76, 0x49, 0xf4, 0x90, 0x88, 0xbd, 0x5a,
-	0x69, 0x55, 0x30, 0xf2, 0x8b, 0x11, 0x08, 0xbf, 0x2b, 0x56, 0x42, 0x3c, 0xb6, 0x20, 0x9b, 0x80,
-	0x31, 0x3b, 0x30, 0xc8, 0xe0, 0xec, 0x02, 0x2d, 0xc1, 0xa9, 0x1a, 0xf1, 0x5b, 0xc4, 0x37, 0x89,
-	0xe7, 0xd4, 0x1d, 0xd7, 0xa2, 0xd8, 0x66, 0xd1, 0x0f, 0x57, 0x4e, 0xf2, 0x1b, 0x0f, 0x42, 0x7b,
-	0xc8, 0xce, 0x02, 0x6f, 0x13, 0x96, 0x26, 0xc2, 0xae, 0x08, 0x3f, 0x3a, 0x7b, 0x3c, 0x76, 0x9f,
-	0x5d, 0x31, 0x31, 0x23, 0xb1, 0x7f, 0x2f, 0xb2, 0x4a, 0x1e, 0x54, 0x7d, 0xec, 0x75, 0xb1, 0xbd,
-	0x49, 0x1b, 0xe5, 0x26, 0xa9, 0x3d, 0x95, 0x35, 0x4c, 0x03, 0x74, 0x7c, 0x6c, 0x76, 0xd7, 0xcc,
-	0xa7, 0xb8, 0xc7, 0x72, 0x1d, 0xae, 0x1c, 0xee, 0xf8, 0xf8, 0xf1, 0xda, 0x9b, 0xb8, 0x37, 0x42,
-	0x2d, 0xaf, 0x44, 0x56, 0x4a, 0x3a, 0x57, 0xbf, 0xa6, 0x6a, 0x60, 0x90, 0xfd, 0x87, 0x5d, 0x64,
-	0x61, 0xc6, 0xfa, 0xf3, 0x97, 0x8c, 0x19, 0xef, 0xbe, 0xca, 0x36, 0x69, 0x7c, 0xae, 0x89, 0xa5,
-	0xb0, 0xde, 0xff, 0xae, 0x8d, 0x76, 0xd6, 0xa6, 0xd3, 0x72, 0xa8, 0x1c, 0xc2, 0x2e, 0xd0, 0x14,
-	0x1c, 0x26, 0x9e, 0x8d, 0x3d, 0xb3, 0xda, 0x93, 0x1f, 0x3b, 0xec, 0xba, 0xdc, 0x43, 0xe7, 0x01,
-	0x6a, 0x4d, 0xcb, 0x69, 0x99, 0xc1, 0x37, 0xb8, 0x68, 0x23, 0xe3, 0xcc, 0xb2, 0xdd, 0x6b, 0x47,
-	0x10, 0x0e, 0x44, 0x3b, 0xf5, 0x59, 0x38, 0xd8, 0xc0, 0x4e, 0xbd, 0x41, 0x27, 0xc7, 0x98, 0x59,
-	0x5c, 0x25, 0x66, 0xe7, 0x60, 0x8e, 0xd9, 0x39, 0x34, 0x70, 0x41, 0xc6, 0x2b, 0x0c, 0xdb, 0xd6,
-	0xd1, 0xc8, 0x17, 0xbd, 0x6c, 0x5d, 0x2f, 0x47, 0x5b, 0x57, 0x64, 0x9c, 0x68, 0x59, 0xb1, 0x21,
-	0x46, 0x05, 0x2e, 0x8a, 0x05, 0xdf, 0xc4, 0x75, 0x8b, 0xe2, 0x37, 0x71, 0xcf, 0x2f, 0x07, 0x9f,
-	0x42, 0x41, 0x9f, 0x25, 0x9e, 0xdc, 0x65, 0x96, 0xe0, 0x54, 0x57, 0xda, 0xcc, 0x78, 0x0f, 0x3b,
-	0xd9, 0x4d, 0x38, 0x1b, 0xdf, 0xd7, 0xc4, 0xb1, 0x7e, 0x70, 0xd0, 0x58, 0xb7, 0xa2, 0x8d, 0x44,
-	0x58, 0xc0, 0xb4, 0x21, 0xb3, 0xaf, 0xc1, 0x04, 0xf1, 0x82, 0x23, 0x0e, 0xf5, 0x62, 0x00, 0xfc,
-	0x01, 0x9e, 0x8e, 0xde, 0x93, 0x0c, 0x6f, 0x88, 0x53, 0x5b, 0x1c, 0x61, 0xb3, 0x1f, 0x73, 0x58,
-	0x52, 0xe3, 0x47, 0x1a, 0xcc, 0x0d, 0x0c, 0x11, 0xf2, 0x8f, 0x32, 0x39, 0x7b, 0xa9, 0xe5, 0x89,
-	0x38, 0x53, 0xc7, 0x41, 0x1e, 0xa4, 0x3d, 0x33, 0x83, 0x6b, 0xd9, 0xc1, 0x3f, 0x86, 0x95, 0x7c,
-	0xc1, 0xf7, 0x56, 0x6e, 0x62, 0x9a, 0xf7, 0xa7, 0xa6, 0xb9, 0x21, 0xbe, 0xae, 0xc4, 0xf1, 0xfd,
-	0x11, 0x76, 0xed, 0x6d, 0xb2, 0x49, 0x1b, 0x68, 0x0e, 0x8e, 0xfb, 0xd8, 0x0d, 0x5e, 0xd5, 0x78,
-	0x8e, 0x63, 0xdc, 0xba, 0x3e, 0xf2, 0xce, 0xf9, 0x77, 0x4d, 0xac, 0x89, 0x64, 0xaa, 0xb0, 0xb2,
-	0xc7, 0x30, 0x41, 0x3d, 0xcb, 0xf5, 0x77, 0xb0, 0xe7, 0x9b, 0x8e, 0x6b, 0xc6, 0x8f, 0xe2, 0x05,
-	0xe5, 0x91, 0x4f, 0xf8, 0x6f, 0xef, 0x8a, 0xd7, 0x0b, 0x85, 0x11, 0xee, 0xba, 0xe2, 0x74, 0x8f,
-	0xde, 0x86, 0xd3, 0x1d, 0x97, 0x07, 0xb3, 0xcd, 0xf0, 0xfe, 0xe4, 0xfe, 0x51, 0xc2, 0x86, 0x01,
-	0xe4, 0x2d, 0xdf, 0x78, 0x0f, 0xce, 0x45, 0xeb, 0xb9, 0x5b, 0xad, 0xad, 0x77, 0x28, 0xb9, 0x43,
-	0xbc, 0x0f, 0x2c, 0xcf, 0xf6, 0x33, 0x1a, 0x60, 0xfe, 0xf9, 0xfa, 0xa1, 0x26, 0x7a, 0x83, 0x3a,
-	0x7e, 0x38, 0x6b, 0xef, 0xc2, 0x54, 0x9b, 0x7b, 0x98, 0x4e, 0xb5, 0x66, 0x5a, 0x1d, 0x4a, 0xcc,
-	0x1d, 0xe1, 0x24, 0xa6, 0x6e, 0x36, 0x26, 0xfa, 0xa9, 0xc2, 0x55, 0xce, 0xb6, 0x95, 0x59, 0x8c,
-	0x45, 0x21, 0x36, 0xde, 0x73, 0x82, 0xf3, 0x0e, 0x07, 0xcc, 0xa8, 0xcd, 0xf8, 0xb6, 0x3c, 0xe4,
-	0x45, 0x7d, 0x23, 0xdf, 0x2b, 0x10, 0x56, 0x2e, 0xc1, 0x26, 0xa2, 0x60, 0x72, 0x88, 0xfc, 0x5a,
-	0x97, 0xf3, 0xe1, 0x1b, 0x05, 0x98, 0x66, 0x81, 0xdf, 0x22, 0xae, 0x43, 0x89, 0x87, 0x6d, 0x76,
-	0x30, 0x10, 0x4b, 0x10, 0xfb, 0xc6, 0x6d, 0xb8, 0x34, 0xe8, 0x7e, 0x88, 0x30, 0x0d, 0xe3, 0x96,
-	0x34, 0x32, 0x82, 0xf1, 0x4a, 0xdf, 0x60, 0x3c, 0x16, 0xcf, 0xb3, 0xec, 0x39, 0x76, 0x1d, 0x97,
-	0xad, 0xa6, 0xe5, 0xd6, 0xf0, 0x23, 0xd7, 0x6a, 0xfb, 0x0d, 0x42, 0xb3, 0x9e, 0xe7, 0x2c, 0x1c,
-	0x75, 0xf1, 0x07, 0xd8, 0xa7, 0xe6, 0x8e, 0xe3, 0xf9, 0x54, 0x9c, 0x3c, 0x8e, 0x70, 0xdb, 0x9d,
-	0xc0, 0x64, 0xec, 0x88, 0xe7, 0xa8, 0x8e, 0x1b, 0xc2, 0xbd, 0x0e, 0xe3, 0xbe, 0x34, 0xaa, 0x9e,
-	0x9b, 0x72, 0x78, 0xa5, 0x3f, 0xc6, 0x68, 0x88, 0x3e, 0xa5, 0x74, 0x2c, 0xf7, 0xfa, 0xa7, 0xd5,
-	0x2f, 0x2c, 0xd9, 0x11, 0xd1, 0xb4, 0x86, 0x66, 0x8a, 0x7e, 0xde, 0x48, 0x50, 0xf1, 0x05, 0x97,
-	0xa3, 0xb6, 0x70, 0x48, 0xe9, 0xbf, 0x73, 0x30, 0xc6, 0x32, 0x22, 0x07, 0x0e, 0x72, 0xd5, 0x1a,
-	0xc5, 0x5e, 0xdc, 0xb4, 0x20, 0xae, 0xcf, 0x64, 0xde, 0xe7, 0x4c, 0x46, 0xe1, 0x07, 0xff, 0xf8,
-	0xcf, 0xcf, 0xf7, 0x4f, 0xa2, 0xb3, 0xc5, 0xbe, 0x44, 0x5f, 0xc5, 0xd4, 0x2a, 0x72, 0x21, 0x1c,
-	0x7d, 0xa2, 0xc1, 0xb1, 0x98, 0x7a, 0x8d, 0xe6, 0x52, 0x21, 0x55, 0x22, 0xb9, 0x7e, 0x79, 0x98,
-	0x9b, 0x00, 0xb8, 0xcc, 0x00, 0x2e, 0xa0, 0x42, 0x12, 0x80, 0x8b, 0x67, 0xc5, 0x1a, 0x1f, 0x85,
-	0x3e, 0x86, 0x63, 0x71, 0x15, 0x3e, 0xcd, 0xa1, 0x52, 0xc5, 0x15, 0x1c, 0x4a, 0x7d, 0x3b, 0x7b,
-	0x22, 0x38, 0x07, 0x9b, 0x88, 0x98, 0xba, 0x9a, 0x09, 0x10, 0xd7, 0xbb, 0x33, 0x01, 0x12, 0xfa,
-	0xf4, 0xf0, 0x89, 0x10, 0x69, 0x7f, 0xab, 0xc1, 0x19, 0xa5, 0x4c, 0x8c, 0x96, 0x07, 0x67, 0x4a,
-	0x68, 0xd6, 0xfa, 0x4a, 0x5e, 0x77, 0x01, 0x38, 0xcf, 0x00, 0x0d, 0x74, 0x21, 0x09, 0x28, 0xbf,
-	0x3f, 0x8b, 0x1f, 0xb2, 0x77, 0xe8, 0x23, 0xf4, 0x2b, 0x0d, 0x50, 0x5a, 0x17, 0x46, 0x8b, 0xa9,
-	0x84, 0x99, 0x42, 0xb4, 0xbe, 0x94, 0xcb, 0x57, 0x90, 0x5d, 0x61, 0x64, 0xb3, 0x68, 0x26, 0x63,
-	0xea, 0x3c, 0x49, 0xf0, 0x67, 0x0d, 0x0a, 0x83, 0x75, 0x5e, 0x74, 0x43, 0x99, 0x78, 0xa8, 0x14,
-	0xad, 0xdf, 0x1c, 0x79, 0x9c, 0x80, 0xbf, 0xc8, 0xe0, 0xcf, 0xa3, 0x73, 0x19, 0xf0, 0x4d, 0xcb,
-	0xa7, 0xe8, 0xaf, 0x1a, 0x9c, 0x1f, 0xa8, 0x9a, 0xa2, 0xeb, 0x83, 0xf2, 0x67, 0xca, 0xba, 0xfa,
-	0x8d, 0x51, 0x87, 0x09, 0xea, 0x5b, 0x8c, 0xfa, 0x2b, 0xa8, 0x94, 0xa4, 0x66, 0x27, 0x04, 0x06,
-	0x6d, 0xca, 0x1d, 0x59, 0x4c, 0xbf, 0x59, 0xed, 0xb1, 0x63, 0x14, 0xfa, 0xa3, 0x06, 0x7a, 0xb6,
-	0x5a, 0x8a, 0x4a, 0x83, 0x90, 0xd4, 0x42, 0xae, 0x7e, 0x6d, 0xa4, 0x31, 0xc3, 0x96, 0x4d, 0x33,
-	0x18, 0x50, 0xfc, 0x50, 0xec, 0x8a, 0x1f, 0xa1, 0xdf, 0x6b, 0x30, 0xa1, 0xd2, 0x3a, 0xd0, 0x55,
-	0x65, 0xda, 0x0c, 0xe9, 0x45, 0x5f, 0xce, 0xe9, 0x2d, 0xf0, 0xae, 0x31, 0xbc, 0x65, 0xb4, 0x94,
-	0xc4, 0x23, 0x9e, 0x55, 0x6b, 0xe2, 0x22, 0x93, 0x52, 0xd8, 0x1b, 0x17, 0x41, 0xf5, 0x61, 0x3c,
-	0x54, 0xfc, 0xd1, 0x85, 0x54, 0xc2, 0xc4, 0x7f, 0x20, 0xf4, 0xd9, 0x01, 0x1e, 0x02, 0x63, 0x96,
-	0x61, 0x9c, 0x43, 0x53, 0xca, 0x27, 0xbd, 0x13, 0xe4, 0xf9, 0x85, 0x06, 0xa7, 0x52, 0x7a, 0x32,
-	0x5a, 0x48, 0xc5, 0xce, 0x92, 0xaf, 0xf5, 0xc5, 0x3c, 0xae, 0xc3, 0xda, 0x10, 0x5f, 0x79, 0x44,
-	0x0c, 0xa4, 0xbb, 0xe8, 0xd7, 0x1a, 0xa0, 0xb4, 0x76, 0x8c, 0xb2, 0x93, 0xa5, 0xc4, 0x6a, 0x45,
-	0x1b, 0xca, 0x16, 0xa3, 0x8d, 0x25, 0x46, 0x36, 0x87, 0x2e, 0x0e, 0x26, 0x63, 0xab, 0x2b, 0x68,
-	0xe3, 0xa7, 0x15, 0x5a, 0x2f, 0x5a, 0x52, 0x3f, 0x11, 0xa5, 0x3e, 0xad, 0x5f, 0xcd, 0xe7, 0x2c,
-	0xf8, 0x56, 0x18, 0xdf, 0x3c, 0xba, 0xac, 0xe6, 0x8b, 0xbc, 0xa6, 0xfc, 0x2c, 0x14, 0x6c, 0x79,
-	0x31, 0xa1, 0x56, 0xb1, 0xe5, 0xa9, 0x04, 0x65, 0xc5, 0x96, 0xa7, 0xd4, 0x7b, 0xb3, 0xb7, 0x3c,
-	0x0e, 0x24, 0xf7, 0x15, 0x06, 0x12, 0x13, 0x4d, 0x15, 0x20, 0x2a, 0xcd, 0x57, 0x01, 0xa2, 0xd4,
-	0x5e, 0xb3, 0x41, 0x78, 0x27, 0x08, 0x41, 0x7e, 0xa9, 0xc1, 0xd1, 0xa8, 0xa4, 0x88, 0x2e, 0xa5,
-	0x12, 0x28, 0xd4, 0x4c, 0x7d, 0x6e, 0x88, 0x97, 0xa0, 0xf8, 0x2a, 0xa3, 0x28, 0xa1, 0xd5, 0xf4,
-	0x06, 0x9b, 0xd0, 0xf6, 0x8a, 0x4c, 0xf6, 0x33, 0x29, 0x31, 0xb9, 0xb8, 0x18, 0x70, 0x45, 0xe5,
-	0x42, 0x05, 0x97, 0x42, 0xa9, 0x54, 0x70, 0xa9, 0x34, 0xc7, 0x51, 0xb8, 0x18, 0x4e, 0xc0, 0xc5,
-	0x75, 0xc9, 0x3f, 0x68, 0xf0, 0xf2, 0x16, 0xa6, 0x2a, 0xf5, 0x2f, 0xa3, 0x77, 0x66, 0x08, 0x92,
-	0x19, 0xbd, 0x33, 0x4b, 0x52, 0x34, 0xae, 0x33, 0xe4, 0x22, 0x5a, 0x4e, 0x22, 0xb3, 0x5f, 0xc6,
-	0x98, 0x6c, 0x7b, 0x22, 0x62, 0xb0, 0x89, 0x69, 0xc3, 0x64, 0x9a, 0x63, 0x06, 0x2f, 0x7f, 0x31,
-	0x87, 0xf2, 0xc6, 0xde, 0xcc, 0xe5, 0x9c, 0xde, 0x7b, 0xe5, 0xe5, 0x6f, 0xe8, 0x4f, 0x34, 0x38,
-	0xb1, 0x85, 0x69, 0x54, 0x98, 0x53, 0x3c, 0x7a, 0x85, 0x32, 0xa9, 0x78, 0xf4, 0x2a, 0x75, 0xcf,
-	0x58, 0x64, 0x5c, 0x97, 0x90, 0xa1, 0xe6, 0x8a, 0xca, 0x78, 0xe8, 0x2f, 0x1a, 0x4c, 0x6d, 0x61,
-	0x1a, 0x11, 0x71, 0x22, 0x7a, 0x1b, 0x2a, 0x2a, 0xd6, 0xda, 0x20, 0x65, 0x4e, 0x71, 0xa0, 0xca,
-	0x27, 0xe5, 0x65, 0x2f, 0x57, 0xce, 0x6c, 0x8b, 0x28, 0xe6, 0x53, 0xdc, 0xf3, 0x83, 0x66, 0x17,
-	0xea, 0x45, 0xe8, 0x77, 0x1a, 0x9c, 0x4e, 0x56, 0xb0, 0x49, 0x1b, 0x8a, 0x9d, 0x2c, 0x4b, 0x8f,
-	0xd3, 0xd7, 0x72, 0xbb, 0x86, 0xbc, 0x25, 0xc6, 0x7b, 0x15, 0x2d, 0xe6, 0xe4, 0xc5, 0xb4, 0x81,
-	0xfe, 0xa6, 0xc1, 0x74, 0x92, 0x34, 0xaa, 0x97, 0x29, 0x0e, 0x51, 0x43, 0xc5, 0x35, 0xfd, 0xd6,
-	0xe8, 0x63, 0xc2, 0x22, 0x5e, 0x65, 0x45, 0x5c, 0x47, 0xd7, 0x72, 0x16, 0x11, 0x95, 0x01, 0x83,
-	0xef, 0x85, 0x60, 0xde, 0x53, 0xf2, 0x5b, 0xfa, 0x74, 0x92, 0x74, 0xd1, 0x17, 0x86, 0xba, 0x84,
-	0x88, 0x6b, 0x0c, 0x71, 0x09, 0x2d, 0xa8, 0x11, 0xe5, 0x69, 0xd5, 0xc7, 0xae, 0xcd, 0x3a, 0x18,
-	0x6d, 0x04, 0x27, 0xd5, 0xa9, 0x3e, 0x58, 0x52, 0xdc, 0xba, 0x92, 0x95, 0x3b, 0xe1, 0xa8, 0x17,
-	0x73, 0x3a, 0x86, 0xa8, 0x37, 0x19, 0xea, 0x1a, 0x2a, 0x0e, 0x46, 0x4d, 0x49, 0x5d, 0xe8, 0xc7,
-	0x1a, 0x9c, 0x0c, 0x1a, 0x58, 0x4c, 0xa8, 0x4a, 0x8b, 0x04, 0xb1, 0xfb, 0xaa, 0x5d, 0x52, 0x25,
-	0x5e, 0x19, 0xcb, 0x8c, 0xea, 0x0a, 0x9a, 0xcb, 0x68, 0x52, 0x8e, 0x4f, 0xcd, 0xbe, 0xba, 0x85,
-	0xfe, 0xa4, 0x81, 0xbe, 0x85, 0x69, 0x86, 0x1e, 0x85, 0xe6, 0x53, 0x59, 0x33, 0x3c, 0xf5, 0xd5,
-	0xbc, 0x9e, 0x79, 0xe7, 0xaf, 0x25, 0x87, 0x9b, 0x94, 0x3c, 0xc5, 0xae, 0x19, 0xca, 0x5f, 0xc1,
-	0x06, 0x10, 0x3c, 0xf0, 0x0c, 0xf5, 0x2b, 0xfd, 0xc0, 0xd5, 0x8e, 0x8a, 0x07, 0x3e, 0x58, 0xf7,
-	0x32, 0x6e, 0x30, 0xe0, 0x55, 0xb4, 0xa2, 0x06, 0xae, 0xb2, 0xd1, 0x66, 0x95, 0x0f, 0x37, 0x43,
-	0xb9, 0x0b, 0xfd, 0x4b, 0x83, 0x4b, 0x59, 0xbc, 0x31, 0xb5, 0xab, 0x94, 0x8f, 0x28, 0x3a, 0x46,
-	0xd1, 0x0f, 0x72, 0x6b, 0x5d, 0xc6, 0x6d, 0x56, 0xd0, 0x6b, 0xe8, 0x6b, 0x23, 0x15, 0xc4, 0xda,
-	0x5b, 0xff, 0x9f, 0xc6, 0xe5, 0xef, 0x7c, 0xfa, 0xac, 0xa0, 0x7d, 0xf6, 0xac, 0xa0, 0x7d, 0xfe,
-	0xac, 0xa0, 0xfd, 0xec, 0x79, 0x61, 0xdf, 0x67, 0xcf, 0x0b, 0xfb, 0xfe, 0xf9, 0xbc, 0xb0, 0xef,
-	0x9d, 0xd7, 0xeb, 0x0e, 0x6d, 0x74, 0xaa, 0x2b, 0x35, 0xd2, 0x2a, 0x6e, 0xf1, 0x0c, 0xcb, 0x9c,
-	0x2e, 0x79, 0xd9, 0x22, 0x76, 0xa7, 0x89, 0x8b, 0xbb, 0x21, 0x08, 0xfb, 0x79, 0x6b, 0xf5, 0x20,
-	0xfb, 0x1d, 0xe9, 0xb5, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0x76, 0x8f, 0x82, 0x3f, 0x37, 0x2b,
-	0x00, 0x00,
+func (m *QueryRateLimitsRequest) Reset()         { *m = QueryRateLimitsRequest{} }
+func (m *QueryRateLimitsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRateLimitsRequest) ProtoMessage()    {}
+func (*QueryRateLimitsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{60}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Deployments queries deployments
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	CurrentValset(ctx context.Context, in *QueryCurrentValsetRequest, opts ...grpc.CallOption) (*QueryCurrentValsetResponse, error)
-	ValsetRequest(ctx context.Context, in *QueryValsetRequestRequest, opts ...grpc.CallOption) (*QueryValsetRequestResponse, error)
-	ValsetConfirm(ctx context.Context, in *QueryValsetConfirmRequest, opts ...grpc.CallOption) (*QueryValsetConfirmResponse, error)
-	ValsetConfirmsByNonce(ctx context.Context, in *QueryValsetConfirmsByNonceRequest, opts ...grpc.CallOption) (*QueryValsetConfirmsByNonceResponse, error)
-	LastValsetRequests(ctx context.Context, in *QueryLastValsetRequestsRequest, opts ...grpc.CallOption) (*QueryLastValsetRequestsResponse, error)
-	LastPendingValsetRequestByAddr(ctx context.Context, in *QueryLastPendingValsetRequestByAddrRequest, opts ...grpc.CallOption) (*QueryLastPendingValsetRequestByAddrResponse, error)
-	LastPendingBatchRequestByAddr(ctx context.Context, in *QueryLastPendingBatchRequestByAddrRequest, opts ...grpc.CallOption) (*QueryLastPendingBatchRequestByAddrResponse, error)
-	LastPendingLogicCallByAddr(ctx context.Context, in *QueryLastPendingLogicCallByAddrRequest, opts ...grpc.CallOption) (*QueryLastPendingLogicCallByAddrResponse, error)
-	LastEventNonceByAddr(ctx context.Context, in *QueryLastEventNonceByAddrRequest, opts ...grpc.CallOption) (*QueryLastEventNonceByAddrResponse, error)
-	BatchFees(ctx context.Context, in *QueryBatchFeeRequest, opts ...grpc.CallOption) (*QueryBatchFeeResponse, error)
-	OutgoingTxBatches(ctx context.Context, in *QueryOutgoingTxBatchesRequest, opts ...grpc.CallOption) (*QueryOutgoingTxBatchesResponse, error)
-	OutgoingLogicCalls(ctx context.Context, in *QueryOutgoingLogicCallsRequest, opts ...grpc.CallOption) (*QueryOutgoingLogicCallsResponse, error)
-	BatchRequestByNonce(ctx context.Context, in *QueryBatchRequestByNonceRequest, opts ...grpc.CallOption) (*QueryBatchRequestByNonceResponse, error)
-	BatchConfirms(ctx context.Context, in *QueryBatchConfirmsRequest, opts ...grpc.CallOption) (*QueryBatchConfirmsResponse, error)
-	LogicConfirms(ctx context.Context, in *QueryLogicConfirmsRequest, opts ...grpc.CallOption) (*QueryLogicConfirmsResponse, error)
-	ERC20ToDenom(ctx context.Context, in *QueryERC20ToDenomRequest, opts ...grpc.CallOption) (*QueryERC20ToDenomResponse, error)
-	DenomToERC20(ctx context.Context, in *QueryDenomToERC20Request, opts ...grpc.CallOption) (*QueryDenomToERC20Response, error)
-	GetLastObservedEthBlock(ctx context.Context, in *QueryLastObservedEthBlockRequest, opts ...grpc.CallOption) (*QueryLastObservedEthBlockResponse, error)
-	GetLastObservedEthNonce(ctx context.Context, in *QueryLastObservedEthNonceRequest, opts ...grpc.CallOption) (*QueryLastObservedEthNonceResponse, error)
-	GetAttestations(ctx context.Context, in *QueryAttestationsRequest, opts ...grpc.CallOption) (*QueryAttestationsResponse, error)
-	GetDelegateKeyByValidator(ctx context.Context, in *QueryDelegateKeysByValidatorAddress, opts ...grpc.CallOption) (*QueryDelegateKeysByValidatorAddressResponse, error)
-	GetDelegateKeyByEth(ctx context.Context, in *QueryDelegateKeysByEthAddress, opts ...grpc.CallOption) (*QueryDelegateKeysByEthAddressResponse, error)
-	GetDelegateKeyByOrchestrator(ctx context.Context, in *QueryDelegateKeysByOrchestratorAddress, opts ...grpc.CallOption) (*QueryDelegateKeysByOrchestratorAddressResponse, error)
-	GetPendingSendToEth(ctx context.Context, in *QueryPendingSendToEth, opts ...grpc.CallOption) (*QueryPendingSendToEthResponse, error)
-	GetPendingIbcAutoForwards(ctx context.Context, in *QueryPendingIbcAutoForwards, opts ...grpc.CallOption) (*QueryPendingIbcAutoForwardsResponse, error)
-	GetListEvmChains(ctx context.Context, in *QueryListEvmChains, opts ...grpc.CallOption) (*QueryListEvmChainsResponse, error)
-	GetMonitoredERC20Addresses(ctx context.Context, in *QueryMonitoredERC20Addresses, opts ...grpc.CallOption) (*QueryMonitoredERC20AddressesResponse, error)
-	GetBridgeBalanceSnapshots(ctx context.Context, in *QueryBridgeBalanceSnapshots, opts ...grpc.CallOption) (*QueryBridgeBalanceSnapshotsResponse, error)
-	GetBridgeBalanceSnapshotByEventNonce(ctx context.Context, in *QueryBridgeBalanceSnapshotByEventNonce, opts ...grpc.CallOption) (*QueryBridgeBalanceSnapshotByEventNonceResponse, error)
+func (m *QueryRateLimitsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryRateLimitsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRateLimitsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryRateLimitsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRateLimitsRequest.Merge(m, src)
+}
+func (m *QueryRateLimitsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRateLimitsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRateLimitsRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRateLimitsRequest proto.InternalMessageInfo
+
+func (m *QueryRateLimitsRequest) GetEvmChainPrefix() string {
+	if m != nil {
+		return m.EvmChainPrefix
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) CurrentValset(ctx context.Context, in *QueryCurrentValsetRequest, opts ...grpc.CallOption) (*QueryCurrentValsetResponse, error) {
-	out := new(QueryCurrentValsetResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Query/CurrentValset", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryRateLimitsRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) ValsetRequest(ctx context.Context, in *QueryValsetRequestRequest, opts ...grpc.CallOption) (*QueryValsetRequestResponse, error) {
-	out := new(QueryValsetRequestResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Query/ValsetRequest", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// RateLimitStatus reports the usage of a RateLimit within its current window,
+// the remaining capacity of an unlimited direction is always zero
+type RateLimitStatus struct {
+	RateLimit         RateLimit                              `protobuf:"bytes,1,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit"`
+	WindowStart       uint64                                 `protobuf:"varint,2,opt,name=window_start,json=windowStart,proto3" json:"window_start,omitempty"`
+	WindowEnd         uint64                                 `protobuf:"varint,3,opt,name=window_end,json=windowEnd,proto3" json:"window_end,omitempty"`
+	OutboundUsed      github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=outbound_used,json=outboundUsed,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"outbound_used"`
+	InboundUsed       github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=inbound_used,json=inboundUsed,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"inbound_used"`
+	RemainingOutbound github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=remaining_outbound,json=remainingOutbound,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"remaining_outbound"`
+	RemainingInbound  github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=remaining_inbound,json=remainingInbound,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"remaining_inbound"`
 }
 
-func (c *queryClient) ValsetConfirm(ctx context.Context, in *QueryValsetConfirmRequest, opts ...grpc.CallOption) (*QueryValsetConfirmResponse, error) {
-	out := new(QueryValsetConfirmResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Query/ValsetConfirm", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RateLimitStatus) Reset()         { *m = RateLimitStatus{} }
+func (m *RateLimitStatus) String() string { return proto.CompactTextString(m) }
+func (*RateLimitStatus) ProtoMessage()    {}
+func (*RateLimitStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{61}
+}
+func (m *RateLimitStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimitStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimitStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *RateLimitStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitStatus.Merge(m, src)
+}
+func (m *RateLimitStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimitStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitStatus.DiscardUnknown(m)
 }
 
-func (c *queryClient) ValsetConfirmsByNonce(ctx context.Context, in *QueryValsetConfirmsByNonceRequest, opts ...grpc.CallOption) (*QueryValsetConfirmsByNonceResponse, error) {
-	out := new(QueryValsetConfirmsByNonceResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Query/ValsetConfirmsByNonce", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RateLimitStatus proto.InternalMessageInfo
+
+func (m *RateLimitStatus) GetRateLimit() RateLimit {
+	if m != nil {
+		return m.RateLimit
 	}
-	return out, nil
+	return RateLimit{}
 }
 
-func (c *queryClient) LastValsetRequests(ctx context.Context, in *QueryLastValsetRequestsRequest, opts ...grpc.CallOption) (*QueryLastValsetRequestsResponse, error) {
-	out := new(QueryLastValsetRequestsResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Query/LastValsetRequests", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RateLimitStatus) GetWindowStart() uint64 {
+	if m != nil {
+		return m.WindowStart
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) LastPendingValsetRequestByAddr(ctx context.Context, in *QueryLastPendingValsetRequestByAddrRequest, opts ...grpc.CallOption) (*QueryLastPendingValsetRequestByAddrResponse, error) {
-	out := new(QueryLastPendingValsetRequestByAddrResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Query/LastPendingValsetRequestByAddr", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RateLimitStatus) GetWindowEnd() uint64 {
+	if m != nil {
+		return m.WindowEnd
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) LastPendingBatchRequestByAddr(ctx context.Context, in *QueryLastPendingBatchRequestByAddrRequest, opts ...grpc.CallOption) (*QueryLastPendingBatchRequestByAddrResponse, error) {
-	out := new(QueryLastPendingBatchRequestByAddrResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Query/LastPendingBatchRequestByAddr", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryRateLimitsResponse struct {
+	RateLimits []RateLimitStatus `protobuf:"bytes,1,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits"`
 }
 
-func (c *queryClient) LastPendingLogicCallByAddr(ctx context.Context, in *QueryLastPendingLogicCallByAddrRequest, opts ...grpc.CallOption) (*QueryLastPendingLogicCallByAddrResponse, error) {
-	out := new(QueryLastPendingLogicCallByAddrResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Query/LastPendingLogicCallByAddr", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryRateLimitsResponse) Reset()         { *m = QueryRateLimitsResponse{} }
+func (m *QueryRateLimitsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRateLimitsResponse) ProtoMessage()    {}
+func (*QueryRateLimitsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{62}
+}
+func (m *QueryRateLimitsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRateLimitsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRateLimitsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryRateLimitsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRateLimitsResponse.Merge(m, src)
+}
+func (m *QueryRateLimitsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRateLimitsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRateLimitsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) LastEventNonceByAddr(ctx context.Context, in *QueryLastEventNonceByAddrRequest, opts ...grpc.CallOption) (*QueryLastEventNonceByAddrResponse, error) {
-	out := new(QueryLastEventNonceByAddrResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Query/LastEventNonceByAddr", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRateLimitsResponse proto.InternalMessageInfo
+
+func (m *QueryRateLimitsResponse) GetRateLimits() []RateLimitStatus {
+	if m != nil {
+		return m.RateLimits
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) BatchFees(ctx context.Context, in *QueryBatchFeeRequest, opts ...grpc.CallOption) (*QueryBatchFeeResponse, error) {
-	out := new(QueryBatchFeeResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Query/BatchFees", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryHeldSendToCosmosRequest struct {
+	EvmChainPrefix string `protobuf:"bytes,1,opt,name=evm_chain_prefix,json=evmChainPrefix,proto3" json:"evm_chain_prefix,omitempty"`
 }
 
-func (c *queryClient) OutgoingTxBatches(ctx context.Context, in *QueryOutgoingTxBatchesRequest, opts ...grpc.CallOption) (*QueryOutgoingTxBatchesResponse, error) {
-	out := new(QueryOutgoingTxBatchesResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Query/OutgoingTxBatches", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryHeldSendToCosmosRequest) Reset()         { *m = QueryHeldSendToCosmosRequest{} }
+func (m *QueryHeldSendToCosmosRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHeldSendToCosmosRequest) ProtoMessage()    {}
+func (*QueryHeldSendToCosmosRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{63}
+}
+func (m *QueryHeldSendToCosmosRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHeldSendToCosmosRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHeldSendToCosmosRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryHeldSendToCosmosRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHeldSendToCosmosRequest.Merge(m, src)
+}
+func (m *QueryHeldSendToCosmosRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHeldSendToCosmosRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHeldSendToCosmosRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) OutgoingLogicCalls(ctx context.Context, in *QueryOutgoingLogicCallsRequest, opts ...grpc.CallOption) (*QueryOutgoingLogicCallsResponse, error) {
-	out := new(QueryOutgoingLogicCallsResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Query/OutgoingLogicCalls", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryHeldSendToCosmosRequest proto.InternalMessageInfo
+
+func (m *QueryHeldSendToCosmosRequest) GetEvmChainPrefix() string {
+	if m != nil {
+		return m.EvmChainPrefix
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) BatchRequestByNonce(ctx context.Context, in *QueryBatchRequestByNonceRequest, opts ...grpc.CallOption) (*QueryBatchRequestByNonceResponse, error) {
-	out := new(QueryBatchRequestByNonceResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Query/BatchRequestByNonce", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryHeldSendToCosmosResponse struct {
+	Held []HeldSendToCosmos `protobuf:"bytes,1,rep,name=held,proto3" json:"held"`
 }
 
-func (c *queryClient) BatchConfirms(ctx context.Context, in *QueryBatchConfirmsRequest, opts ...grpc.CallOption) (*QueryBatchConfirmsResponse, error) {
-	out := new(QueryBatchConfirmsResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Query/BatchConfirms", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryHeldSendToCosmosResponse) Reset()         { *m = QueryHeldSendToCosmosResponse{} }
+func (m *QueryHeldSendToCosmosResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHeldSendToCosmosResponse) ProtoMessage()    {}
+func (*QueryHeldSendToCosmosResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{64}
+}
+func (m *QueryHeldSendToCosmosResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHeldSendToCosmosResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHeldSendToCosmosResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryHeldSendToCosmosResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHeldSendToCosmosResponse.Merge(m, src)
+}
+func (m *QueryHeldSendToCosmosResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHeldSendToCosmosResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHeldSendToCosmosResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) LogicConfirms(ctx context.Context, in *QueryLogicConfirmsRequest, opts ...grpc.CallOption) (*QueryLogicConfirmsResponse, error) {
-	out := new(QueryLogicConfirmsResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Query/LogicConfirms", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryHeldSendToCosmosResponse proto.InternalMessageInfo
+
+func (m *QueryHeldSendToCosmosResponse) GetHeld() []HeldSendToCosmos {
+	if m != nil {
+		return m.Held
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) ERC20ToDenom(ctx context.Context, in *QueryERC20ToDenomRequest, opts ...grpc.CallOption) (*QueryERC20ToDenomResponse, error) {
-	out := new(QueryERC20ToDenomResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Query/ERC20ToDenom", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// Query params for GetIbcBridgeFees, an empty denom returns the default bridge
+// fees of every denom on the evm chain
+type QueryIbcBridgeFeesRequest struct {
+	EvmChainPrefix string `protobuf:"bytes,1,opt,name=evm_chain_prefix,json=evmChainPrefix,proto3" json:"evm_chain_prefix,omitempty"`
+	Denom          string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (c *queryClient) DenomToERC20(ctx context.Context, in *QueryDenomToERC20Request, opts ...grpc.CallOption) (*QueryDenomToERC20Response, error) {
-	out := new(QueryDenomToERC20Response)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Query/DenomToERC20", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryIbcBridgeFeesRequest) Reset()         { *m = QueryIbcBridgeFeesRequest{} }
+func (m *QueryIbcBridgeFeesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIbcBridgeFeesRequest) ProtoMessage()    {}
+func (*QueryIbcBridgeFeesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{65}
+}
+func (m *QueryIbcBridgeFeesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIbcBridgeFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIbcBridgeFeesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryIbcBridgeFeesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIbcBridgeFeesRequest.Merge(m, src)
+}
+func (m *QueryIbcBridgeFeesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIbcBridgeFeesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIbcBridgeFeesRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetLastObservedEthBlock(ctx context.Context, in *QueryLastObservedEthBlockRequest, opts ...grpc.CallOption) (*QueryLastObservedEthBlockResponse, error) {
-	out := new(QueryLastObservedEthBlockResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Query/GetLastObservedEthBlock", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryIbcBridgeFeesRequest proto.InternalMessageInfo
+
+func (m *QueryIbcBridgeFeesRequest) GetEvmChainPrefix() string {
+	if m != nil {
+		return m.EvmChainPrefix
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) GetLastObservedEthNonce(ctx context.Context, in *QueryLastObservedEthNonceRequest, opts ...grpc.CallOption) (*QueryLastObservedEthNonceResponse, error) {
-	out := new(QueryLastObservedEthNonceResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Query/GetLastObservedEthNonce", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryIbcBridgeFeesRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) GetAttestations(ctx context.Context, in *QueryAttestationsRequest, opts ...grpc.CallOption) (*QueryAttestationsResponse, error) {
-	out := new(QueryAttestationsResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Query/GetAttestations", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryIbcBridgeFeesResponse struct {
+	BridgeFees []IbcBridgeFee `protobuf:"bytes,1,rep,name=bridge_fees,json=bridgeFees,proto3" json:"bridge_fees"`
 }
 
-func (c *queryClient) GetDelegateKeyByValidator(ctx context.Context, in *QueryDelegateKeysByValidatorAddress, opts ...grpc.CallOption) (*QueryDelegateKeysByValidatorAddressResponse, error) {
-	out := new(QueryDelegateKeysByValidatorAddressResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Query/GetDelegateKeyByValidator", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryIbcBridgeFeesResponse) Reset()         { *m = QueryIbcBridgeFeesResponse{} }
+func (m *QueryIbcBridgeFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIbcBridgeFeesResponse) ProtoMessage()    {}
+func (*QueryIbcBridgeFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{66}
+}
+func (m *QueryIbcBridgeFeesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIbcBridgeFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIbcBridgeFeesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryIbcBridgeFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIbcBridgeFeesResponse.Merge(m, src)
+}
+func (m *QueryIbcBridgeFeesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIbcBridgeFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIbcBridgeFeesResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetDelegateKeyByEth(ctx context.Context, in *QueryDelegateKeysByEthAddress, opts ...grpc.CallOption) (*QueryDelegateKeysByEthAddressResponse, error) {
-	out := new(QueryDelegateKeysByEthAddressResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Query/GetDelegateKeyByEth", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryIbcBridgeFeesResponse proto.InternalMessageInfo
+
+func (m *QueryIbcBridgeFeesResponse) GetBridgeFees() []IbcBridgeFee {
+	if m != nil {
+		return m.BridgeFees
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) GetDelegateKeyByOrchestrator(ctx context.Context, in *QueryDelegateKeysByOrchestratorAddress, opts ...grpc.CallOption) (*QueryDelegateKeysByOrchestratorAddressResponse, error) {
-	out := new(QueryDelegateKeysByOrchestratorAddressResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Query/GetDelegateKeyByOrchestrator", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// Query params for GetIbcAutoForwardLogs, a non zero event nonce returns only
+// the log of that forward, otherwise the most recent logs are returned newest
+// first, up to an optional limit
+type QueryIbcAutoForwardLogsRequest struct {
+	EvmChainPrefix string `protobuf:"bytes,1,opt,name=evm_chain_prefix,json=evmChainPrefix,proto3" json:"evm_chain_prefix,omitempty"`
+	EventNonce     uint64 `protobuf:"varint,2,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
+	Limit          uint64 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-func (c *queryClient) GetPendingSendToEth(ctx context.Context, in *QueryPendingSendToEth, opts ...grpc.CallOption) (*QueryPendingSendToEthResponse, error) {
-	out := new(QueryPendingSendToEthResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Query/GetPendingSendToEth", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryIbcAutoForwardLogsRequest) Reset()         { *m = QueryIbcAutoForwardLogsRequest{} }
+func (m *QueryIbcAutoForwardLogsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIbcAutoForwardLogsRequest) ProtoMessage()    {}
+func (*QueryIbcAutoForwardLogsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{67}
+}
+func (m *QueryIbcAutoForwardLogsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIbcAutoForwardLogsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIbcAutoForwardLogsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryIbcAutoForwardLogsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIbcAutoForwardLogsRequest.Merge(m, src)
+}
+func (m *QueryIbcAutoForwardLogsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIbcAutoForwardLogsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIbcAutoForwardLogsRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetPendingIbcAutoForwards(ctx context.Context, in *QueryPendingIbcAutoForwards, opts ...grpc.CallOption) (*QueryPendingIbcAutoForwardsResponse, error) {
-	out := new(QueryPendingIbcAutoForwardsResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Query/GetPendingIbcAutoForwards", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryIbcAutoForwardLogsRequest proto.InternalMessageInfo
+
+func (m *QueryIbcAutoForwardLogsRequest) GetEvmChainPrefix() string {
+	if m != nil {
+		return m.EvmChainPrefix
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) GetListEvmChains(ctx context.Context, in *QueryListEvmChains, opts ...grpc.CallOption) (*QueryListEvmChainsResponse, error) {
-	out := new(QueryListEvmChainsResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Query/GetListEvmChains", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryIbcAutoForwardLogsRequest) GetEventNonce() uint64 {
+	if m != nil {
+		return m.EventNonce
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) GetMonitoredERC20Addresses(ctx context.Context, in *QueryMonitoredERC20Addresses, opts ...grpc.CallOption) (*QueryMonitoredERC20AddressesResponse, error) {
-	out := new(QueryMonitoredERC20AddressesResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Query/GetMonitoredERC20Addresses", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryIbcAutoForwardLogsRequest) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) GetBridgeBalanceSnapshots(ctx context.Context, in *QueryBridgeBalanceSnapshots, opts ...grpc.CallOption) (*QueryBridgeBalanceSnapshotsResponse, error) {
-	out := new(QueryBridgeBalanceSnapshotsResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Query/GetBridgeBalanceSnapshots", in, out, opts...)
-	if err != nil {
-		return nil, err
+type QueryIbcAutoForwardLogsResponse struct {
+	Logs []IbcAutoForwardLog `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs"`
+}
+
+func (m *QueryIbcAutoForwardLogsResponse) Reset()         { *m = QueryIbcAutoForwardLogsResponse{} }
+func (m *QueryIbcAutoForwardLogsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIbcAutoForwardLogsResponse) ProtoMessage()    {}
+func (*QueryIbcAutoForwardLogsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{68}
+}
+func (m *QueryIbcAutoForwardLogsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIbcAutoForwardLogsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIbcAutoForwardLogsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryIbcAutoForwardLogsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIbcAutoForwardLogsResponse.Merge(m, src)
+}
+func (m *QueryIbcAutoForwardLogsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIbcAutoForwardLogsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIbcAutoForwardLogsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetBridgeBalanceSnapshotByEventNonce(ctx context.Context, in *QueryBridgeBalanceSnapshotByEventNonce, opts ...grpc.CallOption) (*QueryBridgeBalanceSnapshotByEventNonceResponse, error) {
-	out := new(QueryBridgeBalanceSnapshotByEventNonceResponse)
-	err := c.cc.Invoke(ctx, "/gravity.v1.Query/GetBridgeBalanceSnapshotByEventNonce", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryIbcAutoForwardLogsResponse proto.InternalMessageInfo
+
+func (m *QueryIbcAutoForwardLogsResponse) GetLogs() []IbcAutoForwardLog {
+	if m != nil {
+		return m.Logs
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Deployments queries deployments
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	CurrentValset(context.Context, *QueryCurrentValsetRequest) (*QueryCurrentValsetResponse, error)
-	ValsetRequest(context.Context, *QueryValsetRequestRequest) (*QueryValsetRequestResponse, error)
-	ValsetConfirm(context.Context, *QueryValsetConfirmRequest) (*QueryValsetConfirmResponse, error)
-	ValsetConfirmsByNonce(context.Context, *QueryValsetConfirmsByNonceRequest) (*QueryValsetConfirmsByNonceResponse, error)
-	LastValsetRequests(context.Context, *QueryLastValsetRequestsRequest) (*QueryLastValsetRequestsResponse, error)
-	LastPendingValsetRequestByAddr(context.Context, *QueryLastPendingValsetRequestByAddrRequest) (*QueryLastPendingValsetRequestByAddrResponse, error)
-	LastPendingBatchRequestByAddr(context.Context, *QueryLastPendingBatchRequestByAddrRequest) (*QueryLastPendingBatchRequestByAddrResponse, error)
-	LastPendingLogicCallByAddr(context.Context, *QueryLastPendingLogicCallByAddrRequest) (*QueryLastPendingLogicCallByAddrResponse, error)
-	LastEventNonceByAddr(context.Context, *QueryLastEventNonceByAddrRequest) (*QueryLastEventNonceByAddrResponse, error)
-	BatchFees(context.Context, *QueryBatchFeeRequest) (*QueryBatchFeeResponse, error)
-	OutgoingTxBatches(context.Context, *QueryOutgoingTxBatchesRequest) (*QueryOutgoingTxBatchesResponse, error)
-	OutgoingLogicCalls(context.Context, *QueryOutgoingLogicCallsRequest) (*QueryOutgoingLogicCallsResponse, error)
-	BatchRequestByNonce(context.Context, *QueryBatchRequestByNonceRequest) (*QueryBatchRequestByNonceResponse, error)
-	BatchConfirms(context.Context, *QueryBatchConfirmsRequest) (*QueryBatchConfirmsResponse, error)
-	LogicConfirms(context.Context, *QueryLogicConfirmsRequest) (*QueryLogicConfirmsResponse, error)
-	ERC20ToDenom(context.Context, *QueryERC20ToDenomRequest) (*QueryERC20ToDenomResponse, error)
-	DenomToERC20(context.Context, *QueryDenomToERC20Request) (*QueryDenomToERC20Response, error)
-	GetLastObservedEthBlock(context.Context, *QueryLastObservedEthBlockRequest) (*QueryLastObservedEthBlockResponse, error)
-	GetLastObservedEthNonce(context.Context, *QueryLastObservedEthNonceRequest) (*QueryLastObservedEthNonceResponse, error)
-	GetAttestations(context.Context, *QueryAttestationsRequest) (*QueryAttestationsResponse, error)
-	GetDelegateKeyByValidator(context.Context, *QueryDelegateKeysByValidatorAddress) (*QueryDelegateKeysByValidatorAddressResponse, error)
-	GetDelegateKeyByEth(context.Context, *QueryDelegateKeysByEthAddress) (*QueryDelegateKeysByEthAddressResponse, error)
-	GetDelegateKeyByOrchestrator(context.Context, *QueryDelegateKeysByOrchestratorAddress) (*QueryDelegateKeysByOrchestratorAddressResponse, error)
-	GetPendingSendToEth(context.Context, *QueryPendingSendToEth) (*QueryPendingSendToEthResponse, error)
-	GetPendingIbcAutoForwards(context.Context, *QueryPendingIbcAutoForwards) (*QueryPendingIbcAutoForwardsResponse, error)
-	GetListEvmChains(context.Context, *QueryListEvmChains) (*QueryListEvmChainsResponse, error)
-	GetMonitoredERC20Addresses(context.Context, *QueryMonitoredERC20Addresses) (*QueryMonitoredERC20AddressesResponse, error)
-	GetBridgeBalanceSnapshots(context.Context, *QueryBridgeBalanceSnapshots) (*QueryBridgeBalanceSnapshotsResponse, error)
-	GetBridgeBalanceSnapshotByEventNonce(context.Context, *QueryBridgeBalanceSnapshotByEventNonce) (*QueryBridgeBalanceSnapshotByEventNonceResponse, error)
+// Query params for GetIbcAutoForwardTransfer, looking up the ibc transfer
+// sent for the deposit with the given event nonce
+type QueryIbcAutoForwardTransferRequest struct {
+	EvmChainPrefix string `protobuf:"bytes,1,opt,name=evm_chain_prefix,json=evmChainPrefix,proto3" json:"evm_chain_prefix,omitempty"`
+	EventNonce     uint64 `protobuf:"varint,2,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryIbcAutoForwardTransferRequest) Reset()         { *m = QueryIbcAutoForwardTransferRequest{} }
+func (m *QueryIbcAutoForwardTransferRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIbcAutoForwardTransferRequest) ProtoMessage()    {}
+func (*QueryIbcAutoForwardTransferRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{69}
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryIbcAutoForwardTransferRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) CurrentValset(ctx context.Context, req *QueryCurrentValsetRequest) (*QueryCurrentValsetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CurrentValset not implemented")
+func (m *QueryIbcAutoForwardTransferRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIbcAutoForwardTransferRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) ValsetRequest(ctx context.Context, req *QueryValsetRequestRequest) (*QueryValsetRequestResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ValsetRequest not implemented")
+func (m *QueryIbcAutoForwardTransferRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIbcAutoForwardTransferRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) ValsetConfirm(ctx context.Context, req *QueryValsetConfirmRequest) (*QueryValsetConfirmResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ValsetConfirm not implemented")
+func (m *QueryIbcAutoForwardTransferRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) ValsetConfirmsByNonce(ctx context.Context, req *QueryValsetConfirmsByNonceRequest) (*QueryValsetConfirmsByNonceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ValsetConfirmsByNonce not implemented")
+func (m *QueryIbcAutoForwardTransferRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIbcAutoForwardTransferRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) LastValsetRequests(ctx context.Context, req *QueryLastValsetRequestsRequest) (*QueryLastValsetRequestsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LastValsetRequests not implemented")
+
+var xxx_messageInfo_QueryIbcAutoForwardTransferRequest proto.InternalMessageInfo
+
+func (m *QueryIbcAutoForwardTransferRequest) GetEvmChainPrefix() string {
+	if m != nil {
+		return m.EvmChainPrefix
+	}
+	return ""
 }
-func (*UnimplementedQueryServer) LastPendingValsetRequestByAddr(ctx context.Context, req *QueryLastPendingValsetRequestByAddrRequest) (*QueryLastPendingValsetRequestByAddrResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LastPendingValsetRequestByAddr not implemented")
+
+func (m *QueryIbcAutoForwardTransferRequest) GetEventNonce() uint64 {
+	if m != nil {
+		return m.EventNonce
+	}
+	return 0
 }
-func (*UnimplementedQueryServer) LastPendingBatchRequestByAddr(ctx context.Context, req *QueryLastPendingBatchRequestByAddrRequest) (*QueryLastPendingBatchRequestByAddrResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LastPendingBatchRequestByAddr not implemented")
+
+type QueryIbcAutoForwardTransferResponse struct {
+	Transfer IbcAutoForwardTransfer `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer"`
 }
-func (*UnimplementedQueryServer) LastPendingLogicCallByAddr(ctx context.Context, req *QueryLastPendingLogicCallByAddrRequest) (*QueryLastPendingLogicCallByAddrResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LastPendingLogicCallByAddr not implemented")
+
+func (m *QueryIbcAutoForwardTransferResponse) Reset()         { *m = QueryIbcAutoForwardTransferResponse{} }
+func (m *QueryIbcAutoForwardTransferResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIbcAutoForwardTransferResponse) ProtoMessage()    {}
+func (*QueryIbcAutoForwardTransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{70}
 }
-func (*UnimplementedQueryServer) LastEventNonceByAddr(ctx context.Context, req *QueryLastEventNonceByAddrRequest) (*QueryLastEventNonceByAddrResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LastEventNonceByAddr not implemented")
+func (m *QueryIbcAutoForwardTransferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) BatchFees(ctx context.Context, req *QueryBatchFeeRequest) (*QueryBatchFeeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BatchFees not implemented")
+func (m *QueryIbcAutoForwardTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIbcAutoForwardTransferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) OutgoingTxBatches(ctx context.Context, req *QueryOutgoingTxBatchesRequest) (*QueryOutgoingTxBatchesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OutgoingTxBatches not implemented")
+func (m *QueryIbcAutoForwardTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIbcAutoForwardTransferResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) OutgoingLogicCalls(ctx context.Context, req *QueryOutgoingLogicCallsRequest) (*QueryOutgoingLogicCallsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OutgoingLogicCalls not implemented")
+func (m *QueryIbcAutoForwardTransferResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) BatchRequestByNonce(ctx context.Context, req *QueryBatchRequestByNonceRequest) (*QueryBatchRequestByNonceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BatchRequestByNonce not implemented")
+func (m *QueryIbcAutoForwardTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIbcAutoForwardTransferResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) BatchConfirms(ctx context.Context, req *QueryBatchConfirmsRequest) (*QueryBatchConfirmsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BatchConfirms not implemented")
+
+var xxx_messageInfo_QueryIbcAutoForwardTransferResponse proto.InternalMessageInfo
+
+func (m *QueryIbcAutoForwardTransferResponse) GetTransfer() IbcAutoForwardTransfer {
+	if m != nil {
+		return m.Transfer
+	}
+	return IbcAutoForwardTransfer{}
 }
-func (*UnimplementedQueryServer) LogicConfirms(ctx context.Context, req *QueryLogicConfirmsRequest) (*QueryLogicConfirmsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LogicConfirms not implemented")
+
+// Query params for GetFailedAttestations, returning the quarantined
+// attestations of the evm chain in order of event nonce
+type QueryFailedAttestationsRequest struct {
+	EvmChainPrefix string `protobuf:"bytes,1,opt,name=evm_chain_prefix,json=evmChainPrefix,proto3" json:"evm_chain_prefix,omitempty"`
 }
-func (*UnimplementedQueryServer) ERC20ToDenom(ctx context.Context, req *QueryERC20ToDenomRequest) (*QueryERC20ToDenomResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ERC20ToDenom not implemented")
+
+func (m *QueryFailedAttestationsRequest) Reset()         { *m = QueryFailedAttestationsRequest{} }
+func (m *QueryFailedAttestationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFailedAttestationsRequest) ProtoMessage()    {}
+func (*QueryFailedAttestationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{71}
 }
-func (*UnimplementedQueryServer) DenomToERC20(ctx context.Context, req *QueryDenomToERC20Request) (*QueryDenomToERC20Response, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DenomToERC20 not implemented")
+func (m *QueryFailedAttestationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) GetLastObservedEthBlock(ctx context.Context, req *QueryLastObservedEthBlockRequest) (*QueryLastObservedEthBlockResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetLastObservedEthBlock not implemented")
+func (m *QueryFailedAttestationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFailedAttestationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) GetLastObservedEthNonce(ctx context.Context, req *QueryLastObservedEthNonceRequest) (*QueryLastObservedEthNonceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetLastObservedEthNonce not implemented")
+func (m *QueryFailedAttestationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFailedAttestationsRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) GetAttestations(ctx context.Context, req *QueryAttestationsRequest) (*QueryAttestationsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAttestations not implemented")
+func (m *QueryFailedAttestationsRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) GetDelegateKeyByValidator(ctx context.Context, req *QueryDelegateKeysByValidatorAddress) (*QueryDelegateKeysByValidatorAddressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetDelegateKeyByValidator not implemented")
+func (m *QueryFailedAttestationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFailedAttestationsRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) GetDelegateKeyByEth(ctx context.Context, req *QueryDelegateKeysByEthAddress) (*QueryDelegateKeysByEthAddressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetDelegateKeyByEth not implemented")
+
+var xxx_messageInfo_QueryFailedAttestationsRequest proto.InternalMessageInfo
+
+func (m *QueryFailedAttestationsRequest) GetEvmChainPrefix() string {
+	if m != nil {
+		return m.EvmChainPrefix
+	}
+	return ""
 }
-func (*UnimplementedQueryServer) GetDelegateKeyByOrchestrator(ctx context.Context, req *QueryDelegateKeysByOrchestratorAddress) (*QueryDelegateKeysByOrchestratorAddressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetDelegateKeyByOrchestrator not implemented")
+
+type QueryFailedAttestationsResponse struct {
+	FailedAttestations []FailedAttestation `protobuf:"bytes,1,rep,name=failed_attestations,json=failedAttestations,proto3" json:"failed_attestations"`
 }
-func (*UnimplementedQueryServer) GetPendingSendToEth(ctx context.Context, req *QueryPendingSendToEth) (*QueryPendingSendToEthResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPendingSendToEth not implemented")
+
+func (m *QueryFailedAttestationsResponse) Reset()         { *m = QueryFailedAttestationsResponse{} }
+func (m *QueryFailedAttestationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFailedAttestationsResponse) ProtoMessage()    {}
+func (*QueryFailedAttestationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{72}
 }
-func (*UnimplementedQueryServer) GetPendingIbcAutoForwards(ctx context.Context, req *QueryPendingIbcAutoForwards) (*QueryPendingIbcAutoForwardsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPendingIbcAutoForwards not implemented")
+func (m *QueryFailedAttestationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) GetListEvmChains(ctx context.Context, req *QueryListEvmChains) (*QueryListEvmChainsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetListEvmChains not implemented")
+func (m *QueryFailedAttestationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFailedAttestationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) GetMonitoredERC20Addresses(ctx context.Context, req *QueryMonitoredERC20Addresses) (*QueryMonitoredERC20AddressesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMonitoredERC20Addresses not implemented")
+func (m *QueryFailedAttestationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFailedAttestationsResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) GetBridgeBalanceSnapshots(ctx context.Context, req *QueryBridgeBalanceSnapshots) (*QueryBridgeBalanceSnapshotsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetBridgeBalanceSnapshots not implemented")
+func (m *QueryFailedAttestationsResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) GetBridgeBalanceSnapshotByEventNonce(ctx context.Context, req *QueryBridgeBalanceSnapshotByEventNonce) (*QueryBridgeBalanceSnapshotByEventNonceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetBridgeBalanceSnapshotByEventNonce not implemented")
+func (m *QueryFailedAttestationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFailedAttestationsResponse.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_QueryFailedAttestationsResponse proto.InternalMessageInfo
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryFailedAttestationsResponse) GetFailedAttestations() []FailedAttestation {
+	if m != nil {
+		return m.FailedAttestations
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_CurrentValset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryCurrentValsetRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).CurrentValset(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Query/CurrentValset",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).CurrentValset(ctx, req.(*QueryCurrentValsetRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// Query params for GetEvmChainDecommission, returning the report of the
+// removal of the evm chain, which remains available once it has been removed
+type QueryEvmChainDecommissionRequest struct {
+	EvmChainPrefix string `protobuf:"bytes,1,opt,name=evm_chain_prefix,json=evmChainPrefix,proto3" json:"evm_chain_prefix,omitempty"`
 }
 
-func _Query_ValsetRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryValsetRequestRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ValsetRequest(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Query/ValsetRequest",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ValsetRequest(ctx, req.(*QueryValsetRequestRequest))
+func (m *QueryEvmChainDecommissionRequest) Reset()         { *m = QueryEvmChainDecommissionRequest{} }
+func (m *QueryEvmChainDecommissionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEvmChainDecommissionRequest) ProtoMessage()    {}
+func (*QueryEvmChainDecommissionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{73}
+}
+func (m *QueryEvmChainDecommissionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEvmChainDecommissionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEvmChainDecommissionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryEvmChainDecommissionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEvmChainDecommissionRequest.Merge(m, src)
+}
+func (m *QueryEvmChainDecommissionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEvmChainDecommissionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEvmChainDecommissionRequest.DiscardUnknown(m)
 }
 
-func _Query_ValsetConfirm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryValsetConfirmRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ValsetConfirm(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Query/ValsetConfirm",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ValsetConfirm(ctx, req.(*QueryValsetConfirmRequest))
+var xxx_messageInfo_QueryEvmChainDecommissionRequest proto.InternalMessageInfo
+
+func (m *QueryEvmChainDecommissionRequest) GetEvmChainPrefix() string {
+	if m != nil {
+		return m.EvmChainPrefix
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_ValsetConfirmsByNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryValsetConfirmsByNonceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ValsetConfirmsByNonce(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Query/ValsetConfirmsByNonce",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ValsetConfirmsByNonce(ctx, req.(*QueryValsetConfirmsByNonceRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryEvmChainDecommissionResponse struct {
+	Decommission EvmChainDecommission `protobuf:"bytes,1,opt,name=decommission,proto3" json:"decommission"`
 }
 
-func _Query_LastValsetRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLastValsetRequestsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LastValsetRequests(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Query/LastValsetRequests",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LastValsetRequests(ctx, req.(*QueryLastValsetRequestsRequest))
+func (m *QueryEvmChainDecommissionResponse) Reset()         { *m = QueryEvmChainDecommissionResponse{} }
+func (m *QueryEvmChainDecommissionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEvmChainDecommissionResponse) ProtoMessage()    {}
+func (*QueryEvmChainDecommissionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{74}
+}
+func (m *QueryEvmChainDecommissionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEvmChainDecommissionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEvmChainDecommissionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryEvmChainDecommissionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEvmChainDecommissionResponse.Merge(m, src)
+}
+func (m *QueryEvmChainDecommissionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEvmChainDecommissionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEvmChainDecommissionResponse.DiscardUnknown(m)
 }
 
-func _Query_LastPendingValsetRequestByAddr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLastPendingValsetRequestByAddrRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LastPendingValsetRequestByAddr(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Query/LastPendingValsetRequestByAddr",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LastPendingValsetRequestByAddr(ctx, req.(*QueryLastPendingValsetRequestByAddrRequest))
+var xxx_messageInfo_QueryEvmChainDecommissionResponse proto.InternalMessageInfo
+
+func (m *QueryEvmChainDecommissionResponse) GetDecommission() EvmChainDecommission {
+	if m != nil {
+		return m.Decommission
 	}
-	return interceptor(ctx, in, info, handler)
+	return EvmChainDecommission{}
 }
 
-func _Query_LastPendingBatchRequestByAddr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLastPendingBatchRequestByAddrRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LastPendingBatchRequestByAddr(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Query/LastPendingBatchRequestByAddr",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LastPendingBatchRequestByAddr(ctx, req.(*QueryLastPendingBatchRequestByAddrRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// Query params for GetBlacklist, returning the blacklist entries of the evm
+// chain which have not expired. Given an address only its entry is returned
+type QueryBlacklistRequest struct {
+	EvmChainPrefix string `protobuf:"bytes,1,opt,name=evm_chain_prefix,json=evmChainPrefix,proto3" json:"evm_chain_prefix,omitempty"`
+	Address        string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func _Query_LastPendingLogicCallByAddr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLastPendingLogicCallByAddrRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LastPendingLogicCallByAddr(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Query/LastPendingLogicCallByAddr",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LastPendingLogicCallByAddr(ctx, req.(*QueryLastPendingLogicCallByAddrRequest))
+func (m *QueryBlacklistRequest) Reset()         { *m = QueryBlacklistRequest{} }
+func (m *QueryBlacklistRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBlacklistRequest) ProtoMessage()    {}
+func (*QueryBlacklistRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{75}
+}
+func (m *QueryBlacklistRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlacklistRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlacklistRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryBlacklistRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlacklistRequest.Merge(m, src)
+}
+func (m *QueryBlacklistRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlacklistRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlacklistRequest.DiscardUnknown(m)
 }
 
-func _Query_LastEventNonceByAddr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLastEventNonceByAddrRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LastEventNonceByAddr(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Query/LastEventNonceByAddr",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LastEventNonceByAddr(ctx, req.(*QueryLastEventNonceByAddrRequest))
+var xxx_messageInfo_QueryBlacklistRequest proto.InternalMessageInfo
+
+func (m *QueryBlacklistRequest) GetEvmChainPrefix() string {
+	if m != nil {
+		return m.EvmChainPrefix
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_BatchFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBatchFeeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).BatchFees(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Query/BatchFees",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BatchFees(ctx, req.(*QueryBatchFeeRequest))
+func (m *QueryBlacklistRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_OutgoingTxBatches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryOutgoingTxBatchesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).OutgoingTxBatches(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Query/OutgoingTxBatches",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).OutgoingTxBatches(ctx, req.(*QueryOutgoingTxBatchesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryBlacklistResponse struct {
+	Entries []BlacklistEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
 }
 
-func _Query_OutgoingLogicCalls_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryOutgoingLogicCallsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).OutgoingLogicCalls(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Query/OutgoingLogicCalls",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).OutgoingLogicCalls(ctx, req.(*QueryOutgoingLogicCallsRequest))
+func (m *QueryBlacklistResponse) Reset()         { *m = QueryBlacklistResponse{} }
+func (m *QueryBlacklistResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBlacklistResponse) ProtoMessage()    {}
+func (*QueryBlacklistResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{76}
+}
+func (m *QueryBlacklistResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlacklistResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlacklistResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryBlacklistResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlacklistResponse.Merge(m, src)
+}
+func (m *QueryBlacklistResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlacklistResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlacklistResponse.DiscardUnknown(m)
 }
 
-func _Query_BatchRequestByNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBatchRequestByNonceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).BatchRequestByNonce(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Query/BatchRequestByNonce",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BatchRequestByNonce(ctx, req.(*QueryBatchRequestByNonceRequest))
+var xxx_messageInfo_QueryBlacklistResponse proto.InternalMessageInfo
+
+func (m *QueryBlacklistResponse) GetEntries() []BlacklistEntry {
+	if m != nil {
+		return m.Entries
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_BatchConfirms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBatchConfirmsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).BatchConfirms(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Query/BatchConfirms",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BatchConfirms(ctx, req.(*QueryBatchConfirmsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// Query params for GetBatchStrategies, returning the TokenBatchStrategy of
+// every token of the evm chain which has one, along with the maximum batch
+// size of the chain
+type QueryBatchStrategiesRequest struct {
+	EvmChainPrefix string `protobuf:"bytes,1,opt,name=evm_chain_prefix,json=evmChainPrefix,proto3" json:"evm_chain_prefix,omitempty"`
 }
 
-func _Query_LogicConfirms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLogicConfirmsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LogicConfirms(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Query/LogicConfirms",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LogicConfirms(ctx, req.(*QueryLogicConfirmsRequest))
+func (m *QueryBatchStrategiesRequest) Reset()         { *m = QueryBatchStrategiesRequest{} }
+func (m *QueryBatchStrategiesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBatchStrategiesRequest) ProtoMessage()    {}
+func (*QueryBatchStrategiesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{77}
+}
+func (m *QueryBatchStrategiesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBatchStrategiesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBatchStrategiesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryBatchStrategiesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBatchStrategiesRequest.Merge(m, src)
+}
+func (m *QueryBatchStrategiesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBatchStrategiesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBatchStrategiesRequest.DiscardUnknown(m)
 }
 
-func _Query_ERC20ToDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryERC20ToDenomRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_QueryBatchStrategiesRequest proto.InternalMessageInfo
+
+func (m *QueryBatchStrategiesRequest) GetEvmChainPrefix() string {
+	if m != nil {
+		return m.EvmChainPrefix
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).ERC20ToDenom(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Query/ERC20ToDenom",
+	return ""
+}
+
+type QueryBatchStrategiesResponse struct {
+	Strategies   []TokenBatchStrategy `protobuf:"bytes,1,rep,name=strategies,proto3" json:"strategies"`
+	MaxBatchSize uint64               `protobuf:"varint,2,opt,name=max_batch_size,json=maxBatchSize,proto3" json:"max_batch_size,omitempty"`
+}
+
+func (m *QueryBatchStrategiesResponse) Reset()         { *m = QueryBatchStrategiesResponse{} }
+func (m *QueryBatchStrategiesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBatchStrategiesResponse) ProtoMessage()    {}
+func (*QueryBatchStrategiesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{78}
+}
+func (m *QueryBatchStrategiesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBatchStrategiesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBatchStrategiesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ERC20ToDenom(ctx, req.(*QueryERC20ToDenomRequest))
+}
+func (m *QueryBatchStrategiesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBatchStrategiesResponse.Merge(m, src)
+}
+func (m *QueryBatchStrategiesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBatchStrategiesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBatchStrategiesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBatchStrategiesResponse proto.InternalMessageInfo
+
+func (m *QueryBatchStrategiesResponse) GetStrategies() []TokenBatchStrategy {
+	if m != nil {
+		return m.Strategies
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_DenomToERC20_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDenomToERC20Request)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryBatchStrategiesResponse) GetMaxBatchSize() uint64 {
+	if m != nil {
+		return m.MaxBatchSize
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).DenomToERC20(ctx, in)
+	return 0
+}
+
+// Query params for GetBridgedSupply, returning the bridged supply ledger of
+// the given evm chain: the outstanding vouchers of evm originated tokens and
+// the coins locked in the gravity module for cosmos originated tokens, by
+// erc20 contract
+type QueryBridgedSupplyRequest struct {
+	EvmChainPrefix string `protobuf:"bytes,1,opt,name=evm_chain_prefix,json=evmChainPrefix,proto3" json:"evm_chain_prefix,omitempty"`
+}
+
+func (m *QueryBridgedSupplyRequest) Reset()         { *m = QueryBridgedSupplyRequest{} }
+func (m *QueryBridgedSupplyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBridgedSupplyRequest) ProtoMessage()    {}
+func (*QueryBridgedSupplyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{79}
+}
+func (m *QueryBridgedSupplyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBridgedSupplyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBridgedSupplyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Query/DenomToERC20",
+}
+func (m *QueryBridgedSupplyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBridgedSupplyRequest.Merge(m, src)
+}
+func (m *QueryBridgedSupplyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBridgedSupplyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBridgedSupplyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBridgedSupplyRequest proto.InternalMessageInfo
+
+func (m *QueryBridgedSupplyRequest) GetEvmChainPrefix() string {
+	if m != nil {
+		return m.EvmChainPrefix
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).DenomToERC20(ctx, req.(*QueryDenomToERC20Request))
+	return ""
+}
+
+type QueryBridgedSupplyResponse struct {
+	Supply []ERC20Token `protobuf:"bytes,1,rep,name=supply,proto3" json:"supply"`
+}
+
+func (m *QueryBridgedSupplyResponse) Reset()         { *m = QueryBridgedSupplyResponse{} }
+func (m *QueryBridgedSupplyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBridgedSupplyResponse) ProtoMessage()    {}
+func (*QueryBridgedSupplyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{80}
+}
+func (m *QueryBridgedSupplyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBridgedSupplyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBridgedSupplyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryBridgedSupplyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBridgedSupplyResponse.Merge(m, src)
+}
+func (m *QueryBridgedSupplyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBridgedSupplyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBridgedSupplyResponse.DiscardUnknown(m)
 }
 
-func _Query_GetLastObservedEthBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLastObservedEthBlockRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_QueryBridgedSupplyResponse proto.InternalMessageInfo
+
+func (m *QueryBridgedSupplyResponse) GetSupply() []ERC20Token {
+	if m != nil {
+		return m.Supply
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetLastObservedEthBlock(ctx, in)
+	return nil
+}
+
+// Query params for GetCheckpoint, returning the checkpoint of the valset,
+// batch or logic call of the given kind ("valset", "batch" or "logic-call")
+// and nonce, along with the signer recovered from each of its stored confirms.
+// A batch is found by nonce alone unless its token_contract is given, a logic
+// call by invalidation nonce alone unless its hex invalidation_id is given
+type QueryCheckpointRequest struct {
+	EvmChainPrefix string `protobuf:"bytes,1,opt,name=evm_chain_prefix,json=evmChainPrefix,proto3" json:"evm_chain_prefix,omitempty"`
+	Kind           string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Nonce          uint64 `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	TokenContract  string `protobuf:"bytes,4,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	InvalidationId string `protobuf:"bytes,5,opt,name=invalidation_id,json=invalidationId,proto3" json:"invalidation_id,omitempty"`
+}
+
+func (m *QueryCheckpointRequest) Reset()         { *m = QueryCheckpointRequest{} }
+func (m *QueryCheckpointRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCheckpointRequest) ProtoMessage()    {}
+func (*QueryCheckpointRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{81}
+}
+func (m *QueryCheckpointRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCheckpointRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCheckpointRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Query/GetLastObservedEthBlock",
+}
+func (m *QueryCheckpointRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCheckpointRequest.Merge(m, src)
+}
+func (m *QueryCheckpointRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCheckpointRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCheckpointRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCheckpointRequest proto.InternalMessageInfo
+
+func (m *QueryCheckpointRequest) GetEvmChainPrefix() string {
+	if m != nil {
+		return m.EvmChainPrefix
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetLastObservedEthBlock(ctx, req.(*QueryLastObservedEthBlockRequest))
+	return ""
+}
+
+func (m *QueryCheckpointRequest) GetKind() string {
+	if m != nil {
+		return m.Kind
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_GetLastObservedEthNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLastObservedEthNonceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryCheckpointRequest) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetLastObservedEthNonce(ctx, in)
+	return 0
+}
+
+func (m *QueryCheckpointRequest) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Query/GetLastObservedEthNonce",
+	return ""
+}
+
+func (m *QueryCheckpointRequest) GetInvalidationId() string {
+	if m != nil {
+		return m.InvalidationId
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetLastObservedEthNonce(ctx, req.(*QueryLastObservedEthNonceRequest))
+	return ""
+}
+
+// The hex encoded abi encoding of the subject and its checkpoint, the keccak256
+// hash of the abi encoding which the orchestrators sign
+type QueryCheckpointResponse struct {
+	GravityId  string              `protobuf:"bytes,1,opt,name=gravity_id,json=gravityId,proto3" json:"gravity_id,omitempty"`
+	AbiEncoded string              `protobuf:"bytes,2,opt,name=abi_encoded,json=abiEncoded,proto3" json:"abi_encoded,omitempty"`
+	Checkpoint string              `protobuf:"bytes,3,opt,name=checkpoint,proto3" json:"checkpoint,omitempty"`
+	Confirms   []CheckpointConfirm `protobuf:"bytes,4,rep,name=confirms,proto3" json:"confirms"`
+}
+
+func (m *QueryCheckpointResponse) Reset()         { *m = QueryCheckpointResponse{} }
+func (m *QueryCheckpointResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCheckpointResponse) ProtoMessage()    {}
+func (*QueryCheckpointResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{82}
+}
+func (m *QueryCheckpointResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCheckpointResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCheckpointResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryCheckpointResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCheckpointResponse.Merge(m, src)
+}
+func (m *QueryCheckpointResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCheckpointResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCheckpointResponse.DiscardUnknown(m)
 }
 
-func _Query_GetAttestations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAttestationsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCheckpointResponse proto.InternalMessageInfo
+
+func (m *QueryCheckpointResponse) GetGravityId() string {
+	if m != nil {
+		return m.GravityId
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetAttestations(ctx, in)
+	return ""
+}
+
+func (m *QueryCheckpointResponse) GetAbiEncoded() string {
+	if m != nil {
+		return m.AbiEncoded
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Query/GetAttestations",
+	return ""
+}
+
+func (m *QueryCheckpointResponse) GetCheckpoint() string {
+	if m != nil {
+		return m.Checkpoint
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetAttestations(ctx, req.(*QueryAttestationsRequest))
+	return ""
+}
+
+func (m *QueryCheckpointResponse) GetConfirms() []CheckpointConfirm {
+	if m != nil {
+		return m.Confirms
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_GetDelegateKeyByValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDelegateKeysByValidatorAddress)
-	if err := dec(in); err != nil {
-		return nil, err
+// CheckpointConfirm is a confirm signature over a checkpoint along with the
+// ethereum address recovered from it. valid is set when the recovered signer
+// is the delegate ethereum key of the orchestrator's validator, otherwise
+// error explains the mismatch
+type CheckpointConfirm struct {
+	Orchestrator       string `protobuf:"bytes,1,opt,name=orchestrator,proto3" json:"orchestrator,omitempty"`
+	EthSigner          string `protobuf:"bytes,2,opt,name=eth_signer,json=ethSigner,proto3" json:"eth_signer,omitempty"`
+	Signature          string `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	RecoveredSigner    string `protobuf:"bytes,4,opt,name=recovered_signer,json=recoveredSigner,proto3" json:"recovered_signer,omitempty"`
+	DelegateEthAddress string `protobuf:"bytes,5,opt,name=delegate_eth_address,json=delegateEthAddress,proto3" json:"delegate_eth_address,omitempty"`
+	Valid              bool   `protobuf:"varint,6,opt,name=valid,proto3" json:"valid,omitempty"`
+	Error              string `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *CheckpointConfirm) Reset()         { *m = CheckpointConfirm{} }
+func (m *CheckpointConfirm) String() string { return proto.CompactTextString(m) }
+func (*CheckpointConfirm) ProtoMessage()    {}
+func (*CheckpointConfirm) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{83}
+}
+func (m *CheckpointConfirm) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CheckpointConfirm) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CheckpointConfirm.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetDelegateKeyByValidator(ctx, in)
+}
+func (m *CheckpointConfirm) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckpointConfirm.Merge(m, src)
+}
+func (m *CheckpointConfirm) XXX_Size() int {
+	return m.Size()
+}
+func (m *CheckpointConfirm) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckpointConfirm.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckpointConfirm proto.InternalMessageInfo
+
+func (m *CheckpointConfirm) GetOrchestrator() string {
+	if m != nil {
+		return m.Orchestrator
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Query/GetDelegateKeyByValidator",
+	return ""
+}
+
+func (m *CheckpointConfirm) GetEthSigner() string {
+	if m != nil {
+		return m.EthSigner
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetDelegateKeyByValidator(ctx, req.(*QueryDelegateKeysByValidatorAddress))
+	return ""
+}
+
+func (m *CheckpointConfirm) GetSignature() string {
+	if m != nil {
+		return m.Signature
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_GetDelegateKeyByEth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDelegateKeysByEthAddress)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *CheckpointConfirm) GetRecoveredSigner() string {
+	if m != nil {
+		return m.RecoveredSigner
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetDelegateKeyByEth(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Query/GetDelegateKeyByEth",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetDelegateKeyByEth(ctx, req.(*QueryDelegateKeysByEthAddress))
-	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_GetDelegateKeyByOrchestrator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDelegateKeysByOrchestratorAddress)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetDelegateKeyByOrchestrator(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Query/GetDelegateKeyByOrchestrator",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetDelegateKeyByOrchestrator(ctx, req.(*QueryDelegateKeysByOrchestratorAddress))
+func (m *CheckpointConfirm) GetDelegateEthAddress() string {
+	if m != nil {
+		return m.DelegateEthAddress
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_GetPendingSendToEth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPendingSendToEth)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetPendingSendToEth(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Query/GetPendingSendToEth",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetPendingSendToEth(ctx, req.(*QueryPendingSendToEth))
+func (m *CheckpointConfirm) GetValid() bool {
+	if m != nil {
+		return m.Valid
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _Query_GetPendingIbcAutoForwards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPendingIbcAutoForwards)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetPendingIbcAutoForwards(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Query/GetPendingIbcAutoForwards",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetPendingIbcAutoForwards(ctx, req.(*QueryPendingIbcAutoForwards))
+func (m *CheckpointConfirm) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_GetListEvmChains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryListEvmChains)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetListEvmChains(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Query/GetListEvmChains",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetListEvmChains(ctx, req.(*QueryListEvmChains))
-	}
-	return interceptor(ctx, in, info, handler)
+// Query params for GetEvidenceRecords, returning the evidence of bad and double
+// signing accepted on the evm chain, only that against the given validator
+// operator address if one is set
+type QueryEvidenceRecordsRequest struct {
+	EvmChainPrefix string `protobuf:"bytes,1,opt,name=evm_chain_prefix,json=evmChainPrefix,proto3" json:"evm_chain_prefix,omitempty"`
+	Offender       string `protobuf:"bytes,2,opt,name=offender,proto3" json:"offender,omitempty"`
 }
 
-func _Query_GetMonitoredERC20Addresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryMonitoredERC20Addresses)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetMonitoredERC20Addresses(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Query/GetMonitoredERC20Addresses",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetMonitoredERC20Addresses(ctx, req.(*QueryMonitoredERC20Addresses))
+func (m *QueryEvidenceRecordsRequest) Reset()         { *m = QueryEvidenceRecordsRequest{} }
+func (m *QueryEvidenceRecordsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEvidenceRecordsRequest) ProtoMessage()    {}
+func (*QueryEvidenceRecordsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{84}
+}
+func (m *QueryEvidenceRecordsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEvidenceRecordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEvidenceRecordsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryEvidenceRecordsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEvidenceRecordsRequest.Merge(m, src)
+}
+func (m *QueryEvidenceRecordsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEvidenceRecordsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEvidenceRecordsRequest.DiscardUnknown(m)
 }
 
-func _Query_GetBridgeBalanceSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBridgeBalanceSnapshots)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetBridgeBalanceSnapshots(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Query/GetBridgeBalanceSnapshots",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetBridgeBalanceSnapshots(ctx, req.(*QueryBridgeBalanceSnapshots))
+var xxx_messageInfo_QueryEvidenceRecordsRequest proto.InternalMessageInfo
+
+func (m *QueryEvidenceRecordsRequest) GetEvmChainPrefix() string {
+	if m != nil {
+		return m.EvmChainPrefix
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_GetBridgeBalanceSnapshotByEventNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBridgeBalanceSnapshotByEventNonce)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetBridgeBalanceSnapshotByEventNonce(ctx, in)
+func (m *QueryEvidenceRecordsRequest) GetOffender() string {
+	if m != nil {
+		return m.Offender
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gravity.v1.Query/GetBridgeBalanceSnapshotByEventNonce",
+	return ""
+}
+
+type QueryEvidenceRecordsResponse struct {
+	Records []EvidenceRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+}
+
+func (m *QueryEvidenceRecordsResponse) Reset()         { *m = QueryEvidenceRecordsResponse{} }
+func (m *QueryEvidenceRecordsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEvidenceRecordsResponse) ProtoMessage()    {}
+func (*QueryEvidenceRecordsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29a9d4192703013c, []int{85}
+}
+func (m *QueryEvidenceRecordsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEvidenceRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEvidenceRecordsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetBridgeBalanceSnapshotByEventNonce(ctx, req.(*QueryBridgeBalanceSnapshotByEventNonce))
+}
+func (m *QueryEvidenceRecordsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEvidenceRecordsResponse.Merge(m, src)
+}
+func (m *QueryEvidenceRecordsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEvidenceRecordsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEvidenceRecordsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEvidenceRecordsResponse proto.InternalMessageInfo
+
+func (m *QueryEvidenceRecordsResponse) GetRecords() []EvidenceRecord {
+	if m != nil {
+		return m.Records
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "gravity.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "CurrentValset",
-			Handler:    _Query_CurrentValset_Handler,
-		},
-		{
-			MethodName: "ValsetRequest",
-			Handler:    _Query_ValsetRequest_Handler,
-		},
-		{
-			MethodName: "ValsetConfirm",
-			Handler:    _Query_ValsetConfirm_Handler,
-		},
-		{
-			MethodName: "ValsetConfirmsByNonce",
-			Handler:    _Query_ValsetConfirmsByNonce_Handler,
-		},
-		{
-			MethodName: "LastValsetRequests",
-			Handler:    _Query_LastValsetRequests_Handler,
-		},
-		{
-			MethodName: "LastPendingValsetRequestByAddr",
-			Handler:    _Query_LastPendingValsetRequestByAddr_Handler,
-		},
-		{
-			MethodName: "LastPendingBatchRequestByAddr",
-			Handler:    _Query_LastPendingBatchRequestByAddr_Handler,
-		},
-		{
-			MethodName: "LastPendingLogicCallByAddr",
-			Handler:    _Query_LastPendingLogicCallByAddr_Handler,
-		},
-		{
-			MethodName: "LastEventNonceByAddr",
-			Handler:    _Query_LastEventNonceByAddr_Handler,
-		},
-		{
-			MethodName: "BatchFees",
-			Handler:    _Query_BatchFees_Handler,
-		},
-		{
-			MethodName: "OutgoingTxBatches",
-			Handler:    _Query_OutgoingTxBatches_Handler,
-		},
-		{
-			MethodName: "OutgoingLogicCalls",
-			Handler:    _Query_OutgoingLogicCalls_Handler,
-		},
-		{
-			MethodName: "BatchRequestByNonce",
-			Handler:    _Query_BatchRequestByNonce_Handler,
-		},
-		{
-			MethodName: "BatchConfirms",
-			Handler:    _Query_BatchConfirms_Handler,
-		},
-		{
-			MethodName: "LogicConfirms",
-			Handler:    _Query_LogicConfirms_Handler,
-		},
-		{
-			MethodName: "ERC20ToDenom",
-			Handler:    _Query_ERC20ToDenom_Handler,
-		},
-		{
-			MethodName: "DenomToERC20",
-			Handler:    _Query_DenomToERC20_Handler,
-		},
-		{
-			MethodName: "GetLastObservedEthBlock",
-			Handler:    _Query_GetLastObservedEthBlock_Handler,
-		},
-		{
-			MethodName: "GetLastObservedEthNonce",
-			Handler:    _Query_GetLastObservedEthNonce_Handler,
-		},
-		{
-			MethodName: "GetAttestations",
-			Handler:    _Query_GetAttestations_Handler,
-		},
-		{
-			MethodName: "GetDelegateKeyByValidator",
-			Handler:    _Query_GetDelegateKeyByValidator_Handler,
-		},
-		{
-			MethodName: "GetDelegateKeyByEth",
-			Handler:    _Query_GetDelegateKeyByEth_Handler,
-		},
-		{
-			MethodName: "GetDelegateKeyByOrchestrator",
-			Handler:    _Query_GetDelegateKeyByOrchestrator_Handler,
-		},
-		{
-			MethodName: "GetPendingSendToEth",
-			Handler:    _Query_GetPendingSendToEth_Handler,
-		},
-		{
-			MethodName: "GetPendingIbcAutoForwards",
-			Handler:    _Query_GetPendingIbcAutoForwards_Handler,
-		},
-		{
-			MethodName: "GetListEvmChains",
-			Handler:    _Query_GetListEvmChains_Handler,
-		},
-		{
-			MethodName: "GetMonitoredERC20Addresses",
-			Handler:    _Query_GetMonitoredERC20Addresses_Handler,
-		},
-		{
-			MethodName: "GetBridgeBalanceSnapshots",
-			Handler:    _Query_GetBridgeBalanceSnapshots_Handler,
-		},
-		{
-			MethodName: "GetBridgeBalanceSnapshotByEventNonce",
-			Handler:    _Query_GetBridgeBalanceSnapshotByEventNonce_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "gravity/v1/query.proto",
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "gravity.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "gravity.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryCurrentValsetRequest)(nil), "gravity.v1.QueryCurrentValsetRequest")
+	proto.RegisterType((*QueryCurrentValsetResponse)(nil), "gravity.v1.QueryCurrentValsetResponse")
+	proto.RegisterType((*QueryValsetRequestRequest)(nil), "gravity.v1.QueryValsetRequestRequest")
+	proto.RegisterType((*QueryValsetRequestResponse)(nil), "gravity.v1.QueryValsetRequestResponse")
+	proto.RegisterType((*QueryValsetConfirmRequest)(nil), "gravity.v1.QueryValsetConfirmRequest")
+	proto.RegisterType((*QueryValsetConfirmResponse)(nil), "gravity.v1.QueryValsetConfirmResponse")
+	proto.RegisterType((*QueryValsetConfirmsByNonceRequest)(nil), "gravity.v1.QueryValsetConfirmsByNonceRequest")
+	proto.RegisterType((*QueryValsetConfirmsByNonceResponse)(nil), "gravity.v1.QueryValsetConfirmsByNonceResponse")
+	proto.RegisterType((*QueryLastValsetRequestsRequest)(nil), "gravity.v1.QueryLastValsetRequestsRequest")
+	proto.RegisterType((*QueryLastValsetRequestsResponse)(nil), "gravity.v1.QueryLastValsetRequestsResponse")
+	proto.RegisterType((*QueryLastPendingValsetRequestByAddrRequest)(nil), "gravity.v1.QueryLastPendingValsetRequestByAddrRequest")
+	proto.RegisterType((*QueryLastPendingValsetRequestByAddrResponse)(nil), "gravity.v1.QueryLastPendingValsetRequestByAddrResponse")
+	proto.RegisterType((*QueryBatchFeeRequest)(nil), "gravity.v1.QueryBatchFeeRequest")
+	proto.RegisterType((*QueryBatchFeeResponse)(nil), "gravity.v1.QueryBatchFeeResponse")
+	proto.RegisterType((*QueryLastPendingBatchRequestByAddrRequest)(nil), "gravity.v1.QueryLastPendingBatchRequestByAddrRequest")
+	proto.RegisterType((*QueryLastPendingBatchRequestByAddrResponse)(nil), "gravity.v1.QueryLastPendingBatchRequestByAddrResponse")
+	proto.RegisterType((*QueryLastPendingLogicCallByAddrRequest)(nil), "gravity.v1.QueryLastPendingLogicCallByAddrRequest")
+	proto.RegisterType((*QueryLastPendingLogicCallByAddrResponse)(nil), "gravity.v1.QueryLastPendingLogicCallByAddrResponse")
+	proto.RegisterType((*QueryOutgoingTxBatchesRequest)(nil), "gravity.v1.QueryOutgoingTxBatchesRequest")
+	proto.RegisterType((*QueryOutgoingTxBatchesResponse)(nil), "gravity.v1.QueryOutgoingTxBatchesResponse")
+	proto.RegisterType((*QueryOutgoingLogicCallsRequest)(nil), "gravity.v1.QueryOutgoingLogicCallsRequest")
+	proto.RegisterType((*QueryOutgoingLogicCallsResponse)(nil), "gravity.v1.QueryOutgoingLogicCallsResponse")
+	proto.RegisterType((*QueryBatchRequestByNonceRequest)(nil), "gravity.v1.QueryBatchRequestByNonceRequest")
+	proto.RegisterType((*QueryBatchRequestByNonceResponse)(nil), "gravity.v1.QueryBatchRequestByNonceResponse")
+	proto.RegisterType((*QueryBatchConfirmsRequest)(nil), "gravity.v1.QueryBatchConfirmsRequest")
+	proto.RegisterType((*QueryBatchConfirmsResponse)(nil), "gravity.v1.QueryBatchConfirmsResponse")
+	proto.RegisterType((*QueryLogicConfirmsRequest)(nil), "gravity.v1.QueryLogicConfirmsRequest")
+	proto.RegisterType((*QueryLogicConfirmsResponse)(nil), "gravity.v1.QueryLogicConfirmsResponse")
+	proto.RegisterType((*QueryLastEventNonceByAddrRequest)(nil), "gravity.v1.QueryLastEventNonceByAddrRequest")
+	proto.RegisterType((*QueryLastEventNonceByAddrResponse)(nil), "gravity.v1.QueryLastEventNonceByAddrResponse")
+	proto.RegisterType((*QueryERC20ToDenomRequest)(nil), "gravity.v1.QueryERC20ToDenomRequest")
+	proto.RegisterType((*QueryERC20ToDenomResponse)(nil), "gravity.v1.QueryERC20ToDenomResponse")
+	proto.RegisterType((*QueryDenomToERC20Request)(nil), "gravity.v1.QueryDenomToERC20Request")
+	proto.RegisterType((*QueryDenomToERC20Response)(nil), "gravity.v1.QueryDenomToERC20Response")
+	proto.RegisterType((*QueryLastObservedEthBlockRequest)(nil), "gravity.v1.QueryLastObservedEthBlockRequest")
+	proto.RegisterType((*QueryLastObservedEthBlockResponse)(nil), "gravity.v1.QueryLastObservedEthBlockResponse")
+	proto.RegisterType((*QueryLastObservedEthNonceRequest)(nil), "gravity.v1.QueryLastObservedEthNonceRequest")
+	proto.RegisterType((*QueryLastObservedEthNonceResponse)(nil), "gravity.v1.QueryLastObservedEthNonceResponse")
+	proto.RegisterType((*QueryAttestationsRequest)(nil), "gravity.v1.QueryAttestationsRequest")
+	proto.RegisterType((*QueryAttestationsResponse)(nil), "gravity.v1.QueryAttestationsResponse")
+	proto.RegisterType((*QueryDelegateKeysByValidatorAddress)(nil), "gravity.v1.QueryDelegateKeysByValidatorAddress")
+	proto.RegisterType((*QueryDelegateKeysByValidatorAddressResponse)(nil), "gravity.v1.QueryDelegateKeysByValidatorAddressResponse")
+	proto.RegisterType((*QueryDelegateKeysByEthAddress)(nil), "gravity.v1.QueryDelegateKeysByEthAddress")
+	proto.RegisterType((*QueryDelegateKeysByEthAddressResponse)(nil), "gravity.v1.QueryDelegateKeysByEthAddressResponse")
+	proto.RegisterType((*QueryDelegateKeysByOrchestratorAddress)(nil), "gravity.v1.QueryDelegateKeysByOrchestratorAddress")
+	proto.RegisterType((*QueryDelegateKeysByOrchestratorAddressResponse)(nil), "gravity.v1.QueryDelegateKeysByOrchestratorAddressResponse")
+	proto.RegisterType((*QueryPendingSendToEth)(nil), "gravity.v1.QueryPendingSendToEth")
+	proto.RegisterType((*QueryPendingSendToEthResponse)(nil), "gravity.v1.QueryPendingSendToEthResponse")
+	proto.RegisterType((*QueryPendingIbcAutoForwards)(nil), "gravity.v1.QueryPendingIbcAutoForwards")
+	proto.RegisterType((*QueryPendingIbcAutoForwardsResponse)(nil), "gravity.v1.QueryPendingIbcAutoForwardsResponse")
+	proto.RegisterType((*QueryListEvmChains)(nil), "gravity.v1.QueryListEvmChains")
+	proto.RegisterType((*QueryListEvmChainsResponse)(nil), "gravity.v1.QueryListEvmChainsResponse")
+	proto.RegisterType((*QueryMonitoredERC20Addresses)(nil), "gravity.v1.QueryMonitoredERC20Addresses")
+	proto.RegisterType((*QueryMonitoredERC20AddressesResponse)(nil), "gravity.v1.QueryMonitoredERC20AddressesResponse")
+	proto.RegisterType((*QueryBridgeBalanceSnapshots)(nil), "gravity.v1.QueryBridgeBalanceSnapshots")
+	proto.RegisterType((*QueryBridgeBalanceSnapshotsResponse)(nil), "gravity.v1.QueryBridgeBalanceSnapshotsResponse")
+	proto.RegisterType((*QueryBridgeBalanceSnapshotByEventNonce)(nil), "gravity.v1.QueryBridgeBalanceSnapshotByEventNonce")
+	proto.RegisterType((*QueryBridgeBalanceSnapshotByEventNonceResponse)(nil), "gravity.v1.QueryBridgeBalanceSnapshotByEventNonceResponse")
+	proto.RegisterType((*QueryRateLimitsRequest)(nil), "gravity.v1.QueryRateLimitsRequest")
+	proto.RegisterType((*RateLimitStatus)(nil), "gravity.v1.RateLimitStatus")
+	proto.RegisterType((*QueryRateLimitsResponse)(nil), "gravity.v1.QueryRateLimitsResponse")
+	proto.RegisterType((*QueryHeldSendToCosmosRequest)(nil), "gravity.v1.QueryHeldSendToCosmosRequest")
+	proto.RegisterType((*QueryHeldSendToCosmosResponse)(nil), "gravity.v1.QueryHeldSendToCosmosResponse")
+	proto.RegisterType((*QueryIbcBridgeFeesRequest)(nil), "gravity.v1.QueryIbcBridgeFeesRequest")
+	proto.RegisterType((*QueryIbcBridgeFeesResponse)(nil), "gravity.v1.QueryIbcBridgeFeesResponse")
+	proto.RegisterType((*QueryIbcAutoForwardLogsRequest)(nil), "gravity.v1.QueryIbcAutoForwardLogsRequest")
+	proto.RegisterType((*QueryIbcAutoForwardLogsResponse)(nil), "gravity.v1.QueryIbcAutoForwardLogsResponse")
+	proto.RegisterType((*QueryIbcAutoForwardTransferRequest)(nil), "gravity.v1.QueryIbcAutoForwardTransferRequest")
+	proto.RegisterType((*QueryIbcAutoForwardTransferResponse)(nil), "gravity.v1.QueryIbcAutoForwardTransferResponse")
+	proto.RegisterType((*QueryFailedAttestationsRequest)(nil), "gravity.v1.QueryFailedAttestationsRequest")
+	proto.RegisterType((*QueryFailedAttestationsResponse)(nil), "gravity.v1.QueryFailedAttestationsResponse")
+	proto.RegisterType((*QueryEvmChainDecommissionRequest)(nil), "gravity.v1.QueryEvmChainDecommissionRequest")
+	proto.RegisterType((*QueryEvmChainDecommissionResponse)(nil), "gravity.v1.QueryEvmChainDecommissionResponse")
+	proto.RegisterType((*QueryBlacklistRequest)(nil), "gravity.v1.QueryBlacklistRequest")
+	proto.RegisterType((*QueryBlacklistResponse)(nil), "gravity.v1.QueryBlacklistResponse")
+	proto.RegisterType((*QueryBatchStrategiesRequest)(nil), "gravity.v1.QueryBatchStrategiesRequest")
+	proto.RegisterType((*QueryBatchStrategiesResponse)(nil), "gravity.v1.QueryBatchStrategiesResponse")
+	proto.RegisterType((*QueryBridgedSupplyRequest)(nil), "gravity.v1.QueryBridgedSupplyRequest")
+	proto.RegisterType((*QueryBridgedSupplyResponse)(nil), "gravity.v1.QueryBridgedSupplyResponse")
+	proto.RegisterType((*QueryCheckpointRequest)(nil), "gravity.v1.QueryCheckpointRequest")
+	proto.RegisterType((*QueryCheckpointResponse)(nil), "gravity.v1.QueryCheckpointResponse")
+	proto.RegisterType((*CheckpointConfirm)(nil), "gravity.v1.CheckpointConfirm")
+	proto.RegisterType((*QueryEvidenceRecordsRequest)(nil), "gravity.v1.QueryEvidenceRecordsRequest")
+	proto.RegisterType((*QueryEvidenceRecordsResponse)(nil), "gravity.v1.QueryEvidenceRecordsResponse")
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func init() { proto.RegisterFile("gravity/v1/query.proto", fileDescriptor_29a9d4192703013c) }
+
+var fileDescriptor_29a9d4192703013c = []byte{
+	// 3716 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x5b, 0xed, 0x6b, 0x1c, 0xd7,
+	0xd5, 0xf7, 0xd8, 0x92, 0x6d, 0x1d, 0xcb, 0x6f, 0xd7, 0xb2, 0x2d, 0x8f, 0xf5, 0x62, 0x8d, 0x2c,
+	0xd9, 0x92, 0x2c, 0xad, 0x25, 0x3b, 0xf6, 0x13, 0xe7, 0x79, 0x92, 0x58, 0xb2, 0xac, 0x28, 0x71,
+	0xe2, 0x64, 0xa5, 0x38, 0xcf, 0x63, 0xc7, 0xcf, 0x30, 0xbb, 0x73, 0xb5, 0x3b, 0xd5, 0xee, 0x8c,
+	0x32, 0x33, 0x2b, 0x7b, 0x13, 0x12, 0xda, 0x04, 0x52, 0x1a, 0x0a, 0x0d, 0xb4, 0x4d, 0xa1, 0x10,
+	0x28, 0x94, 0xd2, 0x16, 0x9a, 0x40, 0x3f, 0xb4, 0x1f, 0x4a, 0xa1, 0x5f, 0x43, 0x0b, 0x25, 0xd0,
+	0x2f, 0xa5, 0x94, 0x10, 0x92, 0xfe, 0x07, 0xfd, 0x5c, 0x28, 0x73, 0x5f, 0x66, 0xee, 0xcc, 0xdc,
+	0xd9, 0x99, 0x55, 0x1c, 0xfa, 0xc9, 0xda, 0x33, 0xe7, 0xe5, 0x77, 0xee, 0xcb, 0xb9, 0xf7, 0x9e,
+	0x73, 0x0c, 0x27, 0x6a, 0xae, 0xb1, 0x6d, 0xf9, 0xed, 0xd2, 0xf6, 0x7c, 0xe9, 0xb5, 0x16, 0x76,
+	0xdb, 0x73, 0x5b, 0xae, 0xe3, 0x3b, 0x08, 0x18, 0x7d, 0x6e, 0x7b, 0x5e, 0x1d, 0x14, 0x78, 0x6a,
+	0xd8, 0xc6, 0x9e, 0xe5, 0x51, 0x2e, 0x55, 0x94, 0xf6, 0xdb, 0x5b, 0x98, 0xd3, 0x8f, 0x0b, 0xf4,
+	0xa6, 0x57, 0x93, 0x91, 0xb7, 0x1c, 0xa7, 0x21, 0xd1, 0x52, 0x31, 0xfc, 0x6a, 0x9d, 0xd1, 0x87,
+	0x04, 0xba, 0xe1, 0xfb, 0xd8, 0xf3, 0x0d, 0xdf, 0x72, 0xec, 0xf0, 0xab, 0xe3, 0xd4, 0x1a, 0xb8,
+	0x64, 0x6c, 0x59, 0x25, 0xc3, 0xb6, 0x1d, 0xfa, 0x91, 0x9b, 0x1a, 0xa8, 0x39, 0x35, 0x87, 0xfc,
+	0x59, 0x0a, 0xfe, 0xa2, 0x54, 0x6d, 0x00, 0xd0, 0x4b, 0x81, 0x93, 0x2f, 0x1a, 0xae, 0xd1, 0xf4,
+	0xca, 0xf8, 0xb5, 0x16, 0xf6, 0x7c, 0x6d, 0x05, 0x8e, 0xc5, 0xa8, 0xde, 0x96, 0x63, 0x7b, 0x18,
+	0x5d, 0x84, 0xbd, 0x5b, 0x84, 0x32, 0xa8, 0x9c, 0x51, 0xce, 0x1f, 0x58, 0x40, 0x73, 0xd1, 0x98,
+	0xcc, 0x51, 0xde, 0xc5, 0x9e, 0x4f, 0x3e, 0x1b, 0xdd, 0x55, 0x66, 0x7c, 0xda, 0x32, 0x9c, 0x22,
+	0x8a, 0x96, 0x5a, 0xae, 0x8b, 0x6d, 0xff, 0x8e, 0xd1, 0xf0, 0xb0, 0xcf, 0xac, 0xa0, 0xf3, 0x70,
+	0x04, 0x6f, 0x37, 0xf5, 0x6a, 0xdd, 0xb0, 0x6c, 0x7d, 0xcb, 0xc5, 0x1b, 0xd6, 0x43, 0xa2, 0xb8,
+	0xaf, 0x7c, 0x08, 0x6f, 0x37, 0x97, 0x02, 0xf2, 0x8b, 0x84, 0xaa, 0xbd, 0x00, 0xaa, 0x4c, 0x4d,
+	0x04, 0x6b, 0x9b, 0x50, 0x64, 0xb0, 0x28, 0x2f, 0x87, 0x45, 0xf9, 0xb4, 0x7b, 0x0c, 0x56, 0x0c,
+	0x0f, 0x87, 0x35, 0x00, 0xbd, 0xb6, 0x63, 0x57, 0x31, 0xd1, 0xd6, 0x53, 0xa6, 0x3f, 0xa4, 0x60,
+	0x77, 0x4b, 0xc1, 0x3e, 0x03, 0xaa, 0x4c, 0x39, 0x03, 0x3b, 0x9d, 0x0f, 0x36, 0x84, 0xd9, 0x8a,
+	0xc1, 0x5c, 0x72, 0xec, 0x0d, 0xcb, 0x6d, 0x76, 0x86, 0x39, 0x08, 0xfb, 0x0c, 0xd3, 0x74, 0xb1,
+	0xe7, 0x31, 0x74, 0xfc, 0xa7, 0xd4, 0x81, 0x3d, 0x52, 0x07, 0xd6, 0x41, 0x95, 0x99, 0x65, 0x0e,
+	0x5c, 0x81, 0x7d, 0x55, 0x4a, 0x62, 0x1e, 0x0c, 0x89, 0x1e, 0x3c, 0xef, 0xd5, 0xe2, 0x62, 0x9c,
+	0x59, 0xab, 0xc2, 0x58, 0x5a, 0xab, 0xb7, 0xd8, 0x7e, 0x21, 0xc0, 0xfd, 0xa8, 0xc6, 0xde, 0x04,
+	0xad, 0x93, 0x11, 0xe6, 0xc2, 0x93, 0xb0, 0x9f, 0xa1, 0x0a, 0x56, 0xf2, 0x9e, 0x3c, 0x1f, 0xd8,
+	0xe2, 0x09, 0x65, 0xb4, 0x67, 0x61, 0x84, 0x58, 0xb9, 0x65, 0x78, 0xf1, 0x25, 0xed, 0x75, 0xbf,
+	0xb4, 0x5f, 0x86, 0xd1, 0x4c, 0x5d, 0x0c, 0xee, 0x02, 0xec, 0xa3, 0x0b, 0x82, 0xa3, 0xcd, 0x5e,
+	0xe0, 0x9c, 0x51, 0xdb, 0x82, 0xe9, 0x50, 0xed, 0x8b, 0xd8, 0x36, 0x2d, 0xbb, 0x16, 0xd3, 0xbe,
+	0xd8, 0xbe, 0x6e, 0x9a, 0x2e, 0x87, 0x2b, 0xac, 0x1a, 0x25, 0x7f, 0xd5, 0xc8, 0x87, 0xde, 0x80,
+	0x99, 0x42, 0x16, 0xbf, 0x82, 0x53, 0x4f, 0xc3, 0x00, 0x31, 0xb1, 0x18, 0x84, 0xc4, 0x9b, 0x18,
+	0x77, 0x3f, 0xda, 0x6b, 0x70, 0x3c, 0xa1, 0x81, 0xc1, 0xb9, 0x06, 0x40, 0x02, 0xad, 0xbe, 0x81,
+	0x31, 0x47, 0x74, 0x5c, 0x44, 0xc4, 0x25, 0x78, 0x84, 0xeb, 0xab, 0x70, 0x82, 0xe6, 0xc0, 0x54,
+	0xd2, 0x73, 0xc2, 0xfd, 0xb5, 0x0d, 0x35, 0x86, 0xe9, 0x22, 0x06, 0x99, 0x6b, 0x57, 0xa1, 0x97,
+	0x60, 0x65, 0x5e, 0x9d, 0x16, 0xbd, 0xba, 0xdd, 0xf2, 0x6b, 0x8e, 0x65, 0xd7, 0xd6, 0x1f, 0x12,
+	0x05, 0xcc, 0x37, 0xca, 0xaf, 0x35, 0x60, 0x32, 0x69, 0xe6, 0x96, 0x53, 0xb3, 0xaa, 0x4b, 0x46,
+	0xa3, 0xf1, 0xe8, 0x9d, 0xaa, 0xc0, 0xb9, 0x5c, 0x6b, 0xa1, 0x47, 0x3d, 0x55, 0xa3, 0xd1, 0x60,
+	0x0e, 0x0d, 0xcb, 0x1c, 0x8a, 0x44, 0xa9, 0x4b, 0x44, 0x40, 0x5b, 0x85, 0x61, 0x62, 0x23, 0xe1,
+	0x36, 0xde, 0xc1, 0xbe, 0xbd, 0x0f, 0x23, 0x59, 0xaa, 0x18, 0xca, 0x27, 0x60, 0x5f, 0x85, 0x92,
+	0x8a, 0x8f, 0x3c, 0x97, 0x08, 0x43, 0x4c, 0xca, 0x9f, 0x1d, 0x40, 0x7d, 0x15, 0x46, 0x33, 0x75,
+	0x31, 0xac, 0x8f, 0x43, 0x6f, 0x30, 0x40, 0x5e, 0x37, 0x43, 0x4a, 0x25, 0xb4, 0xef, 0x2a, 0x4c,
+	0x7d, 0x7c, 0x09, 0x16, 0x08, 0xeb, 0x53, 0x70, 0xa4, 0xea, 0xd8, 0xbe, 0x6b, 0x54, 0x7d, 0x3d,
+	0x7e, 0x68, 0x1d, 0xe6, 0xf4, 0xeb, 0x5d, 0x1f, 0x5e, 0xf7, 0xe0, 0x4c, 0x36, 0x9a, 0xf4, 0x8e,
+	0x50, 0xba, 0xda, 0x11, 0xef, 0x2a, 0xec, 0x44, 0x26, 0xdf, 0xf8, 0xf1, 0xf2, 0x1f, 0xf1, 0x52,
+	0x95, 0xe1, 0x60, 0xfe, 0xfd, 0x4f, 0xea, 0x7c, 0x3b, 0x9d, 0x38, 0xdf, 0xf8, 0xc9, 0x26, 0xb8,
+	0x18, 0x1d, 0x6f, 0x1f, 0x72, 0x2f, 0xe9, 0x8c, 0x27, 0xbc, 0x3c, 0x07, 0x87, 0x2d, 0x7b, 0xdb,
+	0x68, 0x58, 0x26, 0xb9, 0x5e, 0xea, 0x96, 0x49, 0xfc, 0xed, 0x2f, 0x1f, 0x12, 0xc9, 0xab, 0x26,
+	0x9a, 0x05, 0x14, 0x63, 0xa4, 0x63, 0xb3, 0x9b, 0x8c, 0xcd, 0x51, 0xf1, 0xcb, 0x0b, 0x99, 0x87,
+	0xbc, 0xdc, 0x79, 0x1d, 0x54, 0x19, 0x3c, 0xe6, 0xfc, 0xf5, 0x94, 0xf3, 0xa3, 0x72, 0xe7, 0x93,
+	0xeb, 0x39, 0x1a, 0x80, 0x0d, 0x38, 0x13, 0x86, 0xa2, 0xe5, 0x6d, 0x6c, 0xfb, 0x04, 0xe1, 0xa3,
+	0x0f, 0x79, 0x37, 0x60, 0xac, 0x83, 0x1d, 0xe6, 0xcf, 0x28, 0x1c, 0xc0, 0xc1, 0x37, 0x5d, 0x5c,
+	0x5b, 0x80, 0x43, 0x76, 0xed, 0x2e, 0x0c, 0x12, 0x2d, 0xcb, 0xe5, 0xa5, 0x85, 0x8b, 0xeb, 0xce,
+	0x0d, 0x6c, 0x3b, 0xe2, 0x25, 0x11, 0xbb, 0xd5, 0x85, 0x8b, 0x0c, 0x23, 0xfd, 0xd1, 0x05, 0xc2,
+	0xff, 0x87, 0x53, 0x12, 0xdd, 0x0c, 0xd9, 0x00, 0xf4, 0x9a, 0x01, 0x81, 0x2b, 0x27, 0x3f, 0xd0,
+	0x0c, 0x1c, 0xad, 0x3a, 0x5e, 0xd3, 0xf1, 0x74, 0xc7, 0xb5, 0x6a, 0x96, 0x6d, 0xf8, 0xd8, 0x24,
+	0xda, 0xf7, 0x97, 0x8f, 0xd0, 0x0f, 0xb7, 0x43, 0x7a, 0x88, 0x9d, 0x28, 0x5e, 0x77, 0x88, 0x19,
+	0x01, 0xbb, 0x44, 0x7d, 0xf7, 0xd8, 0xe3, 0xba, 0x23, 0xec, 0x92, 0x81, 0xe9, 0x0a, 0xfb, 0x37,
+	0x84, 0x55, 0x72, 0xbb, 0xe2, 0x61, 0x77, 0x1b, 0x9b, 0xcb, 0x7e, 0x7d, 0xb1, 0xe1, 0x54, 0x37,
+	0xb9, 0x0f, 0x43, 0x00, 0x2d, 0x0f, 0xeb, 0xdb, 0xf3, 0xfa, 0x26, 0x6e, 0x13, 0x5b, 0xfb, 0xcb,
+	0xfb, 0x5b, 0x1e, 0xbe, 0x33, 0xff, 0x1c, 0x6e, 0x77, 0xe1, 0xcb, 0xe3, 0x30, 0xd6, 0xc1, 0x56,
+	0xe4, 0x53, 0x25, 0x20, 0xf0, 0xf8, 0x43, 0x7e, 0x64, 0xc1, 0x8c, 0xc5, 0xe7, 0xaf, 0x19, 0x66,
+	0x3c, 0xfa, 0x4a, 0xc3, 0xa4, 0xf6, 0xb9, 0xc2, 0x96, 0xc2, 0xf5, 0xe8, 0x5d, 0x2b, 0x46, 0xd6,
+	0x86, 0xd5, 0xb4, 0x7c, 0x2e, 0x42, 0x7e, 0xa0, 0x53, 0xb0, 0xdf, 0x71, 0x4d, 0xec, 0xea, 0x95,
+	0x36, 0x7f, 0xec, 0x90, 0xdf, 0x8b, 0x6d, 0x34, 0x0c, 0x50, 0x6d, 0x18, 0x56, 0x53, 0x0f, 0xde,
+	0xe0, 0x2c, 0x8c, 0xf4, 0x11, 0xca, 0x7a, 0x7b, 0x4b, 0x80, 0xd0, 0x23, 0x46, 0xea, 0x13, 0xb0,
+	0xb7, 0x8e, 0xad, 0x5a, 0xdd, 0x1f, 0xec, 0x25, 0x64, 0xf6, 0x2b, 0x31, 0x3a, 0x7b, 0x0b, 0x8c,
+	0xce, 0xbe, 0x8e, 0x0b, 0x32, 0xee, 0x61, 0x18, 0xb6, 0xfa, 0x85, 0x17, 0x3d, 0x0f, 0x5d, 0x27,
+	0xc5, 0xd0, 0x25, 0xc8, 0xb1, 0x90, 0x15, 0x13, 0xd1, 0xca, 0x30, 0xce, 0x16, 0x7c, 0x03, 0xd7,
+	0x0c, 0x1f, 0x3f, 0x87, 0xdb, 0xde, 0x62, 0xfb, 0x0e, 0x8d, 0xb3, 0x8e, 0xcb, 0x4f, 0x99, 0x19,
+	0x38, 0xba, 0xcd, 0x69, 0x7a, 0x3c, 0x86, 0x1d, 0xd9, 0x4e, 0x30, 0x6b, 0xdf, 0x52, 0x60, 0xa6,
+	0x80, 0xd2, 0x58, 0xb4, 0xf2, 0xeb, 0x09, 0xb5, 0x80, 0xfd, 0x3a, 0xb7, 0x3e, 0x0f, 0x03, 0x8e,
+	0x1b, 0x5c, 0x71, 0x7c, 0x37, 0x06, 0x80, 0x4e, 0xe0, 0x31, 0xf1, 0x1b, 0xc7, 0xf0, 0x34, 0x0c,
+	0x4b, 0x20, 0x2c, 0x47, 0x3a, 0xf3, 0x8c, 0x6a, 0xdf, 0x56, 0x60, 0xa2, 0xa3, 0x8a, 0x10, 0x7f,
+	0x37, 0x83, 0xb3, 0x13, 0x5f, 0xee, 0xc1, 0xa4, 0x04, 0xc8, 0xed, 0x34, 0x67, 0xa6, 0x72, 0x25,
+	0x5b, 0xf9, 0x5b, 0x30, 0x57, 0x4c, 0xf9, 0xce, 0xdc, 0x4d, 0x0c, 0xf3, 0xee, 0xd4, 0x30, 0xd7,
+	0xd9, 0xeb, 0x8a, 0x5d, 0xdf, 0xd7, 0xb0, 0x6d, 0xae, 0x3b, 0xcb, 0x7e, 0x1d, 0x4d, 0xc0, 0x21,
+	0x0f, 0xdb, 0xc1, 0x56, 0x8d, 0xdb, 0x38, 0x48, 0xa9, 0xd7, 0xbb, 0x3e, 0x39, 0xff, 0xac, 0xc0,
+	0xb0, 0xd4, 0x54, 0xe8, 0xd9, 0x1d, 0x18, 0xf0, 0x5d, 0xc3, 0xf6, 0x36, 0xb0, 0xeb, 0xe9, 0x96,
+	0xad, 0xc7, 0xaf, 0xe2, 0x23, 0xd2, 0x2b, 0x1f, 0xe3, 0x5f, 0x7f, 0xc8, 0xb6, 0x17, 0x0a, 0x35,
+	0xac, 0xda, 0xec, 0x76, 0x8f, 0x5e, 0x86, 0x63, 0x2d, 0x9b, 0x2a, 0x33, 0xf5, 0xf0, 0xfb, 0xe0,
+	0xee, 0x6e, 0xd4, 0x86, 0x0a, 0xf8, 0x27, 0x4f, 0xbb, 0x0f, 0xa7, 0x45, 0x7f, 0x56, 0x2b, 0xd5,
+	0xeb, 0x2d, 0xdf, 0xb9, 0xe9, 0xb8, 0x0f, 0x0c, 0xd7, 0xf4, 0x32, 0x02, 0x60, 0xf1, 0xf1, 0x7a,
+	0x47, 0x81, 0xf1, 0x0e, 0xfa, 0xc3, 0x51, 0x7b, 0x15, 0x4e, 0x6d, 0x51, 0x0e, 0xdd, 0xaa, 0x54,
+	0x75, 0xa3, 0xe5, 0x3b, 0xfa, 0x06, 0x63, 0x62, 0x43, 0x37, 0x16, 0x4b, 0xfa, 0xc9, 0xd4, 0x95,
+	0x4f, 0x6c, 0x49, 0xad, 0x68, 0xd3, 0x2c, 0xd9, 0x78, 0xcb, 0x0a, 0xee, 0x3b, 0x14, 0x60, 0x86,
+	0x6f, 0xda, 0x2b, 0xa0, 0xa6, 0x79, 0x85, 0xf7, 0x0a, 0x84, 0x9e, 0x73, 0x60, 0x03, 0x22, 0x30,
+	0x2e, 0xc2, 0x5f, 0xeb, 0x7c, 0x3c, 0x3c, 0xed, 0x19, 0x18, 0x22, 0x8a, 0x9f, 0x77, 0x6c, 0xcb,
+	0x77, 0x5c, 0x6c, 0x92, 0x8b, 0x01, 0x5b, 0x82, 0xd8, 0xeb, 0xe2, 0x5d, 0x75, 0x03, 0xce, 0x76,
+	0xd2, 0x14, 0x82, 0x1d, 0x82, 0x3e, 0x83, 0x13, 0x09, 0xd6, 0xbe, 0x72, 0x44, 0xd0, 0xbe, 0xa9,
+	0xb0, 0xa9, 0x5f, 0x74, 0x2d, 0xb3, 0x86, 0x17, 0x8d, 0x86, 0x61, 0x57, 0xf1, 0x9a, 0x6d, 0x6c,
+	0x79, 0x75, 0xc7, 0xcf, 0x9a, 0xfa, 0x31, 0xe8, 0xb7, 0xf1, 0x03, 0xec, 0xf9, 0xfa, 0x86, 0xe5,
+	0x7a, 0x3e, 0xbb, 0xa4, 0x1c, 0xa0, 0xb4, 0x9b, 0x01, 0xa9, 0x8b, 0x0b, 0xf5, 0x06, 0x8c, 0x77,
+	0x40, 0x10, 0xfa, 0xf1, 0x14, 0xf4, 0x79, 0x9c, 0x28, 0x5b, 0x0c, 0x52, 0xf1, 0x72, 0x24, 0xa3,
+	0xd5, 0x59, 0xf0, 0x93, 0x32, 0x2e, 0xb6, 0xa3, 0x2b, 0xf0, 0x57, 0xce, 0x03, 0x3a, 0x30, 0x57,
+	0xcc, 0x92, 0xf8, 0x66, 0xe2, 0x40, 0xd9, 0xb3, 0xb0, 0x80, 0x6f, 0xa1, 0x88, 0xf6, 0xbf, 0x70,
+	0x82, 0x18, 0x2c, 0x1b, 0x3e, 0xbe, 0x15, 0xcc, 0x50, 0xf7, 0xef, 0xf4, 0xe8, 0xc2, 0xbb, 0x5b,
+	0xb8, 0xf0, 0x6a, 0xff, 0xdc, 0x03, 0x87, 0x43, 0xad, 0x6b, 0xbe, 0xe1, 0xb7, 0xbc, 0x20, 0x5b,
+	0xe5, 0x1a, 0x3e, 0xd6, 0xa3, 0x85, 0x91, 0xc8, 0x56, 0x85, 0x02, 0x7c, 0xfd, 0xbb, 0x9c, 0x10,
+	0xac, 0x9c, 0x07, 0x96, 0x6d, 0x3a, 0x0f, 0x74, 0xcf, 0x37, 0x5c, 0x9f, 0x3d, 0xc8, 0x0e, 0x50,
+	0xda, 0x5a, 0x40, 0x0a, 0x6e, 0x4f, 0x8c, 0x05, 0xdb, 0x26, 0x59, 0x33, 0x3d, 0xe5, 0x3e, 0x4a,
+	0x59, 0xb6, 0x4d, 0xb4, 0x06, 0x07, 0x9d, 0x96, 0x5f, 0x71, 0x5a, 0xb6, 0xa9, 0xb7, 0x3c, 0x6c,
+	0x92, 0x5b, 0x54, 0xdf, 0xe2, 0x5c, 0x60, 0xe9, 0x6f, 0x9f, 0x8d, 0x4e, 0xd6, 0x2c, 0xbf, 0xde,
+	0xaa, 0xcc, 0x55, 0x9d, 0x66, 0x89, 0x5e, 0x9a, 0xd9, 0x3f, 0xb3, 0x9e, 0xb9, 0xc9, 0x8a, 0x22,
+	0xab, 0xb6, 0x5f, 0xee, 0xe7, 0x4a, 0x5e, 0xf6, 0xb0, 0x89, 0x5e, 0x82, 0x7e, 0xcb, 0x16, 0x74,
+	0xf6, 0xee, 0x48, 0xe7, 0x01, 0xcb, 0x8e, 0x54, 0xde, 0x07, 0xe4, 0xe2, 0xa6, 0x61, 0xd9, 0x41,
+	0x38, 0xe3, 0xc6, 0x06, 0xf7, 0xee, 0x48, 0xf1, 0xd1, 0x50, 0xd3, 0x6d, 0xa6, 0x08, 0xdd, 0x83,
+	0x88, 0xa8, 0x33, 0xbb, 0x83, 0xfb, 0x76, 0xa4, 0xfd, 0x48, 0xa8, 0x68, 0x95, 0xea, 0xd1, 0xee,
+	0xc3, 0xc9, 0xd4, 0x7a, 0x62, 0x2b, 0x75, 0x11, 0x0e, 0x44, 0x93, 0x2f, 0x7d, 0xe0, 0x27, 0x96,
+	0x0b, 0x5b, 0x03, 0x10, 0xae, 0x81, 0x28, 0x08, 0x3e, 0x83, 0x1b, 0x26, 0x3d, 0x3b, 0x97, 0x08,
+	0xae, 0xee, 0x93, 0x4b, 0xaf, 0xc0, 0x70, 0x86, 0xa6, 0xb0, 0x5e, 0xd0, 0x53, 0xc7, 0x0d, 0x53,
+	0x96, 0x68, 0x4f, 0xca, 0xf0, 0x5c, 0x5d, 0xc0, 0x1f, 0xd6, 0x68, 0x56, 0x2b, 0x55, 0xba, 0xf9,
+	0x82, 0x5c, 0xeb, 0xa3, 0xda, 0x54, 0xf7, 0x41, 0x95, 0x29, 0x0f, 0x03, 0xdd, 0x81, 0x0a, 0xa1,
+	0x8a, 0xd9, 0xe0, 0x41, 0x11, 0xb9, 0x28, 0xc7, 0x87, 0xb7, 0x12, 0x2a, 0x0a, 0x6e, 0xcd, 0x23,
+	0x5c, 0xbf, 0x70, 0x02, 0xde, 0x72, 0x6a, 0x3b, 0xf0, 0x20, 0x91, 0x00, 0xd8, 0x9d, 0x4c, 0x00,
+	0x44, 0x27, 0xc4, 0x1e, 0xf1, 0x00, 0xbd, 0x0b, 0xa3, 0x99, 0x10, 0xa2, 0x3c, 0x6a, 0xc3, 0xa9,
+	0x49, 0x93, 0x7e, 0x29, 0x29, 0x3e, 0x37, 0x81, 0x80, 0xe6, 0xb0, 0x32, 0x4b, 0x9c, 0x8b, 0xdf,
+	0x66, 0x1e, 0xbd, 0x8b, 0xda, 0x26, 0x8c, 0x77, 0x34, 0xc8, 0x1c, 0xba, 0x01, 0xfb, 0xf9, 0x95,
+	0x8c, 0x45, 0x45, 0x2d, 0xdb, 0x29, 0x2e, 0xcd, 0xd3, 0x3f, 0x5c, 0x32, 0xcc, 0xbd, 0xde, 0x34,
+	0xac, 0x06, 0x36, 0x65, 0xef, 0xd1, 0xe2, 0xdb, 0xe3, 0x01, 0x8c, 0x66, 0xea, 0x62, 0xa0, 0xd7,
+	0xe1, 0xd8, 0x06, 0xf9, 0xaa, 0x4b, 0x1e, 0x80, 0xb1, 0x49, 0x49, 0x29, 0xe1, 0x17, 0xca, 0x8d,
+	0x94, 0x76, 0xed, 0x16, 0x7b, 0xf6, 0xf3, 0x8b, 0xd0, 0x0d, 0x5c, 0x75, 0x9a, 0x4d, 0xcb, 0xf3,
+	0x2c, 0xc7, 0xee, 0xde, 0x0d, 0x07, 0xc6, 0x3a, 0x68, 0x63, 0x8e, 0x3c, 0x0b, 0xfd, 0xa6, 0x40,
+	0x67, 0x33, 0x70, 0x46, 0x76, 0x2d, 0x13, 0xe5, 0xf9, 0x5b, 0x56, 0x94, 0xd5, 0xee, 0xf1, 0x42,
+	0x4d, 0xc3, 0xa8, 0x6e, 0x36, 0x2c, 0xaf, 0xfb, 0xa2, 0x71, 0x76, 0x29, 0x54, 0x5b, 0x87, 0x13,
+	0x49, 0xe5, 0x61, 0x19, 0x68, 0x1f, 0xb6, 0x7d, 0xd7, 0x0a, 0x77, 0xbd, 0x1a, 0xbb, 0x04, 0x70,
+	0xfe, 0x65, 0xdb, 0x77, 0xdb, 0x3c, 0x65, 0xcf, 0x04, 0xb4, 0x15, 0x7e, 0x8f, 0x0b, 0xee, 0xf6,
+	0x6b, 0xbe, 0x6b, 0xf8, 0xb8, 0x66, 0xed, 0xa4, 0xb4, 0xf0, 0x9e, 0x02, 0x43, 0x72, 0x4d, 0xe1,
+	0x32, 0x07, 0x2f, 0xa4, 0xca, 0x5e, 0x34, 0xeb, 0xce, 0x26, 0xb6, 0x45, 0x69, 0x0e, 0x56, 0x90,
+	0x43, 0x67, 0xe1, 0x50, 0xd3, 0x78, 0x48, 0x1f, 0x46, 0xba, 0x67, 0xbd, 0xce, 0xf7, 0x5d, 0x7f,
+	0xd3, 0xa0, 0xb9, 0xef, 0x35, 0xeb, 0x75, 0x1c, 0x56, 0xf0, 0x69, 0xb8, 0x33, 0xd7, 0x5a, 0x5b,
+	0x5b, 0x8d, 0x76, 0xf7, 0x3e, 0x95, 0x41, 0x95, 0xa9, 0x61, 0x0e, 0x5d, 0x86, 0xbd, 0x1e, 0xa1,
+	0x30, 0x67, 0x4e, 0xc4, 0xd6, 0x0c, 0xcd, 0x3d, 0x06, 0x1e, 0xb1, 0x2a, 0x3e, 0xe5, 0xd5, 0x7e,
+	0xaf, 0xb0, 0x79, 0x5c, 0xaa, 0xe3, 0xea, 0xe6, 0x96, 0x63, 0xd9, 0x3b, 0x58, 0x25, 0x08, 0x7a,
+	0x36, 0x2d, 0xdb, 0x64, 0x4b, 0x84, 0xfc, 0x1d, 0xdd, 0x3e, 0xf7, 0x88, 0xb7, 0xcf, 0x09, 0x38,
+	0xe4, 0x07, 0x28, 0x74, 0x9e, 0xb6, 0xa7, 0xf7, 0x9e, 0xf2, 0x41, 0x42, 0x5d, 0x62, 0x44, 0x59,
+	0x7e, 0xbc, 0x97, 0x5a, 0x8e, 0xe7, 0xc7, 0xb5, 0xdf, 0x29, 0x70, 0x32, 0x05, 0x9f, 0x0d, 0xc8,
+	0x30, 0xf0, 0x76, 0x13, 0x9e, 0x5f, 0xef, 0x2b, 0xf7, 0x31, 0xca, 0xaa, 0x19, 0xc4, 0x4b, 0xa3,
+	0x62, 0xe9, 0xd8, 0xae, 0x3a, 0x26, 0xe6, 0xd8, 0xc1, 0xa8, 0x58, 0xcb, 0x94, 0x82, 0x46, 0x00,
+	0xaa, 0xa1, 0x56, 0x76, 0xeb, 0x17, 0x28, 0xe8, 0x29, 0x21, 0x49, 0xde, 0x93, 0x0e, 0x34, 0x11,
+	0xa2, 0xac, 0x12, 0xf8, 0xbf, 0x14, 0x38, 0x9a, 0xe2, 0x42, 0x1a, 0xf4, 0x8b, 0x79, 0x09, 0x06,
+	0x3c, 0x46, 0x0b, 0x5c, 0x0b, 0xb2, 0x08, 0x9e, 0x55, 0xb3, 0xb1, 0xcb, 0xa0, 0xf7, 0x61, 0xbf,
+	0xbe, 0x46, 0x08, 0xc1, 0x63, 0x29, 0xf8, 0x64, 0xf8, 0x2d, 0x37, 0x4c, 0xdc, 0x85, 0x84, 0xa0,
+	0x98, 0xe2, 0xe2, 0xaa, 0xb3, 0x8d, 0x5d, 0x6c, 0x72, 0x15, 0x74, 0x16, 0x0e, 0x87, 0x74, 0xa6,
+	0xe8, 0x22, 0x0c, 0x98, 0x2c, 0x0f, 0xa2, 0x8b, 0x69, 0x0b, 0x3a, 0x19, 0x88, 0x7f, 0x13, 0xd2,
+	0x48, 0x03, 0xd0, 0x4b, 0x26, 0x88, 0xa5, 0xf8, 0xe8, 0x0f, 0x44, 0x32, 0xc5, 0xae, 0xe3, 0xb2,
+	0xa4, 0x1e, 0xfd, 0xa1, 0x55, 0xd9, 0x66, 0x5f, 0xde, 0xb6, 0x4c, 0x4c, 0xde, 0x11, 0x55, 0x87,
+	0x3c, 0xa4, 0xbb, 0x5d, 0x7f, 0x2a, 0xec, 0x77, 0x36, 0x36, 0x48, 0x1a, 0x84, 0x0d, 0x46, 0xf8,
+	0x5b, 0xbb, 0x0b, 0x43, 0x72, 0x23, 0x51, 0xb4, 0x72, 0x29, 0x49, 0x16, 0xad, 0xe2, 0x52, 0x3c,
+	0x5a, 0x31, 0x81, 0x85, 0x5f, 0x5e, 0x86, 0x5e, 0xa2, 0x1c, 0x59, 0xb0, 0x97, 0xf6, 0xee, 0xa0,
+	0x58, 0x0c, 0x49, 0xb7, 0x05, 0xa9, 0xa3, 0x99, 0xdf, 0x29, 0x20, 0x6d, 0xe4, 0xed, 0xbf, 0xfc,
+	0xe3, 0xfb, 0xbb, 0x07, 0xd1, 0x89, 0x52, 0xd4, 0xa8, 0x54, 0xc1, 0xbe, 0x51, 0xa2, 0xed, 0x40,
+	0xe8, 0x5d, 0x05, 0x0e, 0xc6, 0x7a, 0x78, 0xd0, 0x44, 0x4a, 0xa5, 0xac, 0x55, 0x48, 0x9d, 0xcc,
+	0x63, 0x63, 0x00, 0x26, 0x09, 0x80, 0x33, 0x68, 0x24, 0x09, 0x80, 0xb6, 0x10, 0x94, 0xaa, 0x54,
+	0x0a, 0xbd, 0x05, 0x07, 0x63, 0x06, 0x24, 0x38, 0x64, 0xbd, 0x41, 0xea, 0x64, 0x1e, 0x5b, 0xde,
+	0x40, 0x50, 0x1c, 0x64, 0x20, 0x62, 0x3d, 0x26, 0x99, 0x00, 0xe2, 0x5d, 0x3f, 0xea, 0x64, 0x1e,
+	0x5b, 0xd1, 0x81, 0x60, 0x66, 0x7f, 0xa2, 0xc0, 0x71, 0x69, 0xb3, 0x0c, 0x9a, 0xed, 0x6c, 0x29,
+	0xd1, 0xb9, 0xa3, 0xce, 0x15, 0x65, 0x67, 0x00, 0xcf, 0x13, 0x80, 0x1a, 0x3a, 0x93, 0x04, 0xc8,
+	0x43, 0x4c, 0xe9, 0x0d, 0x12, 0x76, 0xdf, 0x44, 0x1f, 0x28, 0x80, 0xd2, 0xdd, 0x31, 0x68, 0x3a,
+	0x65, 0x30, 0xb3, 0x1d, 0x47, 0x9d, 0x29, 0xc4, 0xcb, 0x90, 0x9d, 0x23, 0xc8, 0xc6, 0xd0, 0x68,
+	0xc6, 0xd0, 0xb9, 0x1c, 0xc1, 0x6f, 0x15, 0x18, 0xe9, 0xdc, 0xed, 0x82, 0xae, 0x48, 0x0d, 0xe7,
+	0x36, 0xe4, 0xa8, 0x57, 0xbb, 0x96, 0x63, 0xe0, 0xc7, 0x09, 0xf8, 0x61, 0x74, 0x3a, 0x03, 0x7c,
+	0xc3, 0xf0, 0x7c, 0xf4, 0x47, 0x05, 0x86, 0x3b, 0xf6, 0x8e, 0xa0, 0xc7, 0x3a, 0xd9, 0xcf, 0x6c,
+	0x6e, 0x51, 0xaf, 0x74, 0x2b, 0xc6, 0x50, 0x5f, 0x23, 0xa8, 0x2f, 0xa3, 0x85, 0x24, 0x6a, 0x72,
+	0x39, 0x21, 0xa0, 0x75, 0x9e, 0x97, 0x64, 0xc3, 0xaf, 0x57, 0xda, 0x24, 0xa4, 0xa3, 0x8f, 0x15,
+	0x50, 0xb3, 0x7b, 0x46, 0xd0, 0x42, 0x27, 0x48, 0xf2, 0x76, 0x16, 0xf5, 0x52, 0x57, 0x32, 0x79,
+	0xcb, 0xa6, 0x11, 0x08, 0x94, 0xde, 0x60, 0xe7, 0xcf, 0x9b, 0xe8, 0x17, 0x0a, 0x0c, 0xc8, 0x2a,
+	0xbe, 0xe8, 0x82, 0xd4, 0x6c, 0x46, 0x01, 0x5a, 0x9d, 0x2d, 0xc8, 0xcd, 0xe0, 0x5d, 0x22, 0xf0,
+	0x66, 0xd1, 0x4c, 0x12, 0x9e, 0xe3, 0x1a, 0xd5, 0x06, 0x2e, 0x91, 0xc7, 0x16, 0xd9, 0x71, 0x02,
+	0x54, 0x0f, 0xfa, 0xc2, 0xbe, 0x27, 0x74, 0x26, 0x65, 0x30, 0xd1, 0x87, 0xa5, 0x8e, 0x75, 0xe0,
+	0x60, 0x30, 0xc6, 0x08, 0x8c, 0xd3, 0xe8, 0x94, 0x74, 0xa6, 0x83, 0xe7, 0x36, 0xfa, 0x81, 0x02,
+	0x47, 0x53, 0x5d, 0x35, 0x68, 0x2a, 0xa5, 0x3b, 0xab, 0x89, 0x47, 0x9d, 0x2e, 0xc2, 0x9a, 0x17,
+	0x86, 0xe8, 0xca, 0x73, 0x98, 0xa0, 0xff, 0x10, 0xfd, 0x58, 0x01, 0x94, 0xee, 0xa0, 0x41, 0xd9,
+	0xc6, 0x52, 0x2d, 0x3b, 0xea, 0x4c, 0x21, 0x5e, 0x86, 0x6c, 0x86, 0x20, 0x9b, 0x40, 0xe3, 0x9d,
+	0x91, 0x91, 0xd5, 0x15, 0x84, 0xf1, 0x63, 0x92, 0x8e, 0x17, 0x34, 0x23, 0x9f, 0x11, 0x69, 0x97,
+	0x8e, 0x7a, 0xa1, 0x18, 0x33, 0xc3, 0x37, 0x47, 0xf0, 0x9d, 0x47, 0x93, 0x72, 0x7c, 0xc2, 0x36,
+	0xa5, 0xd7, 0xe7, 0xe0, 0xc8, 0x8b, 0xb5, 0xab, 0x48, 0x8e, 0x3c, 0x59, 0x5b, 0x8d, 0x3a, 0x99,
+	0xc7, 0x96, 0x77, 0xe4, 0x51, 0x40, 0xfc, 0x5c, 0x21, 0x40, 0x62, 0xad, 0x23, 0x12, 0x20, 0xb2,
+	0xce, 0x17, 0x75, 0x32, 0x8f, 0x2d, 0x0f, 0x08, 0x8d, 0x04, 0x21, 0x90, 0x1f, 0x2a, 0xd0, 0x2f,
+	0x36, 0x56, 0xa0, 0xb3, 0x29, 0x03, 0x92, 0x9e, 0x0e, 0x75, 0x22, 0x87, 0x8b, 0xa1, 0xf8, 0x2f,
+	0x82, 0x62, 0x01, 0x5d, 0x4c, 0x1f, 0xb0, 0x89, 0x0e, 0x87, 0x12, 0x69, 0x7e, 0xd0, 0x7d, 0x47,
+	0xa7, 0x2d, 0x16, 0x01, 0x2e, 0xb1, 0x69, 0x42, 0x82, 0x4b, 0xd2, 0xaf, 0xa1, 0x4e, 0xe4, 0x70,
+	0x75, 0x8f, 0x8b, 0xc0, 0x09, 0x70, 0x11, 0x80, 0xe8, 0x23, 0x05, 0x4e, 0xae, 0x60, 0x5f, 0xd6,
+	0x03, 0x91, 0x11, 0x3b, 0x33, 0xda, 0x32, 0xd4, 0xd9, 0x82, 0xdc, 0x0c, 0xf2, 0x63, 0x04, 0x72,
+	0x09, 0xcd, 0x26, 0x21, 0x93, 0xff, 0x1f, 0xa0, 0x93, 0xe3, 0xc9, 0x61, 0xc2, 0xe4, 0xad, 0x41,
+	0x3a, 0x2f, 0x32, 0xf0, 0xd2, 0x8d, 0x99, 0x8b, 0x37, 0xb6, 0x33, 0x67, 0x0b, 0x72, 0xef, 0x14,
+	0x2f, 0xdd, 0xa1, 0xef, 0x29, 0x70, 0x78, 0x05, 0xfb, 0x62, 0x1a, 0x49, 0x32, 0xf5, 0x92, 0x7c,
+	0x98, 0x3a, 0x91, 0xc3, 0xc5, 0x70, 0x4d, 0x13, 0x5c, 0x67, 0x91, 0x26, 0xc7, 0x25, 0xa6, 0xbf,
+	0xd0, 0x1f, 0x14, 0x38, 0xb5, 0x82, 0x7d, 0xa1, 0x94, 0x2d, 0x74, 0x1d, 0xa0, 0x92, 0x64, 0xad,
+	0x75, 0xea, 0x4f, 0x50, 0xaf, 0x76, 0x29, 0x90, 0xbf, 0x5c, 0x29, 0xe6, 0xf0, 0x89, 0xb9, 0x89,
+	0xdb, 0x5e, 0x10, 0xec, 0xc2, 0xaa, 0x39, 0xfa, 0xb9, 0x02, 0xc7, 0x92, 0x1e, 0x04, 0xc5, 0xf0,
+	0xa9, 0x1c, 0x28, 0xd1, 0x8b, 0x54, 0x9d, 0x2f, 0xcc, 0x1a, 0xe2, 0x5d, 0x20, 0x78, 0x2f, 0xa0,
+	0xe9, 0x82, 0x78, 0xb1, 0x5f, 0x47, 0x7f, 0x52, 0x60, 0x28, 0x89, 0x54, 0xec, 0x1a, 0x90, 0x5c,
+	0xa2, 0x72, 0x5b, 0x0c, 0xd4, 0x6b, 0xdd, 0xcb, 0x84, 0x4e, 0x3c, 0x41, 0x9c, 0x78, 0x0c, 0x5d,
+	0x2a, 0xe8, 0x44, 0x2c, 0xc1, 0xf0, 0x01, 0x1d, 0xf7, 0x54, 0x13, 0x42, 0xfa, 0x76, 0x92, 0x64,
+	0x51, 0xa7, 0x72, 0x59, 0x42, 0x88, 0xf3, 0x04, 0xe2, 0x0c, 0x9a, 0x92, 0x43, 0xe4, 0xb7, 0x55,
+	0x0f, 0xdb, 0x26, 0x89, 0x60, 0x7e, 0x1d, 0x7d, 0x4c, 0x97, 0x74, 0x46, 0x89, 0xff, 0x5c, 0x96,
+	0xed, 0x04, 0xa3, 0x5a, 0x2a, 0xc8, 0x18, 0x42, 0xbd, 0x4a, 0xa0, 0xce, 0xa3, 0x52, 0x67, 0xa8,
+	0xa9, 0x82, 0x3f, 0xfa, 0x8e, 0x02, 0x47, 0x82, 0x00, 0x16, 0x2b, 0xd7, 0xa7, 0x93, 0x04, 0xb1,
+	0xef, 0xea, 0x64, 0xe7, 0xef, 0x21, 0xaa, 0x59, 0x82, 0xea, 0x1c, 0x9a, 0xc8, 0x08, 0x52, 0x96,
+	0xe7, 0xeb, 0x51, 0x8d, 0x1f, 0xfd, 0x5a, 0x01, 0x75, 0x05, 0xfb, 0x99, 0x55, 0xfb, 0x94, 0xd5,
+	0x0c, 0x4e, 0xf5, 0x62, 0x51, 0xce, 0xa2, 0xe3, 0xd7, 0xe4, 0xe2, 0x3a, 0x4d, 0x0f, 0x86, 0xa5,
+	0x7d, 0xf4, 0x11, 0x9d, 0xf0, 0x8c, 0xc2, 0x7e, 0x7a, 0xc2, 0xe5, 0x8c, 0x6a, 0xa9, 0x20, 0x63,
+	0x08, 0xf8, 0x0a, 0x01, 0x7c, 0x11, 0xcd, 0xc9, 0x01, 0xb3, 0xda, 0x56, 0x85, 0x8a, 0xeb, 0x61,
+	0x7d, 0x1e, 0xfd, 0x5d, 0x81, 0xb3, 0x59, 0x78, 0x63, 0xe5, 0xf9, 0x85, 0x62, 0x88, 0x44, 0x19,
+	0xf5, 0x5a, 0xf7, 0x32, 0xa1, 0x43, 0x37, 0x88, 0x43, 0x4f, 0xa2, 0xff, 0xee, 0xca, 0x21, 0x12,
+	0xde, 0xa2, 0xb2, 0x12, 0x7a, 0x47, 0x81, 0x83, 0x2b, 0xd8, 0x8f, 0x4a, 0xaa, 0x48, 0x4b, 0x61,
+	0x4a, 0xd5, 0xef, 0xd5, 0xf1, 0x8e, 0x3c, 0x0c, 0xe0, 0x14, 0x01, 0x38, 0x8e, 0xc6, 0xe4, 0x00,
+	0x85, 0x7a, 0x2d, 0xfa, 0x90, 0x86, 0xa7, 0x64, 0xed, 0x53, 0xb2, 0x82, 0x33, 0x8a, 0xb3, 0xea,
+	0x54, 0x01, 0xce, 0x62, 0x51, 0x2a, 0x28, 0xb4, 0x86, 0x21, 0x8a, 0xde, 0xbf, 0xd0, 0xfb, 0x74,
+	0xd3, 0xc7, 0x2a, 0xa3, 0x92, 0x1b, 0xb2, 0xac, 0x2c, 0xab, 0x4e, 0xe6, 0xb1, 0x15, 0xdb, 0xfb,
+	0x41, 0x24, 0x12, 0x0a, 0xb0, 0xe8, 0x67, 0x0a, 0x1c, 0xa7, 0x90, 0x12, 0x95, 0x4c, 0xc9, 0xeb,
+	0x2b, 0xb3, 0xe2, 0xaa, 0xce, 0x14, 0xe2, 0xcd, 0x7b, 0x2e, 0x47, 0x08, 0xc5, 0x58, 0xa9, 0x07,
+	0x65, 0x51, 0xf4, 0x1b, 0xba, 0xdf, 0xe5, 0x65, 0x46, 0x34, 0x97, 0x63, 0x3f, 0x51, 0x3e, 0x55,
+	0x4b, 0x85, 0xf9, 0x8b, 0xc5, 0xa9, 0x14, 0x66, 0x5e, 0xf0, 0x44, 0x3f, 0xa5, 0xe3, 0x9b, 0xae,
+	0x51, 0x4a, 0xc6, 0x37, 0xb3, 0x28, 0xaa, 0xce, 0x14, 0xe2, 0x2d, 0xb6, 0x30, 0x25, 0x05, 0x51,
+	0xf4, 0x2b, 0x7a, 0x9d, 0x96, 0x95, 0x10, 0x25, 0xd7, 0xe9, 0x0e, 0x75, 0x4f, 0x75, 0xb6, 0x20,
+	0x37, 0xc3, 0x7a, 0x99, 0x60, 0x9d, 0x43, 0x17, 0xe4, 0x58, 0xa3, 0x44, 0xbf, 0x58, 0xc1, 0x44,
+	0x6f, 0x41, 0x7f, 0x10, 0x4b, 0x79, 0xc9, 0x50, 0x72, 0xfd, 0x48, 0xd6, 0x36, 0x55, 0xad, 0x13,
+	0x4b, 0x5e, 0x9a, 0x89, 0x85, 0xc2, 0xd0, 0xde, 0x8f, 0x14, 0x40, 0x01, 0x80, 0x78, 0x0d, 0x51,
+	0x76, 0xea, 0x48, 0xeb, 0x95, 0xea, 0xf9, 0x7c, 0xc6, 0xbc, 0x4c, 0x00, 0x83, 0x44, 0x0b, 0x8c,
+	0x11, 0x84, 0xef, 0xd1, 0x08, 0x13, 0x2b, 0x05, 0xca, 0x92, 0x01, 0x92, 0x8a, 0xa3, 0x3a, 0x99,
+	0xc7, 0xc6, 0x30, 0x5d, 0x20, 0x98, 0x26, 0xd1, 0xd9, 0x4e, 0x27, 0x86, 0xa9, 0xd3, 0x4a, 0x22,
+	0x7a, 0x9b, 0x9e, 0x0c, 0x51, 0x41, 0x4b, 0x72, 0x32, 0xa4, 0x8a, 0x8c, 0xea, 0x78, 0x47, 0x9e,
+	0xbc, 0x04, 0x13, 0x05, 0x22, 0xd4, 0xe4, 0xd8, 0x84, 0x25, 0x8a, 0x3d, 0x92, 0x09, 0x93, 0xd7,
+	0x9c, 0xd4, 0xf3, 0xf9, 0x8c, 0xc5, 0x26, 0x0c, 0x33, 0x31, 0x9d, 0xd5, 0x8a, 0x16, 0xff, 0xef,
+	0x93, 0x2f, 0x46, 0x94, 0x4f, 0xbf, 0x18, 0x51, 0x3e, 0xff, 0x62, 0x44, 0x79, 0xff, 0xcb, 0x91,
+	0x5d, 0x9f, 0x7e, 0x39, 0xb2, 0xeb, 0xaf, 0x5f, 0x8e, 0xec, 0xba, 0xfb, 0x94, 0xd0, 0xe0, 0xb4,
+	0x42, 0x75, 0xcd, 0xd2, 0x09, 0x49, 0xfe, 0x6c, 0x3a, 0x66, 0xab, 0x81, 0x4b, 0x0f, 0x43, 0x93,
+	0xa4, 0xfb, 0xa9, 0xb2, 0x97, 0xfc, 0x37, 0xf4, 0x4b, 0xff, 0x1e, 0x00, 0x3b, 0x2a, 0x40, 0x0a,
+	0x76, 0x3f, 0x00, 0x00,
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Deployments queries deployments
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	CurrentValset(ctx context.Context, in *QueryCurrentValsetRequest, opts ...grpc.CallOption) (*QueryCurrentValsetResponse, error)
+	ValsetRequest(ctx context.Context, in *QueryValsetRequestRequest, opts ...grpc.CallOption) (*QueryValsetRequestResponse, error)
+	ValsetConfirm(ctx context.Context, in *QueryValsetConfirmRequest, opts ...grpc.CallOption) (*QueryValsetConfirmResponse, error)
+	ValsetConfirmsByNonce(ctx context.Context, in *QueryValsetConfirmsByNonceRequest, opts ...grpc.CallOption) (*QueryValsetConfirmsByNonceResponse, error)
+	LastValsetRequests(ctx context.Context, in *QueryLastValsetRequestsRequest, opts ...grpc.CallOption) (*QueryLastValsetRequestsResponse, error)
+	LastPendingValsetRequestByAddr(ctx context.Context, in *QueryLastPendingValsetRequestByAddrRequest, opts ...grpc.CallOption) (*QueryLastPendingValsetRequestByAddrResponse, error)
+	LastPendingBatchRequestByAddr(ctx context.Context, in *QueryLastPendingBatchRequestByAddrRequest, opts ...grpc.CallOption) (*QueryLastPendingBatchRequestByAddrResponse, error)
+	LastPendingLogicCallByAddr(ctx context.Context, in *QueryLastPendingLogicCallByAddrRequest, opts ...grpc.CallOption) (*QueryLastPendingLogicCallByAddrResponse, error)
+	LastEventNonceByAddr(ctx context.Context, in *QueryLastEventNonceByAddrRequest, opts ...grpc.CallOption) (*QueryLastEventNonceByAddrResponse, error)
+	BatchFees(ctx context.Context, in *QueryBatchFeeRequest, opts ...grpc.CallOption) (*QueryBatchFeeResponse, error)
+	OutgoingTxBatches(ctx context.Context, in *QueryOutgoingTxBatchesRequest, opts ...grpc.CallOption) (*QueryOutgoingTxBatchesResponse, error)
+	OutgoingLogicCalls(ctx context.Context, in *QueryOutgoingLogicCallsRequest, opts ...grpc.CallOption) (*QueryOutgoingLogicCallsResponse, error)
+	BatchRequestByNonce(ctx context.Context, in *QueryBatchRequestByNonceRequest, opts ...grpc.CallOption) (*QueryBatchRequestByNonceResponse, error)
+	BatchConfirms(ctx context.Context, in *QueryBatchConfirmsRequest, opts ...grpc.CallOption) (*QueryBatchConfirmsResponse, error)
+	LogicConfirms(ctx context.Context, in *QueryLogicConfirmsRequest, opts ...grpc.CallOption) (*QueryLogicConfirmsResponse, error)
+	ERC20ToDenom(ctx context.Context, in *QueryERC20ToDenomRequest, opts ...grpc.CallOption) (*QueryERC20ToDenomResponse, error)
+	DenomToERC20(ctx context.Context, in *QueryDenomToERC20Request, opts ...grpc.CallOption) (*QueryDenomToERC20Response, error)
+	GetLastObservedEthBlock(ctx context.Context, in *QueryLastObservedEthBlockRequest, opts ...grpc.CallOption) (*QueryLastObservedEthBlockResponse, error)
+	GetLastObservedEthNonce(ctx context.Context, in *QueryLastObservedEthNonceRequest, opts ...grpc.CallOption) (*QueryLastObservedEthNonceResponse, error)
+	GetAttestations(ctx context.Context, in *QueryAttestationsRequest, opts ...grpc.CallOption) (*QueryAttestationsResponse, error)
+	GetDelegateKeyByValidator(ctx context.Context, in *QueryDelegateKeysByValidatorAddress, opts ...grpc.CallOption) (*QueryDelegateKeysByValidatorAddressResponse, error)
+	GetDelegateKeyByEth(ctx context.Context, in *QueryDelegateKeysByEthAddress, opts ...grpc.CallOption) (*QueryDelegateKeysByEthAddressResponse, error)
+	GetDelegateKeyByOrchestrator(ctx context.Context, in *QueryDelegateKeysByOrchestratorAddress, opts ...grpc.CallOption) (*QueryDelegateKeysByOrchestratorAddressResponse, error)
+	GetPendingSendToEth(ctx context.Context, in *QueryPendingSendToEth, opts ...grpc.CallOption) (*QueryPendingSendToEthResponse, error)
+	GetPendingIbcAutoForwards(ctx context.Context, in *QueryPendingIbcAutoForwards, opts ...grpc.CallOption) (*QueryPendingIbcAutoForwardsResponse, error)
+	GetListEvmChains(ctx context.Context, in *QueryListEvmChains, opts ...grpc.CallOption) (*QueryListEvmChainsResponse, error)
+	GetMonitoredERC20Addresses(ctx context.Context, in *QueryMonitoredERC20Addresses, opts ...grpc.CallOption) (*QueryMonitoredERC20AddressesResponse, error)
+	GetBridgeBalanceSnapshots(ctx context.Context, in *QueryBridgeBalanceSnapshots, opts ...grpc.CallOption) (*QueryBridgeBalanceSnapshotsResponse, error)
+	GetBridgeBalanceSnapshotByEventNonce(ctx context.Context, in *QueryBridgeBalanceSnapshotByEventNonce, opts ...grpc.CallOption) (*QueryBridgeBalanceSnapshotByEventNonceResponse, error)
+	GetRateLimits(ctx context.Context, in *QueryRateLimitsRequest, opts ...grpc.CallOption) (*QueryRateLimitsResponse, error)
+	GetHeldSendToCosmos(ctx context.Context, in *QueryHeldSendToCosmosRequest, opts ...grpc.CallOption) (*QueryHeldSendToCosmosResponse, error)
+	GetIbcBridgeFees(ctx context.Context, in *QueryIbcBridgeFeesRequest, opts ...grpc.CallOption) (*QueryIbcBridgeFeesResponse, error)
+	GetIbcAutoForwardLogs(ctx context.Context, in *QueryIbcAutoForwardLogsRequest, opts ...grpc.CallOption) (*QueryIbcAutoForwardLogsResponse, error)
+	GetIbcAutoForwardTransfer(ctx context.Context, in *QueryIbcAutoForwardTransferRequest, opts ...grpc.CallOption) (*QueryIbcAutoForwardTransferResponse, error)
+	GetFailedAttestations(ctx context.Context, in *QueryFailedAttestationsRequest, opts ...grpc.CallOption) (*QueryFailedAttestationsResponse, error)
+	GetEvmChainDecommission(ctx context.Context, in *QueryEvmChainDecommissionRequest, opts ...grpc.CallOption) (*QueryEvmChainDecommissionResponse, error)
+	GetBlacklist(ctx context.Context, in *QueryBlacklistRequest, opts ...grpc.CallOption) (*QueryBlacklistResponse, error)
+	GetBatchStrategies(ctx context.Context, in *QueryBatchStrategiesRequest, opts ...grpc.CallOption) (*QueryBatchStrategiesResponse, error)
+	GetBridgedSupply(ctx context.Context, in *QueryBridgedSupplyRequest, opts ...grpc.CallOption) (*QueryBridgedSupplyResponse, error)
+	GetCheckpoint(ctx context.Context, in *QueryCheckpointRequest, opts ...grpc.CallOption) (*QueryCheckpointResponse, error)
+	GetEvidenceRecords(ctx context.Context, in *QueryEvidenceRecordsRequest, opts ...grpc.CallOption) (*QueryEvidenceRecordsResponse, error)
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type queryClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/Params", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) CurrentValset(ctx context.Context, in *QueryCurrentValsetRequest, opts ...grpc.CallOption) (*QueryCurrentValsetResponse, error) {
+	out := new(QueryCurrentValsetResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/CurrentValset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) ValsetRequest(ctx context.Context, in *QueryValsetRequestRequest, opts ...grpc.CallOption) (*QueryValsetRequestResponse, error) {
+	out := new(QueryValsetRequestResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/ValsetRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryCurrentValsetRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ValsetConfirm(ctx context.Context, in *QueryValsetConfirmRequest, opts ...grpc.CallOption) (*QueryValsetConfirmResponse, error) {
+	out := new(QueryValsetConfirmResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/ValsetConfirm", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryCurrentValsetRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ValsetConfirmsByNonce(ctx context.Context, in *QueryValsetConfirmsByNonceRequest, opts ...grpc.CallOption) (*QueryValsetConfirmsByNonceResponse, error) {
+	out := new(QueryValsetConfirmsByNonceResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/ValsetConfirmsByNonce", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryCurrentValsetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EvmChainPrefix) > 0 {
-		i -= len(m.EvmChainPrefix)
-		copy(dAtA[i:], m.EvmChainPrefix)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.EvmChainPrefix)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) LastValsetRequests(ctx context.Context, in *QueryLastValsetRequestsRequest, opts ...grpc.CallOption) (*QueryLastValsetRequestsResponse, error) {
+	out := new(QueryLastValsetRequestsResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/LastValsetRequests", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryCurrentValsetResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) LastPendingValsetRequestByAddr(ctx context.Context, in *QueryLastPendingValsetRequestByAddrRequest, opts ...grpc.CallOption) (*QueryLastPendingValsetRequestByAddrResponse, error) {
+	out := new(QueryLastPendingValsetRequestByAddrResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/LastPendingValsetRequestByAddr", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryCurrentValsetResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) LastPendingBatchRequestByAddr(ctx context.Context, in *QueryLastPendingBatchRequestByAddrRequest, opts ...grpc.CallOption) (*QueryLastPendingBatchRequestByAddrResponse, error) {
+	out := new(QueryLastPendingBatchRequestByAddrResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/LastPendingBatchRequestByAddr", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryCurrentValsetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Valset.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) LastPendingLogicCallByAddr(ctx context.Context, in *QueryLastPendingLogicCallByAddrRequest, opts ...grpc.CallOption) (*QueryLastPendingLogicCallByAddrResponse, error) {
+	out := new(QueryLastPendingLogicCallByAddrResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/LastPendingLogicCallByAddr", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryValsetRequestRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) LastEventNonceByAddr(ctx context.Context, in *QueryLastEventNonceByAddrRequest, opts ...grpc.CallOption) (*QueryLastEventNonceByAddrResponse, error) {
+	out := new(QueryLastEventNonceByAddrResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/LastEventNonceByAddr", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryValsetRequestRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) BatchFees(ctx context.Context, in *QueryBatchFeeRequest, opts ...grpc.CallOption) (*QueryBatchFeeResponse, error) {
+	out := new(QueryBatchFeeResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/BatchFees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryValsetRequestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EvmChainPrefix) > 0 {
-		i -= len(m.EvmChainPrefix)
-		copy(dAtA[i:], m.EvmChainPrefix)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.EvmChainPrefix)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Nonce != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) OutgoingTxBatches(ctx context.Context, in *QueryOutgoingTxBatchesRequest, opts ...grpc.CallOption) (*QueryOutgoingTxBatchesResponse, error) {
+	out := new(QueryOutgoingTxBatchesResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/OutgoingTxBatches", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryValsetRequestResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) OutgoingLogicCalls(ctx context.Context, in *QueryOutgoingLogicCallsRequest, opts ...grpc.CallOption) (*QueryOutgoingLogicCallsResponse, error) {
+	out := new(QueryOutgoingLogicCallsResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/OutgoingLogicCalls", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryValsetRequestResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) BatchRequestByNonce(ctx context.Context, in *QueryBatchRequestByNonceRequest, opts ...grpc.CallOption) (*QueryBatchRequestByNonceResponse, error) {
+	out := new(QueryBatchRequestByNonceResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/BatchRequestByNonce", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryValsetRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Valset != nil {
-		{
-			size, err := m.Valset.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) BatchConfirms(ctx context.Context, in *QueryBatchConfirmsRequest, opts ...grpc.CallOption) (*QueryBatchConfirmsResponse, error) {
+	out := new(QueryBatchConfirmsResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/BatchConfirms", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryValsetConfirmRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) LogicConfirms(ctx context.Context, in *QueryLogicConfirmsRequest, opts ...grpc.CallOption) (*QueryLogicConfirmsResponse, error) {
+	out := new(QueryLogicConfirmsResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/LogicConfirms", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryValsetConfirmRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ERC20ToDenom(ctx context.Context, in *QueryERC20ToDenomRequest, opts ...grpc.CallOption) (*QueryERC20ToDenomResponse, error) {
+	out := new(QueryERC20ToDenomResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/ERC20ToDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryValsetConfirmRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EvmChainPrefix) > 0 {
-		i -= len(m.EvmChainPrefix)
-		copy(dAtA[i:], m.EvmChainPrefix)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.EvmChainPrefix)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Nonce != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) DenomToERC20(ctx context.Context, in *QueryDenomToERC20Request, opts ...grpc.CallOption) (*QueryDenomToERC20Response, error) {
+	out := new(QueryDenomToERC20Response)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/DenomToERC20", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryValsetConfirmResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetLastObservedEthBlock(ctx context.Context, in *QueryLastObservedEthBlockRequest, opts ...grpc.CallOption) (*QueryLastObservedEthBlockResponse, error) {
+	out := new(QueryLastObservedEthBlockResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/GetLastObservedEthBlock", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryValsetConfirmResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetLastObservedEthNonce(ctx context.Context, in *QueryLastObservedEthNonceRequest, opts ...grpc.CallOption) (*QueryLastObservedEthNonceResponse, error) {
+	out := new(QueryLastObservedEthNonceResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/GetLastObservedEthNonce", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryValsetConfirmResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Confirm != nil {
-		{
-			size, err := m.Confirm.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) GetAttestations(ctx context.Context, in *QueryAttestationsRequest, opts ...grpc.CallOption) (*QueryAttestationsResponse, error) {
+	out := new(QueryAttestationsResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/GetAttestations", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryValsetConfirmsByNonceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetDelegateKeyByValidator(ctx context.Context, in *QueryDelegateKeysByValidatorAddress, opts ...grpc.CallOption) (*QueryDelegateKeysByValidatorAddressResponse, error) {
+	out := new(QueryDelegateKeysByValidatorAddressResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/GetDelegateKeyByValidator", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryValsetConfirmsByNonceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetDelegateKeyByEth(ctx context.Context, in *QueryDelegateKeysByEthAddress, opts ...grpc.CallOption) (*QueryDelegateKeysByEthAddressResponse, error) {
+	out := new(QueryDelegateKeysByEthAddressResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/GetDelegateKeyByEth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryValsetConfirmsByNonceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EvmChainPrefix) > 0 {
-		i -= len(m.EvmChainPrefix)
-		copy(dAtA[i:], m.EvmChainPrefix)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.EvmChainPrefix)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Nonce != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) GetDelegateKeyByOrchestrator(ctx context.Context, in *QueryDelegateKeysByOrchestratorAddress, opts ...grpc.CallOption) (*QueryDelegateKeysByOrchestratorAddressResponse, error) {
+	out := new(QueryDelegateKeysByOrchestratorAddressResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/GetDelegateKeyByOrchestrator", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryValsetConfirmsByNonceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetPendingSendToEth(ctx context.Context, in *QueryPendingSendToEth, opts ...grpc.CallOption) (*QueryPendingSendToEthResponse, error) {
+	out := new(QueryPendingSendToEthResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/GetPendingSendToEth", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryValsetConfirmsByNonceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryValsetConfirmsByNonceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Confirms) > 0 {
-		for iNdEx := len(m.Confirms) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Confirms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) GetPendingIbcAutoForwards(ctx context.Context, in *QueryPendingIbcAutoForwards, opts ...grpc.CallOption) (*QueryPendingIbcAutoForwardsResponse, error) {
+	out := new(QueryPendingIbcAutoForwardsResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/GetPendingIbcAutoForwards", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryLastValsetRequestsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetListEvmChains(ctx context.Context, in *QueryListEvmChains, opts ...grpc.CallOption) (*QueryListEvmChainsResponse, error) {
+	out := new(QueryListEvmChainsResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/GetListEvmChains", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryLastValsetRequestsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryLastValsetRequestsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EvmChainPrefix) > 0 {
-		i -= len(m.EvmChainPrefix)
-		copy(dAtA[i:], m.EvmChainPrefix)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.EvmChainPrefix)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) GetMonitoredERC20Addresses(ctx context.Context, in *QueryMonitoredERC20Addresses, opts ...grpc.CallOption) (*QueryMonitoredERC20AddressesResponse, error) {
+	out := new(QueryMonitoredERC20AddressesResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/GetMonitoredERC20Addresses", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryLastValsetRequestsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetBridgeBalanceSnapshots(ctx context.Context, in *QueryBridgeBalanceSnapshots, opts ...grpc.CallOption) (*QueryBridgeBalanceSnapshotsResponse, error) {
+	out := new(QueryBridgeBalanceSnapshotsResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/GetBridgeBalanceSnapshots", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryLastValsetRequestsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryLastValsetRequestsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Valsets) > 0 {
-		for iNdEx := len(m.Valsets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Valsets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) GetBridgeBalanceSnapshotByEventNonce(ctx context.Context, in *QueryBridgeBalanceSnapshotByEventNonce, opts ...grpc.CallOption) (*QueryBridgeBalanceSnapshotByEventNonceResponse, error) {
+	out := new(QueryBridgeBalanceSnapshotByEventNonceResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/GetBridgeBalanceSnapshotByEventNonce", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryLastPendingValsetRequestByAddrRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetRateLimits(ctx context.Context, in *QueryRateLimitsRequest, opts ...grpc.CallOption) (*QueryRateLimitsResponse, error) {
+	out := new(QueryRateLimitsResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/GetRateLimits", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryLastPendingValsetRequestByAddrRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryLastPendingValsetRequestByAddrRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EvmChainPrefix) > 0 {
-		i -= len(m.EvmChainPrefix)
-		copy(dAtA[i:], m.EvmChainPrefix)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.EvmChainPrefix)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) GetHeldSendToCosmos(ctx context.Context, in *QueryHeldSendToCosmosRequest, opts ...grpc.CallOption) (*QueryHeldSendToCosmosResponse, error) {
+	out := new(QueryHeldSendToCosmosResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/GetHeldSendToCosmos", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryLastPendingValsetRequestByAddrResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetIbcBridgeFees(ctx context.Context, in *QueryIbcBridgeFeesRequest, opts ...grpc.CallOption) (*QueryIbcBridgeFeesResponse, error) {
+	out := new(QueryIbcBridgeFeesResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/GetIbcBridgeFees", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryLastPendingValsetRequestByAddrResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryLastPendingValsetRequestByAddrResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Valsets) > 0 {
-		for iNdEx := len(m.Valsets) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Valsets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) GetIbcAutoForwardLogs(ctx context.Context, in *QueryIbcAutoForwardLogsRequest, opts ...grpc.CallOption) (*QueryIbcAutoForwardLogsResponse, error) {
+	out := new(QueryIbcAutoForwardLogsResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/GetIbcAutoForwardLogs", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryBatchFeeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetIbcAutoForwardTransfer(ctx context.Context, in *QueryIbcAutoForwardTransferRequest, opts ...grpc.CallOption) (*QueryIbcAutoForwardTransferResponse, error) {
+	out := new(QueryIbcAutoForwardTransferResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/GetIbcAutoForwardTransfer", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryBatchFeeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetFailedAttestations(ctx context.Context, in *QueryFailedAttestationsRequest, opts ...grpc.CallOption) (*QueryFailedAttestationsResponse, error) {
+	out := new(QueryFailedAttestationsResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/GetFailedAttestations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryBatchFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EvmChainPrefix) > 0 {
-		i -= len(m.EvmChainPrefix)
-		copy(dAtA[i:], m.EvmChainPrefix)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.EvmChainPrefix)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) GetEvmChainDecommission(ctx context.Context, in *QueryEvmChainDecommissionRequest, opts ...grpc.CallOption) (*QueryEvmChainDecommissionResponse, error) {
+	out := new(QueryEvmChainDecommissionResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/GetEvmChainDecommission", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryBatchFeeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetBlacklist(ctx context.Context, in *QueryBlacklistRequest, opts ...grpc.CallOption) (*QueryBlacklistResponse, error) {
+	out := new(QueryBlacklistResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/GetBlacklist", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryBatchFeeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetBatchStrategies(ctx context.Context, in *QueryBatchStrategiesRequest, opts ...grpc.CallOption) (*QueryBatchStrategiesResponse, error) {
+	out := new(QueryBatchStrategiesResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/GetBatchStrategies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryBatchFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.BatchFees) > 0 {
-		for iNdEx := len(m.BatchFees) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.BatchFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) GetBridgedSupply(ctx context.Context, in *QueryBridgedSupplyRequest, opts ...grpc.CallOption) (*QueryBridgedSupplyResponse, error) {
+	out := new(QueryBridgedSupplyResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/GetBridgedSupply", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryLastPendingBatchRequestByAddrRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetCheckpoint(ctx context.Context, in *QueryCheckpointRequest, opts ...grpc.CallOption) (*QueryCheckpointResponse, error) {
+	out := new(QueryCheckpointResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/GetCheckpoint", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryLastPendingBatchRequestByAddrRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetEvidenceRecords(ctx context.Context, in *QueryEvidenceRecordsRequest, opts ...grpc.CallOption) (*QueryEvidenceRecordsResponse, error) {
+	out := new(QueryEvidenceRecordsResponse)
+	err := c.cc.Invoke(ctx, "/gravity.v1.Query/GetEvidenceRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryLastPendingBatchRequestByAddrRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EvmChainPrefix) > 0 {
-		i -= len(m.EvmChainPrefix)
-		copy(dAtA[i:], m.EvmChainPrefix)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.EvmChainPrefix)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Deployments queries deployments
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	CurrentValset(context.Context, *QueryCurrentValsetRequest) (*QueryCurrentValsetResponse, error)
+	ValsetRequest(context.Context, *QueryValsetRequestRequest) (*QueryValsetRequestResponse, error)
+	ValsetConfirm(context.Context, *QueryValsetConfirmRequest) (*QueryValsetConfirmResponse, error)
+	ValsetConfirmsByNonce(context.Context, *QueryValsetConfirmsByNonceRequest) (*QueryValsetConfirmsByNonceResponse, error)
+	LastValsetRequests(context.Context, *QueryLastValsetRequestsRequest) (*QueryLastValsetRequestsResponse, error)
+	LastPendingValsetRequestByAddr(context.Context, *QueryLastPendingValsetRequestByAddrRequest) (*QueryLastPendingValsetRequestByAddrResponse, error)
+	LastPendingBatchRequestByAddr(context.Context, *QueryLastPendingBatchRequestByAddrRequest) (*QueryLastPendingBatchRequestByAddrResponse, error)
+	LastPendingLogicCallByAddr(context.Context, *QueryLastPendingLogicCallByAddrRequest) (*QueryLastPendingLogicCallByAddrResponse, error)
+	LastEventNonceByAddr(context.Context, *QueryLastEventNonceByAddrRequest) (*QueryLastEventNonceByAddrResponse, error)
+	BatchFees(context.Context, *QueryBatchFeeRequest) (*QueryBatchFeeResponse, error)
+	OutgoingTxBatches(context.Context, *QueryOutgoingTxBatchesRequest) (*QueryOutgoingTxBatchesResponse, error)
+	OutgoingLogicCalls(context.Context, *QueryOutgoingLogicCallsRequest) (*QueryOutgoingLogicCallsResponse, error)
+	BatchRequestByNonce(context.Context, *QueryBatchRequestByNonceRequest) (*QueryBatchRequestByNonceResponse, error)
+	BatchConfirms(context.Context, *QueryBatchConfirmsRequest) (*QueryBatchConfirmsResponse, error)
+	LogicConfirms(context.Context, *QueryLogicConfirmsRequest) (*QueryLogicConfirmsResponse, error)
+	ERC20ToDenom(context.Context, *QueryERC20ToDenomRequest) (*QueryERC20ToDenomResponse, error)
+	DenomToERC20(context.Context, *QueryDenomToERC20Request) (*QueryDenomToERC20Response, error)
+	GetLastObservedEthBlock(context.Context, *QueryLastObservedEthBlockRequest) (*QueryLastObservedEthBlockResponse, error)
+	GetLastObservedEthNonce(context.Context, *QueryLastObservedEthNonceRequest) (*QueryLastObservedEthNonceResponse, error)
+	GetAttestations(context.Context, *QueryAttestationsRequest) (*QueryAttestationsResponse, error)
+	GetDelegateKeyByValidator(context.Context, *QueryDelegateKeysByValidatorAddress) (*QueryDelegateKeysByValidatorAddressResponse, error)
+	GetDelegateKeyByEth(context.Context, *QueryDelegateKeysByEthAddress) (*QueryDelegateKeysByEthAddressResponse, error)
+	GetDelegateKeyByOrchestrator(context.Context, *QueryDelegateKeysByOrchestratorAddress) (*QueryDelegateKeysByOrchestratorAddressResponse, error)
+	GetPendingSendToEth(context.Context, *QueryPendingSendToEth) (*QueryPendingSendToEthResponse, error)
+	GetPendingIbcAutoForwards(context.Context, *QueryPendingIbcAutoForwards) (*QueryPendingIbcAutoForwardsResponse, error)
+	GetListEvmChains(context.Context, *QueryListEvmChains) (*QueryListEvmChainsResponse, error)
+	GetMonitoredERC20Addresses(context.Context, *QueryMonitoredERC20Addresses) (*QueryMonitoredERC20AddressesResponse, error)
+	GetBridgeBalanceSnapshots(context.Context, *QueryBridgeBalanceSnapshots) (*QueryBridgeBalanceSnapshotsResponse, error)
+	GetBridgeBalanceSnapshotByEventNonce(context.Context, *QueryBridgeBalanceSnapshotByEventNonce) (*QueryBridgeBalanceSnapshotByEventNonceResponse, error)
+	GetRateLimits(context.Context, *QueryRateLimitsRequest) (*QueryRateLimitsResponse, error)
+	GetHeldSendToCosmos(context.Context, *QueryHeldSendToCosmosRequest) (*QueryHeldSendToCosmosResponse, error)
+	GetIbcBridgeFees(context.Context, *QueryIbcBridgeFeesRequest) (*QueryIbcBridgeFeesResponse, error)
+	GetIbcAutoForwardLogs(context.Context, *QueryIbcAutoForwardLogsRequest) (*QueryIbcAutoForwardLogsResponse, error)
+	GetIbcAutoForwardTransfer(context.Context, *QueryIbcAutoForwardTransferRequest) (*QueryIbcAutoForwardTransferResponse, error)
+	GetFailedAttestations(context.Context, *QueryFailedAttestationsRequest) (*QueryFailedAttestationsResponse, error)
+	GetEvmChainDecommission(context.Context, *QueryEvmChainDecommissionRequest) (*QueryEvmChainDecommissionResponse, error)
+	GetBlacklist(context.Context, *QueryBlacklistRequest) (*QueryBlacklistResponse, error)
+	GetBatchStrategies(context.Context, *QueryBatchStrategiesRequest) (*QueryBatchStrategiesResponse, error)
+	GetBridgedSupply(context.Context, *QueryBridgedSupplyRequest) (*QueryBridgedSupplyResponse, error)
+	GetCheckpoint(context.Context, *QueryCheckpointRequest) (*QueryCheckpointResponse, error)
+	GetEvidenceRecords(context.Context, *QueryEvidenceRecordsRequest) (*QueryEvidenceRecordsResponse, error)
 }
 
-func (m *QueryLastPendingBatchRequestByAddrResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryLastPendingBatchRequestByAddrResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
-
-func (m *QueryLastPendingBatchRequestByAddrResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Batch) > 0 {
-		for iNdEx := len(m.Batch) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Batch[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) CurrentValset(ctx context.Context, req *QueryCurrentValsetRequest) (*QueryCurrentValsetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CurrentValset not implemented")
 }
-
-func (m *QueryLastPendingLogicCallByAddrRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) ValsetRequest(ctx context.Context, req *QueryValsetRequestRequest) (*QueryValsetRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValsetRequest not implemented")
 }
-
-func (m *QueryLastPendingLogicCallByAddrRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) ValsetConfirm(ctx context.Context, req *QueryValsetConfirmRequest) (*QueryValsetConfirmResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValsetConfirm not implemented")
 }
-
-func (m *QueryLastPendingLogicCallByAddrRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EvmChainPrefix) > 0 {
-		i -= len(m.EvmChainPrefix)
-		copy(dAtA[i:], m.EvmChainPrefix)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.EvmChainPrefix)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) ValsetConfirmsByNonce(ctx context.Context, req *QueryValsetConfirmsByNonceRequest) (*QueryValsetConfirmsByNonceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValsetConfirmsByNonce not implemented")
 }
-
-func (m *QueryLastPendingLogicCallByAddrResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) LastValsetRequests(ctx context.Context, req *QueryLastValsetRequestsRequest) (*QueryLastValsetRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LastValsetRequests not implemented")
 }
-
-func (m *QueryLastPendingLogicCallByAddrResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) LastPendingValsetRequestByAddr(ctx context.Context, req *QueryLastPendingValsetRequestByAddrRequest) (*QueryLastPendingValsetRequestByAddrResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LastPendingValsetRequestByAddr not implemented")
 }
-
-func (m *QueryLastPendingLogicCallByAddrResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Call) > 0 {
-		for iNdEx := len(m.Call) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Call[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) LastPendingBatchRequestByAddr(ctx context.Context, req *QueryLastPendingBatchRequestByAddrRequest) (*QueryLastPendingBatchRequestByAddrResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LastPendingBatchRequestByAddr not implemented")
 }
-
-func (m *QueryOutgoingTxBatchesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) LastPendingLogicCallByAddr(ctx context.Context, req *QueryLastPendingLogicCallByAddrRequest) (*QueryLastPendingLogicCallByAddrResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LastPendingLogicCallByAddr not implemented")
 }
-
-func (m *QueryOutgoingTxBatchesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) LastEventNonceByAddr(ctx context.Context, req *QueryLastEventNonceByAddrRequest) (*QueryLastEventNonceByAddrResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LastEventNonceByAddr not implemented")
 }
-
-func (m *QueryOutgoingTxBatchesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EvmChainPrefix) > 0 {
-		i -= len(m.EvmChainPrefix)
-		copy(dAtA[i:], m.EvmChainPrefix)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.EvmChainPrefix)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) BatchFees(ctx context.Context, req *QueryBatchFeeRequest) (*QueryBatchFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchFees not implemented")
 }
-
-func (m *QueryOutgoingTxBatchesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) OutgoingTxBatches(ctx context.Context, req *QueryOutgoingTxBatchesRequest) (*QueryOutgoingTxBatchesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OutgoingTxBatches not implemented")
 }
-
-func (m *QueryOutgoingTxBatchesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) OutgoingLogicCalls(ctx context.Context, req *QueryOutgoingLogicCallsRequest) (*QueryOutgoingLogicCallsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OutgoingLogicCalls not implemented")
 }
-
-func (m *QueryOutgoingTxBatchesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Batches) > 0 {
-		for iNdEx := len(m.Batches) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Batches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) BatchRequestByNonce(ctx context.Context, req *QueryBatchRequestByNonceRequest) (*QueryBatchRequestByNonceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchRequestByNonce not implemented")
 }
-
-func (m *QueryOutgoingLogicCallsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) BatchConfirms(ctx context.Context, req *QueryBatchConfirmsRequest) (*QueryBatchConfirmsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchConfirms not implemented")
 }
-
-func (m *QueryOutgoingLogicCallsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) LogicConfirms(ctx context.Context, req *QueryLogicConfirmsRequest) (*QueryLogicConfirmsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LogicConfirms not implemented")
 }
-
-func (m *QueryOutgoingLogicCallsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EvmChainPrefix) > 0 {
-		i -= len(m.EvmChainPrefix)
-		copy(dAtA[i:], m.EvmChainPrefix)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.EvmChainPrefix)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) ERC20ToDenom(ctx context.Context, req *QueryERC20ToDenomRequest) (*QueryERC20ToDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ERC20ToDenom not implemented")
 }
-
-func (m *QueryOutgoingLogicCallsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) DenomToERC20(ctx context.Context, req *QueryDenomToERC20Request) (*QueryDenomToERC20Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomToERC20 not implemented")
 }
-
-func (m *QueryOutgoingLogicCallsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) GetLastObservedEthBlock(ctx context.Context, req *QueryLastObservedEthBlockRequest) (*QueryLastObservedEthBlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLastObservedEthBlock not implemented")
+}
+func (*UnimplementedQueryServer) GetLastObservedEthNonce(ctx context.Context, req *QueryLastObservedEthNonceRequest) (*QueryLastObservedEthNonceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLastObservedEthNonce not implemented")
+}
+func (*UnimplementedQueryServer) GetAttestations(ctx context.Context, req *QueryAttestationsRequest) (*QueryAttestationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAttestations not implemented")
+}
+func (*UnimplementedQueryServer) GetDelegateKeyByValidator(ctx context.Context, req *QueryDelegateKeysByValidatorAddress) (*QueryDelegateKeysByValidatorAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDelegateKeyByValidator not implemented")
+}
+func (*UnimplementedQueryServer) GetDelegateKeyByEth(ctx context.Context, req *QueryDelegateKeysByEthAddress) (*QueryDelegateKeysByEthAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDelegateKeyByEth not implemented")
+}
+func (*UnimplementedQueryServer) GetDelegateKeyByOrchestrator(ctx context.Context, req *QueryDelegateKeysByOrchestratorAddress) (*QueryDelegateKeysByOrchestratorAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDelegateKeyByOrchestrator not implemented")
+}
+func (*UnimplementedQueryServer) GetPendingSendToEth(ctx context.Context, req *QueryPendingSendToEth) (*QueryPendingSendToEthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPendingSendToEth not implemented")
+}
+func (*UnimplementedQueryServer) GetPendingIbcAutoForwards(ctx context.Context, req *QueryPendingIbcAutoForwards) (*QueryPendingIbcAutoForwardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPendingIbcAutoForwards not implemented")
+}
+func (*UnimplementedQueryServer) GetListEvmChains(ctx context.Context, req *QueryListEvmChains) (*QueryListEvmChainsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetListEvmChains not implemented")
+}
+func (*UnimplementedQueryServer) GetMonitoredERC20Addresses(ctx context.Context, req *QueryMonitoredERC20Addresses) (*QueryMonitoredERC20AddressesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMonitoredERC20Addresses not implemented")
+}
+func (*UnimplementedQueryServer) GetBridgeBalanceSnapshots(ctx context.Context, req *QueryBridgeBalanceSnapshots) (*QueryBridgeBalanceSnapshotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBridgeBalanceSnapshots not implemented")
+}
+func (*UnimplementedQueryServer) GetBridgeBalanceSnapshotByEventNonce(ctx context.Context, req *QueryBridgeBalanceSnapshotByEventNonce) (*QueryBridgeBalanceSnapshotByEventNonceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBridgeBalanceSnapshotByEventNonce not implemented")
+}
+func (*UnimplementedQueryServer) GetRateLimits(ctx context.Context, req *QueryRateLimitsRequest) (*QueryRateLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRateLimits not implemented")
+}
+func (*UnimplementedQueryServer) GetHeldSendToCosmos(ctx context.Context, req *QueryHeldSendToCosmosRequest) (*QueryHeldSendToCosmosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHeldSendToCosmos not implemented")
+}
+func (*UnimplementedQueryServer) GetIbcBridgeFees(ctx context.Context, req *QueryIbcBridgeFeesRequest) (*QueryIbcBridgeFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIbcBridgeFees not implemented")
+}
+func (*UnimplementedQueryServer) GetIbcAutoForwardLogs(ctx context.Context, req *QueryIbcAutoForwardLogsRequest) (*QueryIbcAutoForwardLogsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIbcAutoForwardLogs not implemented")
+}
+func (*UnimplementedQueryServer) GetIbcAutoForwardTransfer(ctx context.Context, req *QueryIbcAutoForwardTransferRequest) (*QueryIbcAutoForwardTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetIbcAutoForwardTransfer not implemented")
+}
+func (*UnimplementedQueryServer) GetFailedAttestations(ctx context.Context, req *QueryFailedAttestationsRequest) (*QueryFailedAttestationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFailedAttestations not implemented")
+}
+func (*UnimplementedQueryServer) GetEvmChainDecommission(ctx context.Context, req *QueryEvmChainDecommissionRequest) (*QueryEvmChainDecommissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEvmChainDecommission not implemented")
+}
+func (*UnimplementedQueryServer) GetBlacklist(ctx context.Context, req *QueryBlacklistRequest) (*QueryBlacklistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlacklist not implemented")
+}
+func (*UnimplementedQueryServer) GetBatchStrategies(ctx context.Context, req *QueryBatchStrategiesRequest) (*QueryBatchStrategiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBatchStrategies not implemented")
+}
+func (*UnimplementedQueryServer) GetBridgedSupply(ctx context.Context, req *QueryBridgedSupplyRequest) (*QueryBridgedSupplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBridgedSupply not implemented")
+}
+func (*UnimplementedQueryServer) GetCheckpoint(ctx context.Context, req *QueryCheckpointRequest) (*QueryCheckpointResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCheckpoint not implemented")
+}
+func (*UnimplementedQueryServer) GetEvidenceRecords(ctx context.Context, req *QueryEvidenceRecordsRequest) (*QueryEvidenceRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEvidenceRecords not implemented")
 }
 
-func (m *QueryOutgoingLogicCallsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Calls) > 0 {
-		for iNdEx := len(m.Calls) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Calls[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QueryBatchRequestByNonceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryBatchRequestByNonceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_CurrentValset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCurrentValsetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CurrentValset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/CurrentValset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CurrentValset(ctx, req.(*QueryCurrentValsetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryBatchRequestByNonceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EvmChainPrefix) > 0 {
-		i -= len(m.EvmChainPrefix)
-		copy(dAtA[i:], m.EvmChainPrefix)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.EvmChainPrefix)))
-		i--
-		dAtA[i] = 0x1a
+func _Query_ValsetRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValsetRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.ContractAddress) > 0 {
-		i -= len(m.ContractAddress)
-		copy(dAtA[i:], m.ContractAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(QueryServer).ValsetRequest(ctx, in)
 	}
-	if m.Nonce != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
-		i--
-		dAtA[i] = 0x8
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/ValsetRequest",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValsetRequest(ctx, req.(*QueryValsetRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryBatchRequestByNonceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ValsetConfirm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValsetConfirmRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).ValsetConfirm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/ValsetConfirm",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValsetConfirm(ctx, req.(*QueryValsetConfirmRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryBatchRequestByNonceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_ValsetConfirmsByNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValsetConfirmsByNonceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValsetConfirmsByNonce(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/ValsetConfirmsByNonce",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValsetConfirmsByNonce(ctx, req.(*QueryValsetConfirmsByNonceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryBatchRequestByNonceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Batch.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func _Query_LastValsetRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLastValsetRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).LastValsetRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/LastValsetRequests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LastValsetRequests(ctx, req.(*QueryLastValsetRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryBatchConfirmsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_LastPendingValsetRequestByAddr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLastPendingValsetRequestByAddrRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).LastPendingValsetRequestByAddr(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/LastPendingValsetRequestByAddr",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LastPendingValsetRequestByAddr(ctx, req.(*QueryLastPendingValsetRequestByAddrRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryBatchConfirmsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryBatchConfirmsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EvmChainPrefix) > 0 {
-		i -= len(m.EvmChainPrefix)
-		copy(dAtA[i:], m.EvmChainPrefix)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.EvmChainPrefix)))
-		i--
-		dAtA[i] = 0x1a
+func _Query_LastPendingBatchRequestByAddr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLastPendingBatchRequestByAddrRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.ContractAddress) > 0 {
-		i -= len(m.ContractAddress)
-		copy(dAtA[i:], m.ContractAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(QueryServer).LastPendingBatchRequestByAddr(ctx, in)
 	}
-	if m.Nonce != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
-		i--
-		dAtA[i] = 0x8
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/LastPendingBatchRequestByAddr",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LastPendingBatchRequestByAddr(ctx, req.(*QueryLastPendingBatchRequestByAddrRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryBatchConfirmsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_LastPendingLogicCallByAddr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLastPendingLogicCallByAddrRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryBatchConfirmsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryBatchConfirmsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Confirms) > 0 {
-		for iNdEx := len(m.Confirms) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Confirms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).LastPendingLogicCallByAddr(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/LastPendingLogicCallByAddr",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LastPendingLogicCallByAddr(ctx, req.(*QueryLastPendingLogicCallByAddrRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryLogicConfirmsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_LastEventNonceByAddr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLastEventNonceByAddrRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryLogicConfirmsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryLogicConfirmsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EvmChainPrefix) > 0 {
-		i -= len(m.EvmChainPrefix)
-		copy(dAtA[i:], m.EvmChainPrefix)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.EvmChainPrefix)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(QueryServer).LastEventNonceByAddr(ctx, in)
 	}
-	if m.InvalidationNonce != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.InvalidationNonce))
-		i--
-		dAtA[i] = 0x10
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/LastEventNonceByAddr",
 	}
-	if len(m.InvalidationId) > 0 {
-		i -= len(m.InvalidationId)
-		copy(dAtA[i:], m.InvalidationId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.InvalidationId)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LastEventNonceByAddr(ctx, req.(*QueryLastEventNonceByAddrRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryLogicConfirmsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_BatchFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBatchFeeRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryLogicConfirmsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryLogicConfirmsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Confirms) > 0 {
-		for iNdEx := len(m.Confirms) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Confirms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).BatchFees(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/BatchFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BatchFees(ctx, req.(*QueryBatchFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryLastEventNonceByAddrRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_OutgoingTxBatches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOutgoingTxBatchesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryLastEventNonceByAddrRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryLastEventNonceByAddrRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EvmChainPrefix) > 0 {
-		i -= len(m.EvmChainPrefix)
-		copy(dAtA[i:], m.EvmChainPrefix)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.EvmChainPrefix)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(QueryServer).OutgoingTxBatches(ctx, in)
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/OutgoingTxBatches",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OutgoingTxBatches(ctx, req.(*QueryOutgoingTxBatchesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryLastEventNonceByAddrResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_OutgoingLogicCalls_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOutgoingLogicCallsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).OutgoingLogicCalls(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/OutgoingLogicCalls",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OutgoingLogicCalls(ctx, req.(*QueryOutgoingLogicCallsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryLastEventNonceByAddrResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_BatchRequestByNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBatchRequestByNonceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BatchRequestByNonce(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/BatchRequestByNonce",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BatchRequestByNonce(ctx, req.(*QueryBatchRequestByNonceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryLastEventNonceByAddrResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EventNonce != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.EventNonce))
-		i--
-		dAtA[i] = 0x8
+func _Query_BatchConfirms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBatchConfirmsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).BatchConfirms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/BatchConfirms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BatchConfirms(ctx, req.(*QueryBatchConfirmsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryERC20ToDenomRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_LogicConfirms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLogicConfirmsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryERC20ToDenomRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryERC20ToDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EvmChainPrefix) > 0 {
-		i -= len(m.EvmChainPrefix)
-		copy(dAtA[i:], m.EvmChainPrefix)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.EvmChainPrefix)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(QueryServer).LogicConfirms(ctx, in)
 	}
-	if len(m.Erc20) > 0 {
-		i -= len(m.Erc20)
-		copy(dAtA[i:], m.Erc20)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Erc20)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/LogicConfirms",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LogicConfirms(ctx, req.(*QueryLogicConfirmsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryERC20ToDenomResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ERC20ToDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryERC20ToDenomRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryERC20ToDenomResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryERC20ToDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.CosmosOriginated {
-		i--
-		if m.CosmosOriginated {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
+	if interceptor == nil {
+		return srv.(QueryServer).ERC20ToDenom(ctx, in)
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/ERC20ToDenom",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ERC20ToDenom(ctx, req.(*QueryERC20ToDenomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDenomToERC20Request) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_DenomToERC20_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomToERC20Request)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryDenomToERC20Request) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryDenomToERC20Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EvmChainPrefix) > 0 {
-		i -= len(m.EvmChainPrefix)
-		copy(dAtA[i:], m.EvmChainPrefix)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.EvmChainPrefix)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(QueryServer).DenomToERC20(ctx, in)
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/DenomToERC20",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomToERC20(ctx, req.(*QueryDenomToERC20Request))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDenomToERC20Response) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetLastObservedEthBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLastObservedEthBlockRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryDenomToERC20Response) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).GetLastObservedEthBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/GetLastObservedEthBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetLastObservedEthBlock(ctx, req.(*QueryLastObservedEthBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDenomToERC20Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.CosmosOriginated {
-		i--
-		if m.CosmosOriginated {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
+func _Query_GetLastObservedEthNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLastObservedEthNonceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Erc20) > 0 {
-		i -= len(m.Erc20)
-		copy(dAtA[i:], m.Erc20)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Erc20)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).GetLastObservedEthNonce(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/GetLastObservedEthNonce",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetLastObservedEthNonce(ctx, req.(*QueryLastObservedEthNonceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryLastObservedEthBlockRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetAttestations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAttestationsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetAttestations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/GetAttestations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetAttestations(ctx, req.(*QueryAttestationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryLastObservedEthBlockRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_GetDelegateKeyByValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegateKeysByValidatorAddress)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetDelegateKeyByValidator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/GetDelegateKeyByValidator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetDelegateKeyByValidator(ctx, req.(*QueryDelegateKeysByValidatorAddress))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryLastObservedEthBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EvmChainPrefix) > 0 {
-		i -= len(m.EvmChainPrefix)
-		copy(dAtA[i:], m.EvmChainPrefix)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.EvmChainPrefix)))
-		i--
-		dAtA[i] = 0x12
+func _Query_GetDelegateKeyByEth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegateKeysByEthAddress)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.UseV1Key {
-		i--
-		if m.UseV1Key {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+	if interceptor == nil {
+		return srv.(QueryServer).GetDelegateKeyByEth(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/GetDelegateKeyByEth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetDelegateKeyByEth(ctx, req.(*QueryDelegateKeysByEthAddress))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryLastObservedEthBlockResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetDelegateKeyByOrchestrator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegateKeysByOrchestratorAddress)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetDelegateKeyByOrchestrator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/GetDelegateKeyByOrchestrator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetDelegateKeyByOrchestrator(ctx, req.(*QueryDelegateKeysByOrchestratorAddress))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryLastObservedEthBlockResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_GetPendingSendToEth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingSendToEth)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetPendingSendToEth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/GetPendingSendToEth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetPendingSendToEth(ctx, req.(*QueryPendingSendToEth))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryLastObservedEthBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Block != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Block))
-		i--
-		dAtA[i] = 0x8
+func _Query_GetPendingIbcAutoForwards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingIbcAutoForwards)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetPendingIbcAutoForwards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/GetPendingIbcAutoForwards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetPendingIbcAutoForwards(ctx, req.(*QueryPendingIbcAutoForwards))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryLastObservedEthNonceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetListEvmChains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListEvmChains)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetListEvmChains(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/GetListEvmChains",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetListEvmChains(ctx, req.(*QueryListEvmChains))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryLastObservedEthNonceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_GetMonitoredERC20Addresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMonitoredERC20Addresses)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetMonitoredERC20Addresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/GetMonitoredERC20Addresses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetMonitoredERC20Addresses(ctx, req.(*QueryMonitoredERC20Addresses))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryLastObservedEthNonceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EvmChainPrefix) > 0 {
-		i -= len(m.EvmChainPrefix)
-		copy(dAtA[i:], m.EvmChainPrefix)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.EvmChainPrefix)))
-		i--
-		dAtA[i] = 0x12
+func _Query_GetBridgeBalanceSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBridgeBalanceSnapshots)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.UseV1Key {
-		i--
-		if m.UseV1Key {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+	if interceptor == nil {
+		return srv.(QueryServer).GetBridgeBalanceSnapshots(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/GetBridgeBalanceSnapshots",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetBridgeBalanceSnapshots(ctx, req.(*QueryBridgeBalanceSnapshots))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryLastObservedEthNonceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetBridgeBalanceSnapshotByEventNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBridgeBalanceSnapshotByEventNonce)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetBridgeBalanceSnapshotByEventNonce(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/GetBridgeBalanceSnapshotByEventNonce",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetBridgeBalanceSnapshotByEventNonce(ctx, req.(*QueryBridgeBalanceSnapshotByEventNonce))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryLastObservedEthNonceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_GetRateLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRateLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetRateLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/GetRateLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetRateLimits(ctx, req.(*QueryRateLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryLastObservedEthNonceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Nonce != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
-		i--
-		dAtA[i] = 0x8
+func _Query_GetHeldSendToCosmos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHeldSendToCosmosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetHeldSendToCosmos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/GetHeldSendToCosmos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetHeldSendToCosmos(ctx, req.(*QueryHeldSendToCosmosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAttestationsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetIbcBridgeFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIbcBridgeFeesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetIbcBridgeFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/GetIbcBridgeFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetIbcBridgeFees(ctx, req.(*QueryIbcBridgeFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAttestationsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_GetIbcAutoForwardLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIbcAutoForwardLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetIbcAutoForwardLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/GetIbcAutoForwardLogs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetIbcAutoForwardLogs(ctx, req.(*QueryIbcAutoForwardLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAttestationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EvmChainPrefix) > 0 {
-		i -= len(m.EvmChainPrefix)
-		copy(dAtA[i:], m.EvmChainPrefix)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.EvmChainPrefix)))
-		i--
-		dAtA[i] = 0x3a
+func _Query_GetIbcAutoForwardTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIbcAutoForwardTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.UseV1Key {
-		i--
-		if m.UseV1Key {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x30
+	if interceptor == nil {
+		return srv.(QueryServer).GetIbcAutoForwardTransfer(ctx, in)
 	}
-	if m.Height != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x28
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/GetIbcAutoForwardTransfer",
 	}
-	if m.Nonce != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
-		i--
-		dAtA[i] = 0x20
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetIbcAutoForwardTransfer(ctx, req.(*QueryIbcAutoForwardTransferRequest))
 	}
-	if len(m.ClaimType) > 0 {
-		i -= len(m.ClaimType)
-		copy(dAtA[i:], m.ClaimType)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClaimType)))
-		i--
-		dAtA[i] = 0x1a
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetFailedAttestations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFailedAttestationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.OrderBy) > 0 {
-		i -= len(m.OrderBy)
-		copy(dAtA[i:], m.OrderBy)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.OrderBy)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(QueryServer).GetFailedAttestations(ctx, in)
 	}
-	if m.Limit != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
-		i--
-		dAtA[i] = 0x8
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/GetFailedAttestations",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetFailedAttestations(ctx, req.(*QueryFailedAttestationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAttestationsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetEvmChainDecommission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEvmChainDecommissionRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryAttestationsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryAttestationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Attestations) > 0 {
-		for iNdEx := len(m.Attestations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Attestations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).GetEvmChainDecommission(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/GetEvmChainDecommission",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetEvmChainDecommission(ctx, req.(*QueryEvmChainDecommissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDelegateKeysByValidatorAddress) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetBlacklist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlacklistRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryDelegateKeysByValidatorAddress) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryDelegateKeysByValidatorAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).GetBlacklist(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/GetBlacklist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetBlacklist(ctx, req.(*QueryBlacklistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDelegateKeysByValidatorAddressResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetBatchStrategies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBatchStrategiesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryDelegateKeysByValidatorAddressResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryDelegateKeysByValidatorAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.OrchestratorAddress) > 0 {
-		i -= len(m.OrchestratorAddress)
-		copy(dAtA[i:], m.OrchestratorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.OrchestratorAddress)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(QueryServer).GetBatchStrategies(ctx, in)
 	}
-	if len(m.EthAddress) > 0 {
-		i -= len(m.EthAddress)
-		copy(dAtA[i:], m.EthAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.EthAddress)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/GetBatchStrategies",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetBatchStrategies(ctx, req.(*QueryBatchStrategiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDelegateKeysByEthAddress) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetBridgedSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBridgedSupplyRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryDelegateKeysByEthAddress) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryDelegateKeysByEthAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EthAddress) > 0 {
-		i -= len(m.EthAddress)
-		copy(dAtA[i:], m.EthAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.EthAddress)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).GetBridgedSupply(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/GetBridgedSupply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetBridgedSupply(ctx, req.(*QueryBridgedSupplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDelegateKeysByEthAddressResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetCheckpoint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCheckpointRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryDelegateKeysByEthAddressResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryDelegateKeysByEthAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.OrchestratorAddress) > 0 {
-		i -= len(m.OrchestratorAddress)
-		copy(dAtA[i:], m.OrchestratorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.OrchestratorAddress)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(QueryServer).GetCheckpoint(ctx, in)
 	}
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/GetCheckpoint",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetCheckpoint(ctx, req.(*QueryCheckpointRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDelegateKeysByOrchestratorAddress) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetEvidenceRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEvidenceRecordsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryDelegateKeysByOrchestratorAddress) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).GetEvidenceRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gravity.v1.Query/GetEvidenceRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetEvidenceRecords(ctx, req.(*QueryEvidenceRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDelegateKeysByOrchestratorAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "gravity.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "CurrentValset",
+			Handler:    _Query_CurrentValset_Handler,
+		},
+		{
+			MethodName: "ValsetRequest",
+			Handler:    _Query_ValsetRequest_Handler,
+		},
+		{
+			MethodName: "ValsetConfirm",
+			Handler:    _Query_ValsetConfirm_Handler,
+		},
+		{
+			MethodName: "ValsetConfirmsByNonce",
+			Handler:    _Query_ValsetConfirmsByNonce_Handler,
+		},
+		{
+			MethodName: "LastValsetRequests",
+			Handler:    _Query_LastValsetRequests_Handler,
+		},
+		{
+			MethodName: "LastPendingValsetRequestByAddr",
+			Handler:    _Query_LastPendingValsetRequestByAddr_Handler,
+		},
+		{
+			MethodName: "LastPendingBatchRequestByAddr",
+			Handler:    _Query_LastPendingBatchRequestByAddr_Handler,
+		},
+		{
+			MethodName: "LastPendingLogicCallByAddr",
+			Handler:    _Query_LastPendingLogicCallByAddr_Handler,
+		},
+		{
+			MethodName: "LastEventNonceByAddr",
+			Handler:    _Query_LastEventNonceByAddr_Handler,
+		},
+		{
+			MethodName: "BatchFees",
+			Handler:    _Query_BatchFees_Handler,
+		},
+		{
+			MethodName: "OutgoingTxBatches",
+			Handler:    _Query_OutgoingTxBatches_Handler,
+		},
+		{
+			MethodName: "OutgoingLogicCalls",
+			Handler:    _Query_OutgoingLogicCalls_Handler,
+		},
+		{
+			MethodName: "BatchRequestByNonce",
+			Handler:    _Query_BatchRequestByNonce_Handler,
+		},
+		{
+			MethodName: "BatchConfirms",
+			Handler:    _Query_BatchConfirms_Handler,
+		},
+		{
+			MethodName: "LogicConfirms",
+			Handler:    _Query_LogicConfirms_Handler,
+		},
+		{
+			MethodName: "ERC20ToDenom",
+			Handler:    _Query_ERC20ToDenom_Handler,
+		},
+		{
+			MethodName: "DenomToERC20",
+			Handler:    _Query_DenomToERC20_Handler,
+		},
+		{
+			MethodName: "GetLastObservedEthBlock",
+			Handler:    _Query_GetLastObservedEthBlock_Handler,
+		},
+		{
+			MethodName: "GetLastObservedEthNonce",
+			Handler:    _Query_GetLastObservedEthNonce_Handler,
+		},
+		{
+			MethodName: "GetAttestations",
+			Handler:    _Query_GetAttestations_Handler,
+		},
+		{
+			MethodName: "GetDelegateKeyByValidator",
+			Handler:    _Query_GetDelegateKeyByValidator_Handler,
+		},
+		{
+			MethodName: "GetDelegateKeyByEth",
+			Handler:    _Query_GetDelegateKeyByEth_Handler,
+		},
+		{
+			MethodName: "GetDelegateKeyByOrchestrator",
+			Handler:    _Query_GetDelegateKeyByOrchestrator_Handler,
+		},
+		{
+			MethodName: "GetPendingSendToEth",
+			Handler:    _Query_GetPendingSendToEth_Handler,
+		},
+		{
+			MethodName: "GetPendingIbcAutoForwards",
+			Handler:    _Query_GetPendingIbcAutoForwards_Handler,
+		},
+		{
+			MethodName: "GetListEvmChains",
+			Handler:    _Query_GetListEvmChains_Handler,
+		},
+		{
+			MethodName: "GetMonitoredERC20Addresses",
+			Handler:    _Query_GetMonitoredERC20Addresses_Handler,
+		},
+		{
+			MethodName: "GetBridgeBalanceSnapshots",
+			Handler:    _Query_GetBridgeBalanceSnapshots_Handler,
+		},
+		{
+			MethodName: "GetBridgeBalanceSnapshotByEventNonce",
+			Handler:    _Query_GetBridgeBalanceSnapshotByEventNonce_Handler,
+		},
+		{
+			MethodName: "GetRateLimits",
+			Handler:    _Query_GetRateLimits_Handler,
+		},
+		{
+			MethodName: "GetHeldSendToCosmos",
+			Handler:    _Query_GetHeldSendToCosmos_Handler,
+		},
+		{
+			MethodName: "GetIbcBridgeFees",
+			Handler:    _Query_GetIbcBridgeFees_Handler,
+		},
+		{
+			MethodName: "GetIbcAutoForwardLogs",
+			Handler:    _Query_GetIbcAutoForwardLogs_Handler,
+		},
+		{
+			MethodName: "GetIbcAutoForwardTransfer",
+			Handler:    _Query_GetIbcAutoForwardTransfer_Handler,
+		},
+		{
+			MethodName: "GetFailedAttestations",
+			Handler:    _Query_GetFailedAttestations_Handler,
+		},
+		{
+			MethodName: "GetEvmChainDecommission",
+			Handler:    _Query_GetEvmChainDecommission_Handler,
+		},
+		{
+			MethodName: "GetBlacklist",
+			Handler:    _Query_GetBlacklist_Handler,
+		},
+		{
+			MethodName: "GetBatchStrategies",
+			Handler:    _Query_GetBatchStrategies_Handler,
+		},
+		{
+			MethodName: "GetBridgedSupply",
+			Handler:    _Query_GetBridgedSupply_Handler,
+		},
+		{
+			MethodName: "GetCheckpoint",
+			Handler:    _Query_GetCheckpoint_Handler,
+		},
+		{
+			MethodName: "GetEvidenceRecords",
+			Handler:    _Query_GetEvidenceRecords_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "gravity/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.OrchestratorAddress) > 0 {
-		i -= len(m.OrchestratorAddress)
-		copy(dAtA[i:], m.OrchestratorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.OrchestratorAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryDelegateKeysByOrchestratorAddressResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6057,34 +6313,30 @@ func (m *QueryDelegateKeysByOrchestratorAddressResponse) Marshal() (dAtA []byte,
 	return dAtA[:n], nil
 }
 
-func (m *QueryDelegateKeysByOrchestratorAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDelegateKeysByOrchestratorAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.EthAddress) > 0 {
-		i -= len(m.EthAddress)
-		copy(dAtA[i:], m.EthAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.EthAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0xa
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryPendingSendToEth) Marshal() (dAtA []byte, err error) {
+func (m *QueryCurrentValsetRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6094,12 +6346,12 @@ func (m *QueryPendingSendToEth) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryPendingSendToEth) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryCurrentValsetRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPendingSendToEth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryCurrentValsetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6109,19 +6361,12 @@ func (m *QueryPendingSendToEth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		copy(dAtA[i:], m.EvmChainPrefix)
 		i = encodeVarintQuery(dAtA, i, uint64(len(m.EvmChainPrefix)))
 		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.SenderAddress) > 0 {
-		i -= len(m.SenderAddress)
-		copy(dAtA[i:], m.SenderAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SenderAddress)))
-		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryPendingSendToEthResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryCurrentValsetResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6131,48 +6376,30 @@ func (m *QueryPendingSendToEthResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryPendingSendToEthResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryCurrentValsetResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPendingSendToEthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryCurrentValsetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.UnbatchedTransfers) > 0 {
-		for iNdEx := len(m.UnbatchedTransfers) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.UnbatchedTransfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.TransfersInBatches) > 0 {
-		for iNdEx := len(m.TransfersInBatches) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TransfersInBatches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	{
+		size, err := m.Valset.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryPendingIbcAutoForwards) Marshal() (dAtA []byte, err error) {
+func (m *QueryValsetRequestRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6182,12 +6409,12 @@ func (m *QueryPendingIbcAutoForwards) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryPendingIbcAutoForwards) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryValsetRequestRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPendingIbcAutoForwards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryValsetRequestRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6199,15 +6426,15 @@ func (m *QueryPendingIbcAutoForwards) MarshalToSizedBuffer(dAtA []byte) (int, er
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Limit != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
+	if m.Nonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryPendingIbcAutoForwardsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryValsetRequestResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6217,34 +6444,32 @@ func (m *QueryPendingIbcAutoForwardsResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *QueryPendingIbcAutoForwardsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryValsetRequestResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPendingIbcAutoForwardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryValsetRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.PendingIbcAutoForwards) > 0 {
-		for iNdEx := len(m.PendingIbcAutoForwards) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PendingIbcAutoForwards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
+	if m.Valset != nil {
+		{
+			size, err := m.Valset.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0xa
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryListEvmChains) Marshal() (dAtA []byte, err error) {
+func (m *QueryValsetConfirmRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6254,25 +6479,39 @@ func (m *QueryListEvmChains) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryListEvmChains) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryValsetConfirmRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryListEvmChains) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryValsetConfirmRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Limit != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryListEvmChainsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryValsetConfirmResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6282,34 +6521,32 @@ func (m *QueryListEvmChainsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryListEvmChainsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryValsetConfirmResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryListEvmChainsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryValsetConfirmResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.EvmChains) > 0 {
-		for iNdEx := len(m.EvmChains) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.EvmChains[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
+	if m.Confirm != nil {
+		{
+			size, err := m.Confirm.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0xa
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryMonitoredERC20Addresses) Marshal() (dAtA []byte, err error) {
+func (m *QueryValsetConfirmsByNonceRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6319,20 +6556,32 @@ func (m *QueryMonitoredERC20Addresses) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryMonitoredERC20Addresses) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryValsetConfirmsByNonceRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryMonitoredERC20Addresses) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryValsetConfirmsByNonceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryMonitoredERC20AddressesResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryValsetConfirmsByNonceResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6342,21 +6591,26 @@ func (m *QueryMonitoredERC20AddressesResponse) Marshal() (dAtA []byte, err error
 	return dAtA[:n], nil
 }
 
-func (m *QueryMonitoredERC20AddressesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryValsetConfirmsByNonceResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryMonitoredERC20AddressesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryValsetConfirmsByNonceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Addresses) > 0 {
-		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Addresses[iNdEx])
-			copy(dAtA[i:], m.Addresses[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+	if len(m.Confirms) > 0 {
+		for iNdEx := len(m.Confirms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Confirms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
 			i--
 			dAtA[i] = 0xa
 		}
@@ -6364,7 +6618,7 @@ func (m *QueryMonitoredERC20AddressesResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryBridgeBalanceSnapshots) Marshal() (dAtA []byte, err error) {
+func (m *QueryLastValsetRequestsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6374,35 +6628,27 @@ func (m *QueryBridgeBalanceSnapshots) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryBridgeBalanceSnapshots) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryLastValsetRequestsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBridgeBalanceSnapshots) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryLastValsetRequestsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.NewestFirst {
-		i--
-		if m.NewestFirst {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Limit != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EvmChainPrefix)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryBridgeBalanceSnapshotsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryLastValsetRequestsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6412,20 +6658,20 @@ func (m *QueryBridgeBalanceSnapshotsResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *QueryBridgeBalanceSnapshotsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryLastValsetRequestsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBridgeBalanceSnapshotsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryLastValsetRequestsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Snapshots) > 0 {
-		for iNdEx := len(m.Snapshots) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Valsets) > 0 {
+		for iNdEx := len(m.Valsets) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Snapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Valsets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -6439,7 +6685,7 @@ func (m *QueryBridgeBalanceSnapshotsResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryBridgeBalanceSnapshotByEventNonce) Marshal() (dAtA []byte, err error) {
+func (m *QueryLastPendingValsetRequestByAddrRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6449,12 +6695,12 @@ func (m *QueryBridgeBalanceSnapshotByEventNonce) Marshal() (dAtA []byte, err err
 	return dAtA[:n], nil
 }
 
-func (m *QueryBridgeBalanceSnapshotByEventNonce) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryLastPendingValsetRequestByAddrRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBridgeBalanceSnapshotByEventNonce) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryLastPendingValsetRequestByAddrRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6466,15 +6712,17 @@ func (m *QueryBridgeBalanceSnapshotByEventNonce) MarshalToSizedBuffer(dAtA []byt
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Nonce != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryBridgeBalanceSnapshotByEventNonceResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryLastPendingValsetRequestByAddrResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6484,963 +6732,7062 @@ func (m *QueryBridgeBalanceSnapshotByEventNonceResponse) Marshal() (dAtA []byte,
 	return dAtA[:n], nil
 }
 
-func (m *QueryBridgeBalanceSnapshotByEventNonceResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryLastPendingValsetRequestByAddrResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBridgeBalanceSnapshotByEventNonceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryLastPendingValsetRequestByAddrResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Snapshot != nil {
-		{
-			size, err := m.Snapshot.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.Valsets) > 0 {
+		for iNdEx := len(m.Valsets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Valsets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0xa
 		}
-		i--
-		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryBatchFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryBatchFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryBatchFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryCurrentValsetRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.EvmChainPrefix)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryBatchFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryCurrentValsetResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Valset.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryBatchFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryValsetRequestRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryBatchFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Nonce != 0 {
-		n += 1 + sovQuery(uint64(m.Nonce))
-	}
-	l = len(m.EvmChainPrefix)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.BatchFees) > 0 {
+		for iNdEx := len(m.BatchFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BatchFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryValsetRequestResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Valset != nil {
-		l = m.Valset.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryLastPendingBatchRequestByAddrRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryValsetConfirmRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryLastPendingBatchRequestByAddrRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLastPendingBatchRequestByAddrRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Nonce != 0 {
-		n += 1 + sovQuery(uint64(m.Nonce))
-	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.EvmChainPrefix)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryValsetConfirmResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryLastPendingBatchRequestByAddrResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLastPendingBatchRequestByAddrResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLastPendingBatchRequestByAddrResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Confirm != nil {
-		l = m.Confirm.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Batch) > 0 {
+		for iNdEx := len(m.Batch) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Batch[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryValsetConfirmsByNonceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryLastPendingLogicCallByAddrRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLastPendingLogicCallByAddrRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLastPendingLogicCallByAddrRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Nonce != 0 {
-		n += 1 + sovQuery(uint64(m.Nonce))
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.EvmChainPrefix)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryValsetConfirmsByNonceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryLastPendingLogicCallByAddrResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLastPendingLogicCallByAddrResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLastPendingLogicCallByAddrResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Confirms) > 0 {
-		for _, e := range m.Confirms {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.Call) > 0 {
+		for iNdEx := len(m.Call) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Call[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryLastValsetRequestsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryOutgoingTxBatchesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOutgoingTxBatchesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOutgoingTxBatchesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.EvmChainPrefix)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryLastValsetRequestsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Valsets) > 0 {
-		for _, e := range m.Valsets {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (m *QueryOutgoingTxBatchesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryLastPendingValsetRequestByAddrRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.EvmChainPrefix)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryOutgoingTxBatchesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryLastPendingValsetRequestByAddrResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryOutgoingTxBatchesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Valsets) > 0 {
-		for _, e := range m.Valsets {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.Batches) > 0 {
+		for iNdEx := len(m.Batches) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Batches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBatchFeeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.EvmChainPrefix)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryOutgoingLogicCallsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryBatchFeeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.BatchFees) > 0 {
-		for _, e := range m.BatchFees {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	return n
+func (m *QueryOutgoingLogicCallsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryLastPendingBatchRequestByAddrRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryOutgoingLogicCallsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.EvmChainPrefix)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryLastPendingBatchRequestByAddrResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryOutgoingLogicCallsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOutgoingLogicCallsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOutgoingLogicCallsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Batch) > 0 {
-		for _, e := range m.Batch {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.Calls) > 0 {
+		for iNdEx := len(m.Calls) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Calls[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryLastPendingLogicCallByAddrRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBatchRequestByNonceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBatchRequestByNonceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBatchRequestByNonceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.EvmChainPrefix)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if m.Nonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryLastPendingLogicCallByAddrResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Call) > 0 {
-		for _, e := range m.Call {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (m *QueryBatchRequestByNonceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryOutgoingTxBatchesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.EvmChainPrefix)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryBatchRequestByNonceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryOutgoingTxBatchesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryBatchRequestByNonceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Batches) > 0 {
-		for _, e := range m.Batches {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.Batch.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryOutgoingLogicCallsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.EvmChainPrefix)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryBatchConfirmsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryOutgoingLogicCallsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Calls) > 0 {
-		for _, e := range m.Calls {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	return n
+func (m *QueryBatchConfirmsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBatchRequestByNonceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryBatchConfirmsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Nonce != 0 {
-		n += 1 + sovQuery(uint64(m.Nonce))
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.EvmChainPrefix)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Nonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBatchRequestByNonceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBatchConfirmsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Batch.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryBatchConfirmsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Nonce != 0 {
-		n += 1 + sovQuery(uint64(m.Nonce))
-	}
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.EvmChainPrefix)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryBatchConfirmsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBatchConfirmsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryBatchConfirmsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if len(m.Confirms) > 0 {
-		for _, e := range m.Confirms {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		for iNdEx := len(m.Confirms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Confirms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryLogicConfirmsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryLogicConfirmsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLogicConfirmsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLogicConfirmsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.InvalidationId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0x1a
 	}
 	if m.InvalidationNonce != 0 {
-		n += 1 + sovQuery(uint64(m.InvalidationNonce))
+		i = encodeVarintQuery(dAtA, i, uint64(m.InvalidationNonce))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.EvmChainPrefix)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.InvalidationId) > 0 {
+		i -= len(m.InvalidationId)
+		copy(dAtA[i:], m.InvalidationId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.InvalidationId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryLogicConfirmsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryLogicConfirmsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLogicConfirmsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLogicConfirmsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if len(m.Confirms) > 0 {
-		for _, e := range m.Confirms {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		for iNdEx := len(m.Confirms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Confirms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryLastEventNonceByAddrRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.EvmChainPrefix)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryLastEventNonceByAddrRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryLastEventNonceByAddrResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.EventNonce != 0 {
-		n += 1 + sovQuery(uint64(m.EventNonce))
-	}
-	return n
+func (m *QueryLastEventNonceByAddrRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryERC20ToDenomRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryLastEventNonceByAddrRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Erc20)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.EvmChainPrefix)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryERC20ToDenomResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryLastEventNonceByAddrResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLastEventNonceByAddrResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLastEventNonceByAddrResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.CosmosOriginated {
-		n += 2
+	if m.EventNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EventNonce))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDenomToERC20Request) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryERC20ToDenomRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryERC20ToDenomRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryERC20ToDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.EvmChainPrefix)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Erc20) > 0 {
+		i -= len(m.Erc20)
+		copy(dAtA[i:], m.Erc20)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Erc20)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDenomToERC20Response) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryERC20ToDenomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryERC20ToDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryERC20ToDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Erc20)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
 	if m.CosmosOriginated {
-		n += 2
+		i--
+		if m.CosmosOriginated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryLastObservedEthBlockRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryDenomToERC20Request) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomToERC20Request) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomToERC20Request) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.UseV1Key {
-		n += 2
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.EvmChainPrefix)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryLastObservedEthBlockResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Block != 0 {
-		n += 1 + sovQuery(uint64(m.Block))
+func (m *QueryDenomToERC20Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryLastObservedEthNonceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryDenomToERC20Response) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomToERC20Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.UseV1Key {
-		n += 2
+	if m.CosmosOriginated {
+		i--
+		if m.CosmosOriginated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.EvmChainPrefix)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Erc20) > 0 {
+		i -= len(m.Erc20)
+		copy(dAtA[i:], m.Erc20)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Erc20)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryLastObservedEthNonceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Nonce != 0 {
-		n += 1 + sovQuery(uint64(m.Nonce))
+func (m *QueryLastObservedEthBlockRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAttestationsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryLastObservedEthBlockRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLastObservedEthBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Limit != 0 {
-		n += 1 + sovQuery(uint64(m.Limit))
-	}
-	l = len(m.OrderBy)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.ClaimType)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Nonce != 0 {
-		n += 1 + sovQuery(uint64(m.Nonce))
-	}
-	if m.Height != 0 {
-		n += 1 + sovQuery(uint64(m.Height))
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0x12
 	}
 	if m.UseV1Key {
-		n += 2
-	}
-	l = len(m.EvmChainPrefix)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryAttestationsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Attestations) > 0 {
-		for _, e := range m.Attestations {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		i--
+		if m.UseV1Key {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDelegateKeysByValidatorAddress) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryLastObservedEthBlockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryDelegateKeysByValidatorAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.EthAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.OrchestratorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryLastObservedEthBlockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDelegateKeysByEthAddress) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryLastObservedEthBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.EthAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Block != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Block))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDelegateKeysByEthAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.OrchestratorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryLastObservedEthNonceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryDelegateKeysByOrchestratorAddress) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.OrchestratorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryLastObservedEthNonceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDelegateKeysByOrchestratorAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryLastObservedEthNonceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.EthAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.UseV1Key {
+		i--
+		if m.UseV1Key {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPendingSendToEth) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.SenderAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.EvmChainPrefix)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryLastObservedEthNonceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryPendingSendToEthResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.TransfersInBatches) > 0 {
-		for _, e := range m.TransfersInBatches {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if len(m.UnbatchedTransfers) > 0 {
-		for _, e := range m.UnbatchedTransfers {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	return n
+func (m *QueryLastObservedEthNonceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPendingIbcAutoForwards) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryLastObservedEthNonceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Limit != 0 {
-		n += 1 + sovQuery(uint64(m.Limit))
-	}
-	l = len(m.EvmChainPrefix)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Nonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPendingIbcAutoForwardsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.PendingIbcAutoForwards) > 0 {
-		for _, e := range m.PendingIbcAutoForwards {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (m *QueryAttestationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryListEvmChains) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Limit != 0 {
-		n += 1 + sovQuery(uint64(m.Limit))
-	}
-	return n
+func (m *QueryAttestationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryListEvmChainsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryAttestationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.EvmChains) > 0 {
-		for _, e := range m.EvmChains {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	return n
+	if m.UseV1Key {
+		i--
+		if m.UseV1Key {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ClaimType) > 0 {
+		i -= len(m.ClaimType)
+		copy(dAtA[i:], m.ClaimType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClaimType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OrderBy) > 0 {
+		i -= len(m.OrderBy)
+		copy(dAtA[i:], m.OrderBy)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OrderBy)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Limit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryMonitoredERC20Addresses) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAttestationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttestationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttestationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if len(m.Attestations) > 0 {
+		for iNdEx := len(m.Attestations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attestations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryMonitoredERC20AddressesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryDelegateKeysByValidatorAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegateKeysByValidatorAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegateKeysByValidatorAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Addresses) > 0 {
-		for _, s := range m.Addresses {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBridgeBalanceSnapshots) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryDelegateKeysByValidatorAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegateKeysByValidatorAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegateKeysByValidatorAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Limit != 0 {
-		n += 1 + sovQuery(uint64(m.Limit))
+	if len(m.OrchestratorAddress) > 0 {
+		i -= len(m.OrchestratorAddress)
+		copy(dAtA[i:], m.OrchestratorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OrchestratorAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.NewestFirst {
-		n += 2
+	if len(m.EthAddress) > 0 {
+		i -= len(m.EthAddress)
+		copy(dAtA[i:], m.EthAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EthAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBridgeBalanceSnapshotsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryDelegateKeysByEthAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegateKeysByEthAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegateKeysByEthAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Snapshots) > 0 {
-		for _, e := range m.Snapshots {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if len(m.EthAddress) > 0 {
+		i -= len(m.EthAddress)
+		copy(dAtA[i:], m.EthAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EthAddress)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBridgeBalanceSnapshotByEventNonce) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryDelegateKeysByEthAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegateKeysByEthAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegateKeysByEthAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Nonce != 0 {
-		n += 1 + sovQuery(uint64(m.Nonce))
+	if len(m.OrchestratorAddress) > 0 {
+		i -= len(m.OrchestratorAddress)
+		copy(dAtA[i:], m.OrchestratorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OrchestratorAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.EvmChainPrefix)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegateKeysByOrchestratorAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegateKeysByOrchestratorAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegateKeysByOrchestratorAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OrchestratorAddress) > 0 {
+		i -= len(m.OrchestratorAddress)
+		copy(dAtA[i:], m.OrchestratorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OrchestratorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDelegateKeysByOrchestratorAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegateKeysByOrchestratorAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegateKeysByOrchestratorAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EthAddress) > 0 {
+		i -= len(m.EthAddress)
+		copy(dAtA[i:], m.EthAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EthAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingSendToEth) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingSendToEth) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingSendToEth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SenderAddress) > 0 {
+		i -= len(m.SenderAddress)
+		copy(dAtA[i:], m.SenderAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SenderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingSendToEthResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingSendToEthResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingSendToEthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UnbatchedTransfers) > 0 {
+		for iNdEx := len(m.UnbatchedTransfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnbatchedTransfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.TransfersInBatches) > 0 {
+		for iNdEx := len(m.TransfersInBatches) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransfersInBatches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingIbcAutoForwards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingIbcAutoForwards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingIbcAutoForwards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Limit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingIbcAutoForwardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingIbcAutoForwardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingIbcAutoForwardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PendingIbcAutoForwards) > 0 {
+		for iNdEx := len(m.PendingIbcAutoForwards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingIbcAutoForwards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListEvmChains) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListEvmChains) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListEvmChains) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListEvmChainsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListEvmChainsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListEvmChainsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EvmChains) > 0 {
+		for iNdEx := len(m.EvmChains) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EvmChains[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMonitoredERC20Addresses) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMonitoredERC20Addresses) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMonitoredERC20Addresses) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMonitoredERC20AddressesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMonitoredERC20AddressesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMonitoredERC20AddressesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBridgeBalanceSnapshots) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBridgeBalanceSnapshots) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBridgeBalanceSnapshots) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.NewestFirst {
+		i--
+		if m.NewestFirst {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Limit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBridgeBalanceSnapshotsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBridgeBalanceSnapshotsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBridgeBalanceSnapshotsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Snapshots) > 0 {
+		for iNdEx := len(m.Snapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Snapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBridgeBalanceSnapshotByEventNonce) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBridgeBalanceSnapshotByEventNonce) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBridgeBalanceSnapshotByEventNonce) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBridgeBalanceSnapshotByEventNonceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBridgeBalanceSnapshotByEventNonceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBridgeBalanceSnapshotByEventNonceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Snapshot != nil {
+		{
+			size, err := m.Snapshot.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRateLimitsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRateLimitsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRateLimitsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RateLimitStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimitStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimitStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.RemainingInbound.Size()
+		i -= size
+		if _, err := m.RemainingInbound.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.RemainingOutbound.Size()
+		i -= size
+		if _, err := m.RemainingOutbound.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.InboundUsed.Size()
+		i -= size
+		if _, err := m.InboundUsed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.OutboundUsed.Size()
+		i -= size
+		if _, err := m.OutboundUsed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.WindowEnd != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.WindowEnd))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.WindowStart != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.WindowStart))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.RateLimit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRateLimitsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRateLimitsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRateLimitsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RateLimits) > 0 {
+		for iNdEx := len(m.RateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHeldSendToCosmosRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHeldSendToCosmosRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHeldSendToCosmosRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHeldSendToCosmosResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHeldSendToCosmosResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHeldSendToCosmosResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Held) > 0 {
+		for iNdEx := len(m.Held) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Held[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIbcBridgeFeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIbcBridgeFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIbcBridgeFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIbcBridgeFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIbcBridgeFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIbcBridgeFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BridgeFees) > 0 {
+		for iNdEx := len(m.BridgeFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BridgeFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIbcAutoForwardLogsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIbcAutoForwardLogsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIbcAutoForwardLogsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EventNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EventNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIbcAutoForwardLogsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIbcAutoForwardLogsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIbcAutoForwardLogsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Logs) > 0 {
+		for iNdEx := len(m.Logs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Logs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIbcAutoForwardTransferRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIbcAutoForwardTransferRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIbcAutoForwardTransferRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EventNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EventNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIbcAutoForwardTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIbcAutoForwardTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIbcAutoForwardTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Transfer.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFailedAttestationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFailedAttestationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFailedAttestationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFailedAttestationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFailedAttestationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFailedAttestationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FailedAttestations) > 0 {
+		for iNdEx := len(m.FailedAttestations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FailedAttestations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEvmChainDecommissionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEvmChainDecommissionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEvmChainDecommissionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEvmChainDecommissionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEvmChainDecommissionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEvmChainDecommissionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Decommission.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlacklistRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlacklistRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlacklistRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlacklistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlacklistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlacklistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBatchStrategiesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBatchStrategiesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBatchStrategiesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBatchStrategiesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBatchStrategiesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBatchStrategiesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxBatchSize != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxBatchSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Strategies) > 0 {
+		for iNdEx := len(m.Strategies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Strategies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBridgedSupplyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBridgedSupplyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBridgedSupplyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBridgedSupplyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBridgedSupplyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBridgedSupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Supply) > 0 {
+		for iNdEx := len(m.Supply) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Supply[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCheckpointRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCheckpointRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCheckpointRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.InvalidationId) > 0 {
+		i -= len(m.InvalidationId)
+		copy(dAtA[i:], m.InvalidationId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.InvalidationId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Kind) > 0 {
+		i -= len(m.Kind)
+		copy(dAtA[i:], m.Kind)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Kind)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCheckpointResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCheckpointResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCheckpointResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Confirms) > 0 {
+		for iNdEx := len(m.Confirms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Confirms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Checkpoint) > 0 {
+		i -= len(m.Checkpoint)
+		copy(dAtA[i:], m.Checkpoint)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Checkpoint)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AbiEncoded) > 0 {
+		i -= len(m.AbiEncoded)
+		copy(dAtA[i:], m.AbiEncoded)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AbiEncoded)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.GravityId) > 0 {
+		i -= len(m.GravityId)
+		copy(dAtA[i:], m.GravityId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.GravityId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CheckpointConfirm) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CheckpointConfirm) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CheckpointConfirm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Valid {
+		i--
+		if m.Valid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.DelegateEthAddress) > 0 {
+		i -= len(m.DelegateEthAddress)
+		copy(dAtA[i:], m.DelegateEthAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegateEthAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.RecoveredSigner) > 0 {
+		i -= len(m.RecoveredSigner)
+		copy(dAtA[i:], m.RecoveredSigner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RecoveredSigner)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.EthSigner) > 0 {
+		i -= len(m.EthSigner)
+		copy(dAtA[i:], m.EthSigner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EthSigner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Orchestrator) > 0 {
+		i -= len(m.Orchestrator)
+		copy(dAtA[i:], m.Orchestrator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Orchestrator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEvidenceRecordsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEvidenceRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEvidenceRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Offender) > 0 {
+		i -= len(m.Offender)
+		copy(dAtA[i:], m.Offender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Offender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEvidenceRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEvidenceRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEvidenceRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCurrentValsetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCurrentValsetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Valset.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryValsetRequestRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValsetRequestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Valset != nil {
+		l = m.Valset.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValsetConfirmRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValsetConfirmResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Confirm != nil {
+		l = m.Confirm.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValsetConfirmsByNonceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValsetConfirmsByNonceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Confirms) > 0 {
+		for _, e := range m.Confirms {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryLastValsetRequestsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLastValsetRequestsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Valsets) > 0 {
+		for _, e := range m.Valsets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryLastPendingValsetRequestByAddrRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLastPendingValsetRequestByAddrResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Valsets) > 0 {
+		for _, e := range m.Valsets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBatchFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBatchFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BatchFees) > 0 {
+		for _, e := range m.BatchFees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryLastPendingBatchRequestByAddrRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLastPendingBatchRequestByAddrResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Batch) > 0 {
+		for _, e := range m.Batch {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryLastPendingLogicCallByAddrRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLastPendingLogicCallByAddrResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Call) > 0 {
+		for _, e := range m.Call {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryOutgoingTxBatchesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOutgoingTxBatchesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Batches) > 0 {
+		for _, e := range m.Batches {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryOutgoingLogicCallsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOutgoingLogicCallsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Calls) > 0 {
+		for _, e := range m.Calls {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBatchRequestByNonceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBatchRequestByNonceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Batch.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBatchConfirmsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBatchConfirmsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Confirms) > 0 {
+		for _, e := range m.Confirms {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryLogicConfirmsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.InvalidationId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.InvalidationNonce != 0 {
+		n += 1 + sovQuery(uint64(m.InvalidationNonce))
+	}
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLogicConfirmsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Confirms) > 0 {
+		for _, e := range m.Confirms {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryLastEventNonceByAddrRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLastEventNonceByAddrResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EventNonce != 0 {
+		n += 1 + sovQuery(uint64(m.EventNonce))
+	}
+	return n
+}
+
+func (m *QueryERC20ToDenomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Erc20)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryERC20ToDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.CosmosOriginated {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryDenomToERC20Request) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomToERC20Response) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Erc20)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.CosmosOriginated {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryLastObservedEthBlockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UseV1Key {
+		n += 2
+	}
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLastObservedEthBlockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Block != 0 {
+		n += 1 + sovQuery(uint64(m.Block))
+	}
+	return n
+}
+
+func (m *QueryLastObservedEthNonceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UseV1Key {
+		n += 2
+	}
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLastObservedEthNonceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *QueryAttestationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		n += 1 + sovQuery(uint64(m.Limit))
+	}
+	l = len(m.OrderBy)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ClaimType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.UseV1Key {
+		n += 2
+	}
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAttestationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Attestations) > 0 {
+		for _, e := range m.Attestations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryDelegateKeysByValidatorAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegateKeysByValidatorAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EthAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.OrchestratorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegateKeysByEthAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EthAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegateKeysByEthAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.OrchestratorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegateKeysByOrchestratorAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrchestratorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegateKeysByOrchestratorAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.EthAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingSendToEth) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SenderAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingSendToEthResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TransfersInBatches) > 0 {
+		for _, e := range m.TransfersInBatches {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.UnbatchedTransfers) > 0 {
+		for _, e := range m.UnbatchedTransfers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPendingIbcAutoForwards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		n += 1 + sovQuery(uint64(m.Limit))
+	}
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingIbcAutoForwardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PendingIbcAutoForwards) > 0 {
+		for _, e := range m.PendingIbcAutoForwards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryListEvmChains) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		n += 1 + sovQuery(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *QueryListEvmChainsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.EvmChains) > 0 {
+		for _, e := range m.EvmChains {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryMonitoredERC20Addresses) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMonitoredERC20AddressesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBridgeBalanceSnapshots) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		n += 1 + sovQuery(uint64(m.Limit))
+	}
+	if m.NewestFirst {
+		n += 2
+	}
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBridgeBalanceSnapshotsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Snapshots) > 0 {
+		for _, e := range m.Snapshots {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBridgeBalanceSnapshotByEventNonce) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBridgeBalanceSnapshotByEventNonceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Snapshot != nil {
+		l = m.Snapshot.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRateLimitsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RateLimitStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RateLimit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.WindowStart != 0 {
+		n += 1 + sovQuery(uint64(m.WindowStart))
+	}
+	if m.WindowEnd != 0 {
+		n += 1 + sovQuery(uint64(m.WindowEnd))
+	}
+	l = m.OutboundUsed.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.InboundUsed.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.RemainingOutbound.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.RemainingInbound.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRateLimitsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RateLimits) > 0 {
+		for _, e := range m.RateLimits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryHeldSendToCosmosRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHeldSendToCosmosResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Held) > 0 {
+		for _, e := range m.Held {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryIbcBridgeFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIbcBridgeFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BridgeFees) > 0 {
+		for _, e := range m.BridgeFees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryIbcAutoForwardLogsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.EventNonce != 0 {
+		n += 1 + sovQuery(uint64(m.EventNonce))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovQuery(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *QueryIbcAutoForwardLogsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Logs) > 0 {
+		for _, e := range m.Logs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryIbcAutoForwardTransferRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.EventNonce != 0 {
+		n += 1 + sovQuery(uint64(m.EventNonce))
+	}
+	return n
+}
+
+func (m *QueryIbcAutoForwardTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Transfer.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFailedAttestationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFailedAttestationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FailedAttestations) > 0 {
+		for _, e := range m.FailedAttestations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryEvmChainDecommissionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEvmChainDecommissionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Decommission.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBlacklistRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlacklistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBatchStrategiesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBatchStrategiesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Strategies) > 0 {
+		for _, e := range m.Strategies {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.MaxBatchSize != 0 {
+		n += 1 + sovQuery(uint64(m.MaxBatchSize))
+	}
+	return n
+}
+
+func (m *QueryBridgedSupplyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBridgedSupplyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Supply) > 0 {
+		for _, e := range m.Supply {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCheckpointRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.InvalidationId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCheckpointResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GravityId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AbiEncoded)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Checkpoint)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Confirms) > 0 {
+		for _, e := range m.Confirms {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CheckpointConfirm) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Orchestrator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.EthSigner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.RecoveredSigner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DelegateEthAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Valid {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEvidenceRecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Offender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEvidenceRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCurrentValsetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCurrentValsetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCurrentValsetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCurrentValsetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCurrentValsetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCurrentValsetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Valset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValsetRequestRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValsetRequestRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValsetRequestRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValsetRequestResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValsetRequestResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValsetRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Valset == nil {
+				m.Valset = &Valset{}
+			}
+			if err := m.Valset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValsetConfirmRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValsetConfirmRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValsetConfirmRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValsetConfirmResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValsetConfirmResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValsetConfirmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Confirm", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Confirm == nil {
+				m.Confirm = &MsgValsetConfirm{}
+			}
+			if err := m.Confirm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValsetConfirmsByNonceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValsetConfirmsByNonceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValsetConfirmsByNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValsetConfirmsByNonceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValsetConfirmsByNonceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValsetConfirmsByNonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Confirms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Confirms = append(m.Confirms, MsgValsetConfirm{})
+			if err := m.Confirms[len(m.Confirms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLastValsetRequestsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLastValsetRequestsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLastValsetRequestsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLastValsetRequestsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLastValsetRequestsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLastValsetRequestsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valsets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Valsets = append(m.Valsets, Valset{})
+			if err := m.Valsets[len(m.Valsets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLastPendingValsetRequestByAddrRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLastPendingValsetRequestByAddrRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLastPendingValsetRequestByAddrRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLastPendingValsetRequestByAddrResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLastPendingValsetRequestByAddrResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLastPendingValsetRequestByAddrResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valsets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Valsets = append(m.Valsets, Valset{})
+			if err := m.Valsets[len(m.Valsets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBatchFeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBatchFeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBatchFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBatchFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBatchFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBatchFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BatchFees = append(m.BatchFees, BatchFees{})
+			if err := m.BatchFees[len(m.BatchFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLastPendingBatchRequestByAddrRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLastPendingBatchRequestByAddrRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLastPendingBatchRequestByAddrRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLastPendingBatchRequestByAddrResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLastPendingBatchRequestByAddrResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLastPendingBatchRequestByAddrResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Batch = append(m.Batch, OutgoingTxBatch{})
+			if err := m.Batch[len(m.Batch)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLastPendingLogicCallByAddrRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLastPendingLogicCallByAddrRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLastPendingLogicCallByAddrRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLastPendingLogicCallByAddrResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLastPendingLogicCallByAddrResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLastPendingLogicCallByAddrResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Call", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Call = append(m.Call, OutgoingLogicCall{})
+			if err := m.Call[len(m.Call)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOutgoingTxBatchesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOutgoingTxBatchesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOutgoingTxBatchesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOutgoingTxBatchesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOutgoingTxBatchesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOutgoingTxBatchesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Batches = append(m.Batches, OutgoingTxBatch{})
+			if err := m.Batches[len(m.Batches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOutgoingLogicCallsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOutgoingLogicCallsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOutgoingLogicCallsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOutgoingLogicCallsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOutgoingLogicCallsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOutgoingLogicCallsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Calls", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Calls = append(m.Calls, OutgoingLogicCall{})
+			if err := m.Calls[len(m.Calls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBatchRequestByNonceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBatchRequestByNonceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBatchRequestByNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBatchRequestByNonceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBatchRequestByNonceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBatchRequestByNonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Batch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBatchConfirmsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBatchConfirmsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBatchConfirmsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBatchConfirmsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBatchConfirmsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBatchConfirmsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Confirms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Confirms = append(m.Confirms, MsgConfirmBatch{})
+			if err := m.Confirms[len(m.Confirms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLogicConfirmsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLogicConfirmsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLogicConfirmsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InvalidationId = append(m.InvalidationId[:0], dAtA[iNdEx:postIndex]...)
+			if m.InvalidationId == nil {
+				m.InvalidationId = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationNonce", wireType)
+			}
+			m.InvalidationNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InvalidationNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLogicConfirmsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLogicConfirmsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLogicConfirmsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Confirms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Confirms = append(m.Confirms, MsgConfirmLogicCall{})
+			if err := m.Confirms[len(m.Confirms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLastEventNonceByAddrRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLastEventNonceByAddrRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLastEventNonceByAddrRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *QueryBridgeBalanceSnapshotByEventNonceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Snapshot != nil {
-		l = m.Snapshot.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryLastEventNonceByAddrResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7463,12 +13810,31 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLastEventNonceByAddrResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLastEventNonceByAddrResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
+			}
+			m.EventNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7490,7 +13856,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryERC20ToDenomRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7513,17 +13879,17 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryERC20ToDenomRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryERC20ToDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7533,25 +13899,158 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Erc20 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryERC20ToDenomResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryERC20ToDenomResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryERC20ToDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosOriginated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CosmosOriginated = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7573,7 +14072,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCurrentValsetRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomToERC20Request) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7596,13 +14095,45 @@ func (m *QueryCurrentValsetRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCurrentValsetRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomToERC20Request: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCurrentValsetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomToERC20Request: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
 			}
@@ -7655,7 +14186,7 @@ func (m *QueryCurrentValsetRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCurrentValsetResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDenomToERC20Response) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7678,17 +14209,17 @@ func (m *QueryCurrentValsetResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCurrentValsetResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDenomToERC20Response: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCurrentValsetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDenomToERC20Response: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Valset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7698,25 +14229,44 @@ func (m *QueryCurrentValsetResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Valset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Erc20 = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosOriginated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CosmosOriginated = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7738,7 +14288,7 @@ func (m *QueryCurrentValsetResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValsetRequestRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryLastObservedEthBlockRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7761,17 +14311,17 @@ func (m *QueryValsetRequestRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValsetRequestRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLastObservedEthBlockRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValsetRequestRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLastObservedEthBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UseV1Key", wireType)
 			}
-			m.Nonce = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7781,11 +14331,12 @@ func (m *QueryValsetRequestRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.UseV1Key = bool(v != 0)
 		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
@@ -7839,7 +14390,7 @@ func (m *QueryValsetRequestRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValsetRequestResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryLastObservedEthBlockResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7862,17 +14413,17 @@ func (m *QueryValsetRequestResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValsetRequestResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLastObservedEthBlockResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValsetRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLastObservedEthBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Valset", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
 			}
-			var msglen int
+			m.Block = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7882,28 +14433,11 @@ func (m *QueryValsetRequestResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Block |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Valset == nil {
-				m.Valset = &Valset{}
-			}
-			if err := m.Valset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7925,7 +14459,7 @@ func (m *QueryValsetRequestResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValsetConfirmRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryLastObservedEthNonceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7948,17 +14482,17 @@ func (m *QueryValsetConfirmRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValsetConfirmRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLastObservedEthNonceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValsetConfirmRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLastObservedEthNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UseV1Key", wireType)
 			}
-			m.Nonce = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7968,44 +14502,13 @@ func (m *QueryValsetConfirmRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.UseV1Key = bool(v != 0)
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
 			}
@@ -8058,7 +14561,7 @@ func (m *QueryValsetConfirmRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValsetConfirmResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryLastObservedEthNonceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8081,17 +14584,17 @@ func (m *QueryValsetConfirmResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValsetConfirmResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLastObservedEthNonceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValsetConfirmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLastObservedEthNonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Confirm", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
-			var msglen int
+			m.Nonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8101,28 +14604,11 @@ func (m *QueryValsetConfirmResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Nonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Confirm == nil {
-				m.Confirm = &MsgValsetConfirm{}
-			}
-			if err := m.Confirm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8144,7 +14630,7 @@ func (m *QueryValsetConfirmResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValsetConfirmsByNonceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAttestationsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8167,17 +14653,17 @@ func (m *QueryValsetConfirmsByNonceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValsetConfirmsByNonceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAttestationsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValsetConfirmsByNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAttestationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			m.Nonce = 0
+			m.Limit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8187,14 +14673,14 @@ func (m *QueryValsetConfirmsByNonceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
+				m.Limit |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderBy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8222,63 +14708,103 @@ func (m *QueryValsetConfirmsByNonceRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
+			m.OrderBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimType", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryValsetConfirmsByNonceResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.ClaimType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UseV1Key", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValsetConfirmsByNonceResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValsetConfirmsByNonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.UseV1Key = bool(v != 0)
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Confirms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8288,25 +14814,23 @@ func (m *QueryValsetConfirmsByNonceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Confirms = append(m.Confirms, MsgValsetConfirm{})
-			if err := m.Confirms[len(m.Confirms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8329,7 +14853,7 @@ func (m *QueryValsetConfirmsByNonceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLastValsetRequestsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAttestationsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8352,17 +14876,17 @@ func (m *QueryLastValsetRequestsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLastValsetRequestsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAttestationsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLastValsetRequestsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAttestationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8372,23 +14896,25 @@ func (m *QueryLastValsetRequestsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
+			m.Attestations = append(m.Attestations, Attestation{})
+			if err := m.Attestations[len(m.Attestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8411,7 +14937,7 @@ func (m *QueryLastValsetRequestsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLastValsetRequestsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegateKeysByValidatorAddress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8434,17 +14960,17 @@ func (m *QueryLastValsetRequestsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLastValsetRequestsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegateKeysByValidatorAddress: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLastValsetRequestsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegateKeysByValidatorAddress: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Valsets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8454,25 +14980,23 @@ func (m *QueryLastValsetRequestsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Valsets = append(m.Valsets, Valset{})
-			if err := m.Valsets[len(m.Valsets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8495,7 +15019,7 @@ func (m *QueryLastValsetRequestsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLastPendingValsetRequestByAddrRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegateKeysByValidatorAddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8518,15 +15042,15 @@ func (m *QueryLastPendingValsetRequestByAddrRequest) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLastPendingValsetRequestByAddrRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegateKeysByValidatorAddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLastPendingValsetRequestByAddrRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegateKeysByValidatorAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EthAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8554,11 +15078,11 @@ func (m *QueryLastPendingValsetRequestByAddrRequest) Unmarshal(dAtA []byte) erro
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.EthAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrchestratorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8586,7 +15110,7 @@ func (m *QueryLastPendingValsetRequestByAddrRequest) Unmarshal(dAtA []byte) erro
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
+			m.OrchestratorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8609,7 +15133,7 @@ func (m *QueryLastPendingValsetRequestByAddrRequest) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
-func (m *QueryLastPendingValsetRequestByAddrResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegateKeysByEthAddress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8632,17 +15156,17 @@ func (m *QueryLastPendingValsetRequestByAddrResponse) Unmarshal(dAtA []byte) err
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLastPendingValsetRequestByAddrResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegateKeysByEthAddress: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLastPendingValsetRequestByAddrResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegateKeysByEthAddress: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Valsets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EthAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8652,25 +15176,23 @@ func (m *QueryLastPendingValsetRequestByAddrResponse) Unmarshal(dAtA []byte) err
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Valsets = append(m.Valsets, Valset{})
-			if err := m.Valsets[len(m.Valsets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.EthAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8693,7 +15215,7 @@ func (m *QueryLastPendingValsetRequestByAddrResponse) Unmarshal(dAtA []byte) err
 	}
 	return nil
 }
-func (m *QueryBatchFeeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegateKeysByEthAddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8716,15 +15238,15 @@ func (m *QueryBatchFeeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBatchFeeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegateKeysByEthAddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBatchFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegateKeysByEthAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8752,63 +15274,13 @@ func (m *QueryBatchFeeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryBatchFeeResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBatchFeeResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBatchFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchFees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrchestratorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8818,25 +15290,23 @@ func (m *QueryBatchFeeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BatchFees = append(m.BatchFees, BatchFees{})
-			if err := m.BatchFees[len(m.BatchFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.OrchestratorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8859,7 +15329,7 @@ func (m *QueryBatchFeeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLastPendingBatchRequestByAddrRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegateKeysByOrchestratorAddress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8881,48 +15351,16 @@ func (m *QueryLastPendingBatchRequestByAddrRequest) Unmarshal(dAtA []byte) error
 		}
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLastPendingBatchRequestByAddrRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLastPendingBatchRequestByAddrRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegateKeysByOrchestratorAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegateKeysByOrchestratorAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrchestratorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8950,7 +15388,7 @@ func (m *QueryLastPendingBatchRequestByAddrRequest) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
+			m.OrchestratorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8973,7 +15411,7 @@ func (m *QueryLastPendingBatchRequestByAddrRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryLastPendingBatchRequestByAddrResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDelegateKeysByOrchestratorAddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8996,17 +15434,17 @@ func (m *QueryLastPendingBatchRequestByAddrResponse) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLastPendingBatchRequestByAddrResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDelegateKeysByOrchestratorAddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLastPendingBatchRequestByAddrResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDelegateKeysByOrchestratorAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9016,25 +15454,55 @@ func (m *QueryLastPendingBatchRequestByAddrResponse) Unmarshal(dAtA []byte) erro
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Batch = append(m.Batch, OutgoingTxBatch{})
-			if err := m.Batch[len(m.Batch)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9057,7 +15525,7 @@ func (m *QueryLastPendingBatchRequestByAddrResponse) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
-func (m *QueryLastPendingLogicCallByAddrRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingSendToEth) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9080,15 +15548,15 @@ func (m *QueryLastPendingLogicCallByAddrRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLastPendingLogicCallByAddrRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingSendToEth: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLastPendingLogicCallByAddrRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingSendToEth: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9116,7 +15584,7 @@ func (m *QueryLastPendingLogicCallByAddrRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.SenderAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -9171,7 +15639,7 @@ func (m *QueryLastPendingLogicCallByAddrRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLastPendingLogicCallByAddrResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingSendToEthResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9194,15 +15662,15 @@ func (m *QueryLastPendingLogicCallByAddrResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLastPendingLogicCallByAddrResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingSendToEthResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLastPendingLogicCallByAddrResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingSendToEthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Call", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TransfersInBatches", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9229,8 +15697,42 @@ func (m *QueryLastPendingLogicCallByAddrResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Call = append(m.Call, OutgoingLogicCall{})
-			if err := m.Call[len(m.Call)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TransfersInBatches = append(m.TransfersInBatches, OutgoingTransferTx{})
+			if err := m.TransfersInBatches[len(m.TransfersInBatches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbatchedTransfers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbatchedTransfers = append(m.UnbatchedTransfers, OutgoingTransferTx{})
+			if err := m.UnbatchedTransfers[len(m.UnbatchedTransfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9255,7 +15757,7 @@ func (m *QueryLastPendingLogicCallByAddrResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOutgoingTxBatchesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingIbcAutoForwards) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9278,13 +15780,32 @@ func (m *QueryOutgoingTxBatchesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOutgoingTxBatchesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingIbcAutoForwards: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOutgoingTxBatchesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingIbcAutoForwards: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
 			}
@@ -9337,7 +15858,7 @@ func (m *QueryOutgoingTxBatchesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOutgoingTxBatchesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingIbcAutoForwardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9360,15 +15881,15 @@ func (m *QueryOutgoingTxBatchesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOutgoingTxBatchesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingIbcAutoForwardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOutgoingTxBatchesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingIbcAutoForwardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Batches", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingIbcAutoForwards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9395,8 +15916,8 @@ func (m *QueryOutgoingTxBatchesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Batches = append(m.Batches, OutgoingTxBatch{})
-			if err := m.Batches[len(m.Batches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PendingIbcAutoForwards = append(m.PendingIbcAutoForwards, &PendingIbcAutoForward{})
+			if err := m.PendingIbcAutoForwards[len(m.PendingIbcAutoForwards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9421,7 +15942,7 @@ func (m *QueryOutgoingTxBatchesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOutgoingLogicCallsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryListEvmChains) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9444,17 +15965,17 @@ func (m *QueryOutgoingLogicCallsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOutgoingLogicCallsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListEvmChains: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOutgoingLogicCallsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListEvmChains: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			var stringLen uint64
+			m.Limit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9464,24 +15985,11 @@ func (m *QueryOutgoingLogicCallsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Limit |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9503,7 +16011,7 @@ func (m *QueryOutgoingLogicCallsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOutgoingLogicCallsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryListEvmChainsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9526,15 +16034,15 @@ func (m *QueryOutgoingLogicCallsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOutgoingLogicCallsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListEvmChainsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOutgoingLogicCallsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListEvmChainsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Calls", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChains", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9561,8 +16069,8 @@ func (m *QueryOutgoingLogicCallsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Calls = append(m.Calls, OutgoingLogicCall{})
-			if err := m.Calls[len(m.Calls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.EvmChains = append(m.EvmChains, EvmChain{})
+			if err := m.EvmChains[len(m.EvmChains)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9587,7 +16095,7 @@ func (m *QueryOutgoingLogicCallsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBatchRequestByNonceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryMonitoredERC20Addresses) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9610,64 +16118,13 @@ func (m *QueryBatchRequestByNonceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBatchRequestByNonceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMonitoredERC20Addresses: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBatchRequestByNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
-			}
-			m.Nonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ContractAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			return fmt.Errorf("proto: QueryMonitoredERC20Addresses: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
 			}
@@ -9720,7 +16177,7 @@ func (m *QueryBatchRequestByNonceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBatchRequestByNonceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMonitoredERC20AddressesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9743,17 +16200,17 @@ func (m *QueryBatchRequestByNonceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBatchRequestByNonceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMonitoredERC20AddressesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBatchRequestByNonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMonitoredERC20AddressesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9763,24 +16220,23 @@ func (m *QueryBatchRequestByNonceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Batch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9803,7 +16259,7 @@ func (m *QueryBatchRequestByNonceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBatchConfirmsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBridgeBalanceSnapshots) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9826,17 +16282,17 @@ func (m *QueryBatchConfirmsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBatchConfirmsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBridgeBalanceSnapshots: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBatchConfirmsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBridgeBalanceSnapshots: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			m.Nonce = 0
+			m.Limit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9846,16 +16302,16 @@ func (m *QueryBatchConfirmsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
+				m.Limit |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewestFirst", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9865,24 +16321,12 @@ func (m *QueryBatchConfirmsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ContractAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.NewestFirst = bool(v != 0)
 		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
@@ -9936,7 +16380,7 @@ func (m *QueryBatchConfirmsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBatchConfirmsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBridgeBalanceSnapshotsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9959,15 +16403,15 @@ func (m *QueryBatchConfirmsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBatchConfirmsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBridgeBalanceSnapshotsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBatchConfirmsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBridgeBalanceSnapshotsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Confirms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshots", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9994,8 +16438,8 @@ func (m *QueryBatchConfirmsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Confirms = append(m.Confirms, MsgConfirmBatch{})
-			if err := m.Confirms[len(m.Confirms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Snapshots = append(m.Snapshots, &BridgeBalanceSnapshot{})
+			if err := m.Snapshots[len(m.Snapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10020,7 +16464,7 @@ func (m *QueryBatchConfirmsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLogicConfirmsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBridgeBalanceSnapshotByEventNonce) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10043,51 +16487,17 @@ func (m *QueryLogicConfirmsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLogicConfirmsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBridgeBalanceSnapshotByEventNonce: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLogicConfirmsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBridgeBalanceSnapshotByEventNonce: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationId", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.InvalidationId = append(m.InvalidationId[:0], dAtA[iNdEx:postIndex]...)
-			if m.InvalidationId == nil {
-				m.InvalidationId = []byte{}
-			}
-			iNdEx = postIndex
-		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationNonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
-			m.InvalidationNonce = 0
+			m.Nonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10097,12 +16507,12 @@ func (m *QueryLogicConfirmsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.InvalidationNonce |= uint64(b&0x7F) << shift
+				m.Nonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
 			}
@@ -10155,7 +16565,7 @@ func (m *QueryLogicConfirmsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLogicConfirmsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBridgeBalanceSnapshotByEventNonceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10178,15 +16588,15 @@ func (m *QueryLogicConfirmsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLogicConfirmsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBridgeBalanceSnapshotByEventNonceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLogicConfirmsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBridgeBalanceSnapshotByEventNonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Confirms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshot", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10213,8 +16623,10 @@ func (m *QueryLogicConfirmsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Confirms = append(m.Confirms, MsgConfirmLogicCall{})
-			if err := m.Confirms[len(m.Confirms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Snapshot == nil {
+				m.Snapshot = &BridgeBalanceSnapshot{}
+			}
+			if err := m.Snapshot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10239,7 +16651,7 @@ func (m *QueryLogicConfirmsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLastEventNonceByAddrRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRateLimitsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10262,15 +16674,15 @@ func (m *QueryLastEventNonceByAddrRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLastEventNonceByAddrRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRateLimitsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLastEventNonceByAddrRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRateLimitsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10298,11 +16710,11 @@ func (m *QueryLastEventNonceByAddrRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10330,7 +16742,7 @@ func (m *QueryLastEventNonceByAddrRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10353,7 +16765,7 @@ func (m *QueryLastEventNonceByAddrRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLastEventNonceByAddrResponse) Unmarshal(dAtA []byte) error {
+func (m *RateLimitStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10376,17 +16788,17 @@ func (m *QueryLastEventNonceByAddrResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLastEventNonceByAddrResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RateLimitStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLastEventNonceByAddrResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RateLimitStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimit", wireType)
 			}
-			m.EventNonce = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10396,64 +16808,134 @@ func (m *QueryLastEventNonceByAddrResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EventNonce |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStart", wireType)
+			}
+			m.WindowStart = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowStart |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowEnd", wireType)
+			}
+			m.WindowEnd = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowEnd |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutboundUsed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryERC20ToDenomRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			if err := m.OutboundUsed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InboundUsed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.InboundUsed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryERC20ToDenomRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryERC20ToDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Erc20", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingOutbound", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10481,11 +16963,13 @@ func (m *QueryERC20ToDenomRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Erc20 = string(dAtA[iNdEx:postIndex])
+			if err := m.RemainingOutbound.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingInbound", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10513,7 +16997,9 @@ func (m *QueryERC20ToDenomRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
+			if err := m.RemainingInbound.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10536,7 +17022,7 @@ func (m *QueryERC20ToDenomRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryERC20ToDenomResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRateLimitsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10559,17 +17045,17 @@ func (m *QueryERC20ToDenomResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryERC20ToDenomResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRateLimitsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryERC20ToDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRateLimitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimits", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10579,44 +17065,26 @@ func (m *QueryERC20ToDenomResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CosmosOriginated", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.RateLimits = append(m.RateLimits, RateLimitStatus{})
+			if err := m.RateLimits[len(m.RateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.CosmosOriginated = bool(v != 0)
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -10638,7 +17106,7 @@ func (m *QueryERC20ToDenomResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomToERC20Request) Unmarshal(dAtA []byte) error {
+func (m *QueryHeldSendToCosmosRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10661,45 +17129,13 @@ func (m *QueryDenomToERC20Request) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomToERC20Request: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHeldSendToCosmosRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomToERC20Request: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHeldSendToCosmosRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
 			}
@@ -10752,7 +17188,7 @@ func (m *QueryDenomToERC20Request) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomToERC20Response) Unmarshal(dAtA []byte) error {
+func (m *QueryHeldSendToCosmosResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10775,17 +17211,17 @@ func (m *QueryDenomToERC20Response) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomToERC20Response: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHeldSendToCosmosResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomToERC20Response: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHeldSendToCosmosResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Erc20", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Held", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10795,44 +17231,26 @@ func (m *QueryDenomToERC20Response) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Erc20 = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CosmosOriginated", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Held = append(m.Held, HeldSendToCosmos{})
+			if err := m.Held[len(m.Held)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.CosmosOriginated = bool(v != 0)
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -10854,7 +17272,7 @@ func (m *QueryDenomToERC20Response) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLastObservedEthBlockRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryIbcBridgeFeesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10877,17 +17295,17 @@ func (m *QueryLastObservedEthBlockRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLastObservedEthBlockRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIbcBridgeFeesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLastObservedEthBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIbcBridgeFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UseV1Key", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10897,15 +17315,27 @@ func (m *QueryLastObservedEthBlockRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.UseV1Key = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10933,7 +17363,7 @@ func (m *QueryLastObservedEthBlockRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10956,7 +17386,7 @@ func (m *QueryLastObservedEthBlockRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLastObservedEthBlockResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryIbcBridgeFeesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10979,17 +17409,17 @@ func (m *QueryLastObservedEthBlockResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLastObservedEthBlockResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIbcBridgeFeesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLastObservedEthBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIbcBridgeFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeFees", wireType)
 			}
-			m.Block = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10999,11 +17429,26 @@ func (m *QueryLastObservedEthBlockResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Block |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeFees = append(m.BridgeFees, IbcBridgeFee{})
+			if err := m.BridgeFees[len(m.BridgeFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -11025,7 +17470,7 @@ func (m *QueryLastObservedEthBlockResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLastObservedEthNonceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryIbcAutoForwardLogsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11048,17 +17493,17 @@ func (m *QueryLastObservedEthNonceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLastObservedEthNonceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIbcAutoForwardLogsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLastObservedEthNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIbcAutoForwardLogsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UseV1Key", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -11068,17 +17513,48 @@ func (m *QueryLastObservedEthNonceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.UseV1Key = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
 			}
-			var stringLen uint64
+			m.EventNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -11088,24 +17564,11 @@ func (m *QueryLastObservedEthNonceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Limit |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -11127,7 +17590,7 @@ func (m *QueryLastObservedEthNonceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLastObservedEthNonceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryIbcAutoForwardLogsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11150,17 +17613,17 @@ func (m *QueryLastObservedEthNonceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLastObservedEthNonceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIbcAutoForwardLogsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLastObservedEthNonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIbcAutoForwardLogsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
 			}
-			m.Nonce = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -11170,11 +17633,26 @@ func (m *QueryLastObservedEthNonceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Logs = append(m.Logs, IbcAutoForwardLog{})
+			if err := m.Logs[len(m.Logs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -11196,7 +17674,7 @@ func (m *QueryLastObservedEthNonceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAttestationsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryIbcAutoForwardTransferRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11219,66 +17697,15 @@ func (m *QueryAttestationsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAttestationsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIbcAutoForwardTransferRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAttestationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIbcAutoForwardTransferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
-			}
-			m.Limit = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Limit |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderBy", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OrderBy = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClaimType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11306,71 +17733,13 @@ func (m *QueryAttestationsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClaimType = string(dAtA[iNdEx:postIndex])
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
-			}
-			m.Nonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
-			}
-			m.Height = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UseV1Key", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.UseV1Key = bool(v != 0)
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
 			}
-			var stringLen uint64
+			m.EventNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -11380,24 +17749,11 @@ func (m *QueryAttestationsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.EventNonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -11419,7 +17775,7 @@ func (m *QueryAttestationsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAttestationsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryIbcAutoForwardTransferResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11442,15 +17798,15 @@ func (m *QueryAttestationsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAttestationsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIbcAutoForwardTransferResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAttestationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIbcAutoForwardTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Transfer", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11477,8 +17833,7 @@ func (m *QueryAttestationsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Attestations = append(m.Attestations, Attestation{})
-			if err := m.Attestations[len(m.Attestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Transfer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -11503,7 +17858,7 @@ func (m *QueryAttestationsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegateKeysByValidatorAddress) Unmarshal(dAtA []byte) error {
+func (m *QueryFailedAttestationsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11526,15 +17881,15 @@ func (m *QueryDelegateKeysByValidatorAddress) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegateKeysByValidatorAddress: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFailedAttestationsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegateKeysByValidatorAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFailedAttestationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11562,7 +17917,7 @@ func (m *QueryDelegateKeysByValidatorAddress) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11585,7 +17940,7 @@ func (m *QueryDelegateKeysByValidatorAddress) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegateKeysByValidatorAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFailedAttestationsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11608,17 +17963,17 @@ func (m *QueryDelegateKeysByValidatorAddressResponse) Unmarshal(dAtA []byte) err
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegateKeysByValidatorAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFailedAttestationsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegateKeysByValidatorAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFailedAttestationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedAttestations", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -11628,27 +17983,79 @@ func (m *QueryDelegateKeysByValidatorAddressResponse) Unmarshal(dAtA []byte) err
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EthAddress = string(dAtA[iNdEx:postIndex])
+			m.FailedAttestations = append(m.FailedAttestations, FailedAttestation{})
+			if err := m.FailedAttestations[len(m.FailedAttestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEvmChainDecommissionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEvmChainDecommissionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEvmChainDecommissionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrchestratorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11676,7 +18083,7 @@ func (m *QueryDelegateKeysByValidatorAddressResponse) Unmarshal(dAtA []byte) err
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OrchestratorAddress = string(dAtA[iNdEx:postIndex])
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11699,7 +18106,7 @@ func (m *QueryDelegateKeysByValidatorAddressResponse) Unmarshal(dAtA []byte) err
 	}
 	return nil
 }
-func (m *QueryDelegateKeysByEthAddress) Unmarshal(dAtA []byte) error {
+func (m *QueryEvmChainDecommissionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11722,17 +18129,17 @@ func (m *QueryDelegateKeysByEthAddress) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegateKeysByEthAddress: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEvmChainDecommissionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegateKeysByEthAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEvmChainDecommissionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Decommission", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -11742,23 +18149,24 @@ func (m *QueryDelegateKeysByEthAddress) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EthAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.Decommission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11781,7 +18189,7 @@ func (m *QueryDelegateKeysByEthAddress) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegateKeysByEthAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBlacklistRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11804,15 +18212,15 @@ func (m *QueryDelegateKeysByEthAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegateKeysByEthAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBlacklistRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegateKeysByEthAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBlacklistRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11840,11 +18248,11 @@ func (m *QueryDelegateKeysByEthAddressResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrchestratorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11872,7 +18280,7 @@ func (m *QueryDelegateKeysByEthAddressResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OrchestratorAddress = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11895,7 +18303,7 @@ func (m *QueryDelegateKeysByEthAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegateKeysByOrchestratorAddress) Unmarshal(dAtA []byte) error {
+func (m *QueryBlacklistResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11918,17 +18326,17 @@ func (m *QueryDelegateKeysByOrchestratorAddress) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegateKeysByOrchestratorAddress: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBlacklistResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegateKeysByOrchestratorAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBlacklistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrchestratorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -11938,23 +18346,25 @@ func (m *QueryDelegateKeysByOrchestratorAddress) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OrchestratorAddress = string(dAtA[iNdEx:postIndex])
+			m.Entries = append(m.Entries, BlacklistEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11977,7 +18387,7 @@ func (m *QueryDelegateKeysByOrchestratorAddress) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDelegateKeysByOrchestratorAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBatchStrategiesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12000,47 +18410,15 @@ func (m *QueryDelegateKeysByOrchestratorAddressResponse) Unmarshal(dAtA []byte)
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDelegateKeysByOrchestratorAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBatchStrategiesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDelegateKeysByOrchestratorAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBatchStrategiesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12068,7 +18446,7 @@ func (m *QueryDelegateKeysByOrchestratorAddressResponse) Unmarshal(dAtA []byte)
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EthAddress = string(dAtA[iNdEx:postIndex])
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12091,7 +18469,7 @@ func (m *QueryDelegateKeysByOrchestratorAddressResponse) Unmarshal(dAtA []byte)
 	}
 	return nil
 }
-func (m *QueryPendingSendToEth) Unmarshal(dAtA []byte) error {
+func (m *QueryBatchStrategiesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12114,17 +18492,17 @@ func (m *QueryPendingSendToEth) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPendingSendToEth: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBatchStrategiesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPendingSendToEth: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBatchStrategiesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SenderAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategies", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -12134,29 +18512,31 @@ func (m *QueryPendingSendToEth) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SenderAddress = string(dAtA[iNdEx:postIndex])
+			m.Strategies = append(m.Strategies, TokenBatchStrategy{})
+			if err := m.Strategies[len(m.Strategies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBatchSize", wireType)
 			}
-			var stringLen uint64
+			m.MaxBatchSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -12166,24 +18546,11 @@ func (m *QueryPendingSendToEth) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MaxBatchSize |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -12205,7 +18572,7 @@ func (m *QueryPendingSendToEth) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPendingSendToEthResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBridgedSupplyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12228,17 +18595,17 @@ func (m *QueryPendingSendToEthResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPendingSendToEthResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBridgedSupplyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPendingSendToEthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBridgedSupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransfersInBatches", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -12248,29 +18615,77 @@ func (m *QueryPendingSendToEthResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TransfersInBatches = append(m.TransfersInBatches, OutgoingTransferTx{})
-			if err := m.TransfersInBatches[len(m.TransfersInBatches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 2:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBridgedSupplyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBridgedSupplyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBridgedSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbatchedTransfers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -12297,8 +18712,8 @@ func (m *QueryPendingSendToEthResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UnbatchedTransfers = append(m.UnbatchedTransfers, OutgoingTransferTx{})
-			if err := m.UnbatchedTransfers[len(m.UnbatchedTransfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Supply = append(m.Supply, ERC20Token{})
+			if err := m.Supply[len(m.Supply)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -12323,7 +18738,7 @@ func (m *QueryPendingSendToEthResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPendingIbcAutoForwards) Unmarshal(dAtA []byte) error {
+func (m *QueryCheckpointRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12342,21 +18757,85 @@ func (m *QueryPendingIbcAutoForwards) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPendingIbcAutoForwards: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPendingIbcAutoForwards: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCheckpointRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCheckpointRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
-			m.Limit = 0
+			m.Nonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -12366,14 +18845,14 @@ func (m *QueryPendingIbcAutoForwards) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Limit |= uint64(b&0x7F) << shift
+				m.Nonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12401,63 +18880,13 @@ func (m *QueryPendingIbcAutoForwards) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryPendingIbcAutoForwardsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPendingIbcAutoForwardsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPendingIbcAutoForwardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingIbcAutoForwards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -12467,25 +18896,23 @@ func (m *QueryPendingIbcAutoForwardsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PendingIbcAutoForwards = append(m.PendingIbcAutoForwards, &PendingIbcAutoForward{})
-			if err := m.PendingIbcAutoForwards[len(m.PendingIbcAutoForwards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.InvalidationId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12508,7 +18935,7 @@ func (m *QueryPendingIbcAutoForwardsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListEvmChains) Unmarshal(dAtA []byte) error {
+func (m *QueryCheckpointResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12531,17 +18958,17 @@ func (m *QueryListEvmChains) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListEvmChains: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCheckpointResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListEvmChains: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCheckpointResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GravityId", wireType)
 			}
-			m.Limit = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -12551,66 +18978,29 @@ func (m *QueryListEvmChains) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Limit |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryListEvmChainsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListEvmChainsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListEvmChainsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.GravityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EvmChains", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AbiEncoded", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -12620,76 +19010,90 @@ func (m *QueryListEvmChainsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EvmChains = append(m.EvmChains, EvmChain{})
-			if err := m.EvmChains[len(m.EvmChains)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AbiEncoded = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checkpoint", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryMonitoredERC20Addresses) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.Checkpoint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Confirms", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMonitoredERC20Addresses: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMonitoredERC20Addresses: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Confirms = append(m.Confirms, CheckpointConfirm{})
+			if err := m.Confirms[len(m.Confirms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -12711,7 +19115,7 @@ func (m *QueryMonitoredERC20Addresses) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMonitoredERC20AddressesResponse) Unmarshal(dAtA []byte) error {
+func (m *CheckpointConfirm) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12734,15 +19138,15 @@ func (m *QueryMonitoredERC20AddressesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMonitoredERC20AddressesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CheckpointConfirm: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMonitoredERC20AddressesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CheckpointConfirm: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Orchestrator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12770,63 +19174,45 @@ func (m *QueryMonitoredERC20AddressesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			m.Orchestrator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthSigner", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryBridgeBalanceSnapshots) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBridgeBalanceSnapshots: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBridgeBalanceSnapshots: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			m.EthSigner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
 			}
-			m.Limit = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -12836,16 +19222,29 @@ func (m *QueryBridgeBalanceSnapshots) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Limit |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewestFirst", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			var v int
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecoveredSigner", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -12855,67 +19254,81 @@ func (m *QueryBridgeBalanceSnapshots) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.NewestFirst = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryBridgeBalanceSnapshotsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.RecoveredSigner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegateEthAddress", wireType)
 			}
-			if iNdEx >= l {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.DelegateEthAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBridgeBalanceSnapshotsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBridgeBalanceSnapshotsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Valid = bool(v != 0)
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Snapshots", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -12925,25 +19338,23 @@ func (m *QueryBridgeBalanceSnapshotsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Snapshots = append(m.Snapshots, &BridgeBalanceSnapshot{})
-			if err := m.Snapshots[len(m.Snapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12966,7 +19377,7 @@ func (m *QueryBridgeBalanceSnapshotsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBridgeBalanceSnapshotByEventNonce) Unmarshal(dAtA []byte) error {
+func (m *QueryEvidenceRecordsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12989,17 +19400,17 @@ func (m *QueryBridgeBalanceSnapshotByEventNonce) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBridgeBalanceSnapshotByEventNonce: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEvidenceRecordsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBridgeBalanceSnapshotByEventNonce: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEvidenceRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
 			}
-			m.Nonce = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -13009,14 +19420,27 @@ func (m *QueryBridgeBalanceSnapshotByEventNonce) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Offender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13044,7 +19468,7 @@ func (m *QueryBridgeBalanceSnapshotByEventNonce) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
+			m.Offender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13067,7 +19491,7 @@ func (m *QueryBridgeBalanceSnapshotByEventNonce) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBridgeBalanceSnapshotByEventNonceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEvidenceRecordsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13090,15 +19514,15 @@ func (m *QueryBridgeBalanceSnapshotByEventNonceResponse) Unmarshal(dAtA []byte)
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBridgeBalanceSnapshotByEventNonceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEvidenceRecordsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBridgeBalanceSnapshotByEventNonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEvidenceRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Snapshot", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13125,10 +19549,8 @@ func (m *QueryBridgeBalanceSnapshotByEventNonceResponse) Unmarshal(dAtA []byte)
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Snapshot == nil {
-				m.Snapshot = &BridgeBalanceSnapshot{}
-			}
-			if err := m.Snapshot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Records = append(m.Records, EvidenceRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
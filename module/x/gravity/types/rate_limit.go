@@ -0,0 +1,94 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ValidateBasic performs stateless checks on a RateLimit
+func (r RateLimit) ValidateBasic() error {
+	if len(strings.TrimSpace(r.EvmChainPrefix)) == 0 {
+		return fmt.Errorf("evm chain prefix cannot be empty")
+	}
+	if err := sdk.ValidateDenom(r.Denom); err != nil {
+		return err
+	}
+	if r.WindowBlocks == 0 {
+		return fmt.Errorf("rate limit window cannot be zero blocks")
+	}
+	if r.MaxOutbound.IsNil() || r.MaxOutbound.IsNegative() {
+		return fmt.Errorf("invalid max outbound %v", r.MaxOutbound)
+	}
+	if r.MaxInbound.IsNil() || r.MaxInbound.IsNegative() {
+		return fmt.Errorf("invalid max inbound %v", r.MaxInbound)
+	}
+	if r.MaxOutbound.IsZero() && r.MaxInbound.IsZero() {
+		return fmt.Errorf("rate limit does not limit either direction")
+	}
+	return nil
+}
+
+// IsOutboundLimited returns true if sends to the evm chain are capped
+func (r RateLimit) IsOutboundLimited() bool {
+	return r.MaxOutbound.IsPositive()
+}
+
+// IsInboundLimited returns true if deposits from the evm chain are capped
+func (r RateLimit) IsInboundLimited() bool {
+	return r.MaxInbound.IsPositive()
+}
+
+// NewRateLimitFlow returns an empty flow of `denom` for a window starting at `height`
+func NewRateLimitFlow(denom string, height uint64) RateLimitFlow {
+	return RateLimitFlow{
+		WindowStart: height,
+		Outbound:    sdk.ZeroInt(),
+		Inbound:     sdk.ZeroInt(),
+		Denom:       denom,
+	}
+}
+
+// ValidateBasic performs stateless checks on a RateLimitFlow
+func (f RateLimitFlow) ValidateBasic() error {
+	if err := sdk.ValidateDenom(f.Denom); err != nil {
+		return fmt.Errorf("rate limit flow has an invalid denom: %v", err)
+	}
+	if f.Outbound.IsNil() || f.Outbound.IsNegative() || f.Inbound.IsNil() || f.Inbound.IsNegative() {
+		return fmt.Errorf("rate limit flow of %s has a negative amount", f.Denom)
+	}
+	return nil
+}
+
+// ValidateBasic performs stateless checks on a HeldSendToCosmos
+func (h HeldSendToCosmos) ValidateBasic() error {
+	if h.EventNonce == 0 {
+		return fmt.Errorf("held send to cosmos has a zero event nonce")
+	}
+	if _, err := NewEthAddress(h.TokenContract); err != nil {
+		return fmt.Errorf("held send to cosmos has an invalid token contract: %v", err)
+	}
+	if _, err := NewEthAddress(h.EthereumSender); err != nil {
+		return fmt.Errorf("held send to cosmos has an invalid ethereum sender: %v", err)
+	}
+	if !h.Token.IsValid() || h.Token.IsZero() {
+		return fmt.Errorf("held send to cosmos has an invalid token %v", h.Token)
+	}
+	return nil
+}
+
+// ToClaim rebuilds the MsgSendToCosmosClaim which produced the held deposit on the given evm chain,
+// only the claim's content is recovered, the orchestrator who submitted it is not retained
+func (h HeldSendToCosmos) ToClaim(evmChainPrefix string) MsgSendToCosmosClaim {
+	return MsgSendToCosmosClaim{
+		EventNonce:     h.EventNonce,
+		EthBlockHeight: h.EthBlockHeight,
+		TokenContract:  h.TokenContract,
+		Amount:         h.Token.Amount,
+		EthereumSender: h.EthereumSender,
+		CosmosReceiver: h.CosmosReceiver,
+		Orchestrator:   "",
+		EvmChainPrefix: evmChainPrefix,
+	}
+}
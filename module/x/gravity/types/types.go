@@ -1,8 +1,10 @@
 package types
 
 import (
+	"bytes"
 	"crypto/md5"
 	"encoding/binary"
+	"encoding/json"
 	fmt "fmt"
 	"strconv"
 	"strings"
@@ -158,6 +160,147 @@ func ParseReceiverRaw(receiver string) (sourceChannel, cosmosReceiver, destinati
 	return
 }
 
+// SendToEthMemo holds the instructions carried by the memo of an IBC transfer which is forwarded to an evm chain,
+// a nil fee means the memo did not name one and an empty refund keeps the IBC receiver as the owner of the transfer
+type SendToEthMemo struct {
+	EvmChainPrefix string
+	Destination    string
+	BridgeFee      *sdk.Int
+	ChainFee       *sdk.Int
+	Refund         string
+}
+
+// GravityMemoVersion is the current version of the JSON memo format
+const GravityMemoVersion = 1
+
+// gravityMemo is the JSON memo format, the instructions for gravity live under the "gravity" key so that the memo
+// can be shared with other IBC middleware:
+// {"gravity":{"version":1,"evm_chain_prefix":"bsc","dest":"0x...","bridge_fee":"10","chain_fee":"5","refund":"oraib1..."}}
+type gravityMemo struct {
+	Version        uint64   `json:"version,omitempty"`
+	EvmChainPrefix string   `json:"evm_chain_prefix"`
+	Dest           string   `json:"dest"`
+	BridgeFee      *sdk.Int `json:"bridge_fee,omitempty"`
+	ChainFee       *sdk.Int `json:"chain_fee,omitempty"`
+	Refund         string   `json:"refund,omitempty"`
+}
+
+// ValidateBasic performs stateless checks on the instructions of a memo
+func (m SendToEthMemo) ValidateBasic() error {
+	if len(strings.TrimSpace(m.EvmChainPrefix)) == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "memo evm chain prefix cannot be empty")
+	}
+	if _, err := NewEthAddress(m.Destination); err != nil {
+		return sdkerrors.Wrap(err, "memo destination")
+	}
+	if m.BridgeFee != nil && (m.BridgeFee.IsNil() || m.BridgeFee.IsNegative()) {
+		return sdkerrors.Wrapf(ErrInvalid, "invalid memo bridge fee %v", m.BridgeFee)
+	}
+	if m.ChainFee != nil && (m.ChainFee.IsNil() || m.ChainFee.IsNegative()) {
+		return sdkerrors.Wrapf(ErrInvalid, "invalid memo chain fee %v", m.ChainFee)
+	}
+	if m.Refund != "" {
+		if _, err := sdk.AccAddressFromBech32(m.Refund); err != nil {
+			return sdkerrors.Wrap(err, "memo refund address")
+		}
+	}
+	return nil
+}
+
+// ParseSendToEthMemo parses the memo of an incoming IBC transfer, returning nil without error when the memo holds no
+// instructions for gravity. JSON memos are read from their "gravity" key, see gravityMemo, any other memo uses the
+// legacy format:
+// <evm chain prefix>0x<dest> => forward to dest on the evm chain
+// <evm chain prefix>0x<dest>:<bridge fee> => forward paying the given bridge fee
+// <evm chain prefix>0x<dest>:<bridge fee>:<chain fee> => forward paying the given bridge and chain fees
+// fees are integer amounts of the transferred denom
+func ParseSendToEthMemo(memo string) (*SendToEthMemo, error) {
+	var parsed *SendToEthMemo
+	var err error
+	if strings.HasPrefix(strings.TrimSpace(memo), "{") {
+		parsed, err = parseJSONSendToEthMemo(memo)
+	} else {
+		parsed, err = parseLegacySendToEthMemo(memo)
+	}
+	if err != nil || parsed == nil {
+		return nil, err
+	}
+	if err := parsed.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	return parsed, nil
+}
+
+// parseJSONSendToEthMemo parses a JSON memo, memos without a "gravity" key are meant for other middleware
+func parseJSONSendToEthMemo(memo string) (*SendToEthMemo, error) {
+	var root map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(memo), &root); err != nil {
+		return nil, sdkerrors.Wrapf(ErrInvalid, "memo is not valid json: %v", err)
+	}
+	raw, ok := root["gravity"]
+	if !ok {
+		return nil, nil
+	}
+
+	var gm gravityMemo
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&gm); err != nil {
+		return nil, sdkerrors.Wrapf(ErrInvalid, "invalid gravity memo: %v", err)
+	}
+	if gm.Version != 0 && gm.Version != GravityMemoVersion {
+		return nil, sdkerrors.Wrapf(ErrUnsupported, "gravity memo version %d, expected %d", gm.Version, GravityMemoVersion)
+	}
+	return &SendToEthMemo{
+		EvmChainPrefix: gm.EvmChainPrefix,
+		Destination:    gm.Dest,
+		BridgeFee:      gm.BridgeFee,
+		ChainFee:       gm.ChainFee,
+		Refund:         gm.Refund,
+	}, nil
+}
+
+// parseLegacySendToEthMemo parses the <evm chain prefix>0x<dest>[:<bridge fee>[:<chain fee>]] memo format
+func parseLegacySendToEthMemo(memo string) (*SendToEthMemo, error) {
+	ind := strings.Index(memo, "0x")
+	if ind == -1 {
+		return nil, nil
+	}
+
+	args := strings.Split(memo[ind:], ":")
+	if len(args) > 3 {
+		return nil, sdkerrors.Wrapf(ErrInvalid, "send to eth memo has too many parts: %s", memo)
+	}
+	parsed := &SendToEthMemo{
+		EvmChainPrefix: memo[:ind],
+		Destination:    args[0],
+	}
+	if len(args) > 1 {
+		bridgeFee, err := parseMemoFee(args[1])
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, "bridge fee")
+		}
+		parsed.BridgeFee = &bridgeFee
+	}
+	if len(args) > 2 {
+		chainFee, err := parseMemoFee(args[2])
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, "chain fee")
+		}
+		parsed.ChainFee = &chainFee
+	}
+	return parsed, nil
+}
+
+// parseMemoFee parses a non-negative integer fee amount from a memo
+func parseMemoFee(fee string) (sdk.Int, error) {
+	amount, ok := sdk.NewIntFromString(fee)
+	if !ok || amount.IsNegative() {
+		return sdk.Int{}, sdkerrors.Wrapf(ErrInvalid, "invalid fee amount %s", fee)
+	}
+	return amount, nil
+}
+
 // // channel/sender:channel/receiver:denom
 // func ParseDestinationRaw(destination string) (receiver, destChannel, denom string, isCosmos bool) {
 // 	isCosmos = true
@@ -27,6 +27,169 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ValsetReason is the trigger which caused a valset to be requested
+type ValsetReason int32
+
+const (
+	// An unspecified reason, the valset was requested manually
+	VALSET_REASON_UNSPECIFIED ValsetReason = 0
+	// The evm chain had no valset yet
+	VALSET_REASON_FIRST ValsetReason = 1
+	// A validator started unbonding in this block
+	VALSET_REASON_UNBONDING ValsetReason = 2
+	// The power of the current validators differs from the latest valset by more
+	// than the chain's power diff threshold
+	VALSET_REASON_POWER_DIFF ValsetReason = 3
+	// The latest valset is older than the chain's maximum valset interval
+	VALSET_REASON_MAX_INTERVAL ValsetReason = 4
+	// A validator set its delegate keys in this block
+	VALSET_REASON_DELEGATE_KEY_CHANGE ValsetReason = 5
+)
+
+var ValsetReason_name = map[int32]string{
+	0: "VALSET_REASON_UNSPECIFIED",
+	1: "VALSET_REASON_FIRST",
+	2: "VALSET_REASON_UNBONDING",
+	3: "VALSET_REASON_POWER_DIFF",
+	4: "VALSET_REASON_MAX_INTERVAL",
+	5: "VALSET_REASON_DELEGATE_KEY_CHANGE",
+}
+
+var ValsetReason_value = map[string]int32{
+	"VALSET_REASON_UNSPECIFIED":         0,
+	"VALSET_REASON_FIRST":               1,
+	"VALSET_REASON_UNBONDING":           2,
+	"VALSET_REASON_POWER_DIFF":          3,
+	"VALSET_REASON_MAX_INTERVAL":        4,
+	"VALSET_REASON_DELEGATE_KEY_CHANGE": 5,
+}
+
+func (x ValsetReason) String() string {
+	return proto.EnumName(ValsetReason_name, int32(x))
+}
+
+func (ValsetReason) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{0}
+}
+
+// IbcAutoForwardStatus is the lifecycle of an ibc transfer sent for a
+// PendingIbcAutoForward
+type IbcAutoForwardStatus int32
+
+const (
+	// An unspecified status
+	IBC_AUTO_FORWARD_STATUS_UNSPECIFIED IbcAutoForwardStatus = 0
+	// The packet has been sent and awaits an acknowledgement or timeout
+	IBC_AUTO_FORWARD_STATUS_IN_FLIGHT IbcAutoForwardStatus = 1
+	// The counterparty acknowledged the packet, the funds reached the receiver
+	IBC_AUTO_FORWARD_STATUS_ACKNOWLEDGED IbcAutoForwardStatus = 2
+	// The counterparty acknowledged the packet with an error, the funds were
+	// refunded to the local fallback account
+	IBC_AUTO_FORWARD_STATUS_FAILED IbcAutoForwardStatus = 3
+	// The packet timed out, the funds were refunded to the local fallback
+	// account
+	IBC_AUTO_FORWARD_STATUS_TIMED_OUT IbcAutoForwardStatus = 4
+)
+
+var IbcAutoForwardStatus_name = map[int32]string{
+	0: "IBC_AUTO_FORWARD_STATUS_UNSPECIFIED",
+	1: "IBC_AUTO_FORWARD_STATUS_IN_FLIGHT",
+	2: "IBC_AUTO_FORWARD_STATUS_ACKNOWLEDGED",
+	3: "IBC_AUTO_FORWARD_STATUS_FAILED",
+	4: "IBC_AUTO_FORWARD_STATUS_TIMED_OUT",
+}
+
+var IbcAutoForwardStatus_value = map[string]int32{
+	"IBC_AUTO_FORWARD_STATUS_UNSPECIFIED":  0,
+	"IBC_AUTO_FORWARD_STATUS_IN_FLIGHT":    1,
+	"IBC_AUTO_FORWARD_STATUS_ACKNOWLEDGED": 2,
+	"IBC_AUTO_FORWARD_STATUS_FAILED":       3,
+	"IBC_AUTO_FORWARD_STATUS_TIMED_OUT":    4,
+}
+
+func (x IbcAutoForwardStatus) String() string {
+	return proto.EnumName(IbcAutoForwardStatus_name, int32(x))
+}
+
+func (IbcAutoForwardStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{1}
+}
+
+// EvmChainDecommissionStatus is the stage an evm chain removed by a
+// RemoveEvmChainProposal has reached
+type EvmChainDecommissionStatus int32
+
+const (
+	// An unspecified status
+	EVM_CHAIN_DECOMMISSION_STATUS_UNSPECIFIED EvmChainDecommissionStatus = 0
+	// The chain rejects new withdrawals and batches while its escrowed balances
+	// are refunded or processed
+	EVM_CHAIN_DECOMMISSION_STATUS_DRAINING EvmChainDecommissionStatus = 1
+	// The chain and all of its state have been removed
+	EVM_CHAIN_DECOMMISSION_STATUS_REMOVED EvmChainDecommissionStatus = 2
+)
+
+var EvmChainDecommissionStatus_name = map[int32]string{
+	0: "EVM_CHAIN_DECOMMISSION_STATUS_UNSPECIFIED",
+	1: "EVM_CHAIN_DECOMMISSION_STATUS_DRAINING",
+	2: "EVM_CHAIN_DECOMMISSION_STATUS_REMOVED",
+}
+
+var EvmChainDecommissionStatus_value = map[string]int32{
+	"EVM_CHAIN_DECOMMISSION_STATUS_UNSPECIFIED": 0,
+	"EVM_CHAIN_DECOMMISSION_STATUS_DRAINING":    1,
+	"EVM_CHAIN_DECOMMISSION_STATUS_REMOVED":     2,
+}
+
+func (x EvmChainDecommissionStatus) String() string {
+	return proto.EnumName(EvmChainDecommissionStatus_name, int32(x))
+}
+
+func (EvmChainDecommissionStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{2}
+}
+
+// BatchStrategy decides which unbatched txs of a token make up its next batch
+// and whether that batch may pay less fees than the last batch of the token
+type BatchStrategy int32
+
+const (
+	// No strategy was chosen, behaves as BATCH_STRATEGY_FEE_DESC
+	BATCH_STRATEGY_UNSPECIFIED BatchStrategy = 0
+	// The txs paying the highest fees, a new batch must pay more fees than the
+	// last batch of the token
+	BATCH_STRATEGY_FEE_DESC BatchStrategy = 1
+	// The oldest txs, a new batch paying less fees than the last batch of the
+	// token is allowed once its oldest tx has waited `max_wait_blocks`
+	BATCH_STRATEGY_FIFO BatchStrategy = 2
+	// The txs paying the highest fees among those paying at least
+	// `min_fee_per_tx`, a new batch must pay more fees than the last batch of
+	// the token
+	BATCH_STRATEGY_MIN_FEE BatchStrategy = 3
+)
+
+var BatchStrategy_name = map[int32]string{
+	0: "BATCH_STRATEGY_UNSPECIFIED",
+	1: "BATCH_STRATEGY_FEE_DESC",
+	2: "BATCH_STRATEGY_FIFO",
+	3: "BATCH_STRATEGY_MIN_FEE",
+}
+
+var BatchStrategy_value = map[string]int32{
+	"BATCH_STRATEGY_UNSPECIFIED": 0,
+	"BATCH_STRATEGY_FEE_DESC":    1,
+	"BATCH_STRATEGY_FIFO":        2,
+	"BATCH_STRATEGY_MIN_FEE":     3,
+}
+
+func (x BatchStrategy) String() string {
+	return proto.EnumName(BatchStrategy_name, int32(x))
+}
+
+func (BatchStrategy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{3}
+}
+
 type MonitoredERC20Addresses struct {
 	Addresses [][]byte `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
 }
@@ -134,6 +297,8 @@ type Valset struct {
 	RewardAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=reward_amount,json=rewardAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"reward_amount"`
 	// the reward token in it's Ethereum hex address representation
 	RewardToken string `protobuf:"bytes,5,opt,name=reward_token,json=rewardToken,proto3" json:"reward_token,omitempty"`
+	// why the valset was requested, not part of the checkpoint
+	Reason ValsetReason `protobuf:"varint,6,opt,name=reason,proto3,enum=gravity.v1.ValsetReason" json:"reason,omitempty"`
 }
 
 func (m *Valset) Reset()         { *m = Valset{} }
@@ -197,6 +362,13 @@ func (m *Valset) GetRewardToken() string {
 	return ""
 }
 
+func (m *Valset) GetReason() ValsetReason {
+	if m != nil {
+		return m.Reason
+	}
+	return VALSET_REASON_UNSPECIFIED
+}
+
 // LastObservedEthereumBlockHeight stores the last observed
 // Ethereum block height along with the Cosmos block height that
 // it was observed at. These two numbers can be used to project
@@ -460,6 +632,9 @@ type AddEvmChainProposal struct {
 	EvmChainNetVersion    uint64 `protobuf:"varint,5,opt,name=evm_chain_net_version,json=evmChainNetVersion,proto3" json:"evm_chain_net_version,omitempty"`
 	GravityId             string `protobuf:"bytes,6,opt,name=gravity_id,json=gravityId,proto3" json:"gravity_id,omitempty"`
 	BridgeEthereumAddress string `protobuf:"bytes,7,opt,name=bridge_ethereum_address,json=bridgeEthereumAddress,proto3" json:"bridge_ethereum_address,omitempty"`
+	// see the EvmChainParam fields of the same name
+	AttestationVotesPowerThreshold uint64 `protobuf:"varint,8,opt,name=attestation_votes_power_threshold,json=attestationVotesPowerThreshold,proto3" json:"attestation_votes_power_threshold,omitempty"`
+	AttestationPowerDelegatedOnly  bool   `protobuf:"varint,9,opt,name=attestation_power_delegated_only,json=attestationPowerDelegatedOnly,proto3" json:"attestation_power_delegated_only,omitempty"`
 }
 
 func (m *AddEvmChainProposal) Reset()      { *m = AddEvmChainProposal{} }
@@ -538,11 +713,16 @@ func (m *MonitoredERC20TokensProposal) XXX_DiscardUnknown() {
 var xxx_messageInfo_MonitoredERC20TokensProposal proto.InternalMessageInfo
 
 // RemoveEvmChainProposal
-// this types allows users to remove an EVM chain through gov proposal
+// this types allows users to remove an EVM chain through gov proposal. The
+// chain is first drained for `drain_blocks` blocks: new withdrawals and batches
+// are rejected, the outgoing pool and unconfirmed batches are refunded and
+// pending claims keep being processed. Once the deadline has passed the chain
+// is removed as soon as the gravity module escrows nothing more for it
 type RemoveEvmChainProposal struct {
 	Title          string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
 	Description    string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
 	EvmChainPrefix string `protobuf:"bytes,3,opt,name=evm_chain_prefix,json=evmChainPrefix,proto3" json:"evm_chain_prefix,omitempty"`
+	DrainBlocks    uint64 `protobuf:"varint,4,opt,name=drain_blocks,json=drainBlocks,proto3" json:"drain_blocks,omitempty"`
 }
 
 func (m *RemoveEvmChainProposal) Reset()      { *m = RemoveEvmChainProposal{} }
@@ -577,31 +757,82 @@ func (m *RemoveEvmChainProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RemoveEvmChainProposal proto.InternalMessageInfo
 
-// PendingIbcAutoForward represents a SendToCosmos transaction with a foreign
-// CosmosReceiver which will be added to the PendingIbcAutoForward queue in
-// attestation_handler and sent over IBC on some submission of a
-// MsgExecuteIbcAutoForwards
-type PendingIbcAutoForward struct {
-	ForeignReceiver string `protobuf:"bytes,1,opt,name=foreign_receiver,json=foreignReceiver,proto3" json:"foreign_receiver,omitempty"`
-	// not preserve foreign prefixes
-	Token *types1.Coin `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
-	// ibc-enabled chain over `IbcChannel`
-	IbcChannel string `protobuf:"bytes,3,opt,name=ibc_channel,json=ibcChannel,proto3" json:"ibc_channel,omitempty"`
-	EventNonce uint64 `protobuf:"varint,4,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
+// OutgoingLogicCallProposal
+// this type allows governance to create an OutgoingLogicCall on the provided
+// evm chain, the tokens transferred and paid as fees by the logic call are
+// taken from the community pool and locked in the gravity module until the
+// call is executed or canceled
+type OutgoingLogicCallProposal struct {
+	Title                string       `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description          string       `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	EvmChainPrefix       string       `protobuf:"bytes,3,opt,name=evm_chain_prefix,json=evmChainPrefix,proto3" json:"evm_chain_prefix,omitempty"`
+	Transfers            []ERC20Token `protobuf:"bytes,4,rep,name=transfers,proto3" json:"transfers"`
+	Fees                 []ERC20Token `protobuf:"bytes,5,rep,name=fees,proto3" json:"fees"`
+	LogicContractAddress string       `protobuf:"bytes,6,opt,name=logic_contract_address,json=logicContractAddress,proto3" json:"logic_contract_address,omitempty"`
+	Payload              []byte       `protobuf:"bytes,7,opt,name=payload,proto3" json:"payload,omitempty"`
+	Timeout              uint64       `protobuf:"varint,8,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	InvalidationId       []byte       `protobuf:"bytes,9,opt,name=invalidation_id,json=invalidationId,proto3" json:"invalidation_id,omitempty"`
+	InvalidationNonce    uint64       `protobuf:"varint,10,opt,name=invalidation_nonce,json=invalidationNonce,proto3" json:"invalidation_nonce,omitempty"`
+}
+
+func (m *OutgoingLogicCallProposal) Reset()      { *m = OutgoingLogicCallProposal{} }
+func (*OutgoingLogicCallProposal) ProtoMessage() {}
+func (*OutgoingLogicCallProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{11}
+}
+func (m *OutgoingLogicCallProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OutgoingLogicCallProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OutgoingLogicCallProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OutgoingLogicCallProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutgoingLogicCallProposal.Merge(m, src)
+}
+func (m *OutgoingLogicCallProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *OutgoingLogicCallProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutgoingLogicCallProposal.DiscardUnknown(m)
 }
 
-func (m *PendingIbcAutoForward) Reset()         { *m = PendingIbcAutoForward{} }
-func (m *PendingIbcAutoForward) String() string { return proto.CompactTextString(m) }
-func (*PendingIbcAutoForward) ProtoMessage()    {}
-func (*PendingIbcAutoForward) Descriptor() ([]byte, []int) {
-	return fileDescriptor_163831c23fcc179f, []int{11}
+var xxx_messageInfo_OutgoingLogicCallProposal proto.InternalMessageInfo
+
+// SetRateLimitProposal defines a custom governance proposal type to cap the
+// amount of `denom` which may be bridged out to (max_outbound) and minted or
+// unlocked from (max_inbound) the given evm chain within a window of
+// `window_blocks` cosmos blocks. A zero max leaves that direction unlimited,
+// setting both to zero removes the rate limit entirely
+type SetRateLimitProposal struct {
+	Title          string                                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description    string                                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	EvmChainPrefix string                                 `protobuf:"bytes,3,opt,name=evm_chain_prefix,json=evmChainPrefix,proto3" json:"evm_chain_prefix,omitempty"`
+	Denom          string                                 `protobuf:"bytes,4,opt,name=denom,proto3" json:"denom,omitempty"`
+	WindowBlocks   uint64                                 `protobuf:"varint,5,opt,name=window_blocks,json=windowBlocks,proto3" json:"window_blocks,omitempty"`
+	MaxOutbound    github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=max_outbound,json=maxOutbound,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_outbound"`
+	MaxInbound     github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=max_inbound,json=maxInbound,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_inbound"`
 }
-func (m *PendingIbcAutoForward) XXX_Unmarshal(b []byte) error {
+
+func (m *SetRateLimitProposal) Reset()      { *m = SetRateLimitProposal{} }
+func (*SetRateLimitProposal) ProtoMessage() {}
+func (*SetRateLimitProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{12}
+}
+func (m *SetRateLimitProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PendingIbcAutoForward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SetRateLimitProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PendingIbcAutoForward.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SetRateLimitProposal.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -611,69 +842,208 @@ func (m *PendingIbcAutoForward) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *PendingIbcAutoForward) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PendingIbcAutoForward.Merge(m, src)
+func (m *SetRateLimitProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetRateLimitProposal.Merge(m, src)
 }
-func (m *PendingIbcAutoForward) XXX_Size() int {
+func (m *SetRateLimitProposal) XXX_Size() int {
 	return m.Size()
 }
-func (m *PendingIbcAutoForward) XXX_DiscardUnknown() {
-	xxx_messageInfo_PendingIbcAutoForward.DiscardUnknown(m)
+func (m *SetRateLimitProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetRateLimitProposal.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PendingIbcAutoForward proto.InternalMessageInfo
+var xxx_messageInfo_SetRateLimitProposal proto.InternalMessageInfo
 
-func (m *PendingIbcAutoForward) GetForeignReceiver() string {
+// ReleaseHeldSendToCosmosProposal defines a custom governance proposal type to
+// deliver SendToCosmos deposits which were held back by an inbound rate limit,
+// ignoring the remaining capacity of the limit. When no event nonces are given
+// every held deposit of the evm chain is released
+type ReleaseHeldSendToCosmosProposal struct {
+	Title          string   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description    string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	EvmChainPrefix string   `protobuf:"bytes,3,opt,name=evm_chain_prefix,json=evmChainPrefix,proto3" json:"evm_chain_prefix,omitempty"`
+	EventNonces    []uint64 `protobuf:"varint,4,rep,packed,name=event_nonces,json=eventNonces,proto3" json:"event_nonces,omitempty"`
+}
+
+func (m *ReleaseHeldSendToCosmosProposal) Reset()      { *m = ReleaseHeldSendToCosmosProposal{} }
+func (*ReleaseHeldSendToCosmosProposal) ProtoMessage() {}
+func (*ReleaseHeldSendToCosmosProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{13}
+}
+func (m *ReleaseHeldSendToCosmosProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReleaseHeldSendToCosmosProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReleaseHeldSendToCosmosProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReleaseHeldSendToCosmosProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseHeldSendToCosmosProposal.Merge(m, src)
+}
+func (m *ReleaseHeldSendToCosmosProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReleaseHeldSendToCosmosProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseHeldSendToCosmosProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReleaseHeldSendToCosmosProposal proto.InternalMessageInfo
+
+// RateLimit caps the amount of a denom which may cross the bridge of an evm
+// chain in each direction within a window of `window_blocks` cosmos blocks, a
+// zero max leaves that direction unlimited
+type RateLimit struct {
+	EvmChainPrefix string                                 `protobuf:"bytes,1,opt,name=evm_chain_prefix,json=evmChainPrefix,proto3" json:"evm_chain_prefix,omitempty"`
+	Denom          string                                 `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	WindowBlocks   uint64                                 `protobuf:"varint,3,opt,name=window_blocks,json=windowBlocks,proto3" json:"window_blocks,omitempty"`
+	MaxOutbound    github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=max_outbound,json=maxOutbound,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_outbound"`
+	MaxInbound     github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=max_inbound,json=maxInbound,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_inbound"`
+}
+
+func (m *RateLimit) Reset()         { *m = RateLimit{} }
+func (m *RateLimit) String() string { return proto.CompactTextString(m) }
+func (*RateLimit) ProtoMessage()    {}
+func (*RateLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{14}
+}
+func (m *RateLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RateLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimit.Merge(m, src)
+}
+func (m *RateLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimit proto.InternalMessageInfo
+
+func (m *RateLimit) GetEvmChainPrefix() string {
 	if m != nil {
-		return m.ForeignReceiver
+		return m.EvmChainPrefix
 	}
 	return ""
 }
 
-func (m *PendingIbcAutoForward) GetToken() *types1.Coin {
+func (m *RateLimit) GetDenom() string {
 	if m != nil {
-		return m.Token
+		return m.Denom
 	}
-	return nil
+	return ""
 }
 
-func (m *PendingIbcAutoForward) GetIbcChannel() string {
+func (m *RateLimit) GetWindowBlocks() uint64 {
 	if m != nil {
-		return m.IbcChannel
+		return m.WindowBlocks
 	}
-	return ""
+	return 0
 }
 
-func (m *PendingIbcAutoForward) GetEventNonce() uint64 {
+// RateLimitFlow records the amounts of `denom` which have crossed the bridge
+// since `window_start`, the flow is reset once `window_blocks` blocks have
+// passed
+type RateLimitFlow struct {
+	WindowStart uint64                                 `protobuf:"varint,1,opt,name=window_start,json=windowStart,proto3" json:"window_start,omitempty"`
+	Outbound    github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=outbound,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"outbound"`
+	Inbound     github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=inbound,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"inbound"`
+	Denom       string                                 `protobuf:"bytes,4,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *RateLimitFlow) Reset()         { *m = RateLimitFlow{} }
+func (m *RateLimitFlow) String() string { return proto.CompactTextString(m) }
+func (*RateLimitFlow) ProtoMessage()    {}
+func (*RateLimitFlow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{15}
+}
+func (m *RateLimitFlow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimitFlow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimitFlow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RateLimitFlow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitFlow.Merge(m, src)
+}
+func (m *RateLimitFlow) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimitFlow) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitFlow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimitFlow proto.InternalMessageInfo
+
+func (m *RateLimitFlow) GetWindowStart() uint64 {
 	if m != nil {
-		return m.EventNonce
+		return m.WindowStart
 	}
 	return 0
 }
 
-// BridgeBalanceSnapshot records the total bank supply of the Monitored ERC20
-// Tokens immediately after applying each Attestation, plus the Cosmos and Eth
-// Block Heights associated with the Attestation
-type BridgeBalanceSnapshot struct {
-	CosmosBlockHeight   uint64        `protobuf:"varint,1,opt,name=cosmos_block_height,json=cosmosBlockHeight,proto3" json:"cosmos_block_height,omitempty"`
-	EthereumBlockHeight uint64        `protobuf:"varint,2,opt,name=ethereum_block_height,json=ethereumBlockHeight,proto3" json:"ethereum_block_height,omitempty"`
-	EvmChainPrefix      string        `protobuf:"bytes,3,opt,name=evm_chain_prefix,json=evmChainPrefix,proto3" json:"evm_chain_prefix,omitempty"`
-	Balances            []*ERC20Token `protobuf:"bytes,4,rep,name=balances,proto3" json:"balances,omitempty"`
-	EventNonce          uint64        `protobuf:"varint,5,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
+func (m *RateLimitFlow) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
 }
 
-func (m *BridgeBalanceSnapshot) Reset()         { *m = BridgeBalanceSnapshot{} }
-func (m *BridgeBalanceSnapshot) String() string { return proto.CompactTextString(m) }
-func (*BridgeBalanceSnapshot) ProtoMessage()    {}
-func (*BridgeBalanceSnapshot) Descriptor() ([]byte, []int) {
-	return fileDescriptor_163831c23fcc179f, []int{12}
+// HeldSendToCosmos represents a SendToCosmos deposit which exceeded the inbound
+// RateLimit of its denom, the minted or unlocked `token` waits in the gravity
+// module until capacity frees up or governance releases it
+type HeldSendToCosmos struct {
+	EventNonce     uint64      `protobuf:"varint,1,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
+	EthBlockHeight uint64      `protobuf:"varint,2,opt,name=eth_block_height,json=ethBlockHeight,proto3" json:"eth_block_height,omitempty"`
+	TokenContract  string      `protobuf:"bytes,3,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	EthereumSender string      `protobuf:"bytes,4,opt,name=ethereum_sender,json=ethereumSender,proto3" json:"ethereum_sender,omitempty"`
+	CosmosReceiver string      `protobuf:"bytes,5,opt,name=cosmos_receiver,json=cosmosReceiver,proto3" json:"cosmos_receiver,omitempty"`
+	Token          types1.Coin `protobuf:"bytes,6,opt,name=token,proto3" json:"token"`
+	HeldHeight     uint64      `protobuf:"varint,7,opt,name=held_height,json=heldHeight,proto3" json:"held_height,omitempty"`
 }
-func (m *BridgeBalanceSnapshot) XXX_Unmarshal(b []byte) error {
+
+func (m *HeldSendToCosmos) Reset()         { *m = HeldSendToCosmos{} }
+func (m *HeldSendToCosmos) String() string { return proto.CompactTextString(m) }
+func (*HeldSendToCosmos) ProtoMessage()    {}
+func (*HeldSendToCosmos) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{16}
+}
+func (m *HeldSendToCosmos) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *BridgeBalanceSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *HeldSendToCosmos) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_BridgeBalanceSnapshot.Marshal(b, m, deterministic)
+		return xxx_messageInfo_HeldSendToCosmos.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -683,1343 +1053,9896 @@ func (m *BridgeBalanceSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *BridgeBalanceSnapshot) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BridgeBalanceSnapshot.Merge(m, src)
+func (m *HeldSendToCosmos) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HeldSendToCosmos.Merge(m, src)
 }
-func (m *BridgeBalanceSnapshot) XXX_Size() int {
+func (m *HeldSendToCosmos) XXX_Size() int {
 	return m.Size()
 }
-func (m *BridgeBalanceSnapshot) XXX_DiscardUnknown() {
-	xxx_messageInfo_BridgeBalanceSnapshot.DiscardUnknown(m)
+func (m *HeldSendToCosmos) XXX_DiscardUnknown() {
+	xxx_messageInfo_HeldSendToCosmos.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BridgeBalanceSnapshot proto.InternalMessageInfo
+var xxx_messageInfo_HeldSendToCosmos proto.InternalMessageInfo
 
-func (m *BridgeBalanceSnapshot) GetCosmosBlockHeight() uint64 {
+func (m *HeldSendToCosmos) GetEventNonce() uint64 {
 	if m != nil {
-		return m.CosmosBlockHeight
+		return m.EventNonce
 	}
 	return 0
 }
 
-func (m *BridgeBalanceSnapshot) GetEthereumBlockHeight() uint64 {
+func (m *HeldSendToCosmos) GetEthBlockHeight() uint64 {
 	if m != nil {
-		return m.EthereumBlockHeight
+		return m.EthBlockHeight
 	}
 	return 0
 }
 
-func (m *BridgeBalanceSnapshot) GetEvmChainPrefix() string {
+func (m *HeldSendToCosmos) GetTokenContract() string {
 	if m != nil {
-		return m.EvmChainPrefix
+		return m.TokenContract
 	}
 	return ""
 }
 
-func (m *BridgeBalanceSnapshot) GetBalances() []*ERC20Token {
+func (m *HeldSendToCosmos) GetEthereumSender() string {
 	if m != nil {
-		return m.Balances
+		return m.EthereumSender
 	}
-	return nil
+	return ""
 }
 
-func (m *BridgeBalanceSnapshot) GetEventNonce() uint64 {
+func (m *HeldSendToCosmos) GetCosmosReceiver() string {
 	if m != nil {
-		return m.EventNonce
+		return m.CosmosReceiver
 	}
-	return 0
+	return ""
 }
 
-func init() {
-	proto.RegisterType((*MonitoredERC20Addresses)(nil), "gravity.v1.MonitoredERC20Addresses")
-	proto.RegisterType((*BridgeValidator)(nil), "gravity.v1.BridgeValidator")
-	proto.RegisterType((*Valset)(nil), "gravity.v1.Valset")
-	proto.RegisterType((*LastObservedEthereumBlockHeight)(nil), "gravity.v1.LastObservedEthereumBlockHeight")
-	proto.RegisterType((*ERC20ToDenom)(nil), "gravity.v1.ERC20ToDenom")
-	proto.RegisterType((*UnhaltBridgeProposal)(nil), "gravity.v1.UnhaltBridgeProposal")
-	proto.RegisterType((*AirdropProposal)(nil), "gravity.v1.AirdropProposal")
-	proto.RegisterType((*IBCMetadataProposal)(nil), "gravity.v1.IBCMetadataProposal")
-	proto.RegisterType((*AddEvmChainProposal)(nil), "gravity.v1.AddEvmChainProposal")
-	proto.RegisterType((*MonitoredERC20TokensProposal)(nil), "gravity.v1.MonitoredERC20TokensProposal")
-	proto.RegisterType((*RemoveEvmChainProposal)(nil), "gravity.v1.RemoveEvmChainProposal")
-	proto.RegisterType((*PendingIbcAutoForward)(nil), "gravity.v1.PendingIbcAutoForward")
-	proto.RegisterType((*BridgeBalanceSnapshot)(nil), "gravity.v1.BridgeBalanceSnapshot")
+func (m *HeldSendToCosmos) GetToken() types1.Coin {
+	if m != nil {
+		return m.Token
+	}
+	return types1.Coin{}
 }
 
-func init() { proto.RegisterFile("gravity/v1/types.proto", fileDescriptor_163831c23fcc179f) }
+func (m *HeldSendToCosmos) GetHeldHeight() uint64 {
+	if m != nil {
+		return m.HeldHeight
+	}
+	return 0
+}
 
-var fileDescriptor_163831c23fcc179f = []byte{
-	// 1011 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcf, 0x6f, 0x1b, 0xc5,
-	0x17, 0xf7, 0xc6, 0x76, 0x12, 0x3f, 0xfb, 0xdb, 0xf4, 0xbb, 0xf9, 0x51, 0x43, 0x53, 0x3b, 0x58,
-	0x08, 0x99, 0x43, 0xbd, 0x8d, 0x91, 0x40, 0x2a, 0x87, 0x2a, 0x0e, 0x01, 0x22, 0xd1, 0x12, 0x6d,
-	0x4b, 0x24, 0xb8, 0xac, 0x66, 0x77, 0x5f, 0xed, 0x51, 0xbc, 0x33, 0xd6, 0xcc, 0x64, 0xdb, 0xfe,
-	0x01, 0x48, 0x3d, 0x72, 0xe4, 0x98, 0x13, 0x88, 0x3f, 0xa0, 0xff, 0x43, 0x8f, 0x3d, 0x22, 0x0e,
-	0x15, 0x4a, 0x2e, 0x48, 0xfc, 0x07, 0x9c, 0xd0, 0xfc, 0x58, 0xc7, 0x71, 0x73, 0x00, 0x82, 0x38,
-	0xd9, 0xef, 0x33, 0x6f, 0xde, 0xfb, 0xcc, 0xe7, 0xbd, 0x79, 0xb3, 0xb0, 0x31, 0x14, 0x24, 0xa7,
-	0xea, 0x59, 0x90, 0x6f, 0x07, 0xea, 0xd9, 0x04, 0x65, 0x6f, 0x22, 0xb8, 0xe2, 0x3e, 0x38, 0xbc,
-	0x97, 0x6f, 0xbf, 0xdd, 0x4a, 0xb8, 0xcc, 0xb8, 0x0c, 0x62, 0x22, 0x31, 0xc8, 0xb7, 0x63, 0x54,
-	0x64, 0x3b, 0x48, 0x38, 0x65, 0xd6, 0x77, 0x66, 0x9d, 0x1d, 0x4d, 0xd7, 0xb5, 0xe1, 0xd6, 0xd7,
-	0x86, 0x7c, 0xc8, 0xcd, 0xdf, 0x40, 0xff, 0x73, 0xe8, 0xe6, 0x4c, 0x66, 0xa2, 0x14, 0x4a, 0x45,
-	0x14, 0xe5, 0x2e, 0x66, 0xe7, 0x23, 0xb8, 0x71, 0x9f, 0x33, 0xaa, 0xb8, 0xc0, 0x74, 0x2f, 0xdc,
-	0xed, 0xdf, 0xd9, 0x49, 0x53, 0x81, 0x52, 0xa2, 0xf4, 0x37, 0xa1, 0x46, 0x0a, 0xa3, 0xe9, 0x6d,
-	0x95, 0xbb, 0x8d, 0xf0, 0x1c, 0xe8, 0x84, 0xb0, 0x32, 0x10, 0x34, 0x1d, 0xe2, 0x21, 0x19, 0xd3,
-	0x94, 0x28, 0x2e, 0xfc, 0x35, 0xa8, 0x4e, 0xf8, 0x13, 0x14, 0x4d, 0x6f, 0xcb, 0xeb, 0x56, 0x42,
-	0x6b, 0xf8, 0xef, 0xc3, 0x75, 0x54, 0x23, 0x14, 0x78, 0x9c, 0x45, 0x6e, 0x7b, 0x73, 0x61, 0xcb,
-	0xeb, 0xd6, 0xc2, 0x95, 0x02, 0x77, 0x39, 0x3b, 0xbf, 0x7b, 0xb0, 0x78, 0x48, 0xc6, 0x12, 0x95,
-	0x8e, 0xc5, 0x38, 0x4b, 0xb0, 0x88, 0x65, 0x0c, 0xff, 0x63, 0x58, 0xca, 0x30, 0x8b, 0x51, 0xe8,
-	0x10, 0xe5, 0x6e, 0xbd, 0x7f, 0xb3, 0x77, 0xae, 0x5f, 0x6f, 0x8e, 0xcf, 0xa0, 0xf2, 0xf2, 0x75,
-	0xbb, 0x14, 0x16, 0x3b, 0xfc, 0x0d, 0x58, 0x1c, 0x21, 0x1d, 0x8e, 0x54, 0xb3, 0x6c, 0x62, 0x3a,
-	0xcb, 0x7f, 0x08, 0xff, 0x13, 0xf8, 0x84, 0x88, 0x34, 0x22, 0x19, 0x3f, 0x66, 0xaa, 0x59, 0xd1,
-	0xec, 0x06, 0x3d, 0xbd, 0xfb, 0x97, 0xd7, 0xed, 0xf7, 0x86, 0x54, 0x8d, 0x8e, 0xe3, 0x5e, 0xc2,
-	0xb3, 0xc0, 0x15, 0xc0, 0xfe, 0xdc, 0x96, 0xe9, 0x91, 0xab, 0xe5, 0x3e, 0x53, 0x61, 0xc3, 0x06,
-	0xd9, 0x31, 0x31, 0xfc, 0x77, 0xc0, 0xd9, 0x91, 0xe2, 0x47, 0xc8, 0x9a, 0x55, 0x73, 0xe2, 0xba,
-	0xc5, 0x1e, 0x69, 0xa8, 0xf3, 0xad, 0x07, 0xed, 0x2f, 0x88, 0x54, 0x5f, 0xc6, 0x12, 0x45, 0x8e,
-	0xe9, 0x9e, 0x53, 0x63, 0x30, 0xe6, 0xc9, 0xd1, 0xe7, 0x96, 0x5b, 0x0f, 0x56, 0x6d, 0xb2, 0x28,
-	0xd6, 0x68, 0xe4, 0x0e, 0x60, 0x45, 0xf9, 0xbf, 0x5d, 0x9a, 0xf5, 0xef, 0xc3, 0xfa, 0x54, 0xec,
-	0x0b, 0x3b, 0x16, 0xcc, 0x8e, 0x55, 0x7c, 0x33, 0x47, 0xe7, 0x2e, 0x34, 0x4c, 0xe5, 0x1f, 0xf1,
-	0x4f, 0x90, 0xf1, 0x4c, 0x4b, 0x8f, 0x22, 0xe9, 0xdf, 0x31, 0x59, 0x6a, 0xa1, 0x35, 0x34, 0x9a,
-	0xea, 0x65, 0x57, 0x3b, 0x6b, 0x74, 0x7e, 0xf2, 0x60, 0xed, 0x2b, 0x36, 0x22, 0x63, 0x65, 0xc5,
-	0x3f, 0x10, 0x7c, 0xc2, 0x25, 0x19, 0x6b, 0x77, 0x45, 0xd5, 0x18, 0x8b, 0x20, 0xc6, 0xf0, 0xb7,
-	0xa0, 0x9e, 0xa2, 0x4c, 0x04, 0x9d, 0xe8, 0x16, 0x74, 0xa1, 0x66, 0x21, 0xad, 0x9b, 0x22, 0x62,
-	0x88, 0x2a, 0xb2, 0xe5, 0xaf, 0x18, 0xde, 0x75, 0x8b, 0x3d, 0x30, 0x4d, 0xd0, 0x85, 0xeb, 0x98,
-	0x67, 0x51, 0x32, 0x22, 0x94, 0x45, 0x13, 0x81, 0x8f, 0xe9, 0x53, 0x27, 0xef, 0x35, 0xcc, 0xb3,
-	0x5d, 0x0d, 0x1f, 0x18, 0xf4, 0x6e, 0xe3, 0xf9, 0x49, 0xbb, 0xf4, 0xfd, 0x49, 0xbb, 0xf4, 0xdb,
-	0x49, 0xdb, 0xeb, 0xfc, 0xe8, 0xc1, 0xca, 0x0e, 0x15, 0xa9, 0xe0, 0x93, 0x2b, 0xd3, 0x9c, 0xaa,
-	0x51, 0x9e, 0x51, 0xc3, 0x6f, 0x01, 0x08, 0x4c, 0xe8, 0x84, 0x22, 0x53, 0xd2, 0x50, 0x6f, 0x84,
-	0x33, 0x88, 0xdf, 0x84, 0x25, 0xdb, 0x62, 0xb2, 0x59, 0xdd, 0x2a, 0x77, 0x2b, 0x61, 0x61, 0xce,
-	0x31, 0x3d, 0xf3, 0x60, 0x75, 0x7f, 0xb0, 0x7b, 0x1f, 0x15, 0x49, 0x89, 0x22, 0x57, 0x66, 0x7b,
-	0x0f, 0x96, 0x33, 0x17, 0xcb, 0x10, 0xae, 0xf7, 0x6f, 0xf5, 0x6c, 0xef, 0xf4, 0xcc, 0xf8, 0x70,
-	0xb3, 0xa4, 0x57, 0x24, 0x74, 0x37, 0x67, 0xba, 0xc9, 0xbf, 0x09, 0x35, 0x1a, 0x27, 0x91, 0x3d,
-	0xb2, 0xb9, 0x1e, 0xe1, 0x32, 0x8d, 0x13, 0xdb, 0x2f, 0xff, 0xac, 0x1e, 0xa5, 0xce, 0x8b, 0x05,
-	0x58, 0xdd, 0x49, 0xd3, 0xbd, 0xa9, 0xcf, 0x15, 0x4f, 0xf9, 0x2e, 0x5c, 0x3b, 0xe7, 0xc1, 0x48,
-	0x86, 0xae, 0x38, 0x8d, 0x82, 0xc5, 0x03, 0x92, 0x5d, 0xde, 0x3d, 0x95, 0xcb, 0xd8, 0xfa, 0xdb,
-	0xb0, 0x3e, 0x13, 0x0f, 0x55, 0x94, 0xa3, 0x90, 0x3a, 0x77, 0xd5, 0xf4, 0xa4, 0x3f, 0x0d, 0x8b,
-	0xea, 0xd0, 0xae, 0xf8, 0xb7, 0xa0, 0x98, 0xe7, 0x11, 0x4d, 0x9b, 0x8b, 0x26, 0x6c, 0xcd, 0x21,
-	0xfb, 0xa9, 0xff, 0x21, 0xdc, 0x88, 0xcd, 0x35, 0x89, 0xde, 0x98, 0x88, 0x4b, 0xc6, 0x77, 0xdd,
-	0x2e, 0xef, 0x5d, 0x9c, 0x8b, 0x73, 0xdd, 0xf1, 0x83, 0x07, 0x9b, 0x17, 0x67, 0xb6, 0x99, 0x27,
-	0xf2, 0xca, 0x02, 0x5e, 0x26, 0x4d, 0xf9, 0x52, 0x69, 0x36, 0x60, 0xd1, 0x8c, 0x35, 0xdd, 0xe4,
-	0xe5, 0x6e, 0x2d, 0x74, 0xd6, 0x1c, 0xd1, 0xe7, 0x1e, 0x6c, 0x84, 0x98, 0xf1, 0x1c, 0xff, 0xb5,
-	0x1a, 0xff, 0x65, 0x8a, 0x73, 0x54, 0x5e, 0x78, 0xb0, 0x7e, 0x80, 0x2c, 0xa5, 0x6c, 0xb8, 0x1f,
-	0x27, 0x3b, 0xc7, 0x8a, 0x7f, 0xca, 0x85, 0x1e, 0xc5, 0xfa, 0x79, 0x7a, 0xcc, 0x05, 0xd2, 0x21,
-	0x8b, 0x04, 0x26, 0x48, 0x73, 0xf7, 0x7e, 0xd5, 0xc2, 0x15, 0x87, 0x87, 0x0e, 0xf6, 0x03, 0xa8,
-	0xda, 0x61, 0xbe, 0x60, 0xee, 0xd0, 0x5b, 0xe7, 0x77, 0x48, 0xe2, 0xf4, 0x0e, 0xed, 0x72, 0xca,
-	0x42, 0xeb, 0xe7, 0xb7, 0xa1, 0xae, 0xaf, 0x4d, 0x32, 0x22, 0x8c, 0xe1, 0xd8, 0x11, 0x05, 0x1a,
-	0x27, 0xbb, 0x16, 0xd1, 0x0e, 0x98, 0x23, 0xbb, 0x38, 0xec, 0xc0, 0x40, 0x66, 0xd6, 0x75, 0xfe,
-	0xf0, 0x60, 0xdd, 0x4e, 0xd6, 0x01, 0x19, 0x13, 0x96, 0xe0, 0x43, 0x46, 0x26, 0x72, 0xc4, 0xff,
-	0x93, 0x97, 0xe1, 0x6f, 0x34, 0x44, 0x1f, 0x96, 0x63, 0x4b, 0xd0, 0xb6, 0x44, 0xbd, 0xbf, 0x31,
-	0xfb, 0x32, 0x9f, 0x77, 0x69, 0x38, 0xf5, 0x9b, 0x3f, 0x7c, 0x75, 0xfe, 0xf0, 0x83, 0xaf, 0x5f,
-	0x9e, 0xb6, 0xbc, 0x57, 0xa7, 0x2d, 0xef, 0xd7, 0xd3, 0x96, 0xf7, 0xdd, 0x59, 0xab, 0xf4, 0xea,
-	0xac, 0x55, 0xfa, 0xf9, 0xac, 0x55, 0xfa, 0xe6, 0xde, 0xcc, 0x9b, 0xfc, 0x99, 0x4d, 0x73, 0xdb,
-	0xca, 0x34, 0x6f, 0x66, 0x3c, 0x3d, 0x1e, 0x63, 0xf0, 0x34, 0x28, 0xbe, 0x82, 0xcc, 0x83, 0x1d,
-	0x2f, 0x9a, 0xaf, 0x9f, 0x0f, 0xfe, 0x0c, 0x00, 0x00, 0xff, 0xff, 0xbd, 0x45, 0xba, 0x68, 0x97,
-	0x09, 0x00, 0x00,
+// SetIbcBridgeFeeProposal defines a custom governance proposal type to set the
+// default bridge fee paid by IBC transfers of `denom` which are forwarded to
+// the given evm chain without naming a bridge fee in their memo. A zero fee
+// removes the default
+type SetIbcBridgeFeeProposal struct {
+	Title          string                                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description    string                                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	EvmChainPrefix string                                 `protobuf:"bytes,3,opt,name=evm_chain_prefix,json=evmChainPrefix,proto3" json:"evm_chain_prefix,omitempty"`
+	Denom          string                                 `protobuf:"bytes,4,opt,name=denom,proto3" json:"denom,omitempty"`
+	BridgeFee      github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=bridge_fee,json=bridgeFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"bridge_fee"`
 }
 
-func (this *UnhaltBridgeProposal) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *SetIbcBridgeFeeProposal) Reset()      { *m = SetIbcBridgeFeeProposal{} }
+func (*SetIbcBridgeFeeProposal) ProtoMessage() {}
+func (*SetIbcBridgeFeeProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{17}
+}
+func (m *SetIbcBridgeFeeProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetIbcBridgeFeeProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetIbcBridgeFeeProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
+}
+func (m *SetIbcBridgeFeeProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetIbcBridgeFeeProposal.Merge(m, src)
+}
+func (m *SetIbcBridgeFeeProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetIbcBridgeFeeProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetIbcBridgeFeeProposal.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*UnhaltBridgeProposal)
-	if !ok {
-		that2, ok := that.(UnhaltBridgeProposal)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Title != that1.Title {
-		return false
-	}
-	if this.Description != that1.Description {
-		return false
-	}
-	if this.TargetNonce != that1.TargetNonce {
-		return false
-	}
-	if this.EvmChainPrefix != that1.EvmChainPrefix {
-		return false
-	}
-	return true
+var xxx_messageInfo_SetIbcBridgeFeeProposal proto.InternalMessageInfo
+
+// IbcBridgeFee is the default bridge fee, in units of `denom`, taken from IBC
+// transfers forwarded to an evm chain whose memo does not name a bridge fee
+type IbcBridgeFee struct {
+	EvmChainPrefix string                                 `protobuf:"bytes,1,opt,name=evm_chain_prefix,json=evmChainPrefix,proto3" json:"evm_chain_prefix,omitempty"`
+	Denom          string                                 `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	BridgeFee      github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=bridge_fee,json=bridgeFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"bridge_fee"`
 }
-func (this *AirdropProposal) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*AirdropProposal)
-	if !ok {
-		that2, ok := that.(AirdropProposal)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Title != that1.Title {
-		return false
-	}
-	if this.Description != that1.Description {
-		return false
-	}
-	if this.Denom != that1.Denom {
-		return false
-	}
-	if !bytes.Equal(this.Recipients, that1.Recipients) {
-		return false
-	}
-	if len(this.Amounts) != len(that1.Amounts) {
-		return false
-	}
-	for i := range this.Amounts {
-		if this.Amounts[i] != that1.Amounts[i] {
-			return false
+func (m *IbcBridgeFee) Reset()         { *m = IbcBridgeFee{} }
+func (m *IbcBridgeFee) String() string { return proto.CompactTextString(m) }
+func (*IbcBridgeFee) ProtoMessage()    {}
+func (*IbcBridgeFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{18}
+}
+func (m *IbcBridgeFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IbcBridgeFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IbcBridgeFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return true
 }
-func (this *AddEvmChainProposal) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *IbcBridgeFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IbcBridgeFee.Merge(m, src)
+}
+func (m *IbcBridgeFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *IbcBridgeFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_IbcBridgeFee.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*AddEvmChainProposal)
-	if !ok {
-		that2, ok := that.(AddEvmChainProposal)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Title != that1.Title {
-		return false
-	}
-	if this.Description != that1.Description {
-		return false
-	}
-	if this.EvmChainName != that1.EvmChainName {
-		return false
-	}
-	if this.EvmChainPrefix != that1.EvmChainPrefix {
-		return false
-	}
-	if this.EvmChainNetVersion != that1.EvmChainNetVersion {
-		return false
-	}
-	if this.GravityId != that1.GravityId {
-		return false
-	}
-	if this.BridgeEthereumAddress != that1.BridgeEthereumAddress {
-		return false
+var xxx_messageInfo_IbcBridgeFee proto.InternalMessageInfo
+
+func (m *IbcBridgeFee) GetEvmChainPrefix() string {
+	if m != nil {
+		return m.EvmChainPrefix
 	}
-	return true
+	return ""
 }
-func (this *MonitoredERC20TokensProposal) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*MonitoredERC20TokensProposal)
-	if !ok {
-		that2, ok := that.(MonitoredERC20TokensProposal)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Title != that1.Title {
-		return false
-	}
-	if this.Description != that1.Description {
-		return false
-	}
-	if this.EvmChainPrefix != that1.EvmChainPrefix {
-		return false
-	}
-	if len(this.Tokens) != len(that1.Tokens) {
-		return false
+func (m *IbcBridgeFee) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	for i := range this.Tokens {
-		if this.Tokens[i] != that1.Tokens[i] {
-			return false
+	return ""
+}
+
+// ResolveFailedAttestationProposal defines a custom governance proposal type
+// to resolve the quarantined FailedAttestation of `event_nonce` once the
+// problem which made it fail has been fixed. Without a refund receiver the
+// claim is re-executed unchanged, otherwise the failed SendToCosmos deposit is
+// delivered to `refund_receiver` instead of its original receiver. The
+// proposal fails, leaving the attestation quarantined, if the handler errors
+// again
+type ResolveFailedAttestationProposal struct {
+	Title          string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description    string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	EvmChainPrefix string `protobuf:"bytes,3,opt,name=evm_chain_prefix,json=evmChainPrefix,proto3" json:"evm_chain_prefix,omitempty"`
+	EventNonce     uint64 `protobuf:"varint,4,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
+	RefundReceiver string `protobuf:"bytes,5,opt,name=refund_receiver,json=refundReceiver,proto3" json:"refund_receiver,omitempty"`
+}
+
+func (m *ResolveFailedAttestationProposal) Reset()      { *m = ResolveFailedAttestationProposal{} }
+func (*ResolveFailedAttestationProposal) ProtoMessage() {}
+func (*ResolveFailedAttestationProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{19}
+}
+func (m *ResolveFailedAttestationProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ResolveFailedAttestationProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ResolveFailedAttestationProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return true
 }
-func (this *RemoveEvmChainProposal) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *ResolveFailedAttestationProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResolveFailedAttestationProposal.Merge(m, src)
+}
+func (m *ResolveFailedAttestationProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *ResolveFailedAttestationProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResolveFailedAttestationProposal.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*RemoveEvmChainProposal)
-	if !ok {
-		that2, ok := that.(RemoveEvmChainProposal)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+var xxx_messageInfo_ResolveFailedAttestationProposal proto.InternalMessageInfo
+
+// IbcTransferOrigin records the IBC packet which created the outgoing pool
+// transaction `tx_id` through the ibc middleware, so that cancelling or timing
+// out the transaction can return the funds to `sender` on the counterparty
+// chain. `port` and `channel` are the destination end of the original packet
+// on this chain, the refund is sent back over them
+type IbcTransferOrigin struct {
+	EvmChainPrefix string `protobuf:"bytes,1,opt,name=evm_chain_prefix,json=evmChainPrefix,proto3" json:"evm_chain_prefix,omitempty"`
+	TxId           uint64 `protobuf:"varint,2,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	Port           string `protobuf:"bytes,3,opt,name=port,proto3" json:"port,omitempty"`
+	Channel        string `protobuf:"bytes,4,opt,name=channel,proto3" json:"channel,omitempty"`
+	Sequence       uint64 `protobuf:"varint,5,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Sender         string `protobuf:"bytes,6,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (m *IbcTransferOrigin) Reset()         { *m = IbcTransferOrigin{} }
+func (m *IbcTransferOrigin) String() string { return proto.CompactTextString(m) }
+func (*IbcTransferOrigin) ProtoMessage()    {}
+func (*IbcTransferOrigin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{20}
+}
+func (m *IbcTransferOrigin) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IbcTransferOrigin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IbcTransferOrigin.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Title != that1.Title {
-		return false
+}
+func (m *IbcTransferOrigin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IbcTransferOrigin.Merge(m, src)
+}
+func (m *IbcTransferOrigin) XXX_Size() int {
+	return m.Size()
+}
+func (m *IbcTransferOrigin) XXX_DiscardUnknown() {
+	xxx_messageInfo_IbcTransferOrigin.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IbcTransferOrigin proto.InternalMessageInfo
+
+func (m *IbcTransferOrigin) GetEvmChainPrefix() string {
+	if m != nil {
+		return m.EvmChainPrefix
 	}
-	if this.Description != that1.Description {
-		return false
+	return ""
+}
+
+func (m *IbcTransferOrigin) GetTxId() uint64 {
+	if m != nil {
+		return m.TxId
 	}
-	if this.EvmChainPrefix != that1.EvmChainPrefix {
-		return false
+	return 0
+}
+
+func (m *IbcTransferOrigin) GetPort() string {
+	if m != nil {
+		return m.Port
 	}
-	return true
+	return ""
 }
-func (m *MonitoredERC20Addresses) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+
+func (m *IbcTransferOrigin) GetChannel() string {
+	if m != nil {
+		return m.Channel
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MonitoredERC20Addresses) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *IbcTransferOrigin) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
 }
 
-func (m *MonitoredERC20Addresses) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Addresses) > 0 {
-		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Addresses[iNdEx])
-			copy(dAtA[i:], m.Addresses[iNdEx])
-			i = encodeVarintTypes(dAtA, i, uint64(len(m.Addresses[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *IbcTransferOrigin) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *BridgeValidator) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// PendingIbcAutoForward represents a SendToCosmos transaction with a foreign
+// CosmosReceiver which will be added to the PendingIbcAutoForward queue in
+// attestation_handler and sent over IBC on some submission of a
+// MsgExecuteIbcAutoForwards
+type PendingIbcAutoForward struct {
+	ForeignReceiver string `protobuf:"bytes,1,opt,name=foreign_receiver,json=foreignReceiver,proto3" json:"foreign_receiver,omitempty"`
+	// not preserve foreign prefixes
+	Token *types1.Coin `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	// ibc-enabled chain over `IbcChannel`
+	IbcChannel string `protobuf:"bytes,3,opt,name=ibc_channel,json=ibcChannel,proto3" json:"ibc_channel,omitempty"`
+	EventNonce uint64 `protobuf:"varint,4,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
 }
 
-func (m *BridgeValidator) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PendingIbcAutoForward) Reset()         { *m = PendingIbcAutoForward{} }
+func (m *PendingIbcAutoForward) String() string { return proto.CompactTextString(m) }
+func (*PendingIbcAutoForward) ProtoMessage()    {}
+func (*PendingIbcAutoForward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{21}
 }
-
-func (m *BridgeValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EthereumAddress) > 0 {
-		i -= len(m.EthereumAddress)
-		copy(dAtA[i:], m.EthereumAddress)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.EthereumAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Power != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Power))
-		i--
-		dAtA[i] = 0x8
+func (m *PendingIbcAutoForward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingIbcAutoForward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingIbcAutoForward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *PendingIbcAutoForward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingIbcAutoForward.Merge(m, src)
+}
+func (m *PendingIbcAutoForward) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingIbcAutoForward) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingIbcAutoForward.DiscardUnknown(m)
 }
 
-func (m *Valset) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PendingIbcAutoForward proto.InternalMessageInfo
+
+func (m *PendingIbcAutoForward) GetForeignReceiver() string {
+	if m != nil {
+		return m.ForeignReceiver
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Valset) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PendingIbcAutoForward) GetToken() *types1.Coin {
+	if m != nil {
+		return m.Token
+	}
+	return nil
 }
 
-func (m *Valset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RewardToken) > 0 {
-		i -= len(m.RewardToken)
-		copy(dAtA[i:], m.RewardToken)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.RewardToken)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	{
-		size := m.RewardAmount.Size()
-		i -= size
-		if _, err := m.RewardAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTypes(dAtA, i, uint64(size))
+func (m *PendingIbcAutoForward) GetIbcChannel() string {
+	if m != nil {
+		return m.IbcChannel
 	}
-	i--
-	dAtA[i] = 0x22
-	if m.Height != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x18
+	return ""
+}
+
+func (m *PendingIbcAutoForward) GetEventNonce() uint64 {
+	if m != nil {
+		return m.EventNonce
 	}
-	if len(m.Members) > 0 {
-		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Members[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTypes(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+	return 0
+}
+
+// IbcAutoForwardLog records the outcome of processing a PendingIbcAutoForward,
+// mirroring the EventSendToCosmosExecutedIbcAutoForward or
+// EventSendToCosmosLocal event emitted at the time. Forwards processed in
+// EndBlocker cannot emit events, so the log is the only way to observe them
+type IbcAutoForwardLog struct {
+	EvmChainPrefix  string      `protobuf:"bytes,1,opt,name=evm_chain_prefix,json=evmChainPrefix,proto3" json:"evm_chain_prefix,omitempty"`
+	EventNonce      uint64      `protobuf:"varint,2,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
+	ForeignReceiver string      `protobuf:"bytes,3,opt,name=foreign_receiver,json=foreignReceiver,proto3" json:"foreign_receiver,omitempty"`
+	Token           types1.Coin `protobuf:"bytes,4,opt,name=token,proto3" json:"token"`
+	IbcChannel      string      `protobuf:"bytes,5,opt,name=ibc_channel,json=ibcChannel,proto3" json:"ibc_channel,omitempty"`
+	// true when the ibc transfer was sent, false when the funds were left with
+	// the local gravity-prefixed account
+	Forwarded         bool   `protobuf:"varint,6,opt,name=forwarded,proto3" json:"forwarded,omitempty"`
+	TimeoutTimestamp  uint64 `protobuf:"varint,7,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
+	Error             string `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	CosmosBlockHeight uint64 `protobuf:"varint,9,opt,name=cosmos_block_height,json=cosmosBlockHeight,proto3" json:"cosmos_block_height,omitempty"`
+}
+
+func (m *IbcAutoForwardLog) Reset()         { *m = IbcAutoForwardLog{} }
+func (m *IbcAutoForwardLog) String() string { return proto.CompactTextString(m) }
+func (*IbcAutoForwardLog) ProtoMessage()    {}
+func (*IbcAutoForwardLog) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{22}
+}
+func (m *IbcAutoForwardLog) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IbcAutoForwardLog) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IbcAutoForwardLog.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Nonce != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Nonce))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+}
+func (m *IbcAutoForwardLog) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IbcAutoForwardLog.Merge(m, src)
+}
+func (m *IbcAutoForwardLog) XXX_Size() int {
+	return m.Size()
+}
+func (m *IbcAutoForwardLog) XXX_DiscardUnknown() {
+	xxx_messageInfo_IbcAutoForwardLog.DiscardUnknown(m)
 }
 
-func (m *LastObservedEthereumBlockHeight) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_IbcAutoForwardLog proto.InternalMessageInfo
+
+func (m *IbcAutoForwardLog) GetEvmChainPrefix() string {
+	if m != nil {
+		return m.EvmChainPrefix
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *LastObservedEthereumBlockHeight) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *IbcAutoForwardLog) GetEventNonce() uint64 {
+	if m != nil {
+		return m.EventNonce
+	}
+	return 0
 }
 
-func (m *LastObservedEthereumBlockHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EthereumBlockHeight != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.EthereumBlockHeight))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.CosmosBlockHeight != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.CosmosBlockHeight))
-		i--
-		dAtA[i] = 0x8
+func (m *IbcAutoForwardLog) GetForeignReceiver() string {
+	if m != nil {
+		return m.ForeignReceiver
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *ERC20ToDenom) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *IbcAutoForwardLog) GetToken() types1.Coin {
+	if m != nil {
+		return m.Token
 	}
-	return dAtA[:n], nil
+	return types1.Coin{}
 }
 
-func (m *ERC20ToDenom) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *IbcAutoForwardLog) GetIbcChannel() string {
+	if m != nil {
+		return m.IbcChannel
+	}
+	return ""
 }
 
-func (m *ERC20ToDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Erc20) > 0 {
-		i -= len(m.Erc20)
-		copy(dAtA[i:], m.Erc20)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Erc20)))
-		i--
-		dAtA[i] = 0xa
+func (m *IbcAutoForwardLog) GetForwarded() bool {
+	if m != nil {
+		return m.Forwarded
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *UnhaltBridgeProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *IbcAutoForwardLog) GetTimeoutTimestamp() uint64 {
+	if m != nil {
+		return m.TimeoutTimestamp
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *UnhaltBridgeProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *IbcAutoForwardLog) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
 }
 
-func (m *UnhaltBridgeProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EvmChainPrefix) > 0 {
-		i -= len(m.EvmChainPrefix)
-		copy(dAtA[i:], m.EvmChainPrefix)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.EvmChainPrefix)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.TargetNonce != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.TargetNonce))
-		i--
-		dAtA[i] = 0x20
+func (m *IbcAutoForwardLog) GetCosmosBlockHeight() uint64 {
+	if m != nil {
+		return m.CosmosBlockHeight
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+// IbcAutoForwardTransfer tracks the ibc transfer sent for the
+// PendingIbcAutoForward of `event_nonce`, it is indexed by the packet's
+// `channel` and `sequence` so that the gravity ibc middleware can update it
+// when the packet is acknowledged or times out
+type IbcAutoForwardTransfer struct {
+	EvmChainPrefix  string `protobuf:"bytes,1,opt,name=evm_chain_prefix,json=evmChainPrefix,proto3" json:"evm_chain_prefix,omitempty"`
+	EventNonce      uint64 `protobuf:"varint,2,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
+	Port            string `protobuf:"bytes,3,opt,name=port,proto3" json:"port,omitempty"`
+	Channel         string `protobuf:"bytes,4,opt,name=channel,proto3" json:"channel,omitempty"`
+	Sequence        uint64 `protobuf:"varint,5,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	ForeignReceiver string `protobuf:"bytes,6,opt,name=foreign_receiver,json=foreignReceiver,proto3" json:"foreign_receiver,omitempty"`
+	// the local gravity-prefixed account which holds the funds if the transfer
+	// fails or times out
+	Fallback string               `protobuf:"bytes,7,opt,name=fallback,proto3" json:"fallback,omitempty"`
+	Token    types1.Coin          `protobuf:"bytes,8,opt,name=token,proto3" json:"token"`
+	Status   IbcAutoForwardStatus `protobuf:"varint,9,opt,name=status,proto3,enum=gravity.v1.IbcAutoForwardStatus" json:"status,omitempty"`
+	// the error acknowledgement of a failed transfer
+	Error                string `protobuf:"bytes,10,opt,name=error,proto3" json:"error,omitempty"`
+	SentBlockHeight      uint64 `protobuf:"varint,11,opt,name=sent_block_height,json=sentBlockHeight,proto3" json:"sent_block_height,omitempty"`
+	CompletedBlockHeight uint64 `protobuf:"varint,12,opt,name=completed_block_height,json=completedBlockHeight,proto3" json:"completed_block_height,omitempty"`
+}
+
+func (m *IbcAutoForwardTransfer) Reset()         { *m = IbcAutoForwardTransfer{} }
+func (m *IbcAutoForwardTransfer) String() string { return proto.CompactTextString(m) }
+func (*IbcAutoForwardTransfer) ProtoMessage()    {}
+func (*IbcAutoForwardTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{23}
+}
+func (m *IbcAutoForwardTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IbcAutoForwardTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IbcAutoForwardTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *IbcAutoForwardTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IbcAutoForwardTransfer.Merge(m, src)
+}
+func (m *IbcAutoForwardTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *IbcAutoForwardTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_IbcAutoForwardTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IbcAutoForwardTransfer proto.InternalMessageInfo
+
+func (m *IbcAutoForwardTransfer) GetEvmChainPrefix() string {
+	if m != nil {
+		return m.EvmChainPrefix
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *AirdropProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *IbcAutoForwardTransfer) GetEventNonce() uint64 {
+	if m != nil {
+		return m.EventNonce
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *AirdropProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *IbcAutoForwardTransfer) GetPort() string {
+	if m != nil {
+		return m.Port
+	}
+	return ""
 }
 
-func (m *AirdropProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Amounts) > 0 {
-		dAtA2 := make([]byte, len(m.Amounts)*10)
-		var j1 int
-		for _, num := range m.Amounts {
-			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j1++
-			}
-			dAtA2[j1] = uint8(num)
-			j1++
-		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintTypes(dAtA, i, uint64(j1))
-		i--
-		dAtA[i] = 0x2a
+func (m *IbcAutoForwardTransfer) GetChannel() string {
+	if m != nil {
+		return m.Channel
 	}
-	if len(m.Recipients) > 0 {
-		i -= len(m.Recipients)
-		copy(dAtA[i:], m.Recipients)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Recipients)))
-		i--
-		dAtA[i] = 0x22
+	return ""
+}
+
+func (m *IbcAutoForwardTransfer) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x1a
+	return 0
+}
+
+func (m *IbcAutoForwardTransfer) GetForeignReceiver() string {
+	if m != nil {
+		return m.ForeignReceiver
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *IbcAutoForwardTransfer) GetFallback() string {
+	if m != nil {
+		return m.Fallback
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *IbcAutoForwardTransfer) GetToken() types1.Coin {
+	if m != nil {
+		return m.Token
 	}
-	return len(dAtA) - i, nil
+	return types1.Coin{}
 }
 
-func (m *IBCMetadataProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *IbcAutoForwardTransfer) GetStatus() IbcAutoForwardStatus {
+	if m != nil {
+		return m.Status
 	}
-	return dAtA[:n], nil
+	return IBC_AUTO_FORWARD_STATUS_UNSPECIFIED
 }
 
-func (m *IBCMetadataProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *IbcAutoForwardTransfer) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
 }
 
-func (m *IBCMetadataProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EvmChainPrefix) > 0 {
-		i -= len(m.EvmChainPrefix)
-		copy(dAtA[i:], m.EvmChainPrefix)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.EvmChainPrefix)))
-		i--
-		dAtA[i] = 0x2a
+func (m *IbcAutoForwardTransfer) GetSentBlockHeight() uint64 {
+	if m != nil {
+		return m.SentBlockHeight
 	}
-	if len(m.IbcDenom) > 0 {
-		i -= len(m.IbcDenom)
-		copy(dAtA[i:], m.IbcDenom)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.IbcDenom)))
-		i--
-		dAtA[i] = 0x22
+	return 0
+}
+
+func (m *IbcAutoForwardTransfer) GetCompletedBlockHeight() uint64 {
+	if m != nil {
+		return m.CompletedBlockHeight
 	}
-	{
-		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+	return 0
+}
+
+// BridgeBalanceSnapshot records the total bank supply of the Monitored ERC20
+// Tokens immediately after applying each Attestation, plus the Cosmos and Eth
+// Block Heights associated with the Attestation
+type BridgeBalanceSnapshot struct {
+	CosmosBlockHeight   uint64        `protobuf:"varint,1,opt,name=cosmos_block_height,json=cosmosBlockHeight,proto3" json:"cosmos_block_height,omitempty"`
+	EthereumBlockHeight uint64        `protobuf:"varint,2,opt,name=ethereum_block_height,json=ethereumBlockHeight,proto3" json:"ethereum_block_height,omitempty"`
+	EvmChainPrefix      string        `protobuf:"bytes,3,opt,name=evm_chain_prefix,json=evmChainPrefix,proto3" json:"evm_chain_prefix,omitempty"`
+	Balances            []*ERC20Token `protobuf:"bytes,4,rep,name=balances,proto3" json:"balances,omitempty"`
+	EventNonce          uint64        `protobuf:"varint,5,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
+}
+
+func (m *BridgeBalanceSnapshot) Reset()         { *m = BridgeBalanceSnapshot{} }
+func (m *BridgeBalanceSnapshot) String() string { return proto.CompactTextString(m) }
+func (*BridgeBalanceSnapshot) ProtoMessage()    {}
+func (*BridgeBalanceSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{24}
+}
+func (m *BridgeBalanceSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BridgeBalanceSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BridgeBalanceSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTypes(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *BridgeBalanceSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BridgeBalanceSnapshot.Merge(m, src)
+}
+func (m *BridgeBalanceSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *BridgeBalanceSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_BridgeBalanceSnapshot.DiscardUnknown(m)
 }
 
-func (m *AddEvmChainProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_BridgeBalanceSnapshot proto.InternalMessageInfo
+
+func (m *BridgeBalanceSnapshot) GetCosmosBlockHeight() uint64 {
+	if m != nil {
+		return m.CosmosBlockHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *AddEvmChainProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *BridgeBalanceSnapshot) GetEthereumBlockHeight() uint64 {
+	if m != nil {
+		return m.EthereumBlockHeight
+	}
+	return 0
 }
 
-func (m *AddEvmChainProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.BridgeEthereumAddress) > 0 {
-		i -= len(m.BridgeEthereumAddress)
-		copy(dAtA[i:], m.BridgeEthereumAddress)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.BridgeEthereumAddress)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.GravityId) > 0 {
-		i -= len(m.GravityId)
-		copy(dAtA[i:], m.GravityId)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.GravityId)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.EvmChainNetVersion != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.EvmChainNetVersion))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.EvmChainPrefix) > 0 {
-		i -= len(m.EvmChainPrefix)
-		copy(dAtA[i:], m.EvmChainPrefix)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.EvmChainPrefix)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.EvmChainName) > 0 {
-		i -= len(m.EvmChainName)
-		copy(dAtA[i:], m.EvmChainName)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.EvmChainName)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
+func (m *BridgeBalanceSnapshot) GetEvmChainPrefix() string {
+	if m != nil {
+		return m.EvmChainPrefix
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MonitoredERC20TokensProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BridgeBalanceSnapshot) GetBalances() []*ERC20Token {
+	if m != nil {
+		return m.Balances
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MonitoredERC20TokensProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *BridgeBalanceSnapshot) GetEventNonce() uint64 {
+	if m != nil {
+		return m.EventNonce
+	}
+	return 0
 }
 
-func (m *MonitoredERC20TokensProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Tokens) > 0 {
-		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Tokens[iNdEx])
-			copy(dAtA[i:], m.Tokens[iNdEx])
-			i = encodeVarintTypes(dAtA, i, uint64(len(m.Tokens[iNdEx])))
-			i--
-			dAtA[i] = 0x22
+// EvmChainDecommission reports the progress of the removal of an evm chain, it
+// is kept after the chain has been removed
+type EvmChainDecommission struct {
+	EvmChainPrefix   string                     `protobuf:"bytes,1,opt,name=evm_chain_prefix,json=evmChainPrefix,proto3" json:"evm_chain_prefix,omitempty"`
+	Status           EvmChainDecommissionStatus `protobuf:"varint,2,opt,name=status,proto3,enum=gravity.v1.EvmChainDecommissionStatus" json:"status,omitempty"`
+	StartBlockHeight uint64                     `protobuf:"varint,3,opt,name=start_block_height,json=startBlockHeight,proto3" json:"start_block_height,omitempty"`
+	// the chain is not removed before this height, even if nothing is escrowed
+	DeadlineBlockHeight uint64 `protobuf:"varint,4,opt,name=deadline_block_height,json=deadlineBlockHeight,proto3" json:"deadline_block_height,omitempty"`
+	RemovalBlockHeight  uint64 `protobuf:"varint,5,opt,name=removal_block_height,json=removalBlockHeight,proto3" json:"removal_block_height,omitempty"`
+	// the number of unbatched transactions refunded to their senders
+	RefundedTxs     uint64                                   `protobuf:"varint,6,opt,name=refunded_txs,json=refundedTxs,proto3" json:"refunded_txs,omitempty"`
+	RefundedAmounts github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=refunded_amounts,json=refundedAmounts,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"refunded_amounts"`
+	// the number of batches without any confirmation which were cancelled
+	CancelledBatches uint64 `protobuf:"varint,8,opt,name=cancelled_batches,json=cancelledBatches,proto3" json:"cancelled_batches,omitempty"`
+	// the balances the gravity module still escrowed for the chain at the last
+	// check, the chain is only removed once these are empty
+	EscrowedBalances github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,9,rep,name=escrowed_balances,json=escrowedBalances,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"escrowed_balances"`
+	// the supply of the chain's evm originated vouchers which was still in
+	// circulation when the chain was removed
+	OutstandingVouchers github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,10,rep,name=outstanding_vouchers,json=outstandingVouchers,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"outstanding_vouchers"`
+}
+
+func (m *EvmChainDecommission) Reset()         { *m = EvmChainDecommission{} }
+func (m *EvmChainDecommission) String() string { return proto.CompactTextString(m) }
+func (*EvmChainDecommission) ProtoMessage()    {}
+func (*EvmChainDecommission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{25}
+}
+func (m *EvmChainDecommission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EvmChainDecommission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EvmChainDecommission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.EvmChainPrefix) > 0 {
-		i -= len(m.EvmChainPrefix)
-		copy(dAtA[i:], m.EvmChainPrefix)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.EvmChainPrefix)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *EvmChainDecommission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvmChainDecommission.Merge(m, src)
+}
+func (m *EvmChainDecommission) XXX_Size() int {
+	return m.Size()
+}
+func (m *EvmChainDecommission) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvmChainDecommission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EvmChainDecommission proto.InternalMessageInfo
+
+func (m *EvmChainDecommission) GetEvmChainPrefix() string {
+	if m != nil {
+		return m.EvmChainPrefix
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *RemoveEvmChainProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EvmChainDecommission) GetStatus() EvmChainDecommissionStatus {
+	if m != nil {
+		return m.Status
 	}
-	return dAtA[:n], nil
+	return EVM_CHAIN_DECOMMISSION_STATUS_UNSPECIFIED
 }
 
-func (m *RemoveEvmChainProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EvmChainDecommission) GetStartBlockHeight() uint64 {
+	if m != nil {
+		return m.StartBlockHeight
+	}
+	return 0
 }
 
-func (m *RemoveEvmChainProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EvmChainPrefix) > 0 {
-		i -= len(m.EvmChainPrefix)
-		copy(dAtA[i:], m.EvmChainPrefix)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.EvmChainPrefix)))
-		i--
-		dAtA[i] = 0x1a
+func (m *EvmChainDecommission) GetDeadlineBlockHeight() uint64 {
+	if m != nil {
+		return m.DeadlineBlockHeight
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+func (m *EvmChainDecommission) GetRemovalBlockHeight() uint64 {
+	if m != nil {
+		return m.RemovalBlockHeight
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *EvmChainDecommission) GetRefundedTxs() uint64 {
+	if m != nil {
+		return m.RefundedTxs
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *PendingIbcAutoForward) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EvmChainDecommission) GetRefundedAmounts() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RefundedAmounts
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *PendingIbcAutoForward) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EvmChainDecommission) GetCancelledBatches() uint64 {
+	if m != nil {
+		return m.CancelledBatches
+	}
+	return 0
 }
 
-func (m *PendingIbcAutoForward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EventNonce != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.EventNonce))
-		i--
-		dAtA[i] = 0x20
+func (m *EvmChainDecommission) GetEscrowedBalances() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.EscrowedBalances
 	}
-	if len(m.IbcChannel) > 0 {
-		i -= len(m.IbcChannel)
-		copy(dAtA[i:], m.IbcChannel)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.IbcChannel)))
-		i--
-		dAtA[i] = 0x1a
+	return nil
+}
+
+func (m *EvmChainDecommission) GetOutstandingVouchers() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.OutstandingVouchers
 	}
-	if m.Token != nil {
-		{
-			size, err := m.Token.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTypes(dAtA, i, uint64(size))
+	return nil
+}
+
+type EventEvmChainDecommissionStarted struct {
+	EvmChainPrefix      string `protobuf:"bytes,1,opt,name=evm_chain_prefix,json=evmChainPrefix,proto3" json:"evm_chain_prefix,omitempty"`
+	DeadlineBlockHeight string `protobuf:"bytes,2,opt,name=deadline_block_height,json=deadlineBlockHeight,proto3" json:"deadline_block_height,omitempty"`
+}
+
+func (m *EventEvmChainDecommissionStarted) Reset()         { *m = EventEvmChainDecommissionStarted{} }
+func (m *EventEvmChainDecommissionStarted) String() string { return proto.CompactTextString(m) }
+func (*EventEvmChainDecommissionStarted) ProtoMessage()    {}
+func (*EventEvmChainDecommissionStarted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{26}
+}
+func (m *EventEvmChainDecommissionStarted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventEvmChainDecommissionStarted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventEvmChainDecommissionStarted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ForeignReceiver) > 0 {
-		i -= len(m.ForeignReceiver)
-		copy(dAtA[i:], m.ForeignReceiver)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.ForeignReceiver)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventEvmChainDecommissionStarted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventEvmChainDecommissionStarted.Merge(m, src)
+}
+func (m *EventEvmChainDecommissionStarted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventEvmChainDecommissionStarted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventEvmChainDecommissionStarted.DiscardUnknown(m)
 }
 
-func (m *BridgeBalanceSnapshot) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventEvmChainDecommissionStarted proto.InternalMessageInfo
+
+func (m *EventEvmChainDecommissionStarted) GetEvmChainPrefix() string {
+	if m != nil {
+		return m.EvmChainPrefix
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *BridgeBalanceSnapshot) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventEvmChainDecommissionStarted) GetDeadlineBlockHeight() string {
+	if m != nil {
+		return m.DeadlineBlockHeight
+	}
+	return ""
 }
 
-func (m *BridgeBalanceSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EventNonce != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.EventNonce))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.Balances) > 0 {
-		for iNdEx := len(m.Balances) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTypes(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
+type EventEvmChainRemoved struct {
+	EvmChainPrefix      string `protobuf:"bytes,1,opt,name=evm_chain_prefix,json=evmChainPrefix,proto3" json:"evm_chain_prefix,omitempty"`
+	OutstandingVouchers string `protobuf:"bytes,2,opt,name=outstanding_vouchers,json=outstandingVouchers,proto3" json:"outstanding_vouchers,omitempty"`
+}
+
+func (m *EventEvmChainRemoved) Reset()         { *m = EventEvmChainRemoved{} }
+func (m *EventEvmChainRemoved) String() string { return proto.CompactTextString(m) }
+func (*EventEvmChainRemoved) ProtoMessage()    {}
+func (*EventEvmChainRemoved) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{27}
+}
+func (m *EventEvmChainRemoved) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventEvmChainRemoved) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventEvmChainRemoved.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.EvmChainPrefix) > 0 {
-		i -= len(m.EvmChainPrefix)
-		copy(dAtA[i:], m.EvmChainPrefix)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.EvmChainPrefix)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.EthereumBlockHeight != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.EthereumBlockHeight))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.CosmosBlockHeight != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.CosmosBlockHeight))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+}
+func (m *EventEvmChainRemoved) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventEvmChainRemoved.Merge(m, src)
+}
+func (m *EventEvmChainRemoved) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventEvmChainRemoved) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventEvmChainRemoved.DiscardUnknown(m)
 }
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_EventEvmChainRemoved proto.InternalMessageInfo
+
+func (m *EventEvmChainRemoved) GetEvmChainPrefix() string {
+	if m != nil {
+		return m.EvmChainPrefix
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *MonitoredERC20Addresses) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *EventEvmChainRemoved) GetOutstandingVouchers() string {
+	if m != nil {
+		return m.OutstandingVouchers
 	}
-	var l int
-	_ = l
-	if len(m.Addresses) > 0 {
-		for _, b := range m.Addresses {
-			l = len(b)
-			n += 1 + l + sovTypes(uint64(l))
+	return ""
+}
+
+// BlacklistEntry blocks an address from using the bridge of an evm chain. An
+// evm address may not deposit from or withdraw to the evm chain, a bech32
+// address, matched on its account bytes whatever its prefix, may not send to
+// the evm chain nor receive deposits from it
+type BlacklistEntry struct {
+	EvmChainPrefix string `protobuf:"bytes,1,opt,name=evm_chain_prefix,json=evmChainPrefix,proto3" json:"evm_chain_prefix,omitempty"`
+	// an evm address (0x...) or a bech32 address
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// the block height from which the entry no longer applies, zero for an entry
+	// which never expires
+	ExpiryBlockHeight uint64 `protobuf:"varint,3,opt,name=expiry_block_height,json=expiryBlockHeight,proto3" json:"expiry_block_height,omitempty"`
+}
+
+func (m *BlacklistEntry) Reset()         { *m = BlacklistEntry{} }
+func (m *BlacklistEntry) String() string { return proto.CompactTextString(m) }
+func (*BlacklistEntry) ProtoMessage()    {}
+func (*BlacklistEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{28}
+}
+func (m *BlacklistEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlacklistEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlacklistEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *BlacklistEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlacklistEntry.Merge(m, src)
+}
+func (m *BlacklistEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlacklistEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlacklistEntry.DiscardUnknown(m)
 }
 
-func (m *BridgeValidator) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Power != 0 {
-		n += 1 + sovTypes(uint64(m.Power))
-	}
-	l = len(m.EthereumAddress)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+var xxx_messageInfo_BlacklistEntry proto.InternalMessageInfo
+
+func (m *BlacklistEntry) GetEvmChainPrefix() string {
+	if m != nil {
+		return m.EvmChainPrefix
 	}
-	return n
+	return ""
 }
 
-func (m *Valset) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BlacklistEntry) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	var l int
-	_ = l
-	if m.Nonce != 0 {
-		n += 1 + sovTypes(uint64(m.Nonce))
+	return ""
+}
+
+func (m *BlacklistEntry) GetExpiryBlockHeight() uint64 {
+	if m != nil {
+		return m.ExpiryBlockHeight
 	}
-	if len(m.Members) > 0 {
-		for _, e := range m.Members {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
+	return 0
+}
+
+// EvidenceRecord is the record of evidence of misbehaving ethereum signatures
+// accepted against a validator on an evm chain. kind is "bad-signature" for a
+// signature over a checkpoint the chain never requested, or "double-sign" for
+// signatures over two different checkpoints at the same nonce. The offender was
+// jailed and slashed by slash_amount, of which reporter_reward was paid to the
+// reporter. Evidence against a jailed validator is refused until it is
+// unjailed, so it is never recorded without a slash
+type EvidenceRecord struct {
+	// the hex encoded keccak256 hash of the signatures of the evidence
+	Id             string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Kind           string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	EvmChainPrefix string `protobuf:"bytes,3,opt,name=evm_chain_prefix,json=evmChainPrefix,proto3" json:"evm_chain_prefix,omitempty"`
+	Reporter       string `protobuf:"bytes,4,opt,name=reporter,proto3" json:"reporter,omitempty"`
+	// the operator address of the offending validator
+	Offender           string `protobuf:"bytes,5,opt,name=offender,proto3" json:"offender,omitempty"`
+	OffenderEthAddress string `protobuf:"bytes,6,opt,name=offender_eth_address,json=offenderEthAddress,proto3" json:"offender_eth_address,omitempty"`
+	// the hex encoded checkpoints signed by the offender
+	Checkpoints []string `protobuf:"bytes,7,rep,name=checkpoints,proto3" json:"checkpoints,omitempty"`
+	// the tokens slashed from the offender and burned
+	SlashAmount types1.Coin `protobuf:"bytes,8,opt,name=slash_amount,json=slashAmount,proto3" json:"slash_amount"`
+	// the reward minted for the reporter out of the slashed self-delegation, the
+	// evidence burned slash_amount less reporter_reward
+	ReporterReward types1.Coin `protobuf:"bytes,9,opt,name=reporter_reward,json=reporterReward,proto3" json:"reporter_reward"`
+	BlockHeight    uint64      `protobuf:"varint,10,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+}
+
+func (m *EvidenceRecord) Reset()         { *m = EvidenceRecord{} }
+func (m *EvidenceRecord) String() string { return proto.CompactTextString(m) }
+func (*EvidenceRecord) ProtoMessage()    {}
+func (*EvidenceRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{29}
+}
+func (m *EvidenceRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EvidenceRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EvidenceRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Height != 0 {
-		n += 1 + sovTypes(uint64(m.Height))
-	}
-	l = m.RewardAmount.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = len(m.RewardToken)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	return n
+}
+func (m *EvidenceRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EvidenceRecord.Merge(m, src)
+}
+func (m *EvidenceRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *EvidenceRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_EvidenceRecord.DiscardUnknown(m)
 }
 
-func (m *LastObservedEthereumBlockHeight) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.CosmosBlockHeight != 0 {
-		n += 1 + sovTypes(uint64(m.CosmosBlockHeight))
+var xxx_messageInfo_EvidenceRecord proto.InternalMessageInfo
+
+func (m *EvidenceRecord) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	if m.EthereumBlockHeight != 0 {
-		n += 1 + sovTypes(uint64(m.EthereumBlockHeight))
+	return ""
+}
+
+func (m *EvidenceRecord) GetKind() string {
+	if m != nil {
+		return m.Kind
 	}
-	return n
+	return ""
 }
 
-func (m *ERC20ToDenom) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EvidenceRecord) GetEvmChainPrefix() string {
+	if m != nil {
+		return m.EvmChainPrefix
 	}
-	var l int
-	_ = l
-	l = len(m.Erc20)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return ""
+}
+
+func (m *EvidenceRecord) GetReporter() string {
+	if m != nil {
+		return m.Reporter
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return ""
+}
+
+func (m *EvidenceRecord) GetOffender() string {
+	if m != nil {
+		return m.Offender
 	}
-	return n
+	return ""
 }
 
-func (m *UnhaltBridgeProposal) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EvidenceRecord) GetOffenderEthAddress() string {
+	if m != nil {
+		return m.OffenderEthAddress
 	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return ""
+}
+
+func (m *EvidenceRecord) GetCheckpoints() []string {
+	if m != nil {
+		return m.Checkpoints
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return nil
+}
+
+func (m *EvidenceRecord) GetSlashAmount() types1.Coin {
+	if m != nil {
+		return m.SlashAmount
 	}
-	if m.TargetNonce != 0 {
-		n += 1 + sovTypes(uint64(m.TargetNonce))
+	return types1.Coin{}
+}
+
+func (m *EvidenceRecord) GetReporterReward() types1.Coin {
+	if m != nil {
+		return m.ReporterReward
 	}
-	l = len(m.EvmChainPrefix)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return types1.Coin{}
+}
+
+func (m *EvidenceRecord) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	return n
+	return 0
 }
 
-func (m *AirdropProposal) Size() (n int) {
-	if m == nil {
-		return 0
+// AddBlacklistProposal defines a custom governance proposal type to blacklist
+// the given evm and bech32 addresses on the evm chain, optionally until
+// `expiry_block_height`. Addresses already on the blacklist get the new expiry
+type AddBlacklistProposal struct {
+	Title             string   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description       string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	EvmChainPrefix    string   `protobuf:"bytes,3,opt,name=evm_chain_prefix,json=evmChainPrefix,proto3" json:"evm_chain_prefix,omitempty"`
+	Addresses         []string `protobuf:"bytes,4,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	ExpiryBlockHeight uint64   `protobuf:"varint,5,opt,name=expiry_block_height,json=expiryBlockHeight,proto3" json:"expiry_block_height,omitempty"`
+}
+
+func (m *AddBlacklistProposal) Reset()      { *m = AddBlacklistProposal{} }
+func (*AddBlacklistProposal) ProtoMessage() {}
+func (*AddBlacklistProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{30}
+}
+func (m *AddBlacklistProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddBlacklistProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddBlacklistProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.Recipients)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+}
+func (m *AddBlacklistProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddBlacklistProposal.Merge(m, src)
+}
+func (m *AddBlacklistProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddBlacklistProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddBlacklistProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddBlacklistProposal proto.InternalMessageInfo
+
+// RemoveBlacklistProposal defines a custom governance proposal type to remove
+// the given evm and bech32 addresses from the blacklist of the evm chain
+type RemoveBlacklistProposal struct {
+	Title          string   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description    string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	EvmChainPrefix string   `protobuf:"bytes,3,opt,name=evm_chain_prefix,json=evmChainPrefix,proto3" json:"evm_chain_prefix,omitempty"`
+	Addresses      []string `protobuf:"bytes,4,rep,name=addresses,proto3" json:"addresses,omitempty"`
+}
+
+func (m *RemoveBlacklistProposal) Reset()      { *m = RemoveBlacklistProposal{} }
+func (*RemoveBlacklistProposal) ProtoMessage() {}
+func (*RemoveBlacklistProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{31}
+}
+func (m *RemoveBlacklistProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoveBlacklistProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoveBlacklistProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Amounts) > 0 {
-		l = 0
-		for _, e := range m.Amounts {
-			l += sovTypes(uint64(e))
+}
+func (m *RemoveBlacklistProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveBlacklistProposal.Merge(m, src)
+}
+func (m *RemoveBlacklistProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoveBlacklistProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveBlacklistProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveBlacklistProposal proto.InternalMessageInfo
+
+// TokenBatchStrategy selects the BatchStrategy used to build the batches of
+// `token_contract` on an evm chain, `max_batch_size` lowers the maximum batch
+// size of the chain for this token when non zero. The EndBlocker creates a
+// batch of the token on its own once either auto batch threshold is met
+type TokenBatchStrategy struct {
+	EvmChainPrefix string        `protobuf:"bytes,1,opt,name=evm_chain_prefix,json=evmChainPrefix,proto3" json:"evm_chain_prefix,omitempty"`
+	TokenContract  string        `protobuf:"bytes,2,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	Strategy       BatchStrategy `protobuf:"varint,3,opt,name=strategy,proto3,enum=gravity.v1.BatchStrategy" json:"strategy,omitempty"`
+	MaxBatchSize   uint64        `protobuf:"varint,4,opt,name=max_batch_size,json=maxBatchSize,proto3" json:"max_batch_size,omitempty"`
+	// BATCH_STRATEGY_FIFO only, must be positive
+	MaxWaitBlocks uint64 `protobuf:"varint,5,opt,name=max_wait_blocks,json=maxWaitBlocks,proto3" json:"max_wait_blocks,omitempty"`
+	// BATCH_STRATEGY_MIN_FEE only
+	MinFeePerTx github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=min_fee_per_tx,json=minFeePerTx,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_fee_per_tx"`
+	// create a batch once the batch the strategy would build pays at least
+	// these fees in the token, zero disables the fee threshold. Extra fees are
+	// not counted
+	AutoBatchMinFees github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=auto_batch_min_fees,json=autoBatchMinFees,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"auto_batch_min_fees"`
+	// create a batch once the oldest unbatched tx of the token has waited this
+	// many blocks, even if the last batch of the token pays more fees, zero
+	// disables the age threshold
+	AutoBatchMaxAge uint64 `protobuf:"varint,8,opt,name=auto_batch_max_age,json=autoBatchMaxAge,proto3" json:"auto_batch_max_age,omitempty"`
+}
+
+func (m *TokenBatchStrategy) Reset()         { *m = TokenBatchStrategy{} }
+func (m *TokenBatchStrategy) String() string { return proto.CompactTextString(m) }
+func (*TokenBatchStrategy) ProtoMessage()    {}
+func (*TokenBatchStrategy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{32}
+}
+func (m *TokenBatchStrategy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenBatchStrategy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenBatchStrategy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		n += 1 + sovTypes(uint64(l)) + l
+		return b[:n], nil
 	}
-	return n
+}
+func (m *TokenBatchStrategy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenBatchStrategy.Merge(m, src)
+}
+func (m *TokenBatchStrategy) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenBatchStrategy) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenBatchStrategy.DiscardUnknown(m)
 }
 
-func (m *IBCMetadataProposal) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_TokenBatchStrategy proto.InternalMessageInfo
+
+func (m *TokenBatchStrategy) GetEvmChainPrefix() string {
+	if m != nil {
+		return m.EvmChainPrefix
 	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return ""
+}
+
+func (m *TokenBatchStrategy) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return ""
+}
+
+func (m *TokenBatchStrategy) GetStrategy() BatchStrategy {
+	if m != nil {
+		return m.Strategy
 	}
-	l = m.Metadata.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = len(m.IbcDenom)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return BATCH_STRATEGY_UNSPECIFIED
+}
+
+func (m *TokenBatchStrategy) GetMaxBatchSize() uint64 {
+	if m != nil {
+		return m.MaxBatchSize
 	}
-	l = len(m.EvmChainPrefix)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return 0
+}
+
+func (m *TokenBatchStrategy) GetMaxWaitBlocks() uint64 {
+	if m != nil {
+		return m.MaxWaitBlocks
 	}
-	return n
+	return 0
 }
 
-func (m *AddEvmChainProposal) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TokenBatchStrategy) GetAutoBatchMaxAge() uint64 {
+	if m != nil {
+		return m.AutoBatchMaxAge
 	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	return 0
+}
+
+// SetBatchStrategyProposal defines a custom governance proposal type to set
+// the TokenBatchStrategy of `token_contract` on the given evm chain. An
+// unspecified strategy without a max batch size or auto batch threshold
+// removes the strategy, so that the token falls back to
+// BATCH_STRATEGY_FEE_DESC
+type SetBatchStrategyProposal struct {
+	Title            string                                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description      string                                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	EvmChainPrefix   string                                 `protobuf:"bytes,3,opt,name=evm_chain_prefix,json=evmChainPrefix,proto3" json:"evm_chain_prefix,omitempty"`
+	TokenContract    string                                 `protobuf:"bytes,4,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	Strategy         BatchStrategy                          `protobuf:"varint,5,opt,name=strategy,proto3,enum=gravity.v1.BatchStrategy" json:"strategy,omitempty"`
+	MaxBatchSize     uint64                                 `protobuf:"varint,6,opt,name=max_batch_size,json=maxBatchSize,proto3" json:"max_batch_size,omitempty"`
+	MaxWaitBlocks    uint64                                 `protobuf:"varint,7,opt,name=max_wait_blocks,json=maxWaitBlocks,proto3" json:"max_wait_blocks,omitempty"`
+	MinFeePerTx      github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=min_fee_per_tx,json=minFeePerTx,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_fee_per_tx"`
+	AutoBatchMinFees github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=auto_batch_min_fees,json=autoBatchMinFees,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"auto_batch_min_fees"`
+	AutoBatchMaxAge  uint64                                 `protobuf:"varint,10,opt,name=auto_batch_max_age,json=autoBatchMaxAge,proto3" json:"auto_batch_max_age,omitempty"`
+}
+
+func (m *SetBatchStrategyProposal) Reset()      { *m = SetBatchStrategyProposal{} }
+func (*SetBatchStrategyProposal) ProtoMessage() {}
+func (*SetBatchStrategyProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_163831c23fcc179f, []int{33}
+}
+func (m *SetBatchStrategyProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetBatchStrategyProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetBatchStrategyProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+}
+func (m *SetBatchStrategyProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetBatchStrategyProposal.Merge(m, src)
+}
+func (m *SetBatchStrategyProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetBatchStrategyProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetBatchStrategyProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetBatchStrategyProposal proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("gravity.v1.ValsetReason", ValsetReason_name, ValsetReason_value)
+	proto.RegisterEnum("gravity.v1.IbcAutoForwardStatus", IbcAutoForwardStatus_name, IbcAutoForwardStatus_value)
+	proto.RegisterEnum("gravity.v1.EvmChainDecommissionStatus", EvmChainDecommissionStatus_name, EvmChainDecommissionStatus_value)
+	proto.RegisterEnum("gravity.v1.BatchStrategy", BatchStrategy_name, BatchStrategy_value)
+	proto.RegisterType((*MonitoredERC20Addresses)(nil), "gravity.v1.MonitoredERC20Addresses")
+	proto.RegisterType((*BridgeValidator)(nil), "gravity.v1.BridgeValidator")
+	proto.RegisterType((*Valset)(nil), "gravity.v1.Valset")
+	proto.RegisterType((*LastObservedEthereumBlockHeight)(nil), "gravity.v1.LastObservedEthereumBlockHeight")
+	proto.RegisterType((*ERC20ToDenom)(nil), "gravity.v1.ERC20ToDenom")
+	proto.RegisterType((*UnhaltBridgeProposal)(nil), "gravity.v1.UnhaltBridgeProposal")
+	proto.RegisterType((*AirdropProposal)(nil), "gravity.v1.AirdropProposal")
+	proto.RegisterType((*IBCMetadataProposal)(nil), "gravity.v1.IBCMetadataProposal")
+	proto.RegisterType((*AddEvmChainProposal)(nil), "gravity.v1.AddEvmChainProposal")
+	proto.RegisterType((*MonitoredERC20TokensProposal)(nil), "gravity.v1.MonitoredERC20TokensProposal")
+	proto.RegisterType((*RemoveEvmChainProposal)(nil), "gravity.v1.RemoveEvmChainProposal")
+	proto.RegisterType((*OutgoingLogicCallProposal)(nil), "gravity.v1.OutgoingLogicCallProposal")
+	proto.RegisterType((*SetRateLimitProposal)(nil), "gravity.v1.SetRateLimitProposal")
+	proto.RegisterType((*ReleaseHeldSendToCosmosProposal)(nil), "gravity.v1.ReleaseHeldSendToCosmosProposal")
+	proto.RegisterType((*RateLimit)(nil), "gravity.v1.RateLimit")
+	proto.RegisterType((*RateLimitFlow)(nil), "gravity.v1.RateLimitFlow")
+	proto.RegisterType((*HeldSendToCosmos)(nil), "gravity.v1.HeldSendToCosmos")
+	proto.RegisterType((*SetIbcBridgeFeeProposal)(nil), "gravity.v1.SetIbcBridgeFeeProposal")
+	proto.RegisterType((*IbcBridgeFee)(nil), "gravity.v1.IbcBridgeFee")
+	proto.RegisterType((*ResolveFailedAttestationProposal)(nil), "gravity.v1.ResolveFailedAttestationProposal")
+	proto.RegisterType((*IbcTransferOrigin)(nil), "gravity.v1.IbcTransferOrigin")
+	proto.RegisterType((*PendingIbcAutoForward)(nil), "gravity.v1.PendingIbcAutoForward")
+	proto.RegisterType((*IbcAutoForwardLog)(nil), "gravity.v1.IbcAutoForwardLog")
+	proto.RegisterType((*IbcAutoForwardTransfer)(nil), "gravity.v1.IbcAutoForwardTransfer")
+	proto.RegisterType((*BridgeBalanceSnapshot)(nil), "gravity.v1.BridgeBalanceSnapshot")
+	proto.RegisterType((*EvmChainDecommission)(nil), "gravity.v1.EvmChainDecommission")
+	proto.RegisterType((*EventEvmChainDecommissionStarted)(nil), "gravity.v1.EventEvmChainDecommissionStarted")
+	proto.RegisterType((*EventEvmChainRemoved)(nil), "gravity.v1.EventEvmChainRemoved")
+	proto.RegisterType((*BlacklistEntry)(nil), "gravity.v1.BlacklistEntry")
+	proto.RegisterType((*EvidenceRecord)(nil), "gravity.v1.EvidenceRecord")
+	proto.RegisterType((*AddBlacklistProposal)(nil), "gravity.v1.AddBlacklistProposal")
+	proto.RegisterType((*RemoveBlacklistProposal)(nil), "gravity.v1.RemoveBlacklistProposal")
+	proto.RegisterType((*TokenBatchStrategy)(nil), "gravity.v1.TokenBatchStrategy")
+	proto.RegisterType((*SetBatchStrategyProposal)(nil), "gravity.v1.SetBatchStrategyProposal")
+}
+
+func init() { proto.RegisterFile("gravity/v1/types.proto", fileDescriptor_163831c23fcc179f) }
+
+var fileDescriptor_163831c23fcc179f = []byte{
+	// 2891 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x3a, 0xdf, 0x6f, 0x1b, 0x59,
+	0xd5, 0x19, 0xdb, 0x49, 0xec, 0x63, 0x37, 0x71, 0x27, 0x69, 0xea, 0xa6, 0x6d, 0x92, 0x7a, 0xb7,
+	0xdd, 0x6c, 0xbf, 0xaf, 0x49, 0x9b, 0x6f, 0xf7, 0xfb, 0x3e, 0x15, 0x89, 0x95, 0x7f, 0x4c, 0x52,
+	0xb3, 0x49, 0x5c, 0xc6, 0x6e, 0xca, 0x22, 0xa1, 0xd1, 0xf5, 0xcc, 0x8d, 0x3d, 0xca, 0x78, 0xae,
+	0x99, 0xb9, 0x76, 0x9c, 0x7d, 0x40, 0x3c, 0x80, 0xd8, 0x47, 0x1e, 0x90, 0x40, 0x42, 0x2b, 0xad,
+	0x84, 0xc4, 0x4f, 0x89, 0x27, 0x10, 0xff, 0xc2, 0x22, 0x24, 0xb4, 0x0f, 0x08, 0x21, 0x04, 0x0b,
+	0x6c, 0x85, 0xc4, 0x13, 0x0f, 0x3c, 0xf2, 0x84, 0xee, 0x8f, 0x99, 0x8c, 0x1d, 0xa7, 0xcd, 0x8f,
+	0x6e, 0xe0, 0x29, 0xbe, 0xe7, 0x9c, 0x7b, 0xee, 0xf9, 0x7d, 0xcf, 0x3d, 0x13, 0x98, 0x6b, 0x7a,
+	0xa8, 0x67, 0xd3, 0x83, 0xd5, 0xde, 0x83, 0x55, 0x7a, 0xd0, 0xc1, 0xfe, 0x4a, 0xc7, 0x23, 0x94,
+	0xa8, 0x20, 0xe1, 0x2b, 0xbd, 0x07, 0xf3, 0x0b, 0x26, 0xf1, 0xdb, 0xc4, 0x5f, 0x6d, 0x20, 0x1f,
+	0xaf, 0xf6, 0x1e, 0x34, 0x30, 0x45, 0x0f, 0x56, 0x4d, 0x62, 0xbb, 0x82, 0x36, 0x82, 0x77, 0xf7,
+	0x42, 0x3c, 0x5b, 0x48, 0xfc, 0x6c, 0x93, 0x34, 0x09, 0xff, 0xb9, 0xca, 0x7e, 0x49, 0xe8, 0x8d,
+	0xc8, 0xc9, 0x88, 0x52, 0xec, 0x53, 0x44, 0x6d, 0x22, 0x79, 0xe6, 0xff, 0x0f, 0xae, 0x6e, 0x11,
+	0xd7, 0xa6, 0xc4, 0xc3, 0x96, 0xa6, 0x97, 0xd6, 0xee, 0x17, 0x2c, 0xcb, 0xc3, 0xbe, 0x8f, 0x7d,
+	0xf5, 0x06, 0xa4, 0x50, 0xb0, 0xc8, 0x29, 0x4b, 0xf1, 0xe5, 0x8c, 0x7e, 0x08, 0xc8, 0xeb, 0x30,
+	0x5d, 0xf4, 0x6c, 0xab, 0x89, 0x77, 0x90, 0x63, 0x5b, 0x88, 0x12, 0x4f, 0x9d, 0x85, 0xf1, 0x0e,
+	0xd9, 0xc7, 0x5e, 0x4e, 0x59, 0x52, 0x96, 0x13, 0xba, 0x58, 0xa8, 0xaf, 0x43, 0x16, 0xd3, 0x16,
+	0xf6, 0x70, 0xb7, 0x6d, 0xc8, 0xed, 0xb9, 0xd8, 0x92, 0xb2, 0x9c, 0xd2, 0xa7, 0x03, 0xb8, 0x3c,
+	0x33, 0xff, 0x7e, 0x0c, 0x26, 0x76, 0x90, 0xe3, 0x63, 0xca, 0x78, 0xb9, 0xc4, 0x35, 0x71, 0xc0,
+	0x8b, 0x2f, 0xd4, 0xcf, 0xc0, 0x64, 0x1b, 0xb7, 0x1b, 0xd8, 0x63, 0x2c, 0xe2, 0xcb, 0xe9, 0xb5,
+	0xeb, 0x2b, 0x87, 0xf6, 0x5b, 0x19, 0x92, 0xa7, 0x98, 0xf8, 0xf0, 0xe3, 0xc5, 0x31, 0x3d, 0xd8,
+	0xa1, 0xce, 0xc1, 0x44, 0x0b, 0xdb, 0xcd, 0x16, 0xcd, 0xc5, 0x39, 0x4f, 0xb9, 0x52, 0x6b, 0x70,
+	0xc9, 0xc3, 0xfb, 0xc8, 0xb3, 0x0c, 0xd4, 0x26, 0x5d, 0x97, 0xe6, 0x12, 0x4c, 0xba, 0xe2, 0x0a,
+	0xdb, 0xfd, 0xfb, 0x8f, 0x17, 0xef, 0x34, 0x6d, 0xda, 0xea, 0x36, 0x56, 0x4c, 0xd2, 0x5e, 0x95,
+	0x0e, 0x10, 0x7f, 0xee, 0xf9, 0xd6, 0x9e, 0xf4, 0x65, 0xc5, 0xa5, 0x7a, 0x46, 0x30, 0x29, 0x70,
+	0x1e, 0xea, 0x2d, 0x90, 0x6b, 0x83, 0x92, 0x3d, 0xec, 0xe6, 0xc6, 0xb9, 0xc6, 0x69, 0x01, 0xab,
+	0x33, 0x90, 0x7a, 0x1f, 0x26, 0x3c, 0x8c, 0x7c, 0xe2, 0xe6, 0x26, 0x96, 0x94, 0xe5, 0xa9, 0xb5,
+	0x5c, 0x54, 0x17, 0x61, 0x06, 0x9d, 0xe3, 0x75, 0x49, 0x97, 0xff, 0xba, 0x02, 0x8b, 0x9b, 0xc8,
+	0xa7, 0xd5, 0x86, 0x8f, 0xbd, 0x1e, 0xb6, 0x34, 0x69, 0xbf, 0xa2, 0x43, 0xcc, 0xbd, 0x47, 0x42,
+	0x9b, 0x15, 0x98, 0x11, 0xe2, 0x19, 0x0d, 0x06, 0x35, 0xa4, 0xca, 0xc2, 0x8c, 0x97, 0x05, 0x2a,
+	0x4a, 0xbf, 0x06, 0x57, 0x42, 0xf7, 0x0c, 0xec, 0x88, 0xf1, 0x1d, 0x33, 0xf8, 0xe8, 0x19, 0xf9,
+	0x87, 0x90, 0xe1, 0xb1, 0x52, 0x27, 0x65, 0xec, 0x92, 0x36, 0x73, 0x16, 0xf6, 0xcc, 0xb5, 0xfb,
+	0xfc, 0x94, 0x94, 0x2e, 0x16, 0x0c, 0x6a, 0x31, 0xb4, 0xf4, 0xb6, 0x58, 0xe4, 0x7f, 0xa4, 0xc0,
+	0xec, 0x13, 0xb7, 0x85, 0x1c, 0x2a, 0xdc, 0xf5, 0xd8, 0x23, 0x1d, 0xe2, 0x23, 0x87, 0x91, 0x53,
+	0x9b, 0x3a, 0x38, 0x60, 0xc2, 0x17, 0xea, 0x12, 0xa4, 0x2d, 0xec, 0x9b, 0x9e, 0xdd, 0x61, 0x41,
+	0x2b, 0x59, 0x45, 0x41, 0xcc, 0xd2, 0x14, 0x79, 0x4d, 0x4c, 0x0d, 0x11, 0x30, 0x09, 0x2e, 0x77,
+	0x5a, 0xc0, 0xb6, 0x79, 0xd8, 0x2c, 0x43, 0x16, 0xf7, 0xda, 0x86, 0xd9, 0x42, 0xb6, 0x6b, 0x74,
+	0x3c, 0xbc, 0x6b, 0xf7, 0xa5, 0x43, 0xa6, 0x70, 0xaf, 0x5d, 0x62, 0xe0, 0xc7, 0x1c, 0xfa, 0x30,
+	0xf3, 0xde, 0x07, 0x8b, 0x63, 0xdf, 0xf9, 0x60, 0x71, 0xec, 0x6f, 0x1f, 0x2c, 0x2a, 0xf9, 0x1f,
+	0x28, 0x30, 0x5d, 0xb0, 0x3d, 0xcb, 0x23, 0x9d, 0x73, 0x8b, 0x19, 0x5a, 0x23, 0x1e, 0xb1, 0x86,
+	0xba, 0x00, 0xe0, 0x61, 0xd3, 0xee, 0xd8, 0xd8, 0xa5, 0x3e, 0x17, 0x3d, 0xa3, 0x47, 0x20, 0x6a,
+	0x0e, 0x26, 0x45, 0x50, 0xfa, 0xb9, 0xf1, 0xa5, 0xf8, 0x72, 0x42, 0x0f, 0x96, 0x43, 0x92, 0x3e,
+	0x53, 0x60, 0xa6, 0x52, 0x2c, 0x6d, 0x61, 0x8a, 0x2c, 0x44, 0xd1, 0xb9, 0xa5, 0x7d, 0x0b, 0x92,
+	0x6d, 0xc9, 0x8b, 0x0b, 0x9c, 0x5e, 0xbb, 0xb9, 0x22, 0x62, 0x67, 0x85, 0x17, 0x1c, 0x59, 0x7d,
+	0x56, 0x82, 0x03, 0x65, 0xae, 0x85, 0x9b, 0xd4, 0xeb, 0x90, 0xb2, 0x1b, 0xa6, 0x21, 0x54, 0xe6,
+	0x09, 0xa5, 0x27, 0xed, 0x86, 0x29, 0xe2, 0xe5, 0x6c, 0xfe, 0x18, 0xcb, 0xff, 0x32, 0x0e, 0x33,
+	0x05, 0xcb, 0xd2, 0x42, 0x9a, 0x73, 0x6a, 0xf9, 0x2a, 0x4c, 0x1d, 0xca, 0xe1, 0xa2, 0x36, 0x96,
+	0xce, 0xc9, 0x04, 0x52, 0x6c, 0xa3, 0xf6, 0xe8, 0xe8, 0x49, 0x8c, 0x92, 0x56, 0x7d, 0x00, 0x57,
+	0x22, 0xfc, 0x30, 0x35, 0x7a, 0xd8, 0xf3, 0xd9, 0xd9, 0xe3, 0x3c, 0x26, 0xd5, 0x90, 0x2d, 0xa6,
+	0x3b, 0x02, 0xa3, 0xde, 0x84, 0xe0, 0x06, 0x30, 0x6c, 0x8b, 0x17, 0x82, 0x94, 0x9e, 0x92, 0x90,
+	0x8a, 0xa5, 0xfe, 0x2f, 0x5c, 0x6d, 0xf0, 0x34, 0x31, 0x8e, 0xd4, 0xd0, 0x49, 0x4e, 0x7b, 0x45,
+	0xa0, 0xb5, 0xc1, 0x4a, 0xaa, 0x56, 0xe0, 0x56, 0xa4, 0xd6, 0x1b, 0x3d, 0x42, 0xb1, 0x6f, 0xf0,
+	0x7a, 0x6c, 0xd0, 0x96, 0x87, 0xfd, 0x16, 0x71, 0xac, 0x5c, 0x92, 0x4b, 0xb5, 0x10, 0x21, 0xdc,
+	0x61, 0x74, 0x8f, 0x19, 0x59, 0x3d, 0xa0, 0x52, 0x37, 0x60, 0x29, 0xca, 0x4a, 0x30, 0xb1, 0xb0,
+	0x83, 0x9b, 0x88, 0x62, 0xcb, 0x20, 0xae, 0x73, 0x90, 0x4b, 0x2d, 0x29, 0xcb, 0x49, 0xfd, 0x66,
+	0x84, 0x8e, 0x33, 0x29, 0x07, 0x54, 0x55, 0xd7, 0x39, 0x18, 0x8a, 0xd8, 0xef, 0x2b, 0x70, 0x63,
+	0xf0, 0xe6, 0xe1, 0x55, 0xd1, 0x3f, 0xb7, 0x53, 0x47, 0xb9, 0x2b, 0x3e, 0xd2, 0x5d, 0x73, 0x30,
+	0xc1, 0x8b, 0x33, 0x4b, 0xbc, 0xf8, 0x72, 0x4a, 0x97, 0xab, 0x21, 0x41, 0x7f, 0xa2, 0xc0, 0x9c,
+	0x8e, 0xdb, 0xa4, 0x87, 0x5f, 0x5a, 0xdc, 0x9d, 0x5c, 0xc4, 0x5b, 0x90, 0xb1, 0x3c, 0x46, 0xc5,
+	0x4b, 0xb3, 0x1f, 0x14, 0x37, 0x0e, 0xe3, 0x15, 0x79, 0x58, 0xda, 0x5f, 0xc4, 0xe1, 0x5a, 0xb5,
+	0x4b, 0x9b, 0xc4, 0x76, 0x9b, 0x9b, 0xa4, 0x69, 0x9b, 0x25, 0xe4, 0x38, 0x17, 0x28, 0xf0, 0x43,
+	0x48, 0x51, 0x0f, 0xb9, 0xfe, 0x2e, 0xf6, 0x84, 0x59, 0xd3, 0x6b, 0x73, 0xd1, 0x7b, 0xed, 0xd0,
+	0xd3, 0xb2, 0x64, 0x1c, 0x92, 0xab, 0xf7, 0x21, 0xb1, 0x8b, 0xb1, 0xa8, 0x74, 0x2f, 0xda, 0xc6,
+	0x29, 0xd5, 0x37, 0x60, 0xce, 0x61, 0x4a, 0x1a, 0x26, 0x71, 0xa9, 0x87, 0x4c, 0x1a, 0x66, 0x87,
+	0xc8, 0xa4, 0x59, 0x8e, 0x2d, 0x49, 0x64, 0x90, 0x1c, 0x39, 0x98, 0xec, 0xa0, 0x03, 0x87, 0x20,
+	0x8b, 0x27, 0x51, 0x46, 0x0f, 0x96, 0x0c, 0x43, 0xed, 0x36, 0x26, 0x5d, 0x2a, 0x93, 0x23, 0x58,
+	0xaa, 0xaf, 0xc1, 0xb4, 0xed, 0xf6, 0x44, 0x6b, 0xc1, 0xd2, 0xc0, 0xb6, 0x78, 0xd0, 0x67, 0xf4,
+	0xa9, 0x28, 0xb8, 0x62, 0xa9, 0xf7, 0x40, 0x1d, 0x20, 0x14, 0x97, 0x12, 0x88, 0xeb, 0x37, 0x8a,
+	0xe1, 0x57, 0xd3, 0xc3, 0x64, 0xe0, 0xbd, 0xfc, 0x5f, 0x63, 0x30, 0x5b, 0xc3, 0x54, 0x47, 0x14,
+	0x6f, 0xda, 0x6d, 0x9b, 0x5e, 0xa0, 0xd3, 0xc2, 0xbb, 0x29, 0x11, 0xbd, 0x9b, 0x5e, 0x81, 0x4b,
+	0xfb, 0xb6, 0x6b, 0x91, 0xfd, 0x20, 0xf8, 0x44, 0x15, 0xcb, 0x08, 0xa0, 0x88, 0x3e, 0xf5, 0xf3,
+	0x90, 0x69, 0xa3, 0xbe, 0x41, 0xba, 0xb4, 0x41, 0xba, 0xae, 0xac, 0x60, 0xa7, 0xee, 0x9d, 0xd2,
+	0x6d, 0xd4, 0xaf, 0x4a, 0x16, 0x6a, 0x15, 0xd8, 0xd2, 0xb0, 0x5d, 0xc1, 0x71, 0xf2, 0x4c, 0x1c,
+	0xa1, 0x8d, 0xfa, 0x15, 0xc1, 0x61, 0x28, 0x43, 0x7e, 0xa6, 0xc0, 0xa2, 0x8e, 0x1d, 0x8c, 0x7c,
+	0xfc, 0x08, 0x3b, 0x56, 0x0d, 0xbb, 0x56, 0x9d, 0x94, 0x38, 0x8f, 0x8b, 0x4d, 0x6c, 0xdc, 0xc3,
+	0xae, 0x6c, 0x5a, 0x44, 0xaa, 0x24, 0xf4, 0x34, 0x87, 0xf1, 0xc8, 0x18, 0x4e, 0xec, 0x6f, 0xc5,
+	0x20, 0x15, 0xc6, 0xc6, 0xc8, 0x83, 0x94, 0xe7, 0xfb, 0x36, 0xf6, 0x5c, 0xdf, 0xc6, 0x4f, 0xe0,
+	0xdb, 0xc4, 0x4b, 0xf7, 0xed, 0xf8, 0x79, 0x7d, 0x9b, 0xff, 0x58, 0x81, 0x4b, 0xa1, 0x59, 0xd6,
+	0x1d, 0xb2, 0xcf, 0x2c, 0x2b, 0x55, 0xf3, 0x29, 0xf2, 0x82, 0xce, 0x37, 0x2d, 0x60, 0x35, 0x06,
+	0x52, 0x3f, 0x07, 0xc9, 0x50, 0xa9, 0xd8, 0x99, 0x44, 0x08, 0xf7, 0xab, 0x8f, 0x60, 0x32, 0xd0,
+	0x26, 0x7e, 0x26, 0x56, 0xc1, 0xf6, 0xd1, 0x59, 0x98, 0xff, 0x69, 0x0c, 0xb2, 0xc3, 0x71, 0xaa,
+	0x2e, 0x42, 0x3a, 0x12, 0x3d, 0x52, 0x45, 0x38, 0x0c, 0x1e, 0x1e, 0x1f, 0xb4, 0x35, 0xaa, 0xa1,
+	0x9f, 0xc2, 0xb4, 0x15, 0xed, 0xff, 0x6f, 0xc3, 0x14, 0xbf, 0xf6, 0xc2, 0x12, 0x2a, 0x03, 0xf6,
+	0x12, 0x87, 0x06, 0xa5, 0x93, 0xd5, 0xbf, 0xb0, 0x03, 0xf1, 0xb1, 0x6b, 0x61, 0x2f, 0xec, 0x81,
+	0x24, 0xb8, 0xc6, 0xa1, 0x8c, 0x50, 0xbe, 0x3f, 0x3c, 0x6c, 0x62, 0xbb, 0x87, 0xbd, 0xa0, 0xb5,
+	0x13, 0x60, 0x5d, 0x42, 0xd5, 0x37, 0x61, 0x5c, 0x3c, 0x8d, 0x26, 0x78, 0x7f, 0x79, 0xed, 0xb0,
+	0xbf, 0xf4, 0x71, 0xd8, 0x5f, 0x96, 0x88, 0x1d, 0x54, 0x7c, 0x41, 0xcd, 0x54, 0x6f, 0x61, 0xc7,
+	0x0a, 0x94, 0x9a, 0x14, 0xaa, 0x33, 0x90, 0x7c, 0x9c, 0xfc, 0x5d, 0x81, 0xab, 0x35, 0x4c, 0x2b,
+	0x0d, 0x53, 0x3c, 0x30, 0xd6, 0x31, 0xfe, 0xb7, 0x97, 0xd2, 0x2d, 0x00, 0xd9, 0xc6, 0xed, 0x62,
+	0x7c, 0xc6, 0xa8, 0x4f, 0x35, 0x02, 0x75, 0x86, 0x2a, 0xc3, 0xfb, 0x0a, 0x64, 0xa2, 0xda, 0x9e,
+	0xbb, 0x38, 0x0c, 0x4a, 0x1b, 0x3f, 0xa7, 0xb4, 0xf9, 0x3f, 0x28, 0xb0, 0xa4, 0x63, 0x9f, 0x38,
+	0x3d, 0xbc, 0x8e, 0x6c, 0x07, 0x5b, 0x85, 0x48, 0x9b, 0x78, 0x71, 0x9e, 0x19, 0xca, 0x99, 0xc4,
+	0x91, 0x9c, 0x79, 0x0d, 0xa6, 0x3d, 0xbc, 0xdb, 0x75, 0xad, 0x23, 0x91, 0x2b, 0xc0, 0x41, 0xe4,
+	0x1e, 0xbd, 0x4f, 0x2e, 0x57, 0x1a, 0x66, 0x5d, 0xb6, 0x31, 0x55, 0xcf, 0x6e, 0xda, 0xee, 0x29,
+	0x7c, 0x30, 0x03, 0xe3, 0xb4, 0xcf, 0xfa, 0x09, 0x91, 0x9f, 0x09, 0xda, 0xaf, 0x58, 0xaa, 0x0a,
+	0x89, 0x0e, 0xf1, 0x82, 0x5c, 0xe4, 0xbf, 0x59, 0x73, 0x62, 0xb6, 0x90, 0xeb, 0x62, 0x47, 0x06,
+	0x57, 0xb0, 0x54, 0xe7, 0x21, 0xe9, 0xe3, 0x2f, 0x77, 0x31, 0xd3, 0x4b, 0x5c, 0xd2, 0xe1, 0x9a,
+	0x35, 0xb9, 0x32, 0x5f, 0x45, 0x4b, 0x24, 0x57, 0xf9, 0x9f, 0x2b, 0x70, 0xe5, 0x31, 0x76, 0x2d,
+	0xdb, 0x6d, 0x56, 0x1a, 0x66, 0xa1, 0x4b, 0xc9, 0x3a, 0xf1, 0xd8, 0x70, 0x82, 0x0d, 0x6c, 0x76,
+	0x89, 0x87, 0xed, 0xa6, 0x7b, 0x68, 0x08, 0x21, 0xfa, 0xb4, 0x84, 0x87, 0x39, 0xbc, 0x1a, 0xe4,
+	0x70, 0xec, 0x05, 0x39, 0x1c, 0xc9, 0x5e, 0xf6, 0x2c, 0x0c, 0xf4, 0x10, 0xea, 0x81, 0xdd, 0x30,
+	0x4b, 0x52, 0x95, 0x17, 0x79, 0x29, 0xff, 0x8f, 0x18, 0x5c, 0x1e, 0x14, 0x78, 0x93, 0x34, 0x4f,
+	0x61, 0xee, 0xa1, 0x03, 0x62, 0x47, 0xc2, 0x60, 0x94, 0xfa, 0xf1, 0xd1, 0xea, 0x87, 0x25, 0x2c,
+	0x71, 0xda, 0x12, 0x16, 0x35, 0xc2, 0xf8, 0x11, 0x23, 0xdc, 0x80, 0xd4, 0xae, 0xd0, 0x0d, 0x8b,
+	0x8e, 0x2a, 0xa9, 0x1f, 0x02, 0xd4, 0xff, 0x82, 0xcb, 0xb2, 0x2b, 0x35, 0xd8, 0x5f, 0x9f, 0xa2,
+	0x76, 0x47, 0xd6, 0xc1, 0xac, 0x44, 0xd4, 0x03, 0xb8, 0x18, 0xcd, 0x78, 0xc4, 0xcb, 0x25, 0x83,
+	0xd1, 0x8c, 0x47, 0xbc, 0xe3, 0x86, 0x44, 0xa9, 0x63, 0x86, 0x44, 0xf9, 0x3f, 0xc6, 0x61, 0x6e,
+	0xd0, 0xe8, 0x41, 0xb8, 0xbf, 0x4c, 0xcb, 0xbf, 0xbc, 0xa0, 0x1f, 0xe5, 0xc3, 0x89, 0xd1, 0x3e,
+	0x9c, 0x87, 0xe4, 0x2e, 0x72, 0x9c, 0x06, 0x32, 0xf7, 0xe4, 0x93, 0x3a, 0x5c, 0x1f, 0xfa, 0x37,
+	0x79, 0x2a, 0xff, 0xfe, 0x3f, 0x4c, 0xb0, 0xf2, 0xd6, 0xf5, 0xb9, 0x41, 0xa7, 0xd6, 0x96, 0xa2,
+	0x2f, 0x99, 0x41, 0x33, 0xd6, 0x38, 0x9d, 0x2e, 0xe9, 0x0f, 0xbd, 0x05, 0x51, 0x6f, 0xdd, 0x85,
+	0xcb, 0x3e, 0xb3, 0xdb, 0x80, 0xaf, 0xd2, 0x5c, 0xe5, 0x69, 0x86, 0x88, 0x5e, 0xe7, 0x6f, 0xc0,
+	0x9c, 0x49, 0xda, 0x1d, 0x07, 0xb3, 0xb7, 0xf9, 0xc0, 0x86, 0x0c, 0xdf, 0x30, 0x1b, 0x62, 0xa3,
+	0xfe, 0xfd, 0xa7, 0x02, 0x57, 0xc4, 0xfd, 0x51, 0x44, 0x0e, 0x72, 0x4d, 0x5c, 0x73, 0x51, 0xc7,
+	0x6f, 0x91, 0x0b, 0x19, 0x27, 0x9e, 0xa2, 0x86, 0xaf, 0x41, 0xb2, 0x21, 0x04, 0x7c, 0xc1, 0xe3,
+	0x52, 0x0f, 0xe9, 0x86, 0xc3, 0x6e, 0xfc, 0x48, 0x45, 0xf9, 0xed, 0x38, 0xcc, 0x06, 0x4f, 0xfb,
+	0x32, 0x36, 0x49, 0xbb, 0x6d, 0xfb, 0xfe, 0x71, 0x77, 0xcb, 0xe8, 0xd0, 0xfe, 0x6c, 0xe8, 0xf1,
+	0x18, 0xf7, 0xf8, 0x9d, 0x01, 0xa9, 0x46, 0xf0, 0x1e, 0xf2, 0xfb, 0x7f, 0x83, 0xca, 0x9b, 0xd5,
+	0x41, 0x93, 0x89, 0x9e, 0x3c, 0xcb, 0x31, 0x43, 0x36, 0xb6, 0x30, 0xb2, 0x1c, 0xdb, 0xc5, 0x83,
+	0x1b, 0x44, 0xb5, 0x9c, 0x09, 0x90, 0xd1, 0x3d, 0xf7, 0x61, 0xd6, 0x63, 0x43, 0x0c, 0xe4, 0x0c,
+	0x6e, 0x91, 0x93, 0x29, 0x89, 0x8b, 0xee, 0xe0, 0x13, 0x6c, 0x76, 0xef, 0x61, 0xcb, 0xa0, 0x7d,
+	0xf1, 0xa2, 0x4e, 0xe8, 0xe9, 0x00, 0x56, 0xef, 0xfb, 0x6a, 0x0f, 0xb2, 0x21, 0x49, 0x30, 0xa6,
+	0x9c, 0x5c, 0x8a, 0x3f, 0x3f, 0x55, 0xee, 0xb3, 0x54, 0xf9, 0xf1, 0x9f, 0x16, 0x97, 0x4f, 0xd0,
+	0x49, 0xb0, 0x0d, 0xbe, 0x3e, 0x1d, 0x1c, 0x22, 0x66, 0xeb, 0x3e, 0xab, 0x80, 0x26, 0x73, 0xae,
+	0xe3, 0xb0, 0x20, 0x47, 0xd4, 0x6c, 0x61, 0x5f, 0x3e, 0xd8, 0xb3, 0x21, 0xa2, 0x28, 0xe0, 0x6a,
+	0x1f, 0x2e, 0xb3, 0x7e, 0x81, 0xec, 0x73, 0x5a, 0x19, 0x3c, 0xa9, 0x97, 0x2f, 0x65, 0x36, 0x38,
+	0xa5, 0x18, 0x44, 0xde, 0x57, 0x60, 0x96, 0x74, 0xa9, 0x4f, 0x11, 0xbf, 0x65, 0x8d, 0x1e, 0xe9,
+	0x9a, 0x2d, 0x36, 0x16, 0x81, 0x97, 0x7f, 0xf8, 0x4c, 0xe4, 0xa0, 0x1d, 0x79, 0x4e, 0xfe, 0xab,
+	0x0a, 0x2c, 0x69, 0x2c, 0xce, 0x8f, 0x89, 0x40, 0x8f, 0x62, 0xeb, 0x14, 0x41, 0x7e, 0x6c, 0xd8,
+	0x89, 0xb6, 0x6c, 0x54, 0xd8, 0xe5, 0x7d, 0x98, 0x1d, 0x90, 0x40, 0x0c, 0xd2, 0x4e, 0x73, 0xea,
+	0x83, 0x63, 0x8c, 0x28, 0x0f, 0x1d, 0xa5, 0xf7, 0xd7, 0x14, 0x98, 0x2a, 0x3a, 0xc8, 0xdc, 0x73,
+	0x6c, 0x9f, 0x6a, 0x2e, 0xf5, 0x0e, 0x4e, 0x71, 0x1e, 0x9b, 0xb8, 0x0f, 0x7c, 0xa5, 0x0a, 0x96,
+	0xac, 0x14, 0xe2, 0x7e, 0xc7, 0xf6, 0x0e, 0x46, 0x65, 0xe9, 0x65, 0x81, 0x8a, 0xea, 0xfe, 0xdd,
+	0x38, 0x4c, 0x69, 0x3d, 0xdb, 0x62, 0x37, 0x92, 0x8e, 0x4d, 0xe2, 0x59, 0xea, 0x14, 0xc4, 0x6c,
+	0x4b, 0x1e, 0x1c, 0xb3, 0x79, 0x9b, 0xb7, 0x67, 0x07, 0x8f, 0x50, 0x9d, 0xff, 0x3e, 0x45, 0x35,
+	0x9c, 0x87, 0xa4, 0x87, 0xd9, 0x2d, 0x19, 0x3e, 0xc6, 0xc2, 0x35, 0xc3, 0x91, 0xdd, 0x5d, 0xd1,
+	0xf8, 0x89, 0x06, 0x23, 0x5c, 0xb3, 0x5a, 0x10, 0xfc, 0x66, 0x63, 0xe5, 0xa1, 0x99, 0x99, 0x1a,
+	0xe0, 0x34, 0xda, 0x0a, 0x26, 0x66, 0x4b, 0x90, 0x36, 0x5b, 0xd8, 0xdc, 0xeb, 0x10, 0x3b, 0xc8,
+	0xf1, 0x94, 0x1e, 0x05, 0xa9, 0x45, 0xc8, 0xf8, 0x0e, 0xf2, 0x5b, 0xc1, 0x37, 0xb4, 0x13, 0xde,
+	0x98, 0x69, 0xbe, 0x49, 0x7e, 0x33, 0x7b, 0x04, 0xd3, 0x81, 0xfc, 0x86, 0xf8, 0x50, 0x96, 0x4b,
+	0x9d, 0x8c, 0xcd, 0x54, 0xb0, 0x4f, 0xe7, 0xdb, 0x58, 0xed, 0x1a, 0xf0, 0x91, 0x18, 0xbf, 0xa5,
+	0x1b, 0x11, 0xef, 0xfc, 0x46, 0x81, 0xd9, 0x82, 0x65, 0x85, 0x71, 0x72, 0x81, 0x2f, 0x91, 0x81,
+	0x0f, 0xab, 0x62, 0xf4, 0x7c, 0x08, 0x38, 0x2e, 0xcc, 0xc6, 0x8f, 0x09, 0xb3, 0xa3, 0x9f, 0xac,
+	0xae, 0x8a, 0x24, 0xfb, 0x8f, 0xd3, 0x6c, 0x48, 0xd2, 0x5f, 0xc5, 0x41, 0x15, 0x13, 0x5d, 0x56,
+	0xa8, 0x6b, 0xd4, 0x43, 0x14, 0x37, 0x4f, 0x93, 0xa9, 0x47, 0x27, 0x17, 0xb1, 0x51, 0x93, 0x8b,
+	0x37, 0x21, 0xe9, 0x4b, 0xe6, 0x5c, 0xea, 0xa9, 0xb5, 0x6b, 0x03, 0x1f, 0x8d, 0xa3, 0xa7, 0xeb,
+	0x21, 0x29, 0xfb, 0x36, 0xc4, 0x26, 0x55, 0xfc, 0x76, 0x31, 0x7c, 0xfb, 0xdd, 0xe0, 0x2d, 0xc2,
+	0x46, 0x62, 0x62, 0x8f, 0xfd, 0x2e, 0x56, 0xef, 0xc0, 0x34, 0xa3, 0xda, 0x47, 0x36, 0x1d, 0x9c,
+	0x92, 0x5e, 0x6a, 0xa3, 0xfe, 0x53, 0x64, 0x53, 0x39, 0x4a, 0xab, 0xc1, 0x54, 0xdb, 0x76, 0xd9,
+	0x7b, 0xda, 0xe8, 0x60, 0xcf, 0xa0, 0xfd, 0x33, 0x0f, 0x4a, 0x6d, 0x97, 0x0d, 0x34, 0xb0, 0x57,
+	0xef, 0xab, 0x5f, 0x82, 0x19, 0xd4, 0xa5, 0x44, 0xca, 0x28, 0xf9, 0xfb, 0x67, 0x1c, 0x98, 0x66,
+	0x19, 0x2b, 0xae, 0xd8, 0x16, 0x3f, 0x82, 0xdd, 0xb2, 0x6a, 0x94, 0x3d, 0xea, 0x1b, 0xa8, 0x89,
+	0xe5, 0x35, 0x3b, 0x7d, 0x48, 0x8d, 0xfa, 0x85, 0x26, 0xce, 0x7f, 0x3b, 0x01, 0xb9, 0x1a, 0xa6,
+	0x03, 0xd6, 0xbc, 0xc0, 0xc0, 0x3b, 0x1a, 0x0b, 0x89, 0x17, 0xc5, 0xc2, 0xf8, 0x79, 0x62, 0x61,
+	0xe2, 0x64, 0xb1, 0x30, 0x79, 0xb2, 0x58, 0x48, 0x7e, 0x6a, 0xb1, 0x90, 0xfa, 0x54, 0x63, 0x01,
+	0x46, 0xc6, 0xc2, 0x60, 0x9e, 0xdf, 0xfd, 0xb5, 0x02, 0x99, 0xe8, 0x7f, 0x33, 0xa8, 0x37, 0xe1,
+	0xda, 0x4e, 0x61, 0xb3, 0xa6, 0xd5, 0x0d, 0x5d, 0x2b, 0xd4, 0xaa, 0xdb, 0xc6, 0x93, 0xed, 0xda,
+	0x63, 0xad, 0x54, 0x59, 0xaf, 0x68, 0xe5, 0xec, 0x98, 0x7a, 0x15, 0x66, 0x06, 0xd1, 0xeb, 0x15,
+	0xbd, 0x56, 0xcf, 0x2a, 0xea, 0x75, 0xb8, 0x3a, 0xbc, 0xaf, 0x58, 0xdd, 0x2e, 0x57, 0xb6, 0x37,
+	0xb2, 0x31, 0xf5, 0x06, 0xe4, 0x06, 0x91, 0x8f, 0xab, 0x4f, 0x35, 0xdd, 0x28, 0x57, 0xd6, 0xd7,
+	0xb3, 0x71, 0x75, 0x01, 0xe6, 0x07, 0xb1, 0x5b, 0x85, 0x2f, 0x18, 0x95, 0xed, 0xba, 0xa6, 0xef,
+	0x14, 0x36, 0xb3, 0x09, 0xf5, 0x36, 0xdc, 0x1a, 0xc4, 0x97, 0xb5, 0x4d, 0x6d, 0xa3, 0x50, 0xd7,
+	0x8c, 0xb7, 0xb5, 0x77, 0x8c, 0xd2, 0xa3, 0xc2, 0xf6, 0x86, 0x96, 0x1d, 0x9f, 0x4f, 0xbc, 0xf7,
+	0xbd, 0x85, 0xb1, 0xbb, 0x7f, 0x51, 0x60, 0x76, 0xd4, 0x2b, 0x4e, 0x7d, 0x0d, 0x5e, 0xa9, 0x14,
+	0x4b, 0x46, 0xe1, 0x49, 0xbd, 0x6a, 0xac, 0x57, 0xf5, 0xa7, 0x05, 0xbd, 0x6c, 0xd4, 0xea, 0x85,
+	0xfa, 0x93, 0xda, 0x90, 0x8a, 0xb7, 0xe1, 0xd6, 0x71, 0x84, 0x95, 0x6d, 0x63, 0x7d, 0xb3, 0xb2,
+	0xf1, 0x88, 0x29, 0xbc, 0x0c, 0xaf, 0x1e, 0x47, 0x56, 0x28, 0xbd, 0xbd, 0x5d, 0x7d, 0xba, 0xa9,
+	0x95, 0x37, 0xb4, 0x72, 0x36, 0xa6, 0xe6, 0x61, 0xe1, 0x38, 0xca, 0xf5, 0x42, 0x65, 0x53, 0x2b,
+	0x67, 0xe3, 0xcf, 0x3b, 0xb4, 0x5e, 0xd9, 0xd2, 0xca, 0x46, 0xf5, 0x49, 0x3d, 0x9b, 0x90, 0x3a,
+	0xfe, 0x50, 0x81, 0xf9, 0xe3, 0xdf, 0x2d, 0xea, 0x3d, 0x78, 0x5d, 0xdb, 0xd9, 0x62, 0x86, 0xa9,
+	0x30, 0x5b, 0x95, 0xaa, 0x5b, 0x5b, 0x95, 0x5a, 0xad, 0x52, 0xdd, 0x1e, 0xad, 0xef, 0x5d, 0xb8,
+	0xf3, 0x7c, 0xf2, 0xb2, 0x5e, 0xa8, 0x6c, 0x33, 0x47, 0x2a, 0xea, 0xeb, 0x70, 0xfb, 0xf9, 0xb4,
+	0xba, 0xb6, 0x55, 0xdd, 0x61, 0x5a, 0x4b, 0x51, 0xbf, 0xa1, 0xc0, 0xa5, 0xc1, 0x2b, 0x64, 0x01,
+	0xe6, 0x8b, 0x85, 0x7a, 0xe9, 0x91, 0x51, 0xab, 0xeb, 0x85, 0xba, 0xb6, 0xf1, 0xce, 0x90, 0x38,
+	0xd7, 0xe1, 0xea, 0x10, 0x7e, 0x5d, 0xd3, 0x8c, 0xb2, 0x56, 0x2b, 0x65, 0x15, 0x16, 0x7e, 0xc3,
+	0xc8, 0xca, 0x7a, 0x35, 0x1b, 0x53, 0xe7, 0x61, 0x6e, 0x08, 0xb1, 0xc5, 0x9c, 0xa5, 0x69, 0xd9,
+	0xb8, 0x90, 0xa4, 0xf8, 0xce, 0x87, 0x9f, 0x2c, 0x28, 0x1f, 0x7d, 0xb2, 0xa0, 0xfc, 0xf9, 0x93,
+	0x05, 0xe5, 0x9b, 0xcf, 0x16, 0xc6, 0x3e, 0x7a, 0xb6, 0x30, 0xf6, 0xbb, 0x67, 0x0b, 0x63, 0x5f,
+	0x7c, 0x2b, 0x92, 0x78, 0x1b, 0xa2, 0xde, 0xdc, 0x13, 0xef, 0xed, 0xe1, 0x65, 0x9b, 0x58, 0x5d,
+	0x07, 0xaf, 0xf6, 0x57, 0x83, 0xff, 0xda, 0xe2, 0x59, 0xd9, 0x98, 0xe0, 0xff, 0xad, 0xf5, 0x3f,
+	0xff, 0x1a, 0x00, 0xa1, 0x90, 0xa4, 0x6e, 0x47, 0x26, 0x00, 0x00,
+}
+
+func (this *UnhaltBridgeProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	l = len(m.EvmChainName)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+
+	that1, ok := that.(*UnhaltBridgeProposal)
+	if !ok {
+		that2, ok := that.(UnhaltBridgeProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	l = len(m.EvmChainPrefix)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.EvmChainNetVersion != 0 {
-		n += 1 + sovTypes(uint64(m.EvmChainNetVersion))
+	if this.Title != that1.Title {
+		return false
 	}
-	l = len(m.GravityId)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if this.Description != that1.Description {
+		return false
 	}
-	l = len(m.BridgeEthereumAddress)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if this.TargetNonce != that1.TargetNonce {
+		return false
 	}
-	return n
+	if this.EvmChainPrefix != that1.EvmChainPrefix {
+		return false
+	}
+	return true
 }
+func (this *AirdropProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *MonitoredERC20TokensProposal) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*AirdropProposal)
+	if !ok {
+		that2, ok := that.(AirdropProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if this.Title != that1.Title {
+		return false
 	}
-	l = len(m.EvmChainPrefix)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if this.Description != that1.Description {
+		return false
 	}
-	if len(m.Tokens) > 0 {
-		for _, s := range m.Tokens {
-			l = len(s)
-			n += 1 + l + sovTypes(uint64(l))
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if !bytes.Equal(this.Recipients, that1.Recipients) {
+		return false
+	}
+	if len(this.Amounts) != len(that1.Amounts) {
+		return false
+	}
+	for i := range this.Amounts {
+		if this.Amounts[i] != that1.Amounts[i] {
+			return false
 		}
 	}
-	return n
+	return true
 }
-
-func (m *RemoveEvmChainProposal) Size() (n int) {
-	if m == nil {
-		return 0
+func (this *AddEvmChainProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+
+	that1, ok := that.(*AddEvmChainProposal)
+	if !ok {
+		that2, ok := that.(AddEvmChainProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	l = len(m.EvmChainPrefix)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if this.Title != that1.Title {
+		return false
 	}
-	return n
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.EvmChainName != that1.EvmChainName {
+		return false
+	}
+	if this.EvmChainPrefix != that1.EvmChainPrefix {
+		return false
+	}
+	if this.EvmChainNetVersion != that1.EvmChainNetVersion {
+		return false
+	}
+	if this.GravityId != that1.GravityId {
+		return false
+	}
+	if this.BridgeEthereumAddress != that1.BridgeEthereumAddress {
+		return false
+	}
+	if this.AttestationVotesPowerThreshold != that1.AttestationVotesPowerThreshold {
+		return false
+	}
+	if this.AttestationPowerDelegatedOnly != that1.AttestationPowerDelegatedOnly {
+		return false
+	}
+	return true
 }
+func (this *MonitoredERC20TokensProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *PendingIbcAutoForward) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*MonitoredERC20TokensProposal)
+	if !ok {
+		that2, ok := that.(MonitoredERC20TokensProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.ForeignReceiver)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.Token != nil {
-		l = m.Token.Size()
-		n += 1 + l + sovTypes(uint64(l))
+	if this.Title != that1.Title {
+		return false
 	}
-	l = len(m.IbcChannel)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if this.Description != that1.Description {
+		return false
 	}
-	if m.EventNonce != 0 {
-		n += 1 + sovTypes(uint64(m.EventNonce))
+	if this.EvmChainPrefix != that1.EvmChainPrefix {
+		return false
 	}
-	return n
+	if len(this.Tokens) != len(that1.Tokens) {
+		return false
+	}
+	for i := range this.Tokens {
+		if this.Tokens[i] != that1.Tokens[i] {
+			return false
+		}
+	}
+	return true
 }
+func (this *RemoveEvmChainProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *BridgeBalanceSnapshot) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*RemoveEvmChainProposal)
+	if !ok {
+		that2, ok := that.(RemoveEvmChainProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if m.CosmosBlockHeight != 0 {
-		n += 1 + sovTypes(uint64(m.CosmosBlockHeight))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if m.EthereumBlockHeight != 0 {
-		n += 1 + sovTypes(uint64(m.EthereumBlockHeight))
+	if this.Title != that1.Title {
+		return false
 	}
-	l = len(m.EvmChainPrefix)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if this.Description != that1.Description {
+		return false
 	}
-	if len(m.Balances) > 0 {
-		for _, e := range m.Balances {
-			l = e.Size()
-			n += 1 + l + sovTypes(uint64(l))
+	if this.EvmChainPrefix != that1.EvmChainPrefix {
+		return false
+	}
+	if this.DrainBlocks != that1.DrainBlocks {
+		return false
+	}
+	return true
+}
+func (this *SetRateLimitProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SetRateLimitProposal)
+	if !ok {
+		that2, ok := that.(SetRateLimitProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if m.EventNonce != 0 {
-		n += 1 + sovTypes(uint64(m.EventNonce))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	return n
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.EvmChainPrefix != that1.EvmChainPrefix {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if this.WindowBlocks != that1.WindowBlocks {
+		return false
+	}
+	if !this.MaxOutbound.Equal(that1.MaxOutbound) {
+		return false
+	}
+	if !this.MaxInbound.Equal(that1.MaxInbound) {
+		return false
+	}
+	return true
 }
+func (this *ReleaseHeldSendToCosmosProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+	that1, ok := that.(*ReleaseHeldSendToCosmosProposal)
+	if !ok {
+		that2, ok := that.(ReleaseHeldSendToCosmosProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.EvmChainPrefix != that1.EvmChainPrefix {
+		return false
+	}
+	if len(this.EventNonces) != len(that1.EventNonces) {
+		return false
+	}
+	for i := range this.EventNonces {
+		if this.EventNonces[i] != that1.EventNonces[i] {
+			return false
+		}
+	}
+	return true
 }
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (this *SetIbcBridgeFeeProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SetIbcBridgeFeeProposal)
+	if !ok {
+		that2, ok := that.(SetIbcBridgeFeeProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.EvmChainPrefix != that1.EvmChainPrefix {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if !this.BridgeFee.Equal(that1.BridgeFee) {
+		return false
+	}
+	return true
 }
-func (m *MonitoredERC20Addresses) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
+func (this *ResolveFailedAttestationProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ResolveFailedAttestationProposal)
+	if !ok {
+		that2, ok := that.(ResolveFailedAttestationProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.EvmChainPrefix != that1.EvmChainPrefix {
+		return false
+	}
+	if this.EventNonce != that1.EventNonce {
+		return false
+	}
+	if this.RefundReceiver != that1.RefundReceiver {
+		return false
+	}
+	return true
+}
+func (this *AddBlacklistProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*AddBlacklistProposal)
+	if !ok {
+		that2, ok := that.(AddBlacklistProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.EvmChainPrefix != that1.EvmChainPrefix {
+		return false
+	}
+	if len(this.Addresses) != len(that1.Addresses) {
+		return false
+	}
+	for i := range this.Addresses {
+		if this.Addresses[i] != that1.Addresses[i] {
+			return false
+		}
+	}
+	if this.ExpiryBlockHeight != that1.ExpiryBlockHeight {
+		return false
+	}
+	return true
+}
+func (this *RemoveBlacklistProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RemoveBlacklistProposal)
+	if !ok {
+		that2, ok := that.(RemoveBlacklistProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.EvmChainPrefix != that1.EvmChainPrefix {
+		return false
+	}
+	if len(this.Addresses) != len(that1.Addresses) {
+		return false
+	}
+	for i := range this.Addresses {
+		if this.Addresses[i] != that1.Addresses[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *SetBatchStrategyProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SetBatchStrategyProposal)
+	if !ok {
+		that2, ok := that.(SetBatchStrategyProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if this.EvmChainPrefix != that1.EvmChainPrefix {
+		return false
+	}
+	if this.TokenContract != that1.TokenContract {
+		return false
+	}
+	if this.Strategy != that1.Strategy {
+		return false
+	}
+	if this.MaxBatchSize != that1.MaxBatchSize {
+		return false
+	}
+	if this.MaxWaitBlocks != that1.MaxWaitBlocks {
+		return false
+	}
+	if !this.MinFeePerTx.Equal(that1.MinFeePerTx) {
+		return false
+	}
+	if !this.AutoBatchMinFees.Equal(that1.AutoBatchMinFees) {
+		return false
+	}
+	if this.AutoBatchMaxAge != that1.AutoBatchMaxAge {
+		return false
+	}
+	return true
+}
+func (m *MonitoredERC20Addresses) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MonitoredERC20Addresses) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MonitoredERC20Addresses) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BridgeValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgeValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EthereumAddress) > 0 {
+		i -= len(m.EthereumAddress)
+		copy(dAtA[i:], m.EthereumAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.EthereumAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Power != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Valset) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Valset) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Valset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Reason != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.RewardToken) > 0 {
+		i -= len(m.RewardToken)
+		copy(dAtA[i:], m.RewardToken)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.RewardToken)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.RewardAmount.Size()
+		i -= size
+		if _, err := m.RewardAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Members[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LastObservedEthereumBlockHeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LastObservedEthereumBlockHeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LastObservedEthereumBlockHeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EthereumBlockHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EthereumBlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.CosmosBlockHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CosmosBlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ERC20ToDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ERC20ToDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ERC20ToDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Erc20) > 0 {
+		i -= len(m.Erc20)
+		copy(dAtA[i:], m.Erc20)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Erc20)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnhaltBridgeProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnhaltBridgeProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnhaltBridgeProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.TargetNonce != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TargetNonce))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AirdropProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AirdropProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AirdropProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amounts) > 0 {
+		dAtA2 := make([]byte, len(m.Amounts)*10)
+		var j1 int
+		for _, num := range m.Amounts {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintTypes(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Recipients) > 0 {
+		i -= len(m.Recipients)
+		copy(dAtA[i:], m.Recipients)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Recipients)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IBCMetadataProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IBCMetadataProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IBCMetadataProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.IbcDenom) > 0 {
+		i -= len(m.IbcDenom)
+		copy(dAtA[i:], m.IbcDenom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.IbcDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddEvmChainProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddEvmChainProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddEvmChainProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AttestationPowerDelegatedOnly {
+		i--
+		if m.AttestationPowerDelegatedOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.AttestationVotesPowerThreshold != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.AttestationVotesPowerThreshold))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.BridgeEthereumAddress) > 0 {
+		i -= len(m.BridgeEthereumAddress)
+		copy(dAtA[i:], m.BridgeEthereumAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.BridgeEthereumAddress)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.GravityId) > 0 {
+		i -= len(m.GravityId)
+		copy(dAtA[i:], m.GravityId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.GravityId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.EvmChainNetVersion != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EvmChainNetVersion))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.EvmChainName) > 0 {
+		i -= len(m.EvmChainName)
+		copy(dAtA[i:], m.EvmChainName)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.EvmChainName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MonitoredERC20TokensProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MonitoredERC20TokensProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MonitoredERC20TokensProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tokens) > 0 {
+		for iNdEx := len(m.Tokens) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Tokens[iNdEx])
+			copy(dAtA[i:], m.Tokens[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Tokens[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveEvmChainProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveEvmChainProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveEvmChainProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DrainBlocks != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.DrainBlocks))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OutgoingLogicCallProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OutgoingLogicCallProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OutgoingLogicCallProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.InvalidationNonce != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.InvalidationNonce))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.InvalidationId) > 0 {
+		i -= len(m.InvalidationId)
+		copy(dAtA[i:], m.InvalidationId)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.InvalidationId)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Timeout != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Timeout))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.LogicContractAddress) > 0 {
+		i -= len(m.LogicContractAddress)
+		copy(dAtA[i:], m.LogicContractAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.LogicContractAddress)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Transfers) > 0 {
+		for iNdEx := len(m.Transfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Transfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetRateLimitProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetRateLimitProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetRateLimitProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxInbound.Size()
+		i -= size
+		if _, err := m.MaxInbound.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.MaxOutbound.Size()
+		i -= size
+		if _, err := m.MaxOutbound.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.WindowBlocks != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.WindowBlocks))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReleaseHeldSendToCosmosProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReleaseHeldSendToCosmosProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReleaseHeldSendToCosmosProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EventNonces) > 0 {
+		dAtA5 := make([]byte, len(m.EventNonces)*10)
+		var j4 int
+		for _, num := range m.EventNonces {
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintTypes(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxInbound.Size()
+		i -= size
+		if _, err := m.MaxInbound.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MaxOutbound.Size()
+		i -= size
+		if _, err := m.MaxOutbound.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.WindowBlocks != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.WindowBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RateLimitFlow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimitFlow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimitFlow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.Inbound.Size()
+		i -= size
+		if _, err := m.Inbound.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Outbound.Size()
+		i -= size
+		if _, err := m.Outbound.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.WindowStart != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.WindowStart))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HeldSendToCosmos) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HeldSendToCosmos) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HeldSendToCosmos) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HeldHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.HeldHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size, err := m.Token.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.CosmosReceiver) > 0 {
+		i -= len(m.CosmosReceiver)
+		copy(dAtA[i:], m.CosmosReceiver)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.CosmosReceiver)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.EthereumSender) > 0 {
+		i -= len(m.EthereumSender)
+		copy(dAtA[i:], m.EthereumSender)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.EthereumSender)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EthBlockHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EthBlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EventNonce != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EventNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetIbcBridgeFeeProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetIbcBridgeFeeProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetIbcBridgeFeeProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.BridgeFee.Size()
+		i -= size
+		if _, err := m.BridgeFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IbcBridgeFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IbcBridgeFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IbcBridgeFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.BridgeFee.Size()
+		i -= size
+		if _, err := m.BridgeFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ResolveFailedAttestationProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ResolveFailedAttestationProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ResolveFailedAttestationProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RefundReceiver) > 0 {
+		i -= len(m.RefundReceiver)
+		copy(dAtA[i:], m.RefundReceiver)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.RefundReceiver)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.EventNonce != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EventNonce))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IbcTransferOrigin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IbcTransferOrigin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IbcTransferOrigin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Port) > 0 {
+		i -= len(m.Port)
+		copy(dAtA[i:], m.Port)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Port)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TxId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TxId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingIbcAutoForward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingIbcAutoForward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingIbcAutoForward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EventNonce != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EventNonce))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.IbcChannel) > 0 {
+		i -= len(m.IbcChannel)
+		copy(dAtA[i:], m.IbcChannel)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.IbcChannel)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Token != nil {
+		{
+			size, err := m.Token.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ForeignReceiver) > 0 {
+		i -= len(m.ForeignReceiver)
+		copy(dAtA[i:], m.ForeignReceiver)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ForeignReceiver)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IbcAutoForwardLog) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IbcAutoForwardLog) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IbcAutoForwardLog) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CosmosBlockHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CosmosBlockHeight))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.TimeoutTimestamp != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TimeoutTimestamp))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Forwarded {
+		i--
+		if m.Forwarded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.IbcChannel) > 0 {
+		i -= len(m.IbcChannel)
+		copy(dAtA[i:], m.IbcChannel)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.IbcChannel)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.Token.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ForeignReceiver) > 0 {
+		i -= len(m.ForeignReceiver)
+		copy(dAtA[i:], m.ForeignReceiver)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ForeignReceiver)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EventNonce != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EventNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IbcAutoForwardTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IbcAutoForwardTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IbcAutoForwardTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CompletedBlockHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CompletedBlockHeight))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.SentBlockHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SentBlockHeight))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Status != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size, err := m.Token.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if len(m.Fallback) > 0 {
+		i -= len(m.Fallback)
+		copy(dAtA[i:], m.Fallback)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Fallback)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.ForeignReceiver) > 0 {
+		i -= len(m.ForeignReceiver)
+		copy(dAtA[i:], m.ForeignReceiver)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ForeignReceiver)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Port) > 0 {
+		i -= len(m.Port)
+		copy(dAtA[i:], m.Port)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Port)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EventNonce != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EventNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BridgeBalanceSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgeBalanceSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeBalanceSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EventNonce != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EventNonce))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Balances) > 0 {
+		for iNdEx := len(m.Balances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EthereumBlockHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EthereumBlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.CosmosBlockHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CosmosBlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EvmChainDecommission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EvmChainDecommission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EvmChainDecommission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OutstandingVouchers) > 0 {
+		for iNdEx := len(m.OutstandingVouchers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OutstandingVouchers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.EscrowedBalances) > 0 {
+		for iNdEx := len(m.EscrowedBalances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EscrowedBalances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.CancelledBatches != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CancelledBatches))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.RefundedAmounts) > 0 {
+		for iNdEx := len(m.RefundedAmounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RefundedAmounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.RefundedTxs != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RefundedTxs))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.RemovalBlockHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RemovalBlockHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.DeadlineBlockHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.DeadlineBlockHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StartBlockHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.StartBlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Status != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventEvmChainDecommissionStarted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventEvmChainDecommissionStarted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventEvmChainDecommissionStarted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DeadlineBlockHeight) > 0 {
+		i -= len(m.DeadlineBlockHeight)
+		copy(dAtA[i:], m.DeadlineBlockHeight)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.DeadlineBlockHeight)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventEvmChainRemoved) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventEvmChainRemoved) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventEvmChainRemoved) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OutstandingVouchers) > 0 {
+		i -= len(m.OutstandingVouchers)
+		copy(dAtA[i:], m.OutstandingVouchers)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.OutstandingVouchers)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlacklistEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlacklistEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlacklistEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiryBlockHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ExpiryBlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EvidenceRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EvidenceRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EvidenceRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x50
+	}
+	{
+		size, err := m.ReporterReward.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size, err := m.SlashAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if len(m.Checkpoints) > 0 {
+		for iNdEx := len(m.Checkpoints) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Checkpoints[iNdEx])
+			copy(dAtA[i:], m.Checkpoints[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Checkpoints[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.OffenderEthAddress) > 0 {
+		i -= len(m.OffenderEthAddress)
+		copy(dAtA[i:], m.OffenderEthAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.OffenderEthAddress)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Offender) > 0 {
+		i -= len(m.Offender)
+		copy(dAtA[i:], m.Offender)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Offender)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Reporter) > 0 {
+		i -= len(m.Reporter)
+		copy(dAtA[i:], m.Reporter)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Reporter)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Kind) > 0 {
+		i -= len(m.Kind)
+		copy(dAtA[i:], m.Kind)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Kind)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AddBlacklistProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddBlacklistProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddBlacklistProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiryBlockHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ExpiryBlockHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveBlacklistProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveBlacklistProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveBlacklistProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenBatchStrategy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenBatchStrategy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenBatchStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AutoBatchMaxAge != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.AutoBatchMaxAge))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.AutoBatchMinFees.Size()
+		i -= size
+		if _, err := m.AutoBatchMinFees.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.MinFeePerTx.Size()
+		i -= size
+		if _, err := m.MinFeePerTx.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.MaxWaitBlocks != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxWaitBlocks))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxBatchSize != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxBatchSize))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Strategy != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Strategy))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetBatchStrategyProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetBatchStrategyProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetBatchStrategyProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AutoBatchMaxAge != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.AutoBatchMaxAge))
+		i--
+		dAtA[i] = 0x50
+	}
+	{
+		size := m.AutoBatchMinFees.Size()
+		i -= size
+		if _, err := m.AutoBatchMinFees.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.MinFeePerTx.Size()
+		i -= size
+		if _, err := m.MinFeePerTx.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.MaxWaitBlocks != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxWaitBlocks))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxBatchSize != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MaxBatchSize))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Strategy != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Strategy))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.EvmChainPrefix) > 0 {
+		i -= len(m.EvmChainPrefix)
+		copy(dAtA[i:], m.EvmChainPrefix)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.EvmChainPrefix)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MonitoredERC20Addresses) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, b := range m.Addresses {
+			l = len(b)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BridgeValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Power != 0 {
+		n += 1 + sovTypes(uint64(m.Power))
+	}
+	l = len(m.EthereumAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *Valset) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovTypes(uint64(m.Nonce))
+	}
+	if len(m.Members) > 0 {
+		for _, e := range m.Members {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	l = m.RewardAmount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.RewardToken)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Reason != 0 {
+		n += 1 + sovTypes(uint64(m.Reason))
+	}
+	return n
+}
+
+func (m *LastObservedEthereumBlockHeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CosmosBlockHeight != 0 {
+		n += 1 + sovTypes(uint64(m.CosmosBlockHeight))
+	}
+	if m.EthereumBlockHeight != 0 {
+		n += 1 + sovTypes(uint64(m.EthereumBlockHeight))
+	}
+	return n
+}
+
+func (m *ERC20ToDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Erc20)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *UnhaltBridgeProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.TargetNonce != 0 {
+		n += 1 + sovTypes(uint64(m.TargetNonce))
+	}
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *AirdropProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Recipients)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Amounts) > 0 {
+		l = 0
+		for _, e := range m.Amounts {
+			l += sovTypes(uint64(e))
+		}
+		n += 1 + sovTypes(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *IBCMetadataProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.IbcDenom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *AddEvmChainProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.EvmChainName)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.EvmChainNetVersion != 0 {
+		n += 1 + sovTypes(uint64(m.EvmChainNetVersion))
+	}
+	l = len(m.GravityId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.BridgeEthereumAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.AttestationVotesPowerThreshold != 0 {
+		n += 1 + sovTypes(uint64(m.AttestationVotesPowerThreshold))
+	}
+	if m.AttestationPowerDelegatedOnly {
+		n += 2
+	}
+	return n
+}
+
+func (m *MonitoredERC20TokensProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Tokens) > 0 {
+		for _, s := range m.Tokens {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RemoveEvmChainProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.DrainBlocks != 0 {
+		n += 1 + sovTypes(uint64(m.DrainBlocks))
+	}
+	return n
+}
+
+func (m *OutgoingLogicCallProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Transfers) > 0 {
+		for _, e := range m.Transfers {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = len(m.LogicContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Timeout != 0 {
+		n += 1 + sovTypes(uint64(m.Timeout))
+	}
+	l = len(m.InvalidationId)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.InvalidationNonce != 0 {
+		n += 1 + sovTypes(uint64(m.InvalidationNonce))
+	}
+	return n
+}
+
+func (m *SetRateLimitProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.WindowBlocks != 0 {
+		n += 1 + sovTypes(uint64(m.WindowBlocks))
+	}
+	l = m.MaxOutbound.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.MaxInbound.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *ReleaseHeldSendToCosmosProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.EventNonces) > 0 {
+		l = 0
+		for _, e := range m.EventNonces {
+			l += sovTypes(uint64(e))
+		}
+		n += 1 + sovTypes(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *RateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.WindowBlocks != 0 {
+		n += 1 + sovTypes(uint64(m.WindowBlocks))
+	}
+	l = m.MaxOutbound.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.MaxInbound.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *RateLimitFlow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WindowStart != 0 {
+		n += 1 + sovTypes(uint64(m.WindowStart))
+	}
+	l = m.Outbound.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.Inbound.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *HeldSendToCosmos) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EventNonce != 0 {
+		n += 1 + sovTypes(uint64(m.EventNonce))
+	}
+	if m.EthBlockHeight != 0 {
+		n += 1 + sovTypes(uint64(m.EthBlockHeight))
+	}
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.EthereumSender)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.CosmosReceiver)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Token.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.HeldHeight != 0 {
+		n += 1 + sovTypes(uint64(m.HeldHeight))
+	}
+	return n
+}
+
+func (m *SetIbcBridgeFeeProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.BridgeFee.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *IbcBridgeFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.BridgeFee.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *ResolveFailedAttestationProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.EventNonce != 0 {
+		n += 1 + sovTypes(uint64(m.EventNonce))
+	}
+	l = len(m.RefundReceiver)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *IbcTransferOrigin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.TxId != 0 {
+		n += 1 + sovTypes(uint64(m.TxId))
+	}
+	l = len(m.Port)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovTypes(uint64(m.Sequence))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *PendingIbcAutoForward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ForeignReceiver)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Token != nil {
+		l = m.Token.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.IbcChannel)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.EventNonce != 0 {
+		n += 1 + sovTypes(uint64(m.EventNonce))
+	}
+	return n
+}
+
+func (m *IbcAutoForwardLog) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.EventNonce != 0 {
+		n += 1 + sovTypes(uint64(m.EventNonce))
+	}
+	l = len(m.ForeignReceiver)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Token.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.IbcChannel)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Forwarded {
+		n += 2
+	}
+	if m.TimeoutTimestamp != 0 {
+		n += 1 + sovTypes(uint64(m.TimeoutTimestamp))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.CosmosBlockHeight != 0 {
+		n += 1 + sovTypes(uint64(m.CosmosBlockHeight))
+	}
+	return n
+}
+
+func (m *IbcAutoForwardTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.EventNonce != 0 {
+		n += 1 + sovTypes(uint64(m.EventNonce))
+	}
+	l = len(m.Port)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovTypes(uint64(m.Sequence))
+	}
+	l = len(m.ForeignReceiver)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Fallback)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Token.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovTypes(uint64(m.Status))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.SentBlockHeight != 0 {
+		n += 1 + sovTypes(uint64(m.SentBlockHeight))
+	}
+	if m.CompletedBlockHeight != 0 {
+		n += 1 + sovTypes(uint64(m.CompletedBlockHeight))
+	}
+	return n
+}
+
+func (m *BridgeBalanceSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CosmosBlockHeight != 0 {
+		n += 1 + sovTypes(uint64(m.CosmosBlockHeight))
+	}
+	if m.EthereumBlockHeight != 0 {
+		n += 1 + sovTypes(uint64(m.EthereumBlockHeight))
+	}
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Balances) > 0 {
+		for _, e := range m.Balances {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.EventNonce != 0 {
+		n += 1 + sovTypes(uint64(m.EventNonce))
+	}
+	return n
+}
+
+func (m *EvmChainDecommission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovTypes(uint64(m.Status))
+	}
+	if m.StartBlockHeight != 0 {
+		n += 1 + sovTypes(uint64(m.StartBlockHeight))
+	}
+	if m.DeadlineBlockHeight != 0 {
+		n += 1 + sovTypes(uint64(m.DeadlineBlockHeight))
+	}
+	if m.RemovalBlockHeight != 0 {
+		n += 1 + sovTypes(uint64(m.RemovalBlockHeight))
+	}
+	if m.RefundedTxs != 0 {
+		n += 1 + sovTypes(uint64(m.RefundedTxs))
+	}
+	if len(m.RefundedAmounts) > 0 {
+		for _, e := range m.RefundedAmounts {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.CancelledBatches != 0 {
+		n += 1 + sovTypes(uint64(m.CancelledBatches))
+	}
+	if len(m.EscrowedBalances) > 0 {
+		for _, e := range m.EscrowedBalances {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.OutstandingVouchers) > 0 {
+		for _, e := range m.OutstandingVouchers {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventEvmChainDecommissionStarted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.DeadlineBlockHeight)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *EventEvmChainRemoved) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.OutstandingVouchers)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *BlacklistEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.ExpiryBlockHeight != 0 {
+		n += 1 + sovTypes(uint64(m.ExpiryBlockHeight))
+	}
+	return n
+}
+
+func (m *EvidenceRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Reporter)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Offender)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.OffenderEthAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Checkpoints) > 0 {
+		for _, s := range m.Checkpoints {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	l = m.SlashAmount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.ReporterReward.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovTypes(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *AddBlacklistProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.ExpiryBlockHeight != 0 {
+		n += 1 + sovTypes(uint64(m.ExpiryBlockHeight))
+	}
+	return n
+}
+
+func (m *RemoveBlacklistProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TokenBatchStrategy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Strategy != 0 {
+		n += 1 + sovTypes(uint64(m.Strategy))
+	}
+	if m.MaxBatchSize != 0 {
+		n += 1 + sovTypes(uint64(m.MaxBatchSize))
+	}
+	if m.MaxWaitBlocks != 0 {
+		n += 1 + sovTypes(uint64(m.MaxWaitBlocks))
+	}
+	l = m.MinFeePerTx.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.AutoBatchMinFees.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.AutoBatchMaxAge != 0 {
+		n += 1 + sovTypes(uint64(m.AutoBatchMaxAge))
+	}
+	return n
+}
+
+func (m *SetBatchStrategyProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.EvmChainPrefix)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Strategy != 0 {
+		n += 1 + sovTypes(uint64(m.Strategy))
+	}
+	if m.MaxBatchSize != 0 {
+		n += 1 + sovTypes(uint64(m.MaxBatchSize))
+	}
+	if m.MaxWaitBlocks != 0 {
+		n += 1 + sovTypes(uint64(m.MaxWaitBlocks))
+	}
+	l = m.MinFeePerTx.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.AutoBatchMinFees.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.AutoBatchMaxAge != 0 {
+		n += 1 + sovTypes(uint64(m.AutoBatchMaxAge))
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MonitoredERC20Addresses) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MonitoredERC20Addresses: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MonitoredERC20Addresses: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, make([]byte, postIndex-iNdEx))
+			copy(m.Addresses[len(m.Addresses)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BridgeValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BridgeValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BridgeValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Valset) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Valset: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Valset: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, BridgeValidator{})
+			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= ValsetReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LastObservedEthereumBlockHeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LastObservedEthereumBlockHeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LastObservedEthereumBlockHeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosBlockHeight", wireType)
+			}
+			m.CosmosBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CosmosBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumBlockHeight", wireType)
+			}
+			m.EthereumBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthereumBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ERC20ToDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ERC20ToDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ERC20ToDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20 = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnhaltBridgeProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnhaltBridgeProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnhaltBridgeProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetNonce", wireType)
+			}
+			m.TargetNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AirdropProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AirdropProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AirdropProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipients = append(m.Recipients[:0], dAtA[iNdEx:postIndex]...)
+			if m.Recipients == nil {
+				m.Recipients = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Amounts = append(m.Amounts, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTypes
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Amounts) == 0 {
+					m.Amounts = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Amounts = append(m.Amounts, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amounts", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IBCMetadataProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IBCMetadataProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IBCMetadataProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddEvmChainProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddEvmChainProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddEvmChainProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmChainName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainNetVersion", wireType)
+			}
+			m.EvmChainNetVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EvmChainNetVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GravityId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GravityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeEthereumAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeEthereumAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationVotesPowerThreshold", wireType)
+			}
+			m.AttestationVotesPowerThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AttestationVotesPowerThreshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationPowerDelegatedOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AttestationPowerDelegatedOnly = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MonitoredERC20TokensProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MonitoredERC20TokensProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MonitoredERC20TokensProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tokens = append(m.Tokens, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveEvmChainProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveEvmChainProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveEvmChainProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrainBlocks", wireType)
+			}
+			m.DrainBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DrainBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OutgoingLogicCallProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OutgoingLogicCallProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OutgoingLogicCallProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transfers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transfers = append(m.Transfers, ERC20Token{})
+			if err := m.Transfers[len(m.Transfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fees = append(m.Fees, ERC20Token{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogicContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LogicContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			m.Timeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InvalidationId = append(m.InvalidationId[:0], dAtA[iNdEx:postIndex]...)
+			if m.InvalidationId == nil {
+				m.InvalidationId = []byte{}
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationNonce", wireType)
+			}
+			m.InvalidationNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InvalidationNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetRateLimitProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetRateLimitProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetRateLimitProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowBlocks", wireType)
+			}
+			m.WindowBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOutbound", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxOutbound.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxInbound", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxInbound.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReleaseHeldSendToCosmosProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReleaseHeldSendToCosmosProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReleaseHeldSendToCosmosProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.EventNonces = append(m.EventNonces, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTypes
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTypes
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTypes
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.EventNonces) == 0 {
+					m.EventNonces = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTypes
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.EventNonces = append(m.EventNonces, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventNonces", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowBlocks", wireType)
+			}
+			m.WindowBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOutbound", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxOutbound.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxInbound", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxInbound.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimitFlow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimitFlow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimitFlow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStart", wireType)
+			}
+			m.WindowStart = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowStart |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outbound", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Outbound.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inbound", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inbound.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HeldSendToCosmos) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HeldSendToCosmos: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HeldSendToCosmos: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
+			}
+			m.EventNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthBlockHeight", wireType)
+			}
+			m.EthBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumSender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumSender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosReceiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CosmosReceiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeldHeight", wireType)
+			}
+			m.HeldHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HeldHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SetIbcBridgeFeeProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetIbcBridgeFeeProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetIbcBridgeFeeProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BridgeFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IbcBridgeFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IbcBridgeFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IbcBridgeFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BridgeFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResolveFailedAttestationProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResolveFailedAttestationProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResolveFailedAttestationProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
+			}
+			m.EventNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundReceiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RefundReceiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IbcTransferOrigin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IbcTransferOrigin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IbcTransferOrigin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
+			}
+			m.TxId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Port = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingIbcAutoForward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingIbcAutoForward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingIbcAutoForward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForeignReceiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForeignReceiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Token == nil {
+				m.Token = &types1.Coin{}
+			}
+			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcChannel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
+			}
+			m.EventNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IbcAutoForwardLog) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IbcAutoForwardLog: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IbcAutoForwardLog: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
+			}
+			m.EventNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForeignReceiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForeignReceiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcChannel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Forwarded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Forwarded = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			m.TimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosBlockHeight", wireType)
+			}
+			m.CosmosBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CosmosBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IbcAutoForwardTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IbcAutoForwardTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IbcAutoForwardTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
+			}
+			m.EventNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Port = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MonitoredERC20Addresses: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MonitoredERC20Addresses: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ForeignReceiver", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2029,24 +10952,178 @@ func (m *MonitoredERC20Addresses) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, make([]byte, postIndex-iNdEx))
-			copy(m.Addresses[len(m.Addresses)-1], dAtA[iNdEx:postIndex])
+			m.ForeignReceiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fallback", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fallback = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= IbcAutoForwardStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SentBlockHeight", wireType)
+			}
+			m.SentBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SentBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletedBlockHeight", wireType)
+			}
+			m.CompletedBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompletedBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -2068,7 +11145,7 @@ func (m *MonitoredERC20Addresses) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BridgeValidator) Unmarshal(dAtA []byte) error {
+func (m *BridgeBalanceSnapshot) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2091,17 +11168,17 @@ func (m *BridgeValidator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BridgeValidator: wiretype end group for non-group")
+			return fmt.Errorf("proto: BridgeBalanceSnapshot: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BridgeValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BridgeBalanceSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosBlockHeight", wireType)
 			}
-			m.Power = 0
+			m.CosmosBlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2111,14 +11188,33 @@ func (m *BridgeValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Power |= uint64(b&0x7F) << shift
+				m.CosmosBlockHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumBlockHeight", wireType)
+			}
+			m.EthereumBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthereumBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2146,8 +11242,61 @@ func (m *BridgeValidator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EthereumAddress = string(dAtA[iNdEx:postIndex])
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balances = append(m.Balances, &ERC20Token{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
+			}
+			m.EventNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -2169,7 +11318,7 @@ func (m *BridgeValidator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Valset) Unmarshal(dAtA []byte) error {
+func (m *EvmChainDecommission) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2192,17 +11341,17 @@ func (m *Valset) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Valset: wiretype end group for non-group")
+			return fmt.Errorf("proto: EvmChainDecommission: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Valset: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EvmChainDecommission: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
 			}
-			m.Nonce = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2212,16 +11361,29 @@ func (m *Valset) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var msglen int
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2231,31 +11393,16 @@ func (m *Valset) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Status |= EvmChainDecommissionStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Members = append(m.Members, BridgeValidator{})
-			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartBlockHeight", wireType)
 			}
-			m.Height = 0
+			m.StartBlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2265,16 +11412,16 @@ func (m *Valset) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				m.StartBlockHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardAmount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadlineBlockHeight", wireType)
 			}
-			var stringLen uint64
+			m.DeadlineBlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2284,31 +11431,54 @@ func (m *Valset) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.DeadlineBlockHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovalBlockHeight", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
+			m.RemovalBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemovalBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundedTxs", wireType)
 			}
-			if err := m.RewardAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.RefundedTxs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RefundedTxs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 5:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundedAmounts", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2318,79 +11488,50 @@ func (m *Valset) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RewardToken = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
+			m.RefundedAmounts = append(m.RefundedAmounts, types1.Coin{})
+			if err := m.RefundedAmounts[len(m.RefundedAmounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *LastObservedEthereumBlockHeight) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelledBatches", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.CancelledBatches = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CancelledBatches |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: LastObservedEthereumBlockHeight: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LastObservedEthereumBlockHeight: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CosmosBlockHeight", wireType)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowedBalances", wireType)
 			}
-			m.CosmosBlockHeight = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2400,16 +11541,31 @@ func (m *LastObservedEthereumBlockHeight) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CosmosBlockHeight |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumBlockHeight", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
 			}
-			m.EthereumBlockHeight = 0
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EscrowedBalances = append(m.EscrowedBalances, types1.Coin{})
+			if err := m.EscrowedBalances[len(m.EscrowedBalances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutstandingVouchers", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2419,11 +11575,26 @@ func (m *LastObservedEthereumBlockHeight) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EthereumBlockHeight |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutstandingVouchers = append(m.OutstandingVouchers, types1.Coin{})
+			if err := m.OutstandingVouchers[len(m.OutstandingVouchers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -2445,7 +11616,7 @@ func (m *LastObservedEthereumBlockHeight) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ERC20ToDenom) Unmarshal(dAtA []byte) error {
+func (m *EventEvmChainDecommissionStarted) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2468,15 +11639,15 @@ func (m *ERC20ToDenom) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ERC20ToDenom: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventEvmChainDecommissionStarted: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ERC20ToDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventEvmChainDecommissionStarted: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Erc20", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2504,11 +11675,11 @@ func (m *ERC20ToDenom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Erc20 = string(dAtA[iNdEx:postIndex])
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeadlineBlockHeight", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2536,7 +11707,7 @@ func (m *ERC20ToDenom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.DeadlineBlockHeight = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2559,7 +11730,7 @@ func (m *ERC20ToDenom) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UnhaltBridgeProposal) Unmarshal(dAtA []byte) error {
+func (m *EventEvmChainRemoved) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2582,15 +11753,15 @@ func (m *UnhaltBridgeProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UnhaltBridgeProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventEvmChainRemoved: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UnhaltBridgeProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventEvmChainRemoved: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2618,62 +11789,11 @@ func (m *UnhaltBridgeProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetNonce", wireType)
-			}
-			m.TargetNonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TargetNonce |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutstandingVouchers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2701,7 +11821,7 @@ func (m *UnhaltBridgeProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
+			m.OutstandingVouchers = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2724,7 +11844,7 @@ func (m *UnhaltBridgeProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AirdropProposal) Unmarshal(dAtA []byte) error {
+func (m *BlacklistEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2747,15 +11867,15 @@ func (m *AirdropProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AirdropProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: BlacklistEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AirdropProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BlacklistEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2783,43 +11903,11 @@ func (m *AirdropProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2844,120 +11932,29 @@ func (m *AirdropProposal) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Recipients = append(m.Recipients[:0], dAtA[iNdEx:postIndex]...)
-			if m.Recipients == nil {
-				m.Recipients = []byte{}
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTypes
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Amounts = append(m.Amounts, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTypes
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTypes
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTypes
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.Amounts) == 0 {
-					m.Amounts = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTypes
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Amounts = append(m.Amounts, v)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryBlockHeight", wireType)
+			}
+			m.ExpiryBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amounts", wireType)
 			}
 		default:
 			iNdEx = preIndex
@@ -2980,7 +11977,7 @@ func (m *AirdropProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IBCMetadataProposal) Unmarshal(dAtA []byte) error {
+func (m *EvidenceRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3003,15 +12000,15 @@ func (m *IBCMetadataProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IBCMetadataProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: EvidenceRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IBCMetadataProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EvidenceRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3039,11 +12036,11 @@ func (m *IBCMetadataProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3071,13 +12068,13 @@ func (m *IBCMetadataProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3087,28 +12084,27 @@ func (m *IBCMetadataProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IbcDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reporter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3136,11 +12132,11 @@ func (m *IBCMetadataProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IbcDenom = string(dAtA[iNdEx:postIndex])
+			m.Reporter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Offender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3168,61 +12164,43 @@ func (m *IBCMetadataProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
+			m.Offender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OffenderEthAddress", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTypes
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AddEvmChainProposal) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AddEvmChainProposal: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddEvmChainProposal: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.OffenderEthAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Checkpoints", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3250,13 +12228,13 @@ func (m *AddEvmChainProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.Checkpoints = append(m.Checkpoints, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashAmount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3266,29 +12244,30 @@ func (m *AddEvmChainProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			if err := m.SlashAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReporterReward", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3298,27 +12277,97 @@ func (m *AddEvmChainProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EvmChainName = string(dAtA[iNdEx:postIndex])
+			if err := m.ReporterReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddBlacklistProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddBlacklistProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddBlacklistProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3346,13 +12395,13 @@ func (m *AddEvmChainProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
+			m.Title = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainNetVersion", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			m.EvmChainNetVersion = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3362,14 +12411,27 @@ func (m *AddEvmChainProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EvmChainNetVersion |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GravityId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3397,11 +12459,11 @@ func (m *AddEvmChainProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GravityId = string(dAtA[iNdEx:postIndex])
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeEthereumAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3429,8 +12491,27 @@ func (m *AddEvmChainProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BridgeEthereumAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryBlockHeight", wireType)
+			}
+			m.ExpiryBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -3452,7 +12533,7 @@ func (m *AddEvmChainProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MonitoredERC20TokensProposal) Unmarshal(dAtA []byte) error {
+func (m *RemoveBlacklistProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3475,10 +12556,10 @@ func (m *MonitoredERC20TokensProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MonitoredERC20TokensProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveBlacklistProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MonitoredERC20TokensProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveBlacklistProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3579,7 +12660,7 @@ func (m *MonitoredERC20TokensProposal) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3607,7 +12688,7 @@ func (m *MonitoredERC20TokensProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tokens = append(m.Tokens, string(dAtA[iNdEx:postIndex]))
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3630,7 +12711,7 @@ func (m *MonitoredERC20TokensProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoveEvmChainProposal) Unmarshal(dAtA []byte) error {
+func (m *TokenBatchStrategy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3653,15 +12734,15 @@ func (m *RemoveEvmChainProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoveEvmChainProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: TokenBatchStrategy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoveEvmChainProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TokenBatchStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3689,11 +12770,11 @@ func (m *RemoveEvmChainProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3721,11 +12802,68 @@ func (m *RemoveEvmChainProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+			}
+			m.Strategy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Strategy |= BatchStrategy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBatchSize", wireType)
+			}
+			m.MaxBatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBatchSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxWaitBlocks", wireType)
+			}
+			m.MaxWaitBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxWaitBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFeePerTx", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3753,8 +12891,63 @@ func (m *RemoveEvmChainProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
+			if err := m.MinFeePerTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoBatchMinFees", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AutoBatchMinFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoBatchMaxAge", wireType)
+			}
+			m.AutoBatchMaxAge = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoBatchMaxAge |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -3776,7 +12969,7 @@ func (m *RemoveEvmChainProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PendingIbcAutoForward) Unmarshal(dAtA []byte) error {
+func (m *SetBatchStrategyProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3799,15 +12992,15 @@ func (m *PendingIbcAutoForward) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PendingIbcAutoForward: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetBatchStrategyProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PendingIbcAutoForward: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetBatchStrategyProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForeignReceiver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3835,13 +13028,13 @@ func (m *PendingIbcAutoForward) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ForeignReceiver = string(dAtA[iNdEx:postIndex])
+			m.Title = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3851,31 +13044,27 @@ func (m *PendingIbcAutoForward) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Token == nil {
-				m.Token = &types1.Coin{}
-			}
-			if err := m.Token.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IbcChannel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3903,13 +13092,13 @@ func (m *PendingIbcAutoForward) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IbcChannel = string(dAtA[iNdEx:postIndex])
+			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
 			}
-			m.EventNonce = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3919,66 +13108,48 @@ func (m *PendingIbcAutoForward) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EventNonce |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BridgeBalanceSnapshot) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Strategy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Strategy |= BatchStrategy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BridgeBalanceSnapshot: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BridgeBalanceSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CosmosBlockHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBatchSize", wireType)
 			}
-			m.CosmosBlockHeight = 0
+			m.MaxBatchSize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -3988,16 +13159,16 @@ func (m *BridgeBalanceSnapshot) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CosmosBlockHeight |= uint64(b&0x7F) << shift
+				m.MaxBatchSize |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumBlockHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxWaitBlocks", wireType)
 			}
-			m.EthereumBlockHeight = 0
+			m.MaxWaitBlocks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4007,14 +13178,14 @@ func (m *BridgeBalanceSnapshot) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EthereumBlockHeight |= uint64(b&0x7F) << shift
+				m.MaxWaitBlocks |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EvmChainPrefix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFeePerTx", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4042,13 +13213,15 @@ func (m *BridgeBalanceSnapshot) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EvmChainPrefix = string(dAtA[iNdEx:postIndex])
+			if err := m.MinFeePerTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoBatchMinFees", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4058,31 +13231,31 @@ func (m *BridgeBalanceSnapshot) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Balances = append(m.Balances, &ERC20Token{})
-			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AutoBatchMinFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoBatchMaxAge", wireType)
 			}
-			m.EventNonce = 0
+			m.AutoBatchMaxAge = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -4092,7 +13265,7 @@ func (m *BridgeBalanceSnapshot) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EventNonce |= uint64(b&0x7F) << shift
+				m.AutoBatchMaxAge |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
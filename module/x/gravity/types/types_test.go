@@ -336,3 +336,271 @@ func TestParseReceiver(t *testing.T) {
 	sourceChannel, cosmosReceiver, destination, _, _, err = ParseReceiver(msgSendToCosmos.CosmosReceiver)
 	assert.Error(t, err)
 }
+
+func TestParseSendToEthMemo(t *testing.T) {
+	dest := "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+
+	// memos without an evm address are not meant for gravity
+	memo, err := ParseSendToEthMemo("channel-1/cosmos14n3tx8s5ftzhlxvq0w5962v60vd82h30sythlz")
+	require.NoError(t, err)
+	require.Nil(t, memo)
+
+	memo, err = ParseSendToEthMemo("bsc" + dest)
+	require.NoError(t, err)
+	require.Equal(t, &SendToEthMemo{EvmChainPrefix: "bsc", Destination: dest}, memo)
+
+	memo, err = ParseSendToEthMemo("bsc" + dest + ":20")
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(20), *memo.BridgeFee)
+	require.Nil(t, memo.ChainFee)
+
+	memo, err = ParseSendToEthMemo("bsc" + dest + ":20:0")
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(20), *memo.BridgeFee)
+	require.Equal(t, sdk.ZeroInt(), *memo.ChainFee)
+
+	for _, bad := range []string{"bsc" + dest + ":-1", "bsc" + dest + ":1:x", "bsc" + dest + ":1:2:3"} {
+		_, err = ParseSendToEthMemo(bad)
+		require.Error(t, err, bad)
+	}
+}
+
+func TestParseSendToEthMemoJSON(t *testing.T) {
+	dest := "0xd041c41EA1bf0F006ADBb6d2c9ef9D425dE5eaD7"
+	refund := sdk.AccAddress(bytes.Repeat([]byte{1}, 20)).String()
+
+	memo, err := ParseSendToEthMemo(`{"gravity":{"version":1,"evm_chain_prefix":"bsc","dest":"` + dest + `","bridge_fee":"10","chain_fee":"5","refund":"` + refund + `"}}`)
+	require.NoError(t, err)
+	require.Equal(t, "bsc", memo.EvmChainPrefix)
+	require.Equal(t, dest, memo.Destination)
+	require.Equal(t, sdk.NewInt(10), *memo.BridgeFee)
+	require.Equal(t, sdk.NewInt(5), *memo.ChainFee)
+	require.Equal(t, refund, memo.Refund)
+
+	// the version and fees are optional
+	memo, err = ParseSendToEthMemo(`{"gravity":{"evm_chain_prefix":"bsc","dest":"` + dest + `"}}`)
+	require.NoError(t, err)
+	require.Nil(t, memo.BridgeFee)
+	require.Nil(t, memo.ChainFee)
+
+	// memos of other middleware are left alone, even when they contain an evm address
+	memo, err = ParseSendToEthMemo(`{"forward":{"receiver":"` + dest + `","port":"transfer","channel":"channel-1"}}`)
+	require.NoError(t, err)
+	require.Nil(t, memo)
+
+	for _, bad := range []string{
+		`{"gravity":`,
+		`{"gravity":{"evm_chain_prefix":"bsc","dest":"` + dest + `","unknown":1}}`,
+		`{"gravity":{"version":2,"evm_chain_prefix":"bsc","dest":"` + dest + `"}}`,
+		`{"gravity":{"evm_chain_prefix":"","dest":"` + dest + `"}}`,
+		`{"gravity":{"evm_chain_prefix":"bsc","dest":"0xnotanaddress"}}`,
+		`{"gravity":{"evm_chain_prefix":"bsc","dest":"` + dest + `","bridge_fee":"-1"}}`,
+		`{"gravity":{"evm_chain_prefix":"bsc","dest":"` + dest + `","refund":"notbech32"}}`,
+	} {
+		_, err = ParseSendToEthMemo(bad)
+		require.Error(t, err, bad)
+	}
+}
+
+// nolint: exhaustruct
+func TestSetRateLimitProposalValidateBasic(t *testing.T) {
+	proposal := SetRateLimitProposal{
+		Title:          "title",
+		Description:    "description",
+		EvmChainPrefix: "ethereum",
+		Denom:          "ugraviton",
+		WindowBlocks:   100,
+		MaxOutbound:    sdk.NewInt(1000),
+		MaxInbound:     sdk.ZeroInt(),
+	}
+	require.NoError(t, proposal.ValidateBasic())
+	require.False(t, proposal.IsRemoval())
+	rateLimit := proposal.ToRateLimit()
+	require.True(t, rateLimit.IsOutboundLimited())
+	require.False(t, rateLimit.IsInboundLimited())
+
+	zeroWindow := proposal
+	zeroWindow.WindowBlocks = 0
+	require.Error(t, zeroWindow.ValidateBasic())
+
+	negative := proposal
+	negative.MaxInbound = sdk.NewInt(-1)
+	require.Error(t, negative.ValidateBasic())
+
+	badDenom := proposal
+	badDenom.Denom = "1"
+	require.Error(t, badDenom.ValidateBasic())
+
+	// a proposal without any maximum removes the limit and needs no window
+	removal := proposal
+	removal.MaxOutbound = sdk.Int{}
+	removal.WindowBlocks = 0
+	require.True(t, removal.IsRemoval())
+	require.NoError(t, removal.ValidateBasic())
+
+	release := ReleaseHeldSendToCosmosProposal{
+		Title:          "title",
+		Description:    "description",
+		EvmChainPrefix: "ethereum",
+		EventNonces:    []uint64{1, 2},
+	}
+	require.NoError(t, release.ValidateBasic())
+	release.EventNonces = []uint64{1, 1}
+	require.Error(t, release.ValidateBasic())
+	release.EventNonces = []uint64{0}
+	require.Error(t, release.ValidateBasic())
+}
+
+func TestResolveFailedAttestationProposalValidateBasic(t *testing.T) {
+	proposal := ResolveFailedAttestationProposal{
+		Title:          "title",
+		Description:    "description",
+		EvmChainPrefix: "ethereum",
+		EventNonce:     1,
+	}
+	require.NoError(t, proposal.ValidateBasic())
+	require.False(t, proposal.IsRefund())
+
+	refund := proposal
+	refund.RefundReceiver = "oraib14n3tx8s5ftzhlxvq0w5962v60vd82h305kec0j"
+	require.True(t, refund.IsRefund())
+	require.NoError(t, refund.ValidateBasic())
+	refund.RefundReceiver = "not an address"
+	require.Error(t, refund.ValidateBasic())
+
+	zeroNonce := proposal
+	zeroNonce.EventNonce = 0
+	require.Error(t, zeroNonce.ValidateBasic())
+
+	noChain := proposal
+	noChain.EvmChainPrefix = ""
+	require.Error(t, noChain.ValidateBasic())
+}
+
+func TestEvmChainDecommissionValidateBasic(t *testing.T) {
+	draining := EvmChainDecommission{
+		EvmChainPrefix:      "ethereum",
+		Status:              EVM_CHAIN_DECOMMISSION_STATUS_DRAINING,
+		StartBlockHeight:    10,
+		DeadlineBlockHeight: 20,
+	}
+	require.NoError(t, draining.ValidateBasic())
+	require.True(t, draining.IsDraining())
+
+	removed := draining
+	removed.Status = EVM_CHAIN_DECOMMISSION_STATUS_REMOVED
+	removed.RemovalBlockHeight = 25
+	require.NoError(t, removed.ValidateBasic())
+	require.False(t, removed.IsDraining())
+	removed.RemovalBlockHeight = 15
+	require.Error(t, removed.ValidateBasic())
+
+	early := draining
+	early.RemovalBlockHeight = 15
+	require.Error(t, early.ValidateBasic())
+
+	backwards := draining
+	backwards.DeadlineBlockHeight = 5
+	require.Error(t, backwards.ValidateBasic())
+
+	unspecified := draining
+	unspecified.Status = EVM_CHAIN_DECOMMISSION_STATUS_UNSPECIFIED
+	require.Error(t, unspecified.ValidateBasic())
+}
+
+func TestBlacklistProposalValidateBasic(t *testing.T) {
+	proposal := AddBlacklistProposal{
+		Title:          "title",
+		Description:    "description",
+		EvmChainPrefix: "ethereum",
+		Addresses:      []string{"0xc783df8a850f42e7F7e57013759C285caa701eB6", "oraib14n3tx8s5ftzhlxvq0w5962v60vd82h305kec0j"},
+	}
+	require.NoError(t, proposal.ValidateBasic())
+
+	badAddress := proposal
+	badAddress.Addresses = []string{"not an address"}
+	require.Error(t, badAddress.ValidateBasic())
+
+	// the same evm address in another case is a duplicate
+	duplicate := proposal
+	duplicate.Addresses = []string{"0xc783df8a850f42e7F7e57013759C285caa701eB6", "0xc783df8a850f42e7f7e57013759c285caa701eb6"}
+	require.Error(t, duplicate.ValidateBasic())
+
+	remove := RemoveBlacklistProposal{
+		Title:          "title",
+		Description:    "description",
+		EvmChainPrefix: "ethereum",
+	}
+	require.Error(t, remove.ValidateBasic())
+	remove.Addresses = proposal.Addresses
+	require.NoError(t, remove.ValidateBasic())
+	remove.EvmChainPrefix = ""
+	require.Error(t, remove.ValidateBasic())
+
+	entry := BlacklistEntry{EvmChainPrefix: "ethereum", Address: proposal.Addresses[1], ExpiryBlockHeight: 10}
+	require.NoError(t, entry.ValidateBasic())
+	require.False(t, entry.IsExpired(9))
+	require.True(t, entry.IsExpired(10))
+}
+
+func TestBatchStrategyProposalValidateBasic(t *testing.T) {
+	proposal := SetBatchStrategyProposal{
+		Title:          "title",
+		Description:    "description",
+		EvmChainPrefix: "ethereum",
+		TokenContract:  "0xc783df8a850f42e7F7e57013759C285caa701eB6",
+		Strategy:       BATCH_STRATEGY_FIFO,
+		MaxWaitBlocks:  100,
+	}
+	require.False(t, proposal.IsRemoval())
+	require.NoError(t, proposal.ValidateBasic())
+
+	// parameters of another strategy are rejected
+	minFee := proposal
+	minFee.MinFeePerTx = sdk.NewInt(5)
+	require.Error(t, minFee.ValidateBasic())
+	minFee.Strategy = BATCH_STRATEGY_MIN_FEE
+	require.Error(t, minFee.ValidateBasic())
+	minFee.MaxWaitBlocks = 0
+	require.NoError(t, minFee.ValidateBasic())
+	minFee.MinFeePerTx = sdk.ZeroInt()
+	require.Error(t, minFee.ValidateBasic())
+
+	// fifo must bound how long a cheaper batch waits, zero would let every new batch replace the last one
+	noWait := proposal
+	noWait.MaxWaitBlocks = 0
+	require.Error(t, noWait.ValidateBasic())
+
+	unknown := proposal
+	unknown.Strategy = BatchStrategy(42)
+	require.Error(t, unknown.ValidateBasic())
+
+	badToken := proposal
+	badToken.TokenContract = "not an address"
+	require.Error(t, badToken.ValidateBasic())
+
+	// a max batch size alone keeps the fee desc strategy
+	sizeOnly := proposal
+	sizeOnly.Strategy = BATCH_STRATEGY_UNSPECIFIED
+	sizeOnly.MaxWaitBlocks = 0
+	sizeOnly.MaxBatchSize = 10
+	require.False(t, sizeOnly.IsRemoval())
+	require.NoError(t, sizeOnly.ValidateBasic())
+
+	// an auto batch threshold alone keeps the fee desc strategy too
+	autoOnly := sizeOnly
+	autoOnly.MaxBatchSize = 0
+	autoOnly.AutoBatchMaxAge = 50
+	require.False(t, autoOnly.IsRemoval())
+	require.NoError(t, autoOnly.ValidateBasic())
+	require.True(t, autoOnly.ToTokenBatchStrategy().IsAutoBatched())
+	autoOnly.AutoBatchMinFees = sdk.NewInt(-1)
+	require.Error(t, autoOnly.ValidateBasic())
+
+	removal := sizeOnly
+	removal.MaxBatchSize = 0
+	require.True(t, removal.IsRemoval())
+	require.NoError(t, removal.ValidateBasic())
+	removal.TokenContract = ""
+	require.Error(t, removal.ValidateBasic())
+}
@@ -218,6 +218,11 @@ func NewValset(nonce, height uint64, members InternalBridgeValidators, rewardAmo
 
 // GetCheckpoint returns the checkpoint
 func (v Valset) GetCheckpoint(gravityIDstring string) []byte {
+	return crypto.Keccak256Hash(v.GetCheckpointABIEncoding(gravityIDstring)).Bytes()
+}
+
+// GetCheckpointABIEncoding returns the abi encoding of the valset which is hashed into its checkpoint
+func (v Valset) GetCheckpointABIEncoding(gravityIDstring string) []byte {
 
 	// error case here should not occur outside of testing since the above is a constant
 	contractAbi, abiErr := abi.JSON(strings.NewReader(ValsetCheckpointABIJSON))
@@ -268,11 +273,10 @@ func (v Valset) GetCheckpoint(gravityIDstring string) []byte {
 		panic(fmt.Sprintf("Error packing checkpoint! %s/n", packErr))
 	}
 
-	// we hash the resulting encoded bytes discarding the first 4 bytes these 4 bytes are the constant
+	// we discard the first 4 bytes of the resulting encoded bytes, these 4 bytes are the constant
 	// method name 'checkpoint'. If you were to replace the checkpoint constant in this code you would
 	// then need to adjust how many bytes you truncate off the front to get the output of abi.encode()
-	hash := crypto.Keccak256Hash(bytes[4:])
-	return hash.Bytes()
+	return bytes[4:]
 }
 
 // WithoutEmptyMembers returns a new Valset without member that have 0 power or an empty Ethereum address.
@@ -322,6 +326,21 @@ func (v Valset) Equal(o Valset) (bool, error) {
 	return true, nil
 }
 
+// GravityPowerToPass is the power of a valset which must have signed a valset update, batch or logic call for the
+// Gravity contract to accept it, this is a mirror of constant_powerThreshold in Gravity.sol
+const GravityPowerToPass uint64 = 2863311530
+
+// SignedPower returns the power of the members of the valset whose ethereum address is one of `signers`, which must be
+// given as checksummed hex
+func (v Valset) SignedPower(signers map[string]bool) (power uint64) {
+	for _, member := range v.Members {
+		if gethcommon.IsHexAddress(member.EthereumAddress) && signers[gethcommon.HexToAddress(member.EthereumAddress).Hex()] {
+			power += member.Power
+		}
+	}
+	return
+}
+
 func (v Valset) ValidateBasic() error {
 	if len(v.Members) == 0 {
 		return sdkerrors.Wrap(ErrInvalidValset, "valset must have members")
@@ -383,6 +402,7 @@ func (b OutgoingTxBatch) GetFees() sdk.Int {
 // The naming here could be improved.
 type EthereumSigned interface {
 	GetCheckpoint(gravityIDstring string) []byte
+	GetCheckpointABIEncoding(gravityIDstring string) []byte
 }
 
 // nolint: exhaustruct
@@ -723,6 +723,11 @@ pub struct MsgBatchSendToEthClaim {
     pub orchestrator: ::prost::alloc::string::String,
     #[prost(string, tag = "6")]
     pub evm_chain_prefix: ::prost::alloc::string::String,
+    /// the evm address which submitted the batch, the extra fees of the batch are
+    /// paid to the orchestrator of the validator owning it. Empty if unknown.
+    /// Ignored unless the relayer_in_batch_claims param of the evm chain is set
+    #[prost(string, tag = "7")]
+    pub relayer: ::prost::alloc::string::String,
 }
 #[allow(clippy::derive_partial_eq_without_eq)]
 #[derive(Clone, PartialEq, ::prost::Message)]
@@ -391,6 +391,11 @@ pub struct TransactionBatchExecutedEvent {
     /// of the Gravity solidity contract. Ensuring that these events can only be played
     /// back in order
     pub event_nonce: u64,
+    /// the hash of the transaction which executed the batch, used to look up its relayer
+    pub tx_hash: Option<Uint256>,
+    /// the address which submitted the batch, the extra fees of the batch are paid
+    /// to the orchestrator of the validator owning it. None if unknown
+    pub relayer: Option<EthAddress>,
 }
 
 impl EthereumEvent for TransactionBatchExecutedEvent {
@@ -438,6 +443,11 @@ impl EthereumEvent for TransactionBatchExecutedEvent {
                     block_height,
                     erc20,
                     event_nonce,
+                    tx_hash: input
+                        .transaction_hash
+                        .as_ref()
+                        .map(|hash| Uint256::from_be_bytes(hash)),
+                    relayer: None,
                 })
             }
         } else {
@@ -481,6 +491,10 @@ impl EthereumEvent for TransactionBatchExecutedEvent {
             batch_nonce: self.batch_nonce,
             orchestrator: orchestrator.to_string(),
             evm_chain_prefix,
+            relayer: self
+                .relayer
+                .map(|relayer| relayer.to_string())
+                .unwrap_or_default(),
         };
         Msg::new(MSG_BATCH_SEND_TO_ETH_TYPE_URL, claim)
     }
@@ -502,6 +516,8 @@ impl ContractEvent for TransactionBatchExecutedEvent {
             event_nonce,
             erc20: result["_token"].as_str().unwrap().parse().unwrap(),
             batch_nonce: result["_batchNonce"].as_str().unwrap().parse().unwrap(),
+            tx_hash: None,
+            relayer: None,
         })
     }
 
@@ -175,8 +175,11 @@ pub async fn check_for_and_relay_events(
         .await?;
         let valsets = ValsetUpdatedEvent::filter_by_event_nonce(last_event_nonce, &valsets);
         let deposits = SendToCosmosEvent::filter_by_event_nonce(last_event_nonce, &deposits);
-        let withdraws =
-            TransactionBatchExecutedEvent::filter_by_event_nonce(last_event_nonce, &batches);
+        let withdraws = find_batch_relayers(
+            web3,
+            TransactionBatchExecutedEvent::filter_by_event_nonce(last_event_nonce, &batches),
+        )
+        .await;
         let erc20_deploys =
             Erc20DeployedEvent::filter_by_event_nonce(last_event_nonce, &erc20_deployed);
         let logic_calls =
@@ -295,6 +298,33 @@ pub async fn check_for_and_relay_events(
     }
 }
 
+/// Looks up the address which submitted each executed batch, the extra fees of a batch are paid to the
+/// orchestrator of the validator owning it. A relayer which can not be looked up is left unknown, which
+/// pays the extra fees of its batch to the community pool
+async fn find_batch_relayers(
+    web3: &Web3,
+    mut withdraws: Vec<TransactionBatchExecutedEvent>,
+) -> Vec<TransactionBatchExecutedEvent> {
+    for withdraw in withdraws.iter_mut() {
+        let tx_hash = match withdraw.tx_hash.clone() {
+            Some(tx_hash) => tx_hash,
+            None => continue,
+        };
+        match web3.eth_get_transaction_by_hash(tx_hash).await {
+            Ok(Some(tx)) => withdraw.relayer = Some(tx.get_from()),
+            Ok(None) => warn!(
+                "Could not find the transaction of batch {}, reporting no relayer",
+                withdraw.batch_nonce
+            ),
+            Err(e) => warn!(
+                "Failed to get the transaction of batch {}, reporting no relayer {:?}",
+                withdraw.batch_nonce, e
+            ),
+        }
+    }
+    withdraws
+}
+
 /// The latest 'safe block' for Ethereum event checking. This is used to prevent the bridge from
 /// accepting deposits that are not finalized and may be subject to a re-org, resulting in the attacker
 /// recieving tokens that are not actually in the bridge contract.
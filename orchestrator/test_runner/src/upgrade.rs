@@ -80,7 +80,7 @@ pub async fn upgrade_part_1(
     )
     .await;
 
-    let upgrade_height = run_upgrade(gravity_contact, keys, "antares".to_string(), false).await;
+    let upgrade_height = run_upgrade(gravity_contact, keys, "apollo".to_string(), false).await;
 
     // Check that the expected attestations exist
     check_attestations(grpc_client.clone(), MINIMUM_ATTESTATIONS).await;